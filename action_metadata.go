@@ -1,6 +1,7 @@
 package actionlint
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -13,6 +14,29 @@ import (
 
 //go:generate go run ./scripts/generate-popular-actions ./popular_actions.go
 
+var popularActions map[string]*ActionMetadata
+
+var popularActionsOnce sync.Once
+
+// PopularActions returns data set of known popular actions. Keys are specs (owner/repo@ref) of
+// actions and values are their metadata. The data set is embedded in compact JSON and decoded on
+// the first call so that programs which never check popular actions don't pay for it. When
+// actionlint is built with "actionlint_custom_data" build tag, actions in ./data/popular_actions.jsonl
+// are merged into the data set. The returned map is shared and must not be modified.
+func PopularActions() map[string]*ActionMetadata {
+	popularActionsOnce.Do(func() {
+		m := map[string]*ActionMetadata{}
+		if err := json.Unmarshal([]byte(popularActionsJSON), &m); err != nil {
+			panic(fmt.Sprintf("popular actions data set is broken: %s", err)) // Unreachable since the data set is generated
+		}
+		if err := mergeCustomPopularActions(customDataFS, m); err != nil {
+			panic(fmt.Sprintf("custom data embedded at build time is broken: %s", err))
+		}
+		popularActions = m
+	})
+	return popularActions
+}
+
 // ActionMetadataInput is input metadata in "inputs" section in action.yml metadata file.
 // https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#inputs
 type ActionMetadataInput struct {
@@ -20,6 +44,9 @@ type ActionMetadataInput struct {
 	Name string `json:"name"`
 	// Required is true when this input is mandatory to run the action.
 	Required bool `json:"required"`
+	// Deprecated is a deprecation message of this input set at "deprecationMessage". Empty string
+	// means this input is not deprecated.
+	Deprecated string `json:"deprecated,omitempty"`
 }
 
 // ActionMetadataInputs is a map from input ID to its metadata. Keys are in lower case since input
@@ -34,8 +61,9 @@ func (inputs *ActionMetadataInputs) UnmarshalYAML(n *yaml.Node) error {
 	}
 
 	type actionInputMetadata struct {
-		Required bool    `yaml:"required"`
-		Default  *string `yaml:"default"`
+		Required           bool    `yaml:"required"`
+		Default            *string `yaml:"default"`
+		DeprecationMessage string  `yaml:"deprecationMessage"`
 	}
 
 	md := make(ActionMetadataInputs, len(n.Content)/2)
@@ -52,7 +80,7 @@ func (inputs *ActionMetadataInputs) UnmarshalYAML(n *yaml.Node) error {
 			return fmt.Errorf("input %q is duplicated", k)
 		}
 
-		md[id] = &ActionMetadataInput{k, m.Required && m.Default == nil}
+		md[id] = &ActionMetadataInput{k, m.Required && m.Default == nil, strings.TrimSpace(m.DeprecationMessage)}
 	}
 
 	*inputs = md
@@ -142,6 +170,18 @@ type ActionMetadata struct {
 	// Outputs is "outputs" field of action.yaml. Key is name of output. Description is omitted
 	// since actionlint does not use it.
 	Outputs ActionMetadataOutputs `yaml:"outputs" json:"outputs"`
+	// RenamedInputs is a map from input IDs which were renamed to their new input names. Keys are in
+	// lower case. This value is only set to popular actions since action.yaml has no field for it.
+	RenamedInputs map[string]string `yaml:"-" json:"renamed_inputs,omitempty"`
+	// PATInputs is a map from input IDs which require a personal access token to the events which
+	// are not triggered when `secrets.GITHUB_TOKEN` is given instead. Keys are in lower case. This
+	// value is only set to popular actions since action.yaml has no field for it.
+	PATInputs map[string]string `yaml:"-" json:"pat_inputs,omitempty"`
+	// Triggers is a list of events which can trigger workflows where the action is meaningful. For
+	// example, actions/stale only works on "schedule" or "workflow_dispatch" events. Empty means the
+	// action works on any event. This value is only set to popular actions since action.yaml has no
+	// field for it.
+	Triggers []string `yaml:"-" json:"triggers,omitempty"`
 	// SkipInputs is flag to specify behavior of inputs check. When it is true, inputs for this
 	// action will not be checked.
 	SkipInputs bool `yaml:"-" json:"skip_inputs"`
@@ -232,10 +272,7 @@ func (c *LocalActionsCache) FindMetadata(spec string) (*ActionMetadata, bool, er
 		c.debug("No action metadata found in %s", dir)
 		// Remember action was not found
 		c.writeCache(spec, nil)
-		// Do not complain about the action does not exist (#25, #40).
-		// It seems a common pattern that the local action does not exist in the repository
-		// (e.g. Git submodule) and it is cloned at running workflow (due to a private repository).
-		return nil, false, nil
+		return nil, false, checkLocalActionDir(spec, dir)
 	}
 
 	var meta ActionMetadata
@@ -263,6 +300,28 @@ func (c *LocalActionsCache) readLocalActionMetadataFile(dir string) ([]byte, str
 	return nil, "", false
 }
 
+// checkLocalActionDir returns an error when the local action surely cannot be run since its path
+// is not a directory of action. Note that the directory may not exist. It seems a common pattern
+// that the local action does not exist in the repository (e.g. Git submodule) and it is cloned at
+// running workflow (due to a private repository). So the action does not exist and the directory is
+// empty are not reported (#25, #40).
+func checkLocalActionDir(spec, dir string) error {
+	s, err := os.Stat(dir)
+	if err != nil {
+		return nil
+	}
+	if !s.IsDir() {
+		if f := filepath.Base(dir); f == "action.yml" || f == "action.yaml" {
+			return fmt.Errorf("local action %q must be a path to the directory containing %q, not to the file itself. use %q instead", spec, f, strings.TrimSuffix(spec, "/"+f))
+		}
+		return fmt.Errorf("local action %q must be a path to the directory containing \"action.yml\" or \"action.yaml\" but it is a file", spec)
+	}
+	if es, err := os.ReadDir(dir); err == nil && len(es) > 0 {
+		return fmt.Errorf("neither \"action.yml\" nor \"action.yaml\" is found in the directory of local action %q", spec)
+	}
+	return nil
+}
+
 // LocalActionsCacheFactory is a factory to create LocalActionsCache instances. LocalActionsCache
 // should be created for each repositories. LocalActionsCacheFactory creates new LocalActionsCache
 // instance per repository (project).
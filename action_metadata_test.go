@@ -18,9 +18,9 @@ func testGetWantedActionMetadata() *ActionMetadata {
 		Name:        "My action",
 		Description: "my action",
 		Inputs: ActionMetadataInputs{
-			"name":     {"name", false},
-			"message":  {"message", true},
-			"addition": {"addition", false},
+			"name":     {"name", false, ""},
+			"message":  {"message", true, ""},
+			"addition": {"addition", false, ""},
 		},
 		Outputs: ActionMetadataOutputs{
 			"user_id": {"user_id"},
@@ -318,6 +318,22 @@ func TestLocalActionsBrokenMetadata(t *testing.T) {
 			spec: "./broken",
 			want: "could not parse action metadata",
 		},
+		{
+			spec: "./action-yml/action.yml",
+			want: `must be a path to the directory containing "action.yml", not to the file itself. use "./action-yml" instead`,
+		},
+		{
+			spec: "./action-yaml/action.yaml",
+			want: `use "./action-yaml" instead`,
+		},
+		{
+			spec: "./docker/Dockerfile",
+			want: "but it is a file",
+		},
+		{
+			spec: "./no-metadata",
+			want: `neither "action.yml" nor "action.yaml" is found`,
+		},
 	}
 
 	proj := &Project{filepath.Join("testdata", "action_metadata"), nil}
@@ -548,11 +564,11 @@ inputs:
 			want: ActionMetadata{
 				Name: "Test",
 				Inputs: ActionMetadataInputs{
-					"input1": {"input1", false},
-					"input2": {"input2", false},
-					"input3": {"input3", false},
-					"input4": {"input4", false},
-					"input5": {"input5", true},
+					"input1": {"input1", false, ""},
+					"input2": {"input2", false, ""},
+					"input3": {"input3", false, ""},
+					"input4": {"input4", false, ""},
+					"input5": {"input5", true, ""},
 				},
 			},
 		},
@@ -929,3 +929,13 @@ func (w *Workflow) FindWorkflowCallEvent() (*WorkflowCallEvent, bool) {
 	}
 	return nil, false
 }
+
+// FindWorkflowDispatchEvent returns workflow_dispatch event node if exists
+func (w *Workflow) FindWorkflowDispatchEvent() (*WorkflowDispatchEvent, bool) {
+	for _, e := range w.On {
+		if e, ok := e.(*WorkflowDispatchEvent); ok {
+			return e, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,339 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// This file implements JSON serialization of the workflow AST. Keys of JSON objects are the field
+// names of the AST nodes. Positions are serialized as "Pos" objects. Values of interface types
+// such as Event, Exec, and RawYAMLValue are serialized as objects with "Kind" property so that
+// they can be deserialized into the same node types.
+//
+//	b, err := json.Marshal(workflow)
+//	var w actionlint.Workflow
+//	err = json.Unmarshal(b, &w)
+
+const (
+	jsonKindWebhookEvent            = "webhook"
+	jsonKindScheduledEvent          = "schedule"
+	jsonKindWorkflowDispatchEvent   = "workflow_dispatch"
+	jsonKindRepositoryDispatchEvent = "repository_dispatch"
+	jsonKindWorkflowCallEvent       = "workflow_call"
+	jsonKindExecRun                 = "run"
+	jsonKindExecAction              = "action"
+	jsonKindRawYAMLObject           = "object"
+	jsonKindRawYAMLArray            = "array"
+	jsonKindRawYAMLString           = "string"
+)
+
+// jsonKind is used to read "Kind" property of JSON object to determine the node type.
+type jsonKind struct {
+	Kind string
+}
+
+func readJSONKind(b []byte) (string, error) {
+	var k jsonKind
+	if err := json.Unmarshal(b, &k); err != nil {
+		return "", err
+	}
+	return k.Kind, nil
+}
+
+func isJSONNull(b []byte) bool {
+	return len(b) == 0 || string(b) == "null"
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (e *WebhookEvent) MarshalJSON() ([]byte, error) {
+	type node WebhookEvent // Avoid infinite recursion
+	return json.Marshal(&struct {
+		Kind string
+		*node
+	}{jsonKindWebhookEvent, (*node)(e)})
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (e *ScheduledEvent) MarshalJSON() ([]byte, error) {
+	type node ScheduledEvent
+	return json.Marshal(&struct {
+		Kind string
+		*node
+	}{jsonKindScheduledEvent, (*node)(e)})
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (e *WorkflowDispatchEvent) MarshalJSON() ([]byte, error) {
+	type node WorkflowDispatchEvent
+	return json.Marshal(&struct {
+		Kind string
+		*node
+	}{jsonKindWorkflowDispatchEvent, (*node)(e)})
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (e *RepositoryDispatchEvent) MarshalJSON() ([]byte, error) {
+	type node RepositoryDispatchEvent
+	return json.Marshal(&struct {
+		Kind string
+		*node
+	}{jsonKindRepositoryDispatchEvent, (*node)(e)})
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (e *WorkflowCallEvent) MarshalJSON() ([]byte, error) {
+	type node WorkflowCallEvent
+	return json.Marshal(&struct {
+		Kind string
+		*node
+	}{jsonKindWorkflowCallEvent, (*node)(e)})
+}
+
+func unmarshalEventJSON(b []byte) (Event, error) {
+	k, err := readJSONKind(b)
+	if err != nil {
+		return nil, err
+	}
+	var e Event
+	switch k {
+	case jsonKindWebhookEvent:
+		e = &WebhookEvent{}
+	case jsonKindScheduledEvent:
+		e = &ScheduledEvent{}
+	case jsonKindWorkflowDispatchEvent:
+		e = &WorkflowDispatchEvent{}
+	case jsonKindRepositoryDispatchEvent:
+		e = &RepositoryDispatchEvent{}
+	case jsonKindWorkflowCallEvent:
+		e = &WorkflowCallEvent{}
+	default:
+		return nil, fmt.Errorf("unknown kind of event %q in JSON", k)
+	}
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (e *ExecRun) MarshalJSON() ([]byte, error) {
+	type node ExecRun
+	return json.Marshal(&struct {
+		Kind string
+		*node
+	}{jsonKindExecRun, (*node)(e)})
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (e *ExecAction) MarshalJSON() ([]byte, error) {
+	type node ExecAction
+	return json.Marshal(&struct {
+		Kind string
+		*node
+	}{jsonKindExecAction, (*node)(e)})
+}
+
+func unmarshalExecJSON(b []byte) (Exec, error) {
+	if isJSONNull(b) {
+		return nil, nil
+	}
+	k, err := readJSONKind(b)
+	if err != nil {
+		return nil, err
+	}
+	var e Exec
+	switch k {
+	case jsonKindExecRun:
+		e = &ExecRun{}
+	case jsonKindExecAction:
+		e = &ExecAction{}
+	default:
+		return nil, fmt.Errorf("unknown kind of step execution %q in JSON", k)
+	}
+	if err := json.Unmarshal(b, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (o *RawYAMLObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Kind  string
+		Props map[string]RawYAMLValue
+		Pos   *Pos
+	}{jsonKindRawYAMLObject, o.Props, o.pos})
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (a *RawYAMLArray) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Kind  string
+		Elems []RawYAMLValue
+		Pos   *Pos
+	}{jsonKindRawYAMLArray, a.Elems, a.pos})
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (s *RawYAMLString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Kind  string
+		Value string
+		Pos   *Pos
+	}{jsonKindRawYAMLString, s.Value, s.pos})
+}
+
+func unmarshalRawYAMLValueJSON(b []byte) (RawYAMLValue, error) {
+	if isJSONNull(b) {
+		return nil, nil
+	}
+
+	var v struct {
+		Kind  string
+		Props map[string]json.RawMessage
+		Elems []json.RawMessage
+		Value string
+		Pos   *Pos
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	switch v.Kind {
+	case jsonKindRawYAMLObject:
+		o := &RawYAMLObject{Props: make(map[string]RawYAMLValue, len(v.Props)), pos: v.Pos}
+		for n, p := range v.Props {
+			pv, err := unmarshalRawYAMLValueJSON(p)
+			if err != nil {
+				return nil, err
+			}
+			o.Props[n] = pv
+		}
+		return o, nil
+	case jsonKindRawYAMLArray:
+		a := &RawYAMLArray{Elems: make([]RawYAMLValue, 0, len(v.Elems)), pos: v.Pos}
+		for _, e := range v.Elems {
+			ev, err := unmarshalRawYAMLValueJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			a.Elems = append(a.Elems, ev)
+		}
+		return a, nil
+	case jsonKindRawYAMLString:
+		return &RawYAMLString{Value: v.Value, pos: v.Pos}, nil
+	default:
+		return nil, fmt.Errorf("unknown kind of raw YAML value %q in JSON", v.Kind)
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (w *Workflow) UnmarshalJSON(b []byte) error {
+	type node Workflow
+	v := struct {
+		*node
+		On []json.RawMessage
+	}{node: (*node)(w)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	w.On = nil
+	if v.On != nil {
+		w.On = make([]Event, 0, len(v.On))
+	}
+	for _, r := range v.On {
+		e, err := unmarshalEventJSON(r)
+		if err != nil {
+			return err
+		}
+		w.On = append(w.On, e)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (s *Step) UnmarshalJSON(b []byte) error {
+	type node Step
+	v := struct {
+		*node
+		Exec json.RawMessage
+	}{node: (*node)(s)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	e, err := unmarshalExecJSON(v.Exec)
+	if err != nil {
+		return err
+	}
+	s.Exec = e
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (r *MatrixRow) UnmarshalJSON(b []byte) error {
+	type node MatrixRow
+	v := struct {
+		*node
+		Values []json.RawMessage
+	}{node: (*node)(r)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	r.Values = nil
+	if v.Values != nil {
+		r.Values = make([]RawYAMLValue, 0, len(v.Values))
+	}
+	for _, m := range v.Values {
+		y, err := unmarshalRawYAMLValueJSON(m)
+		if err != nil {
+			return err
+		}
+		r.Values = append(r.Values, y)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (a *MatrixAssign) UnmarshalJSON(b []byte) error {
+	type node MatrixAssign
+	v := struct {
+		*node
+		Value json.RawMessage
+	}{node: (*node)(a)}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	y, err := unmarshalRawYAMLValueJSON(v.Value)
+	if err != nil {
+		return err
+	}
+	a.Value = y
+	return nil
+}
+
+// WorkflowAST is a parse result of one workflow file serialized by `-ast-json` flag of actionlint
+// command. Workflow is nil when the file could not be parsed at all.
+type WorkflowAST struct {
+	// Filepath is a file path of the workflow.
+	Filepath string `json:"filepath"`
+	// Workflow is the AST of the workflow.
+	Workflow *Workflow `json:"workflow"`
+	// Errors is a list of syntax errors found while parsing the workflow.
+	Errors []*ErrorTemplateFields `json:"errors"`
+}
+
+// ParseWorkflowAST parses the workflow source and returns the result which can be serialized into
+// JSON with json.Marshal.
+func ParseWorkflowAST(path string, src []byte) *WorkflowAST {
+	w, errs := Parse(src)
+	fs := make([]*ErrorTemplateFields, 0, len(errs))
+	for _, e := range errs {
+		e.Filepath = path
+		fs = append(fs, e.GetTemplateFields(src))
+	}
+	return &WorkflowAST{path, w, fs}
+}
@@ -0,0 +1,124 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestASTJSONRoundTrip(t *testing.T) {
+	dirs := []string{"examples", "ok"}
+	for _, d := range dirs {
+		fs, err := filepath.Glob(filepath.Join("testdata", d, "*.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range fs {
+			t.Run(filepath.Base(f), func(t *testing.T) {
+				b, err := os.ReadFile(f)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, _ := Parse(b)
+				if want == nil {
+					t.Skip("workflow could not be parsed")
+				}
+
+				j, err := json.Marshal(want)
+				if err != nil {
+					t.Fatal(err)
+				}
+				have := &Workflow{}
+				if err := json.Unmarshal(j, have); err != nil {
+					t.Fatalf("could not deserialize %s: %v", j, err)
+				}
+				if !reflect.DeepEqual(want, have) {
+					t.Fatalf("deserialized AST is different from original one. JSON: %s", j)
+				}
+			})
+		}
+	}
+}
+
+func TestASTJSONInterfaceKinds(t *testing.T) {
+	src := `on:
+  push:
+  schedule:
+    - cron: '0 0 * * *'
+  workflow_dispatch:
+  repository_dispatch:
+  workflow_call:
+jobs:
+  test:
+    strategy:
+      matrix:
+        os: [ubuntu-latest, {name: windows, arch: [x64]}]
+        include:
+          - os: macos-latest
+    runs-on: ${{ matrix.os }}
+    steps:
+      - uses: actions/checkout@v4
+      - run: echo hi
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	b, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	j := string(b)
+	for _, k := range []string{"webhook", "schedule", "workflow_dispatch", "repository_dispatch", "workflow_call", "run", "action", "object", "array", "string"} {
+		want := `"Kind":"` + k + `"`
+		if !strings.Contains(j, want) {
+			t.Errorf("%s is not contained in JSON: %s", want, j)
+		}
+	}
+	if want := `{"Kind":"string","Value":"ubuntu-latest","Pos":{"Line":12,"Col":14}}`; !strings.Contains(j, want) {
+		t.Errorf("position of raw YAML value %s is not contained in JSON: %s", want, j)
+	}
+}
+
+func TestASTJSONUnknownKind(t *testing.T) {
+	inputs := []string{
+		`{"On":[{"Kind":"unknown"}]}`,
+		`{"Jobs":{"test":{"Steps":[{"Exec":{"Kind":"unknown"}}]}}}`,
+		`{"Jobs":{"test":{"Strategy":{"Matrix":{"Rows":{"os":{"Values":[{"Kind":"unknown"}]}}}}}}}`,
+	}
+	for _, input := range inputs {
+		var w Workflow
+		err := json.Unmarshal([]byte(input), &w)
+		if err == nil {
+			t.Fatalf("error did not occur for %s", input)
+		}
+		if !strings.Contains(err.Error(), `unknown kind of `) {
+			t.Fatalf("unexpected error for %s: %v", input, err)
+		}
+	}
+}
+
+func TestParseWorkflowAST(t *testing.T) {
+	src := []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps: 42\n")
+	a := ParseWorkflowAST("test.yaml", src)
+	if a.Filepath != "test.yaml" {
+		t.Fatalf("wanted file path \"test.yaml\" but got %q", a.Filepath)
+	}
+	if a.Workflow == nil {
+		t.Fatal("workflow is nil")
+	}
+	if len(a.Errors) == 0 {
+		t.Fatal("no error was reported")
+	}
+	for _, e := range a.Errors {
+		if e.Filepath != "test.yaml" || e.Kind != "syntax-check" {
+			t.Fatalf("unexpected error: %#v", e)
+		}
+	}
+	if e := a.Errors[0]; e.Line != 5 || e.Column != 12 {
+		t.Fatalf("unexpected position of error: %#v", e)
+	}
+}
@@ -8,6 +8,9 @@ import (
 func TestWorkflowKeyAvailability(t *testing.T) {
 	for _, key := range allWorkflowKeys {
 		t.Run(key, func(t *testing.T) {
+			if !WorkflowKeyAcceptsExpression(key) {
+				t.Error("workflow key does not accept expressions:", key)
+			}
 			ctx, sp := WorkflowKeyAvailability(key)
 			if ctx == nil || sp == nil {
 				t.Error("workflow key has not availability info:", key)
@@ -51,6 +54,9 @@ func TestWorkflowKeyAvailability(t *testing.T) {
 	if len(sp) != 0 {
 		t.Error("some special function name was returned", sp)
 	}
+	if WorkflowKeyAcceptsExpression("unknown.workflow.key") {
+		t.Error("unknown key accepts expressions")
+	}
 }
 
 func TestSpecialFunctionNames(t *testing.T) {
@@ -0,0 +1,144 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+const baselineVersion = 1
+
+// BaselineEntry is an entry of errors recorded in a baseline file. Errors are identified by the file
+// path, the rule name, and the message. Line and column are not recorded so that unrelated changes
+// in the same file don't invalidate the baseline. For the same reason, positions like "at line:12"
+// and dates in messages are ignored when identifying errors.
+type BaselineEntry struct {
+	// Filepath is a file path of the errors in slash-separated form.
+	Filepath string `json:"filepath"`
+	// Kind is a rule name of the errors.
+	Kind string `json:"kind"`
+	// Message is a message of the errors.
+	Message string `json:"message"`
+	// Count is the number of errors recorded for the entry.
+	Count int `json:"count"`
+}
+
+// Baseline is a set of errors which already exist in a repository. Errors recorded in the baseline
+// are not reported so that only new errors fail CI while the existing errors are fixed gradually.
+type Baseline struct {
+	// Version is a version of the baseline file format.
+	Version int `json:"version"`
+	// Entries is the list of recorded errors sorted by file path, rule name, and message.
+	Entries []*BaselineEntry `json:"errors"`
+}
+
+var (
+	// Positions in messages like "at line:12" or "at line 12, col 3"
+	reBaselineMessagePos = regexp.MustCompile(`\bline(?::|\s)\s*\d+(?:,\s*col(?:umn)?(?::|\s)\s*\d+)?`)
+	// Dates in messages like "will be unavailable from 2025-01-30". The message of "deprecation" rule
+	// changes after the effective date
+	reBaselineMessageDate = regexp.MustCompile(`(?:will be unavailable from|is no longer available since) \d{4}-\d{2}-\d{2}|\d{4}-\d{2}-\d{2}`)
+)
+
+type baselineKey struct{ file, kind, msg string }
+
+// normalizeBaselineMessage removes the parts of the message which change without changing the error
+// itself such as positions of other nodes and dates.
+func normalizeBaselineMessage(msg string) string {
+	msg = reBaselineMessagePos.ReplaceAllString(msg, "line")
+	return reBaselineMessageDate.ReplaceAllString(msg, "date")
+}
+
+func newBaselineKey(file, kind, msg string) baselineKey {
+	return baselineKey{filepath.ToSlash(file), kind, normalizeBaselineMessage(msg)}
+}
+
+// NewBaseline creates a new Baseline instance which records the given errors.
+func NewBaseline(errs []*Error) *Baseline {
+	m := map[baselineKey]*BaselineEntry{}
+	es := []*BaselineEntry{}
+	for _, err := range errs {
+		k := newBaselineKey(err.Filepath, err.Kind, err.Message)
+		if e, ok := m[k]; ok {
+			e.Count++
+			continue
+		}
+		e := &BaselineEntry{k.file, k.kind, err.Message, 1}
+		m[k] = e
+		es = append(es, e)
+	}
+	sort.Slice(es, func(i, j int) bool {
+		if es[i].Filepath != es[j].Filepath {
+			return es[i].Filepath < es[j].Filepath
+		}
+		if es[i].Kind != es[j].Kind {
+			return es[i].Kind < es[j].Kind
+		}
+		return es[i].Message < es[j].Message
+	})
+	return &Baseline{baselineVersion, es}
+}
+
+// ReadBaselineFile reads the baseline file at the given file path. The file is generated by
+// Baseline.WriteFile method.
+func ReadBaselineFile(path string) (*Baseline, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read baseline file %q: %w", path, err)
+	}
+	var bl Baseline
+	if err := json.Unmarshal(b, &bl); err != nil {
+		return nil, fmt.Errorf("could not parse baseline file %q: %w", path, err)
+	}
+	if bl.Version != baselineVersion {
+		return nil, fmt.Errorf("unsupported version %d of baseline file %q. regenerate it with -update-baseline flag", bl.Version, path)
+	}
+	return &bl, nil
+}
+
+// Write writes the baseline in JSON format to the given writer.
+func (bl *Baseline) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(bl); err != nil {
+		return fmt.Errorf("could not encode baseline into JSON: %w", err)
+	}
+	return nil
+}
+
+// WriteFile writes the baseline to the file at the given path. When the file already exists, it is
+// overwritten.
+func (bl *Baseline) WriteFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create baseline file %q: %w", path, err)
+	}
+	defer f.Close()
+	return bl.Write(f)
+}
+
+// Filter removes the errors recorded in the baseline from the given errors. When the same error
+// occurs more times than the count recorded in the baseline, the errors exceeding the count are
+// kept since they are newly added.
+func (bl *Baseline) Filter(errs []*Error) []*Error {
+	counts := make(map[baselineKey]int, len(bl.Entries))
+	for _, e := range bl.Entries {
+		counts[newBaselineKey(e.Filepath, e.Kind, e.Message)] += e.Count
+	}
+
+	kept := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		k := newBaselineKey(err.Filepath, err.Kind, err.Message)
+		if counts[k] > 0 {
+			counts[k]--
+			continue
+		}
+		kept = append(kept, err)
+	}
+	return kept
+}
@@ -0,0 +1,144 @@
+package actionlint
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBaselineFilter(t *testing.T) {
+	recorded := []*Error{
+		{Filepath: "a.yaml", Line: 1, Kind: "expression", Message: "msg1"},
+		{Filepath: "a.yaml", Line: 2, Kind: "expression", Message: "msg1"},
+		{Filepath: "a.yaml", Line: 3, Kind: "syntax-check", Message: "msg2"},
+		{Filepath: "b.yaml", Line: 1, Kind: "expression", Message: "msg1"},
+	}
+	bl := NewBaseline(recorded)
+
+	errs := []*Error{
+		{Filepath: "a.yaml", Line: 5, Kind: "expression", Message: "msg1"},   // Line moved
+		{Filepath: "a.yaml", Line: 6, Kind: "expression", Message: "msg1"},   // Line moved
+		{Filepath: "a.yaml", Line: 7, Kind: "expression", Message: "msg1"},   // Exceeds recorded count
+		{Filepath: "a.yaml", Line: 8, Kind: "expression", Message: "msg3"},   // New message
+		{Filepath: "b.yaml", Line: 1, Kind: "syntax-check", Message: "msg1"}, // Other rule
+		{Filepath: "c.yaml", Line: 1, Kind: "expression", Message: "msg1"},   // Other file
+	}
+	have := []int{}
+	for _, err := range bl.Filter(errs) {
+		have = append(have, err.Line)
+	}
+	want := []int{7, 8, 1, 1}
+	if !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestBaselineFilterMovedError(t *testing.T) {
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: npm ci\n      - uses: actions/setup-node@v4\n"
+	lint := func(src string) []*Error {
+		t.Helper()
+		l, err := NewLinter(io.Discard, &LinterOptions{OnlyRules: []string{"setup-order"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		l.defaultConfig = &Config{}
+		errs, err := l.Lint("test.yaml", []byte(src), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errs
+	}
+
+	errs := lint(src)
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, "at line:7") {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	bl := NewBaseline(errs)
+
+	// Move the error and the setup step it refers down one line
+	moved := lint(strings.Replace(src, "on: push\n", "on: push\n\n", 1))
+	if len(moved) != 1 || moved[0].Line != errs[0].Line+1 || !strings.Contains(moved[0].Message, "at line:8") {
+		t.Fatalf("unexpected errors after moving lines: %v", moved)
+	}
+	if kept := bl.Filter(moved); len(kept) > 0 {
+		t.Fatalf("error moved down one line should be filtered by baseline: %v", kept)
+	}
+}
+
+func TestBaselineNormalizeMessage(t *testing.T) {
+	tests := []struct {
+		before string
+		after  string
+	}{
+		{
+			`"foo" step must be run before "bar" step at line:12. the site is deployed before it is uploaded`,
+			`"foo" step must be run before "bar" step at line:13. the site is deployed before it is uploaded`,
+		},
+		{
+			"the previous step at line 3 always fails",
+			"the previous step at line 10 always fails",
+		},
+		{
+			`job ID "foo" duplicates. previously defined at line:1,col:3`,
+			`job ID "foo" duplicates. previously defined at line:4,col:3`,
+		},
+		{
+			`action "actions/upload-artifact@v3" is deprecated and will be unavailable from 2025-01-30. see https://example.com`,
+			`action "actions/upload-artifact@v3" is deprecated and is no longer available since 2025-01-30. see https://example.com`,
+		},
+	}
+	for _, tc := range tests {
+		if a, b := normalizeBaselineMessage(tc.before), normalizeBaselineMessage(tc.after); a != b {
+			t.Errorf("normalized messages differ\n  before: %q\n  after:  %q", a, b)
+		}
+	}
+
+	if a, b := normalizeBaselineMessage(`undefined variable "foo"`), normalizeBaselineMessage(`undefined variable "bar"`); a == b {
+		t.Errorf("different messages should not be normalized to the same message: %q", a)
+	}
+}
+
+func TestBaselineWriteAndRead(t *testing.T) {
+	errs := []*Error{
+		{Filepath: "b.yaml", Line: 1, Kind: "expression", Message: "msg <1>"},
+		{Filepath: "a.yaml", Line: 2, Kind: "syntax-check", Message: "msg2"},
+		{Filepath: "a.yaml", Line: 3, Kind: "expression", Message: "msg1"},
+		{Filepath: "a.yaml", Line: 4, Kind: "expression", Message: "msg1"},
+	}
+	want := &Baseline{
+		Version: 1,
+		Entries: []*BaselineEntry{
+			{"a.yaml", "expression", "msg1", 2},
+			{"a.yaml", "syntax-check", "msg2", 1},
+			{"b.yaml", "expression", "msg <1>", 1},
+		},
+	}
+	bl := NewBaseline(errs)
+	if !cmp.Equal(want, bl) {
+		t.Fatal(cmp.Diff(want, bl))
+	}
+
+	var b bytes.Buffer
+	if err := bl.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(b.Bytes(), []byte(`"message": "msg <1>"`)) {
+		t.Fatalf("HTML characters should not be escaped: %s", b.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := bl.WriteFile(path); err != nil {
+		t.Fatal(err)
+	}
+	have, err := ReadBaselineFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
@@ -1,11 +1,19 @@
 package actionlint
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"runtime"
 	"runtime/debug"
+	"strings"
+	"time"
 )
 
 // These variables might be modified by ldflags on building release binaries by GoReleaser. Do not modify manually
@@ -16,6 +24,7 @@ var (
 
 const (
 	// ExitStatusSuccessNoProblem is the exit status when the command ran successfully with no problem found.
+	// Errors whose severities are "warning" or "note" are not counted as problems.
 	ExitStatusSuccessNoProblem = 0
 	// ExitStatusSuccessProblemFound is the exit status when the command ran successfully with some problem found.
 	ExitStatusSuccessProblemFound = 1
@@ -43,11 +52,62 @@ const commandUsageHeader = `Usage: actionlint [FLAGS] [FILES...] [-]
 
     $ actionlint -
 
+  To check workflow files in an archive or a Git revision without checking out,
+  use -archive or -git-dir option:
+
+    $ actionlint -archive repo.tar.gz
+    $ actionlint -git-dir .git -rev main
+
   To serialize errors into JSON, use -format option. It allows to format error
   messages flexibly with Go template syntax.
 
     $ actionlint -format '{{json .}}'
 
+  To lint only one job or step while iterating on it, use -select option.
+  Expressions in the subtree are output with their resolved types:
+
+    $ actionlint -select 'jobs.build.steps[3]' .github/workflows/ci.yaml
+
+  To output the ASTs of workflows with positions as JSON for other tools, use
+  -ast-json option:
+
+    $ actionlint -ast-json .github/workflows/ci.yaml
+
+  To lint workflows again each time they are modified while editing them, use
+  -watch option. Press Ctrl+C to stop watching:
+
+    $ actionlint -watch
+
+  To evaluate an expression with the example payload of some event, use eval
+  subcommand:
+
+    $ actionlint eval 'github.event.pull_request.head.ref' -event pull_request
+
+  To generate a workflow skeleton which passes all checks, use new subcommand:
+
+    $ actionlint new -template go-ci -output .github/workflows/ci.yaml
+
+  To import suppression settings of other linters into actionlint config, use
+  import subcommand:
+
+    $ actionlint import .yamllint >> .github/actionlint.yaml
+
+  To rename a job ID, a step ID, or an input with updating all references to it
+  across the repository, use rename subcommand:
+
+    $ actionlint rename -job build -to build-linux .github/workflows/ci.yaml
+
+  To summarize semantic changes between two versions of a workflow such as
+  triggers added or permissions broadened on reviewing them, use explain-diff
+  subcommand:
+
+    $ actionlint explain-diff old.yaml .github/workflows/ci.yaml
+
+  To integrate actionlint with editors via Language Server Protocol, use lsp
+  subcommand:
+
+    $ actionlint lsp
+
 Documents:
 
   https://github.com/rhysd/actionlint/tree/main/docs
@@ -77,7 +137,59 @@ type Command struct {
 	Stderr io.Writer
 }
 
-func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig bool) ([]*Error, error) {
+// sourceOptions is options to read workflows from other than the file system.
+type sourceOptions struct {
+	archive    string
+	gitDir     string
+	rev        string
+	preReceive bool
+	top        int
+}
+
+// runPreReceive lints workflow files changed by the push in a pre-receive hook. Ref updates are read
+// from stdin and the summary of the result is output instead of the errors.
+func (cmd *Command) runPreReceive(opts *LinterOptions, src *sourceOptions) ([]*Error, error) {
+	l, err := NewLinter(io.Discard, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	us, err := ParseRefUpdates(cmd.Stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	all := []*Error{}
+	files := 0
+	for _, u := range us {
+		s, err := ReadGitPushedWorkflows(ctx, src.gitDir, u)
+		if err != nil {
+			return nil, err
+		}
+		if len(s.Files) == 0 {
+			continue
+		}
+		for _, f := range s.Files {
+			f.Path = u.ShortRef() + ":" + f.Path // Like "main:.github/workflows/ci.yaml"
+		}
+		errs, err := l.LintSourcesContext(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		files += len(s.Files)
+		all = append(all, errs...)
+	}
+
+	writePreReceiveSummary(cmd.Stdout, all, files, src.top)
+	return all, nil
+}
+
+func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig bool, src *sourceOptions) ([]*Error, error) {
+	if src.preReceive {
+		return cmd.runPreReceive(opts, src)
+	}
+
 	l, err := NewLinter(cmd.Stdout, opts)
 	if err != nil {
 		return nil, err
@@ -87,6 +199,22 @@ func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig boo
 		return nil, l.GenerateDefaultConfig(".")
 	}
 
+	if src.archive != "" {
+		s, err := ReadArchive(src.archive)
+		if err != nil {
+			return nil, err
+		}
+		return l.LintSources(s)
+	}
+
+	if src.gitDir != "" {
+		s, err := ReadGitRevision(context.Background(), src.gitDir, src.rev)
+		if err != nil {
+			return nil, err
+		}
+		return l.LintSources(s)
+	}
+
 	if len(args) == 0 {
 		return l.LintRepository(".")
 	}
@@ -106,6 +234,189 @@ func (cmd *Command) runLinter(args []string, opts *LinterOptions, initConfig boo
 	return l.LintFiles(args, nil)
 }
 
+// runUpdateBaseline lints workflows and records all errors found in the baseline file instead of
+// reporting them.
+func (cmd *Command) runUpdateBaseline(args []string, opts *LinterOptions, src *sourceOptions) int {
+	path := opts.Baseline
+	// All errors must be recorded regardless of the current baseline and the options to omit errors
+	opts.Baseline = ""
+	opts.Dedup = false
+	opts.MaxPerRule = 0
+	opts.Format = ""
+	opts.Renderer = ""
+
+	c := &Command{Stdin: cmd.Stdin, Stdout: io.Discard, Stderr: cmd.Stderr}
+	errs, err := c.runLinter(args, opts, false, src)
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err.Error())
+		return ExitStatusFailure
+	}
+
+	if err := NewBaseline(errs).WriteFile(path); err != nil {
+		fmt.Fprintln(cmd.Stderr, err.Error())
+		return ExitStatusFailure
+	}
+	fmt.Fprintf(cmd.Stdout, "Recorded %d %s in baseline file %q\n", len(errs), pluralErrors(len(errs)), path)
+	return ExitStatusSuccessNoProblem
+}
+
+// runASTJSON parses the workflow files and outputs their ASTs as JSON array instead of linting them.
+// When no file is given, all workflow files in the current repository are parsed.
+func (cmd *Command) runASTJSON(args []string, stdinFileName string) int {
+	var asts []*WorkflowAST
+	if len(args) == 1 && args[0] == "-" {
+		b, err := io.ReadAll(cmd.Stdin)
+		if err != nil {
+			fmt.Fprintf(cmd.Stderr, "could not read stdin: %s\n", err)
+			return ExitStatusFailure
+		}
+		n := "<stdin>"
+		if stdinFileName != "" {
+			n = stdinFileName
+		}
+		asts = append(asts, ParseWorkflowAST(n, b))
+	} else {
+		if len(args) == 0 {
+			p, err := findProject(".")
+			if err != nil {
+				fmt.Fprintln(cmd.Stderr, err.Error())
+				return ExitStatusFailure
+			}
+			if p == nil {
+				fmt.Fprintln(cmd.Stderr, "no project was found in any parent directories of \".\". check workflows directory is put correctly in your Git repository")
+				return ExitStatusFailure
+			}
+			fs, err := collectYAMLFiles(p.WorkflowsDir())
+			if err != nil {
+				fmt.Fprintln(cmd.Stderr, err.Error())
+				return ExitStatusFailure
+			}
+			// Show paths relative to the current directory as linter does
+			if wd, err := os.Getwd(); err == nil {
+				for i, f := range fs {
+					if r, err := filepath.Rel(wd, f); err == nil {
+						fs[i] = r
+					}
+				}
+			}
+			args = fs
+		}
+		for _, f := range args {
+			b, err := os.ReadFile(f)
+			if err != nil {
+				fmt.Fprintf(cmd.Stderr, "could not read workflow file: %s\n", err)
+				return ExitStatusFailure
+			}
+			asts = append(asts, ParseWorkflowAST(f, b))
+		}
+	}
+
+	b, err := json.Marshal(asts)
+	if err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not encode ASTs into JSON: %s\n", err)
+		return ExitStatusFailure
+	}
+	cmd.Stdout.Write(b)
+	fmt.Fprintln(cmd.Stdout)
+
+	for _, a := range asts {
+		if len(a.Errors) > 0 {
+			return ExitStatusSuccessProblemFound
+		}
+	}
+	return ExitStatusSuccessNoProblem
+}
+
+// watchPollInterval is the interval to check modifications of the watched files by -watch flag.
+const watchPollInterval = 500 * time.Millisecond
+
+// runWatch lints workflows and then lints them again each time they or the files referenced by them
+// are modified until the ctx is canceled. Only the errors in the modified workflows are reported on
+// each change.
+func (cmd *Command) runWatch(ctx context.Context, args []string, opts *LinterOptions, interval time.Duration) int {
+	w := newWorkflowWatcher(args, opts.ConfigFile)
+	// Record the state before linting so that modifications while linting are not missed
+	if _, _, err := w.poll(); err != nil {
+		fmt.Fprintln(cmd.Stderr, err.Error())
+		return ExitStatusFailure
+	}
+
+	l, err := NewLinter(cmd.Stdout, opts)
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err.Error())
+		return ExitStatusFailure
+	}
+	lintAll := func() ([]*Error, error) {
+		if len(args) == 0 {
+			return l.LintRepositoryContext(ctx, ".")
+		}
+		return l.LintFilesContext(ctx, args, nil)
+	}
+
+	errs, err := lintAll()
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err.Error())
+		return ExitStatusFailure
+	}
+
+	now := func() string { return time.Now().Format("15:04:05") }
+	found := func(errs []*Error) {
+		fmt.Fprintf(cmd.Stderr, "[%s] Found %d %s. Watching for changes...\n", now(), len(errs), pluralErrors(len(errs)))
+	}
+	found(errs)
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ExitStatusSuccessNoProblem
+		case <-t.C:
+		}
+
+		files, config, err := w.poll()
+		if err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			continue
+		}
+
+		if config {
+			fmt.Fprintf(cmd.Stderr, "[%s] Config file was changed. Linting all workflows\n", now())
+			// Config files are cached by the linter so it needs to be created again
+			nl, err := NewLinter(cmd.Stdout, opts)
+			if err != nil {
+				fmt.Fprintln(cmd.Stderr, err.Error())
+				continue
+			}
+			l = nl
+			errs, err = lintAll()
+		} else if len(files) > 0 {
+			ps := make([]string, 0, len(files))
+			for _, f := range files {
+				if l.cwd != "" {
+					if r, err := filepath.Rel(l.cwd, f); err == nil {
+						f = r
+					}
+				}
+				ps = append(ps, f)
+			}
+			fmt.Fprintf(cmd.Stderr, "[%s] Linting changed files: %s\n", now(), strings.Join(ps, ", "))
+			errs, err = l.LintFilesContext(ctx, files, nil)
+		} else {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return ExitStatusSuccessNoProblem
+		}
+		if err != nil {
+			fmt.Fprintln(cmd.Stderr, err.Error())
+			continue
+		}
+		found(errs)
+	}
+}
+
 type ignorePatternFlags []string
 
 func (i *ignorePatternFlags) String() string {
@@ -116,24 +427,77 @@ func (i *ignorePatternFlags) Set(v string) error {
 	return nil
 }
 
+type ruleNameFlags []string
+
+func (r *ruleNameFlags) String() string {
+	return strings.Join(*r, ",")
+}
+func (r *ruleNameFlags) Set(v string) error {
+	for _, n := range strings.Split(v, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			*r = append(*r, n)
+		}
+	}
+	return nil
+}
+
 // Main is main function of actionlint. It takes command line arguments as string slice and returns
 // exit status. The args should be entire arguments including the program name, usually given via
 // os.Args.
 func (cmd *Command) Main(args []string) int {
+	if len(args) > 1 && args[1] == "eval" {
+		return cmd.runEval(args)
+	}
+	if len(args) > 1 && args[1] == "new" {
+		return cmd.runNew(args)
+	}
+	if len(args) > 1 && args[1] == "import" {
+		return cmd.runImport(args)
+	}
+	if len(args) > 1 && args[1] == "rename" {
+		return cmd.runRename(args)
+	}
+	if len(args) > 1 && args[1] == "explain-diff" {
+		return cmd.runExplainDiff(args)
+	}
+	if len(args) > 1 && args[1] == "lsp" {
+		return cmd.runLSP(args)
+	}
+
 	var ver bool
 	var opts LinterOptions
 	var ignorePats ignorePatternFlags
+	var onlyRules ruleNameFlags
 	var initConfig bool
 	var noColor bool
 	var color bool
+	var src sourceOptions
+	var updateBaseline bool
+	var watch bool
+	var cache bool
+	var astJSON bool
 
 	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
 	flags.SetOutput(cmd.Stderr)
-	flags.Var(&ignorePats, "ignore", "Regular expression matching to error messages you want to ignore. This flag is repeatable")
-	flags.StringVar(&opts.Shellcheck, "shellcheck", "shellcheck", "Command name or file path of \"shellcheck\" external command. If empty, shellcheck integration will be disabled")
-	flags.StringVar(&opts.Pyflakes, "pyflakes", "pyflakes", "Command name or file path of \"pyflakes\" external command. If empty, pyflakes integration will be disabled")
+	flags.Var(&ignorePats, "ignore", "Regular expression matching to error messages you want to ignore. This flag is repeatable. Newline-separated patterns in $ACTIONLINT_IGNORE are also used")
+	flags.Var(&onlyRules, "only", "Comma-separated rule names such as \"expression,shellcheck\" to apply only the rules. Syntax errors are always reported. This flag is repeatable")
+	flags.StringVar(&opts.Shellcheck, "shellcheck", "shellcheck", "Command name or file path of \"shellcheck\" external command. If empty, shellcheck integration will be disabled. $ACTIONLINT_SHELLCHECK is used when this flag is not given")
+	flags.StringVar(&opts.Pyflakes, "pyflakes", "pyflakes", "Command name or file path of \"pyflakes\" external command. If empty, pyflakes integration will be disabled. $ACTIONLINT_PYFLAKES is used when this flag is not given")
+	flags.StringVar(&opts.PSScriptAnalyzer, "psscriptanalyzer", "", "Command name or file path of PowerShell 7+ (e.g. \"pwsh\") where PSScriptAnalyzer module is installed. If empty (default), PSScriptAnalyzer integration is disabled. $ACTIONLINT_PSSCRIPTANALYZER is used when this flag is not given")
+	flags.BoolVar(&opts.Plugins, "plugins", false, "Run plugins configured in \"plugins:\" section of config file. Plugins are not run by default since they are arbitrary executables configured by the repository")
 	flags.BoolVar(&opts.Oneline, "oneline", false, "Use one line per one error. Useful for reading error messages from programs")
-	flags.StringVar(&opts.Format, "format", "", "Custom template to format error messages in Go template syntax. See https://github.com/rhysd/actionlint/tree/main/docs/usage.md#format")
+	flags.IntVar(&opts.ContextLines, "context-lines", 0, "Number of source lines shown before and after the line of each error in the code snippet")
+	flags.StringVar(&opts.GroupBy, "group-by", "", "Group errors by \"rule\" or \"file\". Each group is output with a header line")
+	flags.BoolVar(&opts.Dedup, "dedup", false, "Report only the first error among errors with the same rule and message in each file")
+	flags.IntVar(&opts.MaxPerRule, "max-per-rule", 0, "Maximum number of errors reported per rule. 0 means no limit")
+	flags.IntVar(&opts.Jobs, "jobs", 0, "Maximum number of external processes such as shellcheck, pyflakes, and PSScriptAnalyzer run in parallel. 0 means the number of CPUs")
+	flags.BoolVar(&opts.ReportFeedback, "report-feedback", false, "Output machine-readable fingerprint of each error which consists of rule name, message hash, and anonymized snippet hash. It is useful to aggregate suppressed errors")
+	flags.StringVar(&opts.Baseline, "baseline", "", "File path to baseline file. Errors recorded in the baseline are not reported so that only new errors fail. The file is generated by -update-baseline")
+	flags.BoolVar(&updateBaseline, "update-baseline", false, "Record all errors found in the baseline file given by -baseline instead of reporting them. The file is created or overwritten")
+	flags.BoolVar(&watch, "watch", false, "Watch workflow files, local actions and reusable workflows referenced by them, and config files. Workflows are linted again each time they or the referenced files are modified until interrupted")
+	flags.StringVar(&opts.Select, "select", "", "Lint only the subtree of the workflow selected by \"jobs.<job_id>\" or \"jobs.<job_id>.steps[<index>]\" and output expressions in it with their resolved types. Exactly one file argument must be given")
+	flags.BoolVar(&astJSON, "ast-json", false, "Output ASTs of the workflow files with positions as JSON array instead of linting them. Only syntax errors are reported. See https://github.com/rhysd/actionlint/tree/main/docs/usage.md#ast-json")
+	flags.StringVar(&opts.Format, "format", "", "Custom template to format error messages in Go template syntax, or name of built-in output format such as \"sarif\", \"github\", \"junit\", \"checkstyle\", \"rdjson\", \"tap\", or \"html\". See https://github.com/rhysd/actionlint/tree/main/docs/usage.md#format")
 	flags.StringVar(&opts.ConfigFile, "config-file", "", "File path to config file")
 	flags.BoolVar(&initConfig, "init-config", false, "Generate default config file at .github/actionlint.yaml in current project")
 	flags.BoolVar(&noColor, "no-color", false, "Disable colorful output")
@@ -142,6 +506,19 @@ func (cmd *Command) Main(args []string) int {
 	flags.BoolVar(&opts.Debug, "debug", false, "Enable debug output (for development)")
 	flags.BoolVar(&ver, "version", false, "Show version and how this binary was installed")
 	flags.StringVar(&opts.StdinFileName, "stdin-filename", "", "File name when reading input from stdin")
+	flags.BoolVar(&opts.Fix, "fix", false, "Fix errors automatically by overwriting workflow files when the errors are fixable")
+	flags.BoolVar(&opts.Online, "online", false, "Enable checks which send requests to GitHub REST API")
+	flags.StringVar(&opts.GitHubToken, "github-token", "", "Access token for GitHub REST API used by -online checks. $GITHUB_TOKEN is used when this flag is not given")
+	flags.IntVar(&opts.GitHubAPIBudget, "github-api-budget", 0, "Maximum number of requests sent to GitHub API by -online checks in one run. Checks exceeding the budget are skipped. 0 means no limit")
+	flags.StringVar(&opts.GitHubAPIURL, "github-api-url", "", "Base URL of GitHub REST API used by -online checks. This is useful for GitHub Enterprise Server (default \"https://api.github.com\")")
+	flags.StringVar(&opts.OnlineCacheDir, "online-cache-dir", "", "Directory to cache metadata of actions and reusable workflows fetched by -online checks (default \"actionlint/actions\" in user cache directory)")
+	flags.BoolVar(&cache, "cache", false, "Cache errors of each workflow file on disk and skip checking the files which were not changed since the previous run")
+	flags.StringVar(&opts.CacheDir, "cache-dir", "", "Directory to cache errors of workflow files by -cache (default \"actionlint/results\" in user cache directory)")
+	flags.StringVar(&src.archive, "archive", "", "Lint workflow files in the archive of repository (.zip, .tar, .tar.gz, or .tgz) without extracting it")
+	flags.StringVar(&src.gitDir, "git-dir", "", "Lint workflow files in the Git directory such as .git or a bare repository without checking out. Revision is specified by -rev")
+	flags.StringVar(&src.rev, "rev", "HEAD", "Revision of the Git directory given by -git-dir to lint")
+	flags.BoolVar(&src.preReceive, "pre-receive", false, "Run as Git pre-receive hook. Lint workflow files changed by the ref updates read from stdin and output the summary")
+	flags.IntVar(&src.top, "top", 10, "Maximum number of errors listed in the summary of -pre-receive. 0 means no limit")
 	flags.Usage = func() {
 		fmt.Fprintln(cmd.Stderr, commandUsageHeader)
 		flags.PrintDefaults()
@@ -164,11 +541,80 @@ func (cmd *Command) Main(args []string) int {
 			runtime.GOOS,
 			runtime.GOARCH,
 		)
+		if customDataFS != nil {
+			fmt.Fprintln(cmd.Stdout, "with custom data tables")
+		}
 		return ExitStatusSuccessNoProblem
 	}
 
+	if src.archive != "" || src.gitDir != "" || src.preReceive {
+		if src.archive != "" && (src.gitDir != "" || src.preReceive) {
+			fmt.Fprintln(cmd.Stderr, "-archive flag cannot be used with -git-dir or -pre-receive flag")
+			return ExitStatusInvalidCommandOption
+		}
+		if flags.NArg() > 0 {
+			fmt.Fprintln(cmd.Stderr, "file arguments cannot be given with -archive, -git-dir, or -pre-receive flag")
+			return ExitStatusInvalidCommandOption
+		}
+	}
+
+	if opts.Select != "" && (src.archive != "" || src.gitDir != "" || src.preReceive || flags.NArg() != 1) {
+		fmt.Fprintln(cmd.Stderr, "-select flag requires exactly one file argument and cannot be used with -archive, -git-dir, or -pre-receive flag")
+		return ExitStatusInvalidCommandOption
+	}
+
+	if watch && (src.archive != "" || src.gitDir != "" || src.preReceive || updateBaseline || initConfig || flags.NArg() == 1 && flags.Arg(0) == "-") {
+		fmt.Fprintln(cmd.Stderr, "-watch flag cannot be used with -archive, -git-dir, -pre-receive, -update-baseline, -init-config flag, or stdin input")
+		return ExitStatusInvalidCommandOption
+	}
+
+	if astJSON && (src.archive != "" || src.gitDir != "" || src.preReceive || opts.Select != "" || watch || updateBaseline || initConfig) {
+		fmt.Fprintln(cmd.Stderr, "-ast-json flag cannot be used with -archive, -git-dir, -pre-receive, -select, -watch, -update-baseline, or -init-config flag")
+		return ExitStatusInvalidCommandOption
+	}
+
+	if updateBaseline && opts.Baseline == "" {
+		fmt.Fprintln(cmd.Stderr, "-update-baseline flag requires file path of baseline file given by -baseline flag")
+		return ExitStatusInvalidCommandOption
+	}
+
+	// Environment variables override the default values of flags. They are useful when modifying the
+	// command line is not possible, e.g. actionlint in CI images.
+	set := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if v, ok := os.LookupEnv("ACTIONLINT_SHELLCHECK"); ok && !set["shellcheck"] {
+		opts.Shellcheck = v
+	}
+	if v, ok := os.LookupEnv("ACTIONLINT_PYFLAKES"); ok && !set["pyflakes"] {
+		opts.Pyflakes = v
+	}
+	if v, ok := os.LookupEnv("ACTIONLINT_PSSCRIPTANALYZER"); ok && !set["psscriptanalyzer"] {
+		opts.PSScriptAnalyzer = v
+	}
+	for _, p := range strings.Split(os.Getenv("ACTIONLINT_IGNORE"), "\n") {
+		if p != "" {
+			ignorePats = append(ignorePats, p)
+		}
+	}
+
+	if opts.Format != "" && !strings.Contains(opts.Format, "{{") {
+		if _, ok := LookupErrorRenderer(opts.Format); ok {
+			opts.Renderer, opts.Format = opts.Format, ""
+		}
+	}
+
 	opts.IgnorePatterns = ignorePats
+	opts.OnlyRules = onlyRules
+	opts.UserConfigFile = UserConfigFilePath()
 	opts.LogWriter = cmd.Stderr
+	if !cache {
+		opts.CacheDir = ""
+	} else if opts.CacheDir == "" {
+		opts.CacheDir = DefaultResultCacheDir()
+	}
+	if opts.Online && opts.GitHubToken == "" {
+		opts.GitHubToken = os.Getenv("GITHUB_TOKEN")
+	}
 
 	if color {
 		opts.Color = ColorOptionKindAlways
@@ -177,14 +623,638 @@ func (cmd *Command) Main(args []string) int {
 		opts.Color = ColorOptionKindNever
 	}
 
-	errs, err := cmd.runLinter(flags.Args(), &opts, initConfig)
+	if astJSON {
+		return cmd.runASTJSON(flags.Args(), opts.StdinFileName)
+	}
+
+	if updateBaseline {
+		return cmd.runUpdateBaseline(flags.Args(), &opts, &src)
+	}
+
+	if watch {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		return cmd.runWatch(ctx, flags.Args(), &opts, watchPollInterval)
+	}
+
+	errs, err := cmd.runLinter(flags.Args(), &opts, initConfig, &src)
 	if err != nil {
 		fmt.Fprintln(cmd.Stderr, err.Error())
 		return ExitStatusFailure
 	}
-	if len(errs) > 0 {
-		return ExitStatusSuccessProblemFound // Linter found some issues, yay!
+	for _, err := range errs {
+		if !err.IsWarning() && !err.IsNote() {
+			return ExitStatusSuccessProblemFound // Linter found some issues, yay!
+		}
 	}
 
 	return ExitStatusSuccessNoProblem
 }
+
+const evalUsageHeader = `Usage: actionlint eval [FLAGS] EXPR
+
+  eval subcommand evaluates the expression with the example payload of the
+  webhook event. It is useful to debug expressions locally. "${{ }}" around
+  the expression can be omitted:
+
+    $ actionlint eval "startsWith(github.ref, 'refs/tags/')" -event release
+
+  To use the payload of your own event, give the JSON file to -payload flag:
+
+    $ actionlint eval 'github.event.inputs.name' -event workflow_dispatch -payload event.json
+
+Flags:`
+
+func (cmd *Command) runEval(args []string) int {
+	var event string
+	var payload string
+
+	flags := flag.NewFlagSet(args[0]+" eval", flag.ContinueOnError)
+	flags.SetOutput(cmd.Stderr)
+	flags.StringVar(&event, "event", "push", "Name of the webhook event which triggers the workflow run")
+	flags.StringVar(&payload, "payload", "", "File path to the JSON payload of the event. The example payload of the event is used when this flag is not given")
+	flags.Usage = func() {
+		fmt.Fprintln(cmd.Stderr, evalUsageHeader)
+		flags.PrintDefaults()
+	}
+
+	// Flags can be put after the expression like `actionlint eval 'github.ref' -event push`
+	exprs := []string{}
+	rest := args[2:]
+	for {
+		if err := flags.Parse(rest); err != nil {
+			if err == flag.ErrHelp {
+				return ExitStatusSuccessNoProblem
+			}
+			return ExitStatusInvalidCommandOption
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		exprs = append(exprs, flags.Arg(0))
+		rest = flags.Args()[1:]
+	}
+	if len(exprs) != 1 {
+		fmt.Fprintf(cmd.Stderr, "eval subcommand takes exactly one expression but got %d arguments\n", len(exprs))
+		return ExitStatusInvalidCommandOption
+	}
+
+	var p map[string]interface{}
+	if payload != "" {
+		b, err := os.ReadFile(payload)
+		if err != nil {
+			fmt.Fprintf(cmd.Stderr, "could not read payload file: %s\n", err)
+			return ExitStatusFailure
+		}
+		if err := json.Unmarshal(b, &p); err != nil {
+			fmt.Fprintf(cmd.Stderr, "could not parse payload file %q as JSON object: %s\n", payload, err)
+			return ExitStatusFailure
+		}
+	} else {
+		var ok bool
+		p, ok = ExampleEventPayload(event)
+		if !ok {
+			es := make([]string, 0, len(EventPayloadExamples))
+			for e := range EventPayloadExamples {
+				es = append(es, e)
+			}
+			fmt.Fprintf(cmd.Stderr, "example payload of %q event is not available. available events are %s. use -payload flag to give the payload\n", event, sortedQuotes(es))
+			return ExitStatusInvalidCommandOption
+		}
+	}
+
+	src := strings.TrimSpace(exprs[0])
+	if strings.HasPrefix(src, "${{") && strings.HasSuffix(src, "}}") {
+		src = strings.TrimSpace(src[3 : len(src)-2])
+	}
+	expr, err := NewExprParser().Parse(NewExprLexer(src + "}}"))
+	if err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not parse expression at col:%d: %s\n", err.Column, err.Message)
+		return ExitStatusSuccessProblemFound
+	}
+
+	v, err := NewExprEvaluator(NewEventContexts(event, p)).Eval(expr)
+	if err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not evaluate expression at col:%d: %s\n", err.Column, err.Message)
+		return ExitStatusSuccessProblemFound
+	}
+
+	if s, ok := v.(string); ok {
+		fmt.Fprintln(cmd.Stdout, s)
+	} else {
+		b, _ := json.MarshalIndent(v, "", "  ") // Values decoded from JSON can always be encoded
+		fmt.Fprintln(cmd.Stdout, string(b))
+	}
+	return ExitStatusSuccessNoProblem
+}
+
+const newUsageHeader = `Usage: actionlint new [FLAGS]
+
+  new subcommand generates a workflow skeleton from the template. The generated
+  workflow passes all checks by actionlint so it is a correct starting point.
+  The workflow is output to stdout unless -output flag is given:
+
+    $ actionlint new -template go-ci -os macos-latest -go-version 1.22
+
+  To customize the workflow by answering questions, use -interactive flag:
+
+    $ actionlint new -template release -interactive -output .github/workflows/release.yaml
+
+Templates:
+`
+
+type permissionFlags map[string]string
+
+func (ps permissionFlags) String() string {
+	ss := make([]string, 0, len(ps))
+	for k, v := range ps {
+		ss = append(ss, k+":"+v)
+	}
+	return strings.Join(ss, ",")
+}
+
+func (ps permissionFlags) Set(v string) error {
+	for _, p := range strings.Split(v, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		i := strings.IndexByte(p, ':')
+		if i <= 0 {
+			return fmt.Errorf("permission %q must be in the form of \"scope:level\" such as \"pull-requests:write\"", p)
+		}
+		ps[strings.TrimSpace(p[:i])] = strings.TrimSpace(p[i+1:])
+	}
+	return nil
+}
+
+func (cmd *Command) runNew(args []string) int {
+	var name string
+	var output string
+	var interactive bool
+	var opts WorkflowSkeletonOptions
+	perms := permissionFlags{}
+
+	flags := flag.NewFlagSet(args[0]+" new", flag.ContinueOnError)
+	flags.SetOutput(cmd.Stderr)
+	flags.StringVar(&name, "template", "", "Name of the template of the workflow skeleton. This flag is required")
+	flags.StringVar(&opts.OS, "os", "", "Runner label of the jobs in the workflow (default \"ubuntu-latest\")")
+	flags.StringVar(&opts.GoVersion, "go-version", "", "Version of Go set up in the workflow (default \"stable\")")
+	flags.Var(perms, "permissions", "Comma-separated permissions added to the workflow in the form of \"scope:level\" such as \"pull-requests:write\". This flag is repeatable")
+	flags.BoolVar(&interactive, "interactive", false, "Ask the values which are not given by flags from stdin")
+	flags.StringVar(&output, "output", "", "File path to write the generated workflow. The workflow is output to stdout when this flag is not given")
+	flags.Usage = func() {
+		fmt.Fprint(cmd.Stderr, newUsageHeader)
+		for _, n := range WorkflowSkeletonNames() {
+			fmt.Fprintf(cmd.Stderr, "  %-8s %s\n", n, workflowSkeletons[n].desc)
+		}
+		fmt.Fprintln(cmd.Stderr, "\nFlags:")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			return ExitStatusSuccessNoProblem
+		}
+		return ExitStatusInvalidCommandOption
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(cmd.Stderr, "new subcommand takes no argument but got %q\n", flags.Args())
+		return ExitStatusInvalidCommandOption
+	}
+
+	if interactive {
+		set := map[string]bool{}
+		flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
+		r := bufio.NewReader(cmd.Stdin)
+		// Returns an empty string when the value is given by the flag or the default value is chosen
+		ask := func(f, question, def string) string {
+			if set[f] {
+				return ""
+			}
+			fmt.Fprintf(cmd.Stderr, "%s [%s]: ", question, def)
+			l, _ := r.ReadString('\n')
+			return strings.TrimSpace(l)
+		}
+		if !set["template"] {
+			name = "go-ci"
+			if a := ask("template", "Template ("+strings.Join(WorkflowSkeletonNames(), ", ")+")", name); a != "" {
+				name = a
+			}
+		}
+		if a := ask("os", "Runner OS", "ubuntu-latest"); a != "" {
+			opts.OS = a
+		}
+		if name != "docker" {
+			if a := ask("go-version", "Go version", "stable"); a != "" {
+				opts.GoVersion = a
+			}
+		}
+		if a := ask("permissions", "Additional permissions such as \"pull-requests:write\"", "none"); a != "" && a != "none" {
+			if err := perms.Set(a); err != nil {
+				fmt.Fprintln(cmd.Stderr, err)
+				return ExitStatusInvalidCommandOption
+			}
+		}
+	}
+
+	if name == "" {
+		fmt.Fprintf(cmd.Stderr, "-template flag is required. available templates are %s\n", quotes(WorkflowSkeletonNames()))
+		return ExitStatusInvalidCommandOption
+	}
+	opts.Permissions = perms
+
+	b, err := GenerateWorkflowSkeleton(name, &opts)
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err)
+		return ExitStatusInvalidCommandOption
+	}
+
+	if output == "" {
+		cmd.Stdout.Write(b)
+		return ExitStatusSuccessNoProblem
+	}
+	if _, err := os.Stat(output); err == nil {
+		fmt.Fprintf(cmd.Stderr, "workflow file %q already exists. remove it or give another file path to -output flag\n", output)
+		return ExitStatusFailure
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not create directory for workflow file %q: %s\n", output, err)
+		return ExitStatusFailure
+	}
+	if err := os.WriteFile(output, b, 0644); err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not write workflow file %q: %s\n", output, err)
+		return ExitStatusFailure
+	}
+	fmt.Fprintf(cmd.Stdout, "Generated workflow file at %s\n", output)
+	return ExitStatusSuccessNoProblem
+}
+
+const importUsageHeader = `Usage: actionlint import [FLAGS] FILE
+
+  import subcommand converts suppression settings in the config file of another
+  linter into "paths:" section of actionlint.yaml and outputs it to stdout.
+  The linter is detected from the file name unless -from flag is given:
+
+    $ actionlint import .yamllint >> .github/actionlint.yaml
+    $ actionlint import -from super-linter .github/linters.env
+
+  Settings which cannot be converted are reported to stderr as warnings.
+
+Linters:
+`
+
+func (cmd *Command) runImport(args []string) int {
+	var from string
+
+	flags := flag.NewFlagSet(args[0]+" import", flag.ContinueOnError)
+	flags.SetOutput(cmd.Stderr)
+	flags.StringVar(&from, "from", "", "Name of the linter whose config file is imported. It is detected from the file name when this flag is not given")
+	flags.Usage = func() {
+		fmt.Fprint(cmd.Stderr, importUsageHeader)
+		for _, k := range IgnoreImportKinds() {
+			fmt.Fprintf(cmd.Stderr, "  %s\n", k)
+		}
+		fmt.Fprintln(cmd.Stderr, "\nFlags:")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			return ExitStatusSuccessNoProblem
+		}
+		return ExitStatusInvalidCommandOption
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintf(cmd.Stderr, "import subcommand takes exactly one file but got %d arguments\n", flags.NArg())
+		return ExitStatusInvalidCommandOption
+	}
+
+	file := flags.Arg(0)
+	if from == "" {
+		from = DetectIgnoreImportKind(file)
+		if from == "" {
+			fmt.Fprintf(cmd.Stderr, "linter of %q cannot be detected from the file name. give one of %s to -from flag\n", file, sortedQuotes(IgnoreImportKinds()))
+			return ExitStatusInvalidCommandOption
+		}
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not read file to import: %s\n", err)
+		return ExitStatusFailure
+	}
+
+	imp, err := ImportIgnoreConfig(from, b)
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err)
+		return ExitStatusFailure
+	}
+	for _, w := range imp.Warnings {
+		fmt.Fprintf(cmd.Stderr, "warning: %s\n", w)
+	}
+
+	y, err := imp.YAML()
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err)
+		return ExitStatusFailure
+	}
+	if len(y) == 0 {
+		fmt.Fprintf(cmd.Stderr, "no suppression setting was found in %q\n", file)
+		return ExitStatusSuccessProblemFound
+	}
+	cmd.Stdout.Write(y)
+	return ExitStatusSuccessNoProblem
+}
+
+const renameUsageHeader = `Usage: actionlint rename [FLAGS] FILE
+
+  rename subcommand renames a job ID, a step ID, or an input and updates all
+  references to it across the repository such as "needs:", "steps.<id>", and
+  "with:" in the callers of reusable workflows. Only the names are replaced so
+  that formatting of the files is preserved. FILE is the workflow file or the
+  action metadata file where the symbol is defined:
+
+    $ actionlint rename -job build -to build-linux .github/workflows/ci.yaml
+    $ actionlint rename -job build -step setup -to setup-go .github/workflows/ci.yaml
+    $ actionlint rename -input version -to go-version .github/workflows/reusable.yaml
+    $ actionlint rename -input token -to github-token ./my-action/action.yml
+
+  Files are not modified and the occurrences to be renamed are output when
+  -dry-run flag is given.
+
+Flags:`
+
+func (cmd *Command) runRename(args []string) int {
+	var job, step, input, to string
+	var dryRun bool
+
+	flags := flag.NewFlagSet(args[0]+" rename", flag.ContinueOnError)
+	flags.SetOutput(cmd.Stderr)
+	flags.StringVar(&job, "job", "", "ID of the job to rename. When -step is given, the job where the step is defined")
+	flags.StringVar(&step, "step", "", "ID of the step to rename. -job is also necessary")
+	flags.StringVar(&input, "input", "", "Name of the input of reusable workflow or action to rename")
+	flags.StringVar(&to, "to", "", "New name of the symbol")
+	flags.BoolVar(&dryRun, "dry-run", false, "Output the occurrences to be renamed without modifying files")
+	flags.Usage = func() {
+		fmt.Fprintln(cmd.Stderr, renameUsageHeader)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			return ExitStatusSuccessNoProblem
+		}
+		return ExitStatusInvalidCommandOption
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintf(cmd.Stderr, "rename subcommand takes exactly one file but got %d arguments\n", flags.NArg())
+		return ExitStatusInvalidCommandOption
+	}
+	if to == "" {
+		fmt.Fprintln(cmd.Stderr, "new name must be given with -to flag")
+		return ExitStatusInvalidCommandOption
+	}
+	if (job == "") == (input == "") || step != "" && job == "" {
+		fmt.Fprintln(cmd.Stderr, "either -job, -job and -step, or -input must be given to specify the symbol to rename")
+		return ExitStatusInvalidCommandOption
+	}
+
+	path, err := filepath.Abs(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not resolve path %q: %s\n", flags.Arg(0), err)
+		return ExitStatusFailure
+	}
+	src, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(cmd.Stderr, "could not read file to rename symbol: %s\n", err)
+		return ExitStatusFailure
+	}
+	project, err := NewProjects().At(path)
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err)
+		return ExitStatusFailure
+	}
+	root := ""
+	if project != nil {
+		root = project.RootDir()
+	}
+
+	var sym indexedSymbol
+	switch {
+	case step != "":
+		sym = indexedSymbol{symbolKindStep, path, strings.ToLower(job), strings.ToLower(step)}
+	case job != "":
+		sym = indexedSymbol{symbolKindJob, path, "", strings.ToLower(job)}
+	case isActionMetadataFile(path):
+		sym = indexedSymbol{symbolKindActionInput, filepath.Dir(path), "", strings.ToLower(input)}
+	default:
+		sym = indexedSymbol{symbolKindWorkflowInput, path, "", strings.ToLower(input)}
+	}
+
+	idx := newSymbolIndex(root)
+	idx.refresh(map[string][]byte{path: src})
+	occs, err := idx.rename(sym, to)
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err)
+		return ExitStatusFailure
+	}
+
+	cwd, _ := os.Getwd()
+	rel := func(p string) string {
+		if cwd != "" {
+			if r, err := filepath.Rel(cwd, p); err == nil {
+				return r
+			}
+		}
+		return p
+	}
+
+	files := []string{}
+	byFile := map[string][]*symbolOccurrence{}
+	for _, o := range occs {
+		if _, ok := byFile[o.path]; !ok {
+			files = append(files, o.path)
+		}
+		byFile[o.path] = append(byFile[o.path], o)
+	}
+
+	// Replace names in all files before writing any of them not to leave the repository broken
+	renamed := make([][]byte, 0, len(files))
+	for _, f := range files {
+		b, err := replaceOccurrences(idx.files[f].text, byFile[f], to)
+		if err != nil {
+			fmt.Fprintf(cmd.Stderr, "could not rename %s %q: %s\n", sym.kind, byFile[f][0].text, err)
+			return ExitStatusFailure
+		}
+		renamed = append(renamed, b)
+	}
+
+	for i, f := range files {
+		if dryRun {
+			for _, o := range byFile[f] {
+				fmt.Fprintf(cmd.Stdout, "%s:%d:%d: %s -> %s\n", rel(f), o.line, o.col, o.text, to)
+			}
+			continue
+		}
+		perm := os.FileMode(0644)
+		if s, err := os.Stat(f); err == nil {
+			perm = s.Mode().Perm()
+		}
+		if err := os.WriteFile(f, renamed[i], perm); err != nil {
+			fmt.Fprintf(cmd.Stderr, "could not write renamed file %q: %s\n", f, err)
+			return ExitStatusFailure
+		}
+		fmt.Fprintf(cmd.Stdout, "Renamed %d occurrence(s) in %s\n", len(byFile[f]), rel(f))
+	}
+	return ExitStatusSuccessNoProblem
+}
+
+const explainDiffUsageHeader = `Usage: actionlint explain-diff [FLAGS] OLD NEW
+
+  explain-diff subcommand compares two versions of a workflow file and explains
+  the changes which matter on reviewing CI changes: triggers added or removed,
+  permissions broadened, actions newly used, jobs added or removed, and growth
+  of jobs generated by matrix. Changes which may affect the security of the
+  workflow are marked as risky:
+
+    $ git show main:.github/workflows/ci.yaml > old.yaml
+    $ actionlint explain-diff old.yaml .github/workflows/ci.yaml
+
+  The exit status is 1 when some risky change is found. To output the changes
+  as JSON for bots reviewing pull requests, use -json flag.
+
+Flags:`
+
+func (cmd *Command) runExplainDiff(args []string) int {
+	var asJSON bool
+
+	flags := flag.NewFlagSet(args[0]+" explain-diff", flag.ContinueOnError)
+	flags.SetOutput(cmd.Stderr)
+	flags.BoolVar(&asJSON, "json", false, "Output the changes as JSON array")
+	flags.Usage = func() {
+		fmt.Fprintln(cmd.Stderr, explainDiffUsageHeader)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			return ExitStatusSuccessNoProblem
+		}
+		return ExitStatusInvalidCommandOption
+	}
+	if flags.NArg() != 2 {
+		fmt.Fprintf(cmd.Stderr, "explain-diff subcommand takes exactly two files but got %d arguments\n", flags.NArg())
+		return ExitStatusInvalidCommandOption
+	}
+
+	parse := func(path string) *Workflow {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Fprintf(cmd.Stderr, "could not read workflow file: %s\n", err)
+			return nil
+		}
+		w, errs := Parse(src)
+		if len(errs) > 0 {
+			e := errs[0]
+			fmt.Fprintf(cmd.Stderr, "could not parse workflow file %q: line:%d,col:%d: %s\n", path, e.Line, e.Column, e.Message)
+			return nil
+		}
+		return w
+	}
+	before, after := flags.Arg(0), flags.Arg(1)
+	bw := parse(before)
+	if bw == nil {
+		return ExitStatusFailure
+	}
+	aw := parse(after)
+	if aw == nil {
+		return ExitStatusFailure
+	}
+
+	changes := DiffWorkflows(before, bw, after, aw)
+	risky := false
+	for _, c := range changes {
+		if c.Risky {
+			risky = true
+		}
+	}
+
+	if asJSON {
+		b, err := json.Marshal(changes)
+		if err != nil {
+			fmt.Fprintf(cmd.Stderr, "could not encode changes into JSON: %s\n", err)
+			return ExitStatusFailure
+		}
+		cmd.Stdout.Write(b)
+		fmt.Fprintln(cmd.Stdout)
+	} else {
+		for _, c := range changes {
+			fmt.Fprintln(cmd.Stdout, c)
+		}
+	}
+
+	if risky {
+		return ExitStatusSuccessProblemFound
+	}
+	return ExitStatusSuccessNoProblem
+}
+
+const lspUsageHeader = `Usage: actionlint lsp [FLAGS]
+
+  lsp subcommand runs actionlint as a language server. It communicates with an
+  editor via Language Server Protocol on stdin and stdout. Errors are reported
+  as diagnostics when workflow files are opened, changed, or saved. Hovers on
+  expressions and go-to-definition of "needs:" and local "uses:" are supported.
+
+Flags:`
+
+func (cmd *Command) runLSP(args []string) int {
+	var opts LinterOptions
+
+	flags := flag.NewFlagSet(args[0]+" lsp", flag.ContinueOnError)
+	flags.SetOutput(cmd.Stderr)
+	flags.StringVar(&opts.Shellcheck, "shellcheck", "shellcheck", "Command name or file path of \"shellcheck\" external command. If empty, shellcheck integration will be disabled. $ACTIONLINT_SHELLCHECK is used when this flag is not given")
+	flags.StringVar(&opts.Pyflakes, "pyflakes", "pyflakes", "Command name or file path of \"pyflakes\" external command. If empty, pyflakes integration will be disabled. $ACTIONLINT_PYFLAKES is used when this flag is not given")
+	flags.StringVar(&opts.PSScriptAnalyzer, "psscriptanalyzer", "", "Command name or file path of PowerShell 7+ (e.g. \"pwsh\") where PSScriptAnalyzer module is installed. If empty (default), PSScriptAnalyzer integration is disabled. $ACTIONLINT_PSSCRIPTANALYZER is used when this flag is not given")
+	flags.BoolVar(&opts.Plugins, "plugins", false, "Run plugins configured in \"plugins:\" section of config file. Plugins are not run by default since they are arbitrary executables configured by the repository")
+	flags.StringVar(&opts.ConfigFile, "config-file", "", "File path to config file")
+	flags.BoolVar(&opts.Verbose, "verbose", false, "Enable verbose output to stderr")
+	flags.BoolVar(&opts.Debug, "debug", false, "Enable debug output to stderr (for development)")
+	flags.Usage = func() {
+		fmt.Fprintln(cmd.Stderr, lspUsageHeader)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			return ExitStatusSuccessNoProblem
+		}
+		return ExitStatusInvalidCommandOption
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(cmd.Stderr, "lsp subcommand takes no argument but got %d arguments\n", flags.NArg())
+		return ExitStatusInvalidCommandOption
+	}
+
+	set := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if v, ok := os.LookupEnv("ACTIONLINT_SHELLCHECK"); ok && !set["shellcheck"] {
+		opts.Shellcheck = v
+	}
+	if v, ok := os.LookupEnv("ACTIONLINT_PYFLAKES"); ok && !set["pyflakes"] {
+		opts.Pyflakes = v
+	}
+	if v, ok := os.LookupEnv("ACTIONLINT_PSSCRIPTANALYZER"); ok && !set["psscriptanalyzer"] {
+		opts.PSScriptAnalyzer = v
+	}
+	opts.UserConfigFile = UserConfigFilePath()
+	opts.LogWriter = cmd.Stderr
+	opts.Color = ColorOptionKindNever
+
+	s, err := NewLanguageServer(cmd.Stdin, cmd.Stdout, &opts)
+	if err != nil {
+		fmt.Fprintln(cmd.Stderr, err)
+		return ExitStatusFailure
+	}
+	if err := s.Serve(context.Background()); err != nil {
+		fmt.Fprintln(cmd.Stderr, err)
+		return ExitStatusFailure
+	}
+	return ExitStatusSuccessNoProblem
+}
@@ -2,6 +2,8 @@ package actionlint
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -42,3 +44,654 @@ func TestCommandMain(t *testing.T) {
 		t.Errorf("runner-label rule should be ignored by -ignore but it is included in output: %q", out)
 	}
 }
+
+func TestCommandExitStatusWithWarnings(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "actionlint.yaml")
+	if err := os.WriteFile(cfg, []byte("rules:\n  expression:\n    severity: warning\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	workflow := filepath.Join(dir, "test.yaml")
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ unknown }}\n"
+	if err := os.WriteFile(workflow, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var output bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &output,
+		Stderr: &output,
+	}
+	status := cmd.Main([]string{"actionlint", "-shellcheck=", "-pyflakes=", "-no-color", "-config-file", cfg, workflow})
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d when only warnings are found but got %d: %q", ExitStatusSuccessNoProblem, status, output.String())
+	}
+	if out := output.String(); !strings.Contains(out, "warning: undefined variable \"unknown\"") {
+		t.Fatalf("warning was not output: %q", out)
+	}
+}
+
+func TestCommandExitStatusWithOutputSeverity(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "actionlint.yaml")
+	if err := os.WriteFile(cfg, []byte("rules:\n  expression:\n    output-severity:\n      sarif: note\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	workflow := filepath.Join(dir, "test.yaml")
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ unknown }}\n"
+	if err := os.WriteFile(workflow, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(args ...string) (int, string) {
+		var output bytes.Buffer
+		cmd := Command{
+			Stdin:  os.Stdin,
+			Stdout: &output,
+			Stderr: &output,
+		}
+		args = append([]string{"actionlint", "-shellcheck=", "-pyflakes=", "-no-color", "-config-file", cfg}, args...)
+		return cmd.Main(append(args, workflow)), output.String()
+	}
+
+	status, out := run()
+	if status != ExitStatusSuccessProblemFound {
+		t.Fatalf("exit status should be %d on the default output but got %d: %q", ExitStatusSuccessProblemFound, status, out)
+	}
+
+	status, out = run("-format", "sarif")
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d when only notes are found but got %d: %q", ExitStatusSuccessNoProblem, status, out)
+	}
+	if !strings.Contains(out, `"level": "note"`) {
+		t.Fatalf("note was not output in SARIF: %q", out)
+	}
+}
+
+func TestCommandBaseline(t *testing.T) {
+	dir := t.TempDir()
+	baseline := filepath.Join(dir, "baseline.json")
+	workflow := filepath.Join(dir, "test.yaml")
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ unknown }}\n"
+	if err := os.WriteFile(workflow, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(args ...string) (int, string) {
+		var output bytes.Buffer
+		cmd := Command{
+			Stdin:  os.Stdin,
+			Stdout: &output,
+			Stderr: &output,
+		}
+		args = append([]string{"actionlint", "-shellcheck=", "-pyflakes=", "-no-color", "-baseline", baseline}, args...)
+		return cmd.Main(append(args, workflow)), output.String()
+	}
+
+	status, out := run("-update-baseline")
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d when updating baseline but got %d: %q", ExitStatusSuccessNoProblem, status, out)
+	}
+	if !strings.Contains(out, "Recorded 1 error in baseline file") {
+		t.Fatalf("unexpected output on updating baseline: %q", out)
+	}
+
+	status, out = run()
+	if status != ExitStatusSuccessNoProblem || out != "" {
+		t.Fatalf("errors in baseline should not be reported but got status %d: %q", status, out)
+	}
+
+	src += "      - run: echo ${{ unknown2 }}\n"
+	if err := os.WriteFile(workflow, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	status, out = run()
+	if status != ExitStatusSuccessProblemFound {
+		t.Fatalf("exit status should be %d when new error is found but got %d: %q", ExitStatusSuccessProblemFound, status, out)
+	}
+	if strings.Contains(out, `"unknown"`) || !strings.Contains(out, `"unknown2"`) {
+		t.Fatalf("only new error should be reported: %q", out)
+	}
+}
+
+func TestCommandSourceFlagsConflict(t *testing.T) {
+	for _, args := range [][]string{
+		{"actionlint", "-archive", "repo.tar.gz", "-git-dir", ".git"},
+		{"actionlint", "-archive", "repo.tar.gz", "test.yaml"},
+		{"actionlint", "-git-dir", ".git", "test.yaml"},
+		{"actionlint", "-select", "jobs.test", "a.yaml", "b.yaml"},
+		{"actionlint", "-select", "jobs.test"},
+		{"actionlint", "-select", "jobs.test", "-archive", "repo.tar.gz"},
+		{"actionlint", "-update-baseline"},
+		{"actionlint", "-watch", "-archive", "repo.tar.gz"},
+		{"actionlint", "-watch", "-"},
+		{"actionlint", "-ast-json", "-archive", "repo.tar.gz"},
+		{"actionlint", "-ast-json", "-select", "jobs.test", "a.yaml"},
+	} {
+		var output bytes.Buffer
+		cmd := Command{
+			Stdin:  os.Stdin,
+			Stdout: &output,
+			Stderr: &output,
+		}
+		if status := cmd.Main(args); status != ExitStatusInvalidCommandOption {
+			t.Errorf("exit status should be %d for %v but got %d: %q", ExitStatusInvalidCommandOption, args, status, output.String())
+		}
+	}
+}
+
+func TestCommandEnvOverrides(t *testing.T) {
+	d := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", d)
+	if err := os.MkdirAll(filepath.Join(d, "actionlint"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	cfg := []byte("self-hosted-runner:\n  labels: [linux-gpu]\n")
+	if err := os.WriteFile(filepath.Join(d, "actionlint", "config.yaml"), cfg, 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("ACTIONLINT_SHELLCHECK", "")
+	t.Setenv("ACTIONLINT_PYFLAKES", "")
+	t.Setenv("ACTIONLINT_IGNORE", "does not match\n"+`unexpected key "branch"`)
+
+	var output bytes.Buffer
+	cmd := Command{
+		Stdin:  os.Stdin,
+		Stdout: &output,
+		Stderr: &output,
+	}
+	workflow := filepath.Join(d, "test.yaml")
+	src := []byte(`on:
+  push:
+    branch: main
+jobs:
+  test:
+    runs-on: [self-hosted, linux-gpu, linux-cpu]
+    steps:
+      - run: echo $FOO
+`)
+	if err := os.WriteFile(workflow, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+	cmd.Main([]string{"actionlint", workflow})
+	out := output.String()
+
+	if !strings.Contains(out, `label "linux-cpu" is unknown`) {
+		t.Errorf("label which is not in user config should be unknown: %q", out)
+	}
+
+	if strings.Contains(out, `unexpected key "branch"`) {
+		t.Errorf("error should be ignored by $ACTIONLINT_IGNORE: %q", out)
+	}
+	if strings.Contains(out, "[shellcheck]") || strings.Contains(out, "[pyflakes]") {
+		t.Errorf("shellcheck and pyflakes should be disabled by environment variables: %q", out)
+	}
+	if strings.Contains(out, `label "linux-gpu" is unknown`) {
+		t.Errorf("label in user config should be known: %q", out)
+	}
+}
+
+func TestCommandEval(t *testing.T) {
+	testCases := []struct {
+		args   []string
+		status int
+		want   string
+	}{
+		{[]string{"github.event.pull_request.head.ref", "-event", "pull_request"}, ExitStatusSuccessNoProblem, "changes\n"},
+		{[]string{"-event", "pull_request", "${{ github.ref }}"}, ExitStatusSuccessNoProblem, "refs/pull/2/merge\n"},
+		{[]string{"github.event.issue.labels.*.name", "-event", "issues"}, ExitStatusSuccessNoProblem, "[\n  \"bug\"\n]\n"},
+		{[]string{"startsWith(github.ref, 'refs/tags/')", "-event", "release"}, ExitStatusSuccessNoProblem, "true\n"},
+		{[]string{"github.event.inputs.name", "-event", "workflow_dispatch", "-payload", filepath.Join("testdata", "eval", "payload.json")}, ExitStatusSuccessNoProblem, "octocat\n"},
+		{[]string{"github.ref ==", "-event", "push"}, ExitStatusSuccessProblemFound, "could not parse expression"},
+		{[]string{"hashFiles('**/go.sum')"}, ExitStatusSuccessProblemFound, "hashFiles() cannot be evaluated"},
+		{[]string{"github.ref", "-event", "unknown_event"}, ExitStatusInvalidCommandOption, "example payload of \"unknown_event\" event is not available"},
+		{[]string{"github.ref", "github.sha"}, ExitStatusInvalidCommandOption, "takes exactly one expression but got 2 arguments"},
+		{[]string{"github.ref", "-payload", "this-file-does-not-exist.json"}, ExitStatusFailure, "could not read payload file"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			var output bytes.Buffer
+			cmd := Command{
+				Stdin:  os.Stdin,
+				Stdout: &output,
+				Stderr: &output,
+			}
+			status := cmd.Main(append([]string{"actionlint", "eval"}, tc.args...))
+			out := output.String()
+			if status != tc.status {
+				t.Fatalf("exit status should be %d but got %d: %q", tc.status, status, out)
+			}
+			if tc.status == ExitStatusSuccessNoProblem {
+				if out != tc.want {
+					t.Fatalf("wanted output %q but got %q", tc.want, out)
+				}
+			} else if !strings.Contains(out, tc.want) {
+				t.Fatalf("output should contain %q but got %q", tc.want, out)
+			}
+		})
+	}
+}
+
+func TestCommandNew(t *testing.T) {
+	testCases := []struct {
+		what   string
+		args   []string
+		stdin  string
+		status int
+		want   string
+	}{
+		{"template", []string{"-template", "go-ci"}, "", ExitStatusSuccessNoProblem, "go-version: \"stable\"\n"},
+		{"customized", []string{"-template", "go-ci", "-os", "macos-latest", "-go-version", "1.20", "-permissions", "issues:read,pull-requests:write"}, "", ExitStatusSuccessNoProblem, "  issues: read\n  pull-requests: write\n"},
+		{"interactive", []string{"-interactive"}, "release\nwindows-latest\n\n\n", ExitStatusSuccessNoProblem, "runs-on: windows-latest\n"},
+		{"interactive with flags", []string{"-interactive", "-template", "docker"}, "\n\n", ExitStatusSuccessNoProblem, "Runner OS [ubuntu-latest]: Additional permissions"},
+		{"no template", []string{}, "", ExitStatusInvalidCommandOption, "-template flag is required"},
+		{"unknown template", []string{"-template", "rust-ci"}, "", ExitStatusInvalidCommandOption, `unknown template "rust-ci"`},
+		{"invalid permission", []string{"-template", "go-ci", "-permissions", "write"}, "", ExitStatusInvalidCommandOption, `permission "write" must be in the form of "scope:level"`},
+		{"argument", []string{"-template", "go-ci", "ci.yaml"}, "", ExitStatusInvalidCommandOption, "new subcommand takes no argument"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var output bytes.Buffer
+			cmd := Command{
+				Stdin:  strings.NewReader(tc.stdin),
+				Stdout: &output,
+				Stderr: &output,
+			}
+			status := cmd.Main(append([]string{"actionlint", "new"}, tc.args...))
+			out := output.String()
+			if status != tc.status {
+				t.Fatalf("exit status should be %d but got %d: %q", tc.status, status, out)
+			}
+			if !strings.Contains(out, tc.want) {
+				t.Fatalf("output should contain %q but got %q", tc.want, out)
+			}
+		})
+	}
+}
+
+func TestCommandNewOutputFile(t *testing.T) {
+	p := filepath.Join(t.TempDir(), ".github", "workflows", "ci.yaml")
+	for i, want := range []int{ExitStatusSuccessNoProblem, ExitStatusFailure} {
+		var output bytes.Buffer
+		cmd := Command{
+			Stdin:  os.Stdin,
+			Stdout: &output,
+			Stderr: &output,
+		}
+		status := cmd.Main([]string{"actionlint", "new", "-template", "go-ci", "-output", p})
+		if status != want {
+			t.Fatalf("exit status should be %d at #%d run but got %d: %q", want, i, status, output.String())
+		}
+	}
+	b, err := os.ReadFile(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(b), "name: CI\n") {
+		t.Fatalf("unexpected content of generated file: %q", b)
+	}
+}
+
+func TestCommandImport(t *testing.T) {
+	d := t.TempDir()
+	files := map[string]string{
+		".yamllint":      "ignore: |\n  /legacy/\n  !keep.yaml\n",
+		"linters.env":    "GITHUB_ACTIONS_COMMAND_ARGS=-ignore=SC2086\n",
+		"lint.conf":      "ignore: [legacy.yaml]\n",
+		".reviewdog.yml": "runner:\n  golint:\n    cmd: golint ./...\n",
+	}
+	for n, c := range files {
+		if err := os.WriteFile(filepath.Join(d, n), []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		what   string
+		args   []string
+		status int
+		want   string
+	}{
+		{"yamllint", []string{".yamllint"}, ExitStatusSuccessNoProblem, "legacy/**:\n    ignore:\n      - .*\n"},
+		{"warning", []string{".yamllint"}, ExitStatusSuccessNoProblem, `warning: pattern "!keep.yaml" in "ignore" cannot be converted`},
+		{"super-linter", []string{"linters.env"}, ExitStatusSuccessNoProblem, "'**':\n    ignore:\n      - SC2086\n"},
+		{"-from flag", []string{"-from", "yamllint", "lint.conf"}, ExitStatusSuccessNoProblem, "legacy.yaml:\n"},
+		{"nothing imported", []string{".reviewdog.yml"}, ExitStatusSuccessProblemFound, "no suppression setting was found"},
+		{"unknown linter", []string{"lint.conf"}, ExitStatusInvalidCommandOption, "lint.conf\" cannot be detected from the file name"},
+		{"unknown -from", []string{"-from", "eslint", "lint.conf"}, ExitStatusFailure, `unknown linter "eslint"`},
+		{"no argument", []string{}, ExitStatusInvalidCommandOption, "import subcommand takes exactly one file but got 0 arguments"},
+		{"file not found", []string{"not-exist.env"}, ExitStatusFailure, "could not read file to import"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var output bytes.Buffer
+			cmd := Command{
+				Stdin:  os.Stdin,
+				Stdout: &output,
+				Stderr: &output,
+			}
+			args := append([]string{}, tc.args...)
+			if len(args) > 0 {
+				args[len(args)-1] = filepath.Join(d, args[len(args)-1])
+			}
+			status := cmd.Main(append([]string{"actionlint", "import"}, args...))
+			out := output.String()
+			if status != tc.status {
+				t.Fatalf("exit status should be %d but got %d: %q", tc.status, status, out)
+			}
+			if !strings.Contains(out, tc.want) {
+				t.Fatalf("output should contain %q but got %q", tc.want, out)
+			}
+		})
+	}
+}
+
+func TestCommandLSP(t *testing.T) {
+	req := func(id int, method string) string {
+		b := fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":%q}`, id, method)
+		return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(b), b)
+	}
+	exit := `{"jsonrpc":"2.0","method":"exit"}`
+	in := req(1, "initialize") + req(2, "shutdown") + fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(exit), exit)
+
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  strings.NewReader(in),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+	status := cmd.Main([]string{"actionlint", "lsp", "-shellcheck=", "-pyflakes="})
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d but got %d: %q", ExitStatusSuccessNoProblem, status, stderr.String())
+	}
+	out := stdout.String()
+	for _, want := range []string{`"hoverProvider":true`, `{"jsonrpc":"2.0","id":2,"result":null}`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q but got %q", want, out)
+		}
+	}
+
+	status = cmd.Main([]string{"actionlint", "lsp", "foo.yaml"})
+	if status != ExitStatusInvalidCommandOption {
+		t.Fatalf("exit status should be %d but got %d", ExitStatusInvalidCommandOption, status)
+	}
+}
+
+func TestCommandRename(t *testing.T) {
+	files := map[string]string{
+		"ci.yaml": `on: push
+jobs:
+  build: # comment
+    runs-on: ubuntu-latest
+    steps:
+      - id: setup
+        run: echo
+      - run: echo '${{ steps.setup.outputs.dir }}'
+  call:
+    needs: [build]
+    uses: ./.github/workflows/reusable.yaml
+    with:
+      version: "${{ needs.build.result }}"
+  test:
+    needs: build
+    if: needs.build.result == 'success'
+    runs-on: ubuntu-latest
+    steps:
+      - id: setup
+        run: echo
+`,
+		"reusable.yaml": `on:
+  workflow_call:
+    inputs:
+      version:
+        type: string
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo "${{ inputs.version }}"
+`,
+	}
+	want := map[string]string{
+		"ci.yaml": `on: push
+jobs:
+  build-linux: # comment
+    runs-on: ubuntu-latest
+    steps:
+      - id: setup-go
+        run: echo
+      - run: echo '${{ steps.setup-go.outputs.dir }}'
+  call:
+    needs: [build-linux]
+    uses: ./.github/workflows/reusable.yaml
+    with:
+      go-version: "${{ needs.build-linux.result }}"
+  test:
+    needs: build-linux
+    if: needs.build-linux.result == 'success'
+    runs-on: ubuntu-latest
+    steps:
+      - id: setup
+        run: echo
+`,
+		"reusable.yaml": `on:
+  workflow_call:
+    inputs:
+      go-version:
+        type: string
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo "${{ inputs.go-version }}"
+`,
+	}
+
+	root := t.TempDir()
+	dir := filepath.Join(root, ".github", "workflows")
+	if err := os.MkdirAll(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for n, c := range files {
+		if err := os.WriteFile(filepath.Join(dir, n), []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ci := filepath.Join(dir, "ci.yaml")
+	reusable := filepath.Join(dir, "reusable.yaml")
+
+	testCases := []struct {
+		what   string
+		args   []string
+		status int
+		want   string
+	}{
+		{"dry run", []string{"-job", "build", "-to", "build-linux", "-dry-run", ci}, ExitStatusSuccessNoProblem, "ci.yaml:10:13: build -> build-linux\n"},
+		{"job", []string{"-job", "build", "-to", "build-linux", ci}, ExitStatusSuccessNoProblem, "Renamed 5 occurrence(s)"},
+		{"step", []string{"-job", "build-linux", "-step", "setup", "-to", "setup-go", ci}, ExitStatusSuccessNoProblem, "Renamed 2 occurrence(s)"},
+		{"input", []string{"-input", "version", "-to", "go-version", reusable}, ExitStatusSuccessNoProblem, "Renamed 1 occurrence(s)"},
+		{"conflict", []string{"-job", "call", "-to", "Test", ci}, ExitStatusFailure, `job "call" cannot be renamed to "Test" because it is already defined at`},
+		{"not found", []string{"-job", "unknown", "-to", "foo", ci}, ExitStatusFailure, `definition of job "unknown" was not found`},
+		{"invalid name", []string{"-job", "call", "-to", "foo bar", ci}, ExitStatusFailure, `"foo bar" is invalid as name of job`},
+		{"no -to", []string{"-job", "call", ci}, ExitStatusInvalidCommandOption, "new name must be given with -to flag"},
+		{"no symbol", []string{"-to", "foo", ci}, ExitStatusInvalidCommandOption, "either -job, -job and -step, or -input must be given"},
+		{"step without job", []string{"-step", "setup", "-to", "foo", ci}, ExitStatusInvalidCommandOption, "either -job, -job and -step, or -input must be given"},
+		{"no argument", []string{"-job", "call", "-to", "foo"}, ExitStatusInvalidCommandOption, "rename subcommand takes exactly one file but got 0 arguments"},
+		{"file not found", []string{"-job", "call", "-to", "foo", filepath.Join(dir, "missing.yaml")}, ExitStatusFailure, "could not read file to rename symbol"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var output bytes.Buffer
+			cmd := Command{
+				Stdin:  os.Stdin,
+				Stdout: &output,
+				Stderr: &output,
+			}
+			status := cmd.Main(append([]string{"actionlint", "rename"}, tc.args...))
+			out := output.String()
+			if status != tc.status {
+				t.Fatalf("exit status should be %d but got %d: %q", tc.status, status, out)
+			}
+			if !strings.Contains(out, tc.want) {
+				t.Fatalf("output should contain %q but got %q", tc.want, out)
+			}
+		})
+	}
+
+	for n, w := range want {
+		b, err := os.ReadFile(filepath.Join(dir, n))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have := string(b); have != w {
+			t.Errorf("%s was not renamed as expected:\nwant: %q\nhave: %q", n, w, have)
+		}
+	}
+}
+
+func TestCommandExplainDiff(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"old.yaml":     "on: push\npermissions:\n  contents: read\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n",
+		"new.yaml":     "on: push\npermissions:\n  contents: read\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n      - uses: actions/setup-go@v5\n",
+		"risky.yaml":   "on: push\npermissions:\n  contents: write\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n",
+		"invalid.yaml": "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps: 42\n",
+	}
+	for n, c := range files {
+		if err := os.WriteFile(filepath.Join(dir, n), []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := filepath.Join(dir, "old.yaml")
+	changed := filepath.Join(dir, "new.yaml")
+	risky := filepath.Join(dir, "risky.yaml")
+
+	testCases := []struct {
+		what   string
+		args   []string
+		status int
+		want   string
+	}{
+		{"no change", []string{old, old}, ExitStatusSuccessNoProblem, ""},
+		{"change", []string{old, changed}, ExitStatusSuccessNoProblem, changed + `:9:15: action "actions/setup-go@v5" is newly used in job "test" [action]` + "\n"},
+		{"risky change", []string{old, risky}, ExitStatusSuccessProblemFound, `permission of scope "contents" of workflow was broadened from "read" to "write" [permissions] (risky)`},
+		{"json", []string{"-json", old, risky}, ExitStatusSuccessProblemFound, `"kind":"permissions","message":"permission of scope \"contents\" of workflow was broadened from \"read\" to \"write\"","risky":true,`},
+		{"json no change", []string{"-json", old, old}, ExitStatusSuccessNoProblem, "[]\n"},
+		{"parse error", []string{old, filepath.Join(dir, "invalid.yaml")}, ExitStatusFailure, "could not parse workflow file"},
+		{"file not found", []string{old, filepath.Join(dir, "missing.yaml")}, ExitStatusFailure, "could not read workflow file"},
+		{"one argument", []string{old}, ExitStatusInvalidCommandOption, "explain-diff subcommand takes exactly two files but got 1 arguments"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var output bytes.Buffer
+			cmd := Command{
+				Stdin:  os.Stdin,
+				Stdout: &output,
+				Stderr: &output,
+			}
+			status := cmd.Main(append([]string{"actionlint", "explain-diff"}, tc.args...))
+			out := output.String()
+			if status != tc.status {
+				t.Fatalf("exit status should be %d but got %d: %q", tc.status, status, out)
+			}
+			if tc.want == "" && out != "" {
+				t.Fatalf("output should be empty but got %q", out)
+			}
+			if !strings.Contains(out, tc.want) {
+				t.Fatalf("output should contain %q but got %q", tc.want, out)
+			}
+		})
+	}
+}
+
+func TestCommandASTJSON(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.yaml")
+	invalid := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(valid, []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo hi\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(invalid, []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps: 42\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		what   string
+		args   []string
+		status int
+		files  []string
+		errors int
+	}{
+		{"valid", []string{valid}, ExitStatusSuccessNoProblem, []string{valid}, 0},
+		{"invalid", []string{valid, invalid}, ExitStatusSuccessProblemFound, []string{valid, invalid}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			cmd := Command{
+				Stdin:  os.Stdin,
+				Stdout: &stdout,
+				Stderr: &stderr,
+			}
+			status := cmd.Main(append([]string{"actionlint", "-ast-json"}, tc.args...))
+			if status != tc.status {
+				t.Fatalf("exit status should be %d but got %d: %q", tc.status, status, stderr.String())
+			}
+
+			var asts []*WorkflowAST
+			if err := json.Unmarshal(stdout.Bytes(), &asts); err != nil {
+				t.Fatalf("output is not a JSON array of ASTs: %v: %q", err, stdout.String())
+			}
+			if len(asts) != len(tc.files) {
+				t.Fatalf("wanted %d ASTs but got %d", len(tc.files), len(asts))
+			}
+			errs := 0
+			for i, a := range asts {
+				if a.Filepath != tc.files[i] {
+					t.Errorf("wanted file path %q but got %q", tc.files[i], a.Filepath)
+				}
+				if a.Workflow == nil || a.Workflow.Jobs["test"] == nil {
+					t.Errorf("job is not in AST of %q", a.Filepath)
+				}
+				errs += len(a.Errors)
+			}
+			if errs != tc.errors {
+				t.Fatalf("wanted %d errors but got %d", tc.errors, errs)
+			}
+		})
+	}
+}
+
+func TestCommandASTJSONStdin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	cmd := Command{
+		Stdin:  strings.NewReader("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n"),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+	status := cmd.Main([]string{"actionlint", "-ast-json", "-stdin-filename", "test.yaml", "-"})
+	if status != ExitStatusSuccessNoProblem {
+		t.Fatalf("exit status should be %d but got %d: %q", ExitStatusSuccessNoProblem, status, stderr.String())
+	}
+	var asts []*WorkflowAST
+	if err := json.Unmarshal(stdout.Bytes(), &asts); err != nil {
+		t.Fatalf("output is not a JSON array of ASTs: %v: %q", err, stdout.String())
+	}
+	if len(asts) != 1 || asts[0].Filepath != "test.yaml" {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+	e, ok := asts[0].Workflow.Jobs["test"].Steps[0].Exec.(*ExecAction)
+	if !ok || e.Uses.Value != "actions/checkout@v4" || e.Uses.Pos.Line != 6 {
+		t.Fatalf("unexpected step in AST: %q", stdout.String())
+	}
+}
@@ -1,9 +1,11 @@
 package actionlint
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -22,6 +24,169 @@ type Config struct {
 	// listed here as undefined config variables.
 	// https://docs.github.com/en/actions/learn-github-actions/variables
 	ConfigVariables []string `yaml:"config-variables"`
+	// Rules is configuration for each rule. Keys are rule names such as "checkout-persist-credentials".
+	Rules map[string]*RuleConfig `yaml:"rules"`
+	// EmbeddedWorkflows is list of configurations to lint workflows embedded in other YAML files.
+	EmbeddedWorkflows []*EmbeddedWorkflowsConfig `yaml:"embedded-workflows"`
+	// Paths is configuration for files matching to glob patterns. Keys are glob patterns matched to
+	// slash-separated file paths relative to the repository root. "**" matches any number of
+	// directories.
+	Paths map[string]*PathConfig `yaml:"paths"`
+	// Plugins is list of external executables which implement custom rules.
+	Plugins []*PluginConfig `yaml:"plugins"`
+	// RequiredStatusChecks is a file path of the export of required status checks in branch
+	// protection rules. A relative path is resolved from the repository root. The file is JSON or
+	// YAML such as the response of branch protection API.
+	RequiredStatusChecks string `yaml:"required-status-checks"`
+	// DisablePopularActions is flag not to use the data set of popular actions embedded in
+	// actionlint. When it is true, the data set is never loaded and inputs and outputs of actions
+	// are checked only with the metadata fetched by online checks.
+	DisablePopularActions bool `yaml:"disable-popular-actions"`
+}
+
+// PathConfig is configuration for files matching to a glob pattern in "paths:" section of config
+// file.
+type PathConfig struct {
+	// Ignore is list of regular expressions to filter errors in the files. When an error message
+	// matches to one of them, the error is ignored.
+	Ignore []string `yaml:"ignore"`
+	ignore []*regexp.Regexp
+}
+
+// IgnoresError returns whether the error is ignored by the "ignore" patterns.
+func (c *PathConfig) IgnoresError(err *Error) bool {
+	rs := c.ignore
+	if rs == nil {
+		// The config was not created by parsing config file. Invalid patterns are skipped.
+		for _, p := range c.Ignore {
+			if r, err := regexp.Compile(p); err == nil {
+				rs = append(rs, r)
+			}
+		}
+	}
+	for _, r := range rs {
+		if r.MatchString(err.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleConfig is configuration of a single rule in "rules:" section of config file.
+type RuleConfig struct {
+	// Enable is flag to enable the rule. Some rules are disabled by default (opt-in rules) and they
+	// are only enabled when this flag is set to true.
+	Enable bool `yaml:"enable"`
+	// Allow is list of glob patterns which are allowed by the rule. The meaning of the patterns
+	// depends on the rule. For example, "action-fork" rule allows forked actions matching to them.
+	Allow []string `yaml:"allow"`
+	// Require is list of constraints which the rule enforces. Its meaning depends on the rule. For
+	// example, "job-order" rule requires dependencies between jobs matching to the patterns.
+	Require []*RequireConfig `yaml:"require"`
+	// Severity is the severity of errors reported by the rule. It is one of "error", "warning", and
+	// "off". Warnings are reported but they do not make actionlint command fail. "off" disables the
+	// rule. The default value is "error".
+	Severity string `yaml:"severity"`
+	// OutputSeverity overrides Severity for each output of errors. Keys are names of outputs: "text"
+	// for the default output, "template" for the output formatted with a custom template, or names
+	// of error renderers such as "sarif". Values are severities including "note" which is mainly
+	// for SARIF. This is useful when the same run is consumed by tools requiring different
+	// strictness such as code scanning and CI gates.
+	OutputSeverity map[string]string `yaml:"output-severity"`
+}
+
+// RequireConfig is a constraint in "require" of the rule configuration.
+type RequireConfig struct {
+	// Jobs is a glob pattern of job IDs which the constraint is applied to.
+	Jobs string `yaml:"jobs"`
+	// Needs is a glob pattern of job IDs. The jobs matching to Jobs must depend on at least one job
+	// matching to this pattern directly or indirectly via "needs:".
+	Needs string `yaml:"needs"`
+}
+
+// Rule returns the configuration of the rule specified by the name. It returns nil when the rule
+// is not configured.
+func (c *Config) Rule(name string) *RuleConfig {
+	if c == nil {
+		return nil
+	}
+	return c.Rules[name]
+}
+
+// IgnoresError returns whether the error in the file is ignored by "paths:" section. The path
+// parameter is a slash-separated file path relative to the repository root.
+func (c *Config) IgnoresError(path string, err *Error) bool {
+	if c == nil {
+		return false
+	}
+	for p, pc := range c.Paths {
+		if pc != nil && matchGlobFilter([]string{p}, path) && pc.IgnoresError(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleEnabled returns whether the opt-in rule specified by the name is enabled by the "enable"
+// flag in "rules:" section.
+func (c *Config) RuleEnabled(name string) bool {
+	r := c.Rule(name)
+	return r != nil && r.Enable
+}
+
+// RuleSeverity returns the severity of the rule specified by the name configured by the "severity"
+// in "rules:" section. It returns SeverityError when the severity is not configured.
+func (c *Config) RuleSeverity(name string) string {
+	if r := c.Rule(name); r != nil && r.Severity != "" {
+		return r.Severity
+	}
+	return SeverityError
+}
+
+// RuleOutputSeverity returns the severity of the rule specified by the name for the output. The
+// severity configured by "output-severity" in "rules:" section takes precedence over "severity".
+// The output parameter is "text", "template", or a name of error renderer.
+func (c *Config) RuleOutputSeverity(name, output string) string {
+	if r := c.Rule(name); r != nil {
+		if s := r.OutputSeverity[output]; s != "" {
+			return s
+		}
+	}
+	return c.RuleSeverity(name)
+}
+
+// PopularActionsEnabled returns whether the data set of popular actions is used for checking
+// actions. It is disabled by "disable-popular-actions" flag.
+func (c *Config) PopularActionsEnabled() bool {
+	return c == nil || !c.DisablePopularActions
+}
+
+// Names of outputs of errors other than error renderers. They are used as keys of "output-severity"
+// in "rules:" section.
+const (
+	outputText     = "text"
+	outputTemplate = "template"
+)
+
+func outputNames() []string {
+	return append([]string{outputText, outputTemplate}, ErrorRendererNames()...)
+}
+
+func isOutputName(name string) bool {
+	if name == outputText || name == outputTemplate {
+		return true
+	}
+	_, ok := LookupErrorRenderer(name)
+	return ok
+}
+
+func isValidSeverity(s string) bool {
+	switch s {
+	case "", SeverityError, SeverityWarning, SeverityNote, SeverityOff:
+		return true
+	default:
+		return false
+	}
 }
 
 func parseConfig(b []byte, path string) (*Config, error) {
@@ -30,6 +195,61 @@ func parseConfig(b []byte, path string) (*Config, error) {
 		msg := strings.ReplaceAll(err.Error(), "\n", " ")
 		return nil, fmt.Errorf("could not parse config file %q: %s", path, msg)
 	}
+	for n, r := range c.Rules {
+		if r == nil {
+			continue
+		}
+		if !isValidSeverity(r.Severity) {
+			return nil, fmt.Errorf("invalid severity %q of rule %q in config file %q. it must be one of \"error\", \"warning\", \"note\", or \"off\"", r.Severity, n, path)
+		}
+		for o, s := range r.OutputSeverity {
+			if !isOutputName(o) {
+				return nil, fmt.Errorf("unknown output %q at \"output-severity\" of rule %q in config file %q. it must be one of %s", o, n, path, sortedQuotes(outputNames()))
+			}
+			if s == "" || !isValidSeverity(s) {
+				return nil, fmt.Errorf("invalid severity %q for output %q of rule %q in config file %q. it must be one of \"error\", \"warning\", \"note\", or \"off\"", s, o, n, path)
+			}
+		}
+		for _, req := range r.Require {
+			if req == nil || req.Jobs == "" || req.Needs == "" {
+				return nil, fmt.Errorf("both \"jobs\" and \"needs\" must be set to each element of \"require\" of rule %q in config file %q", n, path)
+			}
+			for _, p := range []string{req.Jobs, req.Needs} {
+				if _, err := compileGlob(strings.TrimPrefix(p, "!")); err != nil {
+					return nil, fmt.Errorf("invalid glob pattern %q in \"require\" of rule %q in config file %q: %s", p, n, path, err)
+				}
+			}
+		}
+	}
+	for p, pc := range c.Paths {
+		if pc == nil {
+			continue
+		}
+		if _, err := compileGlob(p); err != nil {
+			return nil, fmt.Errorf("invalid glob pattern %q in \"paths\" section of config file %q: %s", p, path, err)
+		}
+		pc.ignore = make([]*regexp.Regexp, 0, len(pc.Ignore))
+		for _, i := range pc.Ignore {
+			r, err := regexp.Compile(i)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression %q in \"ignore\" of path %q in config file %q: %s", i, p, path, err)
+			}
+			pc.ignore = append(pc.ignore, r)
+		}
+	}
+	names := map[string]struct{}{}
+	for i, p := range c.Plugins {
+		if p == nil || p.Name == "" || p.Command == "" {
+			return nil, fmt.Errorf("both \"name\" and \"command\" must be set to plugin at index %d in \"plugins\" section of config file %q", i, path)
+		}
+		if !rePluginName.MatchString(p.Name) {
+			return nil, fmt.Errorf("invalid plugin name %q in config file %q. name must consist of lower-case letters, digits, and hyphens like \"org-naming\"", p.Name, path)
+		}
+		if _, ok := names[p.Name]; ok {
+			return nil, fmt.Errorf("plugin %q is defined more than once in config file %q", p.Name, path)
+		}
+		names[p.Name] = struct{}{}
+	}
 	return &c, nil
 }
 
@@ -42,6 +262,101 @@ func ReadConfigFile(path string) (*Config, error) {
 	return parseConfig(b, path)
 }
 
+// UserConfigFilePath returns the file path of the per-user config file. It is
+// "$XDG_CONFIG_HOME/actionlint/config.yaml". When $XDG_CONFIG_HOME is not set, "~/.config" is used
+// as the config directory. An empty string is returned when the home directory cannot be found.
+func UserConfigFilePath() string {
+	d := os.Getenv("XDG_CONFIG_HOME")
+	if d == "" {
+		h, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		d = filepath.Join(h, ".config")
+	}
+	return filepath.Join(d, "actionlint", "config.yaml")
+}
+
+// loadUserConfig reads the per-user config file from the given file path. It returns nil when the
+// file does not exist.
+func loadUserConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read user config file %q: %w", path, err)
+	}
+	return parseConfig(b, path)
+}
+
+// mergeConfig merges the config c over the base config and returns the merged one. Values in c
+// have higher priority than values in base. Labels of self-hosted runners and configurations of
+// embedded workflows are concatenated. Configurations in "rules:" are merged per rule. Ignore
+// patterns in "paths:" are concatenated per glob pattern. Plugins are merged per plugin name.
+// The file of required status checks in base is used only when c does not have it.
+func mergeConfig(base, c *Config) *Config {
+	if base == nil {
+		return c
+	}
+	if c == nil {
+		return base
+	}
+
+	m := *c
+	if len(base.SelfHostedRunner.Labels) > 0 {
+		m.SelfHostedRunner.Labels = append(append([]string{}, base.SelfHostedRunner.Labels...), c.SelfHostedRunner.Labels...)
+	}
+	if m.ConfigVariables == nil {
+		m.ConfigVariables = base.ConfigVariables
+	}
+	if len(base.Rules) > 0 {
+		m.Rules = make(map[string]*RuleConfig, len(base.Rules)+len(c.Rules))
+		for n, r := range base.Rules {
+			m.Rules[n] = r
+		}
+		for n, r := range c.Rules {
+			m.Rules[n] = r
+		}
+	}
+	if len(base.EmbeddedWorkflows) > 0 {
+		m.EmbeddedWorkflows = append(append([]*EmbeddedWorkflowsConfig{}, base.EmbeddedWorkflows...), c.EmbeddedWorkflows...)
+	}
+	if len(base.Paths) > 0 {
+		m.Paths = make(map[string]*PathConfig, len(base.Paths)+len(c.Paths))
+		for p, pc := range base.Paths {
+			m.Paths[p] = pc
+		}
+		for p, pc := range c.Paths {
+			if b, ok := m.Paths[p]; ok && b != nil && pc != nil {
+				pc = &PathConfig{
+					Ignore: append(append([]string{}, b.Ignore...), pc.Ignore...),
+					ignore: append(append([]*regexp.Regexp{}, b.ignore...), pc.ignore...),
+				}
+			}
+			m.Paths[p] = pc
+		}
+	}
+	if len(base.Plugins) > 0 {
+		m.Plugins = make([]*PluginConfig, 0, len(base.Plugins)+len(c.Plugins))
+		overridden := make(map[string]struct{}, len(c.Plugins))
+		for _, p := range c.Plugins {
+			overridden[p.Name] = struct{}{}
+		}
+		for _, p := range base.Plugins {
+			if _, ok := overridden[p.Name]; !ok {
+				m.Plugins = append(m.Plugins, p)
+			}
+		}
+		m.Plugins = append(m.Plugins, c.Plugins...)
+	}
+	if m.RequiredStatusChecks == "" {
+		m.RequiredStatusChecks = base.RequiredStatusChecks
+	}
+	m.DisablePopularActions = m.DisablePopularActions || base.DisablePopularActions
+	return &m
+}
+
 // loadRepoConfig reads config file from the repository's .github/actionlint.yml or
 // .github/actionlint.yaml.
 func loadRepoConfig(root string) (*Config, error) {
@@ -68,6 +383,9 @@ func writeDefaultConfigFile(path string) error {
 # organization. ` + "`null`" + ` means disabling configuration variables check.
 # Empty array means no configuration variable is allowed.
 config-variables: null
+# Configuration for each rule. Keys are rule names. Opt-in rules such as
+# "checkout-persist-credentials" are enabled with "enable: true".
+rules: {}
 `)
 	if err := os.WriteFile(path, b, 0644); err != nil {
 		return fmt.Errorf("could not write default configuration file at %q: %w", path, err)
@@ -56,6 +56,305 @@ func TestConfigParseOK(t *testing.T) {
 	}
 }
 
+func TestConfigParseRules(t *testing.T) {
+	input := `rules:
+  foo:
+    enable: true
+  bar:
+    enable: false
+  piyo:
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]bool{"foo": true, "bar": false, "piyo": false, "unknown": false} {
+		if have := c.RuleEnabled(name); have != want {
+			t.Errorf("rule %q should be enabled=%v but got %v", name, want, have)
+		}
+	}
+	if c.Rule("unknown") != nil {
+		t.Error("unknown rule is configured", c.Rule("unknown"))
+	}
+
+	var nilCfg *Config
+	if nilCfg.RuleEnabled("foo") {
+		t.Error("rule is enabled without config")
+	}
+}
+
+func TestConfigParsePaths(t *testing.T) {
+	input := `paths:
+  .github/workflows/**:
+    ignore:
+      - 'label ".+" is unknown'
+      - shellcheck
+  .github/workflows/legacy.yaml:
+    ignore: ['.*']
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		path string
+		msg  string
+		want bool
+	}{
+		{".github/workflows/ci.yaml", `label "foo" is unknown`, true},
+		{".github/workflows/sub/ci.yaml", `shellcheck reported issue in this script`, true},
+		{".github/workflows/ci.yaml", `property "foo" is not defined`, false},
+		{".github/workflows/legacy.yaml", `property "foo" is not defined`, true},
+		{"other/ci.yaml", `label "foo" is unknown`, false},
+	}
+	for _, tc := range testCases {
+		if have := c.IgnoresError(tc.path, &Error{Message: tc.msg}); have != tc.want {
+			t.Errorf("error %q at %q should be ignored=%v but got %v", tc.msg, tc.path, tc.want, have)
+		}
+	}
+
+	var nilCfg *Config
+	if nilCfg.IgnoresError("test.yaml", &Error{Message: "foo"}) {
+		t.Error("error is ignored without config")
+	}
+	manual := &Config{Paths: map[string]*PathConfig{"**": {Ignore: []string{"foo", "("}}}}
+	if !manual.IgnoresError("test.yaml", &Error{Message: "foo"}) {
+		t.Error("error is not ignored by config which was not parsed from file")
+	}
+}
+
+func TestConfigParsePathsError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{
+			what:  "invalid regular expression",
+			input: "paths:\n  '**':\n    ignore: ['(foo']\n",
+			want:  `invalid regular expression "(foo" in "ignore" of path "**"`,
+		},
+		{
+			what:  "invalid glob pattern",
+			input: "paths:\n  '[foo':\n    ignore: ['foo']\n",
+			want:  `invalid glob pattern "[foo" in "paths" section`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, msg)
+			}
+		})
+	}
+}
+
+func TestConfigParseRuleRequire(t *testing.T) {
+	input := `rules:
+  job-order:
+    require:
+      - jobs: deploy-*
+        needs: test-*
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*RequireConfig{{Jobs: "deploy-*", Needs: "test-*"}}
+	if have := c.Rule("job-order").Require; !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestConfigParseRuleRequireError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{
+			what:  "missing needs",
+			input: "rules:\n  job-order:\n    require:\n      - jobs: deploy\n",
+			want:  `both "jobs" and "needs" must be set to each element of "require" of rule "job-order"`,
+		},
+		{
+			what:  "null element",
+			input: "rules:\n  job-order:\n    require: [null]\n",
+			want:  `both "jobs" and "needs" must be set to each element of "require" of rule "job-order"`,
+		},
+		{
+			what:  "invalid glob pattern",
+			input: "rules:\n  job-order:\n    require:\n      - jobs: deploy\n        needs: '[test'\n",
+			want:  `invalid glob pattern "[test" in "require" of rule "job-order"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, msg)
+			}
+		})
+	}
+}
+
+func TestConfigParseRuleSeverity(t *testing.T) {
+	input := `rules:
+  expression:
+    severity: warning
+  runner-label:
+    severity: off
+  job-order:
+    enable: true
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rule, want := range map[string]string{
+		"expression":   SeverityWarning,
+		"runner-label": SeverityOff,
+		"job-order":    SeverityError,
+		"events":       SeverityError,
+	} {
+		if have := c.RuleSeverity(rule); have != want {
+			t.Errorf("wanted severity %q for rule %q but got %q", want, rule, have)
+		}
+	}
+
+	_, err = parseConfig([]byte("rules:\n  expression:\n    severity: info\n"), "/path/to/file.yml")
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if want := `invalid severity "info" of rule "expression"`; !strings.Contains(err.Error(), want) {
+		t.Fatalf("wanted %q in error message but got %q", want, err.Error())
+	}
+}
+
+func TestConfigParseRuleOutputSeverity(t *testing.T) {
+	input := `rules:
+  runner-label:
+    severity: warning
+    output-severity:
+      sarif: note
+      text: error
+  expression:
+    output-severity:
+      junit: off
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		rule   string
+		output string
+		want   string
+	}{
+		{"runner-label", "sarif", SeverityNote},
+		{"runner-label", "text", SeverityError},
+		{"runner-label", "template", SeverityWarning},
+		{"expression", "junit", SeverityOff},
+		{"expression", "sarif", SeverityError},
+		{"events", "sarif", SeverityError},
+	} {
+		if have := c.RuleOutputSeverity(tc.rule, tc.output); have != tc.want {
+			t.Errorf("wanted severity %q for rule %q on output %q but got %q", tc.want, tc.rule, tc.output, have)
+		}
+	}
+
+	for _, tc := range []struct {
+		input string
+		want  string
+	}{
+		{
+			"rules:\n  expression:\n    output-severity:\n      xml: note\n",
+			`unknown output "xml" at "output-severity" of rule "expression"`,
+		},
+		{
+			"rules:\n  expression:\n    output-severity:\n      sarif: info\n",
+			`invalid severity "info" for output "sarif" of rule "expression"`,
+		},
+		{
+			"rules:\n  expression:\n    output-severity:\n      sarif:\n",
+			`invalid severity "" for output "sarif" of rule "expression"`,
+		},
+	} {
+		_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+		if err == nil {
+			t.Fatalf("error did not occur for %q", tc.input)
+		}
+		if !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("wanted %q in error message but got %q", tc.want, err.Error())
+		}
+	}
+}
+
+func TestConfigParsePlugins(t *testing.T) {
+	input := `plugins:
+  - name: org-naming
+    command: ./scripts/lint-naming.sh
+    args: [--strict]
+`
+	c, err := parseConfig([]byte(input), "/path/to/file.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*PluginConfig{{Name: "org-naming", Command: "./scripts/lint-naming.sh", Args: []string{"--strict"}}}
+	if !cmp.Equal(want, c.Plugins) {
+		t.Fatal(cmp.Diff(want, c.Plugins))
+	}
+}
+
+func TestConfigParsePluginsError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{
+			what:  "missing command",
+			input: "plugins:\n  - name: foo\n",
+			want:  `both "name" and "command" must be set to plugin at index 0`,
+		},
+		{
+			what:  "null element",
+			input: "plugins: [null]\n",
+			want:  `both "name" and "command" must be set to plugin at index 0`,
+		},
+		{
+			what:  "invalid name",
+			input: "plugins:\n  - name: Foo_Bar\n    command: foo\n",
+			want:  `invalid plugin name "Foo_Bar"`,
+		},
+		{
+			what:  "duplicate name",
+			input: "plugins:\n  - name: foo\n    command: foo\n  - name: foo\n    command: bar\n",
+			want:  `plugin "foo" is defined more than once`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.input), "/path/to/file.yml")
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, msg)
+			}
+		})
+	}
+}
+
 func TestConfigParseError(t *testing.T) {
 	input := "self-hosted-runner: 42\n"
 	_, err := parseConfig([]byte(input), "/path/to/file.yml")
@@ -138,3 +437,122 @@ func TestConfigGenerateDefaultConfigFileError(t *testing.T) {
 		t.Fatalf("unexpected error message: %q", msg)
 	}
 }
+
+func TestConfigMergeUserConfig(t *testing.T) {
+	user, err := parseConfig([]byte(`
+self-hosted-runner:
+  labels: [user-runner]
+config-variables: [USER_VAR]
+rules:
+  checkout-persist-credentials:
+    enable: true
+  action-fork:
+    allow: [user/*]
+paths:
+  '**':
+    ignore: [user-ignore]
+plugins:
+  - name: user-plugin
+    command: user-plugin
+  - name: shared
+    command: user-shared
+disable-popular-actions: true
+`), "user.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := parseConfig([]byte(`
+self-hosted-runner:
+  labels: [repo-runner]
+rules:
+  action-fork:
+    allow: [repo/*]
+paths:
+  '**':
+    ignore: [repo-ignore]
+  legacy/**:
+    ignore: ['.*']
+plugins:
+  - name: shared
+    command: repo-shared
+`), "repo.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := mergeConfig(user, repo)
+
+	if want := []string{"user-runner", "repo-runner"}; !cmp.Equal(c.SelfHostedRunner.Labels, want) {
+		t.Error(cmp.Diff(want, c.SelfHostedRunner.Labels))
+	}
+	if want := []string{"USER_VAR"}; !cmp.Equal(c.ConfigVariables, want) {
+		t.Error(cmp.Diff(want, c.ConfigVariables))
+	}
+	if !c.RuleEnabled("checkout-persist-credentials") {
+		t.Error("rule enabled by user config is not enabled")
+	}
+	if want := []string{"repo/*"}; !cmp.Equal(c.Rule("action-fork").Allow, want) {
+		t.Error(cmp.Diff(want, c.Rule("action-fork").Allow))
+	}
+	if want := []string{"user-ignore", "repo-ignore"}; !cmp.Equal(c.Paths["**"].Ignore, want) {
+		t.Error(cmp.Diff(want, c.Paths["**"].Ignore))
+	}
+	for _, msg := range []string{"user-ignore", "repo-ignore"} {
+		if !c.IgnoresError("test.yaml", &Error{Message: msg}) {
+			t.Errorf("error %q is not ignored by merged config", msg)
+		}
+	}
+	if !c.IgnoresError("legacy/test.yaml", &Error{Message: "foo"}) {
+		t.Error("error is not ignored by path only in repository config")
+	}
+	cmds := []string{}
+	for _, p := range c.Plugins {
+		cmds = append(cmds, p.Command)
+	}
+	if want := []string{"user-plugin", "repo-shared"}; !cmp.Equal(cmds, want) {
+		t.Error(cmp.Diff(want, cmds))
+	}
+	if c.PopularActionsEnabled() {
+		t.Error("popular actions disabled by user config are enabled")
+	}
+	if want := []string{"repo-ignore"}; !cmp.Equal(repo.Paths["**"].Ignore, want) {
+		t.Error("repository config was modified by merge:", repo.Paths["**"].Ignore)
+	}
+	if want := []string{"repo-runner"}; !cmp.Equal(repo.SelfHostedRunner.Labels, want) {
+		t.Error("repository config was modified by merge:", repo.SelfHostedRunner.Labels)
+	}
+
+	if mergeConfig(nil, repo) != repo {
+		t.Error("repository config should be returned when no user config is given")
+	}
+	if mergeConfig(user, nil) != user {
+		t.Error("user config should be returned when no repository config is given")
+	}
+}
+
+func TestConfigUserConfigFilePath(t *testing.T) {
+	d := filepath.Join("path", "to", "config")
+	t.Setenv("XDG_CONFIG_HOME", d)
+	if want, have := filepath.Join(d, "actionlint", "config.yaml"), UserConfigFilePath(); have != want {
+		t.Errorf("wanted %q but got %q", want, have)
+	}
+
+	t.Setenv("XDG_CONFIG_HOME", "")
+	h, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("home directory is not available:", err)
+	}
+	if want, have := filepath.Join(h, ".config", "actionlint", "config.yaml"), UserConfigFilePath(); have != want {
+		t.Errorf("wanted %q but got %q", want, have)
+	}
+}
+
+func TestConfigLoadUserConfigNotFound(t *testing.T) {
+	c, err := loadUserConfig(filepath.Join("testdata", "config", "does-not-exist.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != nil {
+		t.Fatalf("wanted nil but got %#v", c)
+	}
+}
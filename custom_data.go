@@ -0,0 +1,112 @@
+package actionlint
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+)
+
+// customDataFS is a file system of the data files in ./data directory. It is set only when
+// actionlint is built with "actionlint_custom_data" build tag. Forks maintaining their own data
+// tables put the files in the directory and build actionlint with the tag.
+//
+//	go install -tags actionlint_custom_data ./cmd/actionlint
+var customDataFS fs.FS
+
+const (
+	customPopularActionsFile = "popular_actions.jsonl"
+	customDeprecationsFile   = "deprecations.json"
+)
+
+func readCustomDataFile(fsys fs.FS, name string) ([]byte, error) {
+	if fsys == nil {
+		return nil, nil
+	}
+	b, err := fs.ReadFile(fsys, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read custom data file %q: %w", name, err)
+	}
+	return b, nil
+}
+
+// mergeCustomPopularActions merges popular actions in popular_actions.jsonl into the map. The file
+// is in the same format as the JSONL output of ./scripts/generate-popular-actions with -f jsonl.
+// Actions in the file override actions in the map.
+func mergeCustomPopularActions(fsys fs.FS, m map[string]*ActionMetadata) error {
+	b, err := readCustomDataFile(fsys, customPopularActionsFile)
+	if err != nil || b == nil {
+		return err
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(b))
+	s.Buffer(nil, len(b)+1)
+	for l := 1; s.Scan(); l++ {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		var a struct {
+			Spec string          `json:"spec"`
+			Meta *ActionMetadata `json:"metadata"`
+		}
+		if err := json.Unmarshal(s.Bytes(), &a); err != nil {
+			return fmt.Errorf("could not parse line %d of custom data file %q: %w", l, customPopularActionsFile, err)
+		}
+		if a.Spec == "" || a.Meta == nil {
+			return fmt.Errorf("\"spec\" and \"metadata\" are required at line %d of custom data file %q", l, customPopularActionsFile)
+		}
+		m[a.Spec] = a.Meta
+	}
+	return s.Err()
+}
+
+// mergeCustomDeprecations merges deprecations in deprecations.json into the list and returns the
+// merged list sorted by effective dates. The file is in the same format as the source of
+// ./scripts/generate-deprecations.
+func mergeCustomDeprecations(fsys fs.FS, ds []*Deprecation) ([]*Deprecation, error) {
+	b, err := readCustomDataFile(fsys, customDeprecationsFile)
+	if err != nil || b == nil {
+		return ds, err
+	}
+
+	var src []struct {
+		Kind        string   `json:"kind"`
+		Target      string   `json:"target"`
+		Announced   string   `json:"announced"`
+		Effective   string   `json:"effective"`
+		Replacement string   `json:"replacement"`
+		URL         string   `json:"url"`
+		Actions     []string `json:"actions"`
+	}
+	if err := json.Unmarshal(b, &src); err != nil {
+		return nil, fmt.Errorf("could not parse custom data file %q: %w", customDeprecationsFile, err)
+	}
+
+	ret := make([]*Deprecation, 0, len(ds)+len(src))
+	ret = append(ret, ds...)
+	for _, d := range src {
+		var k DeprecationKind
+		switch d.Kind {
+		case "action":
+			k = DeprecationKindAction
+		case "runtime":
+			k = DeprecationKindRuntime
+		case "runner":
+			k = DeprecationKindRunner
+		default:
+			return nil, fmt.Errorf("unknown kind %q of deprecation %q in custom data file %q. valid kinds are \"action\", \"runtime\", and \"runner\"", d.Kind, d.Target, customDeprecationsFile)
+		}
+		if d.Target == "" || d.Announced == "" || d.Effective == "" {
+			return nil, fmt.Errorf("\"target\", \"announced\", and \"effective\" are required for deprecation in custom data file %q", customDeprecationsFile)
+		}
+		ret = append(ret, &Deprecation{k, d.Target, d.Announced, d.Effective, d.Replacement, d.URL, d.Actions})
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].Effective < ret[j].Effective })
+	return ret, nil
+}
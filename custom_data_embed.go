@@ -0,0 +1,26 @@
+//go:build actionlint_custom_data
+
+package actionlint
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+//go:embed data
+var customDataDir embed.FS
+
+func init() {
+	fsys, err := fs.Sub(customDataDir, "data")
+	if err != nil {
+		panic(err) // Unreachable since the directory is embedded
+	}
+	customDataFS = fsys
+
+	ds, err := mergeCustomDeprecations(fsys, GitHubDeprecations)
+	if err != nil {
+		panic(fmt.Sprintf("custom data embedded at build time is broken: %s", err))
+	}
+	GitHubDeprecations = ds
+}
@@ -0,0 +1,162 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCustomDataMergePopularActions(t *testing.T) {
+	fsys := fstest.MapFS{
+		"popular_actions.jsonl": {
+			Data: []byte(`{"spec":"my-org/my-action@v1","metadata":{"name":"My action","inputs":{"token":{"name":"token","required":true}}}}
+
+{"spec":"actions/checkout@v4","metadata":{"name":"Custom checkout"}}
+`),
+		},
+	}
+
+	m := map[string]*ActionMetadata{
+		"actions/checkout@v4": {Name: "Checkout"},
+		"actions/cache@v4":    {Name: "Cache"},
+	}
+	if err := mergeCustomPopularActions(fsys, m); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]*ActionMetadata{
+		"actions/checkout@v4": {Name: "Custom checkout"},
+		"actions/cache@v4":    {Name: "Cache"},
+		"my-org/my-action@v1": {
+			Name:   "My action",
+			Inputs: ActionMetadataInputs{"token": {Name: "token", Required: true}},
+		},
+	}
+	if diff := cmp.Diff(want, m, cmpopts.IgnoreUnexported(ActionMetadata{})); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestCustomDataMergeDeprecations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"deprecations.json": {
+			Data: []byte(`[
+  {
+    "kind": "runner",
+    "target": "my-runner-1",
+    "announced": "2024-03-01",
+    "effective": "2024-05-01",
+    "replacement": "my-runner-2",
+    "url": "https://example.com/my-runner-1"
+  }
+]`),
+		},
+	}
+
+	ds := []*Deprecation{
+		{Kind: DeprecationKindAction, Target: "actions/foo@v1", Announced: "2024-01-01", Effective: "2024-04-01"},
+		{Kind: DeprecationKindRuntime, Target: "node16", Announced: "2024-01-01", Effective: "2024-06-01"},
+	}
+	have, err := mergeCustomDeprecations(fsys, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Deprecation{
+		ds[0],
+		{
+			Kind:        DeprecationKindRunner,
+			Target:      "my-runner-1",
+			Announced:   "2024-03-01",
+			Effective:   "2024-05-01",
+			Replacement: "my-runner-2",
+			URL:         "https://example.com/my-runner-1",
+		},
+		ds[1],
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestCustomDataNoFile(t *testing.T) {
+	for _, fsys := range []fstest.MapFS{nil, {"README.md": {Data: []byte("hello")}}} {
+		m := map[string]*ActionMetadata{"actions/checkout@v4": {Name: "Checkout"}}
+		if err := mergeCustomPopularActions(fsys, m); err != nil {
+			t.Fatal(err)
+		}
+		if len(m) != 1 {
+			t.Fatal("popular actions were modified:", m)
+		}
+
+		ds := []*Deprecation{{Target: "node16"}}
+		have, err := mergeCustomDeprecations(fsys, ds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(ds, have); diff != "" {
+			t.Fatal(diff)
+		}
+	}
+}
+
+func TestCustomDataBrokenFiles(t *testing.T) {
+	testCases := []struct {
+		what string
+		file string
+		data string
+		want string
+	}{
+		{
+			what: "broken JSONL",
+			file: "popular_actions.jsonl",
+			data: "{\"spec\":\"my-org/my-action@v1\",\"metadata\":{}}\n{",
+			want: `could not parse line 2 of custom data file "popular_actions.jsonl"`,
+		},
+		{
+			what: "no metadata",
+			file: "popular_actions.jsonl",
+			data: `{"spec":"my-org/my-action@v1"}`,
+			want: `"spec" and "metadata" are required at line 1`,
+		},
+		{
+			what: "broken JSON",
+			file: "deprecations.json",
+			data: `[`,
+			want: `could not parse custom data file "deprecations.json"`,
+		},
+		{
+			what: "unknown kind",
+			file: "deprecations.json",
+			data: `[{"kind":"foo","target":"bar","announced":"2024-01-01","effective":"2024-02-01"}]`,
+			want: `unknown kind "foo" of deprecation "bar"`,
+		},
+		{
+			what: "no target",
+			file: "deprecations.json",
+			data: `[{"kind":"runner","announced":"2024-01-01","effective":"2024-02-01"}]`,
+			want: `"target", "announced", and "effective" are required`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			fsys := fstest.MapFS{tc.file: {Data: []byte(tc.data)}}
+			var err error
+			if strings.HasSuffix(tc.file, ".jsonl") {
+				err = mergeCustomPopularActions(fsys, map[string]*ActionMetadata{})
+			} else {
+				_, err = mergeCustomDeprecations(fsys, nil)
+			}
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Code generated by actionlint/scripts/generate-deprecations. DO NOT EDIT.
+
+package actionlint
+
+// GitHubDeprecations is a calendar of deprecations of GitHub-managed actions, action runtimes, and
+// runner images sorted by their effective dates. This variable was generated by script at
+// ./scripts/generate-deprecations based on ./scripts/generate-deprecations/deprecations.json
+var GitHubDeprecations = []*Deprecation{
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "windows-2016",
+		Announced:   "2021-10-19",
+		Effective:   "2022-03-15",
+		Replacement: "windows-2022",
+		URL:         "https://github.com/actions/runner-images/issues/4312",
+	},
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "macos-10.15",
+		Announced:   "2022-05-31",
+		Effective:   "2022-12-01",
+		Replacement: "macos-latest",
+		URL:         "https://github.com/actions/runner-images/issues/5583",
+	},
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "macos-11",
+		Announced:   "2024-01-15",
+		Effective:   "2024-06-28",
+		Replacement: "macos-latest",
+		URL:         "https://github.com/actions/runner-images/issues/9255",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/download-artifact@v1",
+		Announced:   "2024-02-13",
+		Effective:   "2024-06-30",
+		Replacement: "actions/download-artifact@v4",
+		URL:         "https://github.blog/changelog/2024-02-13-deprecation-notice-v1-and-v2-of-the-artifact-actions/",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/download-artifact@v2",
+		Announced:   "2024-02-13",
+		Effective:   "2024-06-30",
+		Replacement: "actions/download-artifact@v4",
+		URL:         "https://github.blog/changelog/2024-02-13-deprecation-notice-v1-and-v2-of-the-artifact-actions/",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/upload-artifact@v1",
+		Announced:   "2024-02-13",
+		Effective:   "2024-06-30",
+		Replacement: "actions/upload-artifact@v4",
+		URL:         "https://github.blog/changelog/2024-02-13-deprecation-notice-v1-and-v2-of-the-artifact-actions/",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/upload-artifact@v2",
+		Announced:   "2024-02-13",
+		Effective:   "2024-06-30",
+		Replacement: "actions/upload-artifact@v4",
+		URL:         "https://github.blog/changelog/2024-02-13-deprecation-notice-v1-and-v2-of-the-artifact-actions/",
+	},
+	{
+		Kind:        DeprecationKindRuntime,
+		Target:      "node16",
+		Announced:   "2023-09-22",
+		Effective:   "2024-11-12",
+		Replacement: "node20",
+		URL:         "https://github.blog/changelog/2024-09-25-end-of-life-for-actions-node16/",
+		Actions: []string{
+			"actions/cache@v3",
+			"actions/checkout@v3",
+			"actions/github-script@v6",
+			"actions/setup-go@v4",
+			"actions/setup-java@v3",
+			"actions/setup-node@v3",
+			"actions/setup-python@v4",
+		},
+	},
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "macos-12",
+		Announced:   "2024-10-07",
+		Effective:   "2024-12-03",
+		Replacement: "macos-latest",
+		URL:         "https://github.com/actions/runner-images/issues/10721",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/download-artifact@v3",
+		Announced:   "2024-04-16",
+		Effective:   "2025-01-30",
+		Replacement: "actions/download-artifact@v4",
+		URL:         "https://github.blog/changelog/2024-04-16-deprecation-notice-v3-of-the-artifact-actions/",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/upload-artifact@v3",
+		Announced:   "2024-04-16",
+		Effective:   "2025-01-30",
+		Replacement: "actions/upload-artifact@v4",
+		URL:         "https://github.blog/changelog/2024-04-16-deprecation-notice-v3-of-the-artifact-actions/",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/cache@v1",
+		Announced:   "2024-12-05",
+		Effective:   "2025-03-01",
+		Replacement: "actions/cache@v4",
+		URL:         "https://github.com/actions/cache/discussions/1510",
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/cache@v2",
+		Announced:   "2024-12-05",
+		Effective:   "2025-03-01",
+		Replacement: "actions/cache@v4",
+		URL:         "https://github.com/actions/cache/discussions/1510",
+	},
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "ubuntu-20.04",
+		Announced:   "2025-01-09",
+		Effective:   "2025-04-15",
+		Replacement: "ubuntu-latest",
+		URL:         "https://github.com/actions/runner-images/issues/11101",
+	},
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "windows-2019",
+		Announced:   "2025-04-15",
+		Effective:   "2025-06-30",
+		Replacement: "windows-latest",
+		URL:         "https://github.com/actions/runner-images/issues/12045",
+	},
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "macos-13",
+		Announced:   "2025-09-19",
+		Effective:   "2025-12-04",
+		Replacement: "macos-latest",
+		URL:         "https://github.com/actions/runner-images/issues/13046",
+	},
+}
@@ -0,0 +1,267 @@
+package actionlint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EmbeddedWorkflowsConfig is configuration to extract workflows embedded in other YAML files such
+// as Backstage software templates or cookiecutter outputs.
+type EmbeddedWorkflowsConfig struct {
+	// Files is list of glob patterns of the host files which embed workflows. The patterns are
+	// matched to slash-separated file paths relative to the repository root. "**" matches any
+	// number of directories.
+	Files []string `yaml:"files"`
+	// Path is a selector of the workflows in the host files like "spec.steps[*].input.workflow".
+	// Each element is a mapping key, "[N]" as an index of sequence, or "*" and "[*]" matching to
+	// any key and any index. A selected node must be a mapping of workflow or a literal block
+	// scalar whose content is a workflow.
+	Path string `yaml:"path"`
+}
+
+// Matches returns whether the given slash-separated file path relative to the repository root is
+// a host file of the embedded workflows.
+func (c *EmbeddedWorkflowsConfig) Matches(path string) bool {
+	return matchGlobFilter(c.Files, path)
+}
+
+type yamlPathSegment struct {
+	key   string // "*" matches to any key
+	index int    // -1 matches to any index
+	isKey bool
+}
+
+// parseYAMLPathSelector parses a selector like "spec.steps[0].workflow". A leading "$." is
+// optional.
+func parseYAMLPathSelector(sel string) ([]yamlPathSegment, error) {
+	s := strings.TrimPrefix(strings.TrimPrefix(sel, "$"), ".")
+	if s == "" {
+		return []yamlPathSegment{}, nil // Select the root node
+	}
+
+	segs := []yamlPathSegment{}
+	for _, part := range strings.Split(s, ".") {
+		key := part
+		idx := ""
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			key, idx = part[:i], part[i:]
+		}
+		if key != "" {
+			segs = append(segs, yamlPathSegment{key: key, isKey: true})
+		} else if idx == "" {
+			return nil, fmt.Errorf("empty key in selector %q", sel)
+		}
+		for idx != "" {
+			end := strings.IndexByte(idx, ']')
+			if idx[0] != '[' || end < 0 {
+				return nil, fmt.Errorf("invalid index %q in selector %q", idx, sel)
+			}
+			i := idx[1:end]
+			if i == "*" {
+				segs = append(segs, yamlPathSegment{index: -1})
+			} else {
+				n, err := strconv.Atoi(i)
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("invalid index %q in selector %q", i, sel)
+				}
+				segs = append(segs, yamlPathSegment{index: n})
+			}
+			idx = idx[end+1:]
+		}
+	}
+	return segs, nil
+}
+
+func selectYAMLNodes(n *yaml.Node, segs []yamlPathSegment) []*yaml.Node {
+	if n.Kind == yaml.DocumentNode {
+		if len(n.Content) == 0 {
+			return nil
+		}
+		n = n.Content[0]
+	}
+	if len(segs) == 0 {
+		return []*yaml.Node{n}
+	}
+
+	seg := segs[0]
+	ret := []*yaml.Node{}
+	if seg.isKey {
+		if n.Kind != yaml.MappingNode {
+			return nil
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if seg.key == "*" || n.Content[i].Value == seg.key {
+				ret = append(ret, selectYAMLNodes(n.Content[i+1], segs[1:])...)
+			}
+		}
+		return ret
+	}
+
+	if n.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for i, c := range n.Content {
+		if seg.index < 0 || seg.index == i {
+			ret = append(ret, selectYAMLNodes(c, segs[1:])...)
+		}
+	}
+	return ret
+}
+
+// EmbeddedWorkflow is a workflow source extracted from a host file.
+type EmbeddedWorkflow struct {
+	// Source is the source of the workflow.
+	Source []byte
+	// Line is the line offset of the workflow in the host file. 1st line of the workflow source is
+	// at Line + 1 in the host file.
+	Line int
+	// Column is the column offset of the workflow in the host file.
+	Column int
+}
+
+// Position translates the position in the embedded workflow source into the position in the host
+// file. Lines and columns are 1-based.
+func (w *EmbeddedWorkflow) Position(line, col int) (int, int) {
+	if line <= 0 {
+		return w.Line + 1, w.Column + 1
+	}
+	if col > 0 {
+		col += w.Column
+	}
+	return line + w.Line, col
+}
+
+// translateMessage translates line numbers in the YAML error message like "yaml: line 3: ..." into
+// line numbers in the host file.
+func (w *EmbeddedWorkflow) translateMessage(msg string) string {
+	return reYAMLErrorLine.ReplaceAllStringFunc(msg, func(m string) string {
+		l, err := strconv.Atoi(m[len("line ") : len(m)-1])
+		if err != nil {
+			return m
+		}
+		return fmt.Sprintf("line %d:", l+w.Line)
+	})
+}
+
+func hostLines(src []byte) [][]byte {
+	return bytes.Split(src, []byte{'\n'})
+}
+
+// lineIndent returns indentation of the line. It returns false when the line is blank or only
+// contains a comment.
+func lineIndent(l []byte) (int, bool) {
+	t := bytes.TrimLeft(l, " ")
+	if len(bytes.TrimSpace(t)) == 0 || t[0] == '#' {
+		return 0, false
+	}
+	return len(l) - len(t), true
+}
+
+// extractEmbeddedMapping extracts lines of the mapping node from the host source. Each line is
+// dedented by the column of the node.
+func extractEmbeddedMapping(lines [][]byte, n *yaml.Node) *EmbeddedWorkflow {
+	start := n.Line - 1
+	col := n.Column - 1
+
+	var b bytes.Buffer
+	for i := start; i < len(lines); i++ {
+		l := lines[i]
+		if i > start {
+			if bytes.HasPrefix(l, []byte("---")) || bytes.HasPrefix(l, []byte("...")) {
+				break // Document separator
+			}
+			indent, ok := lineIndent(l)
+			if ok && indent < col {
+				break
+			}
+			if !ok && len(l)-len(bytes.TrimLeft(l, " ")) < col {
+				b.WriteByte('\n') // Blank line or comment at smaller indentation
+				continue
+			}
+		}
+		if len(l) > col {
+			b.Write(l[col:])
+		}
+		b.WriteByte('\n')
+	}
+
+	return &EmbeddedWorkflow{b.Bytes(), start, col}
+}
+
+// extractEmbeddedBlockScalar extracts the content of the literal block scalar node. The content
+// starts from the next line of the block indicator '|'.
+func extractEmbeddedBlockScalar(lines [][]byte, n *yaml.Node) *EmbeddedWorkflow {
+	col := 0
+	for i := n.Line; i < len(lines); i++ {
+		if indent, ok := lineIndent(lines[i]); ok {
+			col = indent
+			break
+		}
+	}
+	return &EmbeddedWorkflow{[]byte(n.Value), n.Line, col}
+}
+
+// ExtractEmbeddedWorkflows extracts workflows from the host file source with the selector. See the
+// document of EmbeddedWorkflowsConfig for the syntax of the selector.
+func ExtractEmbeddedWorkflows(src []byte, selector string) ([]*EmbeddedWorkflow, error) {
+	segs, err := parseYAMLPathSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := hostLines(src)
+	ret := []*EmbeddedWorkflow{}
+	dec := yaml.NewDecoder(bytes.NewReader(src))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		for _, n := range selectYAMLNodes(&doc, segs) {
+			switch n.Kind {
+			case yaml.MappingNode:
+				ret = append(ret, extractEmbeddedMapping(lines, n))
+			case yaml.ScalarNode:
+				if n.Style&yaml.LiteralStyle == 0 {
+					return nil, fmt.Errorf("line %d: embedded workflow selected by %q must be a mapping or a literal block scalar (\"|\") but it is not a literal block scalar", n.Line, selector)
+				}
+				ret = append(ret, extractEmbeddedBlockScalar(lines, n))
+			default:
+				return nil, fmt.Errorf("line %d: embedded workflow selected by %q must be a mapping or a literal block scalar (\"|\") but it is %s node", n.Line, selector, nodeKindName(n.Kind))
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+// embeddedWorkflowsConfigFor returns the configuration of embedded workflows which matches to the
+// given file path. It returns nil when no configuration matches.
+// The path parameter must be an absolute path.
+func embeddedWorkflowsConfigFor(cfg *Config, proj *Project, path string) *EmbeddedWorkflowsConfig {
+	if cfg == nil || len(cfg.EmbeddedWorkflows) == 0 || proj == nil {
+		return nil
+	}
+	rel, err := filepath.Rel(proj.RootDir(), path)
+	if err != nil {
+		return nil
+	}
+	rel = filepath.ToSlash(rel)
+	for _, c := range cfg.EmbeddedWorkflows {
+		if c.Matches(rel) {
+			return c
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEmbeddedWorkflowParseSelector(t *testing.T) {
+	testCases := []struct {
+		sel  string
+		want []yamlPathSegment
+	}{
+		{"", []yamlPathSegment{}},
+		{"$", []yamlPathSegment{}},
+		{"foo", []yamlPathSegment{{key: "foo", isKey: true}}},
+		{"$.foo.bar", []yamlPathSegment{{key: "foo", isKey: true}, {key: "bar", isKey: true}}},
+		{".foo[1]", []yamlPathSegment{{key: "foo", isKey: true}, {index: 1}}},
+		{"foo[*][0].*", []yamlPathSegment{{key: "foo", isKey: true}, {index: -1}, {index: 0}, {key: "*", isKey: true}}},
+		{"[2].foo", []yamlPathSegment{{index: 2}, {key: "foo", isKey: true}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sel, func(t *testing.T) {
+			have, err := parseYAMLPathSelector(tc.sel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, have, cmp.AllowUnexported(yamlPathSegment{})); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestEmbeddedWorkflowParseSelectorError(t *testing.T) {
+	for _, sel := range []string{"foo..bar", "foo[", "foo[x]", "foo[-1]", "foo[1]x"} {
+		t.Run(sel, func(t *testing.T) {
+			if _, err := parseYAMLPathSelector(sel); err == nil {
+				t.Fatal("error did not occur")
+			}
+		})
+	}
+}
+
+func TestEmbeddedWorkflowExtract(t *testing.T) {
+	src := `items:
+  - workflow:
+      on: push
+      jobs: {}
+    other: 1
+  - script: |
+      on: push
+      jobs: {}
+---
+items:
+  - workflow:
+      name: doc2
+`
+
+	have, err := ExtractEmbeddedWorkflows([]byte(src), "items[*].*")
+	if err == nil {
+		t.Fatalf("error did not occur: %v", have)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "line 5: ") {
+		t.Fatalf("unexpected error message: %q", msg)
+	}
+
+	have, err = ExtractEmbeddedWorkflows([]byte(src), "items[*].workflow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*EmbeddedWorkflow{
+		{[]byte("on: push\njobs: {}\n"), 2, 6},
+		{[]byte("name: doc2\n\n"), 11, 6}, // Blank line at the end of file is included
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+
+	have, err = ExtractEmbeddedWorkflows([]byte(src), "items[1].script")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []*EmbeddedWorkflow{
+		{[]byte("on: push\njobs: {}\n"), 6, 6},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+
+	w := have[0]
+	if l, c := w.Position(2, 1); l != 8 || c != 7 {
+		t.Fatalf("unexpected position %d:%d", l, c)
+	}
+	if l, c := w.Position(0, 0); l != 7 || c != 7 {
+		t.Fatalf("unexpected position %d:%d", l, c)
+	}
+	if msg := w.translateMessage("yaml: line 2: did not find expected key"); msg != "yaml: line 8: did not find expected key" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
+
+func TestEmbeddedWorkflowExtractError(t *testing.T) {
+	testCases := []struct {
+		what string
+		src  string
+		sel  string
+		want string
+	}{
+		{"flow scalar", "foo: 'on: push'\n", "foo", "not a literal block scalar"},
+		{"sequence", "foo: [1, 2]\n", "foo", "it is sequence node"},
+		{"broken YAML", "foo: {\n", "foo", "did not find expected"},
+		{"invalid selector", "foo: bar\n", "foo[", "invalid index"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := ExtractEmbeddedWorkflows([]byte(tc.src), tc.sel)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if msg := err.Error(); !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
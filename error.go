@@ -3,10 +3,14 @@ package actionlint
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
@@ -22,6 +26,21 @@ var (
 	gray   = color.New(color.FgHiBlack)
 )
 
+// Severities of errors configured by "severity" of each rule in "rules:" section of config file.
+const (
+	// SeverityError is the default severity. Errors with this severity make actionlint command fail.
+	SeverityError = "error"
+	// SeverityWarning is the severity of errors which are reported but do not make actionlint command
+	// fail. This is useful for adopting a rule gradually.
+	SeverityWarning = "warning"
+	// SeverityNote is the severity of errors which are reported as informational notes. Like
+	// warnings, they do not make actionlint command fail. This is useful for outputs consumed by
+	// tools like code scanning which show notes separately from errors and warnings.
+	SeverityNote = "note"
+	// SeverityOff is the severity to disable the rule. Errors of the rule are never reported.
+	SeverityOff = "off"
+)
+
 // Error represents an error detected by actionlint rules
 type Error struct {
 	// Message is an error message.
@@ -34,6 +53,17 @@ type Error struct {
 	Column int
 	// Kind is a string to represent kind of the error. Usually rule name which found the error.
 	Kind string
+	// Fixes is a list of edits of the source to fix the error. It is empty when the error cannot be
+	// fixed automatically. The edits are applied with -fix flag. See ApplyFixes for more details.
+	Fixes []*TextEdit
+	// Events is names of the workflow triggers at "on:" which make the error relevant. It is only
+	// set when the error depends on the event triggering the workflow such as an untrusted property
+	// of `github.event` context. For example, an error for `github.head_ref` in a workflow triggered
+	// by "push" and "pull_request" events has only "pull_request".
+	Events []string
+	// Severity is the severity of the error configured in config file. It is SeverityError,
+	// SeverityWarning, or SeverityNote. An empty string means SeverityError.
+	Severity string
 }
 
 // Error returns summary of the error as string.
@@ -45,6 +75,18 @@ func (e *Error) String() string {
 	return e.Error()
 }
 
+// IsWarning returns whether the severity of the error is "warning". Warnings do not make actionlint
+// command fail.
+func (e *Error) IsWarning() bool {
+	return e.Severity == SeverityWarning
+}
+
+// IsNote returns whether the severity of the error is "note". Notes do not make actionlint command
+// fail.
+func (e *Error) IsNote() bool {
+	return e.Severity == SeverityNote
+}
+
 func errorAt(pos *Pos, kind string, msg string) *Error {
 	return &Error{
 		Message: msg,
@@ -79,6 +121,11 @@ func (e *Error) GetTemplateFields(source []byte) *ErrorTemplateFields {
 		}
 	}
 
+	sev := SeverityError
+	if e.IsWarning() || e.IsNote() {
+		sev = e.Severity
+	}
+
 	return &ErrorTemplateFields{
 		Message:   e.Message,
 		Filepath:  e.Filepath,
@@ -87,37 +134,110 @@ func (e *Error) GetTemplateFields(source []byte) *ErrorTemplateFields {
 		Kind:      e.Kind,
 		Snippet:   snippet,
 		EndColumn: end,
+		Events:    e.Events,
+		Severity:  sev,
+		Fixes:     e.Fixes,
+	}
+}
+
+var reQuotedInMessage = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+func fingerprintHash(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:8])
+}
+
+// Fingerprint returns a machine-readable fingerprint of the error in the form of
+// "{rule}:{message hash}:{snippet hash}". Organizations can aggregate fingerprints of suppressed
+// errors to know which rules are noisy without sharing their workflows. Quoted values such as job
+// IDs are removed from the message before hashing so that the same kind of errors have the same
+// message hash across repositories. The snippet hash is calculated from the source line where the
+// error occurred ignoring its indentation. When no source is given, the snippet hash is calculated
+// from an empty string.
+func (e *Error) Fingerprint(source []byte) string {
+	line := ""
+	if len(source) > 0 && e.Line > 0 {
+		if l, ok := e.getLine(source); ok {
+			line = strings.TrimSpace(l)
+		}
 	}
+	msg := reQuotedInMessage.ReplaceAllString(e.Message, `""`)
+	return fmt.Sprintf("%s:%s:%s", e.Kind, fingerprintHash(msg), fingerprintHash(line))
 }
 
 // PrettyPrint prints the error with user-friendly way. It prints file name, source position, error
 // message with colorful output and source snippet with indicator. When nil is set to source, no
 // source snippet is not printed. To disable colorful output, set true to fatih/color.NoColor.
 func (e *Error) PrettyPrint(w io.Writer, source []byte) {
+	e.PrettyPrintWithContext(w, source, 0)
+}
+
+// PrettyPrintWithContext is the same as PrettyPrint but it also prints the given number of lines
+// before and after the line of the error in the source snippet. The lines are printed in gray so
+// that the line of the error stands out.
+func (e *Error) PrettyPrintWithContext(w io.Writer, source []byte, context int) {
 	yellow.Fprint(w, e.Filepath)
 	gray.Fprint(w, ":")
 	fmt.Fprint(w, e.Line)
 	gray.Fprint(w, ":")
 	fmt.Fprint(w, e.Column)
 	gray.Fprint(w, ": ")
+	if e.IsWarning() {
+		yellow.Fprint(w, "warning: ")
+	} else if e.IsNote() {
+		green.Fprint(w, "note: ")
+	}
 	bold.Fprint(w, e.Message)
 	gray.Fprintf(w, " [%s]\n", e.Kind)
 
 	if len(source) == 0 || e.Line <= 0 {
 		return
 	}
-	line, ok := e.getLine(source)
-	if !ok || len(line) < e.Column-1 {
+	start := e.Line - context
+	if start < 1 {
+		start = 1
+	}
+	lines := sourceLines(source, start, e.Line+context)
+	if len(lines) <= e.Line-start {
+		return
+	}
+	line := lines[e.Line-start]
+	if len(line) < e.Column-1 {
 		return
 	}
 
-	lnum := fmt.Sprintf("%d | ", e.Line)
-	indent := strings.Repeat(" ", len(lnum)-2)
+	width := len(strconv.Itoa(start + len(lines) - 1))
+	indent := strings.Repeat(" ", width+1)
 	gray.Fprintf(w, "%s|\n", indent)
-	gray.Fprint(w, lnum)
-	fmt.Fprintln(w, line)
-	gray.Fprintf(w, "%s| ", indent)
-	green.Fprintln(w, e.getIndicator(line))
+	for i, l := range lines {
+		lnum := start + i
+		gray.Fprintf(w, "%*d | ", width, lnum)
+		if lnum != e.Line {
+			gray.Fprintln(w, l)
+			continue
+		}
+		fmt.Fprintln(w, l)
+		gray.Fprintf(w, "%s| ", indent)
+		green.Fprintln(w, e.getIndicator(l))
+	}
+}
+
+// sourceLines returns the lines from start to end (both are 1-based and inclusive) in the source.
+// Lines after the end of the source are not included.
+func sourceLines(source []byte, start, end int) []string {
+	ls := make([]string, 0, end-start+1)
+	s := bufio.NewScanner(bytes.NewReader(source))
+	l := 0
+	for s.Scan() {
+		l++
+		if l > end {
+			break
+		}
+		if l >= start {
+			ls = append(ls, s.Text())
+		}
+	}
+	return ls
 }
 
 func (e *Error) getLine(source []byte) (string, bool) {
@@ -193,12 +313,24 @@ type ErrorTemplateFields struct {
 	Column int `json:"column"`
 	// Kind is a rule name the error belongs to.
 	Kind string `json:"kind"`
+	// Severity is the severity of the error. It is "error", "warning", or "note".
+	Severity string `json:"severity"`
 	// Snippet is a code snippet and indicator to indicate where the error occurred.
 	// When encoding into JSON, this field may be omitted when the snippet is empty.
 	Snippet string `json:"snippet,omitempty"`
 	// EndColumn is a column number where the error indicator (^~~~~~~) ends. When no indicator
 	// can be shown, EndColumn is equal to Column.
 	EndColumn int `json:"end_column"`
+	// Fingerprint is a machine-readable fingerprint of the error. It is only set when ReportFeedback
+	// of LinterOptions is enabled. See Error.Fingerprint for more details.
+	// When encoding into JSON, this field may be omitted when the fingerprint is empty.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// Events is names of the workflow triggers which make the error relevant. See Error.Events for
+	// more details. When encoding into JSON, this field may be omitted when no event is set.
+	Events []string `json:"events,omitempty"`
+	// Fixes is edits of the source to fix the error. See Error.Fixes for more details. This field
+	// is not encoded into JSON since the edits are byte offsets in the source.
+	Fixes []*TextEdit `json:"-"`
 }
 
 func unescapeBackslash(s string) string {
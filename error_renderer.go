@@ -0,0 +1,290 @@
+package actionlint
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrorReport is a report of linting passed to ErrorRenderer.
+type ErrorReport struct {
+	// Files is file paths of all linted files sorted in lexical order. Files which have no error are
+	// also included. The file path is empty when the input was read from stdin.
+	Files []string
+	// Errors is errors found by the linter. Each error contains a code snippet of the source.
+	// Errors are sorted by their file paths and positions.
+	Errors []*ErrorTemplateFields
+	// Sources maps the file paths in Files to their sources.
+	Sources map[string][]byte
+	// Rules is rules used for linting sorted by their names. Rules which found no error are also
+	// included.
+	Rules []*ErrorReportRule
+}
+
+// ErrorReportRule is metadata of a rule in ErrorReport.
+type ErrorReportRule struct {
+	// Name is the name of the rule such as "expression". It is the same as Kind of errors reported
+	// by the rule.
+	Name string
+	// Description is the description of the rule. It may be empty when the errors were not reported
+	// by any rule.
+	Description string
+}
+
+// reportRules collects rules used for linting to pass them to ErrorRenderer. Rules are collected
+// in parallel.
+type reportRules struct {
+	mu sync.Mutex
+	m  map[string]string
+}
+
+func newReportRules() *reportRules {
+	return &reportRules{
+		m: map[string]string{
+			"syntax-check": "Checks for GitHub Actions workflow syntax",
+		},
+	}
+}
+
+func (rs *reportRules) add(name, desc string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if d, ok := rs.m[name]; !ok || d == "" {
+		rs.m[name] = desc
+	}
+}
+
+func (rs *reportRules) list() []*ErrorReportRule {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	ret := make([]*ErrorReportRule, 0, len(rs.m))
+	for n, d := range rs.m {
+		ret = append(ret, &ErrorReportRule{n, d})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// ErrorRenderer is an interface to render errors in a custom output format such as JUnit XML.
+// Renderers are registered with names by RegisterErrorRenderer function and selected by the
+// Renderer field of LinterOptions.
+type ErrorRenderer interface {
+	// Render renders the report and writes it to the writer.
+	Render(out io.Writer, report *ErrorReport) error
+}
+
+var (
+	errorRenderers = map[string]ErrorRenderer{
+		"checkstyle": &CheckstyleErrorRenderer{},
+		"github":     &GitHubErrorRenderer{},
+		"html":       &HTMLErrorRenderer{},
+		"junit":      &JUnitErrorRenderer{},
+		"junit-file": &JUnitErrorRenderer{PerFile: true},
+		"rdjson":     &RDJSONErrorRenderer{},
+		"sarif":      &SARIFErrorRenderer{},
+		"tap":        &TAPErrorRenderer{},
+	}
+	errorRenderersMu sync.Mutex
+)
+
+// RegisterErrorRenderer registers the renderer with the name. When a renderer is already registered
+// with the same name, it is replaced with the new one. This function can be called in parallel.
+func RegisterErrorRenderer(name string, r ErrorRenderer) {
+	errorRenderersMu.Lock()
+	defer errorRenderersMu.Unlock()
+	errorRenderers[name] = r
+}
+
+// LookupErrorRenderer returns the renderer registered with the name. This function can be called
+// in parallel.
+func LookupErrorRenderer(name string) (ErrorRenderer, bool) {
+	errorRenderersMu.Lock()
+	defer errorRenderersMu.Unlock()
+	r, ok := errorRenderers[name]
+	return r, ok
+}
+
+// ErrorRendererNames returns names of all registered renderers sorted in lexical order.
+func ErrorRendererNames() []string {
+	errorRenderersMu.Lock()
+	defer errorRenderersMu.Unlock()
+	ns := make([]string, 0, len(errorRenderers))
+	for n := range errorRenderers {
+		ns = append(ns, n)
+	}
+	sort.Strings(ns)
+	return ns
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",cdata"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name          `xml:"testsuites"`
+	Name       string            `xml:"name,attr"`
+	Tests      int               `xml:"tests,attr"`
+	Failures   int               `xml:"failures,attr"`
+	TestSuites []*junitTestSuite `xml:"testsuite"`
+}
+
+// JUnitErrorRenderer is a renderer to output errors in JUnit XML format. By default, one test suite
+// is output per file and one failed test case is output per error. A file without errors is output
+// as a test suite containing one passed test case. When PerFile is true, one test case is output
+// per file in a single test suite and all errors in the file are output in its failure. This
+// renderer is registered as "junit" and "junit-file" (PerFile is true) by default.
+type JUnitErrorRenderer struct {
+	// PerFile is a flag to output one test case per file instead of one test case per error.
+	PerFile bool
+}
+
+func junitFailureBody(path string, e *ErrorTemplateFields) string {
+	msg := e.Message
+	if e.Severity == SeverityWarning || e.Severity == SeverityNote {
+		msg = e.Severity + ": " + msg
+	}
+	body := fmt.Sprintf("%s:%d:%d: %s [%s]", path, e.Line, e.Column, msg, e.Kind)
+	if e.Snippet != "" {
+		body += "\n" + e.Snippet
+	}
+	return body
+}
+
+// Render renders the report in JUnit XML format and writes it to the writer.
+func (r *JUnitErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	var root *junitTestSuites
+	if r.PerFile {
+		root = junitTestSuitesPerFile(report)
+	} else {
+		root = junitTestSuitesPerError(report)
+	}
+
+	b, err := xml.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode errors into JUnit XML: %w", err)
+	}
+	if _, err := fmt.Fprintf(out, "%s%s\n", xml.Header, b); err != nil {
+		return fmt.Errorf("could not write JUnit XML: %w", err)
+	}
+	return nil
+}
+
+func junitTestSuitesPerError(report *ErrorReport) *junitTestSuites {
+	suites := map[string]*junitTestSuite{}
+	root := &junitTestSuites{Name: "actionlint"}
+	suite := func(path string) *junitTestSuite {
+		if s, ok := suites[path]; ok {
+			return s
+		}
+		n := path
+		if n == "" {
+			n = "<stdin>"
+		}
+		s := &junitTestSuite{Name: n}
+		suites[path] = s
+		root.TestSuites = append(root.TestSuites, s)
+		return s
+	}
+
+	for _, f := range report.Files {
+		suite(f)
+	}
+	for _, e := range report.Errors {
+		s := suite(e.Filepath)
+		s.TestCases = append(s.TestCases, &junitTestCase{
+			Name:      fmt.Sprintf("%s:%d:%d [%s]", s.Name, e.Line, e.Column, e.Kind),
+			ClassName: e.Kind,
+			File:      e.Filepath,
+			Line:      e.Line,
+			Failure: &junitFailure{
+				Message: e.Message,
+				Type:    e.Kind,
+				Body:    junitFailureBody(s.Name, e),
+			},
+		})
+		s.Failures++
+	}
+
+	for _, s := range root.TestSuites {
+		if len(s.TestCases) == 0 {
+			s.TestCases = append(s.TestCases, &junitTestCase{Name: s.Name, ClassName: "actionlint"})
+		}
+		s.Tests = len(s.TestCases)
+		root.Tests += s.Tests
+		root.Failures += s.Failures
+	}
+	sort.SliceStable(root.TestSuites, func(i, j int) bool {
+		return strings.Compare(root.TestSuites[i].Name, root.TestSuites[j].Name) < 0
+	})
+	return root
+}
+
+func junitTestSuitesPerFile(report *ErrorReport) *junitTestSuites {
+	cases := map[string]*junitTestCase{}
+	suite := &junitTestSuite{Name: "actionlint"}
+	testCase := func(path string) *junitTestCase {
+		if c, ok := cases[path]; ok {
+			return c
+		}
+		n := path
+		if n == "" {
+			n = "<stdin>"
+		}
+		c := &junitTestCase{Name: n, ClassName: "actionlint", File: path}
+		cases[path] = c
+		suite.TestCases = append(suite.TestCases, c)
+		return c
+	}
+
+	for _, f := range report.Files {
+		testCase(f)
+	}
+	counts := map[*junitTestCase]int{}
+	for _, e := range report.Errors {
+		c := testCase(e.Filepath)
+		body := junitFailureBody(c.Name, e)
+		if c.Failure == nil {
+			c.Failure = &junitFailure{Type: "actionlint", Body: body}
+			suite.Failures++
+		} else {
+			c.Failure.Body += "\n" + body
+		}
+		counts[c]++
+	}
+	for c, n := range counts {
+		c.Failure.Message = fmt.Sprintf("%d %s found", n, pluralErrors(n))
+	}
+
+	sort.SliceStable(suite.TestCases, func(i, j int) bool {
+		return strings.Compare(suite.TestCases[i].Name, suite.TestCases[j].Name) < 0
+	})
+	suite.Tests = len(suite.TestCases)
+	return &junitTestSuites{
+		Name:       "actionlint",
+		Tests:      suite.Tests,
+		Failures:   suite.Failures,
+		TestSuites: []*junitTestSuite{suite},
+	}
+}
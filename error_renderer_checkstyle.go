@@ -0,0 +1,82 @@
+package actionlint
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+type checkstyleFile struct {
+	Name   string             `xml:"name,attr"`
+	Errors []*checkstyleError `xml:"error"`
+}
+
+type checkstyleResult struct {
+	XMLName xml.Name          `xml:"checkstyle"`
+	Version string            `xml:"version,attr"`
+	Files   []*checkstyleFile `xml:"file"`
+}
+
+// CheckstyleErrorRenderer is a renderer to output errors in Checkstyle XML format. Many tools and
+// IDE plugins such as reviewdog, Jenkins Warnings plugin, and SonarQube can read the format. One
+// file element is output per linted file and one error element is output per error. A file without
+// errors is output as an empty file element. This renderer is registered as "checkstyle" by
+// default.
+// https://checkstyle.org/
+type CheckstyleErrorRenderer struct{}
+
+// Render renders the report in Checkstyle XML format and writes it to the writer.
+func (r *CheckstyleErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	files := map[string]*checkstyleFile{}
+	root := &checkstyleResult{Version: "4.3"}
+	file := func(path string) *checkstyleFile {
+		if f, ok := files[path]; ok {
+			return f
+		}
+		n := path
+		if n == "" {
+			n = "<stdin>"
+		}
+		f := &checkstyleFile{Name: n}
+		files[path] = f
+		root.Files = append(root.Files, f)
+		return f
+	}
+
+	for _, p := range report.Files {
+		file(p)
+	}
+	for _, e := range report.Errors {
+		sev := "error"
+		if e.Severity == SeverityWarning {
+			sev = "warning"
+		} else if e.Severity == SeverityNote {
+			sev = "info"
+		}
+		f := file(e.Filepath)
+		f.Errors = append(f.Errors, &checkstyleError{
+			Line:     e.Line,
+			Column:   e.Column,
+			Severity: sev,
+			Message:  fmt.Sprintf("%s [%s]", e.Message, e.Kind),
+			Source:   "actionlint." + e.Kind,
+		})
+	}
+
+	b, err := xml.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode errors into Checkstyle XML: %w", err)
+	}
+	if _, err := fmt.Fprintf(out, "%s%s\n", xml.Header, b); err != nil {
+		return fmt.Errorf("could not write Checkstyle XML: %w", err)
+	}
+	return nil
+}
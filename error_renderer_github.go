@@ -0,0 +1,51 @@
+package actionlint
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	githubCommandDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	githubCommandPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+// GitHubErrorRenderer is a renderer to output errors as GitHub Actions workflow commands such as
+// `::error file=...,line=...,col=...::message`. When actionlint is run in a workflow with this
+// renderer, the errors are shown as annotations on pull requests and workflow runs without any
+// problem matcher. Errors of the rules whose severities are "warning" and "note" are output with
+// `::warning` and `::notice` commands. This renderer is registered as "github" by default.
+// https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions
+type GitHubErrorRenderer struct{}
+
+// Render renders the report as workflow commands and writes them to the writer.
+func (r *GitHubErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	w := bufio.NewWriter(out)
+	for _, e := range report.Errors {
+		cmd := "error"
+		if e.Severity == SeverityWarning {
+			cmd = "warning"
+		} else if e.Severity == SeverityNote {
+			cmd = "notice"
+		}
+
+		w.WriteString("::" + cmd + " ")
+		if e.Filepath != "" {
+			fmt.Fprintf(w, "file=%s,", githubCommandPropertyEscaper.Replace(e.Filepath))
+		}
+		fmt.Fprintf(w, "line=%d,col=%d", e.Line, e.Column)
+		if e.EndColumn > e.Column {
+			fmt.Fprintf(w, ",endColumn=%d", e.EndColumn)
+		}
+		fmt.Fprintf(w, ",title=%s::", githubCommandPropertyEscaper.Replace("actionlint ("+e.Kind+")"))
+		w.WriteString(githubCommandDataEscaper.Replace(fmt.Sprintf("%s [%s]", e.Message, e.Kind)))
+		w.WriteByte('\n')
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("could not write workflow commands: %w", err)
+	}
+	return nil
+}
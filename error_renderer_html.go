@@ -0,0 +1,200 @@
+package actionlint
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+)
+
+const htmlReportTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>actionlint report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #1f2328; }
+h1 { font-size: 1.6em; }
+h2 { font-size: 1.2em; margin-top: 2em; border-bottom: 1px solid #d0d7de; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #d0d7de; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+pre { margin: 4px 0 0; font-size: 0.85em; overflow-x: auto; }
+.summary span { margin-right: 2em; }
+.bar { background: #cf222e; height: 1em; }
+.chart td:first-child { width: 16em; }
+.filters { margin: 1em 0; }
+.filters select, .filters input { margin-right: 1em; }
+.ok { color: #1a7f37; }
+.warning { color: #9a6700; font-weight: bold; }
+.note { color: #0969da; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>actionlint report</h1>
+<p class="summary"><span>{{len .Files}} files</span><span>{{len .Errors}} errors</span><span>{{len .Rules}} rules</span></p>
+{{- if .Rules}}
+<h2>Errors per rule</h2>
+<table class="chart">
+{{- range .Rules}}
+<tr><td><code>{{.Name}}</code></td><td><div class="bar" style="width: {{.Percent}}%"></div></td><td>{{.Count}}</td></tr>
+{{- end}}
+</table>
+<h2>Errors</h2>
+<div class="filters">
+<label>Rule <select id="rule-filter"><option value="">All</option>{{range .Rules}}<option>{{.Name}}</option>{{end}}</select></label>
+<label>File <select id="file-filter"><option value="">All</option>{{range .Files}}<option>{{.}}</option>{{end}}</select></label>
+<label>Message <input id="text-filter" type="search"></label>
+</div>
+<table id="errors">
+<tr><th>File</th><th>Line</th><th>Column</th><th>Rule</th><th>Message</th></tr>
+{{- range .Errors}}
+<tr data-rule="{{.Kind}}" data-file="{{file .Filepath}}"><td>{{file .Filepath}}</td><td>{{.Line}}</td><td>{{.Column}}</td><td><code>{{.Kind}}</code></td><td>{{if eq .Severity "warning"}}<span class="warning">warning:</span> {{else if eq .Severity "note"}}<span class="note">note:</span> {{end}}{{.Message}}{{if .Snippet}}<pre>{{.Snippet}}</pre>{{end}}</td></tr>
+{{- end}}
+</table>
+<script>
+(function() {
+  var rule = document.getElementById('rule-filter');
+  var file = document.getElementById('file-filter');
+  var text = document.getElementById('text-filter');
+  function update() {
+    var rows = document.querySelectorAll('#errors tr[data-rule]');
+    var q = text.value.toLowerCase();
+    for (var i = 0; i < rows.length; i++) {
+      var r = rows[i];
+      var show = (!rule.value || r.dataset.rule === rule.value) &&
+        (!file.value || r.dataset.file === file.value) &&
+        (!q || r.textContent.toLowerCase().indexOf(q) >= 0);
+      r.style.display = show ? '' : 'none';
+    }
+  }
+  rule.addEventListener('change', update);
+  file.addEventListener('change', update);
+  text.addEventListener('input', update);
+})();
+</script>
+{{- else}}
+<p class="ok">No error was found.</p>
+{{- end}}
+{{- if .Calls}}
+<h2>Workflow call graph</h2>
+<table>
+<tr><th>Caller</th><th>Job</th><th>Called workflow</th></tr>
+{{- range .Calls}}
+<tr><td>{{.Caller}}</td><td><code>{{.Job}}</code></td><td>{{.Callee}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+<h2>Files</h2>
+<table>
+<tr><th>File</th><th>Errors</th></tr>
+{{- range .FileCounts}}
+<tr><td>{{.Name}}</td><td>{{.Count}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`
+
+var htmlReport = template.Must(template.New("html").Funcs(template.FuncMap{
+	"file": htmlReportFile,
+}).Parse(htmlReportTemplate))
+
+func htmlReportFile(path string) string {
+	if path == "" {
+		return "<stdin>"
+	}
+	return path
+}
+
+type htmlReportCount struct {
+	Name    string
+	Count   int
+	Percent int
+}
+
+type htmlReportCall struct {
+	Caller string
+	Job    string
+	Callee string
+}
+
+type htmlReportData struct {
+	Files      []string
+	Errors     []*ErrorTemplateFields
+	Rules      []*htmlReportCount
+	FileCounts []*htmlReportCount
+	Calls      []*htmlReportCall
+}
+
+// HTMLErrorRenderer is a renderer to output errors as a standalone HTML page. The page contains a
+// table of errors with filters, a chart of the numbers of errors per rule, and a call graph of
+// reusable workflows. It is useful to share results of scheduled audits. This renderer is
+// registered as "html" by default.
+type HTMLErrorRenderer struct{}
+
+// Render renders the report as an HTML page and writes it to the writer.
+func (r *HTMLErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	d := &htmlReportData{
+		Files:  make([]string, 0, len(report.Files)),
+		Errors: report.Errors,
+	}
+
+	for _, f := range report.Files {
+		d.Files = append(d.Files, htmlReportFile(f))
+	}
+	perFile := map[string]int{}
+	perRule := map[string]int{}
+	for _, e := range report.Errors {
+		perFile[e.Filepath]++
+		perRule[e.Kind]++
+	}
+
+	max := 0
+	for _, c := range perRule {
+		if c > max {
+			max = c
+		}
+	}
+	for k, c := range perRule {
+		d.Rules = append(d.Rules, &htmlReportCount{k, c, c * 100 / max})
+	}
+	sort.Slice(d.Rules, func(i, j int) bool {
+		if d.Rules[i].Count != d.Rules[j].Count {
+			return d.Rules[i].Count > d.Rules[j].Count
+		}
+		return d.Rules[i].Name < d.Rules[j].Name
+	})
+	for i, f := range report.Files {
+		d.FileCounts = append(d.FileCounts, &htmlReportCount{Name: d.Files[i], Count: perFile[f]})
+	}
+
+	for _, f := range report.Files {
+		src, ok := report.Sources[f]
+		if !ok {
+			continue
+		}
+		w, _ := Parse(src)
+		if w == nil {
+			continue
+		}
+		for _, j := range w.Jobs {
+			if j.WorkflowCall != nil && j.WorkflowCall.Uses != nil {
+				d.Calls = append(d.Calls, &htmlReportCall{htmlReportFile(f), j.ID.Value, j.WorkflowCall.Uses.Value})
+			}
+		}
+	}
+	sort.Slice(d.Calls, func(i, j int) bool {
+		l, r := d.Calls[i], d.Calls[j]
+		if l.Caller != r.Caller {
+			return l.Caller < r.Caller
+		}
+		return l.Job < r.Job
+	})
+
+	if err := htmlReport.Execute(out, d); err != nil {
+		return fmt.Errorf("could not write HTML report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package actionlint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+type rdjsonRange struct {
+	Start rdjsonPosition  `json:"start"`
+	End   *rdjsonPosition `json:"end,omitempty"`
+}
+
+type rdjsonLocation struct {
+	Path  string       `json:"path"`
+	Range *rdjsonRange `json:"range,omitempty"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+type rdjsonSuggestion struct {
+	Range rdjsonRange `json:"range"`
+	Text  string      `json:"text"`
+}
+
+type rdjsonDiagnostic struct {
+	Message     string              `json:"message"`
+	Location    rdjsonLocation      `json:"location"`
+	Severity    string              `json:"severity"`
+	Code        *rdjsonCode         `json:"code,omitempty"`
+	Suggestions []*rdjsonSuggestion `json:"suggestions,omitempty"`
+}
+
+type rdjsonResult struct {
+	Source      rdjsonSource        `json:"source"`
+	Diagnostics []*rdjsonDiagnostic `json:"diagnostics"`
+}
+
+// rdjsonColumn converts the column counting characters into the column counting bytes in the line
+// since columns in rdjson are byte counts in UTF-8.
+func rdjsonColumn(src []byte, line, col int) int {
+	start, _, ok := lineRange(src, line)
+	if !ok {
+		return col
+	}
+	o := posOffset(src, &Pos{Line: line, Col: col})
+	if o < 0 {
+		return col
+	}
+	return o - start + 1
+}
+
+// rdjsonOffsetPosition converts the byte offset in the source into the position in rdjson.
+func rdjsonOffsetPosition(src []byte, offset int) rdjsonPosition {
+	if offset > len(src) {
+		offset = len(src)
+	}
+	b := src[:offset]
+	l := bytes.Count(b, []byte{'\n'}) + 1
+	c := offset - (bytes.LastIndexByte(b, '\n') + 1) + 1
+	return rdjsonPosition{l, c}
+}
+
+// RDJSONErrorRenderer is a renderer to output errors in Reviewdog Diagnostic Format (rdjson). The
+// output can be given to reviewdog with `-f=rdjson` without writing an error format. Edits to fix
+// errors are output as suggestions so that reviewdog can suggest the changes on pull requests.
+// This renderer is registered as "rdjson" by default.
+// https://github.com/reviewdog/reviewdog/tree/master/proto/rdf
+type RDJSONErrorRenderer struct{}
+
+// Render renders the report in rdjson format and writes it to the writer.
+func (r *RDJSONErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	ds := make([]*rdjsonDiagnostic, 0, len(report.Errors))
+	for _, e := range report.Errors {
+		src := report.Sources[e.Filepath]
+
+		sev := "ERROR"
+		if e.Severity == SeverityWarning {
+			sev = "WARNING"
+		} else if e.Severity == SeverityNote {
+			sev = "INFO"
+		}
+		d := &rdjsonDiagnostic{
+			Message:  e.Message,
+			Location: rdjsonLocation{Path: filepath.ToSlash(e.Filepath)},
+			Severity: sev,
+			Code:     &rdjsonCode{e.Kind, sarifHelpURI(e.Kind)},
+		}
+
+		if e.Line > 0 {
+			rng := &rdjsonRange{Start: rdjsonPosition{Line: e.Line}}
+			if e.Column > 0 {
+				rng.Start.Column = rdjsonColumn(src, e.Line, e.Column)
+				// End position is exclusive in rdjson
+				rng.End = &rdjsonPosition{e.Line, rdjsonColumn(src, e.Line, e.EndColumn+1)}
+			}
+			d.Location.Range = rng
+		}
+
+		if src != nil {
+			for _, f := range e.Fixes {
+				end := rdjsonOffsetPosition(src, f.End)
+				d.Suggestions = append(d.Suggestions, &rdjsonSuggestion{
+					Range: rdjsonRange{rdjsonOffsetPosition(src, f.Start), &end},
+					Text:  f.NewText,
+				})
+			}
+		}
+
+		ds = append(ds, d)
+	}
+
+	res := &rdjsonResult{
+		Source:      rdjsonSource{"actionlint", sarifToolURI},
+		Diagnostics: ds,
+	}
+	b, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode errors into rdjson: %w", err)
+	}
+	if _, err := fmt.Fprintf(out, "%s\n", b); err != nil {
+		return fmt.Errorf("could not write rdjson: %w", err)
+	}
+	return nil
+}
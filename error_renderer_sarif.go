@@ -0,0 +1,263 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+const (
+	sarifSchema      = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+	sarifVersion     = "2.1.0"
+	sarifToolName    = "GitHub Actions lint" // Changing this name makes code scanning treat all alerts as new ones
+	sarifToolURI     = "https://github.com/rhysd/actionlint"
+	sarifChecksURI   = "https://github.com/rhysd/actionlint/blob/main/docs/checks.md"
+	sarifFingerprint = "actionlint/v1"
+)
+
+// sarifRuleAnchors maps names of the built-in rules to anchors of their sections in docs/checks.md.
+var sarifRuleAnchors = map[string]string{
+	"action":                       "check-action-format",
+	"action-fork":                  "action-fork",
+	"action-trigger":               "action-trigger",
+	"always-on-cancel":             "always-on-cancel",
+	"artifact-name":                "artifact-name",
+	"checkout-path":                "checkout-path",
+	"checkout-persist-credentials": "checkout-persist-credentials",
+	"comment-guard":                "comment-guard",
+	"credentials":                  "check-hardcoded-credentials",
+	"deprecated-commands":          "check-deprecated-workflow-commands",
+	"deprecation":                  "deprecation",
+	"dispatch-input-command":       "dispatch-input-command",
+	"env-var":                      "check-env-var-names",
+	"environment-file":             "environment-file",
+	"event-action":                 "event-action",
+	"events":                       "check-webhook-events",
+	"expression":                   "check-syntax-expression",
+	"github-token":                 "github-token",
+	"glob":                         "check-glob-pattern",
+	"id":                           "check-job-step-ids",
+	"if-cond":                      "if-cond-always-true",
+	"job-needs":                    "check-job-deps",
+	"job-order":                    "job-order",
+	"literal-key":                  "literal-key",
+	"matrix":                       "check-matrix-values",
+	"matrix-outputs":               "matrix-outputs",
+	"matrix-suggestion":            "matrix-suggestion",
+	"matrix-unused":                "matrix-unused",
+	"pages":                        "pages",
+	"permissions":                  "permissions",
+	"psscriptanalyzer":             "check-psscriptanalyzer-integ",
+	"pyflakes":                     "check-pyflakes-integ",
+	"ref-name":                     "ref-name",
+	"release-trigger":              "release-trigger",
+	"required-status-checks":       "required-status-checks",
+	"remote-script":                "remote-script",
+	"run-name":                     "run-name",
+	"runner-arch":                  "runner-arch",
+	"runner-label":                 "check-runner-labels",
+	"runner-tool":                  "runner-tool",
+	"schedule-timezone":            "schedule-timezone",
+	"setup-cache":                  "setup-cache",
+	"setup-order":                  "setup-order",
+	"shell-name":                   "check-shell-names",
+	"shellcheck":                   "check-shellcheck-integ",
+	"status-check-name":            "status-check-name",
+	"syntax-check":                 "check-unexpected-keys",
+	"trace-secrets":                "trace-secrets",
+	"unreachable-step":             "unreachable-step",
+	"untrusted-flow":               "untrusted-flow",
+	"windows-bash":                 "windows-bash",
+	"workflow-call":                "check-reusable-workflows",
+	"workflow-file":                "workflow-file",
+}
+
+// sarifHelpURI returns the URI of the document of the rule. The document of all checks is returned
+// for unknown rules such as rules added by users.
+func sarifHelpURI(rule string) string {
+	if a, ok := sarifRuleAnchors[rule]; ok {
+		return sarifChecksURI + "#" + a
+	}
+	return sarifChecksURI
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifRuleConfig struct {
+	Level string `json:"level"`
+}
+
+type sarifRule struct {
+	ID                   string          `json:"id"`
+	Name                 string          `json:"name"`
+	ShortDescription     *sarifMessage   `json:"shortDescription,omitempty"`
+	FullDescription      *sarifMessage   `json:"fullDescription,omitempty"`
+	HelpURI              string          `json:"helpUri"`
+	DefaultConfiguration sarifRuleConfig `json:"defaultConfiguration"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version"`
+	InformationURI string       `json:"informationUri"`
+	Rules          []*sarifRule `json:"rules"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+type sarifRegion struct {
+	StartLine   int           `json:"startLine"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	EndLine     int           `json:"endLine"`
+	EndColumn   int           `json:"endColumn,omitempty"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           int               `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []*sarifLocation  `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+	Properties          *sarifProperties  `json:"properties,omitempty"`
+}
+
+type sarifProperties struct {
+	Events []string `json:"events,omitempty"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool      `json:"tool"`
+	ColumnKind string         `json:"columnKind"`
+	Results    []*sarifResult `json:"results"`
+}
+
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+// SARIFErrorRenderer is a renderer to output errors in SARIF 2.1.0 format. The output can be
+// uploaded to GitHub code scanning. All rules used for linting are output as rule metadata with
+// links to their documents. Errors are output with "error", "warning", or "note" level following
+// their severities. This renderer is registered as "sarif" by default.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type SARIFErrorRenderer struct{}
+
+// Render renders the report in SARIF format and writes it to the writer.
+func (r *SARIFErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	driver := sarifDriver{
+		Name:           sarifToolName,
+		Version:        getCommandVersion(),
+		InformationURI: sarifToolURI,
+		Rules:          make([]*sarifRule, 0, len(report.Rules)),
+	}
+	indices := make(map[string]int, len(report.Rules))
+	addRule := func(name, desc string) int {
+		if i, ok := indices[name]; ok {
+			return i
+		}
+		rule := &sarifRule{
+			ID:                   name,
+			Name:                 toPascalCase(name),
+			HelpURI:              sarifHelpURI(name),
+			DefaultConfiguration: sarifRuleConfig{"error"},
+		}
+		if desc != "" {
+			rule.ShortDescription = &sarifMessage{desc}
+			rule.FullDescription = &sarifMessage{desc}
+		}
+		i := len(driver.Rules)
+		driver.Rules = append(driver.Rules, rule)
+		indices[name] = i
+		return i
+	}
+	for _, rule := range report.Rules {
+		addRule(rule.Name, rule.Description)
+	}
+
+	results := make([]*sarifResult, 0, len(report.Errors))
+	for _, e := range report.Errors {
+		level := "error"
+		if e.Severity == SeverityWarning || e.Severity == SeverityNote {
+			level = e.Severity
+		}
+		res := &sarifResult{
+			RuleID:    e.Kind,
+			RuleIndex: addRule(e.Kind, ""),
+			Level:     level,
+			Message:   sarifMessage{e.Message},
+		}
+		if e.Filepath != "" {
+			loc := &sarifLocation{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{
+						URI:       filepath.ToSlash(e.Filepath),
+						URIBaseID: "%SRCROOT%",
+					},
+				},
+			}
+			if e.Line > 0 {
+				reg := &sarifRegion{StartLine: e.Line, EndLine: e.Line}
+				if e.Column > 0 {
+					reg.StartColumn = e.Column
+					reg.EndColumn = e.EndColumn + 1 // End column is exclusive in SARIF
+				}
+				if l := sourceLine(report.Sources[e.Filepath], e.Line); l != "" {
+					reg.Snippet = &sarifMessage{l}
+				}
+				loc.PhysicalLocation.Region = reg
+			}
+			res.Locations = []*sarifLocation{loc}
+		}
+		if e.Fingerprint != "" {
+			res.PartialFingerprints = map[string]string{sarifFingerprint: e.Fingerprint}
+		}
+		if len(e.Events) > 0 {
+			res.Properties = &sarifProperties{e.Events}
+		}
+		results = append(results, res)
+	}
+
+	log := &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []*sarifRun{
+			{
+				Tool:       sarifTool{driver},
+				ColumnKind: "unicodeCodePoints",
+				Results:    results,
+			},
+		},
+	}
+
+	b, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode errors into SARIF: %w", err)
+	}
+	if _, err := fmt.Fprintf(out, "%s\n", b); err != nil {
+		return fmt.Errorf("could not write SARIF: %w", err)
+	}
+	return nil
+}
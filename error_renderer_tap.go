@@ -0,0 +1,97 @@
+package actionlint
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type tapDiagnostic struct {
+	Message  string `yaml:"message"`
+	Line     int    `yaml:"line"`
+	Column   int    `yaml:"column"`
+	Kind     string `yaml:"kind"`
+	Severity string `yaml:"severity"`
+}
+
+type tapDiagnostics struct {
+	Errors []*tapDiagnostic `yaml:"errors"`
+}
+
+// TAPErrorRenderer is a renderer to output errors in Test Anything Protocol (TAP) version 13. One
+// test point is output per file. The test point fails when the file has some errors and the errors
+// are output in its YAML diagnostic block. Errors whose severities are "warning" or "note" are also
+// output in the block but they don't fail the test point. This renderer is registered as "tap" by
+// default.
+// https://testanything.org/tap-version-13-specification.html
+type TAPErrorRenderer struct{}
+
+// Render renders the report in TAP format and writes it to the writer.
+func (r *TAPErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	files := make([]string, 0, len(report.Files))
+	errs := map[string][]*ErrorTemplateFields{}
+	for _, f := range report.Files {
+		if _, ok := errs[f]; !ok {
+			files = append(files, f)
+			errs[f] = nil
+		}
+	}
+	for _, e := range report.Errors {
+		if _, ok := errs[e.Filepath]; !ok {
+			files = append(files, e.Filepath)
+		}
+		errs[e.Filepath] = append(errs[e.Filepath], e)
+	}
+
+	w := bufio.NewWriter(out)
+	fmt.Fprintf(w, "TAP version 13\n1..%d\n", len(files))
+	for i, f := range files {
+		n := f
+		if n == "" {
+			n = "<stdin>"
+		}
+
+		es := errs[f]
+		status := "ok"
+		for _, e := range es {
+			if e.Severity != SeverityWarning && e.Severity != SeverityNote {
+				status = "not ok"
+				break
+			}
+		}
+		fmt.Fprintf(w, "%s %d - %s\n", status, i+1, n)
+		if len(es) == 0 {
+			continue
+		}
+
+		ds := &tapDiagnostics{Errors: make([]*tapDiagnostic, 0, len(es))}
+		for _, e := range es {
+			sev := e.Severity
+			if sev == "" {
+				sev = SeverityError
+			}
+			ds.Errors = append(ds.Errors, &tapDiagnostic{e.Message, e.Line, e.Column, e.Kind, sev})
+		}
+		var b strings.Builder
+		enc := yaml.NewEncoder(&b)
+		enc.SetIndent(2)
+		if err := enc.Encode(ds); err != nil {
+			return fmt.Errorf("could not encode errors into YAML diagnostic block of TAP: %w", err)
+		}
+		w.WriteString("  ---\n")
+		for _, l := range strings.SplitAfter(b.String(), "\n") {
+			if l != "" {
+				w.WriteString("  " + l)
+			}
+		}
+		w.WriteString("  ...\n")
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("could not write TAP: %w", err)
+	}
+	return nil
+}
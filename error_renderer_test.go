@@ -0,0 +1,771 @@
+package actionlint
+
+import (
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestErrorRendererJUnit(t *testing.T) {
+	r := &ErrorReport{
+		Files: []string{"a.yaml", "b.yaml"},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:   `unexpected key "branch" for "push" section`,
+				Filepath:  "b.yaml",
+				Line:      3,
+				Column:    5,
+				Kind:      "syntax-check",
+				Snippet:   "    branch: main\n    ^~~~~~~",
+				EndColumn: 11,
+			},
+			{
+				Message:  "output of <stdin>",
+				Line:     1,
+				Column:   1,
+				Kind:     "expression",
+				Snippet:  "",
+				Filepath: "",
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := (&JUnitErrorRenderer{}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="actionlint" tests="3" failures="2">
+  <testsuite name="&lt;stdin&gt;" tests="1" failures="1">
+    <testcase name="&lt;stdin&gt;:1:1 [expression]" classname="expression" line="1">
+      <failure message="output of &lt;stdin&gt;" type="expression"><![CDATA[<stdin>:1:1: output of <stdin> [expression]]]></failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="a.yaml" tests="1" failures="0">
+    <testcase name="a.yaml" classname="actionlint"></testcase>
+  </testsuite>
+  <testsuite name="b.yaml" tests="1" failures="1">
+    <testcase name="b.yaml:3:5 [syntax-check]" classname="syntax-check" file="b.yaml" line="3">
+      <failure message="unexpected key &#34;branch&#34; for &#34;push&#34; section" type="syntax-check"><![CDATA[b.yaml:3:5: unexpected key "branch" for "push" section [syntax-check]
+    branch: main
+    ^~~~~~~]]></failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestErrorRendererJUnitPerFile(t *testing.T) {
+	r := &ErrorReport{
+		Files: []string{"a.yaml", "b.yaml"},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:   `unexpected key "branch" for "push" section`,
+				Filepath:  "b.yaml",
+				Line:      3,
+				Column:    5,
+				Kind:      "syntax-check",
+				Snippet:   "    branch: main\n    ^~~~~~~",
+				EndColumn: 11,
+			},
+			{
+				Message:  "property \"foo\" is not defined",
+				Filepath: "b.yaml",
+				Line:     7,
+				Column:   12,
+				Kind:     "expression",
+				Severity: SeverityWarning,
+			},
+			{
+				Message:  "output of <stdin>",
+				Line:     1,
+				Column:   1,
+				Kind:     "expression",
+				Snippet:  "",
+				Filepath: "",
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := (&JUnitErrorRenderer{PerFile: true}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="actionlint" tests="3" failures="2">
+  <testsuite name="actionlint" tests="3" failures="2">
+    <testcase name="&lt;stdin&gt;" classname="actionlint">
+      <failure message="1 error found" type="actionlint"><![CDATA[<stdin>:1:1: output of <stdin> [expression]]]></failure>
+    </testcase>
+    <testcase name="a.yaml" classname="actionlint" file="a.yaml"></testcase>
+    <testcase name="b.yaml" classname="actionlint" file="b.yaml">
+      <failure message="2 errors found" type="actionlint"><![CDATA[b.yaml:3:5: unexpected key "branch" for "push" section [syntax-check]
+    branch: main
+    ^~~~~~~
+b.yaml:7:12: warning: property "foo" is not defined [expression]]]></failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	if l, ok := LookupErrorRenderer("junit-file"); !ok {
+		t.Fatal("\"junit-file\" renderer is not registered")
+	} else if j, ok := l.(*JUnitErrorRenderer); !ok || !j.PerFile {
+		t.Fatalf("\"junit-file\" renderer does not output test cases per file: %#v", l)
+	}
+}
+
+func TestErrorRendererCheckstyle(t *testing.T) {
+	r := &ErrorReport{
+		Files: []string{"", "a.yaml", "b.yaml"},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:  "output of <stdin>",
+				Line:     1,
+				Column:   1,
+				Kind:     "expression",
+				Filepath: "",
+			},
+			{
+				Message:   `unexpected key "branch" for "push" section`,
+				Filepath:  "b.yaml",
+				Line:      3,
+				Column:    5,
+				Kind:      "syntax-check",
+				Snippet:   "    branch: main\n    ^~~~~~~",
+				EndColumn: 11,
+			},
+			{
+				Message:  `label "foo" is unknown`,
+				Filepath: "b.yaml",
+				Line:     6,
+				Column:   14,
+				Kind:     "runner-label",
+				Severity: SeverityWarning,
+			},
+			{
+				Message:  "step name is not set",
+				Filepath: "b.yaml",
+				Line:     8,
+				Column:   9,
+				Kind:     "step-name",
+				Severity: SeverityNote,
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := (&CheckstyleErrorRenderer{}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="&lt;stdin&gt;">
+    <error line="1" column="1" severity="error" message="output of &lt;stdin&gt; [expression]" source="actionlint.expression"></error>
+  </file>
+  <file name="a.yaml"></file>
+  <file name="b.yaml">
+    <error line="3" column="5" severity="error" message="unexpected key &#34;branch&#34; for &#34;push&#34; section [syntax-check]" source="actionlint.syntax-check"></error>
+    <error line="6" column="14" severity="warning" message="label &#34;foo&#34; is unknown [runner-label]" source="actionlint.runner-label"></error>
+    <error line="8" column="9" severity="info" message="step name is not set [step-name]" source="actionlint.step-name"></error>
+  </file>
+</checkstyle>
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestErrorRendererGitHub(t *testing.T) {
+	r := &ErrorReport{
+		Files: []string{"", "a.yaml", "dir,1/b.yaml"},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:   "100% of the output\nis from <stdin>",
+				Line:      1,
+				Column:    1,
+				EndColumn: 1,
+				Kind:      "expression",
+			},
+			{
+				Message:   `unexpected key "branch" for "push" section`,
+				Filepath:  "dir,1/b.yaml",
+				Line:      3,
+				Column:    5,
+				EndColumn: 11,
+				Kind:      "syntax-check",
+			},
+			{
+				Message:  `label "foo" is unknown`,
+				Filepath: "dir,1/b.yaml",
+				Line:     6,
+				Column:   14,
+				Kind:     "runner-label",
+				Severity: SeverityWarning,
+			},
+			{
+				Message:  "step name is not set",
+				Filepath: "dir,1/b.yaml",
+				Line:     8,
+				Column:   9,
+				Kind:     "step-name",
+				Severity: SeverityNote,
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := (&GitHubErrorRenderer{}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `::error line=1,col=1,title=actionlint (expression)::100%25 of the output%0Ais from <stdin> [expression]
+::error file=dir%2C1/b.yaml,line=3,col=5,endColumn=11,title=actionlint (syntax-check)::unexpected key "branch" for "push" section [syntax-check]
+::warning file=dir%2C1/b.yaml,line=6,col=14,title=actionlint (runner-label)::label "foo" is unknown [runner-label]
+::notice file=dir%2C1/b.yaml,line=8,col=9,title=actionlint (step-name)::step name is not set [step-name]
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestErrorRendererTAP(t *testing.T) {
+	r := &ErrorReport{
+		Files: []string{"", "a.yaml", "b.yaml", "c.yaml"},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:  "output of <stdin>",
+				Line:     1,
+				Column:   1,
+				Kind:     "expression",
+				Filepath: "",
+			},
+			{
+				Message:   `unexpected key "branch" for "push" section`,
+				Filepath:  "b.yaml",
+				Line:      3,
+				Column:    5,
+				Kind:      "syntax-check",
+				Snippet:   "    branch: main\n    ^~~~~~~",
+				EndColumn: 11,
+			},
+			{
+				Message:  `label "foo" is unknown`,
+				Filepath: "b.yaml",
+				Line:     6,
+				Column:   14,
+				Kind:     "runner-label",
+				Severity: SeverityWarning,
+			},
+			{
+				Message:  "step name is not set",
+				Filepath: "c.yaml",
+				Line:     8,
+				Column:   9,
+				Kind:     "step-name",
+				Severity: SeverityNote,
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := (&TAPErrorRenderer{}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `TAP version 13
+1..4
+not ok 1 - <stdin>
+  ---
+  errors:
+    - message: output of <stdin>
+      line: 1
+      column: 1
+      kind: expression
+      severity: error
+  ...
+ok 2 - a.yaml
+not ok 3 - b.yaml
+  ---
+  errors:
+    - message: unexpected key "branch" for "push" section
+      line: 3
+      column: 5
+      kind: syntax-check
+      severity: error
+    - message: label "foo" is unknown
+      line: 6
+      column: 14
+      kind: runner-label
+      severity: warning
+  ...
+ok 4 - c.yaml
+  ---
+  errors:
+    - message: step name is not set
+      line: 8
+      column: 9
+      kind: step-name
+      severity: note
+  ...
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestErrorRendererSARIF(t *testing.T) {
+	r := &ErrorReport{
+		Files: []string{"a.yaml", filepath.Join("dir", "b.yaml")},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:     `unexpected key "branch" for "push" section`,
+				Filepath:    filepath.Join("dir", "b.yaml"),
+				Line:        3,
+				Column:      5,
+				Kind:        "syntax-check",
+				EndColumn:   11,
+				Fingerprint: "0123abcd",
+				Events:      []string{"pull_request", "push"},
+			},
+			{
+				Message:  "error from my rule",
+				Line:     1,
+				Column:   1,
+				Kind:     "my-rule",
+				Severity: SeverityWarning,
+			},
+		},
+		Sources: map[string][]byte{
+			filepath.Join("dir", "b.yaml"): []byte("on:\n  push:\n    branch: main\n"),
+		},
+		Rules: []*ErrorReportRule{
+			{"expression", "Checks for expressions"},
+			{"syntax-check", "Checks for syntax"},
+		},
+	}
+
+	var b strings.Builder
+	if err := (&SARIFErrorRenderer{}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+  "$schema": "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "GitHub Actions lint",
+          "version": ` + strconv.Quote(getCommandVersion()) + `,
+          "informationUri": "https://github.com/rhysd/actionlint",
+          "rules": [
+            {
+              "id": "expression",
+              "name": "Expression",
+              "shortDescription": {
+                "text": "Checks for expressions"
+              },
+              "fullDescription": {
+                "text": "Checks for expressions"
+              },
+              "helpUri": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md#check-syntax-expression",
+              "defaultConfiguration": {
+                "level": "error"
+              }
+            },
+            {
+              "id": "syntax-check",
+              "name": "SyntaxCheck",
+              "shortDescription": {
+                "text": "Checks for syntax"
+              },
+              "fullDescription": {
+                "text": "Checks for syntax"
+              },
+              "helpUri": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md#check-unexpected-keys",
+              "defaultConfiguration": {
+                "level": "error"
+              }
+            },
+            {
+              "id": "my-rule",
+              "name": "MyRule",
+              "helpUri": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md",
+              "defaultConfiguration": {
+                "level": "error"
+              }
+            }
+          ]
+        }
+      },
+      "columnKind": "unicodeCodePoints",
+      "results": [
+        {
+          "ruleId": "syntax-check",
+          "ruleIndex": 1,
+          "level": "error",
+          "message": {
+            "text": "unexpected key \"branch\" for \"push\" section"
+          },
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "dir/b.yaml",
+                  "uriBaseId": "%SRCROOT%"
+                },
+                "region": {
+                  "startLine": 3,
+                  "startColumn": 5,
+                  "endLine": 3,
+                  "endColumn": 12,
+                  "snippet": {
+                    "text": "    branch: main"
+                  }
+                }
+              }
+            }
+          ],
+          "partialFingerprints": {
+            "actionlint/v1": "0123abcd"
+          },
+          "properties": {
+            "events": [
+              "pull_request",
+              "push"
+            ]
+          }
+        },
+        {
+          "ruleId": "my-rule",
+          "ruleIndex": 2,
+          "level": "warning",
+          "message": {
+            "text": "error from my rule"
+          }
+        }
+      ]
+    }
+  ]
+}
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestErrorRendererRDJSON(t *testing.T) {
+	r := &ErrorReport{
+		Files: []string{"", "a.yaml", filepath.Join("dir", "b.yaml")},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:   "output of <stdin>",
+				Line:      1,
+				Column:    1,
+				Kind:      "my-rule",
+				EndColumn: 1,
+				Severity:  SeverityNote,
+			},
+			{
+				Message:   `name "日本" is not allowed`,
+				Filepath:  "a.yaml",
+				Line:      1,
+				Column:    7,
+				Kind:      "expression",
+				EndColumn: 8,
+				Severity:  SeverityWarning,
+			},
+			{
+				Message:   `unexpected key "branch" for "push" section`,
+				Filepath:  filepath.Join("dir", "b.yaml"),
+				Line:      3,
+				Column:    5,
+				Kind:      "syntax-check",
+				EndColumn: 10,
+				Fixes:     []*TextEdit{{Start: 16, End: 22, NewText: "branches"}},
+			},
+		},
+		Sources: map[string][]byte{
+			"a.yaml":                       []byte("name: 日本\n"),
+			filepath.Join("dir", "b.yaml"): []byte("on:\n  push:\n    branch: main\n"),
+		},
+	}
+
+	var b strings.Builder
+	if err := (&RDJSONErrorRenderer{}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+  "source": {
+    "name": "actionlint",
+    "url": "https://github.com/rhysd/actionlint"
+  },
+  "diagnostics": [
+    {
+      "message": "output of \u003cstdin\u003e",
+      "location": {
+        "path": "",
+        "range": {
+          "start": {
+            "line": 1,
+            "column": 1
+          },
+          "end": {
+            "line": 1,
+            "column": 2
+          }
+        }
+      },
+      "severity": "INFO",
+      "code": {
+        "value": "my-rule",
+        "url": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md"
+      }
+    },
+    {
+      "message": "name \"日本\" is not allowed",
+      "location": {
+        "path": "a.yaml",
+        "range": {
+          "start": {
+            "line": 1,
+            "column": 7
+          },
+          "end": {
+            "line": 1,
+            "column": 13
+          }
+        }
+      },
+      "severity": "WARNING",
+      "code": {
+        "value": "expression",
+        "url": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md#check-syntax-expression"
+      }
+    },
+    {
+      "message": "unexpected key \"branch\" for \"push\" section",
+      "location": {
+        "path": "dir/b.yaml",
+        "range": {
+          "start": {
+            "line": 3,
+            "column": 5
+          },
+          "end": {
+            "line": 3,
+            "column": 11
+          }
+        }
+      },
+      "severity": "ERROR",
+      "code": {
+        "value": "syntax-check",
+        "url": "https://github.com/rhysd/actionlint/blob/main/docs/checks.md#check-unexpected-keys"
+      },
+      "suggestions": [
+        {
+          "range": {
+            "start": {
+              "line": 3,
+              "column": 5
+            },
+            "end": {
+              "line": 3,
+              "column": 11
+            }
+          },
+          "text": "branches"
+        }
+      ]
+    }
+  ]
+}
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestErrorRendererHTML(t *testing.T) {
+	caller := `on: push
+jobs:
+  call:
+    uses: ./.github/workflows/reusable.yaml
+`
+	r := &ErrorReport{
+		Files: []string{"a.yaml", "b.yaml"},
+		Errors: []*ErrorTemplateFields{
+			{
+				Message:  `unexpected key "branch" for "push" section`,
+				Filepath: "b.yaml",
+				Line:     3,
+				Column:   5,
+				Kind:     "syntax-check",
+				Snippet:  "    branch: <main>\n    ^~~~~~~",
+			},
+			{
+				Message:  "property \"msg\" is not defined",
+				Filepath: "b.yaml",
+				Line:     9,
+				Column:   23,
+				Kind:     "expression",
+			},
+			{
+				Message:  "another syntax error",
+				Filepath: "b.yaml",
+				Line:     10,
+				Column:   9,
+				Kind:     "syntax-check",
+			},
+		},
+		Sources: map[string][]byte{
+			"a.yaml": []byte(caller),
+		},
+	}
+
+	var b strings.Builder
+	if err := (&HTMLErrorRenderer{}).Render(&b, r); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		"<span>2 files</span><span>3 errors</span><span>2 rules</span>",
+		`<tr><td><code>syntax-check</code></td><td><div class="bar" style="width: 100%"></div></td><td>2</td></tr>`,
+		`<tr><td><code>expression</code></td><td><div class="bar" style="width: 50%"></div></td><td>1</td></tr>`,
+		`<tr data-rule="syntax-check" data-file="b.yaml"><td>b.yaml</td><td>3</td><td>5</td>`,
+		"unexpected key &#34;branch&#34; for &#34;push&#34; section<pre>    branch: &lt;main&gt;",
+		"<tr><td>a.yaml</td><td><code>call</code></td><td>./.github/workflows/reusable.yaml</td></tr>",
+		"<tr><td>a.yaml</td><td>0</td></tr>",
+		"<tr><td>b.yaml</td><td>3</td></tr>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestErrorRendererHTMLNoError(t *testing.T) {
+	var b strings.Builder
+	if err := (&HTMLErrorRenderer{}).Render(&b, &ErrorReport{Files: []string{""}}); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, want := range []string{"No error was found.", "<tr><td>&lt;stdin&gt;</td><td>0</td></tr>"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "<script>") {
+		t.Errorf("filters should not be output when no error was found:\n%s", out)
+	}
+}
+
+type testErrorRenderer struct {
+	report *ErrorReport
+}
+
+func (r *testErrorRenderer) Render(out io.Writer, report *ErrorReport) error {
+	r.report = report
+	_, err := io.WriteString(out, "rendered")
+	return err
+}
+
+func TestErrorRendererRegisterCustomRenderer(t *testing.T) {
+	r := &testErrorRenderer{}
+	RegisterErrorRenderer("test-renderer", r)
+	defer func() {
+		errorRenderersMu.Lock()
+		delete(errorRenderers, "test-renderer")
+		errorRenderersMu.Unlock()
+	}()
+
+	if have, ok := LookupErrorRenderer("test-renderer"); !ok || have != r {
+		t.Fatalf("registered renderer was not found: %v", have)
+	}
+	if want, have := []string{"checkstyle", "github", "html", "junit", "junit-file", "rdjson", "sarif", "tap", "test-renderer"}, ErrorRendererNames(); !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	var b strings.Builder
+	l, err := NewLinter(&b, &LinterOptions{Renderer: "test-renderer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+	dir := filepath.Join("testdata", "format")
+	infile := filepath.Join(dir, "test.yaml")
+	errs, err := l.LintFile(infile, &Project{root: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != "rendered" {
+		t.Fatalf("output was not rendered by the renderer: %q", b.String())
+	}
+	if r.report == nil {
+		t.Fatal("renderer was not called")
+	}
+	if !cmp.Equal(r.report.Files, []string{infile}) {
+		t.Fatalf("unexpected files: %v", r.report.Files)
+	}
+	if len(r.report.Errors) != len(errs) {
+		t.Fatalf("wanted %d errors but got %d errors", len(errs), len(r.report.Errors))
+	}
+	for i, e := range r.report.Errors {
+		if e.Message != errs[i].Message || e.Snippet == "" {
+			t.Errorf("unexpected error at %d: %#v", i, e)
+		}
+	}
+	rules := map[string]string{}
+	for _, rule := range r.report.Rules {
+		rules[rule.Name] = rule.Description
+	}
+	for _, n := range []string{"syntax-check", "expression", "job-needs"} {
+		if rules[n] == "" {
+			t.Errorf("rule %q with description is not included in rules of the report: %v", n, rules)
+		}
+	}
+}
+
+func TestErrorRendererOptionErrors(t *testing.T) {
+	for _, tc := range []struct {
+		opts LinterOptions
+		want string
+	}{
+		{
+			opts: LinterOptions{Renderer: "unknown"},
+			want: `unknown renderer "unknown" to output errors. available renderers are "checkstyle", "github", "html", "junit", "junit-file", "rdjson", "sarif", "tap"`,
+		},
+		{
+			opts: LinterOptions{Renderer: "junit", Format: "{{json .}}"},
+			want: `renderer "junit" cannot be used with custom format template`,
+		},
+	} {
+		_, err := NewLinter(io.Discard, &tc.opts)
+		if err == nil {
+			t.Fatalf("error did not occur for %#v", tc.opts)
+		}
+		if have := err.Error(); have != tc.want {
+			t.Errorf("wanted %q but got %q", tc.want, have)
+		}
+	}
+}
@@ -226,6 +226,87 @@ func TestErrorPrettyPrint(t *testing.T) {
 	}
 }
 
+func TestErrorPrettyPrintWithContext(t *testing.T) {
+	src := "line 1\nline 2\nline 3\nline 4\nline 5\nline 6\nline 7\nline 8\nline 9\nline 10\n"
+	testCases := []struct {
+		what    string
+		line    int
+		context int
+		want    string
+	}{
+		{
+			what:    "no context",
+			line:    5,
+			context: 0,
+			want: `  |
+5 | line 5
+  | ^~~~`,
+		},
+		{
+			what:    "middle of source",
+			line:    5,
+			context: 2,
+			want: `  |
+3 | line 3
+4 | line 4
+5 | line 5
+  | ^~~~
+6 | line 6
+7 | line 7`,
+		},
+		{
+			what:    "start of source",
+			line:    1,
+			context: 2,
+			want: `  |
+1 | line 1
+  | ^~~~
+2 | line 2
+3 | line 3`,
+		},
+		{
+			what:    "end of source",
+			line:    10,
+			context: 2,
+			want: `   |
+ 8 | line 8
+ 9 | line 9
+10 | line 10
+   | ^~~~`,
+		},
+		{
+			what:    "line number width changes",
+			line:    9,
+			context: 1,
+			want: `   |
+ 8 | line 8
+ 9 | line 9
+   | ^~~~
+10 | line 10`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			err := &Error{
+				Message:  "oops",
+				Filepath: "filename.txt",
+				Line:     tc.line,
+				Column:   1,
+				Kind:     "kind",
+			}
+
+			var buf bytes.Buffer
+			err.PrettyPrintWithContext(&buf, []byte(src), tc.context)
+
+			want := fmt.Sprintf("filename.txt:%d:1: oops [kind]\n%s\n", tc.line, tc.want)
+			if have := buf.String(); have != want {
+				t.Fatalf("wanted:\n%q\n\nhave:\n%q", want, have)
+			}
+		})
+	}
+}
+
 func TestErrorSortErrorsByPosition(t *testing.T) {
 	testCases := [][]struct {
 		line int
@@ -373,6 +454,38 @@ func TestErrorGetTemplateFieldsColumnIsOutOfBounds(t *testing.T) {
 	}
 }
 
+func TestErrorFingerprint(t *testing.T) {
+	src := []byte("on: push\njobs:\n  test:\n    runs-on: ${{ env.OS }}\n")
+	indented := []byte("on: push\njobs:\n    test:\n        runs-on: ${{ env.OS }}\n")
+
+	err := errorAt(&Pos{4, 18}, "expression", `context "env" is not available at "jobs.test.runs-on"`)
+	fp := err.Fingerprint(src)
+	if !strings.HasPrefix(fp, "expression:") || strings.Count(fp, ":") != 2 {
+		t.Fatalf("unexpected fingerprint format: %q", fp)
+	}
+	if strings.Contains(fp, "env") || strings.Contains(fp, "runs-on") {
+		t.Fatalf("fingerprint should not contain contents of the error: %q", fp)
+	}
+	if have := err.Fingerprint(indented); have != fp {
+		t.Fatalf("fingerprint should not depend on indentation: %q vs %q", fp, have)
+	}
+
+	other := errorAt(&Pos{4, 18}, "expression", `context "env" is not available at "jobs.build.runs-on"`)
+	if have := other.Fingerprint(src); have != fp {
+		t.Fatalf("quoted values in message should not affect fingerprint: %q vs %q", fp, have)
+	}
+
+	other = errorAt(&Pos{3, 3}, "expression", `context "env" is not available at "jobs.test.runs-on"`)
+	if have := other.Fingerprint(src); have == fp {
+		t.Fatalf("fingerprint should depend on the source line: %q", have)
+	}
+
+	other = errorAt(&Pos{4, 18}, "expression", "some other message")
+	if have := other.Fingerprint(src); have == fp {
+		t.Fatalf("fingerprint should depend on the message: %q", have)
+	}
+}
+
 func TestErrorErrorToString(t *testing.T) {
 	err := &Error{
 		Message: "this is message",
@@ -0,0 +1,352 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ./scripts/generate-event-payloads ./event_payloads.go
+
+// ExampleEventPayload returns the example payload of the webhook event from EventPayloadExamples.
+// The second return value is false when no example is available for the event.
+func ExampleEventPayload(event string) (map[string]interface{}, bool) {
+	s, ok := EventPayloadExamples[event]
+	if !ok {
+		return nil, false
+	}
+	var p map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &p); err != nil {
+		panic(fmt.Sprintf("example payload of %q event is broken: %s", event, err)) // Unreachable since the payloads are generated
+	}
+	return p, true
+}
+
+// eventPayloadProps is a map from properties of `github.event` to the events whose payloads
+// include the properties. Properties which are included in payloads of most events such as
+// `github.event.repository` are not listed.
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads
+var eventPayloadProps = map[string][]string{
+	"check_run":         {"check_run"},
+	"check_suite":       {"check_suite"},
+	"client_payload":    {"repository_dispatch"},
+	"comment":           {"commit_comment", "discussion_comment", "issue_comment", "pull_request_review_comment"},
+	"commits":           {"push"},
+	"deployment":        {"deployment", "deployment_status"},
+	"deployment_status": {"deployment_status"},
+	"discussion":        {"discussion", "discussion_comment"},
+	"forkee":            {"fork"},
+	"head_commit":       {"push"},
+	"inputs":            {"workflow_dispatch"},
+	"issue":             {"issue_comment", "issues"},
+	"merge_group":       {"merge_group"},
+	"milestone":         {"milestone"},
+	"pages":             {"gollum"},
+	"pull_request":      {"pull_request", "pull_request_review", "pull_request_review_comment", "pull_request_target"},
+	"pusher":            {"push"},
+	"release":           {"release"},
+	"review":            {"pull_request_review"},
+	"schedule":          {"schedule"},
+	"workflow_run":      {"workflow_run"},
+}
+
+// eventGitHubProps is a map from properties of `github` context to the events which populate
+// them. The properties are empty strings on other events.
+// https://docs.github.com/en/actions/learn-github-actions/contexts#github-context
+var eventGitHubProps = map[string][]string{
+	"base_ref": {"pull_request", "pull_request_target"},
+	"head_ref": {"pull_request", "pull_request_target"},
+}
+
+// eventDependentProp returns the property like "github.event.pull_request" and the events which
+// populate it when the node accesses a property listed in eventPayloadProps or eventGitHubProps.
+func eventDependentProp(n ExprNode) (string, []string, bool) {
+	var receiver ExprNode
+	var prop string
+	switch n := n.(type) {
+	case *ObjectDerefNode:
+		receiver, prop = n.Receiver, n.Property
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok {
+			return "", nil, false
+		}
+		receiver, prop = n.Operand, s.Value
+	default:
+		return "", nil, false
+	}
+	prop = strings.ToLower(prop)
+
+	if v, ok := receiver.(*VariableNode); ok {
+		if strings.ToLower(v.Name) != "github" {
+			return "", nil, false
+		}
+		es, ok := eventGitHubProps[prop]
+		return "github." + prop, es, ok
+	}
+
+	d, ok := receiver.(*ObjectDerefNode)
+	if !ok || strings.ToLower(d.Property) != "event" {
+		return "", nil, false
+	}
+	if v, ok := d.Receiver.(*VariableNode); !ok || strings.ToLower(v.Name) != "github" {
+		return "", nil, false
+	}
+	es, ok := eventPayloadProps[prop]
+	return "github.event." + prop, es, ok
+}
+
+// eventPayloadTypes is a map from event names to types of `github.event` built from the example
+// payloads in EventPayloadExamples.
+var eventPayloadTypes map[string]*ObjectType
+
+var eventPayloadTypesOnce sync.Once
+
+func initEventPayloadTypes() {
+	eventPayloadTypesOnce.Do(func() {
+		ts := make(map[string]*ObjectType, len(EventPayloadExamples))
+		for e := range EventPayloadExamples {
+			p, _ := ExampleEventPayload(e)
+			ts[e] = typeOfPayloadValue(p).(*ObjectType)
+		}
+		eventPayloadTypes = ts
+	})
+}
+
+// typeOfPayloadValue returns the type of the value in the example payload. Objects are typed as
+// loose objects since an example payload doesn't contain all properties which can be populated.
+func typeOfPayloadValue(v interface{}) ExprType {
+	switch v := v.(type) {
+	case string:
+		return StringType{}
+	case float64:
+		return NumberType{}
+	case bool:
+		return BoolType{}
+	case []interface{}:
+		var elem ExprType
+		for _, e := range v {
+			t := typeOfPayloadValue(e)
+			if elem == nil {
+				elem = t
+			} else {
+				elem = elem.Merge(t)
+			}
+		}
+		if elem == nil {
+			elem = AnyType{}
+		}
+		return &ArrayType{Elem: elem}
+	case map[string]interface{}:
+		props := make(map[string]ExprType, len(v))
+		for k, e := range v {
+			props[strings.ToLower(k)] = typeOfPayloadValue(e)
+		}
+		return NewObjectType(props)
+	default:
+		return AnyType{} // null is typed as any since the value is usually populated in other payloads
+	}
+}
+
+// eventPayloadType returns the type of `github.event` on the workflow triggered by the events. The
+// type is built by merging the types of the example payloads of the events. Events without example
+// payloads only loosen the type. nil is returned when the payload is unknown such as a reusable
+// workflow whose payload is given by the caller.
+func eventPayloadType(events []string) *ObjectType {
+	if len(events) == 0 || contains(events, "workflow_call") {
+		return nil
+	}
+	initEventPayloadTypes()
+	var merged ExprType = NewEmptyObjectType()
+	for _, e := range events {
+		if t, ok := eventPayloadTypes[e]; ok {
+			merged = merged.Merge(t)
+		}
+	}
+	// Copy the properties since the merged type may be the cached type itself
+	ret := NewEmptyObjectType()
+	for n, t := range merged.(*ObjectType).Props {
+		ret.Props[n] = t
+	}
+	return ret
+}
+
+// githubTypeWithEventPayload returns a copy of the `github` context type whose `github.event` is
+// typed with the payloads of the events. Properties already typed such as `github.event.inputs`
+// of workflow_dispatch event remain as-is.
+func githubTypeWithEventPayload(github ExprType, events []string) ExprType {
+	payload := eventPayloadType(events)
+	if payload == nil {
+		return github
+	}
+	github = github.DeepCopy()
+	props := github.(*ObjectType).Props
+	if prev, ok := props["event"].(*ObjectType); ok {
+		for n, t := range prev.Props {
+			payload.Props[n] = t
+		}
+	}
+	props["event"] = payload
+	return github
+}
+
+// eventPayloadPropAbsent returns the property like "github.event.pull_request" and the events which
+// populate it when the node accesses a property of `github.event` which none of the events
+// populate. For example, `github.event.pull_request` is absent on "issues" event.
+func eventPayloadPropAbsent(n ExprNode, events []string) (string, []string, bool) {
+	if len(events) == 0 || contains(events, "workflow_call") {
+		return "", nil, false
+	}
+	prop, available, ok := eventDependentProp(n)
+	if !ok || !strings.HasPrefix(prop, "github.event.") || containsAny(available, events) {
+		return "", nil, false
+	}
+	return prop, available, true
+}
+
+func payloadString(v interface{}, path ...string) string {
+	for _, p := range path {
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		v = o[p]
+	}
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// refOfPayload returns `github.ref` and `github.sha` of the workflow run triggered by the event.
+// https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows
+func refOfPayload(event string, p map[string]interface{}) (string, string) {
+	switch event {
+	case "push":
+		return payloadString(p, "ref"), payloadString(p, "after")
+	case "pull_request", "pull_request_review", "pull_request_review_comment":
+		sha := payloadString(p, "pull_request", "merge_commit_sha")
+		if sha == "" {
+			sha = payloadString(p, "pull_request", "head", "sha")
+		}
+		return fmt.Sprintf("refs/pull/%s/merge", payloadString(p, "pull_request", "number")), sha
+	case "pull_request_target":
+		return "refs/heads/" + payloadString(p, "pull_request", "base", "ref"), payloadString(p, "pull_request", "base", "sha")
+	case "release":
+		return "refs/tags/" + payloadString(p, "release", "tag_name"), ""
+	case "create":
+		if payloadString(p, "ref_type") == "tag" {
+			return "refs/tags/" + payloadString(p, "ref"), ""
+		}
+		return "refs/heads/" + payloadString(p, "ref"), ""
+	case "workflow_dispatch":
+		return payloadString(p, "ref"), ""
+	case "merge_group":
+		return payloadString(p, "merge_group", "head_ref"), payloadString(p, "merge_group", "head_sha")
+	case "deployment":
+		return "refs/heads/" + payloadString(p, "deployment", "ref"), payloadString(p, "deployment", "sha")
+	default:
+		// Other events run on the last commit on the default branch
+		return "refs/heads/" + payloadString(p, "repository", "default_branch"), ""
+	}
+}
+
+// NewEventContexts creates the values of contexts available in expressions of the workflow run
+// triggered by the event with the payload. The result can be given to NewExprEvaluator. Values
+// which cannot be known from the payload such as `github.run_id` and `steps` are filled with
+// dummy values.
+func NewEventContexts(event string, payload map[string]interface{}) map[string]interface{} {
+	ref, sha := refOfPayload(event, payload)
+	refName, refType := ref, ""
+	for _, p := range []string{"refs/heads/", "refs/tags/", "refs/pull/"} {
+		if strings.HasPrefix(ref, p) {
+			refName = ref[len(p):]
+			refType = "branch"
+			if p == "refs/tags/" {
+				refType = "tag"
+			}
+			break
+		}
+	}
+	headRef, baseRef := "", ""
+	if strings.HasPrefix(event, "pull_request") {
+		headRef = payloadString(payload, "pull_request", "head", "ref")
+		baseRef = payloadString(payload, "pull_request", "base", "ref")
+	}
+	repo := payloadString(payload, "repository", "full_name")
+	actor := payloadString(payload, "sender", "login")
+
+	inputs := map[string]interface{}{}
+	if event == "workflow_dispatch" {
+		if i, ok := payload["inputs"].(map[string]interface{}); ok {
+			inputs = i
+		}
+	}
+
+	return map[string]interface{}{
+		"github": map[string]interface{}{
+			"action":           "__run",
+			"action_path":      "",
+			"actor":            actor,
+			"actor_id":         payloadString(payload, "sender", "id"),
+			"api_url":          "https://api.github.com",
+			"base_ref":         baseRef,
+			"event":            payload,
+			"event_name":       event,
+			"event_path":       "/home/runner/work/_temp/_github_workflow/event.json",
+			"graphql_url":      "https://api.github.com/graphql",
+			"head_ref":         headRef,
+			"job":              "job",
+			"ref":              ref,
+			"ref_name":         refName,
+			"ref_protected":    false,
+			"ref_type":         refType,
+			"repository":       repo,
+			"repository_id":    payloadString(payload, "repository", "id"),
+			"repository_owner": payloadString(payload, "repository", "owner", "login"),
+			"repositoryUrl":    "git://github.com/" + repo + ".git",
+			"retention_days":   "90",
+			"run_attempt":      "1",
+			"run_id":           "1",
+			"run_number":       "1",
+			"server_url":       "https://github.com",
+			"sha":              sha,
+			"token":            "***",
+			"triggering_actor": actor,
+			"workflow":         "CI",
+			"workflow_ref":     repo + "/.github/workflows/ci.yaml@" + ref,
+			"workspace":        "/home/runner/work/" + payloadString(payload, "repository", "name") + "/" + payloadString(payload, "repository", "name"),
+		},
+		"env":  map[string]interface{}{},
+		"vars": map[string]interface{}{},
+		"secrets": map[string]interface{}{
+			"GITHUB_TOKEN": "***",
+		},
+		"inputs": inputs,
+		"job": map[string]interface{}{
+			"status": "success",
+		},
+		"steps": map[string]interface{}{},
+		"runner": map[string]interface{}{
+			"arch":       "X64",
+			"name":       "GitHub Actions 1",
+			"os":         "Linux",
+			"temp":       "/home/runner/work/_temp",
+			"tool_cache": "/opt/hostedtoolcache",
+		},
+		"strategy": map[string]interface{}{
+			"fail-fast":    true,
+			"job-index":    float64(0),
+			"job-total":    float64(1),
+			"max-parallel": float64(1),
+		},
+		"matrix": map[string]interface{}{},
+		"needs":  map[string]interface{}{},
+	}
+}
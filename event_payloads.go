@@ -0,0 +1,26 @@
+// Code generated by actionlint/scripts/generate-event-payloads. DO NOT EDIT.
+
+package actionlint
+
+// EventPayloadExamples is a table from webhook event names to example payloads of the events in
+// JSON. This variable was generated by script at ./scripts/generate-event-payloads based on
+// https://github.com/octokit/webhooks/tree/main/payload-examples/api.github.com
+var EventPayloadExamples = map[string]string{
+	"check_run":                   "{\"action\":\"completed\",\"check_run\":{\"app\":{\"created_at\":\"2019-04-19T19:36:24Z\",\"description\":\"\",\"events\":[],\"html_url\":\"https://github.com/apps/octocoders-linter\",\"id\":29310,\"name\":\"octocoders-linter\",\"node_id\":\"MDExOkludGVncmF0aW9uMjkzMTA=\",\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"slug\":\"octocoders-linter\",\"updated_at\":\"2019-04-19T19:36:56Z\"},\"check_suite\":{\"after\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"app\":{\"created_at\":\"2019-04-19T19:36:24Z\",\"description\":\"\",\"events\":[],\"html_url\":\"https://github.com/apps/octocoders-linter\",\"id\":29310,\"name\":\"octocoders-linter\",\"node_id\":\"MDExOkludGVncmF0aW9uMjkzMTA=\",\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"slug\":\"octocoders-linter\",\"updated_at\":\"2019-04-19T19:36:56Z\"},\"before\":\"6113728f27ae82c7b1a177c8d03f9e96e0adf246\",\"conclusion\":\"success\",\"created_at\":\"2019-05-15T15:20:31Z\",\"head_branch\":\"changes\",\"head_sha\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"id\":118578147,\"node_id\":\"MDEwOkNoZWNrU3VpdGUxMTg1NzgxNDc=\",\"pull_requests\":[],\"status\":\"completed\",\"updated_at\":\"2019-05-15T15:21:14Z\"},\"completed_at\":\"2019-05-15T15:21:45Z\",\"conclusion\":\"success\",\"external_id\":\"\",\"head_sha\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"html_url\":\"https://github.com/Codertocat/Hello-World/runs/128620228\",\"id\":128620228,\"name\":\"Octocoders-linter\",\"node_id\":\"MDg6Q2hlY2tSdW4xMjg2MjAyMjg=\",\"output\":{\"annotations_count\":0,\"summary\":null,\"text\":null,\"title\":null},\"pull_requests\":[],\"started_at\":\"2019-05-15T15:21:12Z\",\"status\":\"completed\"},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"check_suite":                 "{\"action\":\"completed\",\"check_suite\":{\"after\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"app\":{\"created_at\":\"2019-04-19T19:36:24Z\",\"description\":\"\",\"events\":[],\"html_url\":\"https://github.com/apps/octocoders-linter\",\"id\":29310,\"name\":\"octocoders-linter\",\"node_id\":\"MDExOkludGVncmF0aW9uMjkzMTA=\",\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"slug\":\"octocoders-linter\",\"updated_at\":\"2019-04-19T19:36:56Z\"},\"before\":\"6113728f27ae82c7b1a177c8d03f9e96e0adf246\",\"conclusion\":\"success\",\"created_at\":\"2019-05-15T15:20:31Z\",\"head_branch\":\"changes\",\"head_sha\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"id\":118578147,\"node_id\":\"MDEwOkNoZWNrU3VpdGUxMTg1NzgxNDc=\",\"pull_requests\":[],\"status\":\"completed\",\"updated_at\":\"2019-05-15T15:21:14Z\"},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"create":                      "{\"description\":null,\"master_branch\":\"master\",\"pusher_type\":\"user\",\"ref\":\"simple-tag\",\"ref_type\":\"tag\",\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"delete":                      "{\"pusher_type\":\"user\",\"ref\":\"simple-tag\",\"ref_type\":\"tag\",\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"deployment":                  "{\"action\":\"created\",\"deployment\":{\"created_at\":\"2019-05-15T15:20:53Z\",\"creator\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"description\":null,\"environment\":\"production\",\"id\":145988746,\"node_id\":\"MDEwOkRlcGxveW1lbnQxNDU5ODg3NDY=\",\"original_environment\":\"production\",\"payload\":{},\"ref\":\"master\",\"sha\":\"f95f852bd8fca8fcc58a9a2d6c842781e32a215e\",\"task\":\"deploy\",\"updated_at\":\"2019-05-15T15:20:53Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/deployments/145988746\"},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"discussion":                  "{\"action\":\"created\",\"discussion\":{\"active_lock_reason\":null,\"answer_chosen_at\":null,\"answer_chosen_by\":null,\"author_association\":\"OWNER\",\"body\":\"We're glad to have you here!\",\"category\":{\"created_at\":\"2021-05-21T14:48:58.000-04:00\",\"description\":\"Chat about anything and everything here\",\"emoji\":\":hash:\",\"id\":55646,\"is_answerable\":false,\"name\":\"General\",\"node_id\":\"MDE4OkRpc2N1c3Npb25DYXRlZ29yeTU1NjQ2\",\"repository_id\":186853002,\"slug\":\"general\",\"updated_at\":\"2021-05-21T14:48:58.000-04:00\"},\"comments\":0,\"created_at\":\"2021-07-02T15:21:00Z\",\"html_url\":\"https://github.com/Codertocat/Hello-World/discussions/90\",\"id\":3463158,\"locked\":false,\"node_id\":\"MDEwOkRpc2N1c3Npb24zNDYzMTU4\",\"number\":90,\"state\":\"open\",\"title\":\"Welcome to discussions!\",\"updated_at\":\"2021-07-02T15:21:00Z\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"issue_comment":               "{\"action\":\"created\",\"comment\":{\"author_association\":\"OWNER\",\"body\":\"You are totally right! I'll get this fixed right away.\",\"created_at\":\"2019-05-15T15:20:21Z\",\"html_url\":\"https://github.com/Codertocat/Hello-World/issues/1#issuecomment-492700400\",\"id\":492700400,\"node_id\":\"MDEyOklzc3VlQ29tbWVudDQ5MjcwMDQwMA==\",\"updated_at\":\"2019-05-15T15:20:21Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/issues/comments/492700400\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"issue\":{\"assignee\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"assignees\":[{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}],\"author_association\":\"OWNER\",\"body\":\"It looks like you accidently spelled 'commit' with two 't's.\",\"closed_at\":null,\"comments\":0,\"created_at\":\"2019-05-15T15:20:18Z\",\"html_url\":\"https://github.com/Codertocat/Hello-World/issues/1\",\"id\":444500041,\"labels\":[{\"color\":\"d73a4a\",\"default\":true,\"description\":\"Something isn't working\",\"id\":1362934389,\"name\":\"bug\",\"node_id\":\"MDU6TGFiZWwxMzYyOTM0Mzg5\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/labels/bug\"}],\"locked\":false,\"milestone\":null,\"node_id\":\"MDU6SXNzdWU0NDQ1MDAwNDE=\",\"number\":1,\"state\":\"open\",\"title\":\"Spelling error in the README file\",\"updated_at\":\"2019-05-15T15:20:18Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/issues/1\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"issues":                      "{\"action\":\"opened\",\"issue\":{\"assignee\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"assignees\":[{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}],\"author_association\":\"OWNER\",\"body\":\"It looks like you accidently spelled 'commit' with two 't's.\",\"closed_at\":null,\"comments\":0,\"created_at\":\"2019-05-15T15:20:18Z\",\"html_url\":\"https://github.com/Codertocat/Hello-World/issues/1\",\"id\":444500041,\"labels\":[{\"color\":\"d73a4a\",\"default\":true,\"description\":\"Something isn't working\",\"id\":1362934389,\"name\":\"bug\",\"node_id\":\"MDU6TGFiZWwxMzYyOTM0Mzg5\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/labels/bug\"}],\"locked\":false,\"milestone\":null,\"node_id\":\"MDU6SXNzdWU0NDQ1MDAwNDE=\",\"number\":1,\"state\":\"open\",\"title\":\"Spelling error in the README file\",\"updated_at\":\"2019-05-15T15:20:18Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/issues/1\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"merge_group":                 "{\"action\":\"checks_requested\",\"merge_group\":{\"base_ref\":\"refs/heads/master\",\"base_sha\":\"f95f852bd8fca8fcc58a9a2d6c842781e32a215e\",\"head_commit\":{\"author\":{\"email\":\"21031067+Codertocat@users.noreply.github.com\",\"name\":\"Codertocat\"},\"committer\":{\"email\":\"noreply@github.com\",\"name\":\"GitHub\"},\"id\":\"4fd3bf8f41bf6047da3a5dc5b9fe9bde6d2f0d9a\",\"message\":\"Update the README with new information.\",\"timestamp\":\"2022-11-09T14:03:01Z\",\"tree_id\":\"d23f6eedb1e1b9610bbc754ddb5197bfe7271223\"},\"head_ref\":\"refs/heads/gh-readonly-queue/master/pr-2-f95f852bd8fca8fcc58a9a2d6c842781e32a215e\",\"head_sha\":\"4fd3bf8f41bf6047da3a5dc5b9fe9bde6d2f0d9a\"},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"pull_request":                "{\"action\":\"opened\",\"number\":2,\"pull_request\":{\"additions\":1,\"assignee\":null,\"assignees\":[],\"author_association\":\"OWNER\",\"auto_merge\":null,\"base\":{\"label\":\"Codertocat:master\",\"ref\":\"master\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"f95f852bd8fca8fcc58a9a2d6c842781e32a215e\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"body\":\"This is a pretty simple change that we need to pull into master.\",\"changed_files\":1,\"closed_at\":null,\"comments\":0,\"commits\":1,\"created_at\":\"2019-05-15T15:20:33Z\",\"deletions\":1,\"draft\":false,\"head\":{\"label\":\"Codertocat:changes\",\"ref\":\"changes\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"html_url\":\"https://github.com/Codertocat/Hello-World/pull/2\",\"id\":279147437,\"labels\":[],\"locked\":false,\"maintainer_can_modify\":false,\"merge_commit_sha\":null,\"mergeable\":null,\"mergeable_state\":\"unknown\",\"merged\":false,\"merged_at\":null,\"merged_by\":null,\"milestone\":null,\"node_id\":\"MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3\",\"number\":2,\"rebaseable\":null,\"requested_reviewers\":[],\"requested_teams\":[],\"review_comments\":0,\"state\":\"open\",\"title\":\"Update the README with new information.\",\"updated_at\":\"2019-05-15T15:20:33Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/pulls/2\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"pull_request_review":         "{\"action\":\"submitted\",\"pull_request\":{\"additions\":1,\"assignee\":null,\"assignees\":[],\"author_association\":\"OWNER\",\"auto_merge\":null,\"base\":{\"label\":\"Codertocat:master\",\"ref\":\"master\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"f95f852bd8fca8fcc58a9a2d6c842781e32a215e\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"body\":\"This is a pretty simple change that we need to pull into master.\",\"changed_files\":1,\"closed_at\":null,\"comments\":0,\"commits\":1,\"created_at\":\"2019-05-15T15:20:33Z\",\"deletions\":1,\"draft\":false,\"head\":{\"label\":\"Codertocat:changes\",\"ref\":\"changes\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"html_url\":\"https://github.com/Codertocat/Hello-World/pull/2\",\"id\":279147437,\"labels\":[],\"locked\":false,\"maintainer_can_modify\":false,\"merge_commit_sha\":null,\"mergeable\":null,\"mergeable_state\":\"unknown\",\"merged\":false,\"merged_at\":null,\"merged_by\":null,\"milestone\":null,\"node_id\":\"MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3\",\"number\":2,\"rebaseable\":null,\"requested_reviewers\":[],\"requested_teams\":[],\"review_comments\":0,\"state\":\"open\",\"title\":\"Update the README with new information.\",\"updated_at\":\"2019-05-15T15:20:33Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/pulls/2\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"review\":{\"author_association\":\"OWNER\",\"body\":null,\"commit_id\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"html_url\":\"https://github.com/Codertocat/Hello-World/pull/2#pullrequestreview-237895671\",\"id\":237895671,\"node_id\":\"MDE3OlB1bGxSZXF1ZXN0UmV2aWV3MjM3ODk1Njcx\",\"state\":\"commented\",\"submitted_at\":\"2019-05-15T15:20:38Z\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"pull_request_review_comment": "{\"action\":\"created\",\"comment\":{\"author_association\":\"OWNER\",\"body\":\"Maybe you should use more emoji on this line.\",\"commit_id\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"created_at\":\"2019-05-15T15:20:37Z\",\"diff_hunk\":\"@@ -1 +1 @@\\n-# Hello-World\",\"html_url\":\"https://github.com/Codertocat/Hello-World/pull/2#discussion_r284312630\",\"id\":284312630,\"line\":1,\"node_id\":\"MDI0OlB1bGxSZXF1ZXN0UmV2aWV3Q29tbWVudDI4NDMxMjYzMA==\",\"original_commit_id\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"original_position\":1,\"path\":\"README.md\",\"position\":1,\"pull_request_review_id\":237895671,\"side\":\"RIGHT\",\"updated_at\":\"2019-05-15T15:20:38Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/pulls/comments/284312630\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"pull_request\":{\"additions\":1,\"assignee\":null,\"assignees\":[],\"author_association\":\"OWNER\",\"auto_merge\":null,\"base\":{\"label\":\"Codertocat:master\",\"ref\":\"master\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"f95f852bd8fca8fcc58a9a2d6c842781e32a215e\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"body\":\"This is a pretty simple change that we need to pull into master.\",\"changed_files\":1,\"closed_at\":null,\"comments\":0,\"commits\":1,\"created_at\":\"2019-05-15T15:20:33Z\",\"deletions\":1,\"draft\":false,\"head\":{\"label\":\"Codertocat:changes\",\"ref\":\"changes\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"html_url\":\"https://github.com/Codertocat/Hello-World/pull/2\",\"id\":279147437,\"labels\":[],\"locked\":false,\"maintainer_can_modify\":false,\"merge_commit_sha\":null,\"mergeable\":null,\"mergeable_state\":\"unknown\",\"merged\":false,\"merged_at\":null,\"merged_by\":null,\"milestone\":null,\"node_id\":\"MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3\",\"number\":2,\"rebaseable\":null,\"requested_reviewers\":[],\"requested_teams\":[],\"review_comments\":0,\"state\":\"open\",\"title\":\"Update the README with new information.\",\"updated_at\":\"2019-05-15T15:20:33Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/pulls/2\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"pull_request_target":         "{\"action\":\"opened\",\"number\":2,\"pull_request\":{\"additions\":1,\"assignee\":null,\"assignees\":[],\"author_association\":\"OWNER\",\"auto_merge\":null,\"base\":{\"label\":\"Codertocat:master\",\"ref\":\"master\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"f95f852bd8fca8fcc58a9a2d6c842781e32a215e\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"body\":\"This is a pretty simple change that we need to pull into master.\",\"changed_files\":1,\"closed_at\":null,\"comments\":0,\"commits\":1,\"created_at\":\"2019-05-15T15:20:33Z\",\"deletions\":1,\"draft\":false,\"head\":{\"label\":\"Codertocat:changes\",\"ref\":\"changes\",\"repo\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sha\":\"ec26c3e57ca3a959ca5aad62de7213c562f8c821\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"html_url\":\"https://github.com/Codertocat/Hello-World/pull/2\",\"id\":279147437,\"labels\":[],\"locked\":false,\"maintainer_can_modify\":false,\"merge_commit_sha\":null,\"mergeable\":null,\"mergeable_state\":\"unknown\",\"merged\":false,\"merged_at\":null,\"merged_by\":null,\"milestone\":null,\"node_id\":\"MDExOlB1bGxSZXF1ZXN0Mjc5MTQ3NDM3\",\"number\":2,\"rebaseable\":null,\"requested_reviewers\":[],\"requested_teams\":[],\"review_comments\":0,\"state\":\"open\",\"title\":\"Update the README with new information.\",\"updated_at\":\"2019-05-15T15:20:33Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/pulls/2\",\"user\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"push":                        "{\"after\":\"0000000000000000000000000000000000000000\",\"base_ref\":null,\"before\":\"6113728f27ae82c7b1a177c8d03f9e96e0adf246\",\"commits\":[],\"compare\":\"https://github.com/Codertocat/Hello-World/compare/6113728f27ae...000000000000\",\"created\":false,\"deleted\":true,\"forced\":false,\"head_commit\":null,\"pusher\":{\"email\":\"21031067+Codertocat@users.noreply.github.com\",\"name\":\"Codertocat\"},\"ref\":\"refs/tags/simple-tag\",\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"release":                     "{\"action\":\"published\",\"release\":{\"assets\":[],\"author\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"body\":null,\"created_at\":\"2019-05-15T15:19:27Z\",\"draft\":false,\"html_url\":\"https://github.com/Codertocat/Hello-World/releases/tag/0.0.1\",\"id\":11248810,\"name\":null,\"node_id\":\"MDc6UmVsZWFzZTExMjQ4ODEw\",\"prerelease\":false,\"published_at\":\"2019-05-15T15:20:53Z\",\"tag_name\":\"0.0.1\",\"target_commitish\":\"master\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/releases/11248810\"},\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"}}",
+	"workflow_dispatch":           "{\"inputs\":{\"name\":\"Mona the Octocat\"},\"ref\":\"refs/heads/master\",\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"workflow\":\".github/workflows/hello-world-workflow.yml\"}",
+	"workflow_run":                "{\"action\":\"completed\",\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"sender\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"workflow\":{\"created_at\":\"2020-01-22T19:33:08Z\",\"html_url\":\"https://github.com/Codertocat/Hello-World/blob/master/.github/workflows/build.yml\",\"id\":159038,\"name\":\"Build\",\"node_id\":\"MDg6V29ya2Zsb3cxNTkwMzg=\",\"path\":\".github/workflows/build.yml\",\"state\":\"active\",\"updated_at\":\"2020-01-22T19:33:08Z\"},\"workflow_run\":{\"actor\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"check_suite_id\":414944374,\"conclusion\":\"success\",\"created_at\":\"2020-01-22T19:33:08Z\",\"event\":\"push\",\"head_branch\":\"master\",\"head_commit\":{\"author\":{\"email\":\"21031067+Codertocat@users.noreply.github.com\",\"name\":\"Codertocat\"},\"committer\":{\"email\":\"noreply@github.com\",\"name\":\"GitHub\"},\"id\":\"acb5820ced9479c074f688cc328bf03f341a511d\",\"message\":\"Create linter.yaml\",\"timestamp\":\"2019-11-21T19:35:07Z\",\"tree_id\":\"d23f6eedb1e1b9610bbc754ddb5197bfe7271223\"},\"head_repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"head_sha\":\"acb5820ced9479c074f688cc328bf03f341a511d\",\"html_url\":\"https://github.com/Codertocat/Hello-World/actions/runs/30433642\",\"id\":30433642,\"name\":\"Build\",\"node_id\":\"MDEyOldvcmtmbG93IFJ1bjI2OTI4OQ==\",\"path\":\".github/workflows/build.yml\",\"pull_requests\":[],\"repository\":{\"archived\":false,\"created_at\":\"2019-05-15T15:19:25Z\",\"default_branch\":\"master\",\"description\":null,\"disabled\":false,\"fork\":false,\"forks\":1,\"forks_count\":1,\"full_name\":\"Codertocat/Hello-World\",\"has_downloads\":true,\"has_issues\":true,\"has_pages\":true,\"has_projects\":true,\"has_wiki\":true,\"homepage\":null,\"html_url\":\"https://github.com/Codertocat/Hello-World\",\"id\":186853002,\"language\":\"Ruby\",\"license\":null,\"name\":\"Hello-World\",\"node_id\":\"MDEwOlJlcG9zaXRvcnkxODY4NTMwMDI=\",\"open_issues\":2,\"open_issues_count\":2,\"owner\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"private\":false,\"pushed_at\":\"2019-05-15T15:20:32Z\",\"size\":0,\"stargazers_count\":0,\"topics\":[],\"updated_at\":\"2019-05-15T15:19:27Z\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World\",\"visibility\":\"public\",\"watchers\":0,\"watchers_count\":0},\"run_attempt\":1,\"run_number\":562,\"run_started_at\":\"2020-01-22T19:33:08Z\",\"status\":\"completed\",\"triggering_actor\":{\"html_url\":\"https://github.com/Codertocat\",\"id\":21031067,\"login\":\"Codertocat\",\"node_id\":\"MDQ6VXNlcjIxMDMxMDY3\",\"site_admin\":false,\"type\":\"User\",\"url\":\"https://api.github.com/users/Codertocat\"},\"updated_at\":\"2020-01-22T19:33:08Z\",\"workflow_id\":159038}}",
+}
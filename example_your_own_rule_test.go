@@ -63,3 +63,37 @@ func ExampleLinter_yourOwnRule() {
 	fmt.Println(len(errs), "lint errors found by actionlint")
 	// Output: 1 lint errors found by actionlint
 }
+
+func ExampleRegisterRule() {
+	// The factory registered by RegisterRule is called to create the rule instance for each workflow
+	// file. Errors reported by the rule are output, formatted, and filtered in the same way as
+	// errors of the built-in rules.
+	err := actionlint.RegisterRule("step-name", func(path string, src []byte) actionlint.Rule {
+		return NewRuleStepName()
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer actionlint.RegisterRule("step-name", nil) // Unregister the rule
+
+	// OnlyRules option can select the registered rule as well as the built-in rules.
+	o := &actionlint.LinterOptions{
+		OnlyRules: []string{"step-name"},
+	}
+
+	l, err := actionlint.NewLinter(io.Discard, o)
+	if err != nil {
+		panic(err)
+	}
+
+	f := filepath.Join("testdata", "ok", "minimal.yaml")
+	errs, err := l.LintFile(f, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, err := range errs {
+		fmt.Printf("%d:%d: %s [%s]\n", err.Line, err.Column, err.Message, err.Kind)
+	}
+	// Output: 6:9: every step must have its name [step-name]
+}
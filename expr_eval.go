@@ -0,0 +1,462 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// exprFiltered is an array created by object filter `.*`. Property accesses and index accesses to
+// the filtered array are applied to each element of the array.
+type exprFiltered []interface{}
+
+// ExprEvaluator evaluates expressions with the values of contexts in the same way as GitHub Actions
+// does. Values are represented as values decoded by encoding/json package: nil for null, bool,
+// float64, string, []interface{} for arrays, and map[string]interface{} for objects.
+// https://docs.github.com/en/actions/learn-github-actions/expressions
+type ExprEvaluator struct {
+	contexts map[string]interface{}
+}
+
+// NewExprEvaluator creates a new ExprEvaluator instance. The contexts parameter is a map from
+// context names like "github" to their values. Context names are case-insensitive.
+func NewExprEvaluator(contexts map[string]interface{}) *ExprEvaluator {
+	cs := make(map[string]interface{}, len(contexts))
+	for k, v := range contexts {
+		cs[strings.ToLower(k)] = v
+	}
+	return &ExprEvaluator{cs}
+}
+
+// Eval evaluates the syntax tree of the expression and returns the result value. Functions which
+// depend on the runner like `hashFiles()` cannot be evaluated. Status check functions are
+// evaluated assuming all previous steps succeeded.
+func (ev *ExprEvaluator) Eval(n ExprNode) (interface{}, *ExprError) {
+	v, err := ev.eval(n)
+	if err != nil {
+		return nil, err
+	}
+	if f, ok := v.(exprFiltered); ok {
+		return []interface{}(f), nil
+	}
+	return v, nil
+}
+
+func (ev *ExprEvaluator) eval(n ExprNode) (interface{}, *ExprError) {
+	switch n := n.(type) {
+	case *VariableNode:
+		v, ok := ev.contexts[strings.ToLower(n.Name)]
+		if !ok {
+			return nil, errorfAtExpr(n, "context %q is not available", n.Name)
+		}
+		return v, nil
+	case *NullNode:
+		return nil, nil
+	case *BoolNode:
+		return n.Value, nil
+	case *IntNode:
+		return float64(n.Value), nil
+	case *FloatNode:
+		return n.Value, nil
+	case *StringNode:
+		return n.Value, nil
+	case *ObjectDerefNode:
+		v, err := ev.eval(n.Receiver)
+		if err != nil {
+			return nil, err
+		}
+		return evalDeref(v, func(v interface{}) interface{} {
+			return evalProp(v, n.Property)
+		}), nil
+	case *ArrayDerefNode:
+		v, err := ev.eval(n.Receiver)
+		if err != nil {
+			return nil, err
+		}
+		return evalFilter(v), nil
+	case *IndexAccessNode:
+		v, err := ev.eval(n.Operand)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := ev.eval(n.Index)
+		if err != nil {
+			return nil, err
+		}
+		return evalDeref(v, func(v interface{}) interface{} {
+			return evalIndex(v, idx)
+		}), nil
+	case *NotOpNode:
+		v, err := ev.eval(n.Operand)
+		if err != nil {
+			return nil, err
+		}
+		return !exprTruthy(v), nil
+	case *CompareOpNode:
+		l, err := ev.eval(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := ev.eval(n.Right)
+		if err != nil {
+			return nil, err
+		}
+		return evalCompare(n.Kind, l, r), nil
+	case *LogicalOpNode:
+		l, err := ev.eval(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		// Both operators are short-circuit and return the operand value as-is
+		if exprTruthy(l) == (n.Kind == LogicalOpNodeKindOr) {
+			return l, nil
+		}
+		return ev.eval(n.Right)
+	case *FuncCallNode:
+		return ev.evalFuncCall(n)
+	default:
+		return nil, errorfAtExpr(n, "unknown expression node %T", n)
+	}
+}
+
+func (ev *ExprEvaluator) evalFuncCall(n *FuncCallNode) (interface{}, *ExprError) {
+	args := make([]interface{}, 0, len(n.Args))
+	for _, a := range n.Args {
+		v, err := ev.eval(a)
+		if err != nil {
+			return nil, err
+		}
+		if f, ok := v.(exprFiltered); ok {
+			v = []interface{}(f)
+		}
+		args = append(args, v)
+	}
+
+	name := strings.ToLower(n.Callee)
+	arity := func(min, max int) *ExprError {
+		if len(args) < min || max < len(args) {
+			return errorfAtExpr(n, "wrong number of arguments to %s(): %d", n.Callee, len(args))
+		}
+		return nil
+	}
+
+	switch name {
+	case "success", "always":
+		return true, arity(0, 0)
+	case "failure", "cancelled":
+		return false, arity(0, 0)
+	case "contains":
+		if err := arity(2, 2); err != nil {
+			return nil, err
+		}
+		if a, ok := args[0].([]interface{}); ok {
+			for _, e := range a {
+				if evalCompare(CompareOpNodeKindEq, e, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return strings.Contains(strings.ToLower(exprString(args[0])), strings.ToLower(exprString(args[1]))), nil
+	case "startswith":
+		if err := arity(2, 2); err != nil {
+			return nil, err
+		}
+		return strings.HasPrefix(strings.ToLower(exprString(args[0])), strings.ToLower(exprString(args[1]))), nil
+	case "endswith":
+		if err := arity(2, 2); err != nil {
+			return nil, err
+		}
+		return strings.HasSuffix(strings.ToLower(exprString(args[0])), strings.ToLower(exprString(args[1]))), nil
+	case "format":
+		if err := arity(1, math.MaxInt32); err != nil {
+			return nil, err
+		}
+		s, ok := evalFormat(exprString(args[0]), args[1:])
+		if !ok {
+			return nil, errorfAtExpr(n, "invalid format string %q with %d arguments", exprString(args[0]), len(args)-1)
+		}
+		return s, nil
+	case "join":
+		if err := arity(1, 2); err != nil {
+			return nil, err
+		}
+		sep := ","
+		if len(args) == 2 {
+			sep = exprString(args[1])
+		}
+		a, ok := args[0].([]interface{})
+		if !ok {
+			return exprString(args[0]), nil
+		}
+		ss := make([]string, 0, len(a))
+		for _, e := range a {
+			ss = append(ss, exprString(e))
+		}
+		return strings.Join(ss, sep), nil
+	case "tojson":
+		if err := arity(1, 1); err != nil {
+			return nil, err
+		}
+		b, err := json.MarshalIndent(args[0], "", "  ")
+		if err != nil {
+			return nil, errorfAtExpr(n, "could not convert value to JSON: %s", err)
+		}
+		return string(b), nil
+	case "fromjson":
+		if err := arity(1, 1); err != nil {
+			return nil, err
+		}
+		var v interface{}
+		if err := json.Unmarshal([]byte(exprString(args[0])), &v); err != nil {
+			return nil, errorfAtExpr(n, "could not parse %q as JSON: %s", exprString(args[0]), err)
+		}
+		return v, nil
+	case "hashfiles":
+		return nil, errorfAtExpr(n, "%s() cannot be evaluated since it depends on files in the runner", n.Callee)
+	default:
+		return nil, errorfAtExpr(n, "undefined function %q", n.Callee)
+	}
+}
+
+// evalDeref applies the access to the value. When the value is an array filtered by `.*`, the
+// access is applied to each element and null results are removed.
+func evalDeref(v interface{}, access func(interface{}) interface{}) interface{} {
+	f, ok := v.(exprFiltered)
+	if !ok {
+		return access(v)
+	}
+	ret := exprFiltered{}
+	for _, e := range f {
+		if r := access(e); r != nil {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+func evalProp(v interface{}, prop string) interface{} {
+	o, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if e, ok := o[prop]; ok {
+		return e
+	}
+	for k, e := range o {
+		if strings.EqualFold(k, prop) {
+			return e
+		}
+	}
+	return nil
+}
+
+func evalIndex(v, idx interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		f, ok := idx.(float64)
+		if !ok || f != math.Trunc(f) || f < 0 || int(f) >= len(v) {
+			return nil
+		}
+		return v[int(f)]
+	case map[string]interface{}:
+		s, ok := idx.(string)
+		if !ok {
+			return nil
+		}
+		return evalProp(v, s)
+	default:
+		return nil
+	}
+}
+
+func evalFilter(v interface{}) exprFiltered {
+	switch v := v.(type) {
+	case exprFiltered:
+		ret := exprFiltered{}
+		for _, e := range v {
+			ret = append(ret, evalFilter(e)...)
+		}
+		return ret
+	case []interface{}:
+		return exprFiltered(v)
+	case map[string]interface{}:
+		ks := make([]string, 0, len(v))
+		for k := range v {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+		ret := make(exprFiltered, 0, len(ks))
+		for _, k := range ks {
+			ret = append(ret, v[k])
+		}
+		return ret
+	default:
+		return exprFiltered{}
+	}
+}
+
+func evalCompare(kind CompareOpNodeKind, l, r interface{}) bool {
+	if f, ok := l.(exprFiltered); ok {
+		l = []interface{}(f)
+	}
+	if f, ok := r.(exprFiltered); ok {
+		r = []interface{}(f)
+	}
+
+	var c int
+	switch l := l.(type) {
+	case string:
+		s, ok := r.(string)
+		if !ok {
+			break
+		}
+		c = strings.Compare(strings.ToLower(l), strings.ToLower(s))
+		return compareResult(kind, c)
+	case []interface{}, map[string]interface{}:
+		if reflect.TypeOf(l) != reflect.TypeOf(r) {
+			break
+		}
+		// Arrays and objects are equal only when they are the same instance
+		eq := reflect.ValueOf(l).Pointer() == reflect.ValueOf(r).Pointer()
+		switch kind {
+		case CompareOpNodeKindEq:
+			return eq
+		case CompareOpNodeKindNotEq:
+			return !eq
+		default:
+			return false
+		}
+	}
+
+	// Operands of different types are coerced to numbers
+	lf, rf := exprNumber(l), exprNumber(r)
+	if math.IsNaN(lf) || math.IsNaN(rf) {
+		return kind == CompareOpNodeKindNotEq
+	}
+	switch {
+	case lf < rf:
+		c = -1
+	case lf > rf:
+		c = 1
+	}
+	return compareResult(kind, c)
+}
+
+func compareResult(kind CompareOpNodeKind, c int) bool {
+	switch kind {
+	case CompareOpNodeKindLess:
+		return c < 0
+	case CompareOpNodeKindLessEq:
+		return c <= 0
+	case CompareOpNodeKindGreater:
+		return c > 0
+	case CompareOpNodeKindGreaterEq:
+		return c >= 0
+	case CompareOpNodeKindEq:
+		return c == 0
+	case CompareOpNodeKindNotEq:
+		return c != 0
+	default:
+		return false
+	}
+}
+
+func evalFormat(f string, args []interface{}) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(f); i++ {
+		c := f[i]
+		switch c {
+		case '{':
+			if i+1 < len(f) && f[i+1] == '{' {
+				b.WriteByte('{')
+				i++
+				continue
+			}
+			end := strings.IndexByte(f[i:], '}')
+			if end < 0 {
+				return "", false
+			}
+			idx := 0
+			digits := f[i+1 : i+end]
+			if digits == "" {
+				return "", false
+			}
+			for _, d := range digits {
+				if d < '0' || '9' < d {
+					return "", false
+				}
+				idx = idx*10 + int(d-'0')
+			}
+			if idx >= len(args) {
+				return "", false
+			}
+			b.WriteString(exprString(args[idx]))
+			i += end
+		case '}':
+			if i+1 < len(f) && f[i+1] == '}' {
+				i++
+			}
+			b.WriteByte('}')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String(), true
+}
+
+func exprTruthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0 && !math.IsNaN(v)
+	case string:
+		return v != ""
+	default:
+		return true
+	}
+}
+
+func exprNumber(v interface{}) float64 {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	case float64:
+		return v
+	case string:
+		return coerceStringToNumber(v)
+	default:
+		return math.NaN()
+	}
+}
+
+// exprString converts the value to string in the same way as `${{ }}` placeholders.
+func exprString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case float64:
+		return formatNumber(v)
+	case string:
+		return v
+	case []interface{}, exprFiltered:
+		return "Array"
+	default:
+		return "Object"
+	}
+}
@@ -0,0 +1,153 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testEvalExpr(t *testing.T, src string, contexts map[string]interface{}) (interface{}, *ExprError) {
+	t.Helper()
+	n, err := NewExprParser().Parse(NewExprLexer(src + "}}"))
+	if err != nil {
+		t.Fatalf("parse error: %s", err)
+	}
+	return NewExprEvaluator(contexts).Eval(n)
+}
+
+func TestExprEvalOK(t *testing.T) {
+	contexts := map[string]interface{}{
+		"github": map[string]interface{}{
+			"event_name": "pull_request",
+			"ref":        "refs/heads/main",
+			"event": map[string]interface{}{
+				"number": float64(42),
+				"labels": []interface{}{
+					map[string]interface{}{"name": "bug"},
+					map[string]interface{}{"name": "enhancement"},
+					map[string]interface{}{"color": "red"},
+				},
+			},
+		},
+		"Matrix": map[string]interface{}{
+			"os": "ubuntu-latest",
+		},
+	}
+
+	testCases := []struct {
+		input string
+		want  interface{}
+	}{
+		{"null", nil},
+		{"true", true},
+		{"42", 42.0},
+		{"0x1f", 31.0},
+		{"1.5e3", 1500.0},
+		{"'hello'", "hello"},
+		{"github.event_name", "pull_request"},
+		{"GITHUB.Event_Name", "pull_request"},
+		{"github['ref']", "refs/heads/main"},
+		{"github.not_exist", nil},
+		{"github.not_exist.foo", nil},
+		{"matrix.os", "ubuntu-latest"},
+		{"github.event.labels[1].name", "enhancement"},
+		{"github.event.labels[5]", nil},
+		{"github.event.labels.*.name", []interface{}{"bug", "enhancement"}},
+		{"github.event.labels.*.name[0]", []interface{}{}},
+		{"matrix.*", []interface{}{"ubuntu-latest"}},
+		{"!github.ref", false},
+		{"!''", true},
+		{"github.event.number == 42", true},
+		{"github.event.number != 42", false},
+		{"github.event.number >= 43", false},
+		{"'ABC' == 'abc'", true},
+		{"'a' < 'B'", true},
+		{"'1' == 1", true},
+		{"'' == 0", true},
+		{"null == 0", true},
+		{"true == 1", true},
+		{"'foo' == 0", false},
+		{"'foo' != 0", true},
+		{"github.event == github.event", true},
+		{"github.ref && 'yes'", "yes"},
+		{"'' && 'yes'", ""},
+		{"github.not_exist || 'default'", "default"},
+		{"github.ref || 'default'", "refs/heads/main"},
+		{"success()", true},
+		{"cancelled()", false},
+		{"contains(github.ref, 'MAIN')", true},
+		{"contains(github.event.labels.*.name, 'BUG')", true},
+		{"contains(github.event.labels.*.name, 'docs')", false},
+		{"startsWith(github.ref, 'refs/heads/')", true},
+		{"endsWith(github.ref, '/dev')", false},
+		{"format('{0} #{1} {{ok}}', github.event_name, github.event.number)", "pull_request #42 {ok}"},
+		{"join(github.event.labels.*.name, ', ')", "bug, enhancement"},
+		{"join(github.event.labels.*.name)", "bug,enhancement"},
+		{"join('foo')", "foo"},
+		{"toJSON(matrix)", "{\n  \"os\": \"ubuntu-latest\"\n}"},
+		{"fromJSON('{\"a\": [1, true]}').a", []interface{}{1.0, true}},
+		{"fromJSON('[1, 2]')[1]", 2.0},
+		{"format('{0}', fromJSON('[]'))", "Array"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			have, err := testEvalExpr(t, tc.input, contexts)
+			if err != nil {
+				t.Fatalf("eval error: %s", err)
+			}
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
+
+func TestExprEvalError(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{"foo.bar", "context \"foo\" is not available"},
+		{"hashFiles('**/go.sum')", "hashFiles() cannot be evaluated"},
+		{"unknownFunc()", "undefined function \"unknownFunc\""},
+		{"contains('foo')", "wrong number of arguments to contains(): 1"},
+		{"format('{1}', 'a')", "invalid format string \"{1}\" with 1 arguments"},
+		{"format('{x}', 'a')", "invalid format string"},
+		{"fromJSON('{')", "could not parse \"{\" as JSON"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := testEvalExpr(t, tc.input, map[string]interface{}{})
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Message, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, err.Message)
+			}
+		})
+	}
+}
+
+func TestExprEvalExampleEventPayloads(t *testing.T) {
+	for e := range EventPayloadExamples {
+		t.Run(e, func(t *testing.T) {
+			p, ok := ExampleEventPayload(e)
+			if !ok {
+				t.Fatal("payload was not found")
+			}
+			cs := NewEventContexts(e, p)
+			for _, src := range []string{"github.event_name", "github.repository", "github.ref"} {
+				v, err := testEvalExpr(t, src, cs)
+				if err != nil {
+					t.Fatalf("eval error for %q: %s", src, err)
+				}
+				if s, ok := v.(string); !ok || s == "" || s == "refs/heads/" {
+					t.Fatalf("%q should be evaluated to non-empty string but got %#v", src, v)
+				}
+			}
+		})
+	}
+}
@@ -148,6 +148,7 @@ type UntrustedInputChecker struct {
 	cur             []*UntrustedInputMap
 	start           ExprNode
 	errs            []*ExprError
+	inputs          []string // Paths of all untrusted inputs found so far
 }
 
 // NewUntrustedInputChecker creates a new UntrustedInputChecker instance. The roots argument is a
@@ -270,6 +271,7 @@ func (u *UntrustedInputChecker) end() {
 		cur.buildPath(&b)
 		inputs = append(inputs, b.String())
 	}
+	u.inputs = append(u.inputs, inputs...)
 
 	if len(inputs) == 1 {
 		err := errorfAtExpr(
@@ -329,5 +331,19 @@ func (u *UntrustedInputChecker) Errs() []*ExprError {
 // Init initializes a state of checker.
 func (u *UntrustedInputChecker) Init() {
 	u.errs = u.errs[:0]
+	u.inputs = u.inputs[:0]
 	u.reset()
 }
+
+// untrustedInputsIn returns paths of the potentially untrusted inputs like
+// "github.event.issue.title" accessed in the expression.
+func untrustedInputsIn(expr ExprNode) []string {
+	u := NewUntrustedInputChecker(BuiltinUntrustedInputs)
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			u.OnVisitNodeLeave(n)
+		}
+	})
+	u.OnVisitEnd()
+	return u.inputs
+}
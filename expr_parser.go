@@ -1,7 +1,9 @@
 package actionlint
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -139,10 +141,17 @@ func (p *ExprParser) parseNestedExpr() ExprNode {
 
 func (p *ExprParser) parseInt() ExprNode {
 	t := p.peek()
-	i, err := strconv.ParseInt(t.Value, 0, 32)
+	i, err := strconv.ParseInt(t.Value, 0, strconv.IntSize)
 	if err != nil {
-		p.errorf("parsing invalid integer literal %q: %s", t.Value, err)
-		return nil
+		// Numbers in expressions are 64-bit floating point values so large integers are not invalid
+		b, ok := new(big.Int).SetString(t.Value, 0)
+		if !errors.Is(err, strconv.ErrRange) || !ok {
+			p.errorf("parsing invalid integer literal %q: %s", t.Value, err)
+			return nil
+		}
+		f, _ := new(big.Float).SetInt(b).Float64()
+		p.next() // eat int
+		return &FloatNode{f, t}
 	}
 
 	p.next() // eat int
@@ -61,6 +61,16 @@ func TestParseExpressionSyntaxOK(t *testing.T) {
 			input:    "0x0",
 			expected: &IntNode{Value: 0x0},
 		},
+		{
+			what:     "integer literal larger than 64-bit integer",
+			input:    "18446744073709551616",
+			expected: &FloatNode{Value: 18446744073709551616},
+		},
+		{
+			what:     "hex integer literal larger than 64-bit integer",
+			input:    "0x10000000000000000",
+			expected: &FloatNode{Value: 0x10000000000000000},
+		},
 		{
 			what:     "float literal",
 			input:    "1234.567",
@@ -2,12 +2,18 @@ package actionlint
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
 )
 
-var reFormatPlaceholder = regexp.MustCompile(`{\d+}`)
+var (
+	reFormatPlaceholder = regexp.MustCompile(`{\d+}`)
+	// Decimal number format accepted on converting strings to numbers
+	reDecimalNumber = regexp.MustCompile(`^[+-]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)(?:[eE][+-]?[0-9]+)?$`)
+)
 
 func ordinal(i int) string {
 	suffix := "th"
@@ -204,7 +210,7 @@ var BuiltinGlobalVariableTypes = map[string]ExprType{
 		"api_url":             StringType{},
 		"base_ref":            StringType{},
 		"env":                 StringType{},
-		"event":               NewEmptyObjectType(), // Note: Stricter type check for this payload would be possible
+		"event":               NewEmptyObjectType(), // Typed with the payloads of the workflow triggers by SetEvents
 		"event_name":          StringType{},
 		"event_path":          StringType{},
 		"graphql_url":         StringType{},
@@ -301,7 +307,13 @@ type ExprSemanticsChecker struct {
 	untrusted             *UntrustedInputChecker
 	availableContexts     []string
 	availableSpecialFuncs []string
+	workflowKey           string
 	configVars            []string
+	callInputs            *ObjectType
+	dispatchInputs        *ObjectType
+	events                []string
+	checkEventProps       bool
+	nodeTypes             map[ExprNode]ExprType
 }
 
 // NewExprSemanticsChecker creates new ExprSemanticsChecker instance. When checkUntrustedInput is
@@ -352,15 +364,6 @@ func (sema *ExprSemanticsChecker) ensureVarsCopied() {
 	sema.varsCopied = true
 }
 
-func (sema *ExprSemanticsChecker) ensureGithubVarCopied() {
-	if sema.githubVarCopied {
-		return
-	}
-	sema.ensureVarsCopied()
-
-	sema.vars["github"] = sema.vars["github"].DeepCopy()
-}
-
 // UpdateMatrix updates matrix object to given object type. Since matrix values change according to
 // 'matrix' section of job configuration, the type needs to be updated.
 func (sema *ExprSemanticsChecker) UpdateMatrix(ty *ObjectType) {
@@ -399,6 +402,11 @@ func (sema *ExprSemanticsChecker) UpdateSecrets(ty *ObjectType) {
 
 // UpdateInputs updates 'inputs' context object to given object type.
 func (sema *ExprSemanticsChecker) UpdateInputs(ty *ObjectType) {
+	sema.callInputs = ty
+	sema.updateInputs(ty)
+}
+
+func (sema *ExprSemanticsChecker) updateInputs(ty *ObjectType) {
 	sema.ensureVarsCopied()
 	o := sema.vars["inputs"].(*ObjectType)
 	if len(o.Props) == 0 && o.IsStrict() {
@@ -413,20 +421,32 @@ func (sema *ExprSemanticsChecker) UpdateInputs(ty *ObjectType) {
 // UpdateDispatchInputs updates 'github.event.inputs' and 'inputs' objects to given object type.
 // https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#workflow_dispatch
 func (sema *ExprSemanticsChecker) UpdateDispatchInputs(ty *ObjectType) {
-	sema.UpdateInputs(ty)
+	sema.updateDispatchInputs(ty, githubTypeWithDispatchInputs(sema.vars["github"], ty))
+}
 
-	// Update `github.event.inputs`.
-	// Unlike `inputs.*`, type of `github.event.inputs.*` is always string unlike `inputs.*`. We need
-	// to create a new type from `ty` (e.g. {foo: boolean, bar: number} -> {foo: string, bar: string})
+// updateDispatchInputs is the same as UpdateDispatchInputs but it takes the `github` context type
+// created by githubTypeWithDispatchInputs. Deep copy of `github` context type is costly so callers
+// checking many expressions can reuse the type.
+func (sema *ExprSemanticsChecker) updateDispatchInputs(ty *ObjectType, github ExprType) {
+	sema.dispatchInputs = ty
+	sema.updateInputs(ty)
+	sema.ensureVarsCopied()
+	sema.vars["github"] = github
+	sema.githubVarCopied = true
+}
 
-	p := make(map[string]ExprType, len(ty.Props))
-	for n := range ty.Props {
+// githubTypeWithDispatchInputs returns a copy of the `github` context type whose
+// `github.event.inputs` is updated with the inputs of workflow_dispatch event.
+func githubTypeWithDispatchInputs(github ExprType, inputs *ObjectType) ExprType {
+	// Unlike `inputs.*`, type of `github.event.inputs.*` is always string unlike `inputs.*`. We need
+	// to create a new type from `inputs` (e.g. {foo: boolean, bar: number} -> {foo: string, bar: string})
+	p := make(map[string]ExprType, len(inputs.Props))
+	for n := range inputs.Props {
 		p[n] = StringType{}
 	}
-	ty = NewStrictObjectType(p)
-
-	sema.ensureGithubVarCopied()
-	sema.vars["github"].(*ObjectType).Props["event"].(*ObjectType).Props["inputs"] = ty
+	github = github.DeepCopy()
+	github.(*ObjectType).Props["event"].(*ObjectType).Props["inputs"] = NewStrictObjectType(p)
+	return github
 }
 
 // UpdateJobs updates 'jobs' context object to given object type.
@@ -448,6 +468,36 @@ func (sema *ExprSemanticsChecker) SetContextAvailability(avail []string) {
 	sema.availableContexts = avail
 }
 
+// SetEvents sets the names of the workflow triggers in lower case. `github.event` is typed with the
+// example payloads of the events. When CheckEventPayloadProps is called, accessing the properties of
+// payloads which none of the events populate such as `github.event.pull_request` on "issues" event
+// is also reported.
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads
+func (sema *ExprSemanticsChecker) SetEvents(events []string) {
+	sema.setEvents(events, githubTypeWithEventPayload(sema.vars["github"], events))
+}
+
+// setEvents is the same as SetEvents but it takes the `github` context type created by
+// githubTypeWithEventPayload. Callers checking many expressions can reuse the type.
+func (sema *ExprSemanticsChecker) setEvents(events []string, github ExprType) {
+	sema.events = events
+	sema.ensureVarsCopied()
+	sema.vars["github"] = github
+	sema.githubVarCopied = true
+}
+
+// CheckEventPayloadProps enables the check for properties of `github.event` which none of the
+// workflow triggers set by SetEvents populate.
+func (sema *ExprSemanticsChecker) CheckEventPayloadProps() {
+	sema.checkEventProps = true
+}
+
+// SetWorkflowKey sets the workflow key where the checked expression is put such as
+// "jobs.build.runs-on". It is used for making error messages more helpful.
+func (sema *ExprSemanticsChecker) SetWorkflowKey(key string) {
+	sema.workflowKey = key
+}
+
 func (sema *ExprSemanticsChecker) checkAvailableContext(n *VariableNode) {
 	if len(sema.availableContexts) == 0 {
 		return
@@ -464,6 +514,20 @@ func (sema *ExprSemanticsChecker) checkAvailableContext(n *VariableNode) {
 	if len(sema.availableContexts) == 1 {
 		s = "context is"
 	}
+
+	// `env` context is often misused at job-level keys since it is available at most step-level keys.
+	// GitHub Actions does not evaluate such `env` context and the runtime error is confusing.
+	if ctx == "env" && sema.workflowKey != "" {
+		sema.errorf(
+			n,
+			"context \"env\" is not available at %q. environment variables defined in \"env:\" sections cannot be referred at the key. available %s %s. see https://docs.github.com/en/actions/learn-github-actions/contexts#context-availability for more details",
+			sema.workflowKey,
+			s,
+			quotes(sema.availableContexts),
+		)
+		return
+	}
+
 	sema.errorf(
 		n,
 		"context %q is not allowed here. available %s %s. see https://docs.github.com/en/actions/learn-github-actions/contexts#context-availability for more details",
@@ -533,6 +597,7 @@ func (sema *ExprSemanticsChecker) checkVariable(n *VariableNode) ExprType {
 }
 
 func (sema *ExprSemanticsChecker) checkObjectDeref(n *ObjectDerefNode) ExprType {
+	sema.checkEventPayloadProp(n)
 	switch ty := sema.check(n.Receiver).(type) {
 	case AnyType:
 		return AnyType{}
@@ -585,6 +650,25 @@ func (sema *ExprSemanticsChecker) checkObjectDeref(n *ObjectDerefNode) ExprType
 	}
 }
 
+// checkEventPayloadProp reports the property of `github.event` which none of the workflow triggers
+// populate.
+func (sema *ExprSemanticsChecker) checkEventPayloadProp(n ExprNode) {
+	if !sema.checkEventProps {
+		return
+	}
+	prop, available, ok := eventPayloadPropAbsent(n, sema.events)
+	if !ok {
+		return
+	}
+	sema.errorf(
+		n,
+		"property %q is not defined in payloads of the workflow triggers %s. it is only available on %s events",
+		prop,
+		quotes(sema.events),
+		quotes(available),
+	)
+}
+
 func (sema *ExprSemanticsChecker) checkConfigVariables(n *ObjectDerefNode) {
 	// https://docs.github.com/en/actions/learn-github-actions/variables#naming-conventions-for-configuration-variables
 	if strings.HasPrefix(n.Property, "github_") {
@@ -684,6 +768,7 @@ func (sema *ExprSemanticsChecker) checkIndexAccess(n *IndexAccessNode) ExprType
 	// properties/indices access check is done in bottom-up order. So, as far as we visit nested
 	// index nodes before visiting operand, the index is recursively checked first.
 	idx := sema.check(n.Index)
+	sema.checkEventPayloadProp(n)
 
 	switch ty := sema.check(n.Operand).(type) {
 	case AnyType:
@@ -912,14 +997,104 @@ func validateCompareOpOperands(op CompareOpNodeKind, l, r ExprType) bool {
 	}
 }
 
+// coerceStringToNumber converts the string to number as GitHub Actions does on comparing values of
+// different types. The string is parsed as JSON number or hex integer after trimming white spaces.
+// An empty string is converted to 0. Other strings are converted to NaN.
+// https://docs.github.com/en/actions/learn-github-actions/expressions#operators
+func coerceStringToNumber(s string) float64 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	if h := strings.TrimPrefix(s, "0x"); len(h) < len(s) {
+		i, ok := new(big.Int).SetString(h, 16)
+		if !ok || h[0] == '-' || h[0] == '+' {
+			return math.NaN()
+		}
+		f, _ := new(big.Float).SetInt(i).Float64()
+		return f
+	}
+	if !reDecimalNumber.MatchString(s) {
+		return math.NaN()
+	}
+	f, _ := strconv.ParseFloat(s, 64) // Out of range is converted to infinity
+	return f
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// checkCoercedLiteralOperand checks the literal operand of the comparison is coerced to number
+// unexpectedly. When types of operands are different, GitHub Actions converts both operands to
+// numbers. For example, `” == 0` is true and `'true' == true` is false.
+func (sema *ExprSemanticsChecker) checkCoercedLiteralOperand(n *CompareOpNode, lit, other ExprNode, ty ExprType) {
+	switch lit := lit.(type) {
+	case *StringNode:
+		switch ty.(type) {
+		case NumberType, BoolType, NullType:
+		default:
+			return
+		}
+		f := coerceStringToNumber(lit.Value)
+		if math.IsNaN(f) {
+			always := "false"
+			if n.Kind == CompareOpNodeKindNotEq {
+				always = "true"
+			}
+			sema.errorf(
+				n,
+				"string %s is coerced to NaN when compared to %q value with %q operator. the comparison is always %s",
+				lit.Token().Value,
+				ty.String(),
+				n.Kind.String(),
+				always,
+			)
+			return
+		}
+		if formatNumber(f) == lit.Value {
+			return // Comparing '42' with 42 is expected
+		}
+		sema.errorf(
+			n,
+			"string %s is coerced to number %s when compared to %q value with %q operator. operands of different types are compared as numbers",
+			lit.Token().Value,
+			formatNumber(f),
+			ty.String(),
+			n.Kind.String(),
+		)
+	case *BoolNode:
+		if n.Kind != CompareOpNodeKindEq && n.Kind != CompareOpNodeKindNotEq {
+			return
+		}
+		if _, ok := ty.(StringType); !ok {
+			return
+		}
+		if _, ok := other.(*StringNode); ok {
+			return // Reported at the string literal
+		}
+		sema.errorf(
+			n,
+			"\"string\" value is compared to bool %s with %q operator. both operands are coerced to numbers so string 'true' does not equal to true. compare the value with string '%s' instead",
+			lit.Token().Value,
+			n.Kind.String(),
+			lit.Token().Value,
+		)
+	}
+}
+
 func (sema *ExprSemanticsChecker) checkCompareOp(n *CompareOpNode) ExprType {
 	l := sema.check(n.Left)
 	r := sema.check(n.Right)
 
 	if !validateCompareOpOperands(n.Kind, l, r) {
 		sema.errorf(n, "%q value cannot be compared to %q value with %q operator", l.String(), r.String(), n.Kind.String())
+		return BoolType{}
 	}
 
+	sema.checkCoercedLiteralOperand(n, n.Left, n.Right, r)
+	sema.checkCoercedLiteralOperand(n, n.Right, n.Left, l)
+
 	return BoolType{}
 }
 
@@ -937,7 +1112,7 @@ func (sema *ExprSemanticsChecker) checkWithNarrowing(n ExprNode, isTruthy bool)
 			// When `l && r` is true, narrow its type to `typeof(r)`
 			if isTruthy {
 				sema.check(n.Left)
-				return sema.check(n.Right)
+				return sema.checkRightOfAnd(n)
 			}
 		case LogicalOpNodeKindOr:
 			// When `l || r` is false, narrow its type to `typeof(r)`
@@ -954,12 +1129,58 @@ func (sema *ExprSemanticsChecker) checkWithNarrowing(n ExprNode, isTruthy bool)
 	}
 }
 
+// inputsNarrowedByEventName returns the type of `inputs` context narrowed by the condition like
+// `github.event_name != 'workflow_dispatch'`. When both `workflow_call` and `workflow_dispatch` are
+// the triggers of the workflow, `inputs` context is typed as the union of their inputs. The
+// condition tells which trigger's inputs are available. `github.event_name` of a called workflow is
+// the event name of its caller workflow, so `==` does not narrow the type since the workflow may be
+// called by a dispatched workflow. `!=` narrows the type to inputs of `workflow_call`. This method
+// returns nil when the condition does not narrow the type.
+func (sema *ExprSemanticsChecker) inputsNarrowedByEventName(cond ExprNode) *ObjectType {
+	if sema.callInputs == nil || sema.dispatchInputs == nil {
+		return nil
+	}
+	c, ok := cond.(*CompareOpNode)
+	if !ok || c.Kind != CompareOpNodeKindNotEq {
+		return nil
+	}
+	isEventName := func(e ExprNode) bool {
+		d, ok := e.(*ObjectDerefNode)
+		if !ok || d.Property != "event_name" {
+			return false
+		}
+		v, ok := d.Receiver.(*VariableNode)
+		return ok && v.Name == "github"
+	}
+	isDispatch := func(e ExprNode) bool {
+		s, ok := e.(*StringNode)
+		return ok && strings.EqualFold(s.Value, "workflow_dispatch")
+	}
+	if !(isEventName(c.Left) && isDispatch(c.Right) || isDispatch(c.Left) && isEventName(c.Right)) {
+		return nil
+	}
+	return sema.callInputs
+}
+
+// checkRightOfAnd checks the right hand side of `l && r`. `r` is evaluated only when `l` is true
+// so the types of contexts in `r` are narrowed by `l`.
+func (sema *ExprSemanticsChecker) checkRightOfAnd(n *LogicalOpNode) ExprType {
+	ty := sema.inputsNarrowedByEventName(n.Left)
+	if ty == nil {
+		return sema.check(n.Right)
+	}
+	prev := sema.vars["inputs"]
+	sema.vars["inputs"] = ty
+	defer func() { sema.vars["inputs"] = prev }()
+	return sema.check(n.Right)
+}
+
 func (sema *ExprSemanticsChecker) checkLogicalOp(n *LogicalOpNode) ExprType {
 	switch n.Kind {
 	case LogicalOpNodeKindAnd:
 		// When `l` is false in `l && r`, its type is `typeof(l)`. Otherwise `typeof(r)`.
 		// Narrow the type of LHS expression by assuming its value is falsy.
-		return sema.checkWithNarrowing(n.Left, false).Merge(sema.check(n.Right))
+		return sema.checkWithNarrowing(n.Left, false).Merge(sema.checkRightOfAnd(n))
 	case LogicalOpNodeKindOr:
 		// When `l` is true in `l || r`, its type is `typeof(l)`. Otherwise `typeof(r).
 		// Narrow the type of LHS expression by assuming its value is truthy.
@@ -972,6 +1193,37 @@ func (sema *ExprSemanticsChecker) checkLogicalOp(n *LogicalOpNode) ExprType {
 }
 
 func (sema *ExprSemanticsChecker) check(expr ExprNode) ExprType {
+	ty := sema.checkNode(expr)
+	if sema.nodeTypes != nil {
+		sema.nodeTypes[expr] = ty
+	}
+	return ty
+}
+
+// checkNumberLiteral checks the integer literal can be represented exactly as number. Numbers in
+// expressions are 64-bit floating point values so integers larger than 2^53 lose their precision.
+func (sema *ExprSemanticsChecker) checkNumberLiteral(n ExprNode) {
+	t := n.Token()
+	if t.Kind != TokenKindInt {
+		return
+	}
+	i, ok := new(big.Int).SetString(t.Value, 0)
+	if !ok {
+		return
+	}
+	f, acc := new(big.Float).SetInt(i).Float64()
+	if acc == big.Exact {
+		return
+	}
+	sema.errorf(
+		n,
+		"integer literal %s cannot be represented exactly as number since numbers are 64-bit floating point values. it is evaluated as %s",
+		t.Value,
+		formatNumber(f),
+	)
+}
+
+func (sema *ExprSemanticsChecker) checkNode(expr ExprNode) ExprType {
 	defer sema.visitUntrustedCheckerOnLeaveNode(expr) // Call this method in bottom-up order
 
 	switch e := expr.(type) {
@@ -984,6 +1236,7 @@ func (sema *ExprSemanticsChecker) check(expr ExprNode) ExprType {
 	case *StringNode:
 		return StringType{}
 	case *IntNode, *FloatNode:
+		sema.checkNumberLiteral(e)
 		return NumberType{}
 	case *ObjectDerefNode:
 		return sema.checkObjectDeref(e)
@@ -374,6 +374,16 @@ func TestExprSemanticsCheckOK(t *testing.T) {
 			input:    "true == 1.1",
 			expected: BoolType{},
 		},
+		{
+			what:     "string coerced to number exactly",
+			input:    "'42' == 42 && '-1.5' < 0 && '0' == null && '1' == true",
+			expected: BoolType{},
+		},
+		{
+			what:     "integer literal represented exactly as number",
+			input:    "9007199254740992 == 0x20000000000000",
+			expected: BoolType{},
+		},
 		{
 			what:     "arguments of format() is not checked when first argument is not a literal",
 			input:    "format(github.action, 1, 2, 3)",
@@ -1217,6 +1227,48 @@ func TestExprSemanticsCheckError(t *testing.T) {
 				"configuration variable name \"foo-bar\" can only contain alphabets, decimal numbers, and '_'.",
 			},
 		},
+		{
+			what:  "empty string coerced to number",
+			input: "'' == 0",
+			expected: []string{
+				"string '' is coerced to number 0 when compared to \"number\" value with \"==\" operator",
+			},
+		},
+		{
+			what:  "hex string coerced to number",
+			input: "' 0x10' < 17",
+			expected: []string{
+				"string ' 0x10' is coerced to number 16 when compared to \"number\" value with \"<\" operator",
+			},
+		},
+		{
+			what:  "string coerced to NaN",
+			input: "'true' == true",
+			expected: []string{
+				"string 'true' is coerced to NaN when compared to \"bool\" value with \"==\" operator. the comparison is always false",
+			},
+		},
+		{
+			what:  "string coerced to NaN with != operator",
+			input: "'v1' != 1",
+			expected: []string{
+				"the comparison is always true",
+			},
+		},
+		{
+			what:  "string value compared to bool",
+			input: "github.action == true",
+			expected: []string{
+				"\"string\" value is compared to bool true with \"==\" operator. both operands are coerced to numbers",
+			},
+		},
+		{
+			what:  "integer literal losing precision",
+			input: "9007199254740993 == 0",
+			expected: []string{
+				"integer literal 9007199254740993 cannot be represented exactly as number since numbers are 64-bit floating point values. it is evaluated as 9007199254740992",
+			},
+		},
 		{
 			what:  "config variable name cannot start with GITHUB_",
 			input: "vars.GITHUB_FOOOOOOOO",
@@ -1568,6 +1620,95 @@ func TestExprSematincsCheckerUpdateDispatchInputsVarType(t *testing.T) {
 	}
 }
 
+func TestExprSemanticsCheckerSetEvents(t *testing.T) {
+	tests := []struct {
+		what   string
+		input  string
+		events []string
+		want   string
+		err    string
+	}{
+		{"typed by payload", "github.event.pull_request.merged", []string{"pull_request"}, "bool", ""},
+		{"merged payloads", "github.event.issue.number", []string{"issues", "pull_request"}, "number", ""},
+		{"array in payload", "github.event.commits", []string{"push"}, "array<any>", ""},
+		{"index access", "github.event['pull_request'].number", []string{"pull_request_target"}, "number", ""},
+		{"unknown property is any", "github.event.label.name", []string{"issues"}, "any", ""},
+		{"event without example", "github.event.schedule", []string{"schedule"}, "any", ""},
+		{
+			"not populated",
+			"github.event.pull_request.merged",
+			[]string{"issues"},
+			"",
+			`property "github.event.pull_request" is not defined in payloads of the workflow triggers "issues". it is only available on "pull_request", "pull_request_review", "pull_request_review_comment", "pull_request_target" events`,
+		},
+		{
+			"not populated by index access",
+			"github.event['head_commit']",
+			[]string{"pull_request", "schedule"},
+			"",
+			`property "github.event.head_commit" is not defined in payloads of the workflow triggers "pull_request", "schedule". it is only available on "push" events`,
+		},
+		{"payload given by caller", "github.event.pull_request.merged", []string{"issues", "workflow_call"}, "any", ""},
+		{"no event", "github.event.pull_request.merged", nil, "any", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			e, err := NewExprParser().Parse(NewExprLexer(tc.input + "}}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := NewExprSemanticsChecker(false, nil)
+			c.SetEvents(tc.events)
+			c.CheckEventPayloadProps()
+			ty, errs := c.Check(e)
+			if tc.err != "" {
+				if len(errs) != 1 || errs[0].Message != tc.err {
+					t.Fatalf("wanted error %q but got %v", tc.err, errs)
+				}
+				return
+			}
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			if have := ty.String(); !strings.HasPrefix(have, tc.want) {
+				t.Fatalf("wanted type %s but got %s", tc.want, have)
+			}
+		})
+	}
+
+	// Properties not populated are not reported unless the check is enabled
+	e, err := NewExprParser().Parse(NewExprLexer("github.event.pull_request.merged}}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewExprSemanticsChecker(false, nil)
+	c.SetEvents([]string{"issues"})
+	if _, errs := c.Check(e); len(errs) > 0 {
+		t.Error("properties not populated were reported without enabling the check", errs)
+	}
+
+	// Check global value is not polluted
+	o := BuiltinGlobalVariableTypes["github"].(*ObjectType).Props["event"].(*ObjectType)
+	if len(o.Props) > 0 {
+		t.Error("Global github.event was updated", o)
+	}
+}
+
+func TestExprSemanticsCheckerSetEventsWithDispatchInputs(t *testing.T) {
+	c := NewExprSemanticsChecker(false, nil)
+	c.UpdateDispatchInputs(NewStrictObjectType(map[string]ExprType{"foo": BoolType{}}))
+	c.SetEvents([]string{"workflow_dispatch"})
+	o := c.vars["github"].(*ObjectType).Props["event"].(*ObjectType)
+	if _, ok := o.Props["ref"].(StringType); !ok {
+		t.Error("github.event is not typed by the payload", o)
+	}
+	i := o.Props["inputs"].(*ObjectType)
+	if _, ok := i.Props["foo"].(StringType); !ok || !i.IsStrict() {
+		t.Error("github.event.inputs was overwritten by the payload", i)
+	}
+}
+
 func TestExprSemanticsCheckerUpdateInputsMultipleTimes(t *testing.T) {
 	tests := []struct {
 		first  *ObjectType
@@ -1624,6 +1765,46 @@ func TestExprSemanticsCheckerUpdateInputsMultipleTimes(t *testing.T) {
 	}
 }
 
+func TestExprSemanticsCheckerNarrowInputsByEventName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  ExprType
+	}{
+		{"inputs.foo", AnyType{}},
+		{"github.event_name == 'workflow_dispatch' && inputs.foo", AnyType{}},
+		{"github.event_name != 'workflow_dispatch' && inputs.foo", NumberType{}},
+		{"'WORKFLOW_DISPATCH' != github.event_name && inputs.foo", NumberType{}},
+		{"!(github.event_name != 'workflow_dispatch' && inputs.foo)", BoolType{}},
+		{"github.event_name == 'push' && inputs.foo", AnyType{}},
+		{"github.event_name == 'workflow_dispatch' || inputs.foo", AnyType{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			e, err := NewExprParser().Parse(NewExprLexer(tc.input + "}}"))
+			if err != nil {
+				t.Fatal("Parse error:", tc.input)
+			}
+			c := NewExprSemanticsChecker(false, nil)
+			c.UpdateInputs(NewStrictObjectType(map[string]ExprType{"foo": NumberType{}}))
+			c.UpdateDispatchInputs(NewStrictObjectType(map[string]ExprType{"foo": BoolType{}}))
+			ty, errs := c.Check(e)
+			if len(errs) > 0 {
+				t.Fatal("semantics check failed:", errs)
+			}
+			if d, ok := e.(*LogicalOpNode); ok && d.Kind == LogicalOpNodeKindAnd {
+				ty = c.checkRightOfAnd(d)
+			}
+			if !cmp.Equal(ty, tc.want) {
+				t.Fatalf("wanted %s but got %s", tc.want, ty)
+			}
+			if have := c.vars["inputs"].(*ObjectType).Props["foo"]; !cmp.Equal(have, AnyType{}) {
+				t.Fatalf("type of inputs was not restored after narrowing: %s", have)
+			}
+		})
+	}
+}
+
 func testObjectPropertiesAreInLowerCase(t *testing.T, ty ExprType) {
 	t.Helper()
 	switch ty := ty.(type) {
@@ -0,0 +1,177 @@
+package actionlint
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// TextEdit is a machine-applicable edit of the source to fix an error. It replaces the bytes in the
+// range [Start, End) of the source with NewText. Start and End are byte offsets from the beginning
+// of the source. When Start equals to End, NewText is inserted at the offset.
+type TextEdit struct {
+	// Start is a byte offset where the replaced range starts.
+	Start int
+	// End is a byte offset where the replaced range ends. The byte at End is not replaced.
+	End int
+	// NewText is a text replacing the range.
+	NewText string
+}
+
+// ApplyFixes applies the fixes of the errors to the source and returns the fixed source and the
+// errors which were fixed. All edits of one error are applied at once. When edits of an error
+// overlap with edits of another error which was already accepted, the error is not fixed. It can be
+// fixed by linting the fixed source and applying the fixes again.
+func ApplyFixes(src []byte, errs []*Error) ([]byte, []*Error) {
+	fixable := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		if len(err.Fixes) > 0 {
+			fixable = append(fixable, err)
+		}
+	}
+	if len(fixable) == 0 {
+		return src, nil
+	}
+
+	accepted := []*TextEdit{}
+	fixed := []*Error{}
+	overlaps := func(e *TextEdit) bool {
+		for _, a := range accepted {
+			if e.Start < a.End && a.Start < e.End || e.Start == a.Start {
+				return true
+			}
+		}
+		return false
+	}
+
+Loop:
+	for _, err := range fixable {
+		for _, e := range err.Fixes {
+			if e.Start < 0 || e.End < e.Start || len(src) < e.End || overlaps(e) {
+				continue Loop
+			}
+		}
+		accepted = append(accepted, err.Fixes...)
+		fixed = append(fixed, err)
+	}
+
+	sort.Slice(accepted, func(i, j int) bool {
+		return accepted[i].Start < accepted[j].Start
+	})
+
+	out := make([]byte, 0, len(src))
+	prev := 0
+	for _, e := range accepted {
+		out = append(out, src[prev:e.Start]...)
+		out = append(out, e.NewText...)
+		prev = e.End
+	}
+	out = append(out, src[prev:]...)
+
+	return out, fixed
+}
+
+// posOffset returns the byte offset of the position in the source. Columns of positions count
+// characters, not bytes. It returns -1 when the position is out of the source.
+func posOffset(src []byte, pos *Pos) int {
+	if pos == nil || pos.Line <= 0 || pos.Col <= 0 {
+		return -1
+	}
+	start, end, ok := lineRange(src, pos.Line)
+	if !ok {
+		return -1
+	}
+	o := start
+	for c := 1; c < pos.Col; c++ {
+		if o >= end {
+			return -1
+		}
+		_, s := utf8.DecodeRune(src[o:end])
+		o += s
+	}
+	return o
+}
+
+// replaceAt returns the edit to replace the text at the position with the new text. It returns nil
+// when the source at the position does not start with the old text. Checking the old text ensures
+// that the edit is not applied to the unexpected place such as a quoted string or a block scalar.
+func replaceAt(src []byte, pos *Pos, old, new string) *TextEdit {
+	o := posOffset(src, pos)
+	if o < 0 || !bytes.HasPrefix(src[o:], []byte(old)) {
+		return nil
+	}
+	return &TextEdit{o, o + len(old), new}
+}
+
+// suggestName returns the candidate which the name is obviously a misspelling of. It returns false
+// when no candidate or multiple candidates are similar to the name so that the fix is not confident.
+func suggestName(name string, candidates []string) (string, bool) {
+	norm := func(s string) string {
+		return strings.ReplaceAll(strings.ToLower(s), "_", "-")
+	}
+	n := norm(name)
+	found := ""
+	min, dup := -1, false
+	for _, c := range candidates {
+		if c == name {
+			return "", false
+		}
+		if norm(c) == n {
+			return c, true
+		}
+		d := editDistance(n, norm(c))
+		if d*3 > len(c) {
+			continue // Too different from the candidate
+		}
+		switch {
+		case min < 0 || d < min:
+			found, min, dup = c, d, false
+		case d == min:
+			dup = true
+		}
+	}
+	if min < 0 || min > 2 || dup {
+		return "", false
+	}
+	return found, true
+}
+
+// editDistance returns the edit distance between the two strings. Insertion, deletion, substitution,
+// and transposition of adjacent characters are counted as one edit (optimal string alignment).
+func editDistance(a, b string) int {
+	if a == b {
+		return 0
+	}
+	rs, rt := []rune(a), []rune(b)
+	// d[i][j] is the distance between rs[:i] and rt[:j]
+	d := make([][]int, len(rs)+1)
+	for i := range d {
+		d[i] = make([]int, len(rt)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(rs); i++ {
+		for j := 1; j <= len(rt); j++ {
+			c := d[i-1][j-1]
+			if rs[i-1] != rt[j-1] {
+				c++
+			}
+			if v := d[i-1][j] + 1; v < c {
+				c = v
+			}
+			if v := d[i][j-1] + 1; v < c {
+				c = v
+			}
+			if i > 1 && j > 1 && rs[i-1] == rt[j-2] && rs[i-2] == rt[j-1] {
+				if v := d[i-2][j-2] + 1; v < c {
+					c = v
+				}
+			}
+			d[i][j] = c
+		}
+	}
+	return d[len(rs)][len(rt)]
+}
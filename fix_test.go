@@ -0,0 +1,154 @@
+package actionlint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestApplyFixes(t *testing.T) {
+	testCases := []struct {
+		what  string
+		src   string
+		fixes [][]*TextEdit
+		want  string
+		fixed int
+	}{
+		{
+			what: "no fix",
+			src:  "hello",
+			want: "hello",
+		},
+		{
+			what:  "replace",
+			src:   "hello world",
+			fixes: [][]*TextEdit{{{6, 11, "actionlint"}}},
+			want:  "hello actionlint",
+			fixed: 1,
+		},
+		{
+			what:  "insert and delete",
+			src:   "abcdef",
+			fixes: [][]*TextEdit{{{0, 0, "x"}, {2, 4, ""}}},
+			want:  "xabef",
+			fixed: 1,
+		},
+		{
+			what:  "multiple errors are applied in order of offsets",
+			src:   "aaa bbb ccc",
+			fixes: [][]*TextEdit{{{8, 11, "C"}}, {{0, 3, "A"}}},
+			want:  "A bbb C",
+			fixed: 2,
+		},
+		{
+			what:  "overlapping error is skipped",
+			src:   "aaa bbb ccc",
+			fixes: [][]*TextEdit{{{0, 5, "X"}}, {{4, 7, "B"}, {8, 11, "C"}}},
+			want:  "Xbb ccc",
+			fixed: 1,
+		},
+		{
+			what:  "insertions at the same offset",
+			src:   "abc",
+			fixes: [][]*TextEdit{{{1, 1, "x"}}, {{1, 1, "y"}}},
+			want:  "axbc",
+			fixed: 1,
+		},
+		{
+			what:  "out of range",
+			src:   "abc",
+			fixes: [][]*TextEdit{{{2, 10, "x"}}, {{2, 1, "x"}}},
+			want:  "abc",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			errs := []*Error{{Message: "not fixable"}}
+			for _, f := range tc.fixes {
+				errs = append(errs, &Error{Fixes: f})
+			}
+			b, fixed := ApplyFixes([]byte(tc.src), errs)
+			if string(b) != tc.want {
+				t.Errorf("wanted %q but got %q", tc.want, b)
+			}
+			if len(fixed) != tc.fixed {
+				t.Errorf("wanted %d fixed errors but got %d: %v", tc.fixed, len(fixed), fixed)
+			}
+		})
+	}
+}
+
+func TestReplaceAt(t *testing.T) {
+	src := []byte("foo: bar\nあい: piyo\n")
+	testCases := []struct {
+		what string
+		pos  *Pos
+		old  string
+		want *TextEdit
+	}{
+		{"first line", &Pos{Line: 1, Col: 6}, "bar", &TextEdit{5, 8, "new"}},
+		{"after multi-byte characters", &Pos{Line: 2, Col: 5}, "piyo", &TextEdit{17, 21, "new"}},
+		{"text mismatch", &Pos{Line: 1, Col: 1}, "bar", nil},
+		{"line out of range", &Pos{Line: 10, Col: 1}, "foo", nil},
+		{"column out of range", &Pos{Line: 1, Col: 20}, "foo", nil},
+		{"no position", &Pos{}, "foo", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			have := replaceAt(src, tc.pos, tc.old, "new")
+			if !cmp.Equal(have, tc.want) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
+
+func TestSuggestName(t *testing.T) {
+	cands := []string{"runs-on", "steps", "needs", "timeout-minutes", "if", "id", "env"}
+	testCases := []struct {
+		name string
+		want string
+	}{
+		{"runs_on", "runs-on"},
+		{"Steps", "steps"},
+		{"stpes", "steps"},
+		{"need", "needs"},
+		{"timeout-minuts", "timeout-minutes"},
+		{"ev", "env"},
+		{"ix", ""},   // Too short to guess "if" or "id"
+		{"foo", ""},  // Nothing is similar
+		{"uses", ""}, // Nothing is similar
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			have, ok := suggestName(tc.name, cands)
+			if ok != (tc.want != "") || have != tc.want {
+				t.Fatalf("wanted %q but got %q (%v)", tc.want, have, ok)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "abc", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"steps", "stpes", 1},
+		{"ca", "abc", 3},
+		{"あいう", "あう", 1},
+	}
+
+	for _, tc := range testCases {
+		if have := editDistance(tc.a, tc.b); have != tc.want {
+			t.Errorf("distance between %q and %q should be %d but got %d", tc.a, tc.b, tc.want, have)
+		}
+	}
+}
@@ -2,7 +2,11 @@
 
 package actionlint_fuzz
 
-import "github.com/rhysd/actionlint"
+import (
+	"time"
+
+	"github.com/rhysd/actionlint"
+)
 
 func parseWorkflowPanicFree(data []byte) *actionlint.Workflow {
 	// Avoid Parse() panicking. It panics when go-yaml panics
@@ -24,7 +28,7 @@ func FuzzCheck(data []byte) int {
 		actionlint.NewRuleMatrix(),
 		actionlint.NewRuleCredentials(),
 		actionlint.NewRuleShellName(),
-		actionlint.NewRuleRunnerLabel(),
+		actionlint.NewRuleRunnerLabelWithSource(data),
 		actionlint.NewRuleEvents(),
 		actionlint.NewRuleGlob(),
 		actionlint.NewRuleJobNeeds(),
@@ -32,10 +36,42 @@ func FuzzCheck(data []byte) int {
 		actionlint.NewRuleEnvVar(),
 		actionlint.NewRuleID(),
 		actionlint.NewRuleExpression(ac, wc),
-		actionlint.NewRuleWorkflowCall("test.yaml", wc),
+		actionlint.NewRuleWorkflowCall("test.yaml", wc, nil),
 		actionlint.NewRulePermissions(),
-		actionlint.NewRuleDeprecatedCommands(),
-		actionlint.NewRuleIfCond(),
+		actionlint.NewRuleDeprecatedCommandsWithSource(data),
+		actionlint.NewRuleIfCondWithSource(data),
+		actionlint.NewRuleRemoteScript(),
+		actionlint.NewRuleArtifactName(),
+		actionlint.NewRuleRunnerArch(),
+		actionlint.NewRuleDeprecation(ac, time.Now()),
+		actionlint.NewRuleReleaseTrigger(nil, ""),
+		actionlint.NewRuleDispatchInputCommand(),
+		actionlint.NewRuleSetupOrder(),
+		actionlint.NewRuleStatusCheckName(),
+		actionlint.NewRuleAlwaysOnCancel(data),
+		actionlint.NewRuleSetupCache(nil),
+		actionlint.NewRuleWorkflowFile("", nil),
+		actionlint.NewRuleEventAction(),
+		actionlint.NewRulePages(),
+		actionlint.NewRuleRefName(),
+		actionlint.NewRuleJobOrder(),
+		actionlint.NewRuleRunName(),
+		actionlint.NewRuleMatrixOutputs(),
+		actionlint.NewRuleGitHubToken(),
+		actionlint.NewRuleActionTrigger(),
+		actionlint.NewRuleUnreachableStep(),
+		actionlint.NewRuleUntrustedFlow(),
+		actionlint.NewRuleWindowsBash(),
+		actionlint.NewRuleScheduleTimezone(data),
+		actionlint.NewRuleEnvironmentFile(),
+		actionlint.NewRuleCommentGuard(),
+		actionlint.NewRuleRunnerTool(),
+		actionlint.NewRuleCheckoutPath(nil),
+		actionlint.NewRuleTraceSecrets(),
+		actionlint.NewRuleLiteralKey(),
+		actionlint.NewRuleCheckoutPersistCredentials(),
+		actionlint.NewRuleMatrixSuggestion(data),
+		actionlint.NewRuleMatrixUnused(data),
 	}
 
 	v := actionlint.NewVisitor()
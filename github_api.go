@@ -0,0 +1,449 @@
+package actionlint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultGitHubAPIURL is the base URL of GitHub REST API used when no URL is specified to
+// NewGitHubAPIClient.
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// The number of pages which can be fetched on listing items via GitHub API. 10 pages are 1000
+// items since the size of one page is 100.
+const gitHubAPIMaxPages = 10
+
+// The number of repositories queried in one GraphQL request on prefetching.
+const gitHubGraphQLBatchSize = 50
+
+// ErrGitHubAPIBudgetExceeded is an error returned when sending a request to GitHub API exceeds the
+// budget set by GitHubAPIClient.SetBudget.
+var ErrGitHubAPIBudgetExceeded = errors.New("budget of requests to GitHub API was exceeded")
+
+// GitHubAPIClient is a small client for GitHub REST API. It is used by checks which require network
+// access (online checks). Responses are cached in the client instance so sending the same request
+// multiple times does not consume the API rate limit. Calling methods of this type is thread-safe.
+type GitHubAPIClient struct {
+	base   string
+	token  string
+	client *http.Client
+	dbg    io.Writer
+	ctx    context.Context
+	state  *gitHubAPIClientState
+}
+
+// gitHubAPIClientState is a state shared by the clients derived with WithContext method. The mutex
+// guards only the cache and the budget. It is not held while sending requests so that requests for
+// different endpoints are sent in parallel. Requests for the same endpoint are de-duplicated by the
+// group.
+type gitHubAPIClientState struct {
+	mu     sync.Mutex
+	cache  map[string]*gitHubAPIResponse
+	budget int
+	sent   int
+	group  singleflight.Group
+}
+
+type gitHubAPIResponse struct {
+	body []byte
+	err  error
+}
+
+// GitHubRepository is a repository information returned from GitHub REST API. Only fields used by
+// actionlint are defined.
+type GitHubRepository struct {
+	// FullName is a repository slug like "owner/name".
+	FullName string `json:"full_name"`
+	// Fork is true when the repository is a fork of another repository.
+	Fork bool `json:"fork"`
+	// Parent is the repository which this repository was forked from. This value is nil when the
+	// repository is not a fork.
+	Parent *GitHubRepository `json:"parent"`
+}
+
+// NewGitHubAPIClient creates a new GitHubAPIClient instance. The base parameter is a base URL of
+// GitHub REST API. When it is empty, DefaultGitHubAPIURL is used. For GitHub Enterprise Server, it
+// should be "https://{host}/api/v3". The token parameter is an access token to send requests. When
+// it is empty, requests are sent without authentication. The dbg parameter is a writer to output
+// debug logs. When it is nil, no debug log is output.
+func NewGitHubAPIClient(base, token string, dbg io.Writer) *GitHubAPIClient {
+	if base == "" {
+		base = DefaultGitHubAPIURL
+	}
+	return &GitHubAPIClient{
+		base:   strings.TrimSuffix(base, "/"),
+		token:  token,
+		client: &http.Client{Timeout: 30 * time.Second},
+		dbg:    dbg,
+		ctx:    context.Background(),
+		state:  &gitHubAPIClientState{cache: map[string]*gitHubAPIResponse{}},
+	}
+}
+
+// WithContext returns a shallow copy of the client whose requests are sent with the ctx parameter.
+// When the context is canceled, in-flight requests are aborted. The returned client shares the
+// cache and the budget with the original client.
+func (c *GitHubAPIClient) WithContext(ctx context.Context) *GitHubAPIClient {
+	copied := *c
+	copied.ctx = ctx
+	return &copied
+}
+
+// SetBudget sets the maximum number of requests which the client sends in total. Requests which
+// exceed the budget fail with ErrGitHubAPIBudgetExceeded without being sent. Cached responses don't
+// consume the budget. Zero or negative value means no limit.
+func (c *GitHubAPIClient) SetBudget(n int) {
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+	c.state.budget = n
+}
+
+func (c *GitHubAPIClient) consumeBudget(u string) error {
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+	if c.state.budget > 0 && c.state.sent >= c.state.budget {
+		c.debug("Request to %s was not sent since %d requests were already sent", u, c.state.sent)
+		return fmt.Errorf("could not send request to %s: %w", u, ErrGitHubAPIBudgetExceeded)
+	}
+	c.state.sent++
+	return nil
+}
+
+func (c *GitHubAPIClient) debug(format string, args ...interface{}) {
+	if c.dbg == nil {
+		return
+	}
+	format = "[GitHubAPIClient] " + format + "\n"
+	fmt.Fprintf(c.dbg, format, args...)
+}
+
+func (c *GitHubAPIClient) cached(key string) (*gitHubAPIResponse, bool) {
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+	r, ok := c.state.cache[key]
+	return r, ok
+}
+
+func (c *GitHubAPIClient) store(key string, r *gitHubAPIResponse) {
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+	c.state.cache[key] = r
+}
+
+// fetch sends GET request to the API endpoint and returns the response body. The result is cached
+// by the path. While a request is in flight, other calls for the same endpoint wait for it instead
+// of sending the same request.
+func (c *GitHubAPIClient) fetch(path, accept string) ([]byte, error) {
+	key := accept + " " + path
+	if r, ok := c.cached(key); ok {
+		return r.body, r.err
+	}
+	v, _, _ := c.state.group.Do(key, func() (interface{}, error) {
+		if r, ok := c.cached(key); ok {
+			return r, nil // The response was cached after the check above
+		}
+		b, err := c.send(path, accept)
+		r := &gitHubAPIResponse{b, err}
+		if c.ctx.Err() == nil {
+			c.store(key, r) // Don't cache the error caused by the cancellation
+		}
+		return r, nil
+	})
+	r := v.(*gitHubAPIResponse)
+	return r.body, r.err
+}
+
+func (c *GitHubAPIClient) send(path, accept string) ([]byte, error) {
+	u := c.base + path
+	if err := c.consumeBudget(u); err != nil {
+		return nil, err
+	}
+	c.debug("Sending GET request to %s", u)
+
+	req, err := http.NewRequestWithContext(c.ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request for %s: %w", u, err)
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not send request to %s: %w", u, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || 300 <= res.StatusCode {
+		return nil, fmt.Errorf("request to %s was not successful: %s", u, res.Status)
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response from %s: %w", u, err)
+	}
+	return b, nil
+}
+
+func (c *GitHubAPIClient) get(path string, out interface{}) error {
+	b, err := c.fetch(path, gitHubJSONAccept)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("could not parse response from %s%s as JSON: %w", c.base, path, err)
+	}
+	return nil
+}
+
+// ListTags returns list of all tag names in the given repository. The repo parameter is a repository
+// slug like "owner/name". The result is cached.
+// https://docs.github.com/en/rest/repos/repos#list-repository-tags
+func (c *GitHubAPIClient) ListTags(repo string) ([]string, error) {
+	tags := []string{}
+	for page := 1; page <= gitHubAPIMaxPages; page++ {
+		var res []struct {
+			Name string `json:"name"`
+		}
+		if err := c.get(fmt.Sprintf("/repos/%s/tags?per_page=100&page=%d", repo, page), &res); err != nil {
+			return nil, err
+		}
+		for _, t := range res {
+			tags = append(tags, t.Name)
+		}
+		if len(res) < 100 {
+			break
+		}
+	}
+
+	c.debug("Fetched %d tags in repository %s", len(tags), repo)
+	return tags, nil
+}
+
+// Repository returns information of the given repository. The repo parameter is a repository slug
+// like "owner/name". The result is cached.
+// https://docs.github.com/en/rest/repos/repos#get-a-repository
+func (c *GitHubAPIClient) Repository(repo string) (*GitHubRepository, error) {
+	var r GitHubRepository
+	if err := c.get("/repos/"+repo, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// FileContent returns raw content of the file at the path in the given repository. The ref parameter
+// is a branch name, a tag name, or a commit SHA. When it is empty, the default branch is used. The
+// result is cached.
+// https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (c *GitHubAPIClient) FileContent(repo, path, ref string) ([]byte, error) {
+	return c.fetch(gitHubFileContentPath(repo, path, ref), gitHubRawAccept)
+}
+
+const (
+	gitHubJSONAccept = "application/vnd.github+json"
+	gitHubRawAccept  = "application/vnd.github.raw+json"
+)
+
+func gitHubFileContentPath(repo, path, ref string) string {
+	p := fmt.Sprintf("/repos/%s/contents/%s", repo, strings.TrimPrefix(path, "/"))
+	if ref != "" {
+		p += "?ref=" + url.QueryEscape(ref)
+	}
+	return p
+}
+
+// GitHubFile is a file at the ref in a GitHub repository.
+type GitHubFile struct {
+	// Repo is a repository slug like "owner/name".
+	Repo string
+	// Path is a file path from the repository root.
+	Path string
+	// Ref is a branch name, a tag name, or a commit SHA.
+	Ref string
+}
+
+func (c *GitHubAPIClient) graphQLURL() string {
+	// GitHub Enterprise Server: https://{host}/api/v3 -> https://{host}/api/graphql
+	if strings.HasSuffix(c.base, "/v3") {
+		return strings.TrimSuffix(c.base, "/v3") + "/graphql"
+	}
+	return c.base + "/graphql"
+}
+
+func (c *GitHubAPIClient) graphQL(query string, out interface{}) error {
+	u := c.graphQLURL()
+	if err := c.consumeBudget(u); err != nil {
+		return err
+	}
+	c.debug("Sending GraphQL request to %s", u)
+
+	body, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return fmt.Errorf("could not encode GraphQL query: %w", err)
+	}
+	req, err := http.NewRequestWithContext(c.ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request for %s: %w", u, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request to %s: %w", u, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || 300 <= res.StatusCode {
+		return fmt.Errorf("request to %s was not successful: %s", u, res.Status)
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response from %s: %w", u, err)
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("could not parse response from %s as JSON: %w", u, err)
+	}
+	return nil
+}
+
+func graphQLString(s string) string {
+	b, _ := json.Marshal(s) // GraphQL string literal is compatible with JSON string
+	return string(b)
+}
+
+// Prefetch fetches information of the repositories and contents of the files in batches via GitHub
+// GraphQL API and caches them. Following calls of Repository and FileContent methods for them don't
+// send any request. This consumes far less rate limit than sending REST API requests for each
+// repository and file. Since GraphQL API requires authentication, this method does nothing when no
+// access token is set.
+// https://docs.github.com/en/graphql/reference/objects#repository
+func (c *GitHubAPIClient) Prefetch(repos []string, files []*GitHubFile) error {
+	if c.token == "" {
+		return nil
+	}
+
+	type target struct {
+		repo  string
+		files []*GitHubFile
+	}
+	idx := map[string]*target{}
+	targets := []*target{}
+	add := func(repo string) *target {
+		if t, ok := idx[repo]; ok {
+			return t
+		}
+		t := &target{repo: repo}
+		idx[repo] = t
+		targets = append(targets, t)
+		return t
+	}
+	for _, r := range repos {
+		if _, ok := c.cached(gitHubJSONAccept + " /repos/" + r); !ok {
+			add(r)
+		}
+	}
+	for _, f := range files {
+		if _, ok := c.cached(gitHubRawAccept + " " + gitHubFileContentPath(f.Repo, f.Path, f.Ref)); !ok {
+			t := add(f.Repo)
+			t.files = append(t.files, f)
+		}
+	}
+
+	for len(targets) > 0 {
+		n := len(targets)
+		if n > gitHubGraphQLBatchSize {
+			n = gitHubGraphQLBatchSize
+		}
+		batch := targets[:n]
+		targets = targets[n:]
+
+		var q strings.Builder
+		q.WriteString("query {\n")
+		for i, t := range batch {
+			owner, name, ok := strings.Cut(t.repo, "/")
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&q, "  r%d: repository(owner: %s, name: %s) {\n    nameWithOwner\n    isFork\n    parent { nameWithOwner }\n", i, graphQLString(owner), graphQLString(name))
+			for j, f := range t.files {
+				fmt.Fprintf(&q, "    f%d: object(expression: %s) { ... on Blob { text } }\n", j, graphQLString(f.Ref+":"+strings.TrimPrefix(f.Path, "/")))
+			}
+			q.WriteString("  }\n")
+		}
+		q.WriteString("}\n")
+
+		var res struct {
+			Data map[string]json.RawMessage `json:"data"`
+		}
+		if err := c.graphQL(q.String(), &res); err != nil {
+			return err
+		}
+
+		for i, t := range batch {
+			raw := res.Data[fmt.Sprintf("r%d", i)]
+			var repo *struct {
+				NameWithOwner string `json:"nameWithOwner"`
+				IsFork        bool   `json:"isFork"`
+				Parent        *struct {
+					NameWithOwner string `json:"nameWithOwner"`
+				} `json:"parent"`
+			}
+			var objs map[string]*struct {
+				Text *string `json:"text"`
+			}
+			if raw != nil {
+				if err := json.Unmarshal(raw, &repo); err != nil {
+					return fmt.Errorf("could not parse repository %s in GraphQL response: %w", t.repo, err)
+				}
+				json.Unmarshal(raw, &objs) // Ignore errors since non-object fields are also included
+			}
+
+			if repo == nil {
+				err := fmt.Errorf("repository %s was not found via GraphQL API", t.repo)
+				c.store(gitHubJSONAccept+" /repos/"+t.repo, &gitHubAPIResponse{nil, err})
+				for _, f := range t.files {
+					c.store(gitHubRawAccept+" "+gitHubFileContentPath(f.Repo, f.Path, f.Ref), &gitHubAPIResponse{nil, err})
+				}
+				continue
+			}
+
+			info := &GitHubRepository{FullName: repo.NameWithOwner, Fork: repo.IsFork}
+			if repo.Parent != nil {
+				info.Parent = &GitHubRepository{FullName: repo.Parent.NameWithOwner}
+			}
+			b, err := json.Marshal(info)
+			if err != nil {
+				return fmt.Errorf("could not encode repository %s: %w", t.repo, err)
+			}
+			c.store(gitHubJSONAccept+" /repos/"+t.repo, &gitHubAPIResponse{b, nil})
+
+			for j, f := range t.files {
+				key := gitHubRawAccept + " " + gitHubFileContentPath(f.Repo, f.Path, f.Ref)
+				if o := objs[fmt.Sprintf("f%d", j)]; o != nil && o.Text != nil {
+					c.store(key, &gitHubAPIResponse{[]byte(*o.Text), nil})
+				} else {
+					c.store(key, &gitHubAPIResponse{nil, fmt.Errorf("file %s at %s in repository %s was not found via GraphQL API", f.Path, f.Ref, f.Repo)})
+				}
+			}
+		}
+
+		c.debug("Prefetched %d repositories via GraphQL API", len(batch))
+	}
+
+	return nil
+}
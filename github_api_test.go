@@ -0,0 +1,413 @@
+package actionlint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testGitHubTagsServer(t *testing.T, tags []string, requests *int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.URL.Path != "/repos/owner/repo/tags" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if a := r.Header.Get("Authorization"); a != "Bearer dummy-token" {
+			t.Errorf("unexpected Authorization header %q", a)
+		}
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			t.Errorf("invalid page query: %s", err)
+		}
+		start := (page - 1) * 100
+		end := start + 100
+		if end > len(tags) {
+			end = len(tags)
+		}
+		if start > end {
+			start = end
+		}
+		var b strings.Builder
+		b.WriteByte('[')
+		for i, t := range tags[start:end] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			fmt.Fprintf(&b, `{"name":%q}`, t)
+		}
+		b.WriteByte(']')
+		w.Write([]byte(b.String()))
+	}))
+}
+
+func TestGitHubAPIClientListTags(t *testing.T) {
+	for _, n := range []int{0, 3, 100, 250} {
+		t.Run(fmt.Sprintf("%d tags", n), func(t *testing.T) {
+			want := make([]string, 0, n)
+			for i := 0; i < n; i++ {
+				want = append(want, fmt.Sprintf("v1.0.%d", i))
+			}
+			requests := 0
+			s := testGitHubTagsServer(t, want, &requests)
+			defer s.Close()
+
+			c := NewGitHubAPIClient(s.URL, "dummy-token", nil)
+			have, err := c.ListTags("owner/repo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, have); diff != "" {
+				t.Fatal(diff)
+			}
+
+			sent := requests
+			if _, err := c.ListTags("owner/repo"); err != nil {
+				t.Fatal(err)
+			}
+			if requests != sent {
+				t.Fatalf("result was not cached. %d requests were sent but wanted %d", requests, sent)
+			}
+		})
+	}
+}
+
+func TestGitHubAPIClientListTagsError(t *testing.T) {
+	requests := 0
+	s := testGitHubTagsServer(t, nil, &requests)
+	defer s.Close()
+
+	c := NewGitHubAPIClient(s.URL+"/", "dummy-token", nil)
+	_, err := c.ListTags("owner/unknown")
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "404 Not Found") {
+		t.Fatalf("unexpected error message: %q", msg)
+	}
+
+	if _, err := c.ListTags("owner/unknown"); err == nil {
+		t.Fatal("error was not cached")
+	}
+	if requests != 1 {
+		t.Fatalf("error result was not cached. %d requests were sent", requests)
+	}
+}
+
+func testGitHubAPIServer(t *testing.T, responses map[string]string, requests *int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		p := r.URL.Path
+		if q := r.URL.RawQuery; q != "" {
+			p += "?" + q
+		}
+		b, ok := responses[p]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(b))
+	}))
+}
+
+func TestGitHubAPIClientRepository(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/fork":     `{"full_name":"owner/fork","fork":true,"parent":{"full_name":"actions/checkout","fork":false}}`,
+		"/repos/owner/not-fork": `{"full_name":"owner/not-fork","fork":false}`,
+	}, &requests)
+	defer s.Close()
+	c := NewGitHubAPIClient(s.URL, "", nil)
+
+	r, err := c.Repository("owner/fork")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &GitHubRepository{
+		FullName: "owner/fork",
+		Fork:     true,
+		Parent:   &GitHubRepository{FullName: "actions/checkout"},
+	}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Fatal(diff)
+	}
+
+	r, err = c.Repository("owner/not-fork")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Fork || r.Parent != nil {
+		t.Fatalf("repository should not be a fork: %#v", r)
+	}
+
+	if _, err := c.Repository("owner/fork"); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Fatalf("response was not cached. %d requests were sent", requests)
+	}
+}
+
+func TestGitHubAPIClientFileContent(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/repo/contents/action.yml?ref=v1":            "name: Foo",
+		"/repos/owner/repo/contents/path/to/action.yml?ref=v%2F1": "name: Bar",
+		"/repos/owner/repo/contents/action.yml":                   "name: Default",
+	}, &requests)
+	defer s.Close()
+	c := NewGitHubAPIClient(s.URL, "", nil)
+
+	for _, tc := range []struct {
+		path string
+		ref  string
+		want string
+	}{
+		{"action.yml", "v1", "name: Foo"},
+		{"/path/to/action.yml", "v/1", "name: Bar"},
+		{"action.yml", "", "name: Default"},
+	} {
+		b, err := c.FileContent("owner/repo", tc.path, tc.ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tc.want {
+			t.Fatalf("wanted %q but got %q", tc.want, b)
+		}
+	}
+
+	if _, err := c.FileContent("owner/repo", "action.yaml", "v1"); err == nil {
+		t.Fatal("error did not occur")
+	}
+}
+
+func TestGitHubAPIClientPrefetch(t *testing.T) {
+	requests := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodPost || r.URL.Path != "/graphql" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req struct {
+			Query string `json:"query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		for _, want := range []string{
+			`r0: repository(owner: "owner", name: "fork")`,
+			`f0: object(expression: "v1:action.yml")`,
+			`f1: object(expression: "v1:path/action.yml")`,
+			`r1: repository(owner: "owner", name: "missing")`,
+		} {
+			if !strings.Contains(req.Query, want) {
+				t.Errorf("query does not contain %q: %s", want, req.Query)
+			}
+		}
+		w.Write([]byte(`{
+			"data": {
+				"r0": {
+					"nameWithOwner": "owner/fork",
+					"isFork": true,
+					"parent": {"nameWithOwner": "actions/checkout"},
+					"f0": {"text": "name: Checkout"},
+					"f1": null
+				},
+				"r1": null
+			}
+		}`))
+	}))
+	defer s.Close()
+
+	c := NewGitHubAPIClient(s.URL, "dummy-token", nil)
+	err := c.Prefetch(
+		[]string{"owner/fork", "owner/missing"},
+		[]*GitHubFile{{"owner/fork", "action.yml", "v1"}, {"owner/fork", "path/action.yml", "v1"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := c.Repository("owner/fork")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &GitHubRepository{
+		FullName: "owner/fork",
+		Fork:     true,
+		Parent:   &GitHubRepository{FullName: "actions/checkout"},
+	}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Fatal(diff)
+	}
+	b, err := c.FileContent("owner/fork", "action.yml", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "name: Checkout" {
+		t.Fatalf("unexpected file content %q", b)
+	}
+	if _, err := c.FileContent("owner/fork", "path/action.yml", "v1"); err == nil {
+		t.Fatal("error did not occur for missing file")
+	}
+	if _, err := c.Repository("owner/missing"); err == nil {
+		t.Fatal("error did not occur for missing repository")
+	}
+
+	if err := c.Prefetch([]string{"owner/fork"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Fatalf("prefetched results were not cached. %d requests were sent", requests)
+	}
+}
+
+func TestGitHubAPIClientPrefetchWithoutToken(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{}, &requests)
+	defer s.Close()
+
+	c := NewGitHubAPIClient(s.URL, "", nil)
+	if err := c.Prefetch([]string{"owner/repo"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 0 {
+		t.Fatalf("%d requests were sent without token", requests)
+	}
+}
+
+func TestGitHubAPIClientGraphQLURL(t *testing.T) {
+	for base, want := range map[string]string{
+		"":                                  "https://api.github.com/graphql",
+		"https://github.example.com/api/v3": "https://github.example.com/api/graphql",
+	} {
+		if have := NewGitHubAPIClient(base, "", nil).graphQLURL(); have != want {
+			t.Errorf("wanted %q but got %q for base URL %q", want, have, base)
+		}
+	}
+}
+
+func TestGitHubAPIClientBudget(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/a": `{"full_name":"owner/a"}`,
+		"/repos/owner/b": `{"full_name":"owner/b"}`,
+	}, &requests)
+	defer s.Close()
+
+	c := NewGitHubAPIClient(s.URL, "", nil)
+	c.SetBudget(1)
+	if _, err := c.Repository("owner/a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Repository("owner/a"); err != nil {
+		t.Fatal("cached response should not consume budget:", err)
+	}
+	_, err := c.Repository("owner/b")
+	if !errors.Is(err, ErrGitHubAPIBudgetExceeded) {
+		t.Fatalf("budget error was expected but got %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("%d requests were sent but wanted 1", requests)
+	}
+}
+
+func TestGitHubAPIClientWithContext(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/a": `{"full_name":"owner/a"}`,
+	}, &requests)
+	defer s.Close()
+
+	c := NewGitHubAPIClient(s.URL, "", nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.WithContext(ctx).Repository("owner/a")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled error was expected but got %v", err)
+	}
+
+	// The error caused by the cancellation is not cached
+	r, err := c.Repository("owner/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.FullName != "owner/a" {
+		t.Fatalf("unexpected repository: %#v", r)
+	}
+
+	// The derived client shares the cache with the original client
+	if _, err := c.WithContext(context.Background()).Repository("owner/a"); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Fatalf("%d requests were sent but wanted 1", requests)
+	}
+}
+
+func TestGitHubAPIClientConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	arrived := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/repos/owner/a":
+			// Respond after the request for owner/b arrives. This blocks forever when requests are
+			// sent one by one
+			select {
+			case <-arrived:
+			case <-time.After(5 * time.Second):
+				t.Error("request for owner/b was not sent while request for owner/a is in flight")
+			}
+		case "/repos/owner/b":
+			close(arrived)
+		}
+		fmt.Fprintf(w, `{"full_name":%q}`, strings.TrimPrefix(r.URL.Path, "/repos/"))
+	}))
+	defer s.Close()
+
+	c := NewGitHubAPIClient(s.URL, "", nil)
+	var wg sync.WaitGroup
+	for _, repo := range []string{"owner/a", "owner/a", "owner/a", "owner/b"} {
+		wg.Add(1)
+		go func(repo string) {
+			defer wg.Done()
+			if repo == "owner/b" {
+				time.Sleep(10 * time.Millisecond) // Send the request after the requests for owner/a
+			}
+			r, err := c.Repository(repo)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if r.FullName != repo {
+				t.Errorf("wanted %q but got %q", repo, r.FullName)
+			}
+		}(repo)
+	}
+	wg.Wait()
+
+	// Requests for the same endpoint in flight are sent only once
+	want := map[string]int{"/repos/owner/a": 1, "/repos/owner/b": 1}
+	if !cmp.Equal(want, requests) {
+		t.Fatal(cmp.Diff(want, requests))
+	}
+}
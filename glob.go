@@ -2,7 +2,9 @@ package actionlint
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"sync"
 	"text/scanner"
 	"unicode"
 )
@@ -253,3 +255,94 @@ func ValidatePathGlob(pat string) []InvalidGlobPattern {
 	}
 	return validateGlob(pat, false)
 }
+
+// compileGlob converts a glob pattern in filter into a regular expression. The pattern must be
+// validated with ValidateRefGlob or ValidatePathGlob in advance. Leading '!' for negation must be
+// removed from the pattern by a caller.
+// https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#filter-pattern-cheat-sheet
+func compileGlob(pat string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteRune('^')
+	rs := []rune(pat)
+	for i := 0; i < len(rs); i++ {
+		switch c := rs[i]; c {
+		case '*':
+			if i+1 < len(rs) && rs[i+1] == '*' {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?', '+':
+			// '?' and '+' are applied to the preceding character as well as regular expression
+			b.WriteRune(c)
+		case '[':
+			j := i + 1
+			for j < len(rs) && rs[j] != ']' {
+				j++
+			}
+			if j == len(rs) {
+				return nil, fmt.Errorf("missing ] in glob pattern %q", pat)
+			}
+			b.WriteRune('[')
+			for _, r := range rs[i+1 : j] {
+				if r == '\\' || r == '[' || r == '^' {
+					b.WriteRune('\\')
+				}
+				b.WriteRune(r)
+			}
+			b.WriteRune(']')
+			i = j
+		case '\\':
+			if i+1 < len(rs) {
+				i++
+				c = rs[i]
+			}
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteRune('$')
+	return regexp.Compile(b.String())
+}
+
+// matchGlobFilter returns whether the given input matches to the list of glob patterns in filter
+// such as "branches:" or "tags:". Patterns starting with '!' exclude the input matched by the
+// preceding patterns. The last matching pattern decides the result. Invalid patterns are ignored.
+func matchGlobFilter(pats []string, input string) bool {
+	matched := false
+	for _, p := range pats {
+		neg := strings.HasPrefix(p, "!")
+		if neg {
+			p = p[1:]
+		}
+		r := compileGlobCached(p)
+		if r == nil {
+			continue
+		}
+		if r.MatchString(input) {
+			matched = !neg
+		}
+	}
+	return matched
+}
+
+// globCache caches regular expressions compiled from glob patterns. The same patterns in config
+// and workflows are matched repeatedly for each workflow, job, step, and error so compiling them
+// at every match is costly. nil is cached for invalid patterns.
+var globCache sync.Map
+
+// compileGlobCached is the same as compileGlob but the compiled regular expression is cached. It
+// returns nil when the pattern is invalid.
+func compileGlobCached(pat string) *regexp.Regexp {
+	if v, ok := globCache.Load(pat); ok {
+		return v.(*regexp.Regexp)
+	}
+	r, err := compileGlob(pat)
+	if err != nil {
+		r = nil
+	}
+	globCache.Store(pat, r)
+	return r
+}
@@ -411,3 +411,40 @@ func TestValidateGlobQuoteCharacterInErrorMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchGlobFilter(t *testing.T) {
+	testCases := []struct {
+		what  string
+		pats  []string
+		input string
+		want  bool
+	}{
+		{"exact", []string{"v1.0.0"}, "v1.0.0", true},
+		{"exact mismatch", []string{"v1.0.0"}, "v1.0.1", false},
+		{"star", []string{"v*"}, "v1.2.3", true},
+		{"star does not match slash", []string{"release/*"}, "release/v1/rc", false},
+		{"double star matches slash", []string{"release/**"}, "release/v1/rc", true},
+		{"question", []string{"v1?"}, "v", true},
+		{"plus", []string{"v[0-9]+.[0-9]+.[0-9]+"}, "v12.3.45", true},
+		{"plus mismatch", []string{"v[0-9]+.[0-9]+.[0-9]+"}, "1.2.3", false},
+		{"dot is not wildcard", []string{"v1.0"}, "v1x0", false},
+		{"character class", []string{"v[12].*"}, "v2.0", true},
+		{"character class mismatch", []string{"v[12].*"}, "v3.0", false},
+		{"escape", []string{`\*`}, "*", true},
+		{"negation", []string{"v*", "!v*-rc*"}, "v1.0.0-rc1", false},
+		{"last match wins", []string{"v*", "!v*-rc*", "v2.0.0-rc1"}, "v2.0.0-rc1", true},
+		{"only negation", []string{"!v*"}, "1.0.0", false},
+		{"multiple patterns", []string{"v*", "release-*"}, "release-2023", true},
+		{"empty", []string{}, "v1", false},
+		{"invalid pattern is ignored", []string{"[v1", "v*"}, "v1", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			have := matchGlobFilter(tc.pats, tc.input)
+			if have != tc.want {
+				t.Fatalf("wanted %v but got %v for %q with patterns %q", tc.want, have, tc.input, tc.pats)
+			}
+		})
+	}
+}
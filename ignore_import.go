@@ -0,0 +1,422 @@
+package actionlint
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IgnoreImport is a result of converting suppression settings of another linter into "paths:"
+// section of actionlint config.
+type IgnoreImport struct {
+	// Paths is the converted configuration. Keys are glob patterns of file paths. It can be put in
+	// "paths:" section of actionlint.yaml as-is.
+	Paths map[string]*PathConfig
+	// Warnings is list of settings which could not be converted.
+	Warnings []string
+}
+
+func (imp *IgnoreImport) add(path string, pats ...string) {
+	c, ok := imp.Paths[path]
+	if !ok {
+		c = &PathConfig{}
+		imp.Paths[path] = c
+	}
+Loop:
+	for _, p := range pats {
+		for _, i := range c.Ignore {
+			if i == p {
+				continue Loop
+			}
+		}
+		c.Ignore = append(c.Ignore, p)
+	}
+}
+
+func (imp *IgnoreImport) warnf(format string, args ...interface{}) {
+	imp.Warnings = append(imp.Warnings, fmt.Sprintf(format, args...))
+}
+
+// YAML returns the converted configuration as "paths:" section of actionlint.yaml.
+func (imp *IgnoreImport) YAML() ([]byte, error) {
+	if len(imp.Paths) == 0 {
+		return nil, nil
+	}
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	c := struct {
+		Paths map[string]*PathConfig `yaml:"paths"`
+	}{imp.Paths}
+	if err := enc.Encode(&c); err != nil {
+		return nil, fmt.Errorf("could not encode imported config: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// ignoreImporters is a map from names of linters to the functions to import their suppression
+// settings.
+var ignoreImporters = map[string]func([]byte, *IgnoreImport) error{
+	"yamllint":     importYAMLLintIgnore,
+	"reviewdog":    importReviewdogIgnore,
+	"super-linter": importSuperLinterIgnore,
+}
+
+// IgnoreImportKinds returns the sorted names of linters whose suppression settings can be imported.
+func IgnoreImportKinds() []string {
+	ks := make([]string, 0, len(ignoreImporters))
+	for k := range ignoreImporters {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+// DetectIgnoreImportKind detects the name of linter from the path of its config file. It returns
+// an empty string when the linter is unknown.
+func DetectIgnoreImportKind(path string) string {
+	switch b := strings.ToLower(filepath.Base(path)); b {
+	case ".yamllint", ".yamllint.yml", ".yamllint.yaml":
+		return "yamllint"
+	case ".reviewdog.yml", ".reviewdog.yaml", "reviewdog.yml", "reviewdog.yaml":
+		return "reviewdog"
+	default:
+		if strings.HasSuffix(b, ".env") {
+			return "super-linter"
+		}
+		return ""
+	}
+}
+
+// ImportIgnoreConfig converts suppression settings in the config file of another linter into
+// "paths:" section of actionlint config. The kind parameter is a name of the linter. See
+// IgnoreImportKinds for the available names.
+//
+//   - yamllint: Files ignored by "ignore:" in .yamllint are ignored
+//   - reviewdog: Patterns given to -ignore flags of actionlint commands in .reviewdog.yml are ignored
+//   - super-linter: Files excluded by FILTER_REGEX_EXCLUDE and patterns given to -ignore flags in
+//     GITHUB_ACTIONS_COMMAND_ARGS are ignored
+func ImportIgnoreConfig(kind string, src []byte) (*IgnoreImport, error) {
+	f, ok := ignoreImporters[kind]
+	if !ok {
+		return nil, fmt.Errorf("cannot import suppression settings of unknown linter %q. available linters are %s", kind, sortedQuotes(IgnoreImportKinds()))
+	}
+	imp := &IgnoreImport{Paths: map[string]*PathConfig{}}
+	if err := f(src, imp); err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+// importYAMLLintIgnore imports "ignore:" of yamllint config. It is a string of gitignore-style
+// patterns separated by newlines or a list of the patterns.
+// https://yamllint.readthedocs.io/en/stable/configuration.html#ignoring-paths
+func importYAMLLintIgnore(src []byte, imp *IgnoreImport) error {
+	var c struct {
+		Ignore         yaml.Node            `yaml:"ignore"`
+		IgnoreFromFile yaml.Node            `yaml:"ignore-from-file"`
+		Rules          map[string]yaml.Node `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal(src, &c); err != nil {
+		return fmt.Errorf("could not parse yamllint config: %s", strings.ReplaceAll(err.Error(), "\n", " "))
+	}
+
+	pats, err := yamlLintPatterns(&c.Ignore)
+	if err != nil {
+		return err
+	}
+	for _, p := range pats {
+		globs, ok := gitignoreToGlobs(p)
+		if !ok {
+			imp.warnf("pattern %q in \"ignore\" cannot be converted to glob pattern", p)
+			continue
+		}
+		for _, g := range globs {
+			imp.add(g, ".*")
+		}
+	}
+
+	if c.IgnoreFromFile.Kind != 0 {
+		imp.warnf("\"ignore-from-file\" is not imported. convert the files given to it separately")
+	}
+	names := make([]string, 0, len(c.Rules))
+	for n := range c.Rules {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		r := c.Rules[n]
+		if r.Kind == yaml.MappingNode && findYAMLMappingValue(&r, "ignore") != nil {
+			imp.warnf("\"ignore\" of rule %q is not imported since yamllint rules don't correspond to actionlint rules", n)
+		}
+	}
+	return nil
+}
+
+func yamlLintPatterns(n *yaml.Node) ([]string, error) {
+	switch n.Kind {
+	case 0:
+		return nil, nil
+	case yaml.ScalarNode:
+		return strings.Split(n.Value, "\n"), nil
+	case yaml.SequenceNode:
+		ps := make([]string, 0, len(n.Content))
+		for _, c := range n.Content {
+			if c.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line:%d,col:%d: element of \"ignore\" in yamllint config must be string", c.Line, c.Column)
+			}
+			ps = append(ps, c.Value)
+		}
+		return ps, nil
+	default:
+		return nil, fmt.Errorf("line:%d,col:%d: \"ignore\" in yamllint config must be string or list of strings", n.Line, n.Column)
+	}
+}
+
+func findYAMLMappingValue(n *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// gitignoreToGlobs converts the gitignore-style pattern into glob patterns matched to file paths
+// relative to the repository root. It returns false when the pattern cannot be converted. Empty
+// lines and comments are converted into no glob pattern.
+// https://git-scm.com/docs/gitignore#_pattern_format
+func gitignoreToGlobs(p string) ([]string, bool) {
+	p = strings.TrimSpace(p)
+	if p == "" || strings.HasPrefix(p, "#") {
+		return nil, true
+	}
+	// Negation and "?" (any single character) cannot be represented in the glob syntax
+	if strings.HasPrefix(p, "!") || strings.ContainsAny(p, "?\\") {
+		return nil, false
+	}
+
+	dir := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	// A pattern which contains slash is relative to the root. Otherwise it matches at any level.
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		return nil, false
+	}
+
+	globs := []string{p}
+	if !anchored {
+		globs = append(globs, "**/"+p)
+	}
+	if dir {
+		for i := range globs {
+			globs[i] += "/**"
+		}
+		return globs, true
+	}
+	if e := path.Ext(p); e == ".yml" || e == ".yaml" {
+		return globs, true
+	}
+	// The pattern may match a directory. Files in it are also ignored
+	for _, g := range globs {
+		globs = append(globs, g+"/**")
+	}
+	return globs, true
+}
+
+// importReviewdogIgnore imports patterns given to -ignore flags of actionlint commands in runners
+// of reviewdog config.
+// https://github.com/reviewdog/reviewdog#reviewdog-config-file
+func importReviewdogIgnore(src []byte, imp *IgnoreImport) error {
+	var c struct {
+		Runner map[string]struct {
+			Cmd string `yaml:"cmd"`
+		} `yaml:"runner"`
+	}
+	if err := yaml.Unmarshal(src, &c); err != nil {
+		return fmt.Errorf("could not parse reviewdog config: %s", strings.ReplaceAll(err.Error(), "\n", " "))
+	}
+
+	names := make([]string, 0, len(c.Runner))
+	for n := range c.Runner {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	found := false
+	for _, n := range names {
+		args := splitCommandArgs(c.Runner[n].Cmd)
+		i := actionlintArgsIndex(args)
+		if i < 0 {
+			continue
+		}
+		found = true
+		importIgnoreFlags(args[i:], imp)
+	}
+	if !found {
+		imp.warnf("no runner running actionlint command was found in reviewdog config")
+	}
+	return nil
+}
+
+// importSuperLinterIgnore imports the environment variables in super-linter's env file such as
+// .github/super-linter.env.
+// https://github.com/super-linter/super-linter#configure-super-linter
+func importSuperLinterIgnore(src []byte, imp *IgnoreImport) error {
+	s := bufio.NewScanner(bytes.NewReader(src))
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		l = strings.TrimPrefix(l, "export ")
+		k, v, ok := strings.Cut(l, "=")
+		if !ok {
+			continue
+		}
+		k = strings.TrimSpace(k)
+		v = strings.TrimSpace(v)
+		if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+			if v[0] == '"' {
+				v = strings.ReplaceAll(v[1:len(v)-1], `\"`, `"`)
+			} else {
+				v = v[1 : len(v)-1]
+			}
+		}
+
+		switch k {
+		case "FILTER_REGEX_EXCLUDE":
+			g, ok := regexToGlob(v)
+			if !ok {
+				imp.warnf("regular expression %q of FILTER_REGEX_EXCLUDE cannot be converted to glob pattern", v)
+				continue
+			}
+			imp.add(g, ".*")
+		case "GITHUB_ACTIONS_COMMAND_ARGS":
+			importIgnoreFlags(splitCommandArgs(v), imp)
+		case "VALIDATE_GITHUB_ACTIONS":
+			if strings.EqualFold(v, "false") {
+				imp.warnf("actionlint is disabled by VALIDATE_GITHUB_ACTIONS=false. it is not imported")
+			}
+		case "FILTER_REGEX_INCLUDE":
+			imp.warnf("FILTER_REGEX_INCLUDE is not imported since actionlint config cannot include only some files")
+		}
+	}
+	if err := s.Err(); err != nil {
+		return fmt.Errorf("could not read super-linter env file: %w", err)
+	}
+	return nil
+}
+
+// regexToGlob converts the regular expression matched to file paths into a glob pattern. Only
+// regular expressions consisting of literals and ".*" can be converted. "." not followed by "*" is
+// treated as a literal dot since it is usually intended as a separator of file extension.
+func regexToGlob(r string) (string, bool) {
+	if _, err := regexp.Compile(r); err != nil {
+		return "", false
+	}
+	r = strings.TrimPrefix(r, "^")
+	r = strings.TrimSuffix(r, "$")
+	var b strings.Builder
+	for i := 0; i < len(r); i++ {
+		c := r[i]
+		switch {
+		case c == '.' && i+1 < len(r) && r[i+1] == '*':
+			b.WriteString("**")
+			i++
+		case c == '\\' && i+1 < len(r) && strings.IndexByte(`./-_`, r[i+1]) >= 0:
+			b.WriteByte(r[i+1])
+			i++
+		case c == '.':
+			b.WriteByte(c)
+		case strings.IndexByte(`\[](){}|+?*^$`, c) >= 0:
+			return "", false
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if b.Len() == 0 {
+		return "", false
+	}
+	return b.String(), true
+}
+
+// importIgnoreFlags imports patterns given to -ignore flags in the command line arguments of
+// actionlint. The patterns are applied to all files.
+func importIgnoreFlags(args []string, imp *IgnoreImport) {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "-ignore" || a == "--ignore":
+			if i+1 < len(args) {
+				imp.add("**", args[i+1])
+				i++
+			}
+		case strings.HasPrefix(a, "-ignore=") || strings.HasPrefix(a, "--ignore="):
+			_, v, _ := strings.Cut(a, "=")
+			imp.add("**", v)
+		}
+	}
+}
+
+// actionlintArgsIndex returns the index of actionlint command in the command line arguments. -1 is
+// returned when actionlint is not run.
+func actionlintArgsIndex(args []string) int {
+	for i, a := range args {
+		if b := filepath.Base(a); b == "actionlint" || b == "actionlint.exe" {
+			return i
+		}
+	}
+	return -1
+}
+
+// splitCommandArgs splits the command line into arguments. Single quotes, double quotes, and
+// backslash escapes are handled roughly in the same way as shells.
+func splitCommandArgs(s string) []string {
+	ret := []string{}
+	var b strings.Builder
+	inArg := false
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' && i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			} else {
+				b.WriteByte(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				ret = append(ret, b.String())
+				b.Reset()
+				inArg = false
+			}
+		default:
+			b.WriteByte(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		ret = append(ret, b.String())
+	}
+	return ret
+}
@@ -0,0 +1,213 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIgnoreImportYAMLLint(t *testing.T) {
+	input := `extends: default
+ignore: |
+  # Generated files
+  /vendor/
+  *.generated.yaml
+  .github/workflows/legacy.yml
+  !keep.yaml
+rules:
+  truthy:
+    ignore: .github/
+  line-length: disable
+`
+	imp, err := ImportIgnoreConfig("yamllint", []byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]*PathConfig{
+		"vendor/**":                    {Ignore: []string{".*"}},
+		"*.generated.yaml":             {Ignore: []string{".*"}},
+		"**/*.generated.yaml":          {Ignore: []string{".*"}},
+		".github/workflows/legacy.yml": {Ignore: []string{".*"}},
+	}
+	if !cmp.Equal(want, imp.Paths, cmp.AllowUnexported(PathConfig{})) {
+		t.Error(cmp.Diff(want, imp.Paths, cmp.AllowUnexported(PathConfig{})))
+	}
+
+	if len(imp.Warnings) != 2 {
+		t.Fatalf("wanted 2 warnings but got %q", imp.Warnings)
+	}
+	if !strings.Contains(imp.Warnings[0], `pattern "!keep.yaml" in "ignore" cannot be converted`) {
+		t.Errorf("unexpected warning: %q", imp.Warnings[0])
+	}
+	if !strings.Contains(imp.Warnings[1], `"ignore" of rule "truthy" is not imported`) {
+		t.Errorf("unexpected warning: %q", imp.Warnings[1])
+	}
+}
+
+func TestIgnoreImportYAMLLintList(t *testing.T) {
+	input := "ignore: [legacy, /docs/*.yaml]\n"
+	imp, err := ImportIgnoreConfig("yamllint", []byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*PathConfig{
+		"legacy":       {Ignore: []string{".*"}},
+		"**/legacy":    {Ignore: []string{".*"}},
+		"legacy/**":    {Ignore: []string{".*"}},
+		"**/legacy/**": {Ignore: []string{".*"}},
+		"docs/*.yaml":  {Ignore: []string{".*"}},
+	}
+	if !cmp.Equal(want, imp.Paths, cmp.AllowUnexported(PathConfig{})) {
+		t.Error(cmp.Diff(want, imp.Paths, cmp.AllowUnexported(PathConfig{})))
+	}
+}
+
+func TestIgnoreImportReviewdog(t *testing.T) {
+	input := `runner:
+  actionlint:
+    cmd: actionlint -oneline -ignore 'label "linux-gpu" is unknown' -ignore=SC2086 --ignore "a\"b"
+    errorformat:
+      - "%f:%l:%c: %m"
+  golint:
+    cmd: golint ./... -ignore foo
+`
+	imp, err := ImportIgnoreConfig("reviewdog", []byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*PathConfig{
+		"**": {Ignore: []string{`label "linux-gpu" is unknown`, "SC2086", `a"b`}},
+	}
+	if !cmp.Equal(want, imp.Paths, cmp.AllowUnexported(PathConfig{})) {
+		t.Error(cmp.Diff(want, imp.Paths, cmp.AllowUnexported(PathConfig{})))
+	}
+	if len(imp.Warnings) > 0 {
+		t.Errorf("unexpected warnings: %q", imp.Warnings)
+	}
+
+	imp, err = ImportIgnoreConfig("reviewdog", []byte("runner:\n  golint:\n    cmd: golint ./...\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imp.Paths) > 0 || len(imp.Warnings) != 1 {
+		t.Errorf("nothing should be imported with one warning: %v %q", imp.Paths, imp.Warnings)
+	}
+}
+
+func TestIgnoreImportSuperLinter(t *testing.T) {
+	input := `# Super-linter config
+VALIDATE_ALL_CODEBASE=false
+export FILTER_REGEX_EXCLUDE=".github/workflows/legacy/.*"
+GITHUB_ACTIONS_COMMAND_ARGS="-ignore 'property \"foo\" is not defined'"
+FILTER_REGEX_INCLUDE=.*src/.*
+`
+	imp, err := ImportIgnoreConfig("super-linter", []byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*PathConfig{
+		".github/workflows/legacy/**": {Ignore: []string{".*"}},
+		"**":                          {Ignore: []string{`property "foo" is not defined`}},
+	}
+	if !cmp.Equal(want, imp.Paths, cmp.AllowUnexported(PathConfig{})) {
+		t.Error(cmp.Diff(want, imp.Paths, cmp.AllowUnexported(PathConfig{})))
+	}
+	if len(imp.Warnings) != 1 || !strings.Contains(imp.Warnings[0], "FILTER_REGEX_INCLUDE is not imported") {
+		t.Errorf("unexpected warnings: %q", imp.Warnings)
+	}
+
+	imp, err = ImportIgnoreConfig("super-linter", []byte("FILTER_REGEX_EXCLUDE=(foo|bar)/.*\nVALIDATE_GITHUB_ACTIONS=false\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imp.Paths) > 0 || len(imp.Warnings) != 2 {
+		t.Errorf("nothing should be imported with two warnings: %v %q", imp.Paths, imp.Warnings)
+	}
+}
+
+func TestIgnoreImportYAML(t *testing.T) {
+	imp := &IgnoreImport{Paths: map[string]*PathConfig{}}
+	b, err := imp.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 0 {
+		t.Fatalf("empty config should be output as empty: %q", b)
+	}
+
+	imp.add("legacy/**", ".*")
+	imp.add("**", "foo", "bar", "foo")
+	b, err = imp.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := parseConfig(b, "imported.yaml")
+	if err != nil {
+		t.Fatalf("imported config cannot be parsed: %s: %q", err, b)
+	}
+	if want := []string{"foo", "bar"}; !cmp.Equal(want, c.Paths["**"].Ignore) {
+		t.Error(cmp.Diff(want, c.Paths["**"].Ignore))
+	}
+	if !c.IgnoresError("legacy/test.yaml", &Error{Message: "foo"}) {
+		t.Errorf("error is not ignored by imported config: %q", b)
+	}
+}
+
+func TestIgnoreImportError(t *testing.T) {
+	testCases := []struct {
+		kind  string
+		input string
+		want  string
+	}{
+		{"unknown", "", `unknown linter "unknown". available linters are "reviewdog", "super-linter", "yamllint"`},
+		{"yamllint", "ignore: {foo: bar}", `"ignore" in yamllint config must be string or list of strings`},
+		{"yamllint", "ignore: [[foo]]", `element of "ignore" in yamllint config must be string`},
+		{"yamllint", "ignore: [", "could not parse yamllint config"},
+		{"reviewdog", "runner: 42", "could not parse reviewdog config"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			_, err := ImportIgnoreConfig(tc.kind, []byte(tc.input))
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestIgnoreImportDetectKind(t *testing.T) {
+	for input, want := range map[string]string{
+		".yamllint":                   "yamllint",
+		"path/to/.yamllint.yml":       "yamllint",
+		".reviewdog.yml":              "reviewdog",
+		".github/super-linter.env":    "super-linter",
+		".github/linters/.yaml-lint":  "",
+		".github/workflows/test.yaml": "",
+	} {
+		if have := DetectIgnoreImportKind(input); have != want {
+			t.Errorf("wanted %q for %q but got %q", want, input, have)
+		}
+	}
+}
+
+func TestIgnoreImportSplitCommandArgs(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  []string
+	}{
+		{"", []string{}},
+		{"actionlint -oneline", []string{"actionlint", "-oneline"}},
+		{`a 'b c' "d \"e\"" f\ g ''`, []string{"a", "b c", `d "e"`, "f g", ""}},
+		{"  a\t\tb\n", []string{"a", "b"}},
+	}
+	for _, tc := range testCases {
+		if have := splitCommandArgs(tc.input); !cmp.Equal(tc.want, have) {
+			t.Errorf("%q: %s", tc.input, cmp.Diff(tc.want, have))
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package actionlint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+)
+
+// Inspection is a result of inspecting the position in a workflow file. It is useful to implement
+// hovers in IDEs.
+type Inspection struct {
+	// Expr is the source of the expression at the position. For "${{ }}" placeholders, it does not
+	// include "${{" and "}}". This value is empty when no expression is at the position.
+	Expr string
+	// Root is the root node of the syntax tree of the expression. This value is nil when no
+	// expression is at the position.
+	Root ExprNode
+	// Node is the innermost node of the syntax tree at the position. This value is nil when no
+	// expression is at the position.
+	Node ExprNode
+	// Line is the 1-based line number of Node.
+	Line int
+	// Column is the 1-based column number where Node starts.
+	Column int
+	// EndColumn is the 1-based column number where Node ends (inclusive).
+	EndColumn int
+	// Type is the type of Node resolved by the type checker. Types of contexts like `steps` and
+	// `matrix` are resolved from the workflow. This value is nil when Node is nil.
+	Type ExprType
+	// WorkflowKey is the key of the workflow where the expression is placed like
+	// "jobs.<job_id>.steps.run". This value is empty when the key is unknown.
+	WorkflowKey string
+	// Contexts is names of contexts available at the workflow key.
+	Contexts []string
+	// Errors is errors reported by rules at the line of the position.
+	Errors []*Error
+	// DocURL is a URL of the documentation describing Node.
+	DocURL string
+}
+
+// InspectPosition inspects the position in the workflow file and returns the expression at the
+// position with its resolved type, errors reported at the line, and the documentation link. The
+// line and col parameters are 1-based and the column is counted in characters as Error.Column. The project is detected from the file path as LintFile
+// does. Workflows embedded in other files are not supported.
+func (l *Linter) InspectPosition(file string, line, col int) (*Inspection, error) {
+	return l.InspectPositionContext(context.Background(), file, line, col)
+}
+
+// InspectPositionContext is the same as InspectPosition but the linting is canceled when the ctx
+// parameter is canceled.
+func (l *Linter) InspectPositionContext(ctx context.Context, file string, line, col int) (*Inspection, error) {
+	project, err := l.projects.At(file)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %q: %w", file, err)
+	}
+
+	return l.inspectSource(ctx, file, src, project, line, col)
+}
+
+// inspectSource inspects the position in the source of the workflow file. The source may differ from
+// the content of the file, for example when it is being edited in an editor.
+func (l *Linter) inspectSource(ctx context.Context, file string, src []byte, project *Project, line, col int) (*Inspection, error) {
+	path := file
+	if l.cwd != "" {
+		if r, err := filepath.Rel(l.cwd, file); err == nil {
+			path = r
+		}
+	}
+
+	rules := []*RuleExpression{}
+	c := *l
+	c.onRulesCreated = func(rs []Rule) []Rule {
+		if l.onRulesCreated != nil {
+			rs = l.onRulesCreated(rs)
+		}
+		for _, r := range rs {
+			if e, ok := r.(*RuleExpression); ok {
+				e.inspected = []*inspectedExpr{}
+				rules = append(rules, e)
+			}
+		}
+		return rs
+	}
+
+	proc := newConcurrentProcess(ctx, runtime.NumCPU())
+	dbg := l.debugWriter()
+	localActions := NewLocalActionsCache(project, dbg)
+	localReusableWorkflows := NewLocalReusableWorkflowCache(project, l.cwd, dbg)
+	errs, err := c.check(ctx, path, src, project, proc, localActions, localReusableWorkflows)
+	proc.wait()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := []*inspectedExpr{}
+	for _, r := range rules {
+		exprs = append(exprs, r.inspected...)
+	}
+
+	// Columns are counted in runes as positions of errors, while expressions are found by byte offsets
+	text := sourceLine(src, line)
+	ret := inspectExprAt(exprs, text, line, lspRuneOffset(text, col)+1)
+	if ret.Node != nil {
+		ret.Column = utf8.RuneCountInString(text[:ret.Column-1]) + 1
+		ret.EndColumn = utf8.RuneCountInString(text[:ret.EndColumn])
+	}
+	for _, err := range errs {
+		if err.Line == line {
+			ret.Errors = append(ret.Errors, err)
+		}
+	}
+	return ret, nil
+}
+
+// sourceLine returns the 1-based line of the source.
+func sourceLine(src []byte, line int) string {
+	for i := 1; len(src) > 0; i++ {
+		l := src
+		if j := bytes.IndexByte(src, '\n'); j >= 0 {
+			l, src = src[:j], src[j+1:]
+		} else {
+			src = nil
+		}
+		if i == line {
+			return strings.TrimSuffix(string(l), "\r")
+		}
+	}
+	return ""
+}
+
+// inspectExprAt finds the expression at the column in the text of the line. Positions of
+// expressions recorded by RuleExpression are not accurate in multi-line strings so the expression
+// is found by its source in the text. When multiple expressions have the same source, the one
+// recorded at the nearest line is chosen.
+func inspectExprAt(exprs []*inspectedExpr, text string, line, col int) *Inspection {
+	var found *inspectedExpr
+	offset, base := 0, 0
+	for _, e := range exprs {
+		if e.line > line || e.src == "" || found != nil && found.line > e.line {
+			continue
+		}
+		for start := 0; ; {
+			i := strings.Index(text[start:], e.src)
+			if i < 0 {
+				break
+			}
+			i += start
+			end := i + len(e.src)
+			if e.placeholder && (!strings.HasSuffix(text[:i], "${{") || !strings.HasPrefix(text[end:], "}}")) {
+				start = i + 1
+				continue
+			}
+			if i < col && col <= end {
+				found, offset, base = e, col-1-i, i
+				break
+			}
+			start = i + 1
+		}
+	}
+	if found == nil {
+		return &Inspection{}
+	}
+
+	node := innermostExprNodeAt(found.root, offset)
+	ctx, _ := WorkflowKeyAvailability(found.workflowKey)
+	return &Inspection{
+		Expr:        strings.TrimSpace(found.src),
+		Root:        found.root,
+		Node:        node,
+		Line:        line,
+		Column:      base + node.Token().Offset + 1,
+		EndColumn:   base + exprNodeEnd(node),
+		Type:        found.types[node],
+		WorkflowKey: found.workflowKey,
+		Contexts:    ctx,
+		DocURL:      exprNodeDocURL(node),
+	}
+}
+
+// exprNodeEnd returns the byte offset of the end of the node in the expression source. The end is
+// approximated assuming no white space is put around "." and brackets.
+func exprNodeEnd(n ExprNode) int {
+	switch n := n.(type) {
+	case *ObjectDerefNode:
+		return exprNodeEnd(n.Receiver) + 1 + len(n.Property)
+	case *ArrayDerefNode:
+		return exprNodeEnd(n.Receiver) + 2 // ".*"
+	case *IndexAccessNode:
+		return exprNodeEnd(n.Index) + 1 // "]"
+	case *NotOpNode:
+		return exprNodeEnd(n.Operand)
+	case *CompareOpNode:
+		return exprNodeEnd(n.Right)
+	case *LogicalOpNode:
+		return exprNodeEnd(n.Right)
+	case *FuncCallNode:
+		if len(n.Args) > 0 {
+			return exprNodeEnd(n.Args[len(n.Args)-1]) + 1 // ")"
+		}
+		return n.tok.Offset + len(n.tok.Value) + 2 // "()"
+	default:
+		t := n.Token()
+		return t.Offset + len(t.Value)
+	}
+}
+
+// innermostExprNodeAt returns the smallest node containing the byte offset in the expression
+// source. When no node contains the offset, the root node is returned.
+func innermostExprNodeAt(root ExprNode, offset int) ExprNode {
+	found, size := root, -1
+	VisitExprNode(root, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		start, end := n.Token().Offset, exprNodeEnd(n)
+		if offset < start || end <= offset {
+			return
+		}
+		if s := end - start; size < 0 || s <= size {
+			found, size = n, s
+		}
+	})
+	return found
+}
+
+// exprNodeDocURL returns the URL of the documentation for the node.
+func exprNodeDocURL(n ExprNode) string {
+	const (
+		contexts    = "https://docs.github.com/en/actions/learn-github-actions/contexts"
+		expressions = "https://docs.github.com/en/actions/learn-github-actions/expressions"
+	)
+
+	recv := n
+	for {
+		switch r := recv.(type) {
+		case *ObjectDerefNode:
+			recv = r.Receiver
+			continue
+		case *ArrayDerefNode:
+			recv = r.Receiver
+			continue
+		case *IndexAccessNode:
+			recv = r.Operand
+			continue
+		}
+		break
+	}
+
+	switch r := recv.(type) {
+	case *VariableNode:
+		return fmt.Sprintf("%s#%s-context", contexts, strings.ToLower(r.Name))
+	case *FuncCallNode:
+		if _, ok := BuiltinFuncSignatures[strings.ToLower(r.Callee)]; ok {
+			return fmt.Sprintf("%s#%s", expressions, strings.ToLower(r.Callee))
+		}
+		return expressions + "#functions"
+	case *NotOpNode, *CompareOpNode, *LogicalOpNode:
+		return expressions + "#operators"
+	case *NullNode, *BoolNode, *IntNode, *FloatNode, *StringNode:
+		return expressions + "#literals"
+	default:
+		return expressions
+	}
+}
@@ -0,0 +1,167 @@
+package actionlint
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestInspectPosition(t *testing.T) {
+	file := filepath.Join("testdata", "inspect", "test.yaml")
+	b, err := os.ReadFile(file)
+	if err != nil {
+		panic(err)
+	}
+	lines := strings.Split(string(b), "\n")
+
+	testCases := []struct {
+		what   string
+		line   int
+		near   string
+		expr   string
+		node   string
+		ty     string
+		key    string
+		doc    string
+		errors int
+	}{
+		{
+			what: "property of matrix",
+			line: 13,
+			near: "os }}",
+			expr: "matrix.os",
+			ty:   "string",
+			key:  "jobs.<job_id>.runs-on",
+			doc:  "https://docs.github.com/en/actions/learn-github-actions/contexts#matrix-context",
+		},
+		{
+			what: "matrix context",
+			line: 13,
+			near: "matrix.os",
+			expr: "matrix.os",
+			node: "matrix",
+			ty:   "{os: string}",
+			key:  "jobs.<job_id>.runs-on",
+			doc:  "https://docs.github.com/en/actions/learn-github-actions/contexts#matrix-context",
+		},
+		{
+			what: "step outputs in multi-line script",
+			line: 19,
+			near: "outputs.version",
+			expr: "steps.version.outputs.version",
+			node: "steps.version.outputs",
+			ty:   "{string => string}",
+			key:  "jobs.<job_id>.steps.run",
+			doc:  "https://docs.github.com/en/actions/learn-github-actions/contexts#steps-context",
+		},
+		{
+			what: "function call",
+			line: 19,
+			near: "toJSON",
+			expr: "toJSON(github.event)",
+			ty:   "string",
+			key:  "jobs.<job_id>.steps.run",
+			doc:  "https://docs.github.com/en/actions/learn-github-actions/expressions#tojson",
+		},
+		{
+			what: "if condition without placeholder",
+			line: 20,
+			near: "startsWith",
+			expr: "github.event_name == 'push' && startsWith(github.ref, 'refs/tags/')",
+			node: "startsWith(github.ref, 'refs/tags/')",
+			ty:   "bool",
+			key:  "jobs.<job_id>.steps.if",
+			doc:  "https://docs.github.com/en/actions/learn-github-actions/expressions#startswith",
+		},
+		{
+			what: "operator",
+			line: 20,
+			near: "&&",
+			expr: "github.event_name == 'push' && startsWith(github.ref, 'refs/tags/')",
+			ty:   "bool",
+			key:  "jobs.<job_id>.steps.if",
+			doc:  "https://docs.github.com/en/actions/learn-github-actions/expressions#operators",
+		},
+		{
+			what:   "errors at the line",
+			line:   21,
+			near:   "target",
+			expr:   "inputs.target",
+			ty:     "string",
+			key:    "jobs.<job_id>.steps.run",
+			doc:    "https://docs.github.com/en/actions/learn-github-actions/contexts#inputs-context",
+			errors: 1,
+		},
+		{
+			what: "line containing non-ASCII characters",
+			line: 22,
+			near: "event_name",
+			expr: "github.event_name",
+			ty:   "string",
+			key:  "jobs.<job_id>.steps.run",
+			doc:  "https://docs.github.com/en/actions/learn-github-actions/contexts#github-context",
+		},
+		{
+			what: "no expression",
+			line: 16,
+			near: "echo",
+		},
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			line := lines[tc.line-1]
+			idx := strings.Index(line, tc.near)
+			if idx < 0 {
+				t.Fatalf("%q is not found at line %d", tc.near, tc.line)
+			}
+			// Columns are counted in characters
+			col := utf8.RuneCountInString(line[:idx]) + 1
+
+			i, err := l.InspectPosition(file, tc.line, col)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(i.Errors) != tc.errors {
+				t.Errorf("wanted %d errors but got %v", tc.errors, i.Errors)
+			}
+			if tc.expr == "" {
+				if i.Node != nil {
+					t.Fatalf("wanted no expression but got %#v", i)
+				}
+				return
+			}
+
+			if i.Expr != tc.expr {
+				t.Errorf("wanted expression %q but got %q", tc.expr, i.Expr)
+			}
+			node := tc.node
+			if node == "" {
+				node = tc.expr
+			}
+			if i.Line != tc.line {
+				t.Errorf("wanted line %d but got %d", tc.line, i.Line)
+			}
+			if s := string([]rune(line)[i.Column-1 : i.EndColumn]); s != node {
+				t.Errorf("wanted node %q but got %q", node, s)
+			}
+			if i.Type == nil || i.Type.String() != tc.ty {
+				t.Errorf("wanted type %q but got %v", tc.ty, i.Type)
+			}
+			if i.WorkflowKey != tc.key {
+				t.Errorf("wanted workflow key %q but got %q", tc.key, i.WorkflowKey)
+			}
+			if i.DocURL != tc.doc {
+				t.Errorf("wanted doc URL %q but got %q", tc.doc, i.DocURL)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -62,6 +63,10 @@ type LinterOptions struct {
 	// Oneline is flag if one line output is enabled. When enabling it, one error is output per one
 	// line. It is useful when reading outputs from programs.
 	Oneline bool
+	// ContextLines is the number of source lines printed before and after the line of each error in
+	// the source snippet. Zero means only the line of the error is printed. This value is ignored
+	// when Oneline is true or a custom error format is used.
+	ContextLines int
 	// Shellcheck is executable for running shellcheck external command. It can be command name like
 	// "shellcheck" or file path like "/path/to/shellcheck", "path/to/shellcheck". When this value
 	// is empty, shellcheck won't run to check scripts in workflow file.
@@ -70,15 +75,39 @@ type LinterOptions struct {
 	// or file path like "/path/to/pyflakes", "path/to/pyflakes". When this value is empty, pyflakes
 	// won't run to check scripts in workflow file.
 	Pyflakes string
+	// PSScriptAnalyzer is executable of PowerShell 7 or later for running PSScriptAnalyzer. It can be
+	// command name like "pwsh" or file path like "/path/to/pwsh". PSScriptAnalyzer module must be
+	// installed in the PowerShell. When this value is empty, PSScriptAnalyzer won't run to check
+	// scripts in workflow file.
+	PSScriptAnalyzer string
+	// Plugins enables running the plugins configured in "plugins:" section of config file. Plugins
+	// are external executables and a relative file path of the command is resolved from the
+	// repository root. Running plugins of an untrusted repository runs code controlled by the
+	// repository so they are not run unless this value is true.
+	Plugins bool
 	// IgnorePatterns is list of regular expression to filter errors. The pattern is applied to error
 	// messages. When an error is matched, the error is ignored.
 	IgnorePatterns []string
+	// OnlyRules is list of rule names to apply such as "expression". When it is not empty, rules not in
+	// the list are not applied. Names of plugins and rules registered by RegisterRule can also be
+	// given. Opt-in rules are not enabled by this option. Note that syntax errors are always reported
+	// even if "syntax-check" is not in the list since the workflow cannot be checked without parsing
+	// it.
+	OnlyRules []string
 	// ConfigFile is a path to config file. Empty string means no config file path is given. In
 	// the case, actionlint will try to read config from .github/actionlint.yaml.
 	ConfigFile string
+	// UserConfigFile is a path to the per-user config file. The config is merged under the config
+	// of repository or the config given by ConfigFile. When the file does not exist, it is ignored.
+	// Empty string means no per-user config is used. See UserConfigFilePath for the default path.
+	UserConfigFile string
 	// Format is a custom template to format error messages. It must follow Go Template format and
 	// contain at least one {{ }} placeholder. https://pkg.go.dev/text/template
 	Format string
+	// Renderer is a name of the renderer to output errors such as "junit". The renderer must be
+	// registered by RegisterErrorRenderer. This option cannot be used with Format. Empty string means
+	// no renderer is used. See ErrorRendererNames for the available names.
+	Renderer string
 	// StdinFileName is a file name when reading input from stdin. When this value is empty, "<stdin>"
 	// is used as the default value.
 	StdinFileName string
@@ -90,23 +119,132 @@ type LinterOptions struct {
 	// function should return the modified rules.
 	// Note that syntax errors may be reported even if this function returns nil or an empty slice.
 	OnRulesCreated func([]Rule) []Rule
+	// OnFileStart is a hook called before the rules are applied to a workflow. The path parameter is
+	// the file path of the workflow and the rules parameter is the rules created for it, after
+	// OnRulesCreated is applied. The function returns the rules to apply to the workflow so that the
+	// rules can be enabled or disabled per file. When the file contains multiple embedded workflows,
+	// this function is called for each of them. Returning an error stops linting and the error is
+	// returned from the Lint* methods.
+	// Note that files are checked in parallel. This function must be thread-safe.
+	OnFileStart func(path string, rules []Rule) ([]Rule, error)
+	// OnRuleError is a hook called for each error found by the rules in a workflow file. The rule
+	// parameter is the rule which reported the error. Errors may be filtered out after this function
+	// is called, for example by IgnorePatterns. Returning an error stops linting and the error is
+	// returned from the Lint* methods. It is useful to stop linting when too many errors are found.
+	// Note that files are checked in parallel. This function must be thread-safe.
+	OnRuleError func(path string, rule Rule, err *Error) error
+	// OnFileEnd is a hook called after checking each file. The errs parameter is the errors reported
+	// for the file, which are sorted by their positions. Returning an error stops linting and the
+	// error is returned from the Lint* methods. When Fix is true, the hooks are called again each time
+	// the fixed file is checked.
+	// Note that files are checked in parallel. This function must be thread-safe.
+	OnFileEnd func(path string, errs []*Error) error
+	// Online is flag if checks which require network access are enabled. When enabling it, actionlint
+	// sends requests to GitHub REST API to check workflows with information of the repository.
+	Online bool
+	// GitHubToken is an access token used for sending requests to GitHub REST API. This value is only
+	// used when Online is true. When this value is empty, requests are sent without authentication
+	// and they are subject to the stricter API rate limit.
+	GitHubToken string
+	// GitHubAPIURL is a base URL of GitHub REST API. This value is only used when Online is true.
+	// When this value is empty, DefaultGitHubAPIURL is used.
+	GitHubAPIURL string
+	// GitHubAPIBudget is the maximum number of requests sent to GitHub API in one run. Checks
+	// requiring requests exceeding the budget are skipped. Zero means no limit. This value is only
+	// used when Online is true.
+	GitHubAPIBudget int
+	// OnlineCacheDir is a directory to cache metadata of actions and reusable workflows fetched from
+	// GitHub by online checks. When this value is empty, DefaultRemoteActionsCacheDir is used. This
+	// value is only used when Online is true.
+	OnlineCacheDir string
+	// Fix is flag to fix errors automatically. When it is true, the edits to fix errors are applied
+	// to workflow files and the files are overwritten. Only the errors which were not fixed are
+	// reported. Workflows given via Lint method are not fixed.
+	Fix bool
+	// GroupBy is a key to group error outputs. "rule" groups errors by their rule names and "file"
+	// groups errors by their file paths. Each group is output with a header line when the default
+	// error format is used. Empty string means errors are output per file without headers.
+	GroupBy string
+	// Dedup is flag to remove errors whose rule and message are the same as another error in the same
+	// file. Such errors are repeated when the same content is duplicated by YAML anchors or is
+	// repeated across matrix jobs. Only the first error is reported.
+	Dedup bool
+	// MaxPerRule is the maximum number of errors reported per rule. Errors exceeding the number are
+	// omitted. Zero means no limit.
+	MaxPerRule int
+	// Jobs is the maximum number of external processes such as shellcheck and pyflakes which run in
+	// parallel. The limit is shared by all workflow files linted at once. Zero means the number of
+	// CPUs.
+	Jobs int
+	// ReportFeedback is flag to output a machine-readable fingerprint for each error. The fingerprint
+	// consists of the rule name, the hash of the message, and the hash of the source line so that
+	// organizations can aggregate findings suppressed in their repositories without sharing the
+	// contents. See Error.Fingerprint for more details.
+	ReportFeedback bool
+	// Select is a selector of the subtree of workflows to lint such as "jobs.build.steps[3]". When it
+	// is not empty, only the errors in the subtree are reported and the expressions in the subtree
+	// are output with their resolved types after the errors. Whole workflows are still checked to
+	// resolve the types. See Selector for the syntax.
+	Select string
+	// Baseline is a file path of the baseline file generated by Baseline.WriteFile. Errors recorded
+	// in the baseline are not reported. When it is empty, all errors are reported.
+	Baseline string
+	// CacheDir is a directory to cache errors of each workflow file on disk. When it is not empty,
+	// files whose content, config, and referenced local actions and reusable workflows are not changed
+	// since the previous run with the same actionlint are not checked and the cached errors are
+	// reported instead. The cache is not used for the workflows whose errors depend on other files
+	// in the repository such as lock files checked by "setup-cache" rule. The cache is not used when Fix, Online, Select, OnRulesCreated, OnFileStart,
+	// OnRuleError, or OnFileEnd is set since the errors depend on them. It is also not used for the
+	// workflows checked by plugins or rules registered by RegisterRule. Cached errors are not reused
+	// on another day since some errors depend on the date. See DefaultResultCacheDir for the
+	// default directory.
+	CacheDir string
+	// Now is the current time used by the checks which depend on dates such as the deprecations
+	// checked by "deprecation" rule. When it is zero, the time when the Linter instance is created
+	// is used. Setting a fixed time makes the outputs reproducible.
+	Now time.Time
 	// More options will come here
 }
 
 // Linter is struct to lint workflow files.
 type Linter struct {
-	projects       *Projects
-	out            io.Writer
-	logOut         io.Writer
-	logLevel       LogLevel
-	oneline        bool
-	shellcheck     string
-	pyflakes       string
-	ignorePats     []*regexp.Regexp
-	defaultConfig  *Config
-	errFmt         *ErrorFormatter
-	cwd            string
-	onRulesCreated func([]Rule) []Rule
+	projects         *Projects
+	out              io.Writer
+	logOut           io.Writer
+	logLevel         LogLevel
+	oneline          bool
+	contextLines     int
+	shellcheck       string
+	pyflakes         string
+	psscriptanalyzer string
+	plugins          bool
+	ignorePats       []*regexp.Regexp
+	onlyRules        map[string]struct{}
+	defaultConfig    *Config
+	userConfig       *Config
+	errFmt           *ErrorFormatter
+	renderer         ErrorRenderer
+	output           string
+	reportRules      *reportRules
+	cwd              string
+	onRulesCreated   func([]Rule) []Rule
+	onFileStart      func(string, []Rule) ([]Rule, error)
+	onRuleError      func(string, Rule, *Error) error
+	onFileEnd        func(string, []*Error) error
+	github           *GitHubAPIClient
+	remoteActions    *RemoteActionsCache
+	remoteWorkflows  *RemoteReusableWorkflowCache
+	fix              bool
+	groupBy          string
+	dedup            bool
+	maxPerRule       int
+	jobs             int
+	reportFeedback   bool
+	selector         *Selector
+	selected         *selections
+	baseline         *Baseline
+	cache            *resultCache
+	now              time.Time
 }
 
 // NewLinter creates a new Linter instance.
@@ -147,6 +285,15 @@ func NewLinter(out io.Writer, opts *LinterOptions) (*Linter, error) {
 		cfg = c
 	}
 
+	var user *Config
+	if opts.UserConfigFile != "" {
+		c, err := loadUserConfig(opts.UserConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		user = c
+	}
+
 	ignore := make([]*regexp.Regexp, 0, len(opts.IgnorePatterns))
 	for _, s := range opts.IgnorePatterns {
 		r, err := regexp.Compile(s)
@@ -156,6 +303,17 @@ func NewLinter(out io.Writer, opts *LinterOptions) (*Linter, error) {
 		ignore = append(ignore, r)
 	}
 
+	var only map[string]struct{}
+	if len(opts.OnlyRules) > 0 {
+		only = make(map[string]struct{}, len(opts.OnlyRules))
+		for _, n := range opts.OnlyRules {
+			if !contains(builtinRuleNames, n) && !rePluginName.MatchString(n) {
+				return nil, fmt.Errorf("invalid rule name %q to apply only the rule", n)
+			}
+			only[n] = struct{}{}
+		}
+	}
+
 	var formatter *ErrorFormatter
 	if opts.Format != "" {
 		f, err := NewErrorFormatter(opts.Format)
@@ -165,6 +323,91 @@ func NewLinter(out io.Writer, opts *LinterOptions) (*Linter, error) {
 		formatter = f
 	}
 
+	var renderer ErrorRenderer
+	if opts.Renderer != "" {
+		if formatter != nil {
+			return nil, fmt.Errorf("renderer %q cannot be used with custom format template", opts.Renderer)
+		}
+		r, ok := LookupErrorRenderer(opts.Renderer)
+		if !ok {
+			return nil, fmt.Errorf("unknown renderer %q to output errors. available renderers are %s", opts.Renderer, sortedQuotes(ErrorRendererNames()))
+		}
+		renderer = r
+	}
+
+	// Name of the output to select "output-severity" in config
+	output := outputText
+	if formatter != nil {
+		output = outputTemplate
+	} else if opts.Renderer != "" {
+		output = opts.Renderer
+	}
+
+	switch opts.GroupBy {
+	case "", "rule", "file":
+	default:
+		return nil, fmt.Errorf("invalid key %q to group errors. it must be \"rule\" or \"file\"", opts.GroupBy)
+	}
+	if opts.ContextLines < 0 {
+		return nil, fmt.Errorf("number of context lines must not be negative but got %d", opts.ContextLines)
+	}
+	if opts.MaxPerRule < 0 {
+		return nil, fmt.Errorf("maximum number of errors per rule must not be negative but got %d", opts.MaxPerRule)
+	}
+	jobs := opts.Jobs
+	if jobs < 0 {
+		return nil, fmt.Errorf("number of parallel external processes must not be negative but got %d", jobs)
+	}
+	if jobs == 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	var selector *Selector
+	if opts.Select != "" {
+		s, err := ParseSelector(opts.Select)
+		if err != nil {
+			return nil, err
+		}
+		selector = s
+	}
+
+	var baseline *Baseline
+	if opts.Baseline != "" {
+		b, err := ReadBaselineFile(opts.Baseline)
+		if err != nil {
+			return nil, err
+		}
+		baseline = b
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var cache *resultCache
+	if opts.CacheDir != "" {
+		if opts.Fix || opts.Online || opts.Select != "" || opts.OnRulesCreated != nil || opts.OnFileStart != nil || opts.OnRuleError != nil || opts.OnFileEnd != nil {
+			fmt.Fprintln(lout, "Result cache is disabled since -fix, -online, -select, or hooks of linter are enabled")
+		} else {
+			var dbg io.Writer
+			if level >= LogLevelDebug {
+				dbg = lout
+			}
+			parts := []string{
+				"shellcheck=" + resultCacheExecutable(opts.Shellcheck),
+				"pyflakes=" + resultCacheExecutable(opts.Pyflakes),
+				"psscriptanalyzer=" + resultCacheExecutable(opts.PSScriptAnalyzer),
+				"ignore=" + strings.Join(opts.IgnorePatterns, "\n"),
+				"only=" + strings.Join(opts.OnlyRules, ","),
+				"output=" + output,
+				// Errors of some rules such as "deprecation" depend on the current date
+				"date=" + now.UTC().Format("2006-01-02"),
+			}
+			cache = newResultCache(opts.CacheDir, parts, dbg)
+		}
+	}
+
 	cwd := opts.WorkingDir
 	if cwd == "" {
 		if d, err := os.Getwd(); err == nil {
@@ -172,19 +415,62 @@ func NewLinter(out io.Writer, opts *LinterOptions) (*Linter, error) {
 		}
 	}
 
+	var github *GitHubAPIClient
+	var remoteActions *RemoteActionsCache
+	var remoteWorkflows *RemoteReusableWorkflowCache
+	if opts.Online {
+		var dbg io.Writer
+		if level >= LogLevelDebug {
+			dbg = lout
+		}
+		github = NewGitHubAPIClient(opts.GitHubAPIURL, opts.GitHubToken, dbg)
+		github.SetBudget(opts.GitHubAPIBudget)
+		dir := opts.OnlineCacheDir
+		if dir == "" {
+			dir = DefaultRemoteActionsCacheDir()
+		}
+		remoteActions = NewRemoteActionsCache(github, dir, dbg)
+		remoteWorkflows = NewRemoteReusableWorkflowCache(github, dir, dbg)
+	}
+
 	return &Linter{
 		NewProjects(),
 		out,
 		lout,
 		level,
 		opts.Oneline,
+		opts.ContextLines,
 		opts.Shellcheck,
 		opts.Pyflakes,
+		opts.PSScriptAnalyzer,
+		opts.Plugins,
 		ignore,
+		only,
 		cfg,
+		user,
 		formatter,
+		renderer,
+		output,
+		newReportRules(),
 		cwd,
 		opts.OnRulesCreated,
+		opts.OnFileStart,
+		opts.OnRuleError,
+		opts.OnFileEnd,
+		github,
+		remoteActions,
+		remoteWorkflows,
+		opts.Fix,
+		opts.GroupBy,
+		opts.Dedup,
+		opts.MaxPerRule,
+		jobs,
+		opts.ReportFeedback,
+		selector,
+		&selections{m: map[string]*Selection{}},
+		baseline,
+		cache,
+		now,
 	}, nil
 }
 
@@ -204,6 +490,19 @@ func (l *Linter) debug(format string, args ...interface{}) {
 	fmt.Fprintf(l.logOut, format, args...)
 }
 
+// config returns the config used for linting workflows in the project. The per-user config is
+// merged under the config of the project.
+func (l *Linter) config(project *Project) *Config {
+	var cfg *Config
+	if l.defaultConfig != nil {
+		// `-config-file` option has higher prioritiy than repository config file
+		cfg = l.defaultConfig
+	} else if project != nil {
+		cfg = project.Config()
+	}
+	return mergeConfig(l.userConfig, cfg)
+}
+
 func (l *Linter) debugWriter() io.Writer {
 	if l.logLevel < LogLevelDebug {
 		return nil
@@ -239,8 +538,15 @@ func (l *Linter) GenerateDefaultConfig(dir string) error {
 
 // LintRepository lints YAML workflow files and outputs the errors to given writer. It finds the nearest
 // `.github/workflows` directory based on `dir` and applies lint rules to all YAML workflow files
-// under the directory.
+// under the directory. Rules checking all workflows in the repository at once such as
+// "required-status-checks" are applied only by this method.
 func (l *Linter) LintRepository(dir string) ([]*Error, error) {
+	return l.LintRepositoryContext(context.Background(), dir)
+}
+
+// LintRepositoryContext is the same as LintRepository but the linting is canceled when the ctx
+// parameter is canceled. See the document of LintFilesContext for the cancellation.
+func (l *Linter) LintRepositoryContext(ctx context.Context, dir string) ([]*Error, error) {
 	l.log("Linting all workflow files in repository:", dir)
 
 	p, err := l.projects.At(dir)
@@ -253,11 +559,27 @@ func (l *Linter) LintRepository(dir string) ([]*Error, error) {
 
 	l.log("Detected project:", p.RootDir())
 	wd := p.WorkflowsDir()
-	return l.LintDir(wd, p)
+
+	cfg := l.config(p)
+	if cfg == nil || len(cfg.EmbeddedWorkflows) == 0 {
+		return l.lintDir(ctx, wd, p, true)
+	}
+
+	files, err := collectYAMLFiles(wd)
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := collectEmbeddedWorkflowHosts(cfg, p)
+	if err != nil {
+		return nil, err
+	}
+	l.log("Collected", len(hosts), "files embedding workflows")
+	files = append(files, hosts...)
+	sort.Strings(files)
+	return l.lintFiles(ctx, files, p, true)
 }
 
-// LintDir lints all YAML workflow files in the given directory recursively.
-func (l *Linter) LintDir(dir string, project *Project) ([]*Error, error) {
+func collectYAMLFiles(dir string) ([]string, error) {
 	files := []string{}
 	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -273,6 +595,51 @@ func (l *Linter) LintDir(dir string, project *Project) ([]*Error, error) {
 	}); err != nil {
 		return nil, fmt.Errorf("could not read files in %q: %w", dir, err)
 	}
+	return files, nil
+}
+
+// collectEmbeddedWorkflowHosts collects files which embed workflows in the project. Files in
+// workflows directory are not included.
+func collectEmbeddedWorkflowHosts(cfg *Config, proj *Project) ([]string, error) {
+	root := proj.RootDir()
+	wd := proj.WorkflowsDir()
+	files := []string{}
+	if err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path == wd || info.Name() == ".git" || info.Name() == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if embeddedWorkflowsConfigFor(cfg, proj, path) != nil {
+			files = append(files, path)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not collect files embedding workflows in %q: %w", root, err)
+	}
+	return files, nil
+}
+
+// LintDir lints all YAML workflow files in the given directory recursively.
+func (l *Linter) LintDir(dir string, project *Project) ([]*Error, error) {
+	return l.LintDirContext(context.Background(), dir, project)
+}
+
+// LintDirContext is the same as LintDir but the linting is canceled when the ctx parameter is
+// canceled. See the document of LintFilesContext for the cancellation.
+func (l *Linter) LintDirContext(ctx context.Context, dir string, project *Project) ([]*Error, error) {
+	return l.lintDir(ctx, dir, project, false)
+}
+
+func (l *Linter) lintDir(ctx context.Context, dir string, project *Project, repo bool) ([]*Error, error) {
+	files, err := collectYAMLFiles(dir)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(files) == 0 {
 		return nil, fmt.Errorf("no YAML file was found in %q", dir)
@@ -282,28 +649,43 @@ func (l *Linter) LintDir(dir string, project *Project) ([]*Error, error) {
 	// To make output deterministic, sort order of file paths
 	sort.Strings(files)
 
-	return l.LintFiles(files, project)
+	return l.lintFiles(ctx, files, project, repo)
 }
 
 // LintFiles lints YAML workflow files and outputs the errors to given writer. It applies lint
 // rules to all given files. The project parameter can be nil. In the case, a project is detected
 // from the file path.
 func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, error) {
+	return l.LintFilesContext(context.Background(), filepaths, project)
+}
+
+// LintFilesContext is the same as LintFiles but the linting is canceled when the ctx parameter is
+// canceled. On the cancellation, running external processes such as shellcheck are killed, requests
+// to GitHub API are aborted, and the error wrapping ctx.Err() is returned. Files are not overwritten
+// by the Fix option after the cancellation.
+func (l *Linter) LintFilesContext(ctx context.Context, filepaths []string, project *Project) ([]*Error, error) {
+	return l.lintFiles(ctx, filepaths, project, false)
+}
+
+// lintFiles lints the files in parallel. When the repo parameter is true, the files are all
+// workflows in the project and the rules checking across the workflows are also applied.
+func (l *Linter) lintFiles(ctx context.Context, filepaths []string, project *Project, repo bool) ([]*Error, error) {
 	n := len(filepaths)
 	switch n {
 	case 0:
 		return []*Error{}, nil
 	case 1:
-		return l.LintFile(filepaths[0], project)
+		if !repo {
+			return l.LintFileContext(ctx, filepaths[0], project)
+		}
 	}
 
 	l.log("Linting", n, "files")
 
 	cwd := l.cwd
 	cpus := runtime.NumCPU()
-	proc := newConcurrentProcess(cpus)
+	proc := newConcurrentProcess(ctx, l.jobs)
 	sema := semaphore.NewWeighted(int64(cpus))
-	ctx := context.Background()
 	dbg := l.debugWriter()
 	acf := NewLocalActionsCacheFactory(dbg)
 	rwcf := NewLocalReusableWorkflowCacheFactory(cwd, dbg)
@@ -338,22 +720,33 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 
 		eg.Go(func() error {
 			// Bound concurrency on reading files to avoid "too many files to open" error (issue #3)
-			sema.Acquire(ctx, 1)
+			if err := sema.Acquire(ctx, 1); err != nil {
+				return fmt.Errorf("linting %s was canceled: %w", w.path, err)
+			}
 			src, err := os.ReadFile(w.path)
 			sema.Release(1)
 			if err != nil {
 				return fmt.Errorf("could not read %q: %w", w.path, err)
 			}
 
+			file := w.path
 			if cwd != "" {
 				if r, err := filepath.Rel(cwd, w.path); err == nil {
 					w.path = r // Use relative path if possible
 				}
 			}
-			errs, err := l.check(w.path, src, proj, proc, ac, rwc)
+			errs, err := l.checkFile(ctx, w.path, src, proj, proc, ac, rwc)
 			if err != nil {
 				return fmt.Errorf("fatal error while checking %s: %w", w.path, err)
 			}
+			if l.fix {
+				errs, src, err = l.fixFile(ctx, w.path, file, src, errs, func(b []byte) ([]*Error, error) {
+					return l.check(ctx, w.path, b, proj, proc, ac, rwc)
+				})
+				if err != nil {
+					return err
+				}
+			}
 			w.src = src
 			w.errs = errs
 			return nil
@@ -378,24 +771,25 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 	}
 
 	all := make([]*Error, 0, total)
-	if l.errFmt != nil {
-		temp := make([]*ErrorTemplateFields, 0, total)
-		for i := range ws {
-			w := &ws[i]
-			for _, err := range w.errs {
-				temp = append(temp, err.GetTemplateFields(w.src))
-			}
-			all = append(all, w.errs...)
-		}
-		if err := l.errFmt.Print(l.out, temp); err != nil {
+	srcs := make(map[string][]byte, len(ws))
+	for i := range ws {
+		w := &ws[i]
+		all = append(all, w.errs...)
+		srcs[w.path] = w.src
+	}
+
+	if repo {
+		errs, err := l.checkRequiredStatusChecks(project, srcs)
+		if err != nil {
 			return nil, err
 		}
-	} else {
-		for i := range ws {
-			w := &ws[i]
-			l.printErrors(w.errs, w.src)
-			all = append(all, w.errs...)
-		}
+		total += len(errs)
+		all = append(all, errs...)
+	}
+
+	all, err := l.printErrors(all, srcs)
+	if err != nil {
+		return nil, err
 	}
 
 	l.log("Found", total, "errors in", n, "files")
@@ -406,6 +800,12 @@ func (l *Linter) LintFiles(filepaths []string, project *Project) ([]*Error, erro
 // LintFile lints one YAML workflow file and outputs the errors to given writer. The project
 // parameter can be nil. In the case, the project is detected from the given path.
 func (l *Linter) LintFile(path string, project *Project) ([]*Error, error) {
+	return l.LintFileContext(context.Background(), path, project)
+}
+
+// LintFileContext is the same as LintFile but the linting is canceled when the ctx parameter is
+// canceled. See the document of LintFilesContext for the cancellation.
+func (l *Linter) LintFileContext(ctx context.Context, path string, project *Project) ([]*Error, error) {
 	if project == nil {
 		p, err := l.projects.At(path)
 		if err != nil {
@@ -419,28 +819,29 @@ func (l *Linter) LintFile(path string, project *Project) ([]*Error, error) {
 		return nil, fmt.Errorf("could not read %q: %w", path, err)
 	}
 
+	file := path
 	if l.cwd != "" {
 		if r, err := filepath.Rel(l.cwd, path); err == nil {
 			path = r
 		}
 	}
 
-	proc := newConcurrentProcess(runtime.NumCPU())
+	proc := newConcurrentProcess(ctx, l.jobs)
 	dbg := l.debugWriter()
 	localActions := NewLocalActionsCache(project, dbg)
 	localReusableWorkflows := NewLocalReusableWorkflowCache(project, l.cwd, dbg)
-	errs, err := l.check(path, src, project, proc, localActions, localReusableWorkflows)
+	errs, err := l.checkFile(ctx, path, src, project, proc, localActions, localReusableWorkflows)
+	if err == nil && l.fix {
+		errs, src, err = l.fixFile(ctx, path, file, src, errs, func(b []byte) ([]*Error, error) {
+			return l.check(ctx, path, b, project, proc, localActions, localReusableWorkflows)
+		})
+	}
 	proc.wait()
 	if err != nil {
 		return nil, err
 	}
 
-	if l.errFmt != nil {
-		l.errFmt.PrintErrors(l.out, errs, src)
-	} else {
-		l.printErrors(errs, src)
-	}
-	return errs, err
+	return l.printErrors(errs, map[string][]byte{path: src})
 }
 
 // Lint lints YAML workflow file content given as byte slice. The path parameter is used as file
@@ -448,6 +849,13 @@ func (l *Linter) LintFile(path string, project *Project) ([]*Error, error) {
 // from STDIN.
 // When nil is passed to the project parameter, it tries to find the project from the path parameter.
 func (l *Linter) Lint(path string, content []byte, project *Project) ([]*Error, error) {
+	return l.LintContext(context.Background(), path, content, project)
+}
+
+// LintContext is the same as Lint but the linting is canceled when the ctx parameter is canceled.
+// This is useful to cancel in-flight linting when the content is updated, for example in a language
+// server. See the document of LintFilesContext for the cancellation.
+func (l *Linter) LintContext(ctx context.Context, path string, content []byte, project *Project) ([]*Error, error) {
 	if project == nil && path != "<stdin>" {
 		if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
 			p, err := l.projects.At(path)
@@ -457,24 +865,118 @@ func (l *Linter) Lint(path string, content []byte, project *Project) ([]*Error,
 			project = p
 		}
 	}
-	proc := newConcurrentProcess(runtime.NumCPU())
+	errs, err := l.lintContent(ctx, path, content, project)
+	if err != nil {
+		return nil, err
+	}
+	return l.printErrors(errs, map[string][]byte{path: content})
+}
+
+// lintContent lints the content in the project and returns the errors without printing them.
+func (l *Linter) lintContent(ctx context.Context, path string, content []byte, project *Project) ([]*Error, error) {
+	proc := newConcurrentProcess(ctx, l.jobs)
 	dbg := l.debugWriter()
 	localActions := NewLocalActionsCache(project, dbg)
 	localReusableWorkflows := NewLocalReusableWorkflowCache(project, l.cwd, dbg)
-	errs, err := l.check(path, content, project, proc, localActions, localReusableWorkflows)
+	errs, err := l.check(ctx, path, content, project, proc, localActions, localReusableWorkflows)
 	proc.wait()
-	if err != nil {
+	return errs, err
+}
+
+// LintSources lints workflow files read from other than the file system such as an archive or a Git
+// revision. See ReadArchive and ReadGitRevision to read the sources. The config in the sources is
+// used unless a config file is given via LinterOptions. Local actions and local reusable workflows
+// are not checked since they cannot be read from the file system. The Fix option is ignored.
+func (l *Linter) LintSources(srcs *Sources) ([]*Error, error) {
+	return l.LintSourcesContext(context.Background(), srcs)
+}
+
+// LintSourcesContext is the same as LintSources but the linting is canceled when the ctx parameter
+// is canceled. See the document of LintFilesContext for the cancellation.
+func (l *Linter) LintSourcesContext(ctx context.Context, srcs *Sources) ([]*Error, error) {
+	l.log("Linting", len(srcs.Files), "files from sources")
+
+	lint := l
+	if l.defaultConfig == nil && srcs.Config != nil {
+		// The project cannot be detected so the config in the sources is used instead of `-config-file`
+		c := *l
+		c.defaultConfig = srcs.Config
+		lint = &c
+	}
+
+	proc := newConcurrentProcess(ctx, l.jobs)
+	dbg := l.debugWriter()
+	ac := NewLocalActionsCache(nil, dbg)
+	rwc := NewLocalReusableWorkflowCache(nil, l.cwd, dbg)
+
+	errs := make([][]*Error, len(srcs.Files))
+	eg := errgroup.Group{}
+	for i, f := range srcs.Files {
+		i, f := i, f
+		eg.Go(func() error {
+			es, err := lint.check(ctx, f.Path, f.Content, nil, proc, ac, rwc)
+			if err != nil {
+				return fmt.Errorf("fatal error while checking %s: %w", f.Path, err)
+			}
+			errs[i] = es
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
-	if l.errFmt != nil {
-		l.errFmt.PrintErrors(l.out, errs, content)
-	} else {
-		l.printErrors(errs, content)
+	proc.wait() // See the comment in LintFilesContext
+
+	all := []*Error{}
+	contents := make(map[string][]byte, len(srcs.Files))
+	for i, f := range srcs.Files {
+		all = append(all, errs[i]...)
+		contents[f.Path] = f.Content
+	}
+
+	l.log("Found", len(all), "errors in", len(srcs.Files), "files")
+
+	return l.printErrors(all, contents)
+}
+
+// checkFile checks the workflow file read from the file system. When the result cache is enabled,
+// the cached errors are returned if the file was not changed since the previous check.
+func (l *Linter) checkFile(
+	ctx context.Context,
+	path string,
+	content []byte,
+	project *Project,
+	proc *concurrentProcess,
+	localActions *LocalActionsCache,
+	localReusableWorkflows *LocalReusableWorkflowCache,
+) ([]*Error, error) {
+	cfg := l.config(project)
+	// Plugins and custom rules may read any files and their outputs cannot be cached
+	if l.cache == nil || (l.plugins && cfg != nil && len(cfg.Plugins) > 0) || len(RegisteredRuleNames()) > 0 || !resultCacheable(content) {
+		return l.check(ctx, path, content, project, proc, localActions, localReusableWorkflows)
+	}
+
+	abs := l.absPath(path)
+	key := l.cache.key(abs, content, project, cfg)
+	if errs, ok := l.cache.get(abs, key); ok {
+		l.log("Found", len(errs), "errors in result cache for", path)
+		for _, err := range errs {
+			err.Filepath = path
+		}
+		return errs, nil
+	}
+
+	errs, err := l.check(ctx, path, content, project, proc, localActions, localReusableWorkflows)
+	if err != nil {
+		return nil, err
 	}
+	l.cache.put(abs, key, errs)
 	return errs, nil
 }
 
 func (l *Linter) check(
+	ctx context.Context,
 	path string,
 	content []byte,
 	project *Project,
@@ -485,6 +987,10 @@ func (l *Linter) check(
 	// Note: This method is called to check multiple files in parallel.
 	// It must be thread safe assuming fields of Linter are not modified while running.
 
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("linting %s was canceled: %w", path, err)
+	}
+
 	var start time.Time
 	if l.logLevel >= LogLevelVerbose {
 		start = time.Now()
@@ -495,19 +1001,238 @@ func (l *Linter) check(
 		l.log("Using project at", project.RootDir())
 	}
 
-	var cfg *Config
-	if l.defaultConfig != nil {
-		// `-config-file` option has higher prioritiy than repository config file
-		cfg = l.defaultConfig
-	} else if project != nil {
-		cfg = project.Config()
-	}
+	cfg := l.config(project)
 	if cfg != nil {
 		l.debug("Config: %#v", cfg)
 	} else {
 		l.debug("No config was found")
 	}
 
+	var all []*Error
+	if c := embeddedWorkflowsConfigFor(cfg, project, l.absPath(path)); c != nil {
+		if l.selector != nil {
+			return nil, fmt.Errorf("selecting %s is not supported for workflows embedded in %s", l.selector, path)
+		}
+		l.log("Linting workflows embedded in", path, "selected by", c.Path)
+		ws, err := ExtractEmbeddedWorkflows(content, c.Path)
+		if err != nil {
+			all = []*Error{embeddedWorkflowsError(err, content)}
+		}
+		l.log("Found", len(ws), "embedded workflows in", path)
+		for _, w := range ws {
+			errs, err := l.lintWorkflow(ctx, path, w.Source, project, cfg, proc, localActions, localReusableWorkflows)
+			if err != nil {
+				return nil, err
+			}
+			for _, err := range errs {
+				err.Fixes = nil // Edits are for the extracted source
+				err.Line, err.Column = w.Position(err.Line, err.Column)
+				if err.Kind == "syntax-check" {
+					err.Message = w.translateMessage(err.Message)
+				}
+			}
+			all = append(all, errs...)
+		}
+	} else {
+		errs, err := l.lintWorkflow(ctx, path, content, project, cfg, proc, localActions, localReusableWorkflows)
+		if err != nil {
+			return nil, err
+		}
+		all = errs
+	}
+
+	s, err := parseSuppressions(content)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse suppression comments in %q: %w", path, err)
+	}
+	if s != nil {
+		filtered := make([]*Error, 0, len(all))
+		for _, err := range all {
+			if s.suppresses(err) {
+				l.debug("Error at %s:%d:%d was suppressed by comment: %s", path, err.Line, err.Column, err.Message)
+				continue
+			}
+			filtered = append(filtered, err)
+		}
+		all = filtered
+	}
+	all = l.filterIgnoredErrors(path, project, cfg, all)
+	all = l.applySeverities(cfg, all)
+
+	for _, err := range all {
+		err.Filepath = path // Populate filename in the error
+	}
+
+	sort.Stable(ByErrorPosition(all))
+
+	if l.logLevel >= LogLevelVerbose {
+		elapsed := time.Since(start)
+		l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for", path)
+	}
+
+	if l.onFileEnd != nil {
+		if err := l.onFileEnd(path, all); err != nil {
+			return nil, fmt.Errorf("linting was stopped after checking %s: %w", path, err)
+		}
+	}
+
+	return all, nil
+}
+
+// filterIgnoredErrors removes the errors ignored by -ignore option and "paths:" section of config.
+func (l *Linter) filterIgnoredErrors(path string, project *Project, cfg *Config, all []*Error) []*Error {
+	if len(l.ignorePats) > 0 {
+		filtered := make([]*Error, 0, len(all))
+	Loop:
+		for _, err := range all {
+			for _, pat := range l.ignorePats {
+				if pat.MatchString(err.Message) {
+					continue Loop
+				}
+			}
+			filtered = append(filtered, err)
+		}
+		all = filtered
+	}
+
+	if cfg != nil && len(cfg.Paths) > 0 {
+		rel := filepath.ToSlash(path)
+		if project != nil {
+			if r, err := filepath.Rel(project.RootDir(), l.absPath(path)); err == nil {
+				rel = filepath.ToSlash(r)
+			}
+		}
+		filtered := make([]*Error, 0, len(all))
+		for _, err := range all {
+			if cfg.IgnoresError(rel, err) {
+				l.debug("Error at %s:%d:%d was ignored by \"paths\" config: %s", path, err.Line, err.Column, err.Message)
+				continue
+			}
+			filtered = append(filtered, err)
+		}
+		all = filtered
+	}
+
+	return all
+}
+
+// applySeverities sets the severities configured in "rules:" section of config to the errors. Errors
+// of the rules whose severities are "off" are removed.
+func (l *Linter) applySeverities(cfg *Config, all []*Error) []*Error {
+	if cfg == nil || len(cfg.Rules) == 0 {
+		return all
+	}
+	filtered := make([]*Error, 0, len(all))
+	for _, err := range all {
+		switch s := cfg.RuleOutputSeverity(err.Kind, l.output); s {
+		case SeverityOff:
+			l.debug("Error at %s:%d:%d was ignored by \"severity: off\" of rule %q: %s", err.Filepath, err.Line, err.Column, err.Kind, err.Message)
+			continue
+		case SeverityWarning, SeverityNote:
+			err.Severity = s
+		}
+		filtered = append(filtered, err)
+	}
+	return filtered
+}
+
+// checkRequiredStatusChecks applies "required-status-checks" rule to all workflows in the project.
+// The srcs parameter maps file paths to the sources of the workflows. The errors are reported in
+// the file of required status checks configured in "required-status-checks:" of config and the
+// source of the file is added to srcs.
+func (l *Linter) checkRequiredStatusChecks(project *Project, srcs map[string][]byte) ([]*Error, error) {
+	cfg := l.config(project)
+	if cfg == nil || cfg.RequiredStatusChecks == "" || l.selector != nil {
+		return nil, nil
+	}
+	rule := NewRuleRequiredStatusChecks()
+	if _, ok := l.onlyRules[rule.Name()]; l.onlyRules != nil && !ok {
+		return nil, nil
+	}
+	if cfg.RuleOutputSeverity(rule.Name(), l.output) == SeverityOff {
+		return nil, nil
+	}
+
+	file := cfg.RequiredStatusChecks
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(project.RootDir(), file)
+	}
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file of required status checks configured in \"required-status-checks\": %w", err)
+	}
+	path := file
+	if l.cwd != "" {
+		if r, err := filepath.Rel(l.cwd, file); err == nil {
+			path = r
+		}
+	}
+	checks, err := parseRequiredStatusChecks(src)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse required status checks in %s: %w", path, err)
+	}
+	l.log("Checking", len(checks), "required status checks in", path)
+
+	v := NewVisitor()
+	v.AddPass(rule)
+	if dbg := l.debugWriter(); dbg != nil {
+		v.EnableDebug(dbg)
+		rule.EnableDebug(dbg)
+	}
+	rule.SetConfig(cfg)
+
+	for p, b := range srcs {
+		if embeddedWorkflowsConfigFor(cfg, project, l.absPath(p)) != nil {
+			continue
+		}
+		w, _ := Parse(b)
+		if w == nil {
+			continue
+		}
+		if err := v.Visit(w); err != nil {
+			return nil, err
+		}
+	}
+	rule.check(checks)
+
+	errs := l.filterIgnoredErrors(path, project, cfg, rule.Errs())
+	errs = l.applySeverities(cfg, errs)
+	for _, err := range errs {
+		err.Filepath = path
+	}
+	sort.Stable(ByErrorPosition(errs))
+
+	if l.errFmt != nil {
+		l.errFmt.RegisterRule(rule)
+	}
+	if l.renderer != nil {
+		l.reportRules.add(rule.Name(), rule.Description())
+	}
+	srcs[path] = src
+
+	return errs, nil
+}
+
+// parallelRulesMinSteps is the minimum number of steps in a workflow to apply rules to it in
+// parallel. For smaller workflows the overhead of goroutines exceeds the gain.
+const parallelRulesMinSteps = 64
+
+// lintWorkflow parses the workflow source and applies rules to the parsed workflow.
+func (l *Linter) lintWorkflow(
+	ctx context.Context,
+	path string,
+	content []byte,
+	project *Project,
+	cfg *Config,
+	proc *concurrentProcess,
+	localActions *LocalActionsCache,
+	localReusableWorkflows *LocalReusableWorkflowCache,
+) ([]*Error, error) {
+	var start time.Time
+	if l.logLevel >= LogLevelVerbose {
+		start = time.Now()
+	}
+
 	w, all := Parse(content)
 
 	if l.logLevel >= LogLevelVerbose {
@@ -518,22 +1243,83 @@ func (l *Linter) check(
 	if w != nil {
 		dbg := l.debugWriter()
 
+		github := l.github
+		remoteActions := l.remoteActions
+		remoteWorkflows := l.remoteWorkflows
+		if github != nil {
+			github = github.WithContext(ctx)
+			remoteActions = remoteActions.WithContext(ctx)
+			remoteWorkflows = remoteWorkflows.WithContext(ctx)
+		}
+
+		// Workflows embedded in other files are not checked as workflow files
+		file := l.absPath(path)
+		if embeddedWorkflowsConfigFor(cfg, project, file) != nil {
+			file = ""
+		}
+
 		rules := []Rule{
 			NewRuleMatrix(),
 			NewRuleCredentials(),
 			NewRuleShellName(),
-			NewRuleRunnerLabel(),
+			NewRuleRunnerLabelWithSource(content),
 			NewRuleEvents(),
 			NewRuleJobNeeds(),
-			NewRuleAction(localActions),
+			NewRuleActionWithRemote(localActions, remoteActions),
 			NewRuleEnvVar(),
 			NewRuleID(),
 			NewRuleGlob(),
 			NewRulePermissions(),
-			NewRuleWorkflowCall(path, localReusableWorkflows),
-			NewRuleExpression(localActions, localReusableWorkflows),
-			NewRuleDeprecatedCommands(),
-			NewRuleIfCond(),
+			NewRuleWorkflowCall(path, localReusableWorkflows, remoteWorkflows),
+			NewRuleExpressionWithRemote(localActions, remoteActions, localReusableWorkflows, remoteWorkflows),
+			NewRuleDeprecatedCommandsWithSource(content),
+			NewRuleIfCondWithSource(content),
+			NewRuleRemoteScript(),
+			NewRuleArtifactName(),
+			NewRuleRunnerArch(),
+			NewRuleDeprecation(localActions, l.now),
+			NewRuleReleaseTrigger(github, l.githubRepository(project)),
+			NewRuleDispatchInputCommand(),
+			NewRuleSetupOrder(),
+			NewRuleStatusCheckName(),
+			NewRuleAlwaysOnCancel(content),
+			NewRuleSetupCache(project),
+			NewRuleWorkflowFile(file, project),
+			NewRuleEventAction(),
+			NewRulePages(),
+			NewRuleRefName(),
+			NewRuleJobOrder(),
+			NewRuleRunName(),
+			NewRuleMatrixOutputs(),
+			NewRuleGitHubToken(),
+			NewRuleUnreachableStep(),
+			NewRuleUntrustedFlow(),
+			NewRuleWindowsBash(),
+			NewRuleScheduleTimezone(content),
+			NewRuleEnvironmentFile(),
+			NewRuleCommentGuard(),
+			NewRuleRunnerTool(),
+			NewRuleCheckoutPath(project),
+			NewRuleTraceSecrets(),
+		}
+		if github != nil && cfg.PopularActionsEnabled() {
+			rules = append(rules, NewRuleActionFork(github))
+		}
+		// Opt-in rules are enabled only when they are enabled in "rules:" section of config
+		if cfg.RuleEnabled("checkout-persist-credentials") {
+			rules = append(rules, NewRuleCheckoutPersistCredentials())
+		}
+		if cfg.RuleEnabled("matrix-suggestion") {
+			rules = append(rules, NewRuleMatrixSuggestion(content))
+		}
+		if cfg.RuleEnabled("matrix-unused") {
+			rules = append(rules, NewRuleMatrixUnused(content))
+		}
+		if cfg.RuleEnabled("literal-key") {
+			rules = append(rules, NewRuleLiteralKey())
+		}
+		if cfg.RuleEnabled("action-trigger") {
+			rules = append(rules, NewRuleActionTrigger())
 		}
 		if l.shellcheck != "" {
 			r, err := NewRuleShellcheck(l.shellcheck, proc)
@@ -555,14 +1341,90 @@ func (l *Linter) check(
 		} else {
 			l.log("Rule \"pyflakes\" was disabled since pyflakes command name was empty")
 		}
+		if l.psscriptanalyzer != "" {
+			r, err := NewRulePSScriptAnalyzer(l.psscriptanalyzer, proc)
+			if err == nil {
+				rules = append(rules, r)
+			} else {
+				l.log("Rule \"psscriptanalyzer\" was disabled:", err)
+			}
+		} else {
+			l.log("Rule \"psscriptanalyzer\" was disabled since PowerShell command name was empty")
+		}
+		if cfg != nil && len(cfg.Plugins) > 0 {
+			if l.plugins {
+				root := ""
+				if project != nil {
+					root = project.RootDir()
+				}
+				for _, p := range cfg.Plugins {
+					if _, ok := l.onlyRules[p.Name]; l.onlyRules != nil && !ok {
+						continue // Avoid finding the command of the plugin which is never run
+					}
+					r, err := NewRulePlugin(p, root, path, content, proc)
+					if err != nil {
+						return nil, err
+					}
+					rules = append(rules, r)
+				}
+			} else {
+				l.log("Plugins in config were not run since running plugins was not enabled by -plugins flag")
+			}
+		}
+		rules = append(rules, newCustomRules(path, content)...)
+		if l.onlyRules != nil {
+			rs, err := l.selectOnlyRules(rules, cfg)
+			if err != nil {
+				return nil, err
+			}
+			rules = rs
+		}
+		if cfg != nil && len(cfg.Rules) > 0 {
+			enabled := make([]Rule, 0, len(rules))
+			for _, r := range rules {
+				if cfg.RuleOutputSeverity(r.Name(), l.output) == SeverityOff {
+					l.debug("Rule %q was disabled by \"severity: off\" in config", r.Name())
+					continue
+				}
+				enabled = append(enabled, r)
+			}
+			rules = enabled
+		}
 		if l.onRulesCreated != nil {
 			rules = l.onRulesCreated(rules)
 		}
+		if l.onFileStart != nil {
+			rs, err := l.onFileStart(path, rules)
+			if err != nil {
+				return nil, fmt.Errorf("linting %s was stopped before applying rules: %w", path, err)
+			}
+			rules = rs
+		}
+
+		var inspected []*RuleExpression
+		if l.selector != nil {
+			for _, r := range rules {
+				if e, ok := r.(*RuleExpression); ok {
+					e.inspected = []*inspectedExpr{}
+					inspected = append(inspected, e)
+				}
+			}
+		}
 
 		v := NewVisitor()
 		for _, rule := range rules {
 			v.AddPass(rule)
 		}
+		// Rules are independent of each other so they can traverse the workflow concurrently. Errors
+		// are still collected in the order of the rules below
+		steps := 0
+		for _, j := range w.Jobs {
+			steps += len(j.Steps)
+		}
+		if steps >= parallelRulesMinSteps {
+			l.debug("Applying %d rules in parallel to %d steps in %s", len(rules), steps, path)
+			v.EnableParallel(runtime.NumCPU())
+		}
 		if dbg != nil {
 			v.EnableDebug(dbg)
 			for _, r := range rules {
@@ -583,6 +1445,13 @@ func (l *Linter) check(
 		for _, rule := range rules {
 			errs := rule.Errs()
 			l.debug("%s found %d errors", rule.Name(), len(errs))
+			if l.onRuleError != nil {
+				for _, e := range errs {
+					if err := l.onRuleError(path, rule, e); err != nil {
+						return nil, fmt.Errorf("linting %s was stopped at error from rule %q: %w", path, rule.Name(), err)
+					}
+				}
+			}
 			all = append(all, errs...)
 		}
 
@@ -591,41 +1460,303 @@ func (l *Linter) check(
 				l.errFmt.RegisterRule(rule)
 			}
 		}
+		if l.renderer != nil {
+			for _, rule := range rules {
+				l.reportRules.add(rule.Name(), rule.Description())
+			}
+		}
+
+		if l.selector != nil {
+			s, err := l.selector.Select(w, content)
+			if err != nil {
+				return nil, fmt.Errorf("could not select subtree of %s: %w", path, err)
+			}
+			for _, r := range inspected {
+				s.addExprs(r.inspected)
+			}
+			l.selected.set(path, s)
+			all = s.Filter(all)
+		}
 	}
 
-	if len(l.ignorePats) > 0 {
-		filtered := make([]*Error, 0, len(all))
-	Loop:
-		for _, err := range all {
-			for _, pat := range l.ignorePats {
-				if pat.MatchString(err.Message) {
-					continue Loop
+	return all, nil
+}
+
+// selectOnlyRules returns the rules given by OnlyRules option. An error is returned when a rule
+// name is neither a built-in rule, a plugin in the config, nor a rule registered by RegisterRule.
+func (l *Linter) selectOnlyRules(rules []Rule, cfg *Config) ([]Rule, error) {
+	known := make(map[string]struct{}, len(builtinRuleNames))
+	for _, n := range builtinRuleNames {
+		known[n] = struct{}{}
+	}
+	if cfg != nil {
+		for _, p := range cfg.Plugins {
+			known[p.Name] = struct{}{}
+		}
+	}
+	for _, n := range RegisteredRuleNames() {
+		known[n] = struct{}{}
+	}
+	for n := range l.onlyRules {
+		if _, ok := known[n]; !ok {
+			ns := make([]string, 0, len(known))
+			for k := range known {
+				ns = append(ns, k)
+			}
+			return nil, fmt.Errorf("unknown rule %q to apply only the rule. available rules are %s", n, sortedQuotes(ns))
+		}
+	}
+
+	selected := make([]Rule, 0, len(l.onlyRules))
+	for _, r := range rules {
+		if _, ok := l.onlyRules[r.Name()]; ok {
+			selected = append(selected, r)
+		} else {
+			l.debug("Rule %q was skipped since it is not given to run only specific rules", r.Name())
+		}
+	}
+	return selected, nil
+}
+
+// The maximum number of times to apply fixes to one file. Fixes which overlap with other fixes are
+// applied at the next time.
+const maxFixIterations = 10
+
+// fixFile applies the fixes of the errors to the workflow file and lints the fixed source again. It
+// returns the errors and the source after the fixes. The path parameter is a path for the errors
+// and the file parameter is a path to write the fixed source.
+func (l *Linter) fixFile(ctx context.Context, path, file string, src []byte, errs []*Error, lint func([]byte) ([]*Error, error)) ([]*Error, []byte, error) {
+	for i := 0; i < maxFixIterations; i++ {
+		b, fixed := ApplyFixes(src, errs)
+		if len(fixed) == 0 {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, nil, fmt.Errorf("fixing %s was canceled: %w", path, err)
+		}
+
+		perm := os.FileMode(0644)
+		if s, err := os.Stat(file); err == nil {
+			perm = s.Mode().Perm()
+		}
+		if err := os.WriteFile(file, b, perm); err != nil {
+			return nil, nil, fmt.Errorf("could not write fixed workflow to %q: %w", file, err)
+		}
+		l.log("Fixed", len(fixed), "errors in", path)
+
+		es, err := lint(b)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fatal error while checking fixed %s: %w", path, err)
+		}
+		src, errs = b, es
+	}
+	return errs, src, nil
+}
+
+func (l *Linter) absPath(path string) string {
+	if filepath.IsAbs(path) || l.cwd == "" {
+		return absPath(path)
+	}
+	return filepath.Join(l.cwd, path)
+}
+
+func embeddedWorkflowsError(err error, src []byte) *Error {
+	msg := err.Error()
+	l, c := 0, 0
+	if ss := reYAMLErrorLine.FindStringSubmatch(msg); len(ss) > 1 {
+		l, _ = strconv.Atoi(ss[1])
+		c = firstNonSpaceColumn(src, l)
+	}
+	return &Error{
+		Message: "could not extract embedded workflows: " + msg,
+		Line:    l,
+		Column:  c,
+		Kind:    "syntax-check",
+	}
+}
+
+func (l *Linter) githubRepository(p *Project) string {
+	if l.github == nil || p == nil {
+		return ""
+	}
+	r := p.GitHubRepository()
+	if r == "" {
+		l.log("Online checks for repository were disabled since GitHub repository was not detected from remote URL of", p.RootDir())
+	}
+	return r
+}
+
+// organizeErrors removes the errors recorded in the baseline, then deduplicates, caps, and groups
+// the errors following the options. It returns the errors to report and the numbers of omitted
+// errors per rule.
+func (l *Linter) organizeErrors(errs []*Error) ([]*Error, map[string]int) {
+	if l.baseline != nil {
+		n := len(errs)
+		errs = l.baseline.Filter(errs)
+		l.log("Omitted", n-len(errs), "errors recorded in baseline file")
+	}
+
+	if l.dedup {
+		type key struct{ file, kind, msg string }
+		seen := make(map[key]struct{}, len(errs))
+		deduped := make([]*Error, 0, len(errs))
+		for _, err := range errs {
+			k := key{err.Filepath, err.Kind, err.Message}
+			if _, ok := seen[k]; ok {
+				l.debug("Removed duplicate error at %s:%d:%d: %s", err.Filepath, err.Line, err.Column, err.Message)
+				continue
+			}
+			seen[k] = struct{}{}
+			deduped = append(deduped, err)
+		}
+		errs = deduped
+	}
+
+	var omitted map[string]int
+	if l.maxPerRule > 0 {
+		counts := map[string]int{}
+		capped := make([]*Error, 0, len(errs))
+		for _, err := range errs {
+			counts[err.Kind]++
+			if counts[err.Kind] > l.maxPerRule {
+				if omitted == nil {
+					omitted = map[string]int{}
 				}
+				omitted[err.Kind]++
+				continue
 			}
-			filtered = append(filtered, err)
+			capped = append(capped, err)
 		}
-		all = filtered
+		errs = capped
 	}
 
-	for _, err := range all {
-		err.Filepath = path // Populate filename in the error
+	if l.groupBy == "rule" {
+		sort.SliceStable(errs, func(i, j int) bool {
+			return errs[i].Kind < errs[j].Kind
+		})
 	}
 
-	sort.Stable(ByErrorPosition(all))
+	return errs, omitted
+}
 
-	if l.logLevel >= LogLevelVerbose {
-		elapsed := time.Since(start)
-		l.log("Found total", len(all), "errors in", elapsed.Milliseconds(), "ms for", path)
+// printErrors organizes the errors and prints them. The srcs parameter maps file paths of the errors
+// to their sources. It returns the printed errors.
+func (l *Linter) printErrors(errs []*Error, srcs map[string][]byte) ([]*Error, error) {
+	errs, omitted := l.organizeErrors(errs)
+
+	kinds := make([]string, 0, len(omitted))
+	for k := range omitted {
+		kinds = append(kinds, k)
 	}
+	sort.Strings(kinds)
 
-	return all, nil
+	if l.renderer != nil {
+		r := &ErrorReport{
+			Files:   make([]string, 0, len(srcs)),
+			Errors:  make([]*ErrorTemplateFields, 0, len(errs)),
+			Sources: srcs,
+		}
+		for f := range srcs {
+			r.Files = append(r.Files, f)
+		}
+		sort.Strings(r.Files)
+		for _, err := range errs {
+			r.Errors = append(r.Errors, l.templateFields(err, srcs[err.Filepath]))
+			l.reportRules.add(err.Kind, "")
+		}
+		r.Rules = l.reportRules.list()
+		if err := l.renderer.Render(l.out, r); err != nil {
+			return nil, err
+		}
+		for _, k := range kinds {
+			l.log("Omitted", omitted[k], "errors of rule", k)
+		}
+		return errs, nil
+	}
+
+	if l.errFmt != nil {
+		t := make([]*ErrorTemplateFields, 0, len(errs))
+		for _, err := range errs {
+			t = append(t, l.templateFields(err, srcs[err.Filepath]))
+		}
+		if err := l.errFmt.Print(l.out, t); err != nil {
+			return nil, err
+		}
+		for _, k := range kinds {
+			l.log("Omitted", omitted[k], "errors of rule", k)
+		}
+		return errs, nil
+	}
+
+	var group func(*Error) string
+	if !l.oneline {
+		switch l.groupBy {
+		case "rule":
+			group = func(e *Error) string { return "[" + e.Kind + "]" }
+		case "file":
+			group = func(e *Error) string { return e.Filepath }
+		}
+	}
+	counts := map[string]int{}
+	if group != nil {
+		for _, err := range errs {
+			counts[group(err)]++
+		}
+	}
+
+	prev := ""
+	for i, err := range errs {
+		if group != nil {
+			if g := group(err); i == 0 || g != prev {
+				if i > 0 {
+					fmt.Fprintln(l.out)
+				}
+				bold.Fprint(l.out, g)
+				gray.Fprintf(l.out, " (%d %s)\n", counts[g], pluralErrors(counts[g]))
+				prev = g
+			}
+		}
+		var src []byte
+		if !l.oneline {
+			src = srcs[err.Filepath]
+		}
+		err.PrettyPrintWithContext(l.out, src, l.contextLines)
+		if l.reportFeedback {
+			gray.Fprintf(l.out, "fingerprint: %s\n", err.Fingerprint(srcs[err.Filepath]))
+		}
+	}
+
+	for _, k := range kinds {
+		gray.Fprintf(l.out, "%d more %s of [%s] omitted\n", omitted[k], pluralErrors(omitted[k]), k)
+	}
+
+	if l.selector != nil && !l.oneline {
+		files := make([]string, 0, len(srcs))
+		for f := range srcs {
+			files = append(files, f)
+		}
+		sort.Strings(files)
+		for _, f := range files {
+			if s := l.selected.get(f); s != nil {
+				s.PrettyPrint(l.out, f)
+			}
+		}
+	}
+
+	return errs, nil
 }
 
-func (l *Linter) printErrors(errs []*Error, src []byte) {
-	if l.oneline {
-		src = nil
+func (l *Linter) templateFields(err *Error, src []byte) *ErrorTemplateFields {
+	t := err.GetTemplateFields(src)
+	if l.reportFeedback {
+		t.Fingerprint = err.Fingerprint(src)
 	}
-	for _, err := range errs {
-		err.PrettyPrint(l.out, src)
+	return t
+}
+
+func pluralErrors(n int) string {
+	if n == 1 {
+		return "error"
 	}
+	return "errors"
 }
@@ -2,7 +2,9 @@ package actionlint
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -11,12 +13,22 @@ import (
 	"runtime"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/sys/execabs"
 )
 
+// testFixtureNow is the current time to check the fixtures. No deprecation by GitHub is announced
+// at this time so the results of the fixtures using old actions and runner images do not depend on
+// the calendar of deprecations. Fixtures for "deprecation" rule are checked at testDeprecationNow.
+var (
+	testFixtureNow     = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testDeprecationNow = time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+)
+
 func TestLinterLintOK(t *testing.T) {
 	dir := filepath.Join("testdata", "ok")
 
@@ -52,6 +64,7 @@ func TestLinterLintOK(t *testing.T) {
 			opts := LinterOptions{
 				Shellcheck: shellcheck,
 				Pyflakes:   pyflakes,
+				Now:        testFixtureNow,
 			}
 
 			linter, err := NewLinter(io.Discard, &opts)
@@ -165,7 +178,11 @@ func TestLinterLintError(t *testing.T) {
 					panic(err)
 				}
 
-				o := LinterOptions{}
+				o := LinterOptions{Now: testFixtureNow}
+
+				if strings.Contains(testName, "deprecation") {
+					o.Now = testDeprecationNow
+				}
 
 				if strings.Contains(testName, "shellcheck") {
 					if shellcheck == "" {
@@ -227,6 +244,7 @@ func TestLinterLintAllErrorWorkflowsAtOnce(t *testing.T) {
 	o := LinterOptions{
 		Shellcheck: shellcheck,
 		Pyflakes:   pyflakes,
+		Now:        testDeprecationNow,
 	}
 
 	l, err := NewLinter(io.Discard, &o)
@@ -311,6 +329,7 @@ func TestLinterLintProject(t *testing.T) {
 			repo := filepath.Join(root, name)
 			opts := LinterOptions{
 				WorkingDir: repo,
+				Now:        testFixtureNow,
 			}
 			cfg := filepath.Join(repo, "actionlint.yaml")
 			if _, err := os.Stat(cfg); err == nil {
@@ -356,7 +375,7 @@ func TestLinterFormatErrorMessageOK(t *testing.T) {
 	infile := filepath.Join(dir, "test.yaml")
 	for _, tc := range tests {
 		t.Run(tc.file, func(t *testing.T) {
-			opts := LinterOptions{Format: tc.format}
+			opts := LinterOptions{Format: tc.format, Now: testFixtureNow}
 
 			var b strings.Builder
 			l, err := NewLinter(&b, &opts)
@@ -406,7 +425,7 @@ func TestLinterFormatErrorMessageInSARIF(t *testing.T) {
 	}
 	format := string(bytes)
 
-	opts := LinterOptions{Format: format}
+	opts := LinterOptions{Format: format, Now: testFixtureNow}
 	var b strings.Builder
 	l, err := NewLinter(&b, &opts)
 	if err != nil {
@@ -477,6 +496,412 @@ func TestLinterPathsNotFound(t *testing.T) {
 	}
 }
 
+func TestLinterFixFiles(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "actionlint.yaml")
+	if err := os.WriteFile(cfg, []byte("rules:\n  matrix-suggestion:\n    enable: true\n"), 0644); err != nil {
+		panic(err)
+	}
+	src := `on: push
+jobs:
+  test-linux:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make test
+  test-windows:
+    runs-on: windows-latest
+    steps:
+      - run: make test
+`
+	want := `on: push
+jobs:
+  test:
+    strategy:
+      matrix:
+        os: [ubuntu-latest, windows-latest]
+    runs-on: ${{ matrix.os }}
+    steps:
+      - run: make test
+`
+	path := filepath.Join(dir, "test.yaml")
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		panic(err)
+	}
+
+	for _, fix := range []bool{false, true} {
+		l, err := NewLinter(io.Discard, &LinterOptions{ConfigFile: cfg, Fix: fix})
+		if err != nil {
+			t.Fatal(err)
+		}
+		errs, err := l.LintFiles([]string{path}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			panic(err)
+		}
+		have := string(b)
+
+		if !fix {
+			if len(errs) != 1 || errs[0].Kind != "matrix-suggestion" {
+				t.Fatalf("wanted one matrix-suggestion error but got %v", errs)
+			}
+			if have != src {
+				t.Fatalf("file was modified without -fix:\n%s", have)
+			}
+			continue
+		}
+
+		if len(errs) > 0 {
+			t.Fatalf("errors remain after fix: %v", errs)
+		}
+		if have != want {
+			t.Fatalf("wanted:\n%s\nbut got:\n%s", want, have)
+		}
+	}
+}
+
+func TestLinterFixMisspellingsAndDeprecations(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latst
+    timeout_minutes: 10
+    stesp:
+    steps:
+      - id: out
+        run: |
+          echo "::set-output name=foo::bar"
+          echo '::add-path::/opt/bin'
+          echo "::set-output name=$NAME::bar"
+      - run: echo ${{ steps.out.outputs.foo }}
+        if: ${{ github.event_name == 'push' }} && ${{ !cancelled() }}
+`
+	want := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    timeout-minutes: 10
+    stesp:
+    steps:
+      - id: out
+        run: |
+          echo "foo=bar" >> "$GITHUB_OUTPUT"
+          echo '/opt/bin' >> "$GITHUB_PATH"
+          echo "::set-output name=$NAME::bar"
+      - run: echo ${{ steps.out.outputs.foo }}
+        if: ${{ github.event_name == 'push' && !cancelled() }}
+`
+	path := filepath.Join(t.TempDir(), "test.yaml")
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		panic(err)
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{Fix: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, err := l.LintFiles([]string{path}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	if have := string(b); have != want {
+		t.Fatalf("wanted:\n%s\nbut got:\n%s", want, have)
+	}
+
+	// "stesp" is not fixed since "steps" already exists. The command with a shell variable as its
+	// name is not fixed since the name cannot be checked
+	kinds := []string{}
+	for _, e := range errs {
+		kinds = append(kinds, e.Kind)
+	}
+	if !cmp.Equal(kinds, []string{"syntax-check", "deprecated-commands"}) {
+		t.Fatalf("unexpected errors after fix: %v", errs)
+	}
+}
+
+func TestLinterOrganizeErrors(t *testing.T) {
+	errs := func() []*Error {
+		return []*Error{
+			{Filepath: "a.yaml", Line: 1, Column: 1, Kind: "expression", Message: "foo"},
+			{Filepath: "a.yaml", Line: 2, Column: 1, Kind: "syntax-check", Message: "bar"},
+			{Filepath: "a.yaml", Line: 3, Column: 1, Kind: "expression", Message: "foo"},
+			{Filepath: "b.yaml", Line: 1, Column: 1, Kind: "expression", Message: "foo"},
+			{Filepath: "b.yaml", Line: 2, Column: 1, Kind: "expression", Message: "piyo"},
+		}
+	}
+
+	testCases := []struct {
+		what    string
+		opts    LinterOptions
+		want    []string
+		omitted map[string]int
+		output  []string
+	}{
+		{
+			what: "default",
+			opts: LinterOptions{},
+			want: []string{"a.yaml:1", "a.yaml:2", "a.yaml:3", "b.yaml:1", "b.yaml:2"},
+		},
+		{
+			what: "dedup",
+			opts: LinterOptions{Dedup: true},
+			want: []string{"a.yaml:1", "a.yaml:2", "b.yaml:1", "b.yaml:2"},
+		},
+		{
+			what:    "max per rule",
+			opts:    LinterOptions{MaxPerRule: 2},
+			want:    []string{"a.yaml:1", "a.yaml:2", "a.yaml:3"},
+			omitted: map[string]int{"expression": 2},
+			output:  []string{"2 more errors of [expression] omitted"},
+		},
+		{
+			what:   "group by rule",
+			opts:   LinterOptions{GroupBy: "rule"},
+			want:   []string{"a.yaml:1", "a.yaml:3", "b.yaml:1", "b.yaml:2", "a.yaml:2"},
+			output: []string{"[expression] (4 errors)", "[syntax-check] (1 error)"},
+		},
+		{
+			what:   "report feedback",
+			opts:   LinterOptions{ReportFeedback: true},
+			want:   []string{"a.yaml:1", "a.yaml:2", "a.yaml:3", "b.yaml:1", "b.yaml:2"},
+			output: []string{"fingerprint: expression:", "fingerprint: syntax-check:"},
+		},
+		{
+			what:   "group by file",
+			opts:   LinterOptions{GroupBy: "file", Dedup: true},
+			want:   []string{"a.yaml:1", "a.yaml:2", "b.yaml:1", "b.yaml:2"},
+			output: []string{"a.yaml (2 errors)", "b.yaml (2 errors)"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var b strings.Builder
+			opts := tc.opts
+			opts.Color = ColorOptionKindNever
+			l, err := NewLinter(&b, &opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			organized, omitted := l.organizeErrors(errs())
+			have := make([]string, 0, len(organized))
+			for _, err := range organized {
+				have = append(have, fmt.Sprintf("%s:%d", err.Filepath, err.Line))
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatal(diff)
+			}
+			if diff := cmp.Diff(tc.omitted, omitted); diff != "" {
+				t.Fatal(diff)
+			}
+
+			if _, err := l.printErrors(errs(), map[string][]byte{}); err != nil {
+				t.Fatal(err)
+			}
+			out := b.String()
+			for _, o := range tc.output {
+				if !strings.Contains(out, o) {
+					t.Errorf("output does not contain %q: %q", o, out)
+				}
+			}
+		})
+	}
+
+	if _, err := NewLinter(io.Discard, &LinterOptions{GroupBy: "job"}); err == nil || !strings.Contains(err.Error(), "invalid key \"job\"") {
+		t.Fatal("unexpected error for invalid group key:", err)
+	}
+}
+
+func TestLinterPrintErrorsWithContextLines(t *testing.T) {
+	var b strings.Builder
+	l, err := NewLinter(&b, &LinterOptions{ContextLines: 1, Color: ColorOptionKindNever})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n")
+	errs := []*Error{{Filepath: "test.yaml", Line: 3, Column: 3, Kind: "syntax-check", Message: "oops"}}
+	if _, err := l.printErrors(errs, map[string][]byte{"test.yaml": src}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `test.yaml:3:3: oops [syntax-check]
+  |
+2 | jobs:
+3 |   test:
+  |   ^~~~~
+4 |     runs-on: ubuntu-latest
+`
+	if have := b.String(); have != want {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	if _, err := NewLinter(io.Discard, &LinterOptions{ContextLines: -1}); err == nil || !strings.Contains(err.Error(), "number of context lines must not be negative") {
+		t.Fatal("unexpected error for negative number of context lines:", err)
+	}
+}
+
+func TestLinterNowOption(t *testing.T) {
+	src := []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/upload-artifact@v3\n        with:\n          name: foo\n          path: foo\n")
+
+	tests := []struct {
+		now  string
+		want string
+	}{
+		{"2024-04-01T00:00:00Z", ""},
+		{"2024-06-01T00:00:00Z", "will be unavailable from 2025-01-30. "},
+		{"2025-06-01T00:00:00Z", "is no longer available since 2025-01-30. "},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.now, func(t *testing.T) {
+			now, err := time.Parse(time.RFC3339, tc.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l, err := NewLinter(io.Discard, &LinterOptions{Now: now, OnlyRules: []string{"deprecation"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			errs, err := l.Lint("test.yaml", src, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0].Message, tc.want) {
+				t.Fatalf("wanted one error containing %q but got %v", tc.want, errs)
+			}
+		})
+	}
+}
+
+func TestLinterLintContextCanceled(t *testing.T) {
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	src := []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo hello\n")
+	if _, err := l.LintContext(ctx, "test.yaml", src, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled error was expected but got %v", err)
+	}
+
+	files := []string{
+		filepath.Join("testdata", "ok", "minimal.yaml"),
+		filepath.Join("testdata", "ok", "minimal.yaml"),
+	}
+	if _, err := l.LintFilesContext(ctx, files, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("canceled error was expected but got %v", err)
+	}
+
+	// Linting is not affected by the canceled context once it finishes
+	errs, err := l.Lint("test.yaml", src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) > 0 {
+		t.Fatal("unexpected errors:", errs)
+	}
+}
+
+func TestLinterLintLargeWorkflowInParallel(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n")
+	for i := 0; i < parallelRulesMinSteps; i++ {
+		fmt.Fprintf(&b, "      - run: echo ${{ unknown%d }}\n        shell: foo\n", i)
+	}
+	src := []byte(b.String())
+
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := l.Lint("test.yaml", src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(want) != parallelRulesMinSteps*2 {
+		t.Fatalf("%d errors were expected but got %d: %v", parallelRulesMinSteps*2, len(want), want)
+	}
+	for i, e := range want {
+		step := i / 2
+		if line := 6 + step*2 + i%2; e.Line != line {
+			t.Fatalf("error #%d should be at line %d but got %s", i, line, e)
+		}
+	}
+
+	// The order of errors does not depend on scheduling of goroutines
+	for i := 0; i < 5; i++ {
+		have, err := l.Lint("test.yaml", src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(want, have) {
+			t.Fatal(cmp.Diff(want, have))
+		}
+	}
+}
+
+func TestLinterLintSources(t *testing.T) {
+	cfg, err := parseConfig([]byte("self-hosted-runner:\n  labels: [my-runner]\n"), "actionlint.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcs := &Sources{
+		Files: []*SourceFile{
+			{".github/workflows/a.yaml", []byte("on: push\njobs:\n  test:\n    runs-on: my-runner\n    steps:\n      - run: echo hello\n")},
+			{".github/workflows/b.yaml", []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ foo }}\n")},
+		},
+		Config: cfg,
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, err := l.LintSources(srcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("wanted only one error but got %v", errs)
+	}
+	if errs[0].Filepath != ".github/workflows/b.yaml" || errs[0].Kind != "expression" {
+		t.Fatalf("unexpected error: %v", errs[0])
+	}
+
+	// Config given via option is prioritized
+	f := filepath.Join(t.TempDir(), "actionlint.yaml")
+	if err := os.WriteFile(f, []byte("self-hosted-runner:\n  labels: []\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	l, err = NewLinter(io.Discard, &LinterOptions{ConfigFile: f})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, err = l.LintSources(srcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 2 || errs[0].Kind != "runner-label" {
+		t.Fatalf("runner label error was expected but got %v", errs)
+	}
+}
+
 type customRuleForTest struct {
 	RuleBase
 	count int
@@ -550,6 +975,136 @@ jobs:
 	}
 }
 
+func TestLinterRegisterRule(t *testing.T) {
+	paths := []string{}
+	var mu sync.Mutex
+	err := RegisterRule("this-is-test", func(path string, src []byte) Rule {
+		mu.Lock()
+		paths = append(paths, path)
+		mu.Unlock()
+		if strings.HasPrefix(path, "skip") {
+			return nil
+		}
+		return &customRuleForTest{RuleBase: NewRuleBase("this-is-test", "Test rule")}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer RegisterRule("this-is-test", nil)
+
+	if want, have := []string{"this-is-test"}, RegisteredRuleNames(); !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo
+      - run: echo
+`
+	l, err := NewLinter(io.Discard, &LinterOptions{OnlyRules: []string{"this-is-test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	errs, err := l.Lint("test.yaml", []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || errs[0].Kind != "this-is-test" || errs[0].Line != 7 {
+		t.Fatal("unexpected errors from registered rule:", errs)
+	}
+
+	errs, err = l.Lint("skip.yaml", []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 0 {
+		t.Fatal("rule should not be applied when the factory returns nil but got", errs)
+	}
+
+	if want := []string{"test.yaml", "skip.yaml"}; !cmp.Equal(want, paths) {
+		t.Fatal(cmp.Diff(want, paths))
+	}
+}
+
+func TestLinterBuiltinRuleNames(t *testing.T) {
+	if !sort.StringsAreSorted(builtinRuleNames) {
+		t.Error("built-in rule names are not sorted:", builtinRuleNames)
+	}
+	for _, n := range builtinRuleNames {
+		if _, ok := sarifRuleAnchors[n]; !ok {
+			t.Errorf("document anchor of built-in rule %q is missing", n)
+		}
+	}
+
+	names := []string{}
+	l, err := NewLinter(io.Discard, &LinterOptions{
+		OnRulesCreated: func(rules []Rule) []Rule {
+			for _, r := range rules {
+				names = append(names, r.Name())
+			}
+			return rules
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{Rules: map[string]*RuleConfig{}}
+	for _, n := range []string{"checkout-persist-credentials", "matrix-suggestion", "matrix-unused", "literal-key", "action-trigger"} {
+		cfg.Rules[n] = &RuleConfig{Enable: true}
+	}
+	l.defaultConfig = cfg
+
+	if _, err := l.Lint("test.yaml", []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) == 0 {
+		t.Fatal("no rule was created")
+	}
+	for _, n := range names {
+		if !contains(builtinRuleNames, n) {
+			t.Errorf("rule %q is not in the list of built-in rule names", n)
+		}
+	}
+}
+
+func TestLinterRegisterRuleFactoryAccessingRegistry(t *testing.T) {
+	// Factories are called without holding the lock of the registry
+	var names []string
+	err := RegisterRule("this-is-test", func(path string, src []byte) Rule {
+		names = RegisteredRuleNames()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer RegisterRule("this-is-test", nil)
+
+	if rs := newCustomRules("test.yaml", []byte("on: push\n")); len(rs) != 0 {
+		t.Fatal("no rule should be created but got", rs)
+	}
+	if want := []string{"this-is-test"}; !cmp.Equal(want, names) {
+		t.Fatal(cmp.Diff(want, names))
+	}
+}
+
+func TestLinterRegisterRuleError(t *testing.T) {
+	f := func(string, []byte) Rule { return nil }
+	if err := RegisterRule("expression", f); err == nil || !strings.Contains(err.Error(), `rule "expression" cannot be registered since it is a built-in rule`) {
+		t.Fatalf("unexpected error for built-in rule: %v", err)
+	}
+	if err := RegisterRule("Step_Name", f); err == nil || !strings.Contains(err.Error(), `invalid rule name "Step_Name"`) {
+		t.Fatalf("unexpected error for invalid name: %v", err)
+	}
+	if ns := RegisteredRuleNames(); len(ns) != 0 {
+		t.Fatal("rules should not be registered on error:", ns)
+	}
+}
+
 func TestLinterRemoveRuleOnRulesCreatedHook(t *testing.T) {
 	o := &LinterOptions{
 		OnRulesCreated: func(rules []Rule) []Rule {
@@ -579,6 +1134,355 @@ func TestLinterRemoveRuleOnRulesCreatedHook(t *testing.T) {
 	}
 }
 
+func TestLinterRuleSeverity(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ unknown }}
+        shel: bash
+`
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{
+		Rules: map[string]*RuleConfig{
+			"expression":   {Severity: SeverityWarning},
+			"syntax-check": {Severity: SeverityOff},
+		},
+	}
+
+	errs, err := l.Lint("test.yaml", []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("wanted one error but got %v", errs)
+	}
+	if e := errs[0]; e.Kind != "expression" || !e.IsWarning() {
+		t.Fatalf("wanted warning of expression rule but got %v (severity=%q)", e, e.Severity)
+	}
+	if f := errs[0].GetTemplateFields(nil); f.Severity != SeverityWarning {
+		t.Fatalf("severity of template fields is %q", f.Severity)
+	}
+}
+
+func TestLinterRuleOutputSeverity(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ unknown }}
+        shel: bash
+`
+	cfg := &Config{
+		Rules: map[string]*RuleConfig{
+			"expression":   {OutputSeverity: map[string]string{"sarif": SeverityNote}},
+			"syntax-check": {Severity: SeverityOff, OutputSeverity: map[string]string{"sarif": SeverityError}},
+		},
+	}
+
+	for _, tc := range []struct {
+		renderer string
+		want     map[string]string
+	}{
+		{"", map[string]string{"expression": ""}},
+		{"sarif", map[string]string{"expression": SeverityNote, "syntax-check": ""}},
+	} {
+		t.Run(tc.renderer, func(t *testing.T) {
+			l, err := NewLinter(io.Discard, &LinterOptions{Renderer: tc.renderer})
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.defaultConfig = cfg
+
+			errs, err := l.Lint("test.yaml", []byte(src), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			have := map[string]string{}
+			for _, e := range errs {
+				have[e.Kind] = e.Severity
+			}
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
+
+func TestLinterOnlyRules(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ unknown }}
+        shel: bash
+`
+	testCases := []struct {
+		what string
+		only []string
+		want []string
+	}{
+		{"expression", []string{"expression"}, []string{"expression", "syntax-check"}},
+		{"no error", []string{"runner-label"}, []string{"syntax-check"}},
+		{"multiple", []string{"runner-label", "expression"}, []string{"expression", "syntax-check"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			l, err := NewLinter(io.Discard, &LinterOptions{OnlyRules: tc.only})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The plugin is never run since it is not selected
+			l.defaultConfig = &Config{Plugins: []*PluginConfig{{Name: "my-plugin", Command: "this-command-does-not-exist"}}}
+
+			errs, err := l.Lint("test.yaml", []byte(src), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			kinds := []string{}
+			for _, e := range errs {
+				kinds = append(kinds, e.Kind)
+			}
+			if !cmp.Equal(kinds, tc.want) {
+				t.Fatal(cmp.Diff(tc.want, kinds))
+			}
+		})
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{OnlyRules: []string{"my-plugin"}, Plugins: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{Plugins: []*PluginConfig{{Name: "my-plugin", Command: "this-command-does-not-exist"}}}
+	_, err = l.Lint("test.yaml", []byte(src), nil)
+	if err == nil || !strings.Contains(err.Error(), `command "this-command-does-not-exist" of plugin "my-plugin" was not found`) {
+		t.Fatalf("selected plugin should be run but got %v", err)
+	}
+}
+
+func TestLinterPluginsOption(t *testing.T) {
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"
+	cfg := &Config{Plugins: []*PluginConfig{{Name: "my-plugin", Command: "./this-command-does-not-exist"}}}
+
+	// Plugins configured by the repository are not run unless they are enabled explicitly
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = cfg
+	errs, err := l.Lint("test.yaml", []byte(src), nil)
+	if err != nil {
+		t.Fatalf("plugin should not be run: %v", err)
+	}
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	l, err = NewLinter(io.Discard, &LinterOptions{Plugins: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = cfg
+	_, err = l.Lint("test.yaml", []byte(src), nil)
+	if err == nil || !strings.Contains(err.Error(), `plugin "my-plugin" was not found`) {
+		t.Fatalf("plugin should be run with Plugins option but got %v", err)
+	}
+}
+
+func TestLinterOnlyRulesError(t *testing.T) {
+	if _, err := NewLinter(io.Discard, &LinterOptions{OnlyRules: []string{"Expression"}}); err == nil || !strings.Contains(err.Error(), `invalid rule name "Expression"`) {
+		t.Fatalf("unexpected error for invalid rule name: %v", err)
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{OnlyRules: []string{"expresion"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+	_, err = l.Lint("test.yaml", []byte("on: push\njobs: {}\n"), nil)
+	if err == nil || !strings.Contains(err.Error(), `unknown rule "expresion" to apply only the rule. available rules are "action", `) {
+		t.Fatalf("unexpected error for unknown rule name: %v", err)
+	}
+}
+
+func TestLinterLifecycleHooks(t *testing.T) {
+	var mu sync.Mutex
+	started := []string{}
+	ruleErrs := map[string]int{}
+	ended := map[string]int{}
+	o := &LinterOptions{
+		OnFileStart: func(path string, rules []Rule) ([]Rule, error) {
+			mu.Lock()
+			started = append(started, filepath.Base(path))
+			mu.Unlock()
+			if filepath.Base(path) != "invalid_runner_labels.yaml" {
+				return rules, nil
+			}
+			rs := make([]Rule, 0, len(rules))
+			for _, r := range rules {
+				if r.Name() != "runner-label" {
+					rs = append(rs, r)
+				}
+			}
+			return rs, nil
+		},
+		OnRuleError: func(path string, rule Rule, err *Error) error {
+			if rule.Name() != err.Kind {
+				t.Errorf("rule %q reported error of kind %q", rule.Name(), err.Kind)
+			}
+			mu.Lock()
+			ruleErrs[filepath.Base(path)]++
+			mu.Unlock()
+			return nil
+		},
+		OnFileEnd: func(path string, errs []*Error) error {
+			mu.Lock()
+			ended[filepath.Base(path)] = len(errs)
+			mu.Unlock()
+			return nil
+		},
+	}
+
+	l, err := NewLinter(io.Discard, o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	fs := []string{
+		filepath.Join("testdata", "err", "invalid_runner_labels.yaml"),
+		filepath.Join("testdata", "err", "env_context_banned.yaml"),
+	}
+	errs, err := l.LintFiles(fs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(started)
+	if diff := cmp.Diff([]string{"env_context_banned.yaml", "invalid_runner_labels.yaml"}, started); diff != "" {
+		t.Fatal(diff)
+	}
+	if n := ended["invalid_runner_labels.yaml"]; n != 0 {
+		t.Errorf("runner-label rule was not disabled by OnFileStart hook: %d errors", n)
+	}
+	if n := ended["env_context_banned.yaml"]; n == 0 || n != ruleErrs["env_context_banned.yaml"] {
+		t.Errorf("errors passed to OnRuleError and OnFileEnd hooks mismatch: %d vs %d", ruleErrs["env_context_banned.yaml"], n)
+	}
+	if len(errs) != ended["env_context_banned.yaml"] {
+		t.Errorf("wanted %d errors but got %v", ended["env_context_banned.yaml"], errs)
+	}
+}
+
+func TestLinterLifecycleHooksStopLinting(t *testing.T) {
+	budget := errors.New("error budget was exhausted")
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo '${{ unknown }}'
+`
+	tests := []struct {
+		what string
+		opts *LinterOptions
+	}{
+		{
+			what: "file start",
+			opts: &LinterOptions{
+				OnFileStart: func(string, []Rule) ([]Rule, error) { return nil, budget },
+			},
+		},
+		{
+			what: "rule error",
+			opts: &LinterOptions{
+				OnRuleError: func(string, Rule, *Error) error { return budget },
+			},
+		},
+		{
+			what: "file end",
+			opts: &LinterOptions{
+				OnFileEnd: func(string, []*Error) error { return budget },
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			l, err := NewLinter(io.Discard, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.defaultConfig = &Config{}
+			_, err = l.Lint("test.yaml", []byte(src), nil)
+			if !errors.Is(err, budget) {
+				t.Fatalf("linting was not stopped by the hook: %v", err)
+			}
+		})
+	}
+}
+
+func TestLinterErrorEvents(t *testing.T) {
+	src := `on:
+  push:
+  pull_request_target:
+  workflow_dispatch:
+    inputs:
+      name:
+        type: string
+run-name: ${{ github.event.issue.title }}
+
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo '${{ github.event.pull_request.title }}'
+      - run: echo '${{ github.head_ref }}'
+      - run: echo '${{ inputs.unknown }}'
+      - run: echo '${{ github.event.head_commit.message }}'
+      - run: echo '${{ github.event.issue.title }}'
+      - run: ${{ inputs.name }}
+      - run: echo '${{ matrix.unknown }}'
+`
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.defaultConfig = &Config{}
+
+	errs, err := l.Lint("test.yaml", []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[int][]string{
+		8:  {"pull_request_target", "push", "workflow_dispatch"},
+		14: {"pull_request_target"},
+		15: {"pull_request_target"},
+		16: {"workflow_dispatch"},
+		17: {"push"},
+		18: nil,
+		19: {"workflow_dispatch"},
+		20: nil,
+	}
+	have := map[int][]string{}
+	for _, err := range errs {
+		if _, ok := have[err.Line]; ok {
+			t.Fatalf("multiple errors at line %d: %v", err.Line, errs)
+		}
+		have[err.Line] = err.Events
+	}
+	if !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
 func BenchmarkLintWorkflowFiles(b *testing.B) {
 	large := filepath.Join("testdata", "bench", "many_scripts.yaml")
 	small := filepath.Join("testdata", "bench", "small.yaml")
@@ -816,3 +1720,63 @@ func BenchmarkLintRepository(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkLintCorpus(b *testing.B) {
+	dir := filepath.Join("testdata", "bench", "corpus")
+	wd := filepath.Join(dir, "workflows")
+	es, err := os.ReadDir(wd)
+	if err != nil {
+		panic(err)
+	}
+	files := make([]string, 0, len(es))
+	contents := make([][]byte, 0, len(es))
+	for _, e := range es {
+		f := filepath.Join(wd, e.Name())
+		b, err := os.ReadFile(f)
+		if err != nil {
+			panic(err)
+		}
+		files = append(files, f)
+		contents = append(contents, b)
+	}
+	proj := &Project{root: dir}
+
+	// Measure linting all workflows in the corpus in parallel as `actionlint` command does
+	b.Run("files", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			l, err := NewLinter(io.Discard, &LinterOptions{})
+			if err != nil {
+				b.Fatal(err)
+			}
+			l.defaultConfig = &Config{}
+			errs, err := l.LintFiles(files, proj)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(errs) > 0 {
+				b.Fatal("some error occurred:", errs)
+			}
+		}
+	})
+
+	// Measure linting each workflow sequentially. Reading file content is not included.
+	b.Run("content", func(b *testing.B) {
+		l, err := NewLinter(io.Discard, &LinterOptions{})
+		if err != nil {
+			b.Fatal(err)
+		}
+		l.defaultConfig = &Config{}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j, f := range files {
+				errs, err := l.Lint(f, contents[j], proj)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(errs) > 0 {
+					b.Fatal("some error occurred:", errs)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,874 @@
+package actionlint
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Error codes of JSON-RPC 2.0 and LSP.
+const (
+	lspErrorParse          = -32700
+	lspErrorInvalidRequest = -32600
+	lspErrorMethodNotFound = -32601
+	lspErrorInvalidParams  = -32602
+	lspErrorInternal       = -32603
+	lspErrorRequestFailed  = -32803
+)
+
+// lspMaxContentLength is the maximum size of body of LSP message. Larger messages are discarded
+// without allocating a buffer for them.
+const lspMaxContentLength = 32 * 1024 * 1024
+
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *lspError) Error() string {
+	return fmt.Sprintf("%s (code: %d)", e.Message, e.Code)
+}
+
+// lspMessage is a JSON-RPC 2.0 message. It is a request when both ID and Method are set, a
+// notification when only Method is set, and a response when only ID is set.
+type lspMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *lspError       `json:"error,omitempty"`
+}
+
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+type lspLocation struct {
+	URI   string   `json:"uri"`
+	Range lspRange `json:"range"`
+}
+
+type lspTextDocumentIdentifier struct {
+	URI     string `json:"uri"`
+	Version int    `json:"version,omitempty"`
+}
+
+type lspTextDocumentItem struct {
+	URI     string `json:"uri"`
+	Version int    `json:"version"`
+	Text    string `json:"text"`
+}
+
+type lspDidOpenParams struct {
+	TextDocument lspTextDocumentItem `json:"textDocument"`
+}
+
+type lspContentChange struct {
+	Text string `json:"text"`
+}
+
+type lspDidChangeParams struct {
+	TextDocument   lspTextDocumentIdentifier `json:"textDocument"`
+	ContentChanges []lspContentChange        `json:"contentChanges"`
+}
+
+type lspTextDocumentParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+}
+
+type lspTextDocumentPositionParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+	Position     lspPosition               `json:"position"`
+}
+
+type lspReferenceContext struct {
+	IncludeDeclaration bool `json:"includeDeclaration"`
+}
+
+type lspReferenceParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+	Position     lspPosition               `json:"position"`
+	Context      lspReferenceContext       `json:"context"`
+}
+
+type lspRenameParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+	Position     lspPosition               `json:"position"`
+	NewName      string                    `json:"newName"`
+}
+
+type lspTextEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
+
+type lspWorkspaceEdit struct {
+	Changes map[string][]*lspTextEdit `json:"changes"`
+}
+
+type lspCodeDescription struct {
+	Href string `json:"href"`
+}
+
+type lspDiagnostic struct {
+	Range           lspRange            `json:"range"`
+	Severity        int                 `json:"severity"`
+	Code            string              `json:"code"`
+	CodeDescription *lspCodeDescription `json:"codeDescription,omitempty"`
+	Source          string              `json:"source"`
+	Message         string              `json:"message"`
+}
+
+type lspPublishDiagnosticsParams struct {
+	URI         string           `json:"uri"`
+	Version     int              `json:"version,omitempty"`
+	Diagnostics []*lspDiagnostic `json:"diagnostics"`
+}
+
+type lspMarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+type lspHover struct {
+	Contents lspMarkupContent `json:"contents"`
+	Range    *lspRange        `json:"range,omitempty"`
+}
+
+// lspDocument is a workflow file opened in the client. Its text may not be saved to the file yet.
+type lspDocument struct {
+	path    string
+	version int
+	text    []byte
+	project *Project
+	cancel  context.CancelFunc
+}
+
+// LanguageServer is a server of Language Server Protocol (LSP) to integrate actionlint with editors.
+// It communicates with the client via JSON-RPC 2.0 messages on the given reader and writer.
+// Documents opened in the client are kept in memory so that unsaved changes are linted without
+// spawning actionlint process for each change. Errors are published as diagnostics when a document
+// is opened, changed, or saved. Linting of a document in flight is canceled when the document is
+// changed again. Hovers on expressions show their types and documents, and go-to-definition is
+// supported for job IDs at "needs:" and local actions and reusable workflows at "uses:". Jobs, step
+// IDs, inputs and outputs of reusable workflows, and inputs of local actions are indexed across the
+// repository for find-references and rename.
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/
+type LanguageServer struct {
+	linter   *Linter
+	in       *bufio.Reader
+	out      io.Writer
+	outMu    sync.Mutex
+	docs     map[string]*lspDocument
+	indexes  map[string]*symbolIndex // Keys are root directories of projects
+	wg       sync.WaitGroup
+	shutdown bool
+}
+
+// NewLanguageServer creates a new LanguageServer instance. The server reads messages from the in
+// parameter and writes messages to the out parameter. Usually they are stdin and stdout. The opts
+// parameter configures the linter used by the server. Options to output errors are ignored.
+func NewLanguageServer(in io.Reader, out io.Writer, opts *LinterOptions) (*LanguageServer, error) {
+	l, err := NewLinter(io.Discard, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &LanguageServer{
+		linter:  l,
+		in:      bufio.NewReader(in),
+		out:     out,
+		docs:    map[string]*lspDocument{},
+		indexes: map[string]*symbolIndex{},
+	}, nil
+}
+
+// Serve runs the server until "exit" notification is received or the input reaches EOF. It returns
+// an error when the server cannot read or write messages or when "exit" notification is received
+// before "shutdown" request. Linting in flight is canceled when this method returns.
+func (s *LanguageServer) Serve(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		s.wg.Wait()
+	}()
+
+	for {
+		msg, err := s.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			var e *lspError
+			if errors.As(err, &e) {
+				if err := s.respond(nil, nil, e); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("\"exit\" notification was received before \"shutdown\" request")
+			}
+			return nil
+		}
+		if msg.Method == "" {
+			continue // Response from the client is not used
+		}
+
+		res, err := s.handle(ctx, msg)
+		if msg.ID == nil {
+			if err != nil {
+				s.linter.log("Could not handle notification", msg.Method+":", err)
+			}
+			continue
+		}
+		var e *lspError
+		if err != nil && !errors.As(err, &e) {
+			e = &lspError{lspErrorInternal, err.Error()}
+		}
+		if err := s.respond(msg.ID, res, e); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *LanguageServer) handle(ctx context.Context, msg *lspMessage) (interface{}, error) {
+	if s.shutdown {
+		return nil, &lspError{lspErrorInvalidRequest, fmt.Sprintf("%q was received after shutdown", msg.Method)}
+	}
+
+	switch msg.Method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync": map[string]interface{}{
+					"openClose": true,
+					"change":    1, // Full
+					"save":      map[string]bool{"includeText": false},
+				},
+				"hoverProvider":      true,
+				"definitionProvider": true,
+				"referencesProvider": true,
+				"renameProvider":     true,
+			},
+			"serverInfo": map[string]string{
+				"name":    "actionlint",
+				"version": getCommandVersion(),
+			},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		for _, d := range s.docs {
+			if d.cancel != nil {
+				d.cancel()
+			}
+		}
+		return nil, nil
+	case "textDocument/didOpen":
+		var p lspDidOpenParams
+		if err := s.params(msg, &p); err != nil {
+			return nil, err
+		}
+		path := lspURIToPath(p.TextDocument.URI)
+		project, err := s.linter.projects.At(path)
+		if err != nil {
+			return nil, err
+		}
+		d := &lspDocument{
+			path:    path,
+			version: p.TextDocument.Version,
+			text:    []byte(p.TextDocument.Text),
+			project: project,
+		}
+		s.docs[p.TextDocument.URI] = d
+		s.lint(ctx, p.TextDocument.URI, d)
+		return nil, nil
+	case "textDocument/didChange":
+		var p lspDidChangeParams
+		if err := s.params(msg, &p); err != nil {
+			return nil, err
+		}
+		d, ok := s.docs[p.TextDocument.URI]
+		if !ok || len(p.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// Only full document sync is supported so the last change is the whole document
+		d.text = []byte(p.ContentChanges[len(p.ContentChanges)-1].Text)
+		d.version = p.TextDocument.Version
+		s.lint(ctx, p.TextDocument.URI, d)
+		return nil, nil
+	case "textDocument/didSave":
+		// Saving the file may change results of other documents which use it as local action or
+		// reusable workflow
+		for uri, d := range s.docs {
+			s.lint(ctx, uri, d)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var p lspTextDocumentParams
+		if err := s.params(msg, &p); err != nil {
+			return nil, err
+		}
+		if d, ok := s.docs[p.TextDocument.URI]; ok {
+			if d.cancel != nil {
+				d.cancel()
+			}
+			delete(s.docs, p.TextDocument.URI)
+		}
+		return nil, s.notify("textDocument/publishDiagnostics", &lspPublishDiagnosticsParams{
+			URI:         p.TextDocument.URI,
+			Diagnostics: []*lspDiagnostic{},
+		})
+	case "textDocument/hover":
+		var p lspTextDocumentPositionParams
+		if err := s.params(msg, &p); err != nil {
+			return nil, err
+		}
+		d, ok := s.docs[p.TextDocument.URI]
+		if !ok {
+			return nil, nil
+		}
+		return s.hover(ctx, d, p.Position)
+	case "textDocument/definition":
+		var p lspTextDocumentPositionParams
+		if err := s.params(msg, &p); err != nil {
+			return nil, err
+		}
+		d, ok := s.docs[p.TextDocument.URI]
+		if !ok {
+			return nil, nil
+		}
+		if l := s.definition(p.TextDocument.URI, d, p.Position); l != nil {
+			return l, nil
+		}
+		return s.symbolDefinition(p.TextDocument.URI, p.Position)
+	case "textDocument/references":
+		var p lspReferenceParams
+		if err := s.params(msg, &p); err != nil {
+			return nil, err
+		}
+		return s.references(p.TextDocument.URI, p.Position, p.Context.IncludeDeclaration)
+	case "textDocument/rename":
+		var p lspRenameParams
+		if err := s.params(msg, &p); err != nil {
+			return nil, err
+		}
+		return s.rename(p.TextDocument.URI, p.Position, p.NewName)
+	case "initialized", "$/cancelRequest", "$/setTrace":
+		return nil, nil
+	default:
+		if msg.ID == nil {
+			return nil, nil // Unknown notifications can be ignored
+		}
+		return nil, &lspError{lspErrorMethodNotFound, fmt.Sprintf("method %q is not supported", msg.Method)}
+	}
+}
+
+func (s *LanguageServer) params(msg *lspMessage, v interface{}) error {
+	if err := json.Unmarshal(msg.Params, v); err != nil {
+		return &lspError{lspErrorInvalidParams, fmt.Sprintf("invalid parameters of %q: %s", msg.Method, err)}
+	}
+	return nil
+}
+
+// lint lints the document in background and publishes the errors as diagnostics. Linting of the
+// same document in flight is canceled.
+func (s *LanguageServer) lint(ctx context.Context, uri string, d *lspDocument) {
+	if d.cancel != nil {
+		d.cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	d.cancel = cancel
+
+	path := d.path
+	if s.linter.cwd != "" {
+		if r, err := filepath.Rel(s.linter.cwd, path); err == nil {
+			path = r
+		}
+	}
+	text, version, project := d.text, d.version, d.project
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		errs, err := s.linter.lintContent(ctx, path, text, project)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			s.notify("window/logMessage", map[string]interface{}{
+				"type":    1, // Error
+				"message": fmt.Sprintf("could not lint %s: %s", path, err),
+			})
+			return
+		}
+
+		ds := make([]*lspDiagnostic, 0, len(errs))
+		for _, e := range errs {
+			ds = append(ds, lspDiagnosticOf(e, text))
+		}
+		s.outMu.Lock()
+		defer s.outMu.Unlock()
+		// Check the cancellation while locking the output so that outdated diagnostics are never
+		// published after the diagnostics of the newer version
+		if ctx.Err() != nil {
+			return
+		}
+		s.writeLocked(&lspMessage{
+			Method: "textDocument/publishDiagnostics",
+			Params: lspMarshal(&lspPublishDiagnosticsParams{uri, version, ds}),
+		})
+	}()
+}
+
+func (s *LanguageServer) hover(ctx context.Context, d *lspDocument, pos lspPosition) (interface{}, error) {
+	text := sourceLine(d.text, pos.Line+1)
+	col := lspColumn(text, pos.Character)
+
+	// External commands are not run for hovers since they are slow and don't affect types
+	l := *s.linter
+	l.shellcheck, l.pyflakes, l.psscriptanalyzer = "", "", ""
+	i, err := l.inspectSource(ctx, d.path, d.text, d.project, pos.Line+1, col)
+	if err != nil {
+		return nil, err
+	}
+	if i.Node == nil {
+		return nil, nil
+	}
+
+	start, end := lspRuneOffset(text, i.Column), lspRuneOffset(text, i.EndColumn+1)
+	src := i.Expr
+	if 0 <= start && start < end && end <= len(text) {
+		src = text[start:end]
+	}
+	ty := "any"
+	if i.Type != nil {
+		ty = i.Type.String()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "```\n%s: %s\n```\n", src, ty)
+	if f, ok := i.Node.(*FuncCallNode); ok {
+		if sigs, ok := BuiltinFuncSignatures[strings.ToLower(f.Callee)]; ok {
+			b.WriteString("\n```\n")
+			for _, sig := range sigs {
+				fmt.Fprintln(&b, sig.String())
+			}
+			b.WriteString("```\n")
+		}
+	}
+	if v, ok := i.Node.(*VariableNode); ok && len(i.Contexts) > 0 && i.WorkflowKey != "" {
+		fmt.Fprintf(&b, "\nContexts available at `%s`: %s\n", i.WorkflowKey, strings.Join(i.Contexts, ", "))
+		if !contains(i.Contexts, strings.ToLower(v.Name)) {
+			fmt.Fprintf(&b, "\n`%s` context is not available here\n", v.Name)
+		}
+	}
+	if i.DocURL != "" {
+		fmt.Fprintf(&b, "\n[Documentation](%s)\n", i.DocURL)
+	}
+
+	return &lspHover{
+		Contents: lspMarkupContent{"markdown", b.String()},
+		Range: &lspRange{
+			Start: lspPosition{pos.Line, lspCharacter(text, start)},
+			End:   lspPosition{pos.Line, lspCharacter(text, end)},
+		},
+	}, nil
+}
+
+// definition returns the location of the job at "needs:" or the file of the local action or the
+// local reusable workflow at "uses:" at the position. It returns nil when nothing is found.
+func (s *LanguageServer) definition(uri string, d *lspDocument, pos lspPosition) interface{} {
+	w, _ := Parse(d.text)
+	if w == nil {
+		return nil
+	}
+	text := sourceLine(d.text, pos.Line+1)
+	col := lspColumn(text, pos.Character)
+	at := func(s *String) bool {
+		if s == nil || s.Pos == nil || s.Pos.Line != pos.Line+1 {
+			return false
+		}
+		// +1 for quotes around the string
+		return s.Pos.Col <= col && col <= s.Pos.Col+utf8.RuneCountInString(s.Value)+1
+	}
+
+	for _, j := range w.Jobs {
+		for _, n := range j.Needs {
+			if !at(n) {
+				continue
+			}
+			dep, ok := w.Jobs[strings.ToLower(n.Value)]
+			if !ok || dep.ID == nil || dep.ID.Pos == nil {
+				return nil
+			}
+			return &lspLocation{uri, lspRangeAt(d.text, dep.ID.Pos, dep.ID.Value)}
+		}
+		if j.WorkflowCall != nil && at(j.WorkflowCall.Uses) {
+			return s.localFileLocation(d, j.WorkflowCall.Uses.Value, false)
+		}
+		for _, st := range j.Steps {
+			if e, ok := st.Exec.(*ExecAction); ok && at(e.Uses) {
+				return s.localFileLocation(d, e.Uses.Value, true)
+			}
+		}
+	}
+	return nil
+}
+
+// localFileLocation returns the location of the local action or reusable workflow specified at
+// "uses:". The path is relative to the root directory of the project.
+func (s *LanguageServer) localFileLocation(d *lspDocument, uses string, action bool) interface{} {
+	if !strings.HasPrefix(uses, "./") || d.project == nil {
+		return nil
+	}
+	p := filepath.Join(d.project.RootDir(), filepath.FromSlash(uses))
+	if action {
+		for _, n := range []string{"action.yaml", "action.yml"} {
+			f := filepath.Join(p, n)
+			if _, err := os.Stat(f); err == nil {
+				return &lspLocation{URI: lspPathToURI(f)}
+			}
+		}
+		return nil
+	}
+	if _, err := os.Stat(p); err != nil {
+		return nil
+	}
+	return &lspLocation{URI: lspPathToURI(p)}
+}
+
+// symbolAt returns the index of the project and the occurrence of the symbol at the position. The
+// index is updated before looking up the symbol. The document does not need to be opened in the
+// client.
+func (s *LanguageServer) symbolAt(uri string, pos lspPosition) (*symbolIndex, *symbolOccurrence, error) {
+	path := lspURIToPath(uri)
+	var project *Project
+	if d, ok := s.docs[uri]; ok {
+		path, project = d.path, d.project
+	} else {
+		p, err := s.linter.projects.At(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		project = p
+	}
+
+	root := ""
+	if project != nil {
+		root = project.RootDir()
+	}
+	idx, ok := s.indexes[root]
+	if !ok {
+		idx = newSymbolIndex(root)
+		s.indexes[root] = idx
+	}
+	docs := map[string][]byte{}
+	for _, d := range s.docs {
+		if d.project == project {
+			docs[d.path] = d.text
+		}
+	}
+	idx.refresh(docs)
+
+	f, ok := idx.files[path]
+	if !ok {
+		return idx, nil, nil
+	}
+	text := sourceLine(f.text, pos.Line+1)
+	col := lspColumn(text, pos.Character)
+	return idx, idx.at(path, pos.Line+1, col), nil
+}
+
+// symbolDefinition returns the location where the symbol at the position is defined such as the job
+// of the step ID at "steps.foo" or the input of the reusable workflow at "with:".
+func (s *LanguageServer) symbolDefinition(uri string, pos lspPosition) (interface{}, error) {
+	idx, o, err := s.symbolAt(uri, pos)
+	if err != nil || o == nil {
+		return nil, err
+	}
+	for _, d := range idx.occurrences(o.symbol) {
+		if d.def {
+			return &lspLocation{s.uriOf(d.path), idx.rangeOf(d)}, nil
+		}
+	}
+	return nil, nil
+}
+
+// references returns the locations of all occurrences of the symbol at the position across the
+// repository. It answers questions like "which workflows call this reusable workflow?".
+func (s *LanguageServer) references(uri string, pos lspPosition, decl bool) (interface{}, error) {
+	idx, o, err := s.symbolAt(uri, pos)
+	if err != nil || o == nil {
+		return nil, err
+	}
+	ls := []*lspLocation{}
+	for _, r := range idx.occurrences(o.symbol) {
+		if !r.def || decl {
+			ls = append(ls, &lspLocation{s.uriOf(r.path), idx.rangeOf(r)})
+		}
+	}
+	return ls, nil
+}
+
+// rename returns the edits to rename the symbol at the position and all its occurrences across the
+// repository.
+func (s *LanguageServer) rename(uri string, pos lspPosition, name string) (interface{}, error) {
+	idx, o, err := s.symbolAt(uri, pos)
+	if err != nil || o == nil {
+		return nil, err
+	}
+	occs, err := idx.rename(o.symbol, name)
+	if err != nil {
+		return nil, &lspError{lspErrorRequestFailed, err.Error()}
+	}
+
+	changes := map[string][]*lspTextEdit{}
+	for _, o := range occs {
+		u := s.uriOf(o.path)
+		changes[u] = append(changes[u], &lspTextEdit{idx.rangeOf(o), name})
+	}
+	return &lspWorkspaceEdit{changes}, nil
+}
+
+// rangeOf returns the range of the occurrence in the index.
+func (idx *symbolIndex) rangeOf(o *symbolOccurrence) lspRange {
+	var src []byte
+	if f, ok := idx.files[o.path]; ok {
+		src = f.text
+	}
+	return lspRangeAt(src, &Pos{Line: o.line, Col: o.col}, o.text)
+}
+
+// uriOf returns the URI of the file. The URI given by the client is used when the file is opened.
+func (s *LanguageServer) uriOf(path string) string {
+	for uri, d := range s.docs {
+		if d.path == path {
+			return uri
+		}
+	}
+	return lspPathToURI(path)
+}
+
+func (s *LanguageServer) read() (*lspMessage, error) {
+	size := -1
+	for {
+		l, err := s.in.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) && l == "" {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("could not read header of LSP message: %w", err)
+		}
+		l = strings.TrimRight(l, "\r\n")
+		if l == "" {
+			break
+		}
+		kv := strings.SplitN(l, ":", 2)
+		if len(kv) == 2 && strings.EqualFold(strings.TrimSpace(kv[0]), "Content-Length") {
+			n, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid Content-Length header of LSP message: %q", l)
+			}
+			size = n
+		}
+	}
+	if size < 0 {
+		return nil, errors.New("Content-Length header is missing in LSP message")
+	}
+	if size > lspMaxContentLength {
+		// Skip the body to read the next message correctly
+		if _, err := io.CopyN(io.Discard, s.in, int64(size)); err != nil {
+			return nil, fmt.Errorf("could not read body of LSP message: %w", err)
+		}
+		return nil, &lspError{lspErrorInvalidRequest, fmt.Sprintf("LSP message is too large. its Content-Length %d exceeds the limit %d", size, lspMaxContentLength)}
+	}
+
+	b := make([]byte, size)
+	if _, err := io.ReadFull(s.in, b); err != nil {
+		return nil, fmt.Errorf("could not read body of LSP message: %w", err)
+	}
+	var msg lspMessage
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return nil, &lspError{lspErrorParse, fmt.Sprintf("could not parse LSP message: %s", err)}
+	}
+	return &msg, nil
+}
+
+func (s *LanguageServer) respond(id json.RawMessage, res interface{}, e *lspError) error {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	msg := &lspMessage{ID: id}
+	if e != nil {
+		msg.Error = e
+	} else {
+		msg.Result = lspMarshal(res)
+	}
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+	return s.writeLocked(msg)
+}
+
+func (s *LanguageServer) notify(method string, params interface{}) error {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+	return s.writeLocked(&lspMessage{Method: method, Params: lspMarshal(params)})
+}
+
+func (s *LanguageServer) writeLocked(msg *lspMessage) error {
+	msg.JSONRPC = "2.0"
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("could not encode LSP message: %w", err)
+	}
+	if _, err := fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(b), b); err != nil {
+		return fmt.Errorf("could not write LSP message: %w", err)
+	}
+	return nil
+}
+
+// lspMarshal encodes the value into JSON. nil is encoded into "null" so that the "result" field of
+// a response is not omitted.
+func lspMarshal(v interface{}) json.RawMessage {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err) // Values are always encodable
+	}
+	return b
+}
+
+func lspDiagnosticOf(e *Error, src []byte) *lspDiagnostic {
+	r := lspRange{}
+	if e.Line > 0 {
+		text := sourceLine(src, e.Line)
+		start, end := e.Column, e.GetTemplateFields(src).EndColumn+1
+		if end <= start {
+			end = start + 1
+		}
+		r.Start = lspPosition{e.Line - 1, lspCharacter(text, lspRuneOffset(text, start))}
+		r.End = lspPosition{e.Line - 1, lspCharacter(text, lspRuneOffset(text, end))}
+	}
+	sev := 1 // Error
+	if e.IsWarning() {
+		sev = 2 // Warning
+	} else if e.IsNote() {
+		sev = 3 // Information
+	}
+	return &lspDiagnostic{
+		Range:           r,
+		Severity:        sev,
+		Code:            e.Kind,
+		CodeDescription: &lspCodeDescription{sarifHelpURI(e.Kind)},
+		Source:          "actionlint",
+		Message:         e.Message,
+	}
+}
+
+func lspRangeAt(src []byte, pos *Pos, value string) lspRange {
+	text := sourceLine(src, pos.Line)
+	start := lspRuneOffset(text, pos.Col)
+	end := lspRuneOffset(text, pos.Col+utf8.RuneCountInString(value))
+	return lspRange{
+		Start: lspPosition{pos.Line - 1, lspCharacter(text, start)},
+		End:   lspPosition{pos.Line - 1, lspCharacter(text, end)},
+	}
+}
+
+// lspRuneOffset returns the byte offset of the 1-based column counting characters in the line. The
+// offset may exceed the length of the line when the column is after the end of the line.
+func lspRuneOffset(line string, col int) int {
+	o := 0
+	for c := 1; c < col; c++ {
+		if o >= len(line) {
+			return o + col - c
+		}
+		_, s := utf8.DecodeRuneInString(line[o:])
+		o += s
+	}
+	return o
+}
+
+// lspCharacter converts the byte offset in the line into the offset in UTF-16 code units, which is
+// used for positions in LSP.
+func lspCharacter(line string, offset int) int {
+	n := 0
+	for i, r := range line {
+		if i >= offset {
+			return n
+		}
+		if r >= 0x10000 {
+			n += 2 // Surrogate pair
+		} else {
+			n++
+		}
+	}
+	if offset > len(line) {
+		n += offset - len(line)
+	}
+	return n
+}
+
+// lspColumn converts the offset in UTF-16 code units into the 1-based column number counted in
+// characters, which is used for positions in actionlint.
+func lspColumn(line string, character int) int {
+	return utf8.RuneCountInString(line[:lspByteOffset(line, character)]) + 1
+}
+
+// lspByteOffset converts the offset in UTF-16 code units into the byte offset in the line.
+func lspByteOffset(line string, character int) int {
+	n := 0
+	for i, r := range line {
+		if n >= character {
+			return i
+		}
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return len(line)
+}
+
+func lspURIToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	p := u.Path
+	if runtime.GOOS == "windows" && len(p) >= 3 && p[0] == '/' && p[2] == ':' {
+		p = p[1:] // "/C:/path/to/file" -> "C:/path/to/file"
+	}
+	return filepath.FromSlash(p)
+}
+
+func lspPathToURI(path string) string {
+	p := filepath.ToSlash(path)
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	u := url.URL{Scheme: "file", Path: p}
+	return u.String()
+}
@@ -0,0 +1,494 @@
+package actionlint
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testLSPClient struct {
+	t   *testing.T
+	in  io.Writer
+	out *bufio.Reader
+}
+
+func (c *testLSPClient) send(id int, method string, params interface{}) {
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if id > 0 {
+		msg["id"] = id
+	}
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := fmt.Fprintf(c.in, "Content-Length: %d\r\n\r\n%s", len(b), b); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *testLSPClient) recv() *lspMessage {
+	size := 0
+	for {
+		l, err := c.out.ReadString('\n')
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		l = strings.TrimSpace(l)
+		if l == "" {
+			break
+		}
+		if _, err := fmt.Sscanf(l, "Content-Length: %d", &size); err != nil {
+			c.t.Fatalf("unexpected header %q: %v", l, err)
+		}
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(c.out, b); err != nil {
+		c.t.Fatal(err)
+	}
+	var msg lspMessage
+	if err := json.Unmarshal(b, &msg); err != nil {
+		c.t.Fatal(err)
+	}
+	return &msg
+}
+
+func (c *testLSPClient) recvDiagnostics() *lspPublishDiagnosticsParams {
+	msg := c.recv()
+	if msg.Method != "textDocument/publishDiagnostics" {
+		c.t.Fatalf("wanted diagnostics but got %+v", msg)
+	}
+	var p lspPublishDiagnosticsParams
+	if err := json.Unmarshal(msg.Params, &p); err != nil {
+		c.t.Fatal(err)
+	}
+	return &p
+}
+
+func (c *testLSPClient) request(id int, method string, params interface{}, result interface{}) {
+	c.send(id, method, params)
+	msg := c.recv()
+	if string(msg.ID) != fmt.Sprint(id) {
+		c.t.Fatalf("wanted response to request %d but got %+v", id, msg)
+	}
+	if msg.Error != nil {
+		c.t.Fatalf("request %q failed: %v", method, msg.Error)
+	}
+	if err := json.Unmarshal(msg.Result, result); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func TestLanguageServer(t *testing.T) {
+	root := t.TempDir()
+	for _, d := range []string{".git", filepath.Join(".github", "workflows"), filepath.Join(".github", "actions", "my")} {
+		if err := os.MkdirAll(filepath.Join(root, d), 0755); err != nil {
+			panic(err)
+		}
+	}
+	action := filepath.Join(root, ".github", "actions", "my", "action.yml")
+	if err := os.WriteFile(action, []byte("name: My action\ndescription: test\nruns:\n  using: composite\n  steps:\n    - run: echo hello\n      shell: bash\n"), 0644); err != nil {
+		panic(err)
+	}
+	workflow := filepath.Join(root, ".github", "workflows", "test.yaml")
+	uri := lspPathToURI(workflow)
+	src := `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: ./.github/actions/my
+      - run: echo ${{ github.ref }}
+  test:
+    needs: build
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ unknown.foo }}
+      - run: echo "日本🚀" ${{ github.event_name }}
+`
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	s, err := NewLanguageServer(inR, outW, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- s.Serve(context.Background())
+	}()
+	c := &testLSPClient{t, inW, bufio.NewReader(outR)}
+
+	var init struct {
+		Capabilities struct {
+			HoverProvider      bool `json:"hoverProvider"`
+			DefinitionProvider bool `json:"definitionProvider"`
+		} `json:"capabilities"`
+	}
+	c.request(1, "initialize", map[string]interface{}{"capabilities": map[string]interface{}{}}, &init)
+	if !init.Capabilities.HoverProvider || !init.Capabilities.DefinitionProvider {
+		t.Fatalf("unexpected capabilities: %+v", init)
+	}
+	c.send(0, "initialized", map[string]interface{}{})
+
+	c.send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "yaml", "version": 1, "text": src},
+	})
+	ds := c.recvDiagnostics()
+	if ds.URI != uri || ds.Version != 1 || len(ds.Diagnostics) != 1 {
+		t.Fatalf("unexpected diagnostics: %+v", ds)
+	}
+	d := ds.Diagnostics[0]
+	if d.Code != "expression" || !strings.Contains(d.Message, `undefined variable "unknown"`) {
+		t.Fatalf("unexpected diagnostic: %+v", d)
+	}
+	if want := (lspRange{lspPosition{11, 22}, lspPosition{11, 33}}); d.Range != want {
+		t.Fatalf("wanted range %+v but got %+v", want, d.Range)
+	}
+
+	// Unsaved changes are linted
+	src = strings.Replace(src, "unknown.foo", "github.sha", 1)
+	c.send(0, "textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]interface{}{{"text": src}},
+	})
+	if ds := c.recvDiagnostics(); ds.Version != 2 || len(ds.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics after change: %+v", ds)
+	}
+
+	var hover lspHover
+	c.request(2, "textDocument/hover", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     lspPosition{6, 29}, // "ref" of "github.ref"
+	}, &hover)
+	if !strings.Contains(hover.Contents.Value, "github.ref: string") || !strings.Contains(hover.Contents.Value, "#github-context") {
+		t.Fatalf("unexpected hover: %q", hover.Contents.Value)
+	}
+	if want := (lspRange{lspPosition{6, 22}, lspPosition{6, 32}}); hover.Range == nil || *hover.Range != want {
+		t.Fatalf("wanted hover range %+v but got %+v", want, hover.Range)
+	}
+
+	// Positions are converted from UTF-16 code units in the line containing non-ASCII characters
+	hover = lspHover{}
+	c.request(3, "textDocument/hover", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     lspPosition{12, 40}, // "event_name" of "github.event_name"
+	}, &hover)
+	if !strings.Contains(hover.Contents.Value, "github.event_name: string") {
+		t.Fatalf("unexpected hover: %q", hover.Contents.Value)
+	}
+	if want := (lspRange{lspPosition{12, 29}, lspPosition{12, 46}}); hover.Range == nil || *hover.Range != want {
+		t.Fatalf("wanted hover range %+v but got %+v", want, hover.Range)
+	}
+
+	var loc *lspLocation
+	c.request(4, "textDocument/definition", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     lspPosition{8, 13}, // "build" at "needs:"
+	}, &loc)
+	if want := (&lspLocation{uri, lspRange{lspPosition{2, 2}, lspPosition{2, 7}}}); loc == nil || *loc != *want {
+		t.Fatalf("wanted location %+v but got %+v", want, loc)
+	}
+
+	loc = nil
+	c.request(5, "textDocument/definition", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     lspPosition{5, 20}, // Local action at "uses:"
+	}, &loc)
+	if loc == nil || loc.URI != lspPathToURI(action) {
+		t.Fatalf("wanted location of %s but got %+v", action, loc)
+	}
+
+	loc = nil
+	c.request(6, "textDocument/definition", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     lspPosition{3, 14}, // "ubuntu-latest"
+	}, &loc)
+	if loc != nil {
+		t.Fatalf("wanted no location but got %+v", loc)
+	}
+
+	c.send(7, "unknown/method", nil)
+	if msg := c.recv(); msg.Error == nil || msg.Error.Code != lspErrorMethodNotFound {
+		t.Fatalf("wanted method-not-found error but got %+v", msg)
+	}
+
+	var res interface{}
+	c.request(8, "shutdown", nil, &res)
+	if res != nil {
+		t.Fatalf("wanted null result but got %v", res)
+	}
+	c.send(0, "exit", nil)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLanguageServerExitWithoutShutdown(t *testing.T) {
+	in := "Content-Length: 33\r\n\r\n{\"jsonrpc\":\"2.0\",\"method\":\"exit\"}"
+	s, err := NewLanguageServer(strings.NewReader(in), io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Serve(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "before \"shutdown\" request") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLanguageServerTooLargeMessage(t *testing.T) {
+	size := lspMaxContentLength + 1
+	in := io.MultiReader(
+		strings.NewReader(fmt.Sprintf("Content-Length: %d\r\n\r\n", size)),
+		strings.NewReader(strings.Repeat(" ", size)),
+		strings.NewReader("Content-Length: 33\r\n\r\n{\"jsonrpc\":\"2.0\",\"method\":\"exit\"}"),
+	)
+	var out bytes.Buffer
+	s, err := NewLanguageServer(in, &out, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The large body is skipped and the next "exit" notification is handled
+	err = s.Serve(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "before \"shutdown\" request") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c := &testLSPClient{t, io.Discard, bufio.NewReader(&out)}
+	msg := c.recv()
+	if msg.Error == nil || msg.Error.Code != lspErrorInvalidRequest || !strings.Contains(msg.Error.Message, "too large") {
+		t.Fatalf("unexpected response: %+v", msg)
+	}
+}
+
+func TestLanguageServerPositionConversion(t *testing.T) {
+	line := "a: あ😀b"
+	testCases := []struct {
+		offset    int
+		character int
+	}{
+		{0, 0},
+		{3, 3},
+		{6, 4},  // After "あ" (3 bytes, 1 code unit)
+		{10, 6}, // After "😀" (4 bytes, 2 code units)
+		{11, 7},
+	}
+	for _, tc := range testCases {
+		if c := lspCharacter(line, tc.offset); c != tc.character {
+			t.Errorf("byte offset %d should be character %d but got %d", tc.offset, tc.character, c)
+		}
+		if o := lspByteOffset(line, tc.character); o != tc.offset {
+			t.Errorf("character %d should be byte offset %d but got %d", tc.character, tc.offset, o)
+		}
+	}
+	if o := lspRuneOffset(line, 5); o != 6 {
+		t.Errorf("column 5 should be byte offset 6 but got %d", o)
+	}
+}
+
+func TestLanguageServerReferencesAndRename(t *testing.T) {
+	root := t.TempDir()
+	for _, d := range []string{".git", filepath.Join(".github", "workflows"), filepath.Join(".github", "actions", "my")} {
+		if err := os.MkdirAll(filepath.Join(root, d), 0755); err != nil {
+			panic(err)
+		}
+	}
+	files := map[string]string{
+		filepath.Join(".github", "actions", "my", "action.yml"): `name: My action
+description: test
+inputs:
+  message:
+    description: message
+runs:
+  using: composite
+  steps:
+    - run: echo "${{ inputs.message }}"
+      shell: bash
+`,
+		filepath.Join(".github", "workflows", "reusable.yaml"): `on:
+  workflow_call:
+    inputs:
+      name:
+        type: string
+    outputs:
+      result:
+        value: ${{ jobs.build.outputs.result }}
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    outputs:
+      result: ${{ steps.greet.outputs.result }}
+    steps:
+      - id: greet
+        run: echo "result=${{ inputs.name }}" >> "$GITHUB_OUTPUT"
+`,
+	}
+	for p, s := range files {
+		if err := os.WriteFile(filepath.Join(root, p), []byte(s), 0644); err != nil {
+			panic(err)
+		}
+	}
+	caller := filepath.Join(root, ".github", "workflows", "caller.yaml")
+	uri := lspPathToURI(caller)
+	reusable := lspPathToURI(filepath.Join(root, ".github", "workflows", "reusable.yaml"))
+	action := lspPathToURI(filepath.Join(root, ".github", "actions", "my", "action.yml"))
+	// The document is not saved to the file
+	src := `on: push
+jobs:
+  call:
+    uses: ./.github/workflows/reusable.yaml
+    with:
+      name: hello
+  show:
+    needs: call
+    if: needs.call.outputs.result != ''
+    runs-on: ubuntu-latest
+    steps:
+      - uses: ./.github/actions/my
+        id: my
+        with:
+          message: ${{ needs.call.outputs.result }}
+      - run: echo '${{ steps.my.outcome }}'
+`
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	s, err := NewLanguageServer(inR, outW, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- s.Serve(context.Background())
+	}()
+	c := &testLSPClient{t, inW, bufio.NewReader(outR)}
+
+	var init struct {
+		Capabilities struct {
+			ReferencesProvider bool `json:"referencesProvider"`
+			RenameProvider     bool `json:"renameProvider"`
+		} `json:"capabilities"`
+	}
+	c.request(1, "initialize", map[string]interface{}{"capabilities": map[string]interface{}{}}, &init)
+	if !init.Capabilities.ReferencesProvider || !init.Capabilities.RenameProvider {
+		t.Fatalf("unexpected capabilities: %+v", init)
+	}
+	c.send(0, "textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "yaml", "version": 1, "text": src},
+	})
+	if ds := c.recvDiagnostics(); len(ds.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics: %+v", ds)
+	}
+
+	id := 2
+	loc := func(uri string, line, start, end int) string {
+		return fmt.Sprintf("%s:%d:%d-%d", uri, line, start, end)
+	}
+	references := func(uri string, line, char int, decl bool) []string {
+		var ls []*lspLocation
+		c.request(id, "textDocument/references", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": uri},
+			"position":     lspPosition{line, char},
+			"context":      map[string]bool{"includeDeclaration": decl},
+		}, &ls)
+		id++
+		ret := []string{}
+		for _, l := range ls {
+			ret = append(ret, loc(l.URI, l.Range.Start.Line, l.Range.Start.Character, l.Range.End.Character))
+		}
+		return ret
+	}
+	rename := func(uri string, line, char int, name string) map[string][]string {
+		var e lspWorkspaceEdit
+		c.request(id, "textDocument/rename", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": uri},
+			"position":     lspPosition{line, char},
+			"newName":      name,
+		}, &e)
+		id++
+		ret := map[string][]string{}
+		for u, es := range e.Changes {
+			for _, e := range es {
+				if e.NewText != name {
+					t.Fatalf("unexpected new text %q", e.NewText)
+				}
+				ret[u] = append(ret[u], loc(u, e.Range.Start.Line, e.Range.Start.Character, e.Range.End.Character))
+			}
+		}
+		return ret
+	}
+	check := func(what string, have, want interface{}) {
+		if fmt.Sprint(have) != fmt.Sprint(want) {
+			t.Errorf("%s: wanted %v but got %v", what, want, have)
+		}
+	}
+
+	check("input of reusable workflow", references(uri, 5, 7, true), []string{
+		loc(uri, 5, 6, 10),
+		loc(reusable, 3, 6, 10),
+		loc(reusable, 15, 37, 41),
+	})
+	// Callers of reusable workflow found from the file which is not opened
+	check("callers of reusable workflow", references(reusable, 1, 4, false), []string{
+		loc(uri, 3, 10, 43),
+	})
+	check("output of reusable workflow", references(uri, 14, 44, true), []string{
+		loc(uri, 8, 27, 33),
+		loc(uri, 14, 42, 48),
+		loc(reusable, 6, 6, 12),
+	})
+	check("unknown symbol", references(uri, 9, 16, true), []string{})
+
+	check("rename job", rename(uri, 7, 11, "invoke"), map[string][]string{
+		uri: {loc(uri, 2, 2, 6), loc(uri, 7, 11, 15), loc(uri, 8, 14, 18), loc(uri, 14, 29, 33)},
+	})
+	check("rename step", rename(uri, 15, 30, "my-step"), map[string][]string{
+		uri: {loc(uri, 12, 12, 14), loc(uri, 15, 29, 31)},
+	})
+	check("rename input of action", rename(uri, 14, 10, "msg"), map[string][]string{
+		action: {loc(action, 3, 2, 9), loc(action, 8, 28, 35)},
+		uri:    {loc(uri, 14, 10, 17)},
+	})
+
+	var def *lspLocation
+	c.request(id, "textDocument/definition", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri},
+		"position":     lspPosition{15, 30}, // "my" of "steps.my"
+	}, &def)
+	id++
+	if def == nil || loc(def.URI, def.Range.Start.Line, def.Range.Start.Character, def.Range.End.Character) != loc(uri, 12, 12, 14) {
+		t.Fatalf("unexpected definition of step: %+v", def)
+	}
+
+	for _, tc := range []struct {
+		line, char int
+		name, want string
+	}{
+		{3, 12, "foo", "reusable workflow cannot be renamed"},
+		{7, 11, "foo bar", `"foo bar" is invalid as name of job`},
+	} {
+		c.send(id, "textDocument/rename", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": uri},
+			"position":     lspPosition{tc.line, tc.char},
+			"newName":      tc.name,
+		})
+		id++
+		if msg := c.recv(); msg.Error == nil || msg.Error.Code != lspErrorRequestFailed || !strings.Contains(msg.Error.Message, tc.want) {
+			t.Errorf("wanted error %q but got %+v", tc.want, msg)
+		}
+	}
+
+	var res interface{}
+	c.request(id, "shutdown", nil, &res)
+	c.send(0, "exit", nil)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
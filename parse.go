@@ -1,6 +1,7 @@
 package actionlint
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"regexp"
@@ -46,18 +47,21 @@ type workflowKeyVal struct {
 	id  string
 	key *String
 	val *yaml.Node
+	// siblings is a map from IDs to positions of all keys in the mapping which contains this key.
+	siblings map[string]*Pos
 }
 
 type parser struct {
 	errors []*Error
+	src    []byte // Source of the workflow to build edits to fix errors
 }
 
 func (p *parser) error(n *yaml.Node, m string) {
-	p.errors = append(p.errors, &Error{m, "", n.Line, n.Column, "syntax-check"})
+	p.errors = append(p.errors, &Error{m, "", n.Line, n.Column, "syntax-check", nil, nil, ""})
 }
 
 func (p *parser) errorAt(pos *Pos, m string) {
-	p.errors = append(p.errors, &Error{m, "", pos.Line, pos.Col, "syntax-check"})
+	p.errors = append(p.errors, &Error{m, "", pos.Line, pos.Col, "syntax-check", nil, nil, ""})
 }
 
 func (p *parser) errorfAt(pos *Pos, format string, args ...interface{}) {
@@ -70,7 +74,8 @@ func (p *parser) errorf(n *yaml.Node, format string, args ...interface{}) {
 	p.error(n, m)
 }
 
-func (p *parser) unexpectedKey(s *String, sec string, expected []string) {
+func (p *parser) unexpectedKey(kv workflowKeyVal, sec string, expected []string) {
+	s := kv.key
 	l := len(expected)
 	var m string
 	if l == 1 {
@@ -81,6 +86,15 @@ func (p *parser) unexpectedKey(s *String, sec string, expected []string) {
 		m = fmt.Sprintf("unexpected key %q for %q section", s.Value, sec)
 	}
 	p.errorAt(s.Pos, m)
+
+	// Fix the key when it is obviously a misspelling of one of the expected keys
+	if k, ok := suggestName(s.Value, expected); ok {
+		if _, ok := kv.siblings[k]; !ok {
+			if e := replaceAt(p.src, s.Pos, s.Value, k); e != nil {
+				p.errors[len(p.errors)-1].Fixes = []*TextEdit{e}
+			}
+		}
+	}
 }
 
 func (p *parser) checkNotEmpty(sec string, len int, n *yaml.Node) bool {
@@ -169,13 +183,16 @@ func (p *parser) parseStringOrStringSequence(sec string, n *yaml.Node, allowEmpt
 	}
 }
 
+// parseBool parses the boolean value. Scalars are resolved in the same way as GitHub Actions. For
+// example, "True" is true and "yes" is a string.
 func (p *parser) parseBool(n *yaml.Node) *Bool {
-	if n.Kind != yaml.ScalarNode || (n.Tag != "!!bool" && n.Tag != "!!str") {
-		p.errorf(n, "expected bool value but found %s node with %q tag", nodeKindName(n.Kind), n.Tag)
+	r := resolveYAMLScalar(n)
+	if n.Kind != yaml.ScalarNode || (r.tag != "!!bool" && r.tag != "!!str") {
+		p.errorf(n, "expected bool value but found %s node with %q tag", nodeKindName(n.Kind), r.tag)
 		return nil
 	}
 
-	if n.Tag == "!!str" {
+	if r.tag == "!!str" {
 		e := p.parseExpression(n, "boolean literal \"true\" or \"false\"")
 		return &Bool{
 			Expression: e,
@@ -184,18 +201,21 @@ func (p *parser) parseBool(n *yaml.Node) *Bool {
 	}
 
 	return &Bool{
-		Value: n.Value == "true",
+		Value: r.value == "true",
 		Pos:   posAt(n),
 	}
 }
 
+// parseInt parses the integer value. Scalars are resolved in the same way as GitHub Actions. For
+// example, "0o17" is 15 and "0b11" is a string.
 func (p *parser) parseInt(n *yaml.Node) *Int {
-	if n.Kind != yaml.ScalarNode || (n.Tag != "!!int" && n.Tag != "!!str") {
-		p.errorf(n, "expected scalar node for integer value but found %s node with %q tag", nodeKindName(n.Kind), n.Tag)
+	r := resolveYAMLScalar(n)
+	if n.Kind != yaml.ScalarNode || (r.tag != "!!int" && r.tag != "!!str") {
+		p.errorf(n, "expected scalar node for integer value but found %s node with %q tag", nodeKindName(n.Kind), r.tag)
 		return nil
 	}
 
-	if n.Tag == "!!str" {
+	if r.tag == "!!str" {
 		e := p.parseExpression(n, "integer literal")
 		if e == nil {
 			return nil
@@ -206,25 +226,22 @@ func (p *parser) parseInt(n *yaml.Node) *Int {
 		}
 	}
 
-	i, err := strconv.Atoi(n.Value)
-	if err != nil {
-		p.errorf(n, "invalid integer value: %q: %s", n.Value, err.Error())
-		return nil
-	}
-
 	return &Int{
-		Value: i,
+		Value: int(r.num),
 		Pos:   posAt(n),
 	}
 }
 
+// parseFloat parses the float value. Scalars are resolved in the same way as GitHub Actions. For
+// example, "0x10" is 16.
 func (p *parser) parseFloat(n *yaml.Node) *Float {
-	if n.Kind != yaml.ScalarNode || (n.Tag != "!!float" && n.Tag != "!!int" && n.Tag != "!!str") {
-		p.errorf(n, "expected scalar node for float value but found %s node with %q tag", nodeKindName(n.Kind), n.Tag)
+	r := resolveYAMLScalar(n)
+	if n.Kind != yaml.ScalarNode || (r.tag != "!!float" && r.tag != "!!int" && r.tag != "!!str") {
+		p.errorf(n, "expected scalar node for float value but found %s node with %q tag", nodeKindName(n.Kind), r.tag)
 		return nil
 	}
 
-	if n.Tag == "!!str" {
+	if r.tag == "!!str" {
 		e := p.parseExpression(n, "float number literal")
 		if e == nil {
 			return nil
@@ -235,14 +252,13 @@ func (p *parser) parseFloat(n *yaml.Node) *Float {
 		}
 	}
 
-	f, err := strconv.ParseFloat(n.Value, 64)
-	if err != nil || math.IsNaN(f) {
-		p.errorf(n, "invalid float value: %q: %s", n.Value, err.Error())
+	if math.IsNaN(r.num) {
+		p.errorf(n, "invalid float value: %q: NaN is not allowed", n.Value)
 		return nil
 	}
 
 	return &Float{
-		Value: f,
+		Value: r.num,
 		Pos:   posAt(n),
 	}
 }
@@ -287,7 +303,7 @@ func (p *parser) parseMapping(what string, n *yaml.Node, allowEmpty, caseSensiti
 			p.errorfAt(k.Pos, "key %q is duplicated in %s. previously defined at %s%s", k.Value, what, pos.String(), note)
 			continue
 		}
-		m = append(m, workflowKeyVal{id, k, n.Content[i+1]})
+		m = append(m, workflowKeyVal{id, k, n.Content[i+1], keys})
 		keys[id] = k.Pos
 	}
 
@@ -329,7 +345,7 @@ func (p *parser) parseWorkflowDispatchEvent(pos *Pos, n *yaml.Node) *WorkflowDis
 
 	for _, kv := range p.parseSectionMapping("workflow_dispatch", n, true, true) {
 		if kv.id != "inputs" {
-			p.unexpectedKey(kv.key, "workflow_dispatch", []string{"inputs"})
+			p.unexpectedKey(kv, "workflow_dispatch", []string{"inputs"})
 			continue
 		}
 
@@ -373,7 +389,7 @@ func (p *parser) parseWorkflowDispatchEvent(pos *Pos, n *yaml.Node) *WorkflowDis
 				case "options":
 					opts = p.parseStringSequence("options", attr.val, false, false)
 				default:
-					p.unexpectedKey(attr.key, "inputs", []string{"description", "required", "default"})
+					p.unexpectedKey(attr, "inputs", []string{"description", "required", "default"})
 				}
 			}
 
@@ -400,7 +416,7 @@ func (p *parser) parseRepositoryDispatchEvent(pos *Pos, n *yaml.Node) *Repositor
 		if kv.id == "types" {
 			ret.Types = p.parseStringOrStringSequence("types", kv.val, false, false)
 		} else {
-			p.unexpectedKey(kv.key, "repository_dispatch", []string{"types"})
+			p.unexpectedKey(kv, "repository_dispatch", []string{"types"})
 		}
 	}
 
@@ -445,7 +461,7 @@ func (p *parser) parseWebhookEvent(name *String, n *yaml.Node) *WebhookEvent {
 		case "workflows":
 			ret.Workflows = p.parseStringOrStringSequence(kv.key.Value, kv.val, false, false)
 		default:
-			p.unexpectedKey(kv.key, name.Value, []string{
+			p.unexpectedKey(kv, name.Value, []string{
 				"types",
 				"branches",
 				"branches-ignore",
@@ -498,7 +514,7 @@ func (p *parser) parseWorkflowCallEvent(pos *Pos, n *yaml.Node) *WorkflowCallEve
 						}
 						sawType = true
 					default:
-						p.unexpectedKey(attr.key, "inputs at workflow_call event", []string{"description", "required", "default", "type"})
+						p.unexpectedKey(attr, "inputs at workflow_call event", []string{"description", "required", "default", "type"})
 					}
 				}
 
@@ -522,7 +538,7 @@ func (p *parser) parseWorkflowCallEvent(pos *Pos, n *yaml.Node) *WorkflowCallEve
 					case "required":
 						secret.Required = p.parseBool(attr.val)
 					default:
-						p.unexpectedKey(attr.key, "secrets", []string{"description", "required"})
+						p.unexpectedKey(attr, "secrets", []string{"description", "required"})
 					}
 				}
 
@@ -542,7 +558,7 @@ func (p *parser) parseWorkflowCallEvent(pos *Pos, n *yaml.Node) *WorkflowCallEve
 					case "value":
 						output.Value = p.parseString(attr.val, false)
 					default:
-						p.unexpectedKey(attr.key, "outputs at workflow_call event", []string{"description", "value"})
+						p.unexpectedKey(attr, "outputs at workflow_call event", []string{"description", "value"})
 					}
 				}
 
@@ -553,7 +569,7 @@ func (p *parser) parseWorkflowCallEvent(pos *Pos, n *yaml.Node) *WorkflowCallEve
 				ret.Outputs[kv.id] = output
 			}
 		default:
-			p.unexpectedKey(kv.key, "workflow_call", []string{"inputs", "secrets", "outputs"})
+			p.unexpectedKey(kv, "workflow_call", []string{"inputs", "secrets", "outputs"})
 		}
 	}
 
@@ -674,6 +690,7 @@ func (p *parser) parseEnv(n *yaml.Node) *Env {
 	vars := make(map[string]*EnvVar, len(m))
 
 	for _, kv := range m {
+		p.checkScalarCompat(kv.val, fmt.Sprintf("of environment variable %q", kv.key.Value), false)
 		vars[kv.id] = &EnvVar{
 			Name:  kv.key,
 			Value: p.parseString(kv.val, true),
@@ -689,7 +706,7 @@ func (p *parser) parseDefaults(pos *Pos, n *yaml.Node) *Defaults {
 
 	for _, kv := range p.parseSectionMapping("defaults", n, false, true) {
 		if kv.id != "run" {
-			p.unexpectedKey(kv.key, "defaults", []string{"run"})
+			p.unexpectedKey(kv, "defaults", []string{"run"})
 			continue
 		}
 		ret.Run = &DefaultsRun{Pos: kv.key.Pos}
@@ -701,7 +718,7 @@ func (p *parser) parseDefaults(pos *Pos, n *yaml.Node) *Defaults {
 			case "working-directory":
 				ret.Run.WorkingDirectory = p.parseString(attr.val, false)
 			default:
-				p.unexpectedKey(attr.key, "run", []string{"shell", "working-directory"})
+				p.unexpectedKey(attr, "run", []string{"shell", "working-directory"})
 			}
 		}
 	}
@@ -729,7 +746,7 @@ func (p *parser) parseConcurrency(pos *Pos, n *yaml.Node) *Concurrency {
 			case "cancel-in-progress":
 				ret.CancelInProgress = p.parseBool(kv.val)
 			default:
-				p.unexpectedKey(kv.key, "concurrency", []string{"group", "cancel-in-progress"})
+				p.unexpectedKey(kv, "concurrency", []string{"group", "cancel-in-progress"})
 			}
 		}
 		if !groupFound {
@@ -756,7 +773,7 @@ func (p *parser) parseEnvironment(pos *Pos, n *yaml.Node) *Environment {
 			case "url":
 				ret.URL = p.parseString(kv.val, false)
 			default:
-				p.unexpectedKey(kv.key, "environment", []string{"name", "url"})
+				p.unexpectedKey(kv, "environment", []string{"name", "url"})
 			}
 		}
 		if !nameFound {
@@ -784,6 +801,7 @@ func (p *parser) parseOutputs(n *yaml.Node) map[string]*Output {
 func (p *parser) parseRawYAMLValue(n *yaml.Node) RawYAMLValue {
 	switch n.Kind {
 	case yaml.ScalarNode:
+		p.checkScalarCompat(n, "in matrix", true)
 		return &RawYAMLString{n.Value, posAt(n)}
 	case yaml.SequenceNode:
 		vs := make([]RawYAMLValue, 0, len(n.Content))
@@ -910,7 +928,7 @@ func (p *parser) parseStrategy(pos *Pos, n *yaml.Node) *Strategy {
 		case "max-parallel":
 			ret.MaxParallel = p.parseMaxParallel(kv.val)
 		default:
-			p.unexpectedKey(kv.key, "strategy", []string{"matrix", "fail-fast", "max-parallel"})
+			p.unexpectedKey(kv, "strategy", []string{"matrix", "fail-fast", "max-parallel"})
 		}
 	}
 
@@ -938,7 +956,7 @@ func (p *parser) parseContainer(sec string, pos *Pos, n *yaml.Node) *Container {
 					case "password":
 						cred.Password = p.parseString(c.val, false)
 					default:
-						p.unexpectedKey(c.key, "credentials", []string{"username", "password"})
+						p.unexpectedKey(c, "credentials", []string{"username", "password"})
 					}
 				}
 				if cred.Username == nil || cred.Password == nil {
@@ -955,7 +973,7 @@ func (p *parser) parseContainer(sec string, pos *Pos, n *yaml.Node) *Container {
 			case "options":
 				ret.Options = p.parseString(kv.val, true)
 			default:
-				p.unexpectedKey(kv.key, sec, []string{
+				p.unexpectedKey(kv, sec, []string{
 					"image",
 					"credentials",
 					"env",
@@ -1041,6 +1059,7 @@ func (p *parser) parseStep(n *yaml.Node) *Step {
 						// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#jobsjob_idstepswithargs
 						exec.Args = p.parseString(input.val, true)
 					default:
+						p.checkScalarCompat(input.val, fmt.Sprintf("of input %q", input.key.Value), false)
 						exec.Inputs[input.id] = &Input{input.key, p.parseString(input.val, true)}
 					}
 				}
@@ -1071,7 +1090,7 @@ func (p *parser) parseStep(n *yaml.Node) *Step {
 				e.WorkingDirectory = workDir
 			}
 		default:
-			p.unexpectedKey(kv.key, "step", []string{
+			p.unexpectedKey(kv, "step", []string{
 				"id",
 				"if",
 				"name",
@@ -1146,7 +1165,7 @@ func (p *parser) parseRunsOn(n *yaml.Node) *Runner {
 		case "group":
 			r.Group = p.parseString(kv.val, false)
 		default:
-			p.unexpectedKey(kv.key, "runs-on", []string{"labels", "group"})
+			p.unexpectedKey(kv, "runs-on", []string{"labels", "group"})
 		}
 	}
 
@@ -1258,7 +1277,7 @@ func (p *parser) parseJob(id *String, n *yaml.Node) *Job {
 			}
 			callOnlyKey = k
 		default:
-			p.unexpectedKey(kv.key, "job", []string{
+			p.unexpectedKey(kv, "job", []string{
 				"name",
 				"needs",
 				"runs-on",
@@ -1360,7 +1379,7 @@ func (p *parser) parse(n *yaml.Node) *Workflow {
 		case "run-name":
 			w.RunName = p.parseString(v, false)
 		default:
-			p.unexpectedKey(k, "workflow", []string{
+			p.unexpectedKey(kv, "workflow", []string{
 				"name",
 				"run-name",
 				"on",
@@ -1390,16 +1409,17 @@ func (p *parser) parse(n *yaml.Node) *Workflow {
 // 	}
 // }
 
-func handleYAMLError(err error) []*Error {
-	re := regexp.MustCompile(`\bline (\d+):`)
+var reYAMLErrorLine = regexp.MustCompile(`\bline (\d+):`)
 
+func handleYAMLError(err error, src []byte) []*Error {
 	yamlErr := func(msg string) *Error {
-		l := 0
-		if ss := re.FindStringSubmatch(msg); len(ss) > 1 {
+		l, c := 0, 0
+		if ss := reYAMLErrorLine.FindStringSubmatch(msg); len(ss) > 1 {
 			l, _ = strconv.Atoi(ss[1])
+			c = firstNonSpaceColumn(src, l)
 		}
 		msg = fmt.Sprintf("could not parse as YAML: %s", msg)
-		return &Error{msg, "", l, 0, "syntax-check"}
+		return &Error{msg, "", l, c, "syntax-check", nil, nil, ""}
 	}
 
 	if te, ok := err.(*yaml.TypeError); ok {
@@ -1413,21 +1433,300 @@ func handleYAMLError(err error) []*Error {
 	return []*Error{yamlErr(err.Error())}
 }
 
+// lineRange returns the start and end offsets of the 1-based line in the source. The end offset
+// does not include the newline character.
+func lineRange(src []byte, line int) (int, int, bool) {
+	start := 0
+	for l := 1; l < line; l++ {
+		i := bytes.IndexByte(src[start:], '\n')
+		if i < 0 {
+			return 0, 0, false
+		}
+		start += i + 1
+	}
+	end := len(src)
+	if i := bytes.IndexByte(src[start:], '\n'); i >= 0 {
+		end = start + i
+	}
+	return start, end, true
+}
+
+// firstNonSpaceColumn returns 1-based column of the first non-space character in the line. It
+// returns 0 when the line does not exist or it only contains spaces.
+func firstNonSpaceColumn(src []byte, line int) int {
+	start, end, ok := lineRange(src, line)
+	if !ok {
+		return 0
+	}
+	for i, c := range src[start:end] {
+		if c != ' ' && c != '\t' && c != '\r' {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// The maximum number of YAML syntax errors reported from one source.
+const maxYAMLSyntaxErrors = 16
+
+// ancestorLines returns line numbers of the parent lines of the given line based on indentation.
+// For example, line 1 and line 2 are ancestors of line 3 in the following source.
+//
+//	jobs:
+//	  test:
+//	    steps:
+func ancestorLines(src []byte, line int) []int {
+	indent := func(l int) (int, bool) {
+		start, end, ok := lineRange(src, l)
+		if !ok {
+			return 0, false
+		}
+		s := src[start:end]
+		t := bytes.TrimLeft(s, " ")
+		if len(bytes.TrimSpace(t)) == 0 || t[0] == '#' {
+			return 0, false
+		}
+		return len(s) - len(t), true
+	}
+
+	cur, ok := indent(line)
+	if !ok {
+		return nil
+	}
+	ls := []int{}
+	for l := line - 1; l > 0 && cur > 0; l-- {
+		if i, ok := indent(l); ok && i < cur {
+			ls = append(ls, l)
+			cur = i
+		}
+	}
+	return ls
+}
+
+// parseYAMLWithRecovery parses the source as YAML. When the source has syntax errors, it tries to
+// recover from them by blanking the lines where the errors occurred and parses the source again.
+// It continues until the source is parsed successfully or it gives up the recovery. Lines are
+// replaced with spaces so that positions in the rest of the source are kept. When the recovery
+// succeeded, the node parsed from the recovered source is returned along with all syntax errors
+// and the set of ancestor lines of the blanked lines. Otherwise, nil is returned as the node.
+func parseYAMLWithRecovery(b []byte) (*yaml.Node, []*Error, map[int]struct{}) {
+	var n yaml.Node
+	err := yaml.Unmarshal(b, &n)
+	if err == nil {
+		return &n, nil, nil
+	}
+
+	errs := handleYAMLError(err, b)
+	src := make([]byte, len(b))
+	copy(src, b)
+
+	for len(errs) < maxYAMLSyntaxErrors {
+		e := errs[len(errs)-1]
+		start, end, ok := lineRange(src, e.Line)
+		if e.Line <= 0 || !ok || len(bytes.TrimSpace(src[start:end])) == 0 {
+			return nil, errs, nil // Cannot recover from the error
+		}
+		for i := start; i < end; i++ {
+			src[i] = ' '
+		}
+
+		n = yaml.Node{}
+		err := yaml.Unmarshal(src, &n)
+		if err == nil {
+			// Parents of the blanked lines may be reported as empty or missing by parser. They
+			// are not actual errors so remember them to filter the errors out
+			shadowed := map[int]struct{}{}
+			for _, e := range errs {
+				for _, l := range ancestorLines(b, e.Line) {
+					shadowed[l] = struct{}{}
+				}
+			}
+			return &n, errs, shadowed
+		}
+		for _, e := range handleYAMLError(err, b) {
+			if e.Line == errs[len(errs)-1].Line && e.Message == errs[len(errs)-1].Message {
+				return nil, errs, nil // Blanking the line did not change the error
+			}
+			errs = append(errs, e)
+		}
+	}
+
+	return nil, errs, nil
+}
+
+// Lines of template engines like "{% if ... %}" (Jinja), "{{- if ... }}" (Go template), "<% ... %>" (ERB)
+// and Markdown code fences like "```yaml". They are left when copying or generating workflow files.
+var reTemplateArtifactLine = regexp.MustCompile(`^[ \t]*(\{%.*%\}|\{\{.*\}\}|\{#.*#\}|<%.*%>|` + "```" + `[a-zA-Z]*)[ \t]*\r?$`)
+
+// templateArtifactLineCandidate returns whether the line may match to reTemplateArtifactLine by
+// checking its first non-blank character.
+func templateArtifactLineCandidate(line []byte) bool {
+	l := bytes.TrimLeft(line, " \t")
+	return len(l) > 0 && (l[0] == '{' || l[0] == '<' || l[0] == '`')
+}
+
+// Header of block scalar like "run: |", "- >-", or "script: |2 # comment". Lines in the block
+// scalar are script contents so they are not template artifacts
+var reBlockScalarHeader = regexp.MustCompile(`(?:^|[:-])[ \t]+(?:[&!][^ \t]*[ \t]+)*[|>][-+0-9]*[ \t]*(?:#.*)?\r?$`)
+
+// blockScalarParentIndent returns the indentation of the node which has the block scalar value
+// started at the line. Items of sequences like "- run: |" are taken into account.
+func blockScalarParentIndent(line []byte) int {
+	i := 0
+	for i < len(line) && line[i] == ' ' {
+		i++
+	}
+	for i+1 < len(line) && line[i] == '-' && (line[i+1] == ' ' || line[i+1] == '\t') {
+		j := i + 1
+		for j < len(line) && (line[j] == ' ' || line[j] == '\t') {
+			j++
+		}
+		if j < len(line) && (line[j] == '|' || line[j] == '>') {
+			break // The block scalar is the item of the sequence like "- |"
+		}
+		i = j
+	}
+	return i
+}
+
+// blankTemplateArtifacts reports lines which are artifacts of templates and replaces them with
+// spaces so that the rest of the source can be parsed. Positions in the source are kept. Lines in
+// block scalars such as "run: |" are not artifacts since they are contents of the scalars.
+func blankTemplateArtifacts(b []byte) ([]byte, []*Error) {
+	var errs []*Error
+	var src []byte
+	block := -1 // Indentation of the parent of the current block scalar. -1 means outside block scalars
+	for l, start := 1, 0; start < len(b); l++ {
+		end := len(b)
+		if i := bytes.IndexByte(b[start:], '\n'); i >= 0 {
+			end = start + i
+		}
+		line := b[start:end]
+		if block >= 0 {
+			if len(bytes.TrimSpace(line)) == 0 || len(line)-len(bytes.TrimLeft(line, " ")) > block {
+				start = end + 1
+				continue
+			}
+			block = -1
+		}
+		if bytes.ContainsAny(line, "|>") && reBlockScalarHeader.Match(line) {
+			block = blockScalarParentIndent(line)
+			start = end + 1
+			continue
+		}
+		// Matching the regular expression to every line is costly. Check the first character in advance
+		if !templateArtifactLineCandidate(b[start:end]) {
+			start = end + 1
+			continue
+		}
+		if m := reTemplateArtifactLine.FindSubmatchIndex(b[start:end]); m != nil {
+			if src == nil {
+				src = make([]byte, len(b))
+				copy(src, b)
+			}
+			a := string(b[start+m[2] : start+m[3]])
+			what, hint := "template directive", "lint the workflow file rendered from the template instead"
+			if strings.HasPrefix(a, "```") {
+				what, hint = "Markdown code fence", "remove the line"
+			}
+			errs = append(errs, &Error{
+				Message: fmt.Sprintf("%s %q is not valid YAML. this line is ignored. %s", what, a, hint),
+				Line:    l,
+				Column:  m[2] + 1,
+				Kind:    "syntax-check",
+			})
+			for i := start; i < end; i++ {
+				if src[i] != '\r' {
+					src[i] = ' '
+				}
+			}
+		}
+		start = end + 1
+	}
+	if src == nil {
+		return b, nil
+	}
+	return src, errs
+}
+
+// checkExtraYAMLDocuments reports YAML documents following the first document separated by "---".
+// A workflow file must consist of one YAML document. Only the first document is parsed as workflow.
+func checkExtraYAMLDocuments(b []byte) *Error {
+	seen, next := false, false
+	sep, line, count := 0, 0, 0
+	for l, start := 1, 0; start < len(b); l++ {
+		end := len(b)
+		if i := bytes.IndexByte(b[start:], '\n'); i >= 0 {
+			end = start + i
+		}
+		s := bytes.TrimRight(b[start:end], " \t\r")
+		start = end + 1
+
+		if bytes.HasPrefix(s, []byte("---")) && (len(s) == 3 || s[3] == ' ' || s[3] == '\t') || bytes.Equal(s, []byte("...")) {
+			next, sep = true, l
+			continue
+		}
+		if t := bytes.TrimSpace(s); len(t) == 0 || t[0] == '#' || s[0] == '%' {
+			continue // Blank line, comment, or directive
+		}
+		if next && seen {
+			count++
+			if line == 0 {
+				line = sep
+			}
+		}
+		seen, next = true, false
+	}
+
+	if count == 0 {
+		return nil
+	}
+	found := "another YAML document"
+	if count > 1 {
+		found = fmt.Sprintf("%d more YAML documents", count)
+	}
+	return &Error{
+		Message: fmt.Sprintf("found %s after the first document. a workflow file must consist of one YAML document. documents after the first one are ignored", found),
+		Line:    line,
+		Column:  1,
+		Kind:    "syntax-check",
+	}
+}
+
 // Parse parses given source as byte sequence into workflow syntax tree. It returns all errors
 // detected while parsing the input. It means that detecting one error does not stop parsing. Even
 // if one or more errors are detected, parser will try to continue parsing and finding more errors.
+// When the source has YAML syntax errors, parser tries to recover from them and continues parsing
+// the rest of the source. When the recovery failed, the returned workflow is nil.
 func Parse(b []byte) (*Workflow, []*Error) {
-	var n yaml.Node
-
-	if err := yaml.Unmarshal(b, &n); err != nil {
-		return nil, handleYAMLError(err)
+	src := b
+	n, errs, shadowed := parseYAMLWithRecovery(src)
+	if len(errs) > 0 {
+		// Template artifacts are blanked only when the source is not valid YAML since the same
+		// lines may be valid YAML in other places such as plain scalars
+		if s, artifacts := blankTemplateArtifacts(b); len(artifacts) > 0 {
+			src = s
+			n, errs, shadowed = parseYAMLWithRecovery(src)
+			errs = append(artifacts, errs...)
+		}
+	}
+	if e := checkExtraYAMLDocuments(src); e != nil {
+		errs = append(errs, e)
+	}
+	if n == nil {
+		return nil, errs
 	}
 
 	// Uncomment for checking YAML tree
 	// dumpYAML(&n, 0)
 
-	p := &parser{}
-	w := p.parse(&n)
+	p := &parser{src: b}
+	w := p.parse(n)
 
-	return w, p.errors
+	for _, e := range p.errors {
+		if _, ok := shadowed[e.Line]; !ok {
+			errs = append(errs, e)
+		}
+	}
+	return w, errs
 }
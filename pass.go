@@ -3,6 +3,7 @@ package actionlint
 import (
 	"fmt"
 	"io"
+	"sync"
 	"time"
 )
 
@@ -22,8 +23,9 @@ type Pass interface {
 
 // Visitor visits syntax tree from root in depth-first order
 type Visitor struct {
-	passes []Pass
-	dbg    io.Writer
+	passes   []Pass
+	dbg      io.Writer
+	parallel int
 }
 
 // NewVisitor creates Visitor instance
@@ -46,8 +48,20 @@ func (v *Visitor) reportElapsedTime(what string, start time.Time) {
 	fmt.Fprintf(v.dbg, "[Visitor] %s took %vms\n", what, time.Since(start).Milliseconds())
 }
 
+// EnableParallel makes the visitor traverse syntax tree with each pass concurrently. At most n
+// passes run at once. Passes must not depend on each other since the order of callbacks across
+// passes is no longer guaranteed. Callbacks of each pass are still called in depth-first order.
+// Values less than 2 disable the parallel traversal.
+func (v *Visitor) EnableParallel(n int) {
+	v.parallel = n
+}
+
 // Visit visits given syntax tree in depth-first order
 func (v *Visitor) Visit(n *Workflow) error {
+	if v.parallel > 1 && len(v.passes) > 1 {
+		return v.visitParallel(n)
+	}
+
 	var t time.Time
 	if v.dbg != nil {
 		t = time.Now()
@@ -88,6 +102,43 @@ func (v *Visitor) Visit(n *Workflow) error {
 	return nil
 }
 
+// visitParallel visits the syntax tree with each pass in its own goroutine. When some passes return
+// errors, the error of the first pass is returned so that the result does not depend on timing.
+func (v *Visitor) visitParallel(n *Workflow) error {
+	var t time.Time
+	if v.dbg != nil {
+		t = time.Now()
+	}
+
+	errs := make([]error, len(v.passes))
+	sema := make(chan struct{}, v.parallel)
+	var wg sync.WaitGroup
+	for i, p := range v.passes {
+		wg.Add(1)
+		sema <- struct{}{}
+		go func(i int, p Pass) {
+			defer func() {
+				<-sema
+				wg.Done()
+			}()
+			sub := &Visitor{passes: []Pass{p}}
+			errs[i] = sub.Visit(n)
+		}(i, p)
+	}
+	wg.Wait()
+
+	if v.dbg != nil {
+		v.reportElapsedTime(fmt.Sprintf("Visiting %d jobs with %d passes in parallel", len(n.Jobs), len(v.passes)), t)
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (v *Visitor) visitJob(n *Job) error {
 	var t time.Time
 	if v.dbg != nil {
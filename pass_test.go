@@ -0,0 +1,91 @@
+package actionlint
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type recordingPass struct {
+	visits []string
+	err    error
+}
+
+func (p *recordingPass) VisitStep(n *Step) error {
+	p.visits = append(p.visits, fmt.Sprintf("step %s", n.Pos))
+	return p.err
+}
+
+func (p *recordingPass) VisitJobPre(n *Job) error {
+	p.visits = append(p.visits, fmt.Sprintf("job pre %s", n.ID.Value))
+	return nil
+}
+
+func (p *recordingPass) VisitJobPost(n *Job) error {
+	p.visits = append(p.visits, fmt.Sprintf("job post %s", n.ID.Value))
+	return nil
+}
+
+func (p *recordingPass) VisitWorkflowPre(n *Workflow) error {
+	p.visits = append(p.visits, "workflow pre")
+	return nil
+}
+
+func (p *recordingPass) VisitWorkflowPost(n *Workflow) error {
+	p.visits = append(p.visits, "workflow post")
+	return nil
+}
+
+func TestVisitorParallel(t *testing.T) {
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo 1\n      - run: echo 2\n      - run: echo 3\n"
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	want := []string{
+		"workflow pre",
+		"job pre test",
+		"step line:6,col:9",
+		"step line:7,col:9",
+		"step line:8,col:9",
+		"job post test",
+		"workflow post",
+	}
+
+	passes := []*recordingPass{{}, {}, {}, {}, {}}
+	v := NewVisitor()
+	for _, p := range passes {
+		v.AddPass(p)
+	}
+	v.EnableParallel(2)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+	for i, p := range passes {
+		if !cmp.Equal(want, p.visits) {
+			t.Errorf("pass #%d visited nodes in unexpected order: %s", i, cmp.Diff(want, p.visits))
+		}
+	}
+}
+
+func TestVisitorParallelError(t *testing.T) {
+	w, errs := Parse([]byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	first, second := errors.New("first"), errors.New("second")
+	for i := 0; i < 10; i++ {
+		v := NewVisitor()
+		v.AddPass(&recordingPass{})
+		v.AddPass(&recordingPass{err: first})
+		v.AddPass(&recordingPass{err: second})
+		v.EnableParallel(3)
+		if err := v.Visit(w); err != first {
+			t.Fatalf("error of the first pass should be returned but got %v", err)
+		}
+	}
+}
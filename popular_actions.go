@@ -2,3713 +2,182 @@
 
 package actionlint
 
-// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of actions
-// and values are their metadata.
-var PopularActions = map[string]*ActionMetadata{
-	"8398a7/action-slack@v3": {
-		Name: "action-slack",
-		Inputs: ActionMetadataInputs{
-			"author_name":     {"author_name", false},
-			"channel":         {"channel", false},
-			"custom_payload":  {"custom_payload", false},
-			"fields":          {"fields", false},
-			"github_base_url": {"github_base_url", false},
-			"github_token":    {"github_token", false},
-			"icon_emoji":      {"icon_emoji", false},
-			"icon_url":        {"icon_url", false},
-			"if_mention":      {"if_mention", false},
-			"job_name":        {"job_name", false},
-			"mention":         {"mention", false},
-			"status":          {"status", true},
-			"text":            {"text", false},
-			"username":        {"username", false},
-		},
-	},
-	"Azure/functions-action@v1": {
-		Name: "Azure Functions Action",
-		Inputs: ActionMetadataInputs{
-			"app-name":                       {"app-name", true},
-			"enable-oryx-build":              {"enable-oryx-build", false},
-			"package":                        {"package", false},
-			"publish-profile":                {"publish-profile", false},
-			"respect-funcignore":             {"respect-funcignore", false},
-			"respect-pom-xml":                {"respect-pom-xml", false},
-			"scm-do-build-during-deployment": {"scm-do-build-during-deployment", false},
-			"slot-name":                      {"slot-name", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"app-url":     {"app-url"},
-			"package-url": {"package-url"},
-		},
-	},
-	"EnricoMi/publish-unit-test-result-action@v1": {
-		Name: "Publish Test Results",
-		Inputs: ActionMetadataInputs{
-			"check_name":                       {"check_name", false},
-			"check_run_annotations":            {"check_run_annotations", false},
-			"check_run_annotations_branch":     {"check_run_annotations_branch", false},
-			"comment_mode":                     {"comment_mode", false},
-			"comment_on_pr":                    {"comment_on_pr", false},
-			"comment_title":                    {"comment_title", false},
-			"commit":                           {"commit", false},
-			"compare_to_earlier_commit":        {"compare_to_earlier_commit", false},
-			"deduplicate_classes_by_file_name": {"deduplicate_classes_by_file_name", false},
-			"event_file":                       {"event_file", false},
-			"event_name":                       {"event_name", false},
-			"fail_on":                          {"fail_on", false},
-			"files":                            {"files", true},
-			"github_retries":                   {"github_retries", false},
-			"github_token":                     {"github_token", false},
-			"hide_comments":                    {"hide_comments", false},
-			"ignore_runs":                      {"ignore_runs", false},
-			"job_summary":                      {"job_summary", false},
-			"json_file":                        {"json_file", false},
-			"json_thousands_separator":         {"json_thousands_separator", false},
-			"pull_request_build":               {"pull_request_build", false},
-			"report_individual_runs":           {"report_individual_runs", false},
-			"seconds_between_github_reads":     {"seconds_between_github_reads", false},
-			"seconds_between_github_writes":    {"seconds_between_github_writes", false},
-			"test_changes_limit":               {"test_changes_limit", false},
-			"time_unit":                        {"time_unit", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"json": {"json"},
-		},
-	},
-	"EnricoMi/publish-unit-test-result-action@v2": {
-		Name: "Publish Test Results",
-		Inputs: ActionMetadataInputs{
-			"action_fail":                       {"action_fail", false},
-			"action_fail_on_inconclusive":       {"action_fail_on_inconclusive", false},
-			"check_name":                        {"check_name", false},
-			"check_run":                         {"check_run", false},
-			"check_run_annotations":             {"check_run_annotations", false},
-			"check_run_annotations_branch":      {"check_run_annotations_branch", false},
-			"comment_mode":                      {"comment_mode", false},
-			"comment_title":                     {"comment_title", false},
-			"commit":                            {"commit", false},
-			"compare_to_earlier_commit":         {"compare_to_earlier_commit", false},
-			"deduplicate_classes_by_file_name":  {"deduplicate_classes_by_file_name", false},
-			"event_file":                        {"event_file", false},
-			"event_name":                        {"event_name", false},
-			"fail_on":                           {"fail_on", false},
-			"files":                             {"files", false},
-			"github_retries":                    {"github_retries", false},
-			"github_token":                      {"github_token", false},
-			"github_token_actor":                {"github_token_actor", false},
-			"ignore_runs":                       {"ignore_runs", false},
-			"job_summary":                       {"job_summary", false},
-			"json_file":                         {"json_file", false},
-			"json_suite_details":                {"json_suite_details", false},
-			"json_test_case_results":            {"json_test_case_results", false},
-			"json_thousands_separator":          {"json_thousands_separator", false},
-			"junit_files":                       {"junit_files", false},
-			"large_files":                       {"large_files", false},
-			"nunit_files":                       {"nunit_files", false},
-			"pull_request_build":                {"pull_request_build", false},
-			"report_individual_runs":            {"report_individual_runs", false},
-			"report_suite_logs":                 {"report_suite_logs", false},
-			"search_pull_requests":              {"search_pull_requests", false},
-			"secondary_rate_limit_wait_seconds": {"secondary_rate_limit_wait_seconds", false},
-			"seconds_between_github_reads":      {"seconds_between_github_reads", false},
-			"seconds_between_github_writes":     {"seconds_between_github_writes", false},
-			"test_changes_limit":                {"test_changes_limit", false},
-			"test_file_prefix":                  {"test_file_prefix", false},
-			"time_unit":                         {"time_unit", false},
-			"trx_files":                         {"trx_files", false},
-			"xunit_files":                       {"xunit_files", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"json": {"json"},
-		},
-	},
-	"JamesIves/github-pages-deploy-action@releases/v4": {
-		Name: "Deploy to GitHub Pages",
-		Inputs: ActionMetadataInputs{
-			"branch":           {"branch", false},
-			"clean":            {"clean", false},
-			"clean-exclude":    {"clean-exclude", false},
-			"commit-message":   {"commit-message", false},
-			"dry-run":          {"dry-run", false},
-			"folder":           {"folder", true},
-			"force":            {"force", false},
-			"git-config-email": {"git-config-email", false},
-			"git-config-name":  {"git-config-name", false},
-			"repository-name":  {"repository-name", false},
-			"silent":           {"silent", false},
-			"single-commit":    {"single-commit", false},
-			"ssh-key":          {"ssh-key", false},
-			"tag":              {"tag", false},
-			"target-folder":    {"target-folder", false},
-			"token":            {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"deployment-status": {"deployment-status"},
-		},
-	},
-	"ReactiveCircus/android-emulator-runner@v2": {
-		Name: "Android Emulator Runner",
-		Inputs: ActionMetadataInputs{
-			"api-level":                  {"api-level", true},
-			"arch":                       {"arch", false},
-			"avd-name":                   {"avd-name", false},
-			"channel":                    {"channel", false},
-			"cmake":                      {"cmake", false},
-			"cores":                      {"cores", false},
-			"disable-animations":         {"disable-animations", false},
-			"disable-linux-hw-accel":     {"disable-linux-hw-accel", false},
-			"disable-spellchecker":       {"disable-spellchecker", false},
-			"disk-size":                  {"disk-size", false},
-			"emulator-boot-timeout":      {"emulator-boot-timeout", false},
-			"emulator-build":             {"emulator-build", false},
-			"emulator-options":           {"emulator-options", false},
-			"enable-hw-keyboard":         {"enable-hw-keyboard", false},
-			"force-avd-creation":         {"force-avd-creation", false},
-			"heap-size":                  {"heap-size", false},
-			"ndk":                        {"ndk", false},
-			"pre-emulator-launch-script": {"pre-emulator-launch-script", false},
-			"profile":                    {"profile", false},
-			"ram-size":                   {"ram-size", false},
-			"script":                     {"script", true},
-			"sdcard-path-or-size":        {"sdcard-path-or-size", false},
-			"target":                     {"target", false},
-			"working-directory":          {"working-directory", false},
-		},
-	},
-	"Swatinem/rust-cache@v2": {
-		Name: "Rust Cache",
-		Inputs: ActionMetadataInputs{
-			"cache-all-crates":  {"cache-all-crates", false},
-			"cache-directories": {"cache-directories", false},
-			"cache-on-failure":  {"cache-on-failure", false},
-			"cache-provider":    {"cache-provider", false},
-			"cache-targets":     {"cache-targets", false},
-			"env-vars":          {"env-vars", false},
-			"key":               {"key", false},
-			"prefix-key":        {"prefix-key", false},
-			"save-if":           {"save-if", false},
-			"shared-key":        {"shared-key", false},
-			"workspaces":        {"workspaces", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit": {"cache-hit"},
-		},
-	},
-	"actions-cool/issues-helper@v3": {
-		Name: "Issues Helper",
-		Inputs: ActionMetadataInputs{
-			"actions":            {"actions", false},
-			"assign-command":     {"assign-command", false},
-			"assignee-includes":  {"assignee-includes", false},
-			"assignees":          {"assignees", false},
-			"body":               {"body", false},
-			"body-includes":      {"body-includes", false},
-			"close-issue":        {"close-issue", false},
-			"close-reason":       {"close-reason", false},
-			"comment-auth":       {"comment-auth", false},
-			"comment-id":         {"comment-id", false},
-			"direction":          {"direction", false},
-			"duplicate-command":  {"duplicate-command", false},
-			"duplicate-labels":   {"duplicate-labels", false},
-			"emoji":              {"emoji", false},
-			"exclude-labels":     {"exclude-labels", false},
-			"inactive-day":       {"inactive-day", false},
-			"inactive-label":     {"inactive-label", false},
-			"inactive-mode":      {"inactive-mode", false},
-			"issue-assignee":     {"issue-assignee", false},
-			"issue-creator":      {"issue-creator", false},
-			"issue-emoji":        {"issue-emoji", false},
-			"issue-mentioned":    {"issue-mentioned", false},
-			"issue-number":       {"issue-number", false},
-			"issue-state":        {"issue-state", false},
-			"label-color":        {"label-color", false},
-			"label-desc":         {"label-desc", false},
-			"label-name":         {"label-name", false},
-			"labels":             {"labels", false},
-			"lock-reason":        {"lock-reason", false},
-			"random-to":          {"random-to", false},
-			"remove-labels":      {"remove-labels", false},
-			"repo":               {"repo", false},
-			"require-permission": {"require-permission", false},
-			"state":              {"state", false},
-			"title":              {"title", false},
-			"title-excludes":     {"title-excludes", false},
-			"title-includes":     {"title-includes", false},
-			"token":              {"token", false},
-			"update-mode":        {"update-mode", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"check-result":    {"check-result"},
-			"comment-id":      {"comment-id"},
-			"comments":        {"comments"},
-			"issue-assignees": {"issue-assignees"},
-			"issue-body":      {"issue-body"},
-			"issue-labels":    {"issue-labels"},
-			"issue-number":    {"issue-number"},
-			"issue-state":     {"issue-state"},
-			"issue-title":     {"issue-title"},
-			"issues":          {"issues"},
-		},
-	},
-	"actions/add-to-project@v1.0.1": {
-		Name: "Add To GitHub projects",
-		Inputs: ActionMetadataInputs{
-			"github-token":   {"github-token", true},
-			"label-operator": {"label-operator", false},
-			"labeled":        {"labeled", false},
-			"project-url":    {"project-url", true},
-		},
-		Outputs: ActionMetadataOutputs{
-			"itemid": {"itemId"},
-		},
-	},
-	"actions/attest-build-provenance@v1": {
-		Name: "Attest Build Provenance",
-		Inputs: ActionMetadataInputs{
-			"github-token":     {"github-token", false},
-			"push-to-registry": {"push-to-registry", false},
-			"subject-digest":   {"subject-digest", false},
-			"subject-name":     {"subject-name", false},
-			"subject-path":     {"subject-path", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"bundle-path": {"bundle-path"},
-		},
-	},
-	"actions/cache@v3": {
-		Name: "Cache",
-		Inputs: ActionMetadataInputs{
-			"enablecrossosarchive": {"enableCrossOsArchive", false},
-			"fail-on-cache-miss":   {"fail-on-cache-miss", false},
-			"key":                  {"key", true},
-			"lookup-only":          {"lookup-only", false},
-			"path":                 {"path", true},
-			"restore-keys":         {"restore-keys", false},
-			"upload-chunk-size":    {"upload-chunk-size", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit": {"cache-hit"},
-		},
-	},
-	"actions/cache@v4": {
-		Name: "Cache",
-		Inputs: ActionMetadataInputs{
-			"enablecrossosarchive": {"enableCrossOsArchive", false},
-			"fail-on-cache-miss":   {"fail-on-cache-miss", false},
-			"key":                  {"key", true},
-			"lookup-only":          {"lookup-only", false},
-			"path":                 {"path", true},
-			"restore-keys":         {"restore-keys", false},
-			"save-always":          {"save-always", false},
-			"upload-chunk-size":    {"upload-chunk-size", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit": {"cache-hit"},
-		},
-	},
-	"actions/checkout@v1": {
-		Name: "Checkout",
-		Inputs: ActionMetadataInputs{
-			"clean":       {"clean", false},
-			"fetch-depth": {"fetch-depth", false},
-			"lfs":         {"lfs", false},
-			"path":        {"path", false},
-			"ref":         {"ref", false},
-			"repository":  {"repository", false},
-			"submodules":  {"submodules", false},
-			"token":       {"token", false},
-		},
-	},
-	"actions/checkout@v3": {
-		Name: "Checkout",
-		Inputs: ActionMetadataInputs{
-			"clean":                     {"clean", false},
-			"fetch-depth":               {"fetch-depth", false},
-			"fetch-tags":                {"fetch-tags", false},
-			"github-server-url":         {"github-server-url", false},
-			"lfs":                       {"lfs", false},
-			"path":                      {"path", false},
-			"persist-credentials":       {"persist-credentials", false},
-			"ref":                       {"ref", false},
-			"repository":                {"repository", false},
-			"set-safe-directory":        {"set-safe-directory", false},
-			"sparse-checkout":           {"sparse-checkout", false},
-			"sparse-checkout-cone-mode": {"sparse-checkout-cone-mode", false},
-			"ssh-key":                   {"ssh-key", false},
-			"ssh-known-hosts":           {"ssh-known-hosts", false},
-			"ssh-strict":                {"ssh-strict", false},
-			"submodules":                {"submodules", false},
-			"token":                     {"token", false},
-		},
-	},
-	"actions/checkout@v4": {
-		Name: "Checkout",
-		Inputs: ActionMetadataInputs{
-			"clean":                     {"clean", false},
-			"fetch-depth":               {"fetch-depth", false},
-			"fetch-tags":                {"fetch-tags", false},
-			"filter":                    {"filter", false},
-			"github-server-url":         {"github-server-url", false},
-			"lfs":                       {"lfs", false},
-			"path":                      {"path", false},
-			"persist-credentials":       {"persist-credentials", false},
-			"ref":                       {"ref", false},
-			"repository":                {"repository", false},
-			"set-safe-directory":        {"set-safe-directory", false},
-			"show-progress":             {"show-progress", false},
-			"sparse-checkout":           {"sparse-checkout", false},
-			"sparse-checkout-cone-mode": {"sparse-checkout-cone-mode", false},
-			"ssh-key":                   {"ssh-key", false},
-			"ssh-known-hosts":           {"ssh-known-hosts", false},
-			"ssh-strict":                {"ssh-strict", false},
-			"ssh-user":                  {"ssh-user", false},
-			"submodules":                {"submodules", false},
-			"token":                     {"token", false},
-		},
-	},
-	"actions/configure-pages@v1": {
-		Name: "Configure GitHub Pages",
-		Inputs: ActionMetadataInputs{
-			"enablement":            {"enablement", false},
-			"generator_config_file": {"generator_config_file", false},
-			"static_site_generator": {"static_site_generator", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"base_path": {"base_path"},
-			"base_url":  {"base_url"},
-			"host":      {"host"},
-			"origin":    {"origin"},
-		},
-	},
-	"actions/configure-pages@v2": {
-		Name: "Configure GitHub Pages",
-		Inputs: ActionMetadataInputs{
-			"enablement":            {"enablement", false},
-			"generator_config_file": {"generator_config_file", false},
-			"static_site_generator": {"static_site_generator", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"base_path": {"base_path"},
-			"base_url":  {"base_url"},
-			"host":      {"host"},
-			"origin":    {"origin"},
-		},
-	},
-	"actions/configure-pages@v3": {
-		Name: "Configure GitHub Pages",
-		Inputs: ActionMetadataInputs{
-			"enablement":            {"enablement", false},
-			"generator_config_file": {"generator_config_file", false},
-			"static_site_generator": {"static_site_generator", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"base_path": {"base_path"},
-			"base_url":  {"base_url"},
-			"host":      {"host"},
-			"origin":    {"origin"},
-		},
-	},
-	"actions/configure-pages@v4": {
-		Name: "Configure GitHub Pages",
-		Inputs: ActionMetadataInputs{
-			"enablement":            {"enablement", false},
-			"generator_config_file": {"generator_config_file", false},
-			"static_site_generator": {"static_site_generator", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"base_path": {"base_path"},
-			"base_url":  {"base_url"},
-			"host":      {"host"},
-			"origin":    {"origin"},
-		},
-	},
-	"actions/configure-pages@v5": {
-		Name: "Configure GitHub Pages",
-		Inputs: ActionMetadataInputs{
-			"enablement":            {"enablement", false},
-			"generator_config_file": {"generator_config_file", false},
-			"static_site_generator": {"static_site_generator", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"base_path": {"base_path"},
-			"base_url":  {"base_url"},
-			"host":      {"host"},
-			"origin":    {"origin"},
-		},
-	},
-	"actions/delete-package-versions@v3": {
-		Name: "Delete Package Versions",
-		Inputs: ActionMetadataInputs{
-			"delete-only-pre-release-versions": {"delete-only-pre-release-versions", false},
-			"ignore-versions":                  {"ignore-versions", false},
-			"min-versions-to-keep":             {"min-versions-to-keep", false},
-			"num-old-versions-to-delete":       {"num-old-versions-to-delete", false},
-			"owner":                            {"owner", false},
-			"package-name":                     {"package-name", false},
-			"package-version-ids":              {"package-version-ids", false},
-			"repo":                             {"repo", false},
-			"token":                            {"token", false},
-		},
-	},
-	"actions/delete-package-versions@v4": {
-		Name: "Delete Package Versions",
-		Inputs: ActionMetadataInputs{
-			"delete-only-pre-release-versions": {"delete-only-pre-release-versions", false},
-			"delete-only-untagged-versions":    {"delete-only-untagged-versions", false},
-			"ignore-versions":                  {"ignore-versions", false},
-			"min-versions-to-keep":             {"min-versions-to-keep", false},
-			"num-old-versions-to-delete":       {"num-old-versions-to-delete", false},
-			"owner":                            {"owner", false},
-			"package-name":                     {"package-name", true},
-			"package-type":                     {"package-type", true},
-			"package-version-ids":              {"package-version-ids", false},
-			"token":                            {"token", false},
-		},
-	},
-	"actions/delete-package-versions@v5": {
-		Name: "Delete Package Versions",
-		Inputs: ActionMetadataInputs{
-			"delete-only-pre-release-versions": {"delete-only-pre-release-versions", false},
-			"delete-only-untagged-versions":    {"delete-only-untagged-versions", false},
-			"ignore-versions":                  {"ignore-versions", false},
-			"min-versions-to-keep":             {"min-versions-to-keep", false},
-			"num-old-versions-to-delete":       {"num-old-versions-to-delete", false},
-			"owner":                            {"owner", false},
-			"package-name":                     {"package-name", true},
-			"package-type":                     {"package-type", true},
-			"package-version-ids":              {"package-version-ids", false},
-			"token":                            {"token", false},
-		},
-	},
-	"actions/dependency-review-action@v3": {
-		Name: "Dependency Review",
-		Inputs: ActionMetadataInputs{
-			"allow-dependencies-licenses":        {"allow-dependencies-licenses", false},
-			"allow-ghsas":                        {"allow-ghsas", false},
-			"allow-licenses":                     {"allow-licenses", false},
-			"base-ref":                           {"base-ref", false},
-			"comment-summary-in-pr":              {"comment-summary-in-pr", false},
-			"config-file":                        {"config-file", false},
-			"deny-groups":                        {"deny-groups", false},
-			"deny-licenses":                      {"deny-licenses", false},
-			"deny-packages":                      {"deny-packages", false},
-			"external-repo-token":                {"external-repo-token", false},
-			"fail-on-scopes":                     {"fail-on-scopes", false},
-			"fail-on-severity":                   {"fail-on-severity", false},
-			"head-ref":                           {"head-ref", false},
-			"license-check":                      {"license-check", false},
-			"repo-token":                         {"repo-token", false},
-			"retry-on-snapshot-warnings":         {"retry-on-snapshot-warnings", false},
-			"retry-on-snapshot-warnings-timeout": {"retry-on-snapshot-warnings-timeout", false},
-			"vulnerability-check":                {"vulnerability-check", false},
-		},
-	},
-	"actions/dependency-review-action@v4": {
-		Name: "Dependency Review",
-		Inputs: ActionMetadataInputs{
-			"allow-dependencies-licenses":        {"allow-dependencies-licenses", false},
-			"allow-ghsas":                        {"allow-ghsas", false},
-			"allow-licenses":                     {"allow-licenses", false},
-			"base-ref":                           {"base-ref", false},
-			"comment-summary-in-pr":              {"comment-summary-in-pr", false},
-			"config-file":                        {"config-file", false},
-			"deny-groups":                        {"deny-groups", false},
-			"deny-licenses":                      {"deny-licenses", false},
-			"deny-packages":                      {"deny-packages", false},
-			"external-repo-token":                {"external-repo-token", false},
-			"fail-on-scopes":                     {"fail-on-scopes", false},
-			"fail-on-severity":                   {"fail-on-severity", false},
-			"head-ref":                           {"head-ref", false},
-			"license-check":                      {"license-check", false},
-			"repo-token":                         {"repo-token", false},
-			"retry-on-snapshot-warnings":         {"retry-on-snapshot-warnings", false},
-			"retry-on-snapshot-warnings-timeout": {"retry-on-snapshot-warnings-timeout", false},
-			"show-openssf-scorecard":             {"show-openssf-scorecard", false},
-			"vulnerability-check":                {"vulnerability-check", false},
-			"warn-on-openssf-scorecard-level":    {"warn-on-openssf-scorecard-level", false},
-			"warn-only":                          {"warn-only", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"comment-content":         {"comment-content"},
-			"denied-changes":          {"denied-changes"},
-			"dependency-changes":      {"dependency-changes"},
-			"invalid-license-changes": {"invalid-license-changes"},
-			"vulnerable-changes":      {"vulnerable-changes"},
-		},
-	},
-	"actions/deploy-pages@v1": {
-		Name: "Deploy GitHub Pages site",
-		Inputs: ActionMetadataInputs{
-			"artifact_name":      {"artifact_name", false},
-			"conclusion":         {"conclusion", false},
-			"emit_telemetry":     {"emit_telemetry", false},
-			"error_count":        {"error_count", false},
-			"preview":            {"preview", false},
-			"reporting_interval": {"reporting_interval", false},
-			"timeout":            {"timeout", false},
-			"token":              {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"page_url": {"page_url"},
-		},
-	},
-	"actions/deploy-pages@v2": {
-		Name: "Deploy GitHub Pages site",
-		Inputs: ActionMetadataInputs{
-			"artifact_name":      {"artifact_name", false},
-			"error_count":        {"error_count", false},
-			"preview":            {"preview", false},
-			"reporting_interval": {"reporting_interval", false},
-			"timeout":            {"timeout", false},
-			"token":              {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"page_url": {"page_url"},
-		},
-	},
-	"actions/deploy-pages@v3": {
-		Name: "Deploy GitHub Pages site",
-		Inputs: ActionMetadataInputs{
-			"artifact_name":      {"artifact_name", false},
-			"error_count":        {"error_count", false},
-			"preview":            {"preview", false},
-			"reporting_interval": {"reporting_interval", false},
-			"timeout":            {"timeout", false},
-			"token":              {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"page_url": {"page_url"},
-		},
-	},
-	"actions/deploy-pages@v4": {
-		Name: "Deploy GitHub Pages site",
-		Inputs: ActionMetadataInputs{
-			"artifact_name":      {"artifact_name", false},
-			"error_count":        {"error_count", false},
-			"preview":            {"preview", false},
-			"reporting_interval": {"reporting_interval", false},
-			"timeout":            {"timeout", false},
-			"token":              {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"page_url": {"page_url"},
-		},
-	},
-	"actions/download-artifact@v1": {
-		Name: "Download a Build Artifact",
-		Inputs: ActionMetadataInputs{
-			"name": {"name", true},
-			"path": {"path", false},
-		},
-	},
-	"actions/download-artifact@v3": {
-		Name: "Download a Build Artifact",
-		Inputs: ActionMetadataInputs{
-			"name": {"name", false},
-			"path": {"path", false},
-		},
-	},
-	"actions/download-artifact@v4": {
-		Name: "Download a Build Artifact",
-		Inputs: ActionMetadataInputs{
-			"github-token":   {"github-token", false},
-			"merge-multiple": {"merge-multiple", false},
-			"name":           {"name", false},
-			"path":           {"path", false},
-			"pattern":        {"pattern", false},
-			"repository":     {"repository", false},
-			"run-id":         {"run-id", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"download-path": {"download-path"},
-		},
-	},
-	"actions/first-interaction@v1": {
-		Name: "First interaction",
-		Inputs: ActionMetadataInputs{
-			"issue-message": {"issue-message", false},
-			"pr-message":    {"pr-message", false},
-			"repo-token":    {"repo-token", true},
-		},
-	},
-	"actions/github-script@v6": {
-		Name: "GitHub Script",
-		Inputs: ActionMetadataInputs{
-			"debug":                     {"debug", false},
-			"github-token":              {"github-token", false},
-			"previews":                  {"previews", false},
-			"result-encoding":           {"result-encoding", false},
-			"retries":                   {"retries", false},
-			"retry-exempt-status-codes": {"retry-exempt-status-codes", false},
-			"script":                    {"script", true},
-			"user-agent":                {"user-agent", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"result": {"result"},
-		},
-	},
-	"actions/github-script@v7": {
-		Name: "GitHub Script",
-		Inputs: ActionMetadataInputs{
-			"base-url":                  {"base-url", false},
-			"debug":                     {"debug", false},
-			"github-token":              {"github-token", false},
-			"previews":                  {"previews", false},
-			"result-encoding":           {"result-encoding", false},
-			"retries":                   {"retries", false},
-			"retry-exempt-status-codes": {"retry-exempt-status-codes", false},
-			"script":                    {"script", true},
-			"user-agent":                {"user-agent", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"result": {"result"},
-		},
-	},
-	"actions/labeler@v4": {
-		Name: "Labeler",
-		Inputs: ActionMetadataInputs{
-			"configuration-path": {"configuration-path", false},
-			"dot":                {"dot", false},
-			"pr-number":          {"pr-number", false},
-			"repo-token":         {"repo-token", false},
-			"sync-labels":        {"sync-labels", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"all-labels": {"all-labels"},
-			"new-labels": {"new-labels"},
-		},
-	},
-	"actions/labeler@v5": {
-		Name: "Labeler",
-		Inputs: ActionMetadataInputs{
-			"configuration-path": {"configuration-path", false},
-			"dot":                {"dot", false},
-			"pr-number":          {"pr-number", false},
-			"repo-token":         {"repo-token", false},
-			"sync-labels":        {"sync-labels", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"all-labels": {"all-labels"},
-			"new-labels": {"new-labels"},
-		},
-	},
-	"actions/setup-dotnet@v2": {
-		Name: "Setup .NET Core SDK",
-		Inputs: ActionMetadataInputs{
-			"config-file":        {"config-file", false},
-			"dotnet-version":     {"dotnet-version", false},
-			"global-json-file":   {"global-json-file", false},
-			"include-prerelease": {"include-prerelease", false},
-			"owner":              {"owner", false},
-			"source-url":         {"source-url", false},
-		},
-	},
-	"actions/setup-dotnet@v3": {
-		Name: "Setup .NET Core SDK",
-		Inputs: ActionMetadataInputs{
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"config-file":           {"config-file", false},
-			"dotnet-quality":        {"dotnet-quality", false},
-			"dotnet-version":        {"dotnet-version", false},
-			"global-json-file":      {"global-json-file", false},
-			"owner":                 {"owner", false},
-			"source-url":            {"source-url", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":      {"cache-hit"},
-			"dotnet-version": {"dotnet-version"},
-		},
-	},
-	"actions/setup-dotnet@v4": {
-		Name: "Setup .NET Core SDK",
-		Inputs: ActionMetadataInputs{
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"config-file":           {"config-file", false},
-			"dotnet-quality":        {"dotnet-quality", false},
-			"dotnet-version":        {"dotnet-version", false},
-			"global-json-file":      {"global-json-file", false},
-			"owner":                 {"owner", false},
-			"source-url":            {"source-url", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":      {"cache-hit"},
-			"dotnet-version": {"dotnet-version"},
-		},
-	},
-	"actions/setup-go@v3": {
-		Name: "Setup Go environment",
-		Inputs: ActionMetadataInputs{
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"go-version":            {"go-version", false},
-			"go-version-file":       {"go-version-file", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":  {"cache-hit"},
-			"go-version": {"go-version"},
-		},
-	},
-	"actions/setup-go@v4": {
-		Name: "Setup Go environment",
-		Inputs: ActionMetadataInputs{
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"go-version":            {"go-version", false},
-			"go-version-file":       {"go-version-file", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":  {"cache-hit"},
-			"go-version": {"go-version"},
-		},
-	},
-	"actions/setup-go@v5": {
-		Name: "Setup Go environment",
-		Inputs: ActionMetadataInputs{
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"go-version":            {"go-version", false},
-			"go-version-file":       {"go-version-file", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":  {"cache-hit"},
-			"go-version": {"go-version"},
-		},
-	},
-	"actions/setup-java@v3": {
-		Name: "Setup Java JDK",
-		Inputs: ActionMetadataInputs{
-			"architecture":         {"architecture", false},
-			"cache":                {"cache", false},
-			"check-latest":         {"check-latest", false},
-			"distribution":         {"distribution", true},
-			"gpg-passphrase":       {"gpg-passphrase", false},
-			"gpg-private-key":      {"gpg-private-key", false},
-			"java-package":         {"java-package", false},
-			"java-version":         {"java-version", false},
-			"java-version-file":    {"java-version-file", false},
-			"jdkfile":              {"jdkFile", false},
-			"job-status":           {"job-status", false},
-			"mvn-toolchain-id":     {"mvn-toolchain-id", false},
-			"mvn-toolchain-vendor": {"mvn-toolchain-vendor", false},
-			"overwrite-settings":   {"overwrite-settings", false},
-			"server-id":            {"server-id", false},
-			"server-password":      {"server-password", false},
-			"server-username":      {"server-username", false},
-			"settings-path":        {"settings-path", false},
-			"token":                {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":    {"cache-hit"},
-			"distribution": {"distribution"},
-			"path":         {"path"},
-			"version":      {"version"},
-		},
-	},
-	"actions/setup-java@v4": {
-		Name: "Setup Java JDK",
-		Inputs: ActionMetadataInputs{
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"distribution":          {"distribution", true},
-			"gpg-passphrase":        {"gpg-passphrase", false},
-			"gpg-private-key":       {"gpg-private-key", false},
-			"java-package":          {"java-package", false},
-			"java-version":          {"java-version", false},
-			"java-version-file":     {"java-version-file", false},
-			"jdkfile":               {"jdkFile", false},
-			"job-status":            {"job-status", false},
-			"mvn-toolchain-id":      {"mvn-toolchain-id", false},
-			"mvn-toolchain-vendor":  {"mvn-toolchain-vendor", false},
-			"overwrite-settings":    {"overwrite-settings", false},
-			"server-id":             {"server-id", false},
-			"server-password":       {"server-password", false},
-			"server-username":       {"server-username", false},
-			"settings-path":         {"settings-path", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":    {"cache-hit"},
-			"distribution": {"distribution"},
-			"path":         {"path"},
-			"version":      {"version"},
-		},
-	},
-	"actions/setup-node@v3": {
-		Name: "Setup Node.js environment",
-		Inputs: ActionMetadataInputs{
-			"always-auth":           {"always-auth", false},
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"node-version":          {"node-version", false},
-			"node-version-file":     {"node-version-file", false},
-			"registry-url":          {"registry-url", false},
-			"scope":                 {"scope", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":    {"cache-hit"},
-			"node-version": {"node-version"},
-		},
-	},
-	"actions/setup-node@v4": {
-		Name: "Setup Node.js environment",
-		Inputs: ActionMetadataInputs{
-			"always-auth":           {"always-auth", false},
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"node-version":          {"node-version", false},
-			"node-version-file":     {"node-version-file", false},
-			"registry-url":          {"registry-url", false},
-			"scope":                 {"scope", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":    {"cache-hit"},
-			"node-version": {"node-version"},
-		},
-	},
-	"actions/setup-python@v3": {
-		Name: "Setup Python",
-		Inputs: ActionMetadataInputs{
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"python-version":        {"python-version", false},
-			"token":                 {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":      {"cache-hit"},
-			"python-version": {"python-version"},
-		},
-	},
-	"actions/setup-python@v4": {
-		Name: "Setup Python",
-		Inputs: ActionMetadataInputs{
-			"allow-prereleases":     {"allow-prereleases", false},
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"python-version":        {"python-version", false},
-			"python-version-file":   {"python-version-file", false},
-			"token":                 {"token", false},
-			"update-environment":    {"update-environment", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":      {"cache-hit"},
-			"python-path":    {"python-path"},
-			"python-version": {"python-version"},
-		},
-	},
-	"actions/setup-python@v5": {
-		Name: "Setup Python",
-		Inputs: ActionMetadataInputs{
-			"allow-prereleases":     {"allow-prereleases", false},
-			"architecture":          {"architecture", false},
-			"cache":                 {"cache", false},
-			"cache-dependency-path": {"cache-dependency-path", false},
-			"check-latest":          {"check-latest", false},
-			"python-version":        {"python-version", false},
-			"python-version-file":   {"python-version-file", false},
-			"token":                 {"token", false},
-			"update-environment":    {"update-environment", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache-hit":      {"cache-hit"},
-			"python-path":    {"python-path"},
-			"python-version": {"python-version"},
-		},
-	},
-	"actions/stale@v5": {
-		Name: "Close Stale Issues",
-		Inputs: ActionMetadataInputs{
-			"any-of-issue-labels":             {"any-of-issue-labels", false},
-			"any-of-labels":                   {"any-of-labels", false},
-			"any-of-pr-labels":                {"any-of-pr-labels", false},
-			"ascending":                       {"ascending", false},
-			"close-issue-label":               {"close-issue-label", false},
-			"close-issue-message":             {"close-issue-message", false},
-			"close-issue-reason":              {"close-issue-reason", false},
-			"close-pr-label":                  {"close-pr-label", false},
-			"close-pr-message":                {"close-pr-message", false},
-			"days-before-close":               {"days-before-close", false},
-			"days-before-issue-close":         {"days-before-issue-close", false},
-			"days-before-issue-stale":         {"days-before-issue-stale", false},
-			"days-before-pr-close":            {"days-before-pr-close", false},
-			"days-before-pr-stale":            {"days-before-pr-stale", false},
-			"days-before-stale":               {"days-before-stale", false},
-			"debug-only":                      {"debug-only", false},
-			"delete-branch":                   {"delete-branch", false},
-			"enable-statistics":               {"enable-statistics", false},
-			"exempt-all-assignees":            {"exempt-all-assignees", false},
-			"exempt-all-issue-assignees":      {"exempt-all-issue-assignees", false},
-			"exempt-all-issue-milestones":     {"exempt-all-issue-milestones", false},
-			"exempt-all-milestones":           {"exempt-all-milestones", false},
-			"exempt-all-pr-assignees":         {"exempt-all-pr-assignees", false},
-			"exempt-all-pr-milestones":        {"exempt-all-pr-milestones", false},
-			"exempt-assignees":                {"exempt-assignees", false},
-			"exempt-draft-pr":                 {"exempt-draft-pr", false},
-			"exempt-issue-assignees":          {"exempt-issue-assignees", false},
-			"exempt-issue-labels":             {"exempt-issue-labels", false},
-			"exempt-issue-milestones":         {"exempt-issue-milestones", false},
-			"exempt-milestones":               {"exempt-milestones", false},
-			"exempt-pr-assignees":             {"exempt-pr-assignees", false},
-			"exempt-pr-labels":                {"exempt-pr-labels", false},
-			"exempt-pr-milestones":            {"exempt-pr-milestones", false},
-			"ignore-issue-updates":            {"ignore-issue-updates", false},
-			"ignore-pr-updates":               {"ignore-pr-updates", false},
-			"ignore-updates":                  {"ignore-updates", false},
-			"include-only-assigned":           {"include-only-assigned", false},
-			"labels-to-add-when-unstale":      {"labels-to-add-when-unstale", false},
-			"labels-to-remove-when-unstale":   {"labels-to-remove-when-unstale", false},
-			"only-issue-labels":               {"only-issue-labels", false},
-			"only-labels":                     {"only-labels", false},
-			"only-pr-labels":                  {"only-pr-labels", false},
-			"operations-per-run":              {"operations-per-run", false},
-			"remove-issue-stale-when-updated": {"remove-issue-stale-when-updated", false},
-			"remove-pr-stale-when-updated":    {"remove-pr-stale-when-updated", false},
-			"remove-stale-when-updated":       {"remove-stale-when-updated", false},
-			"repo-token":                      {"repo-token", false},
-			"stale-issue-label":               {"stale-issue-label", false},
-			"stale-issue-message":             {"stale-issue-message", false},
-			"stale-pr-label":                  {"stale-pr-label", false},
-			"stale-pr-message":                {"stale-pr-message", false},
-			"start-date":                      {"start-date", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"closed-issues-prs": {"closed-issues-prs"},
-			"staled-issues-prs": {"staled-issues-prs"},
-		},
-	},
-	"actions/stale@v6": {
-		Name: "Close Stale Issues",
-		Inputs: ActionMetadataInputs{
-			"any-of-issue-labels":             {"any-of-issue-labels", false},
-			"any-of-labels":                   {"any-of-labels", false},
-			"any-of-pr-labels":                {"any-of-pr-labels", false},
-			"ascending":                       {"ascending", false},
-			"close-issue-label":               {"close-issue-label", false},
-			"close-issue-message":             {"close-issue-message", false},
-			"close-issue-reason":              {"close-issue-reason", false},
-			"close-pr-label":                  {"close-pr-label", false},
-			"close-pr-message":                {"close-pr-message", false},
-			"days-before-close":               {"days-before-close", false},
-			"days-before-issue-close":         {"days-before-issue-close", false},
-			"days-before-issue-stale":         {"days-before-issue-stale", false},
-			"days-before-pr-close":            {"days-before-pr-close", false},
-			"days-before-pr-stale":            {"days-before-pr-stale", false},
-			"days-before-stale":               {"days-before-stale", false},
-			"debug-only":                      {"debug-only", false},
-			"delete-branch":                   {"delete-branch", false},
-			"enable-statistics":               {"enable-statistics", false},
-			"exempt-all-assignees":            {"exempt-all-assignees", false},
-			"exempt-all-issue-assignees":      {"exempt-all-issue-assignees", false},
-			"exempt-all-issue-milestones":     {"exempt-all-issue-milestones", false},
-			"exempt-all-milestones":           {"exempt-all-milestones", false},
-			"exempt-all-pr-assignees":         {"exempt-all-pr-assignees", false},
-			"exempt-all-pr-milestones":        {"exempt-all-pr-milestones", false},
-			"exempt-assignees":                {"exempt-assignees", false},
-			"exempt-draft-pr":                 {"exempt-draft-pr", false},
-			"exempt-issue-assignees":          {"exempt-issue-assignees", false},
-			"exempt-issue-labels":             {"exempt-issue-labels", false},
-			"exempt-issue-milestones":         {"exempt-issue-milestones", false},
-			"exempt-milestones":               {"exempt-milestones", false},
-			"exempt-pr-assignees":             {"exempt-pr-assignees", false},
-			"exempt-pr-labels":                {"exempt-pr-labels", false},
-			"exempt-pr-milestones":            {"exempt-pr-milestones", false},
-			"ignore-issue-updates":            {"ignore-issue-updates", false},
-			"ignore-pr-updates":               {"ignore-pr-updates", false},
-			"ignore-updates":                  {"ignore-updates", false},
-			"include-only-assigned":           {"include-only-assigned", false},
-			"labels-to-add-when-unstale":      {"labels-to-add-when-unstale", false},
-			"labels-to-remove-when-unstale":   {"labels-to-remove-when-unstale", false},
-			"only-issue-labels":               {"only-issue-labels", false},
-			"only-labels":                     {"only-labels", false},
-			"only-pr-labels":                  {"only-pr-labels", false},
-			"operations-per-run":              {"operations-per-run", false},
-			"remove-issue-stale-when-updated": {"remove-issue-stale-when-updated", false},
-			"remove-pr-stale-when-updated":    {"remove-pr-stale-when-updated", false},
-			"remove-stale-when-updated":       {"remove-stale-when-updated", false},
-			"repo-token":                      {"repo-token", false},
-			"stale-issue-label":               {"stale-issue-label", false},
-			"stale-issue-message":             {"stale-issue-message", false},
-			"stale-pr-label":                  {"stale-pr-label", false},
-			"stale-pr-message":                {"stale-pr-message", false},
-			"start-date":                      {"start-date", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"closed-issues-prs": {"closed-issues-prs"},
-			"staled-issues-prs": {"staled-issues-prs"},
-		},
-	},
-	"actions/stale@v7": {
-		Name: "Close Stale Issues",
-		Inputs: ActionMetadataInputs{
-			"any-of-issue-labels":             {"any-of-issue-labels", false},
-			"any-of-labels":                   {"any-of-labels", false},
-			"any-of-pr-labels":                {"any-of-pr-labels", false},
-			"ascending":                       {"ascending", false},
-			"close-issue-label":               {"close-issue-label", false},
-			"close-issue-message":             {"close-issue-message", false},
-			"close-issue-reason":              {"close-issue-reason", false},
-			"close-pr-label":                  {"close-pr-label", false},
-			"close-pr-message":                {"close-pr-message", false},
-			"days-before-close":               {"days-before-close", false},
-			"days-before-issue-close":         {"days-before-issue-close", false},
-			"days-before-issue-stale":         {"days-before-issue-stale", false},
-			"days-before-pr-close":            {"days-before-pr-close", false},
-			"days-before-pr-stale":            {"days-before-pr-stale", false},
-			"days-before-stale":               {"days-before-stale", false},
-			"debug-only":                      {"debug-only", false},
-			"delete-branch":                   {"delete-branch", false},
-			"enable-statistics":               {"enable-statistics", false},
-			"exempt-all-assignees":            {"exempt-all-assignees", false},
-			"exempt-all-issue-assignees":      {"exempt-all-issue-assignees", false},
-			"exempt-all-issue-milestones":     {"exempt-all-issue-milestones", false},
-			"exempt-all-milestones":           {"exempt-all-milestones", false},
-			"exempt-all-pr-assignees":         {"exempt-all-pr-assignees", false},
-			"exempt-all-pr-milestones":        {"exempt-all-pr-milestones", false},
-			"exempt-assignees":                {"exempt-assignees", false},
-			"exempt-draft-pr":                 {"exempt-draft-pr", false},
-			"exempt-issue-assignees":          {"exempt-issue-assignees", false},
-			"exempt-issue-labels":             {"exempt-issue-labels", false},
-			"exempt-issue-milestones":         {"exempt-issue-milestones", false},
-			"exempt-milestones":               {"exempt-milestones", false},
-			"exempt-pr-assignees":             {"exempt-pr-assignees", false},
-			"exempt-pr-labels":                {"exempt-pr-labels", false},
-			"exempt-pr-milestones":            {"exempt-pr-milestones", false},
-			"ignore-issue-updates":            {"ignore-issue-updates", false},
-			"ignore-pr-updates":               {"ignore-pr-updates", false},
-			"ignore-updates":                  {"ignore-updates", false},
-			"include-only-assigned":           {"include-only-assigned", false},
-			"labels-to-add-when-unstale":      {"labels-to-add-when-unstale", false},
-			"labels-to-remove-when-unstale":   {"labels-to-remove-when-unstale", false},
-			"only-issue-labels":               {"only-issue-labels", false},
-			"only-labels":                     {"only-labels", false},
-			"only-pr-labels":                  {"only-pr-labels", false},
-			"operations-per-run":              {"operations-per-run", false},
-			"remove-issue-stale-when-updated": {"remove-issue-stale-when-updated", false},
-			"remove-pr-stale-when-updated":    {"remove-pr-stale-when-updated", false},
-			"remove-stale-when-updated":       {"remove-stale-when-updated", false},
-			"repo-token":                      {"repo-token", false},
-			"stale-issue-label":               {"stale-issue-label", false},
-			"stale-issue-message":             {"stale-issue-message", false},
-			"stale-pr-label":                  {"stale-pr-label", false},
-			"stale-pr-message":                {"stale-pr-message", false},
-			"start-date":                      {"start-date", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"closed-issues-prs": {"closed-issues-prs"},
-			"staled-issues-prs": {"staled-issues-prs"},
-		},
-	},
-	"actions/stale@v8": {
-		Name: "Close Stale Issues",
-		Inputs: ActionMetadataInputs{
-			"any-of-issue-labels":             {"any-of-issue-labels", false},
-			"any-of-labels":                   {"any-of-labels", false},
-			"any-of-pr-labels":                {"any-of-pr-labels", false},
-			"ascending":                       {"ascending", false},
-			"close-issue-label":               {"close-issue-label", false},
-			"close-issue-message":             {"close-issue-message", false},
-			"close-issue-reason":              {"close-issue-reason", false},
-			"close-pr-label":                  {"close-pr-label", false},
-			"close-pr-message":                {"close-pr-message", false},
-			"days-before-close":               {"days-before-close", false},
-			"days-before-issue-close":         {"days-before-issue-close", false},
-			"days-before-issue-stale":         {"days-before-issue-stale", false},
-			"days-before-pr-close":            {"days-before-pr-close", false},
-			"days-before-pr-stale":            {"days-before-pr-stale", false},
-			"days-before-stale":               {"days-before-stale", false},
-			"debug-only":                      {"debug-only", false},
-			"delete-branch":                   {"delete-branch", false},
-			"enable-statistics":               {"enable-statistics", false},
-			"exempt-all-assignees":            {"exempt-all-assignees", false},
-			"exempt-all-issue-assignees":      {"exempt-all-issue-assignees", false},
-			"exempt-all-issue-milestones":     {"exempt-all-issue-milestones", false},
-			"exempt-all-milestones":           {"exempt-all-milestones", false},
-			"exempt-all-pr-assignees":         {"exempt-all-pr-assignees", false},
-			"exempt-all-pr-milestones":        {"exempt-all-pr-milestones", false},
-			"exempt-assignees":                {"exempt-assignees", false},
-			"exempt-draft-pr":                 {"exempt-draft-pr", false},
-			"exempt-issue-assignees":          {"exempt-issue-assignees", false},
-			"exempt-issue-labels":             {"exempt-issue-labels", false},
-			"exempt-issue-milestones":         {"exempt-issue-milestones", false},
-			"exempt-milestones":               {"exempt-milestones", false},
-			"exempt-pr-assignees":             {"exempt-pr-assignees", false},
-			"exempt-pr-labels":                {"exempt-pr-labels", false},
-			"exempt-pr-milestones":            {"exempt-pr-milestones", false},
-			"ignore-issue-updates":            {"ignore-issue-updates", false},
-			"ignore-pr-updates":               {"ignore-pr-updates", false},
-			"ignore-updates":                  {"ignore-updates", false},
-			"include-only-assigned":           {"include-only-assigned", false},
-			"labels-to-add-when-unstale":      {"labels-to-add-when-unstale", false},
-			"labels-to-remove-when-stale":     {"labels-to-remove-when-stale", false},
-			"labels-to-remove-when-unstale":   {"labels-to-remove-when-unstale", false},
-			"only-issue-labels":               {"only-issue-labels", false},
-			"only-labels":                     {"only-labels", false},
-			"only-pr-labels":                  {"only-pr-labels", false},
-			"operations-per-run":              {"operations-per-run", false},
-			"remove-issue-stale-when-updated": {"remove-issue-stale-when-updated", false},
-			"remove-pr-stale-when-updated":    {"remove-pr-stale-when-updated", false},
-			"remove-stale-when-updated":       {"remove-stale-when-updated", false},
-			"repo-token":                      {"repo-token", false},
-			"stale-issue-label":               {"stale-issue-label", false},
-			"stale-issue-message":             {"stale-issue-message", false},
-			"stale-pr-label":                  {"stale-pr-label", false},
-			"stale-pr-message":                {"stale-pr-message", false},
-			"start-date":                      {"start-date", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"closed-issues-prs": {"closed-issues-prs"},
-			"staled-issues-prs": {"staled-issues-prs"},
-		},
-	},
-	"actions/stale@v9": {
-		Name: "Close Stale Issues",
-		Inputs: ActionMetadataInputs{
-			"any-of-issue-labels":             {"any-of-issue-labels", false},
-			"any-of-labels":                   {"any-of-labels", false},
-			"any-of-pr-labels":                {"any-of-pr-labels", false},
-			"ascending":                       {"ascending", false},
-			"close-issue-label":               {"close-issue-label", false},
-			"close-issue-message":             {"close-issue-message", false},
-			"close-issue-reason":              {"close-issue-reason", false},
-			"close-pr-label":                  {"close-pr-label", false},
-			"close-pr-message":                {"close-pr-message", false},
-			"days-before-close":               {"days-before-close", false},
-			"days-before-issue-close":         {"days-before-issue-close", false},
-			"days-before-issue-stale":         {"days-before-issue-stale", false},
-			"days-before-pr-close":            {"days-before-pr-close", false},
-			"days-before-pr-stale":            {"days-before-pr-stale", false},
-			"days-before-stale":               {"days-before-stale", false},
-			"debug-only":                      {"debug-only", false},
-			"delete-branch":                   {"delete-branch", false},
-			"enable-statistics":               {"enable-statistics", false},
-			"exempt-all-assignees":            {"exempt-all-assignees", false},
-			"exempt-all-issue-assignees":      {"exempt-all-issue-assignees", false},
-			"exempt-all-issue-milestones":     {"exempt-all-issue-milestones", false},
-			"exempt-all-milestones":           {"exempt-all-milestones", false},
-			"exempt-all-pr-assignees":         {"exempt-all-pr-assignees", false},
-			"exempt-all-pr-milestones":        {"exempt-all-pr-milestones", false},
-			"exempt-assignees":                {"exempt-assignees", false},
-			"exempt-draft-pr":                 {"exempt-draft-pr", false},
-			"exempt-issue-assignees":          {"exempt-issue-assignees", false},
-			"exempt-issue-labels":             {"exempt-issue-labels", false},
-			"exempt-issue-milestones":         {"exempt-issue-milestones", false},
-			"exempt-milestones":               {"exempt-milestones", false},
-			"exempt-pr-assignees":             {"exempt-pr-assignees", false},
-			"exempt-pr-labels":                {"exempt-pr-labels", false},
-			"exempt-pr-milestones":            {"exempt-pr-milestones", false},
-			"ignore-issue-updates":            {"ignore-issue-updates", false},
-			"ignore-pr-updates":               {"ignore-pr-updates", false},
-			"ignore-updates":                  {"ignore-updates", false},
-			"include-only-assigned":           {"include-only-assigned", false},
-			"labels-to-add-when-unstale":      {"labels-to-add-when-unstale", false},
-			"labels-to-remove-when-stale":     {"labels-to-remove-when-stale", false},
-			"labels-to-remove-when-unstale":   {"labels-to-remove-when-unstale", false},
-			"only-issue-labels":               {"only-issue-labels", false},
-			"only-labels":                     {"only-labels", false},
-			"only-pr-labels":                  {"only-pr-labels", false},
-			"operations-per-run":              {"operations-per-run", false},
-			"remove-issue-stale-when-updated": {"remove-issue-stale-when-updated", false},
-			"remove-pr-stale-when-updated":    {"remove-pr-stale-when-updated", false},
-			"remove-stale-when-updated":       {"remove-stale-when-updated", false},
-			"repo-token":                      {"repo-token", false},
-			"stale-issue-label":               {"stale-issue-label", false},
-			"stale-issue-message":             {"stale-issue-message", false},
-			"stale-pr-label":                  {"stale-pr-label", false},
-			"stale-pr-message":                {"stale-pr-message", false},
-			"start-date":                      {"start-date", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"closed-issues-prs": {"closed-issues-prs"},
-			"staled-issues-prs": {"staled-issues-prs"},
-		},
-	},
-	"actions/upload-artifact@v1": {
-		Name: "Upload a Build Artifact",
-		Inputs: ActionMetadataInputs{
-			"name": {"name", true},
-			"path": {"path", true},
-		},
-	},
-	"actions/upload-artifact@v3": {
-		Name: "Upload a Build Artifact",
-		Inputs: ActionMetadataInputs{
-			"if-no-files-found": {"if-no-files-found", false},
-			"name":              {"name", false},
-			"path":              {"path", true},
-			"retention-days":    {"retention-days", false},
-		},
-	},
-	"actions/upload-artifact@v4": {
-		Name: "Upload a Build Artifact",
-		Inputs: ActionMetadataInputs{
-			"compression-level": {"compression-level", false},
-			"if-no-files-found": {"if-no-files-found", false},
-			"name":              {"name", false},
-			"overwrite":         {"overwrite", false},
-			"path":              {"path", true},
-			"retention-days":    {"retention-days", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"artifact-id":  {"artifact-id"},
-			"artifact-url": {"artifact-url"},
-		},
-	},
-	"actions/upload-pages-artifact@v1": {
-		Name: "Upload GitHub Pages artifact",
-		Inputs: ActionMetadataInputs{
-			"name":           {"name", false},
-			"path":           {"path", false},
-			"retention-days": {"retention-days", false},
-		},
-	},
-	"actions/upload-pages-artifact@v2": {
-		Name: "Upload GitHub Pages artifact",
-		Inputs: ActionMetadataInputs{
-			"name":           {"name", false},
-			"path":           {"path", false},
-			"retention-days": {"retention-days", false},
-		},
-	},
-	"actions/upload-pages-artifact@v3": {
-		Name: "Upload GitHub Pages artifact",
-		Inputs: ActionMetadataInputs{
-			"name":           {"name", false},
-			"path":           {"path", false},
-			"retention-days": {"retention-days", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"artifact_id": {"artifact_id"},
-		},
-	},
-	"aws-actions/configure-aws-credentials@v2": {
-		Name: "Configure AWS Credentials For GitHub Actions",
-		Inputs: ActionMetadataInputs{
-			"audience":                  {"audience", false},
-			"aws-access-key-id":         {"aws-access-key-id", false},
-			"aws-region":                {"aws-region", true},
-			"aws-secret-access-key":     {"aws-secret-access-key", false},
-			"aws-session-token":         {"aws-session-token", false},
-			"http-proxy":                {"http-proxy", false},
-			"inline-session-policy":     {"inline-session-policy", false},
-			"managed-session-policies":  {"managed-session-policies", false},
-			"mask-aws-account-id":       {"mask-aws-account-id", false},
-			"role-chaining":             {"role-chaining", false},
-			"role-duration-seconds":     {"role-duration-seconds", false},
-			"role-external-id":          {"role-external-id", false},
-			"role-session-name":         {"role-session-name", false},
-			"role-skip-session-tagging": {"role-skip-session-tagging", false},
-			"role-to-assume":            {"role-to-assume", false},
-			"web-identity-token-file":   {"web-identity-token-file", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"aws-account-id": {"aws-account-id"},
-		},
-	},
-	"aws-actions/configure-aws-credentials@v3": {
-		Name: "\"Configure AWS Credentials\" Action for GitHub Actions",
-		Inputs: ActionMetadataInputs{
-			"audience":                      {"audience", false},
-			"aws-access-key-id":             {"aws-access-key-id", false},
-			"aws-region":                    {"aws-region", true},
-			"aws-secret-access-key":         {"aws-secret-access-key", false},
-			"aws-session-token":             {"aws-session-token", false},
-			"disable-retry":                 {"disable-retry", false},
-			"http-proxy":                    {"http-proxy", false},
-			"inline-session-policy":         {"inline-session-policy", false},
-			"managed-session-policies":      {"managed-session-policies", false},
-			"mask-aws-account-id":           {"mask-aws-account-id", false},
-			"output-credentials":            {"output-credentials", false},
-			"retry-max-attempts":            {"retry-max-attempts", false},
-			"role-chaining":                 {"role-chaining", false},
-			"role-duration-seconds":         {"role-duration-seconds", false},
-			"role-external-id":              {"role-external-id", false},
-			"role-session-name":             {"role-session-name", false},
-			"role-skip-session-tagging":     {"role-skip-session-tagging", false},
-			"role-to-assume":                {"role-to-assume", false},
-			"special-characters-workaround": {"special-characters-workaround", false},
-			"unset-current-credentials":     {"unset-current-credentials", false},
-			"web-identity-token-file":       {"web-identity-token-file", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"aws-access-key-id":     {"aws-access-key-id"},
-			"aws-account-id":        {"aws-account-id"},
-			"aws-secret-access-key": {"aws-secret-access-key"},
-			"aws-session-token":     {"aws-session-token"},
-		},
-	},
-	"aws-actions/configure-aws-credentials@v4": {
-		Name: "\"Configure AWS Credentials\" Action for GitHub Actions",
-		Inputs: ActionMetadataInputs{
-			"audience":                      {"audience", false},
-			"aws-access-key-id":             {"aws-access-key-id", false},
-			"aws-region":                    {"aws-region", true},
-			"aws-secret-access-key":         {"aws-secret-access-key", false},
-			"aws-session-token":             {"aws-session-token", false},
-			"disable-retry":                 {"disable-retry", false},
-			"http-proxy":                    {"http-proxy", false},
-			"inline-session-policy":         {"inline-session-policy", false},
-			"managed-session-policies":      {"managed-session-policies", false},
-			"mask-aws-account-id":           {"mask-aws-account-id", false},
-			"output-credentials":            {"output-credentials", false},
-			"retry-max-attempts":            {"retry-max-attempts", false},
-			"role-chaining":                 {"role-chaining", false},
-			"role-duration-seconds":         {"role-duration-seconds", false},
-			"role-external-id":              {"role-external-id", false},
-			"role-session-name":             {"role-session-name", false},
-			"role-skip-session-tagging":     {"role-skip-session-tagging", false},
-			"role-to-assume":                {"role-to-assume", false},
-			"special-characters-workaround": {"special-characters-workaround", false},
-			"unset-current-credentials":     {"unset-current-credentials", false},
-			"web-identity-token-file":       {"web-identity-token-file", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"aws-access-key-id":     {"aws-access-key-id"},
-			"aws-account-id":        {"aws-account-id"},
-			"aws-secret-access-key": {"aws-secret-access-key"},
-			"aws-session-token":     {"aws-session-token"},
-		},
-	},
-	"azure/aks-set-context@v3": {
-		Name: "Azure Kubernetes set context",
-		Inputs: ActionMetadataInputs{
-			"admin":          {"admin", false},
-			"cluster-name":   {"cluster-name", true},
-			"resource-group": {"resource-group", true},
-			"subscription":   {"subscription", false},
-			"use-kubelogin":  {"use-kubelogin", false},
-		},
-	},
-	"azure/aks-set-context@v4": {
-		Name: "Azure Kubernetes set context",
-		Inputs: ActionMetadataInputs{
-			"admin":          {"admin", false},
-			"cluster-name":   {"cluster-name", true},
-			"public-fqdn":    {"public-fqdn", false},
-			"resource-group": {"resource-group", true},
-			"subscription":   {"subscription", false},
-			"use-kubelogin":  {"use-kubelogin", false},
-		},
-	},
-	"azure/login@v1": {
-		Name: "Azure Login",
-		Inputs: ActionMetadataInputs{
-			"allow-no-subscriptions": {"allow-no-subscriptions", false},
-			"audience":               {"audience", false},
-			"auth-type":              {"auth-type", false},
-			"client-id":              {"client-id", false},
-			"creds":                  {"creds", false},
-			"enable-azpssession":     {"enable-AzPSSession", false},
-			"environment":            {"environment", false},
-			"subscription-id":        {"subscription-id", false},
-			"tenant-id":              {"tenant-id", false},
-		},
-	},
-	"azure/login@v2": {
-		Name: "Azure Login",
-		Inputs: ActionMetadataInputs{
-			"allow-no-subscriptions": {"allow-no-subscriptions", false},
-			"audience":               {"audience", false},
-			"auth-type":              {"auth-type", false},
-			"client-id":              {"client-id", false},
-			"creds":                  {"creds", false},
-			"enable-azpssession":     {"enable-AzPSSession", false},
-			"environment":            {"environment", false},
-			"subscription-id":        {"subscription-id", false},
-			"tenant-id":              {"tenant-id", false},
-		},
-	},
-	"bahmutov/npm-install@v1": {
-		Name: "NPM or Yarn install with caching",
-		Inputs: ActionMetadataInputs{
-			"cache-key-prefix":  {"cache-key-prefix", false},
-			"install-command":   {"install-command", false},
-			"uselockfile":       {"useLockFile", false},
-			"userollingcache":   {"useRollingCache", false},
-			"working-directory": {"working-directory", false},
-		},
-	},
-	"codecov/codecov-action@v3": {
-		Name: "Codecov",
-		Inputs: ActionMetadataInputs{
-			"commit_parent":          {"commit_parent", false},
-			"directory":              {"directory", false},
-			"dry_run":                {"dry_run", false},
-			"env_vars":               {"env_vars", false},
-			"fail_ci_if_error":       {"fail_ci_if_error", false},
-			"file":                   {"file", false},
-			"files":                  {"files", false},
-			"flags":                  {"flags", false},
-			"full_report":            {"full_report", false},
-			"functionalities":        {"functionalities", false},
-			"gcov":                   {"gcov", false},
-			"gcov_args":              {"gcov_args", false},
-			"gcov_executable":        {"gcov_executable", false},
-			"gcov_ignore":            {"gcov_ignore", false},
-			"gcov_include":           {"gcov_include", false},
-			"move_coverage_to_trash": {"move_coverage_to_trash", false},
-			"name":                   {"name", false},
-			"network_filter":         {"network_filter", false},
-			"network_prefix":         {"network_prefix", false},
-			"os":                     {"os", false},
-			"override_branch":        {"override_branch", false},
-			"override_build":         {"override_build", false},
-			"override_commit":        {"override_commit", false},
-			"override_pr":            {"override_pr", false},
-			"override_tag":           {"override_tag", false},
-			"root_dir":               {"root_dir", false},
-			"slug":                   {"slug", false},
-			"swift":                  {"swift", false},
-			"swift_project":          {"swift_project", false},
-			"token":                  {"token", false},
-			"upstream_proxy":         {"upstream_proxy", false},
-			"url":                    {"url", false},
-			"verbose":                {"verbose", false},
-			"version":                {"version", false},
-			"working-directory":      {"working-directory", false},
-			"xcode":                  {"xcode", false},
-			"xcode_archive_path":     {"xcode_archive_path", false},
-			"xtra_args":              {"xtra_args", false},
-		},
-	},
-	"codecov/codecov-action@v4": {
-		Name: "Codecov",
-		Inputs: ActionMetadataInputs{
-			"codecov_yml_path":           {"codecov_yml_path", false},
-			"commit_parent":              {"commit_parent", false},
-			"directory":                  {"directory", false},
-			"disable_file_fixes":         {"disable_file_fixes", false},
-			"disable_safe_directory":     {"disable_safe_directory", false},
-			"disable_search":             {"disable_search", false},
-			"dry_run":                    {"dry_run", false},
-			"env_vars":                   {"env_vars", false},
-			"exclude":                    {"exclude", false},
-			"fail_ci_if_error":           {"fail_ci_if_error", false},
-			"file":                       {"file", false},
-			"files":                      {"files", false},
-			"flags":                      {"flags", false},
-			"git_service":                {"git_service", false},
-			"handle_no_reports_found":    {"handle_no_reports_found", false},
-			"job_code":                   {"job_code", false},
-			"name":                       {"name", false},
-			"network_filter":             {"network_filter", false},
-			"network_prefix":             {"network_prefix", false},
-			"os":                         {"os", false},
-			"override_branch":            {"override_branch", false},
-			"override_build":             {"override_build", false},
-			"override_build_url":         {"override_build_url", false},
-			"override_commit":            {"override_commit", false},
-			"override_pr":                {"override_pr", false},
-			"plugin":                     {"plugin", false},
-			"plugins":                    {"plugins", false},
-			"report_code":                {"report_code", false},
-			"root_dir":                   {"root_dir", false},
-			"slug":                       {"slug", false},
-			"token":                      {"token", false},
-			"url":                        {"url", false},
-			"use_legacy_upload_endpoint": {"use_legacy_upload_endpoint", false},
-			"use_oidc":                   {"use_oidc", false},
-			"verbose":                    {"verbose", false},
-			"version":                    {"version", false},
-			"working-directory":          {"working-directory", false},
-		},
-	},
-	"dawidd6/action-download-artifact@v2": {
-		Name: "Download workflow artifact",
-		Inputs: ActionMetadataInputs{
-			"allow_forks":          {"allow_forks", false},
-			"branch":               {"branch", false},
-			"check_artifacts":      {"check_artifacts", false},
-			"commit":               {"commit", false},
-			"dry_run":              {"dry_run", false},
-			"event":                {"event", false},
-			"github_token":         {"github_token", false},
-			"if_no_artifact_found": {"if_no_artifact_found", false},
-			"name":                 {"name", false},
-			"name_is_regexp":       {"name_is_regexp", false},
-			"path":                 {"path", false},
-			"pr":                   {"pr", false},
-			"repo":                 {"repo", false},
-			"run_id":               {"run_id", false},
-			"run_number":           {"run_number", false},
-			"search_artifacts":     {"search_artifacts", false},
-			"skip_unpack":          {"skip_unpack", false},
-			"workflow":             {"workflow", false},
-			"workflow_conclusion":  {"workflow_conclusion", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"artifacts":      {"artifacts"},
-			"dry_run":        {"dry_run"},
-			"error_message":  {"error_message"},
-			"found_artifact": {"found_artifact"},
-		},
-	},
-	"dawidd6/action-download-artifact@v3": {
-		Name: "Download workflow artifact",
-		Inputs: ActionMetadataInputs{
-			"allow_forks":          {"allow_forks", false},
-			"branch":               {"branch", false},
-			"check_artifacts":      {"check_artifacts", false},
-			"commit":               {"commit", false},
-			"dry_run":              {"dry_run", false},
-			"event":                {"event", false},
-			"github_token":         {"github_token", false},
-			"if_no_artifact_found": {"if_no_artifact_found", false},
-			"name":                 {"name", false},
-			"name_is_regexp":       {"name_is_regexp", false},
-			"path":                 {"path", false},
-			"pr":                   {"pr", false},
-			"repo":                 {"repo", false},
-			"run_id":               {"run_id", false},
-			"run_number":           {"run_number", false},
-			"search_artifacts":     {"search_artifacts", false},
-			"skip_unpack":          {"skip_unpack", false},
-			"workflow":             {"workflow", false},
-			"workflow_conclusion":  {"workflow_conclusion", false},
-			"workflow_search":      {"workflow_search", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"artifacts":      {"artifacts"},
-			"dry_run":        {"dry_run"},
-			"error_message":  {"error_message"},
-			"found_artifact": {"found_artifact"},
-		},
-	},
-	"dawidd6/action-send-mail@v1": {
-		Name: "Send email",
-		Inputs: ActionMetadataInputs{
-			"body":           {"body", true},
-			"content_type":   {"content_type", false},
-			"from":           {"from", true},
-			"password":       {"password", true},
-			"server_address": {"server_address", true},
-			"server_port":    {"server_port", true},
-			"subject":        {"subject", true},
-			"to":             {"to", true},
-			"username":       {"username", true},
-		},
-	},
-	"dawidd6/action-send-mail@v3": {
-		Name: "Send email",
-		Inputs: ActionMetadataInputs{
-			"attachments":      {"attachments", false},
-			"bcc":              {"bcc", false},
-			"body":             {"body", false},
-			"cc":               {"cc", false},
-			"connection_url":   {"connection_url", false},
-			"convert_markdown": {"convert_markdown", false},
-			"from":             {"from", true},
-			"html_body":        {"html_body", false},
-			"ignore_cert":      {"ignore_cert", false},
-			"in_reply_to":      {"in_reply_to", false},
-			"nodemailerdebug":  {"nodemailerdebug", false},
-			"nodemailerlog":    {"nodemailerlog", false},
-			"password":         {"password", false},
-			"priority":         {"priority", false},
-			"reply_to":         {"reply_to", false},
-			"secure":           {"secure", false},
-			"server_address":   {"server_address", false},
-			"server_port":      {"server_port", false},
-			"subject":          {"subject", true},
-			"to":               {"to", false},
-			"username":         {"username", false},
-		},
-	},
-	"dessant/lock-threads@v4": {
-		Name: "Lock Threads",
-		Inputs: ActionMetadataInputs{
-			"add-issue-labels":              {"add-issue-labels", false},
-			"add-pr-labels":                 {"add-pr-labels", false},
-			"exclude-any-issue-labels":      {"exclude-any-issue-labels", false},
-			"exclude-any-pr-labels":         {"exclude-any-pr-labels", false},
-			"exclude-issue-closed-after":    {"exclude-issue-closed-after", false},
-			"exclude-issue-closed-before":   {"exclude-issue-closed-before", false},
-			"exclude-issue-closed-between":  {"exclude-issue-closed-between", false},
-			"exclude-issue-created-after":   {"exclude-issue-created-after", false},
-			"exclude-issue-created-before":  {"exclude-issue-created-before", false},
-			"exclude-issue-created-between": {"exclude-issue-created-between", false},
-			"exclude-pr-closed-after":       {"exclude-pr-closed-after", false},
-			"exclude-pr-closed-before":      {"exclude-pr-closed-before", false},
-			"exclude-pr-closed-between":     {"exclude-pr-closed-between", false},
-			"exclude-pr-created-after":      {"exclude-pr-created-after", false},
-			"exclude-pr-created-before":     {"exclude-pr-created-before", false},
-			"exclude-pr-created-between":    {"exclude-pr-created-between", false},
-			"github-token":                  {"github-token", false},
-			"include-all-issue-labels":      {"include-all-issue-labels", false},
-			"include-all-pr-labels":         {"include-all-pr-labels", false},
-			"include-any-issue-labels":      {"include-any-issue-labels", false},
-			"include-any-pr-labels":         {"include-any-pr-labels", false},
-			"issue-comment":                 {"issue-comment", false},
-			"issue-inactive-days":           {"issue-inactive-days", false},
-			"issue-lock-reason":             {"issue-lock-reason", false},
-			"log-output":                    {"log-output", false},
-			"pr-comment":                    {"pr-comment", false},
-			"pr-inactive-days":              {"pr-inactive-days", false},
-			"pr-lock-reason":                {"pr-lock-reason", false},
-			"process-only":                  {"process-only", false},
-			"remove-issue-labels":           {"remove-issue-labels", false},
-			"remove-pr-labels":              {"remove-pr-labels", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"issues": {"issues"},
-			"prs":    {"prs"},
-		},
-	},
-	"dessant/lock-threads@v5": {
-		Name: "Lock Threads",
-		Inputs: ActionMetadataInputs{
-			"add-discussion-labels":              {"add-discussion-labels", false},
-			"add-issue-labels":                   {"add-issue-labels", false},
-			"add-pr-labels":                      {"add-pr-labels", false},
-			"discussion-comment":                 {"discussion-comment", false},
-			"discussion-inactive-days":           {"discussion-inactive-days", false},
-			"exclude-any-discussion-labels":      {"exclude-any-discussion-labels", false},
-			"exclude-any-issue-labels":           {"exclude-any-issue-labels", false},
-			"exclude-any-pr-labels":              {"exclude-any-pr-labels", false},
-			"exclude-discussion-closed-after":    {"exclude-discussion-closed-after", false},
-			"exclude-discussion-closed-before":   {"exclude-discussion-closed-before", false},
-			"exclude-discussion-closed-between":  {"exclude-discussion-closed-between", false},
-			"exclude-discussion-created-after":   {"exclude-discussion-created-after", false},
-			"exclude-discussion-created-before":  {"exclude-discussion-created-before", false},
-			"exclude-discussion-created-between": {"exclude-discussion-created-between", false},
-			"exclude-issue-closed-after":         {"exclude-issue-closed-after", false},
-			"exclude-issue-closed-before":        {"exclude-issue-closed-before", false},
-			"exclude-issue-closed-between":       {"exclude-issue-closed-between", false},
-			"exclude-issue-created-after":        {"exclude-issue-created-after", false},
-			"exclude-issue-created-before":       {"exclude-issue-created-before", false},
-			"exclude-issue-created-between":      {"exclude-issue-created-between", false},
-			"exclude-pr-closed-after":            {"exclude-pr-closed-after", false},
-			"exclude-pr-closed-before":           {"exclude-pr-closed-before", false},
-			"exclude-pr-closed-between":          {"exclude-pr-closed-between", false},
-			"exclude-pr-created-after":           {"exclude-pr-created-after", false},
-			"exclude-pr-created-before":          {"exclude-pr-created-before", false},
-			"exclude-pr-created-between":         {"exclude-pr-created-between", false},
-			"github-token":                       {"github-token", false},
-			"include-all-discussion-labels":      {"include-all-discussion-labels", false},
-			"include-all-issue-labels":           {"include-all-issue-labels", false},
-			"include-all-pr-labels":              {"include-all-pr-labels", false},
-			"include-any-discussion-labels":      {"include-any-discussion-labels", false},
-			"include-any-issue-labels":           {"include-any-issue-labels", false},
-			"include-any-pr-labels":              {"include-any-pr-labels", false},
-			"issue-comment":                      {"issue-comment", false},
-			"issue-inactive-days":                {"issue-inactive-days", false},
-			"issue-lock-reason":                  {"issue-lock-reason", false},
-			"log-output":                         {"log-output", false},
-			"pr-comment":                         {"pr-comment", false},
-			"pr-inactive-days":                   {"pr-inactive-days", false},
-			"pr-lock-reason":                     {"pr-lock-reason", false},
-			"process-only":                       {"process-only", false},
-			"remove-discussion-labels":           {"remove-discussion-labels", false},
-			"remove-issue-labels":                {"remove-issue-labels", false},
-			"remove-pr-labels":                   {"remove-pr-labels", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"discussions": {"discussions"},
-			"issues":      {"issues"},
-			"prs":         {"prs"},
-		},
-	},
-	"docker/build-push-action@v1": {
-		Name: "Build and push Docker images",
-		Inputs: ActionMetadataInputs{
-			"add_git_labels": {"add_git_labels", false},
-			"always_pull":    {"always_pull", false},
-			"build_args":     {"build_args", false},
-			"cache_froms":    {"cache_froms", false},
-			"dockerfile":     {"dockerfile", false},
-			"labels":         {"labels", false},
-			"password":       {"password", false},
-			"path":           {"path", false},
-			"push":           {"push", false},
-			"registry":       {"registry", false},
-			"repository":     {"repository", true},
-			"tag_with_ref":   {"tag_with_ref", false},
-			"tag_with_sha":   {"tag_with_sha", false},
-			"tags":           {"tags", false},
-			"target":         {"target", false},
-			"username":       {"username", false},
-		},
-	},
-	"docker/build-push-action@v3": {
-		Name: "Build and push Docker images",
-		Inputs: ActionMetadataInputs{
-			"add-hosts":        {"add-hosts", false},
-			"allow":            {"allow", false},
-			"attests":          {"attests", false},
-			"build-args":       {"build-args", false},
-			"build-contexts":   {"build-contexts", false},
-			"builder":          {"builder", false},
-			"cache-from":       {"cache-from", false},
-			"cache-to":         {"cache-to", false},
-			"cgroup-parent":    {"cgroup-parent", false},
-			"context":          {"context", false},
-			"file":             {"file", false},
-			"github-token":     {"github-token", false},
-			"labels":           {"labels", false},
-			"load":             {"load", false},
-			"network":          {"network", false},
-			"no-cache":         {"no-cache", false},
-			"no-cache-filters": {"no-cache-filters", false},
-			"outputs":          {"outputs", false},
-			"platforms":        {"platforms", false},
-			"provenance":       {"provenance", false},
-			"pull":             {"pull", false},
-			"push":             {"push", false},
-			"sbom":             {"sbom", false},
-			"secret-files":     {"secret-files", false},
-			"secrets":          {"secrets", false},
-			"shm-size":         {"shm-size", false},
-			"ssh":              {"ssh", false},
-			"tags":             {"tags", false},
-			"target":           {"target", false},
-			"ulimit":           {"ulimit", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"digest":   {"digest"},
-			"imageid":  {"imageid"},
-			"metadata": {"metadata"},
-		},
-	},
-	"docker/build-push-action@v4": {
-		Name: "Build and push Docker images",
-		Inputs: ActionMetadataInputs{
-			"add-hosts":        {"add-hosts", false},
-			"allow":            {"allow", false},
-			"attests":          {"attests", false},
-			"build-args":       {"build-args", false},
-			"build-contexts":   {"build-contexts", false},
-			"builder":          {"builder", false},
-			"cache-from":       {"cache-from", false},
-			"cache-to":         {"cache-to", false},
-			"cgroup-parent":    {"cgroup-parent", false},
-			"context":          {"context", false},
-			"file":             {"file", false},
-			"github-token":     {"github-token", false},
-			"labels":           {"labels", false},
-			"load":             {"load", false},
-			"network":          {"network", false},
-			"no-cache":         {"no-cache", false},
-			"no-cache-filters": {"no-cache-filters", false},
-			"outputs":          {"outputs", false},
-			"platforms":        {"platforms", false},
-			"provenance":       {"provenance", false},
-			"pull":             {"pull", false},
-			"push":             {"push", false},
-			"sbom":             {"sbom", false},
-			"secret-files":     {"secret-files", false},
-			"secrets":          {"secrets", false},
-			"shm-size":         {"shm-size", false},
-			"ssh":              {"ssh", false},
-			"tags":             {"tags", false},
-			"target":           {"target", false},
-			"ulimit":           {"ulimit", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"digest":   {"digest"},
-			"imageid":  {"imageid"},
-			"metadata": {"metadata"},
-		},
-	},
-	"docker/build-push-action@v5": {
-		Name: "Build and push Docker images",
-		Inputs: ActionMetadataInputs{
-			"add-hosts":        {"add-hosts", false},
-			"allow":            {"allow", false},
-			"annotations":      {"annotations", false},
-			"attests":          {"attests", false},
-			"build-args":       {"build-args", false},
-			"build-contexts":   {"build-contexts", false},
-			"builder":          {"builder", false},
-			"cache-from":       {"cache-from", false},
-			"cache-to":         {"cache-to", false},
-			"cgroup-parent":    {"cgroup-parent", false},
-			"context":          {"context", false},
-			"file":             {"file", false},
-			"github-token":     {"github-token", false},
-			"labels":           {"labels", false},
-			"load":             {"load", false},
-			"network":          {"network", false},
-			"no-cache":         {"no-cache", false},
-			"no-cache-filters": {"no-cache-filters", false},
-			"outputs":          {"outputs", false},
-			"platforms":        {"platforms", false},
-			"provenance":       {"provenance", false},
-			"pull":             {"pull", false},
-			"push":             {"push", false},
-			"sbom":             {"sbom", false},
-			"secret-envs":      {"secret-envs", false},
-			"secret-files":     {"secret-files", false},
-			"secrets":          {"secrets", false},
-			"shm-size":         {"shm-size", false},
-			"ssh":              {"ssh", false},
-			"tags":             {"tags", false},
-			"target":           {"target", false},
-			"ulimit":           {"ulimit", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"digest":   {"digest"},
-			"imageid":  {"imageid"},
-			"metadata": {"metadata"},
-		},
-	},
-	"docker/login-action@v2": {
-		Name: "Docker Login",
-		Inputs: ActionMetadataInputs{
-			"ecr":      {"ecr", false},
-			"logout":   {"logout", false},
-			"password": {"password", false},
-			"registry": {"registry", false},
-			"username": {"username", false},
-		},
-	},
-	"docker/login-action@v3": {
-		Name: "Docker Login",
-		Inputs: ActionMetadataInputs{
-			"ecr":      {"ecr", false},
-			"logout":   {"logout", false},
-			"password": {"password", false},
-			"registry": {"registry", false},
-			"username": {"username", false},
-		},
-	},
-	"docker/metadata-action@v4": {
-		Name: "Docker Metadata action",
-		Inputs: ActionMetadataInputs{
-			"bake-target":  {"bake-target", false},
-			"context":      {"context", false},
-			"flavor":       {"flavor", false},
-			"github-token": {"github-token", false},
-			"images":       {"images", true},
-			"labels":       {"labels", false},
-			"sep-labels":   {"sep-labels", false},
-			"sep-tags":     {"sep-tags", false},
-			"tags":         {"tags", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"bake-file": {"bake-file"},
-			"json":      {"json"},
-			"labels":    {"labels"},
-			"tags":      {"tags"},
-			"version":   {"version"},
-		},
-	},
-	"docker/metadata-action@v5": {
-		Name: "Docker Metadata action",
-		Inputs: ActionMetadataInputs{
-			"annotations":     {"annotations", false},
-			"bake-target":     {"bake-target", false},
-			"context":         {"context", false},
-			"flavor":          {"flavor", false},
-			"github-token":    {"github-token", false},
-			"images":          {"images", false},
-			"labels":          {"labels", false},
-			"sep-annotations": {"sep-annotations", false},
-			"sep-labels":      {"sep-labels", false},
-			"sep-tags":        {"sep-tags", false},
-			"tags":            {"tags", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"annotations":           {"annotations"},
-			"bake-file":             {"bake-file"},
-			"bake-file-annotations": {"bake-file-annotations"},
-			"bake-file-labels":      {"bake-file-labels"},
-			"bake-file-tags":        {"bake-file-tags"},
-			"json":                  {"json"},
-			"labels":                {"labels"},
-			"tags":                  {"tags"},
-			"version":               {"version"},
-		},
-	},
-	"docker/setup-buildx-action@v2": {
-		Name: "Docker Setup Buildx",
-		Inputs: ActionMetadataInputs{
-			"append":          {"append", false},
-			"buildkitd-flags": {"buildkitd-flags", false},
-			"cleanup":         {"cleanup", false},
-			"config":          {"config", false},
-			"config-inline":   {"config-inline", false},
-			"driver":          {"driver", false},
-			"driver-opts":     {"driver-opts", false},
-			"endpoint":        {"endpoint", false},
-			"install":         {"install", false},
-			"platforms":       {"platforms", false},
-			"use":             {"use", false},
-			"version":         {"version", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"driver":    {"driver"},
-			"endpoint":  {"endpoint"},
-			"flags":     {"flags"},
-			"name":      {"name"},
-			"nodes":     {"nodes"},
-			"platforms": {"platforms"},
-			"status":    {"status"},
-		},
-	},
-	"docker/setup-buildx-action@v3": {
-		Name: "Docker Setup Buildx",
-		Inputs: ActionMetadataInputs{
-			"append":                  {"append", false},
-			"buildkitd-config":        {"buildkitd-config", false},
-			"buildkitd-config-inline": {"buildkitd-config-inline", false},
-			"buildkitd-flags":         {"buildkitd-flags", false},
-			"cache-binary":            {"cache-binary", false},
-			"cleanup":                 {"cleanup", false},
-			"config":                  {"config", false},
-			"config-inline":           {"config-inline", false},
-			"driver":                  {"driver", false},
-			"driver-opts":             {"driver-opts", false},
-			"endpoint":                {"endpoint", false},
-			"install":                 {"install", false},
-			"platforms":               {"platforms", false},
-			"use":                     {"use", false},
-			"version":                 {"version", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"driver":    {"driver"},
-			"endpoint":  {"endpoint"},
-			"flags":     {"flags"},
-			"name":      {"name"},
-			"nodes":     {"nodes"},
-			"platforms": {"platforms"},
-			"status":    {"status"},
-		},
-	},
-	"docker/setup-qemu-action@v2": {
-		Name: "Docker Setup QEMU",
-		Inputs: ActionMetadataInputs{
-			"image":     {"image", false},
-			"platforms": {"platforms", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"platforms": {"platforms"},
-		},
-	},
-	"docker/setup-qemu-action@v3": {
-		Name: "Docker Setup QEMU",
-		Inputs: ActionMetadataInputs{
-			"image":     {"image", false},
-			"platforms": {"platforms", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"platforms": {"platforms"},
-		},
-	},
-	"dorny/paths-filter@v2": {
-		Name: "Paths Changes Filter",
-		Inputs: ActionMetadataInputs{
-			"base":                {"base", false},
-			"filters":             {"filters", true},
-			"initial-fetch-depth": {"initial-fetch-depth", false},
-			"list-files":          {"list-files", false},
-			"ref":                 {"ref", false},
-			"token":               {"token", false},
-			"working-directory":   {"working-directory", false},
-		},
-		SkipOutputs: true,
-	},
-	"dorny/paths-filter@v3": {
-		Name: "Paths Changes Filter",
-		Inputs: ActionMetadataInputs{
-			"base":                 {"base", false},
-			"filters":              {"filters", true},
-			"initial-fetch-depth":  {"initial-fetch-depth", false},
-			"list-files":           {"list-files", false},
-			"predicate-quantifier": {"predicate-quantifier", false},
-			"ref":                  {"ref", false},
-			"token":                {"token", false},
-			"working-directory":    {"working-directory", false},
-		},
-		SkipOutputs: true,
-	},
-	"dtolnay/rust-toolchain@beta": {
-		Name: "rustup toolchain install",
-		Inputs: ActionMetadataInputs{
-			"components": {"components", false},
-			"target":     {"target", false},
-			"targets":    {"targets", false},
-			"toolchain":  {"toolchain", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cachekey": {"cachekey"},
-			"name":     {"name"},
-		},
-	},
-	"dtolnay/rust-toolchain@nightly": {
-		Name: "rustup toolchain install",
-		Inputs: ActionMetadataInputs{
-			"components": {"components", false},
-			"target":     {"target", false},
-			"targets":    {"targets", false},
-			"toolchain":  {"toolchain", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cachekey": {"cachekey"},
-			"name":     {"name"},
-		},
-	},
-	"dtolnay/rust-toolchain@stable": {
-		Name: "rustup toolchain install",
-		Inputs: ActionMetadataInputs{
-			"components": {"components", false},
-			"target":     {"target", false},
-			"targets":    {"targets", false},
-			"toolchain":  {"toolchain", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cachekey": {"cachekey"},
-			"name":     {"name"},
-		},
-	},
-	"enriikke/gatsby-gh-pages-action@v2": {
-		Name: "Gatsby Publish",
-		Inputs: ActionMetadataInputs{
-			"access-token":     {"access-token", false},
-			"commit-message":   {"commit-message", false},
-			"deploy-branch":    {"deploy-branch", false},
-			"deploy-repo":      {"deploy-repo", false},
-			"gatsby-args":      {"gatsby-args", false},
-			"git-config-email": {"git-config-email", false},
-			"git-config-name":  {"git-config-name", false},
-			"skip-publish":     {"skip-publish", false},
-			"working-dir":      {"working-dir", false},
-		},
-	},
-	"erlef/setup-beam@v1": {
-		Name: "Setup Erlang/OTP with optional Elixir (and mix) and/or rebar3",
-		Inputs: ActionMetadataInputs{
-			"disable_problem_matchers": {"disable_problem_matchers", false},
-			"elixir-version":           {"elixir-version", false},
-			"github-token":             {"github-token", false},
-			"gleam-version":            {"gleam-version", false},
-			"hexpm-mirrors":            {"hexpm-mirrors", false},
-			"install-hex":              {"install-hex", false},
-			"install-rebar":            {"install-rebar", false},
-			"otp-version":              {"otp-version", false},
-			"rebar3-version":           {"rebar3-version", false},
-			"version-file":             {"version-file", false},
-			"version-type":             {"version-type", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"elixir-version":     {"elixir-version"},
-			"gleam-version":      {"gleam-version"},
-			"otp-version":        {"otp-version"},
-			"rebar3-version":     {"rebar3-version"},
-			"setup-beam-version": {"setup-beam-version"},
-		},
-	},
-	"game-ci/unity-builder@v2": {
-		Name: "Unity - Builder",
-		Inputs: ActionMetadataInputs{
-			"allowdirtybuild":               {"allowDirtyBuild", false},
-			"androidappbundle":              {"androidAppBundle", false},
-			"androidkeyaliasname":           {"androidKeyaliasName", false},
-			"androidkeyaliaspass":           {"androidKeyaliasPass", false},
-			"androidkeystorebase64":         {"androidKeystoreBase64", false},
-			"androidkeystorename":           {"androidKeystoreName", false},
-			"androidkeystorepass":           {"androidKeystorePass", false},
-			"androidtargetsdkversion":       {"androidTargetSdkVersion", false},
-			"androidversioncode":            {"androidVersionCode", false},
-			"awsbasestackname":              {"awsBaseStackName", false},
-			"buildmethod":                   {"buildMethod", false},
-			"buildname":                     {"buildName", false},
-			"buildspath":                    {"buildsPath", false},
-			"cachekey":                      {"cacheKey", false},
-			"cachepulloverridecommand":      {"cachePullOverrideCommand", false},
-			"cachepushoverridecommand":      {"cachePushOverrideCommand", false},
-			"checkdependencyhealthoverride": {"checkDependencyHealthOverride", false},
-			"chownfilesto":                  {"chownFilesTo", false},
-			"cloudrunnercluster":            {"cloudRunnerCluster", false},
-			"cloudrunnercpu":                {"cloudRunnerCpu", false},
-			"cloudrunnermemory":             {"cloudRunnerMemory", false},
-			"customimage":                   {"customImage", false},
-			"customjob":                     {"customJob", false},
-			"customjobhooks":                {"customJobHooks", false},
-			"customparameters":              {"customParameters", false},
-			"gitprivatetoken":               {"gitPrivateToken", false},
-			"kubeconfig":                    {"kubeConfig", false},
-			"kubestorageclass":              {"kubeStorageClass", false},
-			"kubevolume":                    {"kubeVolume", false},
-			"kubevolumesize":                {"kubeVolumeSize", false},
-			"postbuildsteps":                {"postBuildSteps", false},
-			"prebuildsteps":                 {"preBuildSteps", false},
-			"projectpath":                   {"projectPath", false},
-			"readinputfromoverridelist":     {"readInputFromOverrideList", false},
-			"readinputoverridecommand":      {"readInputOverrideCommand", false},
-			"sshagent":                      {"sshAgent", false},
-			"startdependenciesoverride":     {"startDependenciesOverride", false},
-			"targetplatform":                {"targetPlatform", false},
-			"unityversion":                  {"unityVersion", false},
-			"version":                       {"version", false},
-			"versioning":                    {"versioning", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"buildversion": {"buildVersion"},
-			"volume":       {"volume"},
-		},
-	},
-	"game-ci/unity-builder@v3": {
-		Name: "Unity - Builder",
-		Inputs: ActionMetadataInputs{
-			"allowdirtybuild":             {"allowDirtyBuild", false},
-			"androidexporttype":           {"androidExportType", false},
-			"androidkeyaliasname":         {"androidKeyaliasName", false},
-			"androidkeyaliaspass":         {"androidKeyaliasPass", false},
-			"androidkeystorebase64":       {"androidKeystoreBase64", false},
-			"androidkeystorename":         {"androidKeystoreName", false},
-			"androidkeystorepass":         {"androidKeystorePass", false},
-			"androidsymboltype":           {"androidSymbolType", false},
-			"androidtargetsdkversion":     {"androidTargetSdkVersion", false},
-			"androidversioncode":          {"androidVersionCode", false},
-			"awsstackname":                {"awsStackName", false},
-			"buildmethod":                 {"buildMethod", false},
-			"buildname":                   {"buildName", false},
-			"buildspath":                  {"buildsPath", false},
-			"cachekey":                    {"cacheKey", false},
-			"cacheunityinstallationonmac": {"cacheUnityInstallationOnMac", false},
-			"chownfilesto":                {"chownFilesTo", false},
-			"cloudrunnercpu":              {"cloudRunnerCpu", false},
-			"cloudrunnermemory":           {"cloudRunnerMemory", false},
-			"containerhookfiles":          {"containerHookFiles", false},
-			"customcommandhooks":          {"customCommandHooks", false},
-			"customhookfiles":             {"customHookFiles", false},
-			"customimage":                 {"customImage", false},
-			"customjob":                   {"customJob", false},
-			"customparameters":            {"customParameters", false},
-			"dockerworkspacepath":         {"dockerWorkspacePath", false},
-			"githubowner":                 {"githubOwner", false},
-			"gitprivatetoken":             {"gitPrivateToken", false},
-			"kubeconfig":                  {"kubeConfig", false},
-			"kubestorageclass":            {"kubeStorageClass", false},
-			"kubevolume":                  {"kubeVolume", false},
-			"kubevolumesize":              {"kubeVolumeSize", false},
-			"postbuildsteps":              {"postBuildSteps", false},
-			"prebuildsteps":               {"preBuildSteps", false},
-			"projectpath":                 {"projectPath", false},
-			"providerstrategy":            {"providerStrategy", false},
-			"readinputfromoverridelist":   {"readInputFromOverrideList", false},
-			"readinputoverridecommand":    {"readInputOverrideCommand", false},
-			"sshagent":                    {"sshAgent", false},
-			"sshpublickeysdirectorypath":  {"sshPublicKeysDirectoryPath", false},
-			"targetplatform":              {"targetPlatform", false},
-			"unityhubversiononmac":        {"unityHubVersionOnMac", false},
-			"unitylicensingserver":        {"unityLicensingServer", false},
-			"unityversion":                {"unityVersion", false},
-			"version":                     {"version", false},
-			"versioning":                  {"versioning", false},
-			"watchtoend":                  {"watchToEnd", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"androidversioncode": {"androidVersionCode"},
-			"buildversion":       {"buildVersion"},
-			"volume":             {"volume"},
-		},
-	},
-	"game-ci/unity-builder@v4": {
-		Name: "Unity - Builder",
-		Inputs: ActionMetadataInputs{
-			"allowdirtybuild":               {"allowDirtyBuild", false},
-			"androidexporttype":             {"androidExportType", false},
-			"androidkeyaliasname":           {"androidKeyaliasName", false},
-			"androidkeyaliaspass":           {"androidKeyaliasPass", false},
-			"androidkeystorebase64":         {"androidKeystoreBase64", false},
-			"androidkeystorename":           {"androidKeystoreName", false},
-			"androidkeystorepass":           {"androidKeystorePass", false},
-			"androidsymboltype":             {"androidSymbolType", false},
-			"androidtargetsdkversion":       {"androidTargetSdkVersion", false},
-			"androidversioncode":            {"androidVersionCode", false},
-			"awsstackname":                  {"awsStackName", false},
-			"buildmethod":                   {"buildMethod", false},
-			"buildname":                     {"buildName", false},
-			"buildspath":                    {"buildsPath", false},
-			"cachekey":                      {"cacheKey", false},
-			"cacheunityinstallationonmac":   {"cacheUnityInstallationOnMac", false},
-			"chownfilesto":                  {"chownFilesTo", false},
-			"containercpu":                  {"containerCpu", false},
-			"containerhookfiles":            {"containerHookFiles", false},
-			"containermemory":               {"containerMemory", false},
-			"containerregistryimageversion": {"containerRegistryImageVersion", false},
-			"containerregistryrepository":   {"containerRegistryRepository", false},
-			"customcommandhooks":            {"customCommandHooks", false},
-			"customhookfiles":               {"customHookFiles", false},
-			"customimage":                   {"customImage", false},
-			"customjob":                     {"customJob", false},
-			"customparameters":              {"customParameters", false},
-			"dockercpulimit":                {"dockerCpuLimit", false},
-			"dockerisolationmode":           {"dockerIsolationMode", false},
-			"dockermemorylimit":             {"dockerMemoryLimit", false},
-			"dockerworkspacepath":           {"dockerWorkspacePath", false},
-			"enablegpu":                     {"enableGpu", false},
-			"githubowner":                   {"githubOwner", false},
-			"gitprivatetoken":               {"gitPrivateToken", false},
-			"kubeconfig":                    {"kubeConfig", false},
-			"kubestorageclass":              {"kubeStorageClass", false},
-			"kubevolume":                    {"kubeVolume", false},
-			"kubevolumesize":                {"kubeVolumeSize", false},
-			"manualexit":                    {"manualExit", false},
-			"postbuildsteps":                {"postBuildSteps", false},
-			"prebuildsteps":                 {"preBuildSteps", false},
-			"projectpath":                   {"projectPath", false},
-			"providerstrategy":              {"providerStrategy", false},
-			"readinputfromoverridelist":     {"readInputFromOverrideList", false},
-			"readinputoverridecommand":      {"readInputOverrideCommand", false},
-			"runashostuser":                 {"runAsHostUser", false},
-			"skipactivation":                {"skipActivation", false},
-			"sshagent":                      {"sshAgent", false},
-			"sshpublickeysdirectorypath":    {"sshPublicKeysDirectoryPath", false},
-			"targetplatform":                {"targetPlatform", false},
-			"unityhubversiononmac":          {"unityHubVersionOnMac", false},
-			"unitylicensingserver":          {"unityLicensingServer", false},
-			"unityversion":                  {"unityVersion", false},
-			"version":                       {"version", false},
-			"versioning":                    {"versioning", false},
-			"watchtoend":                    {"watchToEnd", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"androidversioncode": {"androidVersionCode"},
-			"buildversion":       {"buildVersion"},
-			"engineexitcode":     {"engineExitCode"},
-			"volume":             {"volume"},
-		},
-	},
-	"github/codeql-action/analyze@v2": {
-		Name: "CodeQL: Finish",
-		Inputs: ActionMetadataInputs{
-			"add-snippets":        {"add-snippets", false},
-			"category":            {"category", false},
-			"check_name":          {"check_name", false},
-			"checkout_path":       {"checkout_path", false},
-			"cleanup-level":       {"cleanup-level", false},
-			"expect-error":        {"expect-error", false},
-			"matrix":              {"matrix", false},
-			"output":              {"output", false},
-			"ram":                 {"ram", false},
-			"ref":                 {"ref", false},
-			"sha":                 {"sha", false},
-			"skip-queries":        {"skip-queries", false},
-			"threads":             {"threads", false},
-			"token":               {"token", false},
-			"upload":              {"upload", false},
-			"upload-database":     {"upload-database", false},
-			"wait-for-processing": {"wait-for-processing", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"db-locations": {"db-locations"},
-			"sarif-id":     {"sarif-id"},
-			"sarif-output": {"sarif-output"},
-		},
-	},
-	"github/codeql-action/analyze@v3": {
-		Name: "CodeQL: Finish",
-		Inputs: ActionMetadataInputs{
-			"add-snippets":        {"add-snippets", false},
-			"category":            {"category", false},
-			"check_name":          {"check_name", false},
-			"checkout_path":       {"checkout_path", false},
-			"cleanup-level":       {"cleanup-level", false},
-			"expect-error":        {"expect-error", false},
-			"matrix":              {"matrix", false},
-			"output":              {"output", false},
-			"ram":                 {"ram", false},
-			"ref":                 {"ref", false},
-			"sha":                 {"sha", false},
-			"skip-queries":        {"skip-queries", false},
-			"threads":             {"threads", false},
-			"token":               {"token", false},
-			"upload":              {"upload", false},
-			"upload-database":     {"upload-database", false},
-			"wait-for-processing": {"wait-for-processing", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"db-locations": {"db-locations"},
-			"sarif-id":     {"sarif-id"},
-			"sarif-output": {"sarif-output"},
-		},
-	},
-	"github/codeql-action/autobuild@v2": {
-		Name: "CodeQL: Autobuild",
-		Inputs: ActionMetadataInputs{
-			"matrix":            {"matrix", false},
-			"token":             {"token", false},
-			"working-directory": {"working-directory", false},
-		},
-	},
-	"github/codeql-action/autobuild@v3": {
-		Name: "CodeQL: Autobuild",
-		Inputs: ActionMetadataInputs{
-			"matrix":            {"matrix", false},
-			"token":             {"token", false},
-			"working-directory": {"working-directory", false},
-		},
-	},
-	"github/codeql-action/init@v2": {
-		Name: "CodeQL: Init",
-		Inputs: ActionMetadataInputs{
-			"build-mode":                {"build-mode", false},
-			"config":                    {"config", false},
-			"config-file":               {"config-file", false},
-			"db-location":               {"db-location", false},
-			"debug":                     {"debug", false},
-			"debug-artifact-name":       {"debug-artifact-name", false},
-			"debug-database-name":       {"debug-database-name", false},
-			"external-repository-token": {"external-repository-token", false},
-			"languages":                 {"languages", false},
-			"matrix":                    {"matrix", false},
-			"packs":                     {"packs", false},
-			"queries":                   {"queries", false},
-			"ram":                       {"ram", false},
-			"registries":                {"registries", false},
-			"setup-python-dependencies": {"setup-python-dependencies", false},
-			"source-root":               {"source-root", false},
-			"threads":                   {"threads", false},
-			"token":                     {"token", false},
-			"tools":                     {"tools", false},
-			"trap-caching":              {"trap-caching", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"codeql-path": {"codeql-path"},
-		},
-	},
-	"github/codeql-action/init@v3": {
-		Name: "CodeQL: Init",
-		Inputs: ActionMetadataInputs{
-			"build-mode":                {"build-mode", false},
-			"config":                    {"config", false},
-			"config-file":               {"config-file", false},
-			"db-location":               {"db-location", false},
-			"debug":                     {"debug", false},
-			"debug-artifact-name":       {"debug-artifact-name", false},
-			"debug-database-name":       {"debug-database-name", false},
-			"external-repository-token": {"external-repository-token", false},
-			"languages":                 {"languages", false},
-			"matrix":                    {"matrix", false},
-			"packs":                     {"packs", false},
-			"queries":                   {"queries", false},
-			"ram":                       {"ram", false},
-			"registries":                {"registries", false},
-			"setup-python-dependencies": {"setup-python-dependencies", false},
-			"source-root":               {"source-root", false},
-			"threads":                   {"threads", false},
-			"token":                     {"token", false},
-			"tools":                     {"tools", false},
-			"trap-caching":              {"trap-caching", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"codeql-path": {"codeql-path"},
-		},
-	},
-	"github/super-linter@v3": {
-		Name: "Super-Linter",
-	},
-	"github/super-linter@v4": {
-		Name: "Super-Linter",
-	},
-	"github/super-linter@v5": {
-		Name: "Super-Linter",
-	},
-	"github/super-linter@v6": {
-		Name: "Super-Linter",
-	},
-	"golangci/golangci-lint-action@v3": {
-		Name: "Run golangci-lint",
-		Inputs: ActionMetadataInputs{
-			"args":              {"args", false},
-			"github-token":      {"github-token", false},
-			"install-mode":      {"install-mode", false},
-			"only-new-issues":   {"only-new-issues", false},
-			"skip-build-cache":  {"skip-build-cache", false},
-			"skip-cache":        {"skip-cache", false},
-			"skip-pkg-cache":    {"skip-pkg-cache", false},
-			"version":           {"version", false},
-			"working-directory": {"working-directory", false},
-		},
-	},
-	"golangci/golangci-lint-action@v4": {
-		Name: "Run golangci-lint",
-		Inputs: ActionMetadataInputs{
-			"args":              {"args", false},
-			"github-token":      {"github-token", false},
-			"install-mode":      {"install-mode", false},
-			"only-new-issues":   {"only-new-issues", false},
-			"skip-build-cache":  {"skip-build-cache", false},
-			"skip-cache":        {"skip-cache", false},
-			"skip-pkg-cache":    {"skip-pkg-cache", false},
-			"version":           {"version", false},
-			"working-directory": {"working-directory", false},
-		},
-	},
-	"golangci/golangci-lint-action@v5": {
-		Name: "Golangci-lint",
-		Inputs: ActionMetadataInputs{
-			"annotations":                 {"annotations", false},
-			"args":                        {"args", false},
-			"cache-invalidation-interval": {"cache-invalidation-interval", false},
-			"github-token":                {"github-token", false},
-			"install-mode":                {"install-mode", false},
-			"only-new-issues":             {"only-new-issues", false},
-			"skip-cache":                  {"skip-cache", false},
-			"skip-save-cache":             {"skip-save-cache", false},
-			"version":                     {"version", false},
-			"working-directory":           {"working-directory", false},
-		},
-	},
-	"golangci/golangci-lint-action@v6": {
-		Name: "Golangci-lint",
-		Inputs: ActionMetadataInputs{
-			"args":                        {"args", false},
-			"cache-invalidation-interval": {"cache-invalidation-interval", false},
-			"github-token":                {"github-token", false},
-			"install-mode":                {"install-mode", false},
-			"only-new-issues":             {"only-new-issues", false},
-			"problem-matchers":            {"problem-matchers", false},
-			"skip-cache":                  {"skip-cache", false},
-			"skip-save-cache":             {"skip-save-cache", false},
-			"version":                     {"version", false},
-			"working-directory":           {"working-directory", false},
-		},
-	},
-	"google-github-actions/auth@v1": {
-		Name: "Authenticate to Google Cloud",
-		Inputs: ActionMetadataInputs{
-			"access_token_lifetime":        {"access_token_lifetime", false},
-			"access_token_scopes":          {"access_token_scopes", false},
-			"access_token_subject":         {"access_token_subject", false},
-			"audience":                     {"audience", false},
-			"backoff":                      {"backoff", false},
-			"backoff_limit":                {"backoff_limit", false},
-			"cleanup_credentials":          {"cleanup_credentials", false},
-			"create_credentials_file":      {"create_credentials_file", false},
-			"credentials_json":             {"credentials_json", false},
-			"delegates":                    {"delegates", false},
-			"export_environment_variables": {"export_environment_variables", false},
-			"id_token_audience":            {"id_token_audience", false},
-			"id_token_include_email":       {"id_token_include_email", false},
-			"project_id":                   {"project_id", false},
-			"retries":                      {"retries", false},
-			"service_account":              {"service_account", false},
-			"token_format":                 {"token_format", false},
-			"workload_identity_provider":   {"workload_identity_provider", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"access_token":            {"access_token"},
-			"access_token_expiration": {"access_token_expiration"},
-			"credentials_file_path":   {"credentials_file_path"},
-			"id_token":                {"id_token"},
-			"project_id":              {"project_id"},
-		},
-	},
-	"google-github-actions/auth@v2": {
-		Name: "Authenticate to Google Cloud",
-		Inputs: ActionMetadataInputs{
-			"access_token_lifetime":        {"access_token_lifetime", false},
-			"access_token_scopes":          {"access_token_scopes", false},
-			"access_token_subject":         {"access_token_subject", false},
-			"audience":                     {"audience", false},
-			"backoff":                      {"backoff", false},
-			"backoff_limit":                {"backoff_limit", false},
-			"cleanup_credentials":          {"cleanup_credentials", false},
-			"create_credentials_file":      {"create_credentials_file", false},
-			"credentials_json":             {"credentials_json", false},
-			"delegates":                    {"delegates", false},
-			"export_environment_variables": {"export_environment_variables", false},
-			"id_token_audience":            {"id_token_audience", false},
-			"id_token_include_email":       {"id_token_include_email", false},
-			"project_id":                   {"project_id", false},
-			"retries":                      {"retries", false},
-			"service_account":              {"service_account", false},
-			"token_format":                 {"token_format", false},
-			"universe":                     {"universe", false},
-			"workload_identity_provider":   {"workload_identity_provider", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"access_token":          {"access_token"},
-			"auth_token":            {"auth_token"},
-			"credentials_file_path": {"credentials_file_path"},
-			"id_token":              {"id_token"},
-			"project_id":            {"project_id"},
-		},
-	},
-	"google-github-actions/get-secretmanager-secrets@v1": {
-		Name: "Get Secret Manager secrets",
-		Inputs: ActionMetadataInputs{
-			"min_mask_length": {"min_mask_length", false},
-			"secrets":         {"secrets", true},
-		},
-		SkipOutputs: true,
-	},
-	"google-github-actions/get-secretmanager-secrets@v2": {
-		Name: "Get Secret Manager secrets",
-		Inputs: ActionMetadataInputs{
-			"min_mask_length": {"min_mask_length", false},
-			"secrets":         {"secrets", true},
-		},
-		SkipOutputs: true,
-	},
-	"google-github-actions/setup-gcloud@v1": {
-		Name: "Set up gcloud Cloud SDK environment",
-		Inputs: ActionMetadataInputs{
-			"install_components": {"install_components", false},
-			"project_id":         {"project_id", false},
-			"skip_install":       {"skip_install", false},
-			"version":            {"version", false},
-		},
-	},
-	"google-github-actions/setup-gcloud@v2": {
-		Name: "Set up gcloud Cloud SDK environment",
-		Inputs: ActionMetadataInputs{
-			"install_components": {"install_components", false},
-			"project_id":         {"project_id", false},
-			"skip_install":       {"skip_install", false},
-			"version":            {"version", false},
-		},
-	},
-	"google-github-actions/upload-cloud-storage@v1": {
-		Name: "Cloud Storage Uploader",
-		Inputs: ActionMetadataInputs{
-			"concurrency":          {"concurrency", false},
-			"destination":          {"destination", true},
-			"glob":                 {"glob", false},
-			"gzip":                 {"gzip", false},
-			"headers":              {"headers", false},
-			"parent":               {"parent", false},
-			"path":                 {"path", true},
-			"predefinedacl":        {"predefinedAcl", false},
-			"process_gcloudignore": {"process_gcloudignore", false},
-			"project_id":           {"project_id", false},
-			"resumable":            {"resumable", false},
-		},
-	},
-	"google-github-actions/upload-cloud-storage@v2": {
-		Name: "Cloud Storage Uploader",
-		Inputs: ActionMetadataInputs{
-			"concurrency":          {"concurrency", false},
-			"destination":          {"destination", true},
-			"glob":                 {"glob", false},
-			"gzip":                 {"gzip", false},
-			"headers":              {"headers", false},
-			"parent":               {"parent", false},
-			"path":                 {"path", true},
-			"predefinedacl":        {"predefinedAcl", false},
-			"process_gcloudignore": {"process_gcloudignore", false},
-			"project_id":           {"project_id", false},
-			"resumable":            {"resumable", false},
-		},
-	},
-	"goreleaser/goreleaser-action@v3": {
-		Name: "GoReleaser Action",
-		Inputs: ActionMetadataInputs{
-			"args":         {"args", false},
-			"distribution": {"distribution", false},
-			"install-only": {"install-only", false},
-			"version":      {"version", false},
-			"workdir":      {"workdir", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"artifacts": {"artifacts"},
-			"metadata":  {"metadata"},
-		},
-	},
-	"goreleaser/goreleaser-action@v4": {
-		Name: "GoReleaser Action",
-		Inputs: ActionMetadataInputs{
-			"args":         {"args", false},
-			"distribution": {"distribution", false},
-			"install-only": {"install-only", false},
-			"version":      {"version", false},
-			"workdir":      {"workdir", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"artifacts": {"artifacts"},
-			"metadata":  {"metadata"},
-		},
-	},
-	"goreleaser/goreleaser-action@v5": {
-		Name: "GoReleaser Action",
-		Inputs: ActionMetadataInputs{
-			"args":         {"args", false},
-			"distribution": {"distribution", false},
-			"install-only": {"install-only", false},
-			"version":      {"version", false},
-			"workdir":      {"workdir", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"artifacts": {"artifacts"},
-			"metadata":  {"metadata"},
-		},
-	},
-	"gradle/wrapper-validation-action@v1": {
-		Name: "Gradle Wrapper Validation",
-		Inputs: ActionMetadataInputs{
-			"allow-checksums":   {"allow-checksums", false},
-			"allow-snapshots":   {"allow-snapshots", false},
-			"min-wrapper-count": {"min-wrapper-count", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"failed-wrapper": {"failed-wrapper"},
-		},
-	},
-	"gradle/wrapper-validation-action@v2": {
-		Name: "Gradle Wrapper Validation",
-		Inputs: ActionMetadataInputs{
-			"allow-checksums":   {"allow-checksums", false},
-			"allow-snapshots":   {"allow-snapshots", false},
-			"min-wrapper-count": {"min-wrapper-count", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"failed-wrapper": {"failed-wrapper"},
-		},
-	},
-	"gradle/wrapper-validation-action@v3": {
-		Name: "Gradle Wrapper Validation",
-		Inputs: ActionMetadataInputs{
-			"allow-checksums":   {"allow-checksums", false},
-			"allow-snapshots":   {"allow-snapshots", false},
-			"min-wrapper-count": {"min-wrapper-count", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"failed-wrapper": {"failed-wrapper"},
-		},
-	},
-	"haskell/actions/setup@v2": {
-		Name: "Setup Haskell",
-		Inputs: ActionMetadataInputs{
-			"cabal-update":          {"cabal-update", false},
-			"cabal-version":         {"cabal-version", false},
-			"disable-matcher":       {"disable-matcher", false},
-			"enable-stack":          {"enable-stack", false},
-			"ghc-version":           {"ghc-version", false},
-			"ghcup-release-channel": {"ghcup-release-channel", false},
-			"stack-no-global":       {"stack-no-global", false},
-			"stack-setup-ghc":       {"stack-setup-ghc", false},
-			"stack-version":         {"stack-version", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cabal-exe":     {"cabal-exe"},
-			"cabal-path":    {"cabal-path"},
-			"cabal-store":   {"cabal-store"},
-			"cabal-version": {"cabal-version"},
-			"ghc-exe":       {"ghc-exe"},
-			"ghc-path":      {"ghc-path"},
-			"ghc-version":   {"ghc-version"},
-			"stack-exe":     {"stack-exe"},
-			"stack-path":    {"stack-path"},
-			"stack-root":    {"stack-root"},
-			"stack-version": {"stack-version"},
-		},
-	},
-	"microsoft/playwright-github-action@v1": {
-		Name: "Run Playwright tests",
-	},
-	"mikepenz/release-changelog-builder-action@v3": {
-		Name: "Release Changelog Builder",
-		Inputs: ActionMetadataInputs{
-			"baseurl":                 {"baseUrl", false},
-			"commitmode":              {"commitMode", false},
-			"configuration":           {"configuration", false},
-			"configurationjson":       {"configurationJson", false},
-			"failonerror":             {"failOnError", false},
-			"fetchreleaseinformation": {"fetchReleaseInformation", false},
-			"fetchreviewers":          {"fetchReviewers", false},
-			"fetchreviews":            {"fetchReviews", false},
-			"fromtag":                 {"fromTag", false},
-			"ignoreprereleases":       {"ignorePreReleases", false},
-			"includeopen":             {"includeOpen", false},
-			"outputfile":              {"outputFile", false},
-			"owner":                   {"owner", false},
-			"path":                    {"path", false},
-			"repo":                    {"repo", false},
-			"token":                   {"token", false},
-			"totag":                   {"toTag", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"categorized_prs":   {"categorized_prs"},
-			"changelog":         {"changelog"},
-			"failed":            {"failed"},
-			"fromtag":           {"fromTag"},
-			"open_prs":          {"open_prs"},
-			"owner":             {"owner"},
-			"pull_requests":     {"pull_requests"},
-			"repo":              {"repo"},
-			"totag":             {"toTag"},
-			"uncategorized_prs": {"uncategorized_prs"},
-		},
-	},
-	"mikepenz/release-changelog-builder-action@v4": {
-		Name: "Release Changelog Builder",
-		Inputs: ActionMetadataInputs{
-			"baseurl":                 {"baseUrl", false},
-			"cache":                   {"cache", false},
-			"commitmode":              {"commitMode", false},
-			"configuration":           {"configuration", false},
-			"configurationjson":       {"configurationJson", false},
-			"exportcache":             {"exportCache", false},
-			"exportonly":              {"exportOnly", false},
-			"failonerror":             {"failOnError", false},
-			"fetchreleaseinformation": {"fetchReleaseInformation", false},
-			"fetchreviewers":          {"fetchReviewers", false},
-			"fetchreviews":            {"fetchReviews", false},
-			"fetchviacommits":         {"fetchViaCommits", false},
-			"fromtag":                 {"fromTag", false},
-			"ignoreprereleases":       {"ignorePreReleases", false},
-			"includeopen":             {"includeOpen", false},
-			"outputfile":              {"outputFile", false},
-			"owner":                   {"owner", false},
-			"path":                    {"path", false},
-			"platform":                {"platform", false},
-			"repo":                    {"repo", false},
-			"token":                   {"token", false},
-			"totag":                   {"toTag", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"cache":             {"cache"},
-			"categorized_prs":   {"categorized_prs"},
-			"changelog":         {"changelog"},
-			"failed":            {"failed"},
-			"fromtag":           {"fromTag"},
-			"open_prs":          {"open_prs"},
-			"owner":             {"owner"},
-			"pull_requests":     {"pull_requests"},
-			"repo":              {"repo"},
-			"totag":             {"toTag"},
-			"uncategorized_prs": {"uncategorized_prs"},
-		},
-	},
-	"msys2/setup-msys2@v2": {
-		Name: "Setup MSYS2",
-		Inputs: ActionMetadataInputs{
-			"cache":                   {"cache", false},
-			"install":                 {"install", false},
-			"location":                {"location", false},
-			"msystem":                 {"msystem", false},
-			"pacboy":                  {"pacboy", false},
-			"path-type":               {"path-type", false},
-			"platform-check-severity": {"platform-check-severity", false},
-			"release":                 {"release", false},
-			"update":                  {"update", false},
-		},
-	},
-	"ncipollo/release-action@v1": {
-		Name: "Create Release",
-		Inputs: ActionMetadataInputs{
-			"allowupdates":               {"allowUpdates", false},
-			"artifact":                   {"artifact", false},
-			"artifactcontenttype":        {"artifactContentType", false},
-			"artifacterrorsfailbuild":    {"artifactErrorsFailBuild", false},
-			"artifacts":                  {"artifacts", false},
-			"body":                       {"body", false},
-			"bodyfile":                   {"bodyFile", false},
-			"commit":                     {"commit", false},
-			"discussioncategory":         {"discussionCategory", false},
-			"draft":                      {"draft", false},
-			"generatereleasenotes":       {"generateReleaseNotes", false},
-			"makelatest":                 {"makeLatest", false},
-			"name":                       {"name", false},
-			"omitbody":                   {"omitBody", false},
-			"omitbodyduringupdate":       {"omitBodyDuringUpdate", false},
-			"omitdraftduringupdate":      {"omitDraftDuringUpdate", false},
-			"omitname":                   {"omitName", false},
-			"omitnameduringupdate":       {"omitNameDuringUpdate", false},
-			"omitprereleaseduringupdate": {"omitPrereleaseDuringUpdate", false},
-			"owner":                      {"owner", false},
-			"prerelease":                 {"prerelease", false},
-			"removeartifacts":            {"removeArtifacts", false},
-			"replacesartifacts":          {"replacesArtifacts", false},
-			"repo":                       {"repo", false},
-			"skipifreleaseexists":        {"skipIfReleaseExists", false},
-			"tag":                        {"tag", false},
-			"token":                      {"token", false},
-			"updateonlyunreleased":       {"updateOnlyUnreleased", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"html_url":   {"html_url"},
-			"id":         {"id"},
-			"upload_url": {"upload_url"},
-		},
-	},
-	"nwtgck/actions-netlify@v2": {
-		Name: "Netlify Actions",
-		Inputs: ActionMetadataInputs{
-			"alias":                           {"alias", false},
-			"deploy-message":                  {"deploy-message", false},
-			"enable-commit-comment":           {"enable-commit-comment", false},
-			"enable-commit-status":            {"enable-commit-status", false},
-			"enable-github-deployment":        {"enable-github-deployment", false},
-			"enable-pull-request-comment":     {"enable-pull-request-comment", false},
-			"fails-without-credentials":       {"fails-without-credentials", false},
-			"functions-dir":                   {"functions-dir", false},
-			"github-deployment-description":   {"github-deployment-description", false},
-			"github-deployment-environment":   {"github-deployment-environment", false},
-			"github-token":                    {"github-token", false},
-			"netlify-config-path":             {"netlify-config-path", false},
-			"overwrites-pull-request-comment": {"overwrites-pull-request-comment", false},
-			"production-branch":               {"production-branch", false},
-			"production-deploy":               {"production-deploy", false},
-			"publish-dir":                     {"publish-dir", true},
-		},
-		Outputs: ActionMetadataOutputs{
-			"deploy-url": {"deploy-url"},
-		},
-	},
-	"nwtgck/actions-netlify@v3": {
-		Name: "Netlify Actions",
-		Inputs: ActionMetadataInputs{
-			"alias":                           {"alias", false},
-			"deploy-message":                  {"deploy-message", false},
-			"enable-commit-comment":           {"enable-commit-comment", false},
-			"enable-commit-status":            {"enable-commit-status", false},
-			"enable-github-deployment":        {"enable-github-deployment", false},
-			"enable-pull-request-comment":     {"enable-pull-request-comment", false},
-			"fails-without-credentials":       {"fails-without-credentials", false},
-			"functions-dir":                   {"functions-dir", false},
-			"github-deployment-description":   {"github-deployment-description", false},
-			"github-deployment-environment":   {"github-deployment-environment", false},
-			"github-token":                    {"github-token", false},
-			"netlify-config-path":             {"netlify-config-path", false},
-			"overwrites-pull-request-comment": {"overwrites-pull-request-comment", false},
-			"production-branch":               {"production-branch", false},
-			"production-deploy":               {"production-deploy", false},
-			"publish-dir":                     {"publish-dir", true},
-		},
-		Outputs: ActionMetadataOutputs{
-			"deploy-url": {"deploy-url"},
-		},
-	},
-	"octokit/graphql-action@v2.x": {
-		Name: "GitHub GraphQL API Query",
-		Inputs: ActionMetadataInputs{
-			"mediatype": {"mediaType", false},
-			"query":     {"query", true},
-			"variables": {"variables", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"data": {"data"},
-		},
-	},
-	"octokit/request-action@v2.x": {
-		Name:       "GitHub API Request",
-		SkipInputs: true,
-		Outputs: ActionMetadataOutputs{
-			"data":    {"data"},
-			"headers": {"headers"},
-			"status":  {"status"},
-		},
-	},
-	"peaceiris/actions-gh-pages@v2": {
-		Name: "GitHub Pages action",
-		Inputs: ActionMetadataInputs{
-			"commitmessage": {"commitMessage", false},
-			"emptycommits":  {"emptyCommits", false},
-			"forceorphan":   {"forceOrphan", false},
-			"keepfiles":     {"keepFiles", false},
-			"tagmessage":    {"tagMessage", false},
-			"tagname":       {"tagName", false},
-			"tagoverwrite":  {"tagOverwrite", false},
-			"useremail":     {"useremail", false},
-			"username":      {"username", false},
-		},
-	},
-	"peaceiris/actions-gh-pages@v3": {
-		Name: "GitHub Pages action",
-		Inputs: ActionMetadataInputs{
-			"allow_empty_commit":  {"allow_empty_commit", false},
-			"cname":               {"cname", false},
-			"commit_message":      {"commit_message", false},
-			"deploy_key":          {"deploy_key", false},
-			"destination_dir":     {"destination_dir", false},
-			"disable_nojekyll":    {"disable_nojekyll", false},
-			"enable_jekyll":       {"enable_jekyll", false},
-			"exclude_assets":      {"exclude_assets", false},
-			"external_repository": {"external_repository", false},
-			"force_orphan":        {"force_orphan", false},
-			"full_commit_message": {"full_commit_message", false},
-			"github_token":        {"github_token", false},
-			"keep_files":          {"keep_files", false},
-			"personal_token":      {"personal_token", false},
-			"publish_branch":      {"publish_branch", false},
-			"publish_dir":         {"publish_dir", false},
-			"tag_message":         {"tag_message", false},
-			"tag_name":            {"tag_name", false},
-			"user_email":          {"user_email", false},
-			"user_name":           {"user_name", false},
-		},
-	},
-	"peaceiris/actions-gh-pages@v4": {
-		Name: "GitHub Pages action",
-		Inputs: ActionMetadataInputs{
-			"allow_empty_commit":  {"allow_empty_commit", false},
-			"cname":               {"cname", false},
-			"commit_message":      {"commit_message", false},
-			"deploy_key":          {"deploy_key", false},
-			"destination_dir":     {"destination_dir", false},
-			"disable_nojekyll":    {"disable_nojekyll", false},
-			"enable_jekyll":       {"enable_jekyll", false},
-			"exclude_assets":      {"exclude_assets", false},
-			"external_repository": {"external_repository", false},
-			"force_orphan":        {"force_orphan", false},
-			"full_commit_message": {"full_commit_message", false},
-			"github_token":        {"github_token", false},
-			"keep_files":          {"keep_files", false},
-			"personal_token":      {"personal_token", false},
-			"publish_branch":      {"publish_branch", false},
-			"publish_dir":         {"publish_dir", false},
-			"tag_message":         {"tag_message", false},
-			"tag_name":            {"tag_name", false},
-			"user_email":          {"user_email", false},
-			"user_name":           {"user_name", false},
-		},
-	},
-	"peaceiris/actions-hugo@v3": {
-		Name: "Hugo setup",
-		Inputs: ActionMetadataInputs{
-			"extended":     {"extended", false},
-			"hugo-version": {"hugo-version", false},
-		},
-	},
-	"peter-evans/create-pull-request@v4": {
-		Name: "Create Pull Request",
-		Inputs: ActionMetadataInputs{
-			"add-paths":      {"add-paths", false},
-			"assignees":      {"assignees", false},
-			"author":         {"author", false},
-			"base":           {"base", false},
-			"body":           {"body", false},
-			"branch":         {"branch", false},
-			"branch-suffix":  {"branch-suffix", false},
-			"commit-message": {"commit-message", false},
-			"committer":      {"committer", false},
-			"delete-branch":  {"delete-branch", false},
-			"draft":          {"draft", false},
-			"labels":         {"labels", false},
-			"milestone":      {"milestone", false},
-			"path":           {"path", false},
-			"push-to-fork":   {"push-to-fork", false},
-			"reviewers":      {"reviewers", false},
-			"signoff":        {"signoff", false},
-			"team-reviewers": {"team-reviewers", false},
-			"title":          {"title", false},
-			"token":          {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"pull-request-head-sha":  {"pull-request-head-sha"},
-			"pull-request-number":    {"pull-request-number"},
-			"pull-request-operation": {"pull-request-operation"},
-			"pull-request-url":       {"pull-request-url"},
-		},
-	},
-	"peter-evans/create-pull-request@v5": {
-		Name: "Create Pull Request",
-		Inputs: ActionMetadataInputs{
-			"add-paths":      {"add-paths", false},
-			"assignees":      {"assignees", false},
-			"author":         {"author", false},
-			"base":           {"base", false},
-			"body":           {"body", false},
-			"body-path":      {"body-path", false},
-			"branch":         {"branch", false},
-			"branch-suffix":  {"branch-suffix", false},
-			"commit-message": {"commit-message", false},
-			"committer":      {"committer", false},
-			"delete-branch":  {"delete-branch", false},
-			"draft":          {"draft", false},
-			"labels":         {"labels", false},
-			"milestone":      {"milestone", false},
-			"path":           {"path", false},
-			"push-to-fork":   {"push-to-fork", false},
-			"reviewers":      {"reviewers", false},
-			"signoff":        {"signoff", false},
-			"team-reviewers": {"team-reviewers", false},
-			"title":          {"title", false},
-			"token":          {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"pull-request-head-sha":  {"pull-request-head-sha"},
-			"pull-request-number":    {"pull-request-number"},
-			"pull-request-operation": {"pull-request-operation"},
-			"pull-request-url":       {"pull-request-url"},
-		},
-	},
-	"peter-evans/create-pull-request@v6": {
-		Name: "Create Pull Request",
-		Inputs: ActionMetadataInputs{
-			"add-paths":      {"add-paths", false},
-			"assignees":      {"assignees", false},
-			"author":         {"author", false},
-			"base":           {"base", false},
-			"body":           {"body", false},
-			"body-path":      {"body-path", false},
-			"branch":         {"branch", false},
-			"branch-suffix":  {"branch-suffix", false},
-			"commit-message": {"commit-message", false},
-			"committer":      {"committer", false},
-			"delete-branch":  {"delete-branch", false},
-			"draft":          {"draft", false},
-			"git-token":      {"git-token", false},
-			"labels":         {"labels", false},
-			"milestone":      {"milestone", false},
-			"path":           {"path", false},
-			"push-to-fork":   {"push-to-fork", false},
-			"reviewers":      {"reviewers", false},
-			"signoff":        {"signoff", false},
-			"team-reviewers": {"team-reviewers", false},
-			"title":          {"title", false},
-			"token":          {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"pull-request-head-sha":  {"pull-request-head-sha"},
-			"pull-request-number":    {"pull-request-number"},
-			"pull-request-operation": {"pull-request-operation"},
-			"pull-request-url":       {"pull-request-url"},
-		},
-	},
-	"preactjs/compressed-size-action@v2": {
-		Name: "compressed-size-action",
-		Inputs: ActionMetadataInputs{
-			"build-script":             {"build-script", false},
-			"clean-script":             {"clean-script", false},
-			"collapse-unchanged":       {"collapse-unchanged", false},
-			"compression":              {"compression", false},
-			"cwd":                      {"cwd", false},
-			"exclude":                  {"exclude", false},
-			"install-script":           {"install-script", false},
-			"minimum-change-threshold": {"minimum-change-threshold", false},
-			"omit-unchanged":           {"omit-unchanged", false},
-			"pattern":                  {"pattern", false},
-			"repo-token":               {"repo-token", false},
-			"show-total":               {"show-total", false},
-			"strip-hash":               {"strip-hash", false},
-			"use-check":                {"use-check", false},
-		},
-	},
-	"pulumi/actions@v1": {
-		Name: "Pulumi CLI Action",
-		Inputs: ActionMetadataInputs{
-			"command": {"command", true},
-		},
-	},
-	"pulumi/actions@v4": {
-		Name: "Pulumi CLI Action",
-		Inputs: ActionMetadataInputs{
-			"cloud-url":            {"cloud-url", false},
-			"color":                {"color", false},
-			"command":              {"command", false},
-			"comment-on-pr":        {"comment-on-pr", false},
-			"comment-on-pr-number": {"comment-on-pr-number", false},
-			"comment-on-summary":   {"comment-on-summary", false},
-			"config-map":           {"config-map", false},
-			"diff":                 {"diff", false},
-			"edit-pr-comment":      {"edit-pr-comment", false},
-			"exclude-protected":    {"exclude-protected", false},
-			"expect-no-changes":    {"expect-no-changes", false},
-			"github-token":         {"github-token", false},
-			"message":              {"message", false},
-			"parallel":             {"parallel", false},
-			"plan":                 {"plan", false},
-			"policypackconfigs":    {"policyPackConfigs", false},
-			"policypacks":          {"policyPacks", false},
-			"pulumi-version":       {"pulumi-version", false},
-			"refresh":              {"refresh", false},
-			"remove":               {"remove", false},
-			"replace":              {"replace", false},
-			"secrets-provider":     {"secrets-provider", false},
-			"stack-name":           {"stack-name", false},
-			"target":               {"target", false},
-			"target-dependents":    {"target-dependents", false},
-			"upsert":               {"upsert", false},
-			"work-dir":             {"work-dir", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"output": {"output"},
-		},
-	},
-	"pulumi/actions@v5": {
-		Name: "Pulumi CLI Action",
-		Inputs: ActionMetadataInputs{
-			"cloud-url":            {"cloud-url", false},
-			"color":                {"color", false},
-			"command":              {"command", false},
-			"comment-on-pr":        {"comment-on-pr", false},
-			"comment-on-pr-number": {"comment-on-pr-number", false},
-			"comment-on-summary":   {"comment-on-summary", false},
-			"config-map":           {"config-map", false},
-			"diff":                 {"diff", false},
-			"edit-pr-comment":      {"edit-pr-comment", false},
-			"exclude-protected":    {"exclude-protected", false},
-			"expect-no-changes":    {"expect-no-changes", false},
-			"github-token":         {"github-token", false},
-			"message":              {"message", false},
-			"parallel":             {"parallel", false},
-			"plan":                 {"plan", false},
-			"policypackconfigs":    {"policyPackConfigs", false},
-			"policypacks":          {"policyPacks", false},
-			"pulumi-version":       {"pulumi-version", false},
-			"refresh":              {"refresh", false},
-			"remove":               {"remove", false},
-			"replace":              {"replace", false},
-			"secrets-provider":     {"secrets-provider", false},
-			"stack-name":           {"stack-name", false},
-			"suppress-outputs":     {"suppress-outputs", false},
-			"suppress-progress":    {"suppress-progress", false},
-			"target":               {"target", false},
-			"target-dependents":    {"target-dependents", false},
-			"upsert":               {"upsert", false},
-			"work-dir":             {"work-dir", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"output": {"output"},
-		},
-	},
-	"pypa/gh-action-pypi-publish@release/v1": {
-		Name: "pypi-publish",
-		Inputs: ActionMetadataInputs{
-			"packages-dir":    {"packages-dir", false},
-			"packages_dir":    {"packages_dir", false},
-			"password":        {"password", false},
-			"print-hash":      {"print-hash", false},
-			"print_hash":      {"print_hash", false},
-			"repository-url":  {"repository-url", false},
-			"repository_url":  {"repository_url", false},
-			"skip-existing":   {"skip-existing", false},
-			"skip_existing":   {"skip_existing", false},
-			"user":            {"user", false},
-			"verbose":         {"verbose", false},
-			"verify-metadata": {"verify-metadata", false},
-			"verify_metadata": {"verify_metadata", false},
-		},
-	},
-	"reviewdog/action-actionlint@v1": {
-		Name: "actionlint with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"actionlint_flags": {"actionlint_flags", false},
-			"fail_on_error":    {"fail_on_error", false},
-			"filter_mode":      {"filter_mode", false},
-			"github_token":     {"github_token", false},
-			"level":            {"level", false},
-			"reporter":         {"reporter", false},
-			"reviewdog_flags":  {"reviewdog_flags", false},
-			"tool_name":        {"tool_name", false},
-		},
-	},
-	"reviewdog/action-eslint@v1": {
-		Name: "Run eslint with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"eslint_flags":    {"eslint_flags", false},
-			"fail_on_error":   {"fail_on_error", false},
-			"filter_mode":     {"filter_mode", false},
-			"github_token":    {"github_token", false},
-			"level":           {"level", false},
-			"node_options":    {"node_options", false},
-			"reporter":        {"reporter", false},
-			"reviewdog_flags": {"reviewdog_flags", false},
-			"tool_name":       {"tool_name", false},
-			"workdir":         {"workdir", false},
-		},
-	},
-	"reviewdog/action-golangci-lint@v1": {
-		Name: "Run golangci-lint with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"fail_on_error":       {"fail_on_error", false},
-			"filter_mode":         {"filter_mode", false},
-			"github_token":        {"github_token", false},
-			"golangci_lint_flags": {"golangci_lint_flags", false},
-			"level":               {"level", false},
-			"reporter":            {"reporter", false},
-			"reviewdog_flags":     {"reviewdog_flags", false},
-			"tool_name":           {"tool_name", false},
-			"workdir":             {"workdir", false},
-		},
-	},
-	"reviewdog/action-golangci-lint@v2": {
-		Name: "Run golangci-lint with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"cache":                 {"cache", false},
-			"fail_on_error":         {"fail_on_error", false},
-			"filter_mode":           {"filter_mode", false},
-			"github_token":          {"github_token", false},
-			"go_version":            {"go_version", false},
-			"go_version_file":       {"go_version_file", false},
-			"golangci_lint_flags":   {"golangci_lint_flags", false},
-			"golangci_lint_version": {"golangci_lint_version", false},
-			"level":                 {"level", false},
-			"reporter":              {"reporter", false},
-			"reviewdog_flags":       {"reviewdog_flags", false},
-			"reviewdog_version":     {"reviewdog_version", false},
-			"tool_name":             {"tool_name", false},
-			"workdir":               {"workdir", false},
-		},
-	},
-	"reviewdog/action-hadolint@v1": {
-		Name: "Run hadolint with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"exclude":         {"exclude", false},
-			"fail_on_error":   {"fail_on_error", false},
-			"filter_mode":     {"filter_mode", false},
-			"github_token":    {"github_token", false},
-			"hadolint_flags":  {"hadolint_flags", false},
-			"hadolint_ignore": {"hadolint_ignore", false},
-			"level":           {"level", false},
-			"reporter":        {"reporter", false},
-			"reviewdog_flags": {"reviewdog_flags", false},
-			"tool_name":       {"tool_name", false},
-		},
-	},
-	"reviewdog/action-misspell@v1": {
-		Name: "Run misspell with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"exclude":       {"exclude", false},
-			"fail_on_error": {"fail_on_error", false},
-			"filter_mode":   {"filter_mode", false},
-			"github_token":  {"github_token", false},
-			"ignore":        {"ignore", false},
-			"level":         {"level", false},
-			"locale":        {"locale", false},
-			"path":          {"path", false},
-			"pattern":       {"pattern", false},
-			"reporter":      {"reporter", false},
-		},
-	},
-	"reviewdog/action-rubocop@v1": {
-		Name: "Run rubocop with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"fail_on_error":      {"fail_on_error", false},
-			"filter_mode":        {"filter_mode", false},
-			"github_token":       {"github_token", true},
-			"level":              {"level", false},
-			"reporter":           {"reporter", false},
-			"reviewdog_flags":    {"reviewdog_flags", false},
-			"rubocop_extensions": {"rubocop_extensions", false},
-			"rubocop_flags":      {"rubocop_flags", false},
-			"rubocop_version":    {"rubocop_version", false},
-			"skip_install":       {"skip_install", false},
-			"tool_name":          {"tool_name", false},
-			"use_bundler":        {"use_bundler", false},
-			"workdir":            {"workdir", false},
-		},
-	},
-	"reviewdog/action-rubocop@v2": {
-		Name: "Run rubocop with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"fail_on_error":      {"fail_on_error", false},
-			"filter_mode":        {"filter_mode", false},
-			"github_token":       {"github_token", false},
-			"level":              {"level", false},
-			"reporter":           {"reporter", false},
-			"reviewdog_flags":    {"reviewdog_flags", false},
-			"rubocop_extensions": {"rubocop_extensions", false},
-			"rubocop_flags":      {"rubocop_flags", false},
-			"rubocop_version":    {"rubocop_version", false},
-			"skip_install":       {"skip_install", false},
-			"tool_name":          {"tool_name", false},
-			"use_bundler":        {"use_bundler", false},
-			"workdir":            {"workdir", false},
-		},
-	},
-	"reviewdog/action-shellcheck@v1": {
-		Name: "Run shellcheck with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"check_all_files_with_shebangs": {"check_all_files_with_shebangs", false},
-			"exclude":                       {"exclude", false},
-			"fail_on_error":                 {"fail_on_error", false},
-			"filter_mode":                   {"filter_mode", false},
-			"github_token":                  {"github_token", false},
-			"level":                         {"level", false},
-			"path":                          {"path", false},
-			"pattern":                       {"pattern", false},
-			"reporter":                      {"reporter", false},
-			"reviewdog_flags":               {"reviewdog_flags", false},
-			"shellcheck_flags":              {"shellcheck_flags", false},
-		},
-	},
-	"reviewdog/action-tflint@v1": {
-		Name: "Run tflint with reviewdog",
-		Inputs: ActionMetadataInputs{
-			"fail_on_error":     {"fail_on_error", false},
-			"filter_mode":       {"filter_mode", false},
-			"flags":             {"flags", false},
-			"github_token":      {"github_token", false},
-			"level":             {"level", false},
-			"reporter":          {"reporter", false},
-			"tflint_config":     {"tflint_config", false},
-			"tflint_init":       {"tflint_init", false},
-			"tflint_rulesets":   {"tflint_rulesets", false},
-			"tflint_target_dir": {"tflint_target_dir", false},
-			"tflint_version":    {"tflint_version", false},
-			"working_directory": {"working_directory", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"reviewdog-return-code": {"reviewdog-return-code"},
-			"tflint-return-code":    {"tflint-return-code"},
-		},
-	},
-	"rhysd/action-setup-vim@v1": {
-		Name: "Setup Vim",
-		Inputs: ActionMetadataInputs{
-			"configure-args": {"configure-args", false},
-			"neovim":         {"neovim", false},
-			"token":          {"token", false},
-			"version":        {"version", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"executable": {"executable"},
-		},
-	},
-	"ridedott/merge-me-action@v2": {
-		Name: "Merge me!",
-		Inputs: ActionMetadataInputs{
-			"enable_github_api_preview":  {"ENABLE_GITHUB_API_PREVIEW", false},
-			"enabled_for_manual_changes": {"ENABLED_FOR_MANUAL_CHANGES", false},
-			"github_login":               {"GITHUB_LOGIN", false},
-			"github_token":               {"GITHUB_TOKEN", true},
-			"maximum_retries":            {"MAXIMUM_RETRIES", false},
-			"merge_method":               {"MERGE_METHOD", false},
-			"preset":                     {"PRESET", false},
-		},
-	},
-	"rtCamp/action-slack-notify@v2": {
-		Name: "Slack Notify",
-	},
-	"ruby/setup-ruby@v1": {
-		Name: "Setup Ruby, JRuby and TruffleRuby",
-		Inputs: ActionMetadataInputs{
-			"bundler":           {"bundler", false},
-			"bundler-cache":     {"bundler-cache", false},
-			"cache-version":     {"cache-version", false},
-			"ruby-version":      {"ruby-version", false},
-			"rubygems":          {"rubygems", false},
-			"self-hosted":       {"self-hosted", false},
-			"windows-toolchain": {"windows-toolchain", false},
-			"working-directory": {"working-directory", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"ruby-prefix": {"ruby-prefix"},
-		},
-	},
-	"shivammathur/setup-php@v2": {
-		Name: "Setup PHP Action",
-		Inputs: ActionMetadataInputs{
-			"coverage":         {"coverage", false},
-			"extensions":       {"extensions", false},
-			"ini-file":         {"ini-file", false},
-			"ini-values":       {"ini-values", false},
-			"php-version":      {"php-version", false},
-			"php-version-file": {"php-version-file", false},
-			"tools":            {"tools", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"php-version": {"php-version"},
-		},
-	},
-	"softprops/action-gh-release@v1": {
-		Name: "GH Release",
-		Inputs: ActionMetadataInputs{
-			"append_body":              {"append_body", false},
-			"body":                     {"body", false},
-			"body_path":                {"body_path", false},
-			"discussion_category_name": {"discussion_category_name", false},
-			"draft":                    {"draft", false},
-			"fail_on_unmatched_files":  {"fail_on_unmatched_files", false},
-			"files":                    {"files", false},
-			"generate_release_notes":   {"generate_release_notes", false},
-			"name":                     {"name", false},
-			"prerelease":               {"prerelease", false},
-			"repository":               {"repository", false},
-			"tag_name":                 {"tag_name", false},
-			"target_commitish":         {"target_commitish", false},
-			"token":                    {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"assets":     {"assets"},
-			"id":         {"id"},
-			"upload_url": {"upload_url"},
-			"url":        {"url"},
-		},
-	},
-	"softprops/action-gh-release@v2": {
-		Name: "GH Release",
-		Inputs: ActionMetadataInputs{
-			"append_body":              {"append_body", false},
-			"body":                     {"body", false},
-			"body_path":                {"body_path", false},
-			"discussion_category_name": {"discussion_category_name", false},
-			"draft":                    {"draft", false},
-			"fail_on_unmatched_files":  {"fail_on_unmatched_files", false},
-			"files":                    {"files", false},
-			"generate_release_notes":   {"generate_release_notes", false},
-			"make_latest":              {"make_latest", false},
-			"name":                     {"name", false},
-			"prerelease":               {"prerelease", false},
-			"repository":               {"repository", false},
-			"tag_name":                 {"tag_name", false},
-			"target_commitish":         {"target_commitish", false},
-			"token":                    {"token", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"assets":     {"assets"},
-			"id":         {"id"},
-			"upload_url": {"upload_url"},
-			"url":        {"url"},
-		},
-	},
-	"subosito/flutter-action@v2": {
-		Name: "Set up Flutter",
-		Inputs: ActionMetadataInputs{
-			"architecture":         {"architecture", false},
-			"cache":                {"cache", false},
-			"cache-key":            {"cache-key", false},
-			"cache-path":           {"cache-path", false},
-			"channel":              {"channel", false},
-			"dry-run":              {"dry-run", false},
-			"flutter-version":      {"flutter-version", false},
-			"flutter-version-file": {"flutter-version-file", false},
-			"pub-cache-key":        {"pub-cache-key", false},
-			"pub-cache-path":       {"pub-cache-path", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"architecture":   {"ARCHITECTURE"},
-			"cache-key":      {"CACHE-KEY"},
-			"cache-path":     {"CACHE-PATH"},
-			"channel":        {"CHANNEL"},
-			"pub-cache-key":  {"PUB-CACHE-KEY"},
-			"pub-cache-path": {"PUB-CACHE-PATH"},
-			"version":        {"VERSION"},
-		},
-	},
-	"treosh/lighthouse-ci-action@v10": {
-		Name: "Lighthouse CI Action",
-		Inputs: ActionMetadataInputs{
-			"artifactname":           {"artifactName", false},
-			"basicauthpassword":      {"basicAuthPassword", false},
-			"basicauthusername":      {"basicAuthUsername", false},
-			"budgetpath":             {"budgetPath", false},
-			"configpath":             {"configPath", false},
-			"runs":                   {"runs", false},
-			"serverbaseurl":          {"serverBaseUrl", false},
-			"servertoken":            {"serverToken", false},
-			"temporarypublicstorage": {"temporaryPublicStorage", false},
-			"uploadartifacts":        {"uploadArtifacts", false},
-			"urls":                   {"urls", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"assertionresults": {"assertionResults"},
-			"links":            {"links"},
-			"resultspath":      {"resultsPath"},
-		},
-	},
-	"treosh/lighthouse-ci-action@v11": {
-		Name: "Lighthouse CI Action",
-		Inputs: ActionMetadataInputs{
-			"artifactname":           {"artifactName", false},
-			"basicauthpassword":      {"basicAuthPassword", false},
-			"basicauthusername":      {"basicAuthUsername", false},
-			"budgetpath":             {"budgetPath", false},
-			"configpath":             {"configPath", false},
-			"runs":                   {"runs", false},
-			"serverbaseurl":          {"serverBaseUrl", false},
-			"servertoken":            {"serverToken", false},
-			"temporarypublicstorage": {"temporaryPublicStorage", false},
-			"uploadartifacts":        {"uploadArtifacts", false},
-			"uploadextraargs":        {"uploadExtraArgs", false},
-			"urls":                   {"urls", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"assertionresults": {"assertionResults"},
-			"links":            {"links"},
-			"resultspath":      {"resultsPath"},
-		},
-	},
-	"treosh/lighthouse-ci-action@v9": {
-		Name: "Lighthouse CI Action",
-		Inputs: ActionMetadataInputs{
-			"artifactname":           {"artifactName", false},
-			"basicauthpassword":      {"basicAuthPassword", false},
-			"basicauthusername":      {"basicAuthUsername", false},
-			"budgetpath":             {"budgetPath", false},
-			"configpath":             {"configPath", false},
-			"runs":                   {"runs", false},
-			"serverbaseurl":          {"serverBaseUrl", false},
-			"servertoken":            {"serverToken", false},
-			"temporarypublicstorage": {"temporaryPublicStorage", false},
-			"uploadartifacts":        {"uploadArtifacts", false},
-			"urls":                   {"urls", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"assertionresults": {"assertionResults"},
-			"links":            {"links"},
-			"resultspath":      {"resultsPath"},
-		},
-	},
-	"wearerequired/lint-action@v2": {
-		Name: "Lint Action",
-		Inputs: ActionMetadataInputs{
-			"auto_fix":                             {"auto_fix", false},
-			"autopep8":                             {"autopep8", false},
-			"autopep8_args":                        {"autopep8_args", false},
-			"autopep8_auto_fix":                    {"autopep8_auto_fix", false},
-			"autopep8_command_prefix":              {"autopep8_command_prefix", false},
-			"autopep8_dir":                         {"autopep8_dir", false},
-			"autopep8_extensions":                  {"autopep8_extensions", false},
-			"black":                                {"black", false},
-			"black_args":                           {"black_args", false},
-			"black_auto_fix":                       {"black_auto_fix", false},
-			"black_command_prefix":                 {"black_command_prefix", false},
-			"black_dir":                            {"black_dir", false},
-			"black_extensions":                     {"black_extensions", false},
-			"check_name":                           {"check_name", false},
-			"clang_format":                         {"clang_format", false},
-			"clang_format_args":                    {"clang_format_args", false},
-			"clang_format_auto_fix":                {"clang_format_auto_fix", false},
-			"clang_format_command_prefix":          {"clang_format_command_prefix", false},
-			"clang_format_dir":                     {"clang_format_dir", false},
-			"clang_format_extensions":              {"clang_format_extensions", false},
-			"commit":                               {"commit", false},
-			"commit_message":                       {"commit_message", false},
-			"continue_on_error":                    {"continue_on_error", false},
-			"dotnet_format":                        {"dotnet_format", false},
-			"dotnet_format_args":                   {"dotnet_format_args", false},
-			"dotnet_format_auto_fix":               {"dotnet_format_auto_fix", false},
-			"dotnet_format_command_prefix":         {"dotnet_format_command_prefix", false},
-			"dotnet_format_dir":                    {"dotnet_format_dir", false},
-			"dotnet_format_extensions":             {"dotnet_format_extensions", false},
-			"erblint":                              {"erblint", false},
-			"erblint_args":                         {"erblint_args", false},
-			"erblint_auto_fix":                     {"erblint_auto_fix", false},
-			"erblint_command_prefix":               {"erblint_command_prefix", false},
-			"erblint_dir":                          {"erblint_dir", false},
-			"erblint_extensions":                   {"erblint_extensions", false},
-			"eslint":                               {"eslint", false},
-			"eslint_args":                          {"eslint_args", false},
-			"eslint_auto_fix":                      {"eslint_auto_fix", false},
-			"eslint_command_prefix":                {"eslint_command_prefix", false},
-			"eslint_dir":                           {"eslint_dir", false},
-			"eslint_extensions":                    {"eslint_extensions", false},
-			"flake8":                               {"flake8", false},
-			"flake8_args":                          {"flake8_args", false},
-			"flake8_auto_fix":                      {"flake8_auto_fix", false},
-			"flake8_command_prefix":                {"flake8_command_prefix", false},
-			"flake8_dir":                           {"flake8_dir", false},
-			"flake8_extensions":                    {"flake8_extensions", false},
-			"git_email":                            {"git_email", false},
-			"git_name":                             {"git_name", false},
-			"git_no_verify":                        {"git_no_verify", false},
-			"github_token":                         {"github_token", false},
-			"gofmt":                                {"gofmt", false},
-			"gofmt_args":                           {"gofmt_args", false},
-			"gofmt_auto_fix":                       {"gofmt_auto_fix", false},
-			"gofmt_command_prefix":                 {"gofmt_command_prefix", false},
-			"gofmt_dir":                            {"gofmt_dir", false},
-			"gofmt_extensions":                     {"gofmt_extensions", false},
-			"golint":                               {"golint", false},
-			"golint_args":                          {"golint_args", false},
-			"golint_auto_fix":                      {"golint_auto_fix", false},
-			"golint_command_prefix":                {"golint_command_prefix", false},
-			"golint_dir":                           {"golint_dir", false},
-			"golint_extensions":                    {"golint_extensions", false},
-			"mypy":                                 {"mypy", false},
-			"mypy_args":                            {"mypy_args", false},
-			"mypy_auto_fix":                        {"mypy_auto_fix", false},
-			"mypy_command_prefix":                  {"mypy_command_prefix", false},
-			"mypy_dir":                             {"mypy_dir", false},
-			"mypy_extensions":                      {"mypy_extensions", false},
-			"neutral_check_on_warning":             {"neutral_check_on_warning", false},
-			"oitnb":                                {"oitnb", false},
-			"oitnb_args":                           {"oitnb_args", false},
-			"oitnb_auto_fix":                       {"oitnb_auto_fix", false},
-			"oitnb_command_prefix":                 {"oitnb_command_prefix", false},
-			"oitnb_dir":                            {"oitnb_dir", false},
-			"oitnb_extensions":                     {"oitnb_extensions", false},
-			"php_codesniffer":                      {"php_codesniffer", false},
-			"php_codesniffer_args":                 {"php_codesniffer_args", false},
-			"php_codesniffer_auto_fix":             {"php_codesniffer_auto_fix", false},
-			"php_codesniffer_command_prefix":       {"php_codesniffer_command_prefix", false},
-			"php_codesniffer_dir":                  {"php_codesniffer_dir", false},
-			"php_codesniffer_extensions":           {"php_codesniffer_extensions", false},
-			"prettier":                             {"prettier", false},
-			"prettier_args":                        {"prettier_args", false},
-			"prettier_auto_fix":                    {"prettier_auto_fix", false},
-			"prettier_command_prefix":              {"prettier_command_prefix", false},
-			"prettier_dir":                         {"prettier_dir", false},
-			"prettier_extensions":                  {"prettier_extensions", false},
-			"pylint":                               {"pylint", false},
-			"pylint_args":                          {"pylint_args", false},
-			"pylint_auto_fix":                      {"pylint_auto_fix", false},
-			"pylint_command_prefix":                {"pylint_command_prefix", false},
-			"pylint_dir":                           {"pylint_dir", false},
-			"pylint_extensions":                    {"pylint_extensions", false},
-			"rubocop":                              {"rubocop", false},
-			"rubocop_args":                         {"rubocop_args", false},
-			"rubocop_auto_fix":                     {"rubocop_auto_fix", false},
-			"rubocop_command_prefix":               {"rubocop_command_prefix", false},
-			"rubocop_dir":                          {"rubocop_dir", false},
-			"rubocop_extensions":                   {"rubocop_extensions", false},
-			"stylelint":                            {"stylelint", false},
-			"stylelint_args":                       {"stylelint_args", false},
-			"stylelint_auto_fix":                   {"stylelint_auto_fix", false},
-			"stylelint_command_prefix":             {"stylelint_command_prefix", false},
-			"stylelint_dir":                        {"stylelint_dir", false},
-			"stylelint_extensions":                 {"stylelint_extensions", false},
-			"swift_format_lockwood":                {"swift_format_lockwood", false},
-			"swift_format_lockwood_args":           {"swift_format_lockwood_args", false},
-			"swift_format_lockwood_auto_fix":       {"swift_format_lockwood_auto_fix", false},
-			"swift_format_lockwood_command_prefix": {"swift_format_lockwood_command_prefix", false},
-			"swift_format_lockwood_dir":            {"swift_format_lockwood_dir", false},
-			"swift_format_lockwood_extensions":     {"swift_format_lockwood_extensions", false},
-			"swift_format_official":                {"swift_format_official", false},
-			"swift_format_official_args":           {"swift_format_official_args", false},
-			"swift_format_official_auto_fix":       {"swift_format_official_auto_fix", false},
-			"swift_format_official_command_prefix": {"swift_format_official_command_prefix", false},
-			"swift_format_official_dir":            {"swift_format_official_dir", false},
-			"swift_format_official_extensions":     {"swift_format_official_extensions", false},
-			"swiftformat":                          {"swiftformat", false},
-			"swiftformat_args":                     {"swiftformat_args", false},
-			"swiftformat_auto_fix":                 {"swiftformat_auto_fix", false},
-			"swiftformat_command_prefix":           {"swiftformat_command_prefix", false},
-			"swiftformat_dir":                      {"swiftformat_dir", false},
-			"swiftformat_extensions":               {"swiftformat_extensions", false},
-			"swiftlint":                            {"swiftlint", false},
-			"swiftlint_args":                       {"swiftlint_args", false},
-			"swiftlint_auto_fix":                   {"swiftlint_auto_fix", false},
-			"swiftlint_command_prefix":             {"swiftlint_command_prefix", false},
-			"swiftlint_dir":                        {"swiftlint_dir", false},
-			"swiftlint_extensions":                 {"swiftlint_extensions", false},
-			"tsc":                                  {"tsc", false},
-			"tsc_args":                             {"tsc_args", false},
-			"tsc_auto_fix":                         {"tsc_auto_fix", false},
-			"tsc_command_prefix":                   {"tsc_command_prefix", false},
-			"tsc_dir":                              {"tsc_dir", false},
-			"tsc_extensions":                       {"tsc_extensions", false},
-			"xo":                                   {"xo", false},
-			"xo_args":                              {"xo_args", false},
-			"xo_auto_fix":                          {"xo_auto_fix", false},
-			"xo_command_prefix":                    {"xo_command_prefix", false},
-			"xo_dir":                               {"xo_dir", false},
-			"xo_extensions":                        {"xo_extensions", false},
-		},
-	},
-}
+// popularActionsJSON is data set of known popular actions encoded in JSON. Keys are specs
+// (owner/repo@ref) of actions and values are their metadata. It is decoded by PopularActions on
+// the first call. Each action is put in one line to make the diff of updates readable.
+const popularActionsJSON = "{" +
+	"\"8398a7/action-slack@v3\":{\"name\":\"action-slack\",\"inputs\":{\"author_name\":{\"name\":\"author_name\",\"required\":false},\"channel\":{\"name\":\"channel\",\"required\":false},\"custom_payload\":{\"name\":\"custom_payload\",\"required\":false},\"fields\":{\"name\":\"fields\",\"required\":false},\"github_base_url\":{\"name\":\"github_base_url\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"icon_emoji\":{\"name\":\"icon_emoji\",\"required\":false},\"icon_url\":{\"name\":\"icon_url\",\"required\":false},\"if_mention\":{\"name\":\"if_mention\",\"required\":false},\"job_name\":{\"name\":\"job_name\",\"required\":false},\"mention\":{\"name\":\"mention\",\"required\":false},\"status\":{\"name\":\"status\",\"required\":true},\"text\":{\"name\":\"text\",\"required\":false},\"username\":{\"name\":\"username\",\"required\":false}}}" +
+	",\"Azure/functions-action@v1\":{\"name\":\"Azure Functions Action\",\"inputs\":{\"app-name\":{\"name\":\"app-name\",\"required\":true},\"enable-oryx-build\":{\"name\":\"enable-oryx-build\",\"required\":false},\"package\":{\"name\":\"package\",\"required\":false},\"publish-profile\":{\"name\":\"publish-profile\",\"required\":false},\"respect-funcignore\":{\"name\":\"respect-funcignore\",\"required\":false},\"respect-pom-xml\":{\"name\":\"respect-pom-xml\",\"required\":false},\"scm-do-build-during-deployment\":{\"name\":\"scm-do-build-during-deployment\",\"required\":false},\"slot-name\":{\"name\":\"slot-name\",\"required\":false}},\"outputs\":{\"app-url\":{\"name\":\"app-url\"},\"package-url\":{\"name\":\"package-url\"}}}" +
+	",\"EnricoMi/publish-unit-test-result-action@v1\":{\"name\":\"Publish Test Results\",\"inputs\":{\"check_name\":{\"name\":\"check_name\",\"required\":false},\"check_run_annotations\":{\"name\":\"check_run_annotations\",\"required\":false},\"check_run_annotations_branch\":{\"name\":\"check_run_annotations_branch\",\"required\":false},\"comment_mode\":{\"name\":\"comment_mode\",\"required\":false},\"comment_on_pr\":{\"name\":\"comment_on_pr\",\"required\":false},\"comment_title\":{\"name\":\"comment_title\",\"required\":false},\"commit\":{\"name\":\"commit\",\"required\":false},\"compare_to_earlier_commit\":{\"name\":\"compare_to_earlier_commit\",\"required\":false},\"deduplicate_classes_by_file_name\":{\"name\":\"deduplicate_classes_by_file_name\",\"required\":false},\"event_file\":{\"name\":\"event_file\",\"required\":false},\"event_name\":{\"name\":\"event_name\",\"required\":false},\"fail_on\":{\"name\":\"fail_on\",\"required\":false},\"files\":{\"name\":\"files\",\"required\":true},\"github_retries\":{\"name\":\"github_retries\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"hide_comments\":{\"name\":\"hide_comments\",\"required\":false},\"ignore_runs\":{\"name\":\"ignore_runs\",\"required\":false},\"job_summary\":{\"name\":\"job_summary\",\"required\":false},\"json_file\":{\"name\":\"json_file\",\"required\":false},\"json_thousands_separator\":{\"name\":\"json_thousands_separator\",\"required\":false},\"pull_request_build\":{\"name\":\"pull_request_build\",\"required\":false},\"report_individual_runs\":{\"name\":\"report_individual_runs\",\"required\":false},\"seconds_between_github_reads\":{\"name\":\"seconds_between_github_reads\",\"required\":false},\"seconds_between_github_writes\":{\"name\":\"seconds_between_github_writes\",\"required\":false},\"test_changes_limit\":{\"name\":\"test_changes_limit\",\"required\":false},\"time_unit\":{\"name\":\"time_unit\",\"required\":false}},\"outputs\":{\"json\":{\"name\":\"json\"}}}" +
+	",\"EnricoMi/publish-unit-test-result-action@v2\":{\"name\":\"Publish Test Results\",\"inputs\":{\"action_fail\":{\"name\":\"action_fail\",\"required\":false},\"action_fail_on_inconclusive\":{\"name\":\"action_fail_on_inconclusive\",\"required\":false},\"check_name\":{\"name\":\"check_name\",\"required\":false},\"check_run\":{\"name\":\"check_run\",\"required\":false},\"check_run_annotations\":{\"name\":\"check_run_annotations\",\"required\":false},\"check_run_annotations_branch\":{\"name\":\"check_run_annotations_branch\",\"required\":false},\"comment_mode\":{\"name\":\"comment_mode\",\"required\":false},\"comment_title\":{\"name\":\"comment_title\",\"required\":false},\"commit\":{\"name\":\"commit\",\"required\":false},\"compare_to_earlier_commit\":{\"name\":\"compare_to_earlier_commit\",\"required\":false},\"deduplicate_classes_by_file_name\":{\"name\":\"deduplicate_classes_by_file_name\",\"required\":false},\"event_file\":{\"name\":\"event_file\",\"required\":false},\"event_name\":{\"name\":\"event_name\",\"required\":false},\"fail_on\":{\"name\":\"fail_on\",\"required\":false},\"files\":{\"name\":\"files\",\"required\":false},\"github_retries\":{\"name\":\"github_retries\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"github_token_actor\":{\"name\":\"github_token_actor\",\"required\":false},\"ignore_runs\":{\"name\":\"ignore_runs\",\"required\":false},\"job_summary\":{\"name\":\"job_summary\",\"required\":false},\"json_file\":{\"name\":\"json_file\",\"required\":false},\"json_suite_details\":{\"name\":\"json_suite_details\",\"required\":false},\"json_test_case_results\":{\"name\":\"json_test_case_results\",\"required\":false},\"json_thousands_separator\":{\"name\":\"json_thousands_separator\",\"required\":false},\"junit_files\":{\"name\":\"junit_files\",\"required\":false},\"large_files\":{\"name\":\"large_files\",\"required\":false},\"nunit_files\":{\"name\":\"nunit_files\",\"required\":false},\"pull_request_build\":{\"name\":\"pull_request_build\",\"required\":false},\"report_individual_runs\":{\"name\":\"report_individual_runs\",\"required\":false},\"report_suite_logs\":{\"name\":\"report_suite_logs\",\"required\":false},\"search_pull_requests\":{\"name\":\"search_pull_requests\",\"required\":false},\"secondary_rate_limit_wait_seconds\":{\"name\":\"secondary_rate_limit_wait_seconds\",\"required\":false},\"seconds_between_github_reads\":{\"name\":\"seconds_between_github_reads\",\"required\":false},\"seconds_between_github_writes\":{\"name\":\"seconds_between_github_writes\",\"required\":false},\"test_changes_limit\":{\"name\":\"test_changes_limit\",\"required\":false},\"test_file_prefix\":{\"name\":\"test_file_prefix\",\"required\":false},\"time_unit\":{\"name\":\"time_unit\",\"required\":false},\"trx_files\":{\"name\":\"trx_files\",\"required\":false},\"xunit_files\":{\"name\":\"xunit_files\",\"required\":false}},\"outputs\":{\"json\":{\"name\":\"json\"}}}" +
+	",\"JamesIves/github-pages-deploy-action@releases/v4\":{\"name\":\"Deploy to GitHub Pages\",\"inputs\":{\"branch\":{\"name\":\"branch\",\"required\":false},\"clean\":{\"name\":\"clean\",\"required\":false},\"clean-exclude\":{\"name\":\"clean-exclude\",\"required\":false},\"commit-message\":{\"name\":\"commit-message\",\"required\":false},\"dry-run\":{\"name\":\"dry-run\",\"required\":false},\"folder\":{\"name\":\"folder\",\"required\":true},\"force\":{\"name\":\"force\",\"required\":false},\"git-config-email\":{\"name\":\"git-config-email\",\"required\":false},\"git-config-name\":{\"name\":\"git-config-name\",\"required\":false},\"repository-name\":{\"name\":\"repository-name\",\"required\":false},\"silent\":{\"name\":\"silent\",\"required\":false},\"single-commit\":{\"name\":\"single-commit\",\"required\":false},\"ssh-key\":{\"name\":\"ssh-key\",\"required\":false},\"tag\":{\"name\":\"tag\",\"required\":false},\"target-folder\":{\"name\":\"target-folder\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"deployment-status\":{\"name\":\"deployment-status\"}}}" +
+	",\"ReactiveCircus/android-emulator-runner@v2\":{\"name\":\"Android Emulator Runner\",\"inputs\":{\"api-level\":{\"name\":\"api-level\",\"required\":true},\"arch\":{\"name\":\"arch\",\"required\":false},\"avd-name\":{\"name\":\"avd-name\",\"required\":false},\"channel\":{\"name\":\"channel\",\"required\":false},\"cmake\":{\"name\":\"cmake\",\"required\":false},\"cores\":{\"name\":\"cores\",\"required\":false},\"disable-animations\":{\"name\":\"disable-animations\",\"required\":false},\"disable-linux-hw-accel\":{\"name\":\"disable-linux-hw-accel\",\"required\":false},\"disable-spellchecker\":{\"name\":\"disable-spellchecker\",\"required\":false},\"disk-size\":{\"name\":\"disk-size\",\"required\":false},\"emulator-boot-timeout\":{\"name\":\"emulator-boot-timeout\",\"required\":false},\"emulator-build\":{\"name\":\"emulator-build\",\"required\":false},\"emulator-options\":{\"name\":\"emulator-options\",\"required\":false},\"enable-hw-keyboard\":{\"name\":\"enable-hw-keyboard\",\"required\":false},\"force-avd-creation\":{\"name\":\"force-avd-creation\",\"required\":false},\"heap-size\":{\"name\":\"heap-size\",\"required\":false},\"ndk\":{\"name\":\"ndk\",\"required\":false},\"pre-emulator-launch-script\":{\"name\":\"pre-emulator-launch-script\",\"required\":false},\"profile\":{\"name\":\"profile\",\"required\":false},\"ram-size\":{\"name\":\"ram-size\",\"required\":false},\"script\":{\"name\":\"script\",\"required\":true},\"sdcard-path-or-size\":{\"name\":\"sdcard-path-or-size\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"Swatinem/rust-cache@v2\":{\"name\":\"Rust Cache\",\"inputs\":{\"cache-all-crates\":{\"name\":\"cache-all-crates\",\"required\":false},\"cache-directories\":{\"name\":\"cache-directories\",\"required\":false},\"cache-on-failure\":{\"name\":\"cache-on-failure\",\"required\":false},\"cache-provider\":{\"name\":\"cache-provider\",\"required\":false},\"cache-targets\":{\"name\":\"cache-targets\",\"required\":false},\"env-vars\":{\"name\":\"env-vars\",\"required\":false},\"key\":{\"name\":\"key\",\"required\":false},\"prefix-key\":{\"name\":\"prefix-key\",\"required\":false},\"save-if\":{\"name\":\"save-if\",\"required\":false},\"shared-key\":{\"name\":\"shared-key\",\"required\":false},\"workspaces\":{\"name\":\"workspaces\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"}}}" +
+	",\"actions-cool/issues-helper@v3\":{\"name\":\"Issues Helper\",\"inputs\":{\"actions\":{\"name\":\"actions\",\"required\":false},\"assign-command\":{\"name\":\"assign-command\",\"required\":false},\"assignee-includes\":{\"name\":\"assignee-includes\",\"required\":false},\"assignees\":{\"name\":\"assignees\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"body-includes\":{\"name\":\"body-includes\",\"required\":false},\"close-issue\":{\"name\":\"close-issue\",\"required\":false},\"close-reason\":{\"name\":\"close-reason\",\"required\":false},\"comment-auth\":{\"name\":\"comment-auth\",\"required\":false},\"comment-id\":{\"name\":\"comment-id\",\"required\":false},\"direction\":{\"name\":\"direction\",\"required\":false},\"duplicate-command\":{\"name\":\"duplicate-command\",\"required\":false},\"duplicate-labels\":{\"name\":\"duplicate-labels\",\"required\":false},\"emoji\":{\"name\":\"emoji\",\"required\":false},\"exclude-labels\":{\"name\":\"exclude-labels\",\"required\":false},\"inactive-day\":{\"name\":\"inactive-day\",\"required\":false},\"inactive-label\":{\"name\":\"inactive-label\",\"required\":false},\"inactive-mode\":{\"name\":\"inactive-mode\",\"required\":false},\"issue-assignee\":{\"name\":\"issue-assignee\",\"required\":false},\"issue-creator\":{\"name\":\"issue-creator\",\"required\":false},\"issue-emoji\":{\"name\":\"issue-emoji\",\"required\":false},\"issue-mentioned\":{\"name\":\"issue-mentioned\",\"required\":false},\"issue-number\":{\"name\":\"issue-number\",\"required\":false},\"issue-state\":{\"name\":\"issue-state\",\"required\":false},\"label-color\":{\"name\":\"label-color\",\"required\":false},\"label-desc\":{\"name\":\"label-desc\",\"required\":false},\"label-name\":{\"name\":\"label-name\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"lock-reason\":{\"name\":\"lock-reason\",\"required\":false},\"random-to\":{\"name\":\"random-to\",\"required\":false},\"remove-labels\":{\"name\":\"remove-labels\",\"required\":false},\"repo\":{\"name\":\"repo\",\"required\":false},\"require-permission\":{\"name\":\"require-permission\",\"required\":false},\"state\":{\"name\":\"state\",\"required\":false},\"title\":{\"name\":\"title\",\"required\":false},\"title-excludes\":{\"name\":\"title-excludes\",\"required\":false},\"title-includes\":{\"name\":\"title-includes\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"update-mode\":{\"name\":\"update-mode\",\"required\":false}},\"outputs\":{\"check-result\":{\"name\":\"check-result\"},\"comment-id\":{\"name\":\"comment-id\"},\"comments\":{\"name\":\"comments\"},\"issue-assignees\":{\"name\":\"issue-assignees\"},\"issue-body\":{\"name\":\"issue-body\"},\"issue-labels\":{\"name\":\"issue-labels\"},\"issue-number\":{\"name\":\"issue-number\"},\"issue-state\":{\"name\":\"issue-state\"},\"issue-title\":{\"name\":\"issue-title\"},\"issues\":{\"name\":\"issues\"}}}" +
+	",\"actions/add-to-project@v1.0.1\":{\"name\":\"Add To GitHub projects\",\"inputs\":{\"github-token\":{\"name\":\"github-token\",\"required\":true},\"label-operator\":{\"name\":\"label-operator\",\"required\":false},\"labeled\":{\"name\":\"labeled\",\"required\":false},\"project-url\":{\"name\":\"project-url\",\"required\":true}},\"outputs\":{\"itemid\":{\"name\":\"itemId\"}}}" +
+	",\"actions/attest-build-provenance@v1\":{\"name\":\"Attest Build Provenance\",\"inputs\":{\"github-token\":{\"name\":\"github-token\",\"required\":false},\"push-to-registry\":{\"name\":\"push-to-registry\",\"required\":false},\"subject-digest\":{\"name\":\"subject-digest\",\"required\":false},\"subject-name\":{\"name\":\"subject-name\",\"required\":false},\"subject-path\":{\"name\":\"subject-path\",\"required\":false}},\"outputs\":{\"bundle-path\":{\"name\":\"bundle-path\"}}}" +
+	",\"actions/cache@v3\":{\"name\":\"Cache\",\"inputs\":{\"enablecrossosarchive\":{\"name\":\"enableCrossOsArchive\",\"required\":false},\"fail-on-cache-miss\":{\"name\":\"fail-on-cache-miss\",\"required\":false},\"key\":{\"name\":\"key\",\"required\":true},\"lookup-only\":{\"name\":\"lookup-only\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":true},\"restore-keys\":{\"name\":\"restore-keys\",\"required\":false},\"upload-chunk-size\":{\"name\":\"upload-chunk-size\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"}}}" +
+	",\"actions/cache@v4\":{\"name\":\"Cache\",\"inputs\":{\"enablecrossosarchive\":{\"name\":\"enableCrossOsArchive\",\"required\":false},\"fail-on-cache-miss\":{\"name\":\"fail-on-cache-miss\",\"required\":false},\"key\":{\"name\":\"key\",\"required\":true},\"lookup-only\":{\"name\":\"lookup-only\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":true},\"restore-keys\":{\"name\":\"restore-keys\",\"required\":false},\"save-always\":{\"name\":\"save-always\",\"required\":false,\"deprecated\":\"save-always does not work as intended and will be removed in a future release.\\nA separate `actions/cache/restore` step should be used instead.\\nSee https://github.com/actions/cache/tree/main/save#always-save-cache for more details.\"},\"upload-chunk-size\":{\"name\":\"upload-chunk-size\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"}}}" +
+	",\"actions/checkout@v1\":{\"name\":\"Checkout\",\"inputs\":{\"clean\":{\"name\":\"clean\",\"required\":false},\"fetch-depth\":{\"name\":\"fetch-depth\",\"required\":false},\"lfs\":{\"name\":\"lfs\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"ref\":{\"name\":\"ref\",\"required\":false},\"repository\":{\"name\":\"repository\",\"required\":false},\"submodules\":{\"name\":\"submodules\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}}}" +
+	",\"actions/checkout@v3\":{\"name\":\"Checkout\",\"inputs\":{\"clean\":{\"name\":\"clean\",\"required\":false},\"fetch-depth\":{\"name\":\"fetch-depth\",\"required\":false},\"fetch-tags\":{\"name\":\"fetch-tags\",\"required\":false},\"github-server-url\":{\"name\":\"github-server-url\",\"required\":false},\"lfs\":{\"name\":\"lfs\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"persist-credentials\":{\"name\":\"persist-credentials\",\"required\":false},\"ref\":{\"name\":\"ref\",\"required\":false},\"repository\":{\"name\":\"repository\",\"required\":false},\"set-safe-directory\":{\"name\":\"set-safe-directory\",\"required\":false},\"sparse-checkout\":{\"name\":\"sparse-checkout\",\"required\":false},\"sparse-checkout-cone-mode\":{\"name\":\"sparse-checkout-cone-mode\",\"required\":false},\"ssh-key\":{\"name\":\"ssh-key\",\"required\":false},\"ssh-known-hosts\":{\"name\":\"ssh-known-hosts\",\"required\":false},\"ssh-strict\":{\"name\":\"ssh-strict\",\"required\":false},\"submodules\":{\"name\":\"submodules\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}}}" +
+	",\"actions/checkout@v4\":{\"name\":\"Checkout\",\"inputs\":{\"clean\":{\"name\":\"clean\",\"required\":false},\"fetch-depth\":{\"name\":\"fetch-depth\",\"required\":false},\"fetch-tags\":{\"name\":\"fetch-tags\",\"required\":false},\"filter\":{\"name\":\"filter\",\"required\":false},\"github-server-url\":{\"name\":\"github-server-url\",\"required\":false},\"lfs\":{\"name\":\"lfs\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"persist-credentials\":{\"name\":\"persist-credentials\",\"required\":false},\"ref\":{\"name\":\"ref\",\"required\":false},\"repository\":{\"name\":\"repository\",\"required\":false},\"set-safe-directory\":{\"name\":\"set-safe-directory\",\"required\":false},\"show-progress\":{\"name\":\"show-progress\",\"required\":false},\"sparse-checkout\":{\"name\":\"sparse-checkout\",\"required\":false},\"sparse-checkout-cone-mode\":{\"name\":\"sparse-checkout-cone-mode\",\"required\":false},\"ssh-key\":{\"name\":\"ssh-key\",\"required\":false},\"ssh-known-hosts\":{\"name\":\"ssh-known-hosts\",\"required\":false},\"ssh-strict\":{\"name\":\"ssh-strict\",\"required\":false},\"ssh-user\":{\"name\":\"ssh-user\",\"required\":false},\"submodules\":{\"name\":\"submodules\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}}}" +
+	",\"actions/configure-pages@v1\":{\"name\":\"Configure GitHub Pages\",\"inputs\":{\"enablement\":{\"name\":\"enablement\",\"required\":false},\"generator_config_file\":{\"name\":\"generator_config_file\",\"required\":false},\"static_site_generator\":{\"name\":\"static_site_generator\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"base_path\":{\"name\":\"base_path\"},\"base_url\":{\"name\":\"base_url\"},\"host\":{\"name\":\"host\"},\"origin\":{\"name\":\"origin\"}}}" +
+	",\"actions/configure-pages@v2\":{\"name\":\"Configure GitHub Pages\",\"inputs\":{\"enablement\":{\"name\":\"enablement\",\"required\":false},\"generator_config_file\":{\"name\":\"generator_config_file\",\"required\":false},\"static_site_generator\":{\"name\":\"static_site_generator\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"base_path\":{\"name\":\"base_path\"},\"base_url\":{\"name\":\"base_url\"},\"host\":{\"name\":\"host\"},\"origin\":{\"name\":\"origin\"}}}" +
+	",\"actions/configure-pages@v3\":{\"name\":\"Configure GitHub Pages\",\"inputs\":{\"enablement\":{\"name\":\"enablement\",\"required\":false},\"generator_config_file\":{\"name\":\"generator_config_file\",\"required\":false},\"static_site_generator\":{\"name\":\"static_site_generator\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"base_path\":{\"name\":\"base_path\"},\"base_url\":{\"name\":\"base_url\"},\"host\":{\"name\":\"host\"},\"origin\":{\"name\":\"origin\"}}}" +
+	",\"actions/configure-pages@v4\":{\"name\":\"Configure GitHub Pages\",\"inputs\":{\"enablement\":{\"name\":\"enablement\",\"required\":false},\"generator_config_file\":{\"name\":\"generator_config_file\",\"required\":false},\"static_site_generator\":{\"name\":\"static_site_generator\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"base_path\":{\"name\":\"base_path\"},\"base_url\":{\"name\":\"base_url\"},\"host\":{\"name\":\"host\"},\"origin\":{\"name\":\"origin\"}}}" +
+	",\"actions/configure-pages@v5\":{\"name\":\"Configure GitHub Pages\",\"inputs\":{\"enablement\":{\"name\":\"enablement\",\"required\":false},\"generator_config_file\":{\"name\":\"generator_config_file\",\"required\":false},\"static_site_generator\":{\"name\":\"static_site_generator\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"base_path\":{\"name\":\"base_path\"},\"base_url\":{\"name\":\"base_url\"},\"host\":{\"name\":\"host\"},\"origin\":{\"name\":\"origin\"}}}" +
+	",\"actions/delete-package-versions@v3\":{\"name\":\"Delete Package Versions\",\"inputs\":{\"delete-only-pre-release-versions\":{\"name\":\"delete-only-pre-release-versions\",\"required\":false},\"ignore-versions\":{\"name\":\"ignore-versions\",\"required\":false},\"min-versions-to-keep\":{\"name\":\"min-versions-to-keep\",\"required\":false},\"num-old-versions-to-delete\":{\"name\":\"num-old-versions-to-delete\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"package-name\":{\"name\":\"package-name\",\"required\":false},\"package-version-ids\":{\"name\":\"package-version-ids\",\"required\":false},\"repo\":{\"name\":\"repo\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}}}" +
+	",\"actions/delete-package-versions@v4\":{\"name\":\"Delete Package Versions\",\"inputs\":{\"delete-only-pre-release-versions\":{\"name\":\"delete-only-pre-release-versions\",\"required\":false},\"delete-only-untagged-versions\":{\"name\":\"delete-only-untagged-versions\",\"required\":false},\"ignore-versions\":{\"name\":\"ignore-versions\",\"required\":false},\"min-versions-to-keep\":{\"name\":\"min-versions-to-keep\",\"required\":false},\"num-old-versions-to-delete\":{\"name\":\"num-old-versions-to-delete\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"package-name\":{\"name\":\"package-name\",\"required\":true},\"package-type\":{\"name\":\"package-type\",\"required\":true},\"package-version-ids\":{\"name\":\"package-version-ids\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}}}" +
+	",\"actions/delete-package-versions@v5\":{\"name\":\"Delete Package Versions\",\"inputs\":{\"delete-only-pre-release-versions\":{\"name\":\"delete-only-pre-release-versions\",\"required\":false},\"delete-only-untagged-versions\":{\"name\":\"delete-only-untagged-versions\",\"required\":false},\"ignore-versions\":{\"name\":\"ignore-versions\",\"required\":false},\"min-versions-to-keep\":{\"name\":\"min-versions-to-keep\",\"required\":false},\"num-old-versions-to-delete\":{\"name\":\"num-old-versions-to-delete\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"package-name\":{\"name\":\"package-name\",\"required\":true},\"package-type\":{\"name\":\"package-type\",\"required\":true},\"package-version-ids\":{\"name\":\"package-version-ids\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}}}" +
+	",\"actions/dependency-review-action@v3\":{\"name\":\"Dependency Review\",\"inputs\":{\"allow-dependencies-licenses\":{\"name\":\"allow-dependencies-licenses\",\"required\":false},\"allow-ghsas\":{\"name\":\"allow-ghsas\",\"required\":false},\"allow-licenses\":{\"name\":\"allow-licenses\",\"required\":false},\"base-ref\":{\"name\":\"base-ref\",\"required\":false},\"comment-summary-in-pr\":{\"name\":\"comment-summary-in-pr\",\"required\":false},\"config-file\":{\"name\":\"config-file\",\"required\":false},\"deny-groups\":{\"name\":\"deny-groups\",\"required\":false},\"deny-licenses\":{\"name\":\"deny-licenses\",\"required\":false},\"deny-packages\":{\"name\":\"deny-packages\",\"required\":false},\"external-repo-token\":{\"name\":\"external-repo-token\",\"required\":false},\"fail-on-scopes\":{\"name\":\"fail-on-scopes\",\"required\":false},\"fail-on-severity\":{\"name\":\"fail-on-severity\",\"required\":false},\"head-ref\":{\"name\":\"head-ref\",\"required\":false},\"license-check\":{\"name\":\"license-check\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"retry-on-snapshot-warnings\":{\"name\":\"retry-on-snapshot-warnings\",\"required\":false},\"retry-on-snapshot-warnings-timeout\":{\"name\":\"retry-on-snapshot-warnings-timeout\",\"required\":false},\"vulnerability-check\":{\"name\":\"vulnerability-check\",\"required\":false}}}" +
+	",\"actions/dependency-review-action@v4\":{\"name\":\"Dependency Review\",\"inputs\":{\"allow-dependencies-licenses\":{\"name\":\"allow-dependencies-licenses\",\"required\":false},\"allow-ghsas\":{\"name\":\"allow-ghsas\",\"required\":false},\"allow-licenses\":{\"name\":\"allow-licenses\",\"required\":false},\"base-ref\":{\"name\":\"base-ref\",\"required\":false},\"comment-summary-in-pr\":{\"name\":\"comment-summary-in-pr\",\"required\":false},\"config-file\":{\"name\":\"config-file\",\"required\":false},\"deny-groups\":{\"name\":\"deny-groups\",\"required\":false},\"deny-licenses\":{\"name\":\"deny-licenses\",\"required\":false},\"deny-packages\":{\"name\":\"deny-packages\",\"required\":false},\"external-repo-token\":{\"name\":\"external-repo-token\",\"required\":false},\"fail-on-scopes\":{\"name\":\"fail-on-scopes\",\"required\":false},\"fail-on-severity\":{\"name\":\"fail-on-severity\",\"required\":false},\"head-ref\":{\"name\":\"head-ref\",\"required\":false},\"license-check\":{\"name\":\"license-check\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"retry-on-snapshot-warnings\":{\"name\":\"retry-on-snapshot-warnings\",\"required\":false},\"retry-on-snapshot-warnings-timeout\":{\"name\":\"retry-on-snapshot-warnings-timeout\",\"required\":false},\"show-openssf-scorecard\":{\"name\":\"show-openssf-scorecard\",\"required\":false},\"vulnerability-check\":{\"name\":\"vulnerability-check\",\"required\":false},\"warn-on-openssf-scorecard-level\":{\"name\":\"warn-on-openssf-scorecard-level\",\"required\":false},\"warn-only\":{\"name\":\"warn-only\",\"required\":false}},\"outputs\":{\"comment-content\":{\"name\":\"comment-content\"},\"denied-changes\":{\"name\":\"denied-changes\"},\"dependency-changes\":{\"name\":\"dependency-changes\"},\"invalid-license-changes\":{\"name\":\"invalid-license-changes\"},\"vulnerable-changes\":{\"name\":\"vulnerable-changes\"}}}" +
+	",\"actions/deploy-pages@v1\":{\"name\":\"Deploy GitHub Pages site\",\"inputs\":{\"artifact_name\":{\"name\":\"artifact_name\",\"required\":false},\"conclusion\":{\"name\":\"conclusion\",\"required\":false},\"emit_telemetry\":{\"name\":\"emit_telemetry\",\"required\":false},\"error_count\":{\"name\":\"error_count\",\"required\":false},\"preview\":{\"name\":\"preview\",\"required\":false},\"reporting_interval\":{\"name\":\"reporting_interval\",\"required\":false},\"timeout\":{\"name\":\"timeout\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"page_url\":{\"name\":\"page_url\"}}}" +
+	",\"actions/deploy-pages@v2\":{\"name\":\"Deploy GitHub Pages site\",\"inputs\":{\"artifact_name\":{\"name\":\"artifact_name\",\"required\":false},\"error_count\":{\"name\":\"error_count\",\"required\":false},\"preview\":{\"name\":\"preview\",\"required\":false},\"reporting_interval\":{\"name\":\"reporting_interval\",\"required\":false},\"timeout\":{\"name\":\"timeout\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"page_url\":{\"name\":\"page_url\"}}}" +
+	",\"actions/deploy-pages@v3\":{\"name\":\"Deploy GitHub Pages site\",\"inputs\":{\"artifact_name\":{\"name\":\"artifact_name\",\"required\":false},\"error_count\":{\"name\":\"error_count\",\"required\":false},\"preview\":{\"name\":\"preview\",\"required\":false},\"reporting_interval\":{\"name\":\"reporting_interval\",\"required\":false},\"timeout\":{\"name\":\"timeout\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"page_url\":{\"name\":\"page_url\"}}}" +
+	",\"actions/deploy-pages@v4\":{\"name\":\"Deploy GitHub Pages site\",\"inputs\":{\"artifact_name\":{\"name\":\"artifact_name\",\"required\":false},\"error_count\":{\"name\":\"error_count\",\"required\":false},\"preview\":{\"name\":\"preview\",\"required\":false},\"reporting_interval\":{\"name\":\"reporting_interval\",\"required\":false},\"timeout\":{\"name\":\"timeout\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"page_url\":{\"name\":\"page_url\"}}}" +
+	",\"actions/download-artifact@v1\":{\"name\":\"Download a Build Artifact\",\"inputs\":{\"name\":{\"name\":\"name\",\"required\":true},\"path\":{\"name\":\"path\",\"required\":false}}}" +
+	",\"actions/download-artifact@v3\":{\"name\":\"Download a Build Artifact\",\"inputs\":{\"name\":{\"name\":\"name\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false}}}" +
+	",\"actions/download-artifact@v4\":{\"name\":\"Download a Build Artifact\",\"inputs\":{\"github-token\":{\"name\":\"github-token\",\"required\":false},\"merge-multiple\":{\"name\":\"merge-multiple\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"pattern\":{\"name\":\"pattern\",\"required\":false},\"repository\":{\"name\":\"repository\",\"required\":false},\"run-id\":{\"name\":\"run-id\",\"required\":false}},\"outputs\":{\"download-path\":{\"name\":\"download-path\"}}}" +
+	",\"actions/first-interaction@v1\":{\"name\":\"First interaction\",\"inputs\":{\"issue-message\":{\"name\":\"issue-message\",\"required\":false},\"pr-message\":{\"name\":\"pr-message\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":true}},\"triggers\":[\"issues\",\"pull_request\",\"pull_request_target\"]}" +
+	",\"actions/github-script@v6\":{\"name\":\"GitHub Script\",\"inputs\":{\"debug\":{\"name\":\"debug\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"previews\":{\"name\":\"previews\",\"required\":false},\"result-encoding\":{\"name\":\"result-encoding\",\"required\":false},\"retries\":{\"name\":\"retries\",\"required\":false},\"retry-exempt-status-codes\":{\"name\":\"retry-exempt-status-codes\",\"required\":false},\"script\":{\"name\":\"script\",\"required\":true},\"user-agent\":{\"name\":\"user-agent\",\"required\":false}},\"outputs\":{\"result\":{\"name\":\"result\"}}}" +
+	",\"actions/github-script@v7\":{\"name\":\"GitHub Script\",\"inputs\":{\"base-url\":{\"name\":\"base-url\",\"required\":false},\"debug\":{\"name\":\"debug\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"previews\":{\"name\":\"previews\",\"required\":false},\"result-encoding\":{\"name\":\"result-encoding\",\"required\":false},\"retries\":{\"name\":\"retries\",\"required\":false},\"retry-exempt-status-codes\":{\"name\":\"retry-exempt-status-codes\",\"required\":false},\"script\":{\"name\":\"script\",\"required\":true},\"user-agent\":{\"name\":\"user-agent\",\"required\":false}},\"outputs\":{\"result\":{\"name\":\"result\"}}}" +
+	",\"actions/labeler@v4\":{\"name\":\"Labeler\",\"inputs\":{\"configuration-path\":{\"name\":\"configuration-path\",\"required\":false},\"dot\":{\"name\":\"dot\",\"required\":false},\"pr-number\":{\"name\":\"pr-number\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"sync-labels\":{\"name\":\"sync-labels\",\"required\":false}},\"outputs\":{\"all-labels\":{\"name\":\"all-labels\"},\"new-labels\":{\"name\":\"new-labels\"}},\"triggers\":[\"pull_request\",\"pull_request_target\",\"workflow_dispatch\"]}" +
+	",\"actions/labeler@v5\":{\"name\":\"Labeler\",\"inputs\":{\"configuration-path\":{\"name\":\"configuration-path\",\"required\":false},\"dot\":{\"name\":\"dot\",\"required\":false},\"pr-number\":{\"name\":\"pr-number\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"sync-labels\":{\"name\":\"sync-labels\",\"required\":false}},\"outputs\":{\"all-labels\":{\"name\":\"all-labels\"},\"new-labels\":{\"name\":\"new-labels\"}},\"triggers\":[\"pull_request\",\"pull_request_target\",\"workflow_dispatch\"]}" +
+	",\"actions/setup-dotnet@v2\":{\"name\":\"Setup .NET Core SDK\",\"inputs\":{\"config-file\":{\"name\":\"config-file\",\"required\":false},\"dotnet-version\":{\"name\":\"dotnet-version\",\"required\":false},\"global-json-file\":{\"name\":\"global-json-file\",\"required\":false},\"include-prerelease\":{\"name\":\"include-prerelease\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"source-url\":{\"name\":\"source-url\",\"required\":false}}}" +
+	",\"actions/setup-dotnet@v3\":{\"name\":\"Setup .NET Core SDK\",\"inputs\":{\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"config-file\":{\"name\":\"config-file\",\"required\":false},\"dotnet-quality\":{\"name\":\"dotnet-quality\",\"required\":false},\"dotnet-version\":{\"name\":\"dotnet-version\",\"required\":false},\"global-json-file\":{\"name\":\"global-json-file\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"source-url\":{\"name\":\"source-url\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"dotnet-version\":{\"name\":\"dotnet-version\"}}}" +
+	",\"actions/setup-dotnet@v4\":{\"name\":\"Setup .NET Core SDK\",\"inputs\":{\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"config-file\":{\"name\":\"config-file\",\"required\":false},\"dotnet-quality\":{\"name\":\"dotnet-quality\",\"required\":false},\"dotnet-version\":{\"name\":\"dotnet-version\",\"required\":false},\"global-json-file\":{\"name\":\"global-json-file\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"source-url\":{\"name\":\"source-url\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"dotnet-version\":{\"name\":\"dotnet-version\"}}}" +
+	",\"actions/setup-go@v3\":{\"name\":\"Setup Go environment\",\"inputs\":{\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"go-version\":{\"name\":\"go-version\",\"required\":false},\"go-version-file\":{\"name\":\"go-version-file\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"go-version\":{\"name\":\"go-version\"}}}" +
+	",\"actions/setup-go@v4\":{\"name\":\"Setup Go environment\",\"inputs\":{\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"go-version\":{\"name\":\"go-version\",\"required\":false},\"go-version-file\":{\"name\":\"go-version-file\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"go-version\":{\"name\":\"go-version\"}}}" +
+	",\"actions/setup-go@v5\":{\"name\":\"Setup Go environment\",\"inputs\":{\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"go-version\":{\"name\":\"go-version\",\"required\":false},\"go-version-file\":{\"name\":\"go-version-file\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"go-version\":{\"name\":\"go-version\"}}}" +
+	",\"actions/setup-java@v3\":{\"name\":\"Setup Java JDK\",\"inputs\":{\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"distribution\":{\"name\":\"distribution\",\"required\":true},\"gpg-passphrase\":{\"name\":\"gpg-passphrase\",\"required\":false},\"gpg-private-key\":{\"name\":\"gpg-private-key\",\"required\":false},\"java-package\":{\"name\":\"java-package\",\"required\":false},\"java-version\":{\"name\":\"java-version\",\"required\":false},\"java-version-file\":{\"name\":\"java-version-file\",\"required\":false},\"jdkfile\":{\"name\":\"jdkFile\",\"required\":false},\"job-status\":{\"name\":\"job-status\",\"required\":false},\"mvn-toolchain-id\":{\"name\":\"mvn-toolchain-id\",\"required\":false},\"mvn-toolchain-vendor\":{\"name\":\"mvn-toolchain-vendor\",\"required\":false},\"overwrite-settings\":{\"name\":\"overwrite-settings\",\"required\":false},\"server-id\":{\"name\":\"server-id\",\"required\":false},\"server-password\":{\"name\":\"server-password\",\"required\":false},\"server-username\":{\"name\":\"server-username\",\"required\":false},\"settings-path\":{\"name\":\"settings-path\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"distribution\":{\"name\":\"distribution\"},\"path\":{\"name\":\"path\"},\"version\":{\"name\":\"version\"}}}" +
+	",\"actions/setup-java@v4\":{\"name\":\"Setup Java JDK\",\"inputs\":{\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"distribution\":{\"name\":\"distribution\",\"required\":true},\"gpg-passphrase\":{\"name\":\"gpg-passphrase\",\"required\":false},\"gpg-private-key\":{\"name\":\"gpg-private-key\",\"required\":false},\"java-package\":{\"name\":\"java-package\",\"required\":false},\"java-version\":{\"name\":\"java-version\",\"required\":false},\"java-version-file\":{\"name\":\"java-version-file\",\"required\":false},\"jdkfile\":{\"name\":\"jdkFile\",\"required\":false},\"job-status\":{\"name\":\"job-status\",\"required\":false},\"mvn-toolchain-id\":{\"name\":\"mvn-toolchain-id\",\"required\":false},\"mvn-toolchain-vendor\":{\"name\":\"mvn-toolchain-vendor\",\"required\":false},\"overwrite-settings\":{\"name\":\"overwrite-settings\",\"required\":false},\"server-id\":{\"name\":\"server-id\",\"required\":false},\"server-password\":{\"name\":\"server-password\",\"required\":false},\"server-username\":{\"name\":\"server-username\",\"required\":false},\"settings-path\":{\"name\":\"settings-path\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"distribution\":{\"name\":\"distribution\"},\"path\":{\"name\":\"path\"},\"version\":{\"name\":\"version\"}}}" +
+	",\"actions/setup-node@v3\":{\"name\":\"Setup Node.js environment\",\"inputs\":{\"always-auth\":{\"name\":\"always-auth\",\"required\":false},\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"node-version\":{\"name\":\"node-version\",\"required\":false},\"node-version-file\":{\"name\":\"node-version-file\",\"required\":false},\"registry-url\":{\"name\":\"registry-url\",\"required\":false},\"scope\":{\"name\":\"scope\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"node-version\":{\"name\":\"node-version\"}}}" +
+	",\"actions/setup-node@v4\":{\"name\":\"Setup Node.js environment\",\"inputs\":{\"always-auth\":{\"name\":\"always-auth\",\"required\":false},\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"node-version\":{\"name\":\"node-version\",\"required\":false},\"node-version-file\":{\"name\":\"node-version-file\",\"required\":false},\"registry-url\":{\"name\":\"registry-url\",\"required\":false},\"scope\":{\"name\":\"scope\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"node-version\":{\"name\":\"node-version\"}}}" +
+	",\"actions/setup-python@v3\":{\"name\":\"Setup Python\",\"inputs\":{\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"python-version\":{\"name\":\"python-version\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"python-version\":{\"name\":\"python-version\"}}}" +
+	",\"actions/setup-python@v4\":{\"name\":\"Setup Python\",\"inputs\":{\"allow-prereleases\":{\"name\":\"allow-prereleases\",\"required\":false},\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"python-version\":{\"name\":\"python-version\",\"required\":false},\"python-version-file\":{\"name\":\"python-version-file\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"update-environment\":{\"name\":\"update-environment\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"python-path\":{\"name\":\"python-path\"},\"python-version\":{\"name\":\"python-version\"}}}" +
+	",\"actions/setup-python@v5\":{\"name\":\"Setup Python\",\"inputs\":{\"allow-prereleases\":{\"name\":\"allow-prereleases\",\"required\":false},\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-dependency-path\":{\"name\":\"cache-dependency-path\",\"required\":false},\"check-latest\":{\"name\":\"check-latest\",\"required\":false},\"python-version\":{\"name\":\"python-version\",\"required\":false},\"python-version-file\":{\"name\":\"python-version-file\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"update-environment\":{\"name\":\"update-environment\",\"required\":false}},\"outputs\":{\"cache-hit\":{\"name\":\"cache-hit\"},\"python-path\":{\"name\":\"python-path\"},\"python-version\":{\"name\":\"python-version\"}}}" +
+	",\"actions/stale@v5\":{\"name\":\"Close Stale Issues\",\"inputs\":{\"any-of-issue-labels\":{\"name\":\"any-of-issue-labels\",\"required\":false},\"any-of-labels\":{\"name\":\"any-of-labels\",\"required\":false},\"any-of-pr-labels\":{\"name\":\"any-of-pr-labels\",\"required\":false},\"ascending\":{\"name\":\"ascending\",\"required\":false},\"close-issue-label\":{\"name\":\"close-issue-label\",\"required\":false},\"close-issue-message\":{\"name\":\"close-issue-message\",\"required\":false},\"close-issue-reason\":{\"name\":\"close-issue-reason\",\"required\":false},\"close-pr-label\":{\"name\":\"close-pr-label\",\"required\":false},\"close-pr-message\":{\"name\":\"close-pr-message\",\"required\":false},\"days-before-close\":{\"name\":\"days-before-close\",\"required\":false},\"days-before-issue-close\":{\"name\":\"days-before-issue-close\",\"required\":false},\"days-before-issue-stale\":{\"name\":\"days-before-issue-stale\",\"required\":false},\"days-before-pr-close\":{\"name\":\"days-before-pr-close\",\"required\":false},\"days-before-pr-stale\":{\"name\":\"days-before-pr-stale\",\"required\":false},\"days-before-stale\":{\"name\":\"days-before-stale\",\"required\":false},\"debug-only\":{\"name\":\"debug-only\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"enable-statistics\":{\"name\":\"enable-statistics\",\"required\":false},\"exempt-all-assignees\":{\"name\":\"exempt-all-assignees\",\"required\":false},\"exempt-all-issue-assignees\":{\"name\":\"exempt-all-issue-assignees\",\"required\":false},\"exempt-all-issue-milestones\":{\"name\":\"exempt-all-issue-milestones\",\"required\":false},\"exempt-all-milestones\":{\"name\":\"exempt-all-milestones\",\"required\":false},\"exempt-all-pr-assignees\":{\"name\":\"exempt-all-pr-assignees\",\"required\":false},\"exempt-all-pr-milestones\":{\"name\":\"exempt-all-pr-milestones\",\"required\":false},\"exempt-assignees\":{\"name\":\"exempt-assignees\",\"required\":false},\"exempt-draft-pr\":{\"name\":\"exempt-draft-pr\",\"required\":false},\"exempt-issue-assignees\":{\"name\":\"exempt-issue-assignees\",\"required\":false},\"exempt-issue-labels\":{\"name\":\"exempt-issue-labels\",\"required\":false},\"exempt-issue-milestones\":{\"name\":\"exempt-issue-milestones\",\"required\":false},\"exempt-milestones\":{\"name\":\"exempt-milestones\",\"required\":false},\"exempt-pr-assignees\":{\"name\":\"exempt-pr-assignees\",\"required\":false},\"exempt-pr-labels\":{\"name\":\"exempt-pr-labels\",\"required\":false},\"exempt-pr-milestones\":{\"name\":\"exempt-pr-milestones\",\"required\":false},\"ignore-issue-updates\":{\"name\":\"ignore-issue-updates\",\"required\":false},\"ignore-pr-updates\":{\"name\":\"ignore-pr-updates\",\"required\":false},\"ignore-updates\":{\"name\":\"ignore-updates\",\"required\":false},\"include-only-assigned\":{\"name\":\"include-only-assigned\",\"required\":false},\"labels-to-add-when-unstale\":{\"name\":\"labels-to-add-when-unstale\",\"required\":false},\"labels-to-remove-when-unstale\":{\"name\":\"labels-to-remove-when-unstale\",\"required\":false},\"only-issue-labels\":{\"name\":\"only-issue-labels\",\"required\":false},\"only-labels\":{\"name\":\"only-labels\",\"required\":false},\"only-pr-labels\":{\"name\":\"only-pr-labels\",\"required\":false},\"operations-per-run\":{\"name\":\"operations-per-run\",\"required\":false},\"remove-issue-stale-when-updated\":{\"name\":\"remove-issue-stale-when-updated\",\"required\":false},\"remove-pr-stale-when-updated\":{\"name\":\"remove-pr-stale-when-updated\",\"required\":false},\"remove-stale-when-updated\":{\"name\":\"remove-stale-when-updated\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"stale-issue-label\":{\"name\":\"stale-issue-label\",\"required\":false},\"stale-issue-message\":{\"name\":\"stale-issue-message\",\"required\":false},\"stale-pr-label\":{\"name\":\"stale-pr-label\",\"required\":false},\"stale-pr-message\":{\"name\":\"stale-pr-message\",\"required\":false},\"start-date\":{\"name\":\"start-date\",\"required\":false}},\"outputs\":{\"closed-issues-prs\":{\"name\":\"closed-issues-prs\"},\"staled-issues-prs\":{\"name\":\"staled-issues-prs\"}},\"triggers\":[\"schedule\",\"workflow_dispatch\"]}" +
+	",\"actions/stale@v6\":{\"name\":\"Close Stale Issues\",\"inputs\":{\"any-of-issue-labels\":{\"name\":\"any-of-issue-labels\",\"required\":false},\"any-of-labels\":{\"name\":\"any-of-labels\",\"required\":false},\"any-of-pr-labels\":{\"name\":\"any-of-pr-labels\",\"required\":false},\"ascending\":{\"name\":\"ascending\",\"required\":false},\"close-issue-label\":{\"name\":\"close-issue-label\",\"required\":false},\"close-issue-message\":{\"name\":\"close-issue-message\",\"required\":false},\"close-issue-reason\":{\"name\":\"close-issue-reason\",\"required\":false},\"close-pr-label\":{\"name\":\"close-pr-label\",\"required\":false},\"close-pr-message\":{\"name\":\"close-pr-message\",\"required\":false},\"days-before-close\":{\"name\":\"days-before-close\",\"required\":false},\"days-before-issue-close\":{\"name\":\"days-before-issue-close\",\"required\":false},\"days-before-issue-stale\":{\"name\":\"days-before-issue-stale\",\"required\":false},\"days-before-pr-close\":{\"name\":\"days-before-pr-close\",\"required\":false},\"days-before-pr-stale\":{\"name\":\"days-before-pr-stale\",\"required\":false},\"days-before-stale\":{\"name\":\"days-before-stale\",\"required\":false},\"debug-only\":{\"name\":\"debug-only\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"enable-statistics\":{\"name\":\"enable-statistics\",\"required\":false},\"exempt-all-assignees\":{\"name\":\"exempt-all-assignees\",\"required\":false},\"exempt-all-issue-assignees\":{\"name\":\"exempt-all-issue-assignees\",\"required\":false},\"exempt-all-issue-milestones\":{\"name\":\"exempt-all-issue-milestones\",\"required\":false},\"exempt-all-milestones\":{\"name\":\"exempt-all-milestones\",\"required\":false},\"exempt-all-pr-assignees\":{\"name\":\"exempt-all-pr-assignees\",\"required\":false},\"exempt-all-pr-milestones\":{\"name\":\"exempt-all-pr-milestones\",\"required\":false},\"exempt-assignees\":{\"name\":\"exempt-assignees\",\"required\":false},\"exempt-draft-pr\":{\"name\":\"exempt-draft-pr\",\"required\":false},\"exempt-issue-assignees\":{\"name\":\"exempt-issue-assignees\",\"required\":false},\"exempt-issue-labels\":{\"name\":\"exempt-issue-labels\",\"required\":false},\"exempt-issue-milestones\":{\"name\":\"exempt-issue-milestones\",\"required\":false},\"exempt-milestones\":{\"name\":\"exempt-milestones\",\"required\":false},\"exempt-pr-assignees\":{\"name\":\"exempt-pr-assignees\",\"required\":false},\"exempt-pr-labels\":{\"name\":\"exempt-pr-labels\",\"required\":false},\"exempt-pr-milestones\":{\"name\":\"exempt-pr-milestones\",\"required\":false},\"ignore-issue-updates\":{\"name\":\"ignore-issue-updates\",\"required\":false},\"ignore-pr-updates\":{\"name\":\"ignore-pr-updates\",\"required\":false},\"ignore-updates\":{\"name\":\"ignore-updates\",\"required\":false},\"include-only-assigned\":{\"name\":\"include-only-assigned\",\"required\":false},\"labels-to-add-when-unstale\":{\"name\":\"labels-to-add-when-unstale\",\"required\":false},\"labels-to-remove-when-unstale\":{\"name\":\"labels-to-remove-when-unstale\",\"required\":false},\"only-issue-labels\":{\"name\":\"only-issue-labels\",\"required\":false},\"only-labels\":{\"name\":\"only-labels\",\"required\":false},\"only-pr-labels\":{\"name\":\"only-pr-labels\",\"required\":false},\"operations-per-run\":{\"name\":\"operations-per-run\",\"required\":false},\"remove-issue-stale-when-updated\":{\"name\":\"remove-issue-stale-when-updated\",\"required\":false},\"remove-pr-stale-when-updated\":{\"name\":\"remove-pr-stale-when-updated\",\"required\":false},\"remove-stale-when-updated\":{\"name\":\"remove-stale-when-updated\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"stale-issue-label\":{\"name\":\"stale-issue-label\",\"required\":false},\"stale-issue-message\":{\"name\":\"stale-issue-message\",\"required\":false},\"stale-pr-label\":{\"name\":\"stale-pr-label\",\"required\":false},\"stale-pr-message\":{\"name\":\"stale-pr-message\",\"required\":false},\"start-date\":{\"name\":\"start-date\",\"required\":false}},\"outputs\":{\"closed-issues-prs\":{\"name\":\"closed-issues-prs\"},\"staled-issues-prs\":{\"name\":\"staled-issues-prs\"}},\"triggers\":[\"schedule\",\"workflow_dispatch\"]}" +
+	",\"actions/stale@v7\":{\"name\":\"Close Stale Issues\",\"inputs\":{\"any-of-issue-labels\":{\"name\":\"any-of-issue-labels\",\"required\":false},\"any-of-labels\":{\"name\":\"any-of-labels\",\"required\":false},\"any-of-pr-labels\":{\"name\":\"any-of-pr-labels\",\"required\":false},\"ascending\":{\"name\":\"ascending\",\"required\":false},\"close-issue-label\":{\"name\":\"close-issue-label\",\"required\":false},\"close-issue-message\":{\"name\":\"close-issue-message\",\"required\":false},\"close-issue-reason\":{\"name\":\"close-issue-reason\",\"required\":false},\"close-pr-label\":{\"name\":\"close-pr-label\",\"required\":false},\"close-pr-message\":{\"name\":\"close-pr-message\",\"required\":false},\"days-before-close\":{\"name\":\"days-before-close\",\"required\":false},\"days-before-issue-close\":{\"name\":\"days-before-issue-close\",\"required\":false},\"days-before-issue-stale\":{\"name\":\"days-before-issue-stale\",\"required\":false},\"days-before-pr-close\":{\"name\":\"days-before-pr-close\",\"required\":false},\"days-before-pr-stale\":{\"name\":\"days-before-pr-stale\",\"required\":false},\"days-before-stale\":{\"name\":\"days-before-stale\",\"required\":false},\"debug-only\":{\"name\":\"debug-only\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"enable-statistics\":{\"name\":\"enable-statistics\",\"required\":false},\"exempt-all-assignees\":{\"name\":\"exempt-all-assignees\",\"required\":false},\"exempt-all-issue-assignees\":{\"name\":\"exempt-all-issue-assignees\",\"required\":false},\"exempt-all-issue-milestones\":{\"name\":\"exempt-all-issue-milestones\",\"required\":false},\"exempt-all-milestones\":{\"name\":\"exempt-all-milestones\",\"required\":false},\"exempt-all-pr-assignees\":{\"name\":\"exempt-all-pr-assignees\",\"required\":false},\"exempt-all-pr-milestones\":{\"name\":\"exempt-all-pr-milestones\",\"required\":false},\"exempt-assignees\":{\"name\":\"exempt-assignees\",\"required\":false},\"exempt-draft-pr\":{\"name\":\"exempt-draft-pr\",\"required\":false},\"exempt-issue-assignees\":{\"name\":\"exempt-issue-assignees\",\"required\":false},\"exempt-issue-labels\":{\"name\":\"exempt-issue-labels\",\"required\":false},\"exempt-issue-milestones\":{\"name\":\"exempt-issue-milestones\",\"required\":false},\"exempt-milestones\":{\"name\":\"exempt-milestones\",\"required\":false},\"exempt-pr-assignees\":{\"name\":\"exempt-pr-assignees\",\"required\":false},\"exempt-pr-labels\":{\"name\":\"exempt-pr-labels\",\"required\":false},\"exempt-pr-milestones\":{\"name\":\"exempt-pr-milestones\",\"required\":false},\"ignore-issue-updates\":{\"name\":\"ignore-issue-updates\",\"required\":false},\"ignore-pr-updates\":{\"name\":\"ignore-pr-updates\",\"required\":false},\"ignore-updates\":{\"name\":\"ignore-updates\",\"required\":false},\"include-only-assigned\":{\"name\":\"include-only-assigned\",\"required\":false},\"labels-to-add-when-unstale\":{\"name\":\"labels-to-add-when-unstale\",\"required\":false},\"labels-to-remove-when-unstale\":{\"name\":\"labels-to-remove-when-unstale\",\"required\":false},\"only-issue-labels\":{\"name\":\"only-issue-labels\",\"required\":false},\"only-labels\":{\"name\":\"only-labels\",\"required\":false},\"only-pr-labels\":{\"name\":\"only-pr-labels\",\"required\":false},\"operations-per-run\":{\"name\":\"operations-per-run\",\"required\":false},\"remove-issue-stale-when-updated\":{\"name\":\"remove-issue-stale-when-updated\",\"required\":false},\"remove-pr-stale-when-updated\":{\"name\":\"remove-pr-stale-when-updated\",\"required\":false},\"remove-stale-when-updated\":{\"name\":\"remove-stale-when-updated\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"stale-issue-label\":{\"name\":\"stale-issue-label\",\"required\":false},\"stale-issue-message\":{\"name\":\"stale-issue-message\",\"required\":false},\"stale-pr-label\":{\"name\":\"stale-pr-label\",\"required\":false},\"stale-pr-message\":{\"name\":\"stale-pr-message\",\"required\":false},\"start-date\":{\"name\":\"start-date\",\"required\":false}},\"outputs\":{\"closed-issues-prs\":{\"name\":\"closed-issues-prs\"},\"staled-issues-prs\":{\"name\":\"staled-issues-prs\"}},\"triggers\":[\"schedule\",\"workflow_dispatch\"]}" +
+	",\"actions/stale@v8\":{\"name\":\"Close Stale Issues\",\"inputs\":{\"any-of-issue-labels\":{\"name\":\"any-of-issue-labels\",\"required\":false},\"any-of-labels\":{\"name\":\"any-of-labels\",\"required\":false},\"any-of-pr-labels\":{\"name\":\"any-of-pr-labels\",\"required\":false},\"ascending\":{\"name\":\"ascending\",\"required\":false},\"close-issue-label\":{\"name\":\"close-issue-label\",\"required\":false},\"close-issue-message\":{\"name\":\"close-issue-message\",\"required\":false},\"close-issue-reason\":{\"name\":\"close-issue-reason\",\"required\":false},\"close-pr-label\":{\"name\":\"close-pr-label\",\"required\":false},\"close-pr-message\":{\"name\":\"close-pr-message\",\"required\":false},\"days-before-close\":{\"name\":\"days-before-close\",\"required\":false},\"days-before-issue-close\":{\"name\":\"days-before-issue-close\",\"required\":false},\"days-before-issue-stale\":{\"name\":\"days-before-issue-stale\",\"required\":false},\"days-before-pr-close\":{\"name\":\"days-before-pr-close\",\"required\":false},\"days-before-pr-stale\":{\"name\":\"days-before-pr-stale\",\"required\":false},\"days-before-stale\":{\"name\":\"days-before-stale\",\"required\":false},\"debug-only\":{\"name\":\"debug-only\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"enable-statistics\":{\"name\":\"enable-statistics\",\"required\":false},\"exempt-all-assignees\":{\"name\":\"exempt-all-assignees\",\"required\":false},\"exempt-all-issue-assignees\":{\"name\":\"exempt-all-issue-assignees\",\"required\":false},\"exempt-all-issue-milestones\":{\"name\":\"exempt-all-issue-milestones\",\"required\":false},\"exempt-all-milestones\":{\"name\":\"exempt-all-milestones\",\"required\":false},\"exempt-all-pr-assignees\":{\"name\":\"exempt-all-pr-assignees\",\"required\":false},\"exempt-all-pr-milestones\":{\"name\":\"exempt-all-pr-milestones\",\"required\":false},\"exempt-assignees\":{\"name\":\"exempt-assignees\",\"required\":false},\"exempt-draft-pr\":{\"name\":\"exempt-draft-pr\",\"required\":false},\"exempt-issue-assignees\":{\"name\":\"exempt-issue-assignees\",\"required\":false},\"exempt-issue-labels\":{\"name\":\"exempt-issue-labels\",\"required\":false},\"exempt-issue-milestones\":{\"name\":\"exempt-issue-milestones\",\"required\":false},\"exempt-milestones\":{\"name\":\"exempt-milestones\",\"required\":false},\"exempt-pr-assignees\":{\"name\":\"exempt-pr-assignees\",\"required\":false},\"exempt-pr-labels\":{\"name\":\"exempt-pr-labels\",\"required\":false},\"exempt-pr-milestones\":{\"name\":\"exempt-pr-milestones\",\"required\":false},\"ignore-issue-updates\":{\"name\":\"ignore-issue-updates\",\"required\":false},\"ignore-pr-updates\":{\"name\":\"ignore-pr-updates\",\"required\":false},\"ignore-updates\":{\"name\":\"ignore-updates\",\"required\":false},\"include-only-assigned\":{\"name\":\"include-only-assigned\",\"required\":false},\"labels-to-add-when-unstale\":{\"name\":\"labels-to-add-when-unstale\",\"required\":false},\"labels-to-remove-when-stale\":{\"name\":\"labels-to-remove-when-stale\",\"required\":false},\"labels-to-remove-when-unstale\":{\"name\":\"labels-to-remove-when-unstale\",\"required\":false},\"only-issue-labels\":{\"name\":\"only-issue-labels\",\"required\":false},\"only-labels\":{\"name\":\"only-labels\",\"required\":false},\"only-pr-labels\":{\"name\":\"only-pr-labels\",\"required\":false},\"operations-per-run\":{\"name\":\"operations-per-run\",\"required\":false},\"remove-issue-stale-when-updated\":{\"name\":\"remove-issue-stale-when-updated\",\"required\":false},\"remove-pr-stale-when-updated\":{\"name\":\"remove-pr-stale-when-updated\",\"required\":false},\"remove-stale-when-updated\":{\"name\":\"remove-stale-when-updated\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"stale-issue-label\":{\"name\":\"stale-issue-label\",\"required\":false},\"stale-issue-message\":{\"name\":\"stale-issue-message\",\"required\":false},\"stale-pr-label\":{\"name\":\"stale-pr-label\",\"required\":false},\"stale-pr-message\":{\"name\":\"stale-pr-message\",\"required\":false},\"start-date\":{\"name\":\"start-date\",\"required\":false}},\"outputs\":{\"closed-issues-prs\":{\"name\":\"closed-issues-prs\"},\"staled-issues-prs\":{\"name\":\"staled-issues-prs\"}},\"triggers\":[\"schedule\",\"workflow_dispatch\"]}" +
+	",\"actions/stale@v9\":{\"name\":\"Close Stale Issues\",\"inputs\":{\"any-of-issue-labels\":{\"name\":\"any-of-issue-labels\",\"required\":false},\"any-of-labels\":{\"name\":\"any-of-labels\",\"required\":false},\"any-of-pr-labels\":{\"name\":\"any-of-pr-labels\",\"required\":false},\"ascending\":{\"name\":\"ascending\",\"required\":false},\"close-issue-label\":{\"name\":\"close-issue-label\",\"required\":false},\"close-issue-message\":{\"name\":\"close-issue-message\",\"required\":false},\"close-issue-reason\":{\"name\":\"close-issue-reason\",\"required\":false},\"close-pr-label\":{\"name\":\"close-pr-label\",\"required\":false},\"close-pr-message\":{\"name\":\"close-pr-message\",\"required\":false},\"days-before-close\":{\"name\":\"days-before-close\",\"required\":false},\"days-before-issue-close\":{\"name\":\"days-before-issue-close\",\"required\":false},\"days-before-issue-stale\":{\"name\":\"days-before-issue-stale\",\"required\":false},\"days-before-pr-close\":{\"name\":\"days-before-pr-close\",\"required\":false},\"days-before-pr-stale\":{\"name\":\"days-before-pr-stale\",\"required\":false},\"days-before-stale\":{\"name\":\"days-before-stale\",\"required\":false},\"debug-only\":{\"name\":\"debug-only\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"enable-statistics\":{\"name\":\"enable-statistics\",\"required\":false},\"exempt-all-assignees\":{\"name\":\"exempt-all-assignees\",\"required\":false},\"exempt-all-issue-assignees\":{\"name\":\"exempt-all-issue-assignees\",\"required\":false},\"exempt-all-issue-milestones\":{\"name\":\"exempt-all-issue-milestones\",\"required\":false},\"exempt-all-milestones\":{\"name\":\"exempt-all-milestones\",\"required\":false},\"exempt-all-pr-assignees\":{\"name\":\"exempt-all-pr-assignees\",\"required\":false},\"exempt-all-pr-milestones\":{\"name\":\"exempt-all-pr-milestones\",\"required\":false},\"exempt-assignees\":{\"name\":\"exempt-assignees\",\"required\":false},\"exempt-draft-pr\":{\"name\":\"exempt-draft-pr\",\"required\":false},\"exempt-issue-assignees\":{\"name\":\"exempt-issue-assignees\",\"required\":false},\"exempt-issue-labels\":{\"name\":\"exempt-issue-labels\",\"required\":false},\"exempt-issue-milestones\":{\"name\":\"exempt-issue-milestones\",\"required\":false},\"exempt-milestones\":{\"name\":\"exempt-milestones\",\"required\":false},\"exempt-pr-assignees\":{\"name\":\"exempt-pr-assignees\",\"required\":false},\"exempt-pr-labels\":{\"name\":\"exempt-pr-labels\",\"required\":false},\"exempt-pr-milestones\":{\"name\":\"exempt-pr-milestones\",\"required\":false},\"ignore-issue-updates\":{\"name\":\"ignore-issue-updates\",\"required\":false},\"ignore-pr-updates\":{\"name\":\"ignore-pr-updates\",\"required\":false},\"ignore-updates\":{\"name\":\"ignore-updates\",\"required\":false},\"include-only-assigned\":{\"name\":\"include-only-assigned\",\"required\":false},\"labels-to-add-when-unstale\":{\"name\":\"labels-to-add-when-unstale\",\"required\":false},\"labels-to-remove-when-stale\":{\"name\":\"labels-to-remove-when-stale\",\"required\":false},\"labels-to-remove-when-unstale\":{\"name\":\"labels-to-remove-when-unstale\",\"required\":false},\"only-issue-labels\":{\"name\":\"only-issue-labels\",\"required\":false},\"only-labels\":{\"name\":\"only-labels\",\"required\":false},\"only-pr-labels\":{\"name\":\"only-pr-labels\",\"required\":false},\"operations-per-run\":{\"name\":\"operations-per-run\",\"required\":false},\"remove-issue-stale-when-updated\":{\"name\":\"remove-issue-stale-when-updated\",\"required\":false},\"remove-pr-stale-when-updated\":{\"name\":\"remove-pr-stale-when-updated\",\"required\":false},\"remove-stale-when-updated\":{\"name\":\"remove-stale-when-updated\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"stale-issue-label\":{\"name\":\"stale-issue-label\",\"required\":false},\"stale-issue-message\":{\"name\":\"stale-issue-message\",\"required\":false},\"stale-pr-label\":{\"name\":\"stale-pr-label\",\"required\":false},\"stale-pr-message\":{\"name\":\"stale-pr-message\",\"required\":false},\"start-date\":{\"name\":\"start-date\",\"required\":false}},\"outputs\":{\"closed-issues-prs\":{\"name\":\"closed-issues-prs\"},\"staled-issues-prs\":{\"name\":\"staled-issues-prs\"}},\"triggers\":[\"schedule\",\"workflow_dispatch\"]}" +
+	",\"actions/upload-artifact@v1\":{\"name\":\"Upload a Build Artifact\",\"inputs\":{\"name\":{\"name\":\"name\",\"required\":true},\"path\":{\"name\":\"path\",\"required\":true}}}" +
+	",\"actions/upload-artifact@v3\":{\"name\":\"Upload a Build Artifact\",\"inputs\":{\"if-no-files-found\":{\"name\":\"if-no-files-found\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":true},\"retention-days\":{\"name\":\"retention-days\",\"required\":false}}}" +
+	",\"actions/upload-artifact@v4\":{\"name\":\"Upload a Build Artifact\",\"inputs\":{\"compression-level\":{\"name\":\"compression-level\",\"required\":false},\"if-no-files-found\":{\"name\":\"if-no-files-found\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"overwrite\":{\"name\":\"overwrite\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":true},\"retention-days\":{\"name\":\"retention-days\",\"required\":false}},\"outputs\":{\"artifact-id\":{\"name\":\"artifact-id\"},\"artifact-url\":{\"name\":\"artifact-url\"}}}" +
+	",\"actions/upload-pages-artifact@v1\":{\"name\":\"Upload GitHub Pages artifact\",\"inputs\":{\"name\":{\"name\":\"name\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"retention-days\":{\"name\":\"retention-days\",\"required\":false}}}" +
+	",\"actions/upload-pages-artifact@v2\":{\"name\":\"Upload GitHub Pages artifact\",\"inputs\":{\"name\":{\"name\":\"name\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"retention-days\":{\"name\":\"retention-days\",\"required\":false}}}" +
+	",\"actions/upload-pages-artifact@v3\":{\"name\":\"Upload GitHub Pages artifact\",\"inputs\":{\"name\":{\"name\":\"name\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"retention-days\":{\"name\":\"retention-days\",\"required\":false}},\"outputs\":{\"artifact_id\":{\"name\":\"artifact_id\"}}}" +
+	",\"aws-actions/configure-aws-credentials@v2\":{\"name\":\"Configure AWS Credentials For GitHub Actions\",\"inputs\":{\"audience\":{\"name\":\"audience\",\"required\":false},\"aws-access-key-id\":{\"name\":\"aws-access-key-id\",\"required\":false},\"aws-region\":{\"name\":\"aws-region\",\"required\":true},\"aws-secret-access-key\":{\"name\":\"aws-secret-access-key\",\"required\":false},\"aws-session-token\":{\"name\":\"aws-session-token\",\"required\":false},\"http-proxy\":{\"name\":\"http-proxy\",\"required\":false},\"inline-session-policy\":{\"name\":\"inline-session-policy\",\"required\":false},\"managed-session-policies\":{\"name\":\"managed-session-policies\",\"required\":false},\"mask-aws-account-id\":{\"name\":\"mask-aws-account-id\",\"required\":false},\"role-chaining\":{\"name\":\"role-chaining\",\"required\":false},\"role-duration-seconds\":{\"name\":\"role-duration-seconds\",\"required\":false},\"role-external-id\":{\"name\":\"role-external-id\",\"required\":false},\"role-session-name\":{\"name\":\"role-session-name\",\"required\":false},\"role-skip-session-tagging\":{\"name\":\"role-skip-session-tagging\",\"required\":false},\"role-to-assume\":{\"name\":\"role-to-assume\",\"required\":false},\"web-identity-token-file\":{\"name\":\"web-identity-token-file\",\"required\":false}},\"outputs\":{\"aws-account-id\":{\"name\":\"aws-account-id\"}}}" +
+	",\"aws-actions/configure-aws-credentials@v3\":{\"name\":\"\\\"Configure AWS Credentials\\\" Action for GitHub Actions\",\"inputs\":{\"audience\":{\"name\":\"audience\",\"required\":false},\"aws-access-key-id\":{\"name\":\"aws-access-key-id\",\"required\":false},\"aws-region\":{\"name\":\"aws-region\",\"required\":true},\"aws-secret-access-key\":{\"name\":\"aws-secret-access-key\",\"required\":false},\"aws-session-token\":{\"name\":\"aws-session-token\",\"required\":false},\"disable-retry\":{\"name\":\"disable-retry\",\"required\":false},\"http-proxy\":{\"name\":\"http-proxy\",\"required\":false},\"inline-session-policy\":{\"name\":\"inline-session-policy\",\"required\":false},\"managed-session-policies\":{\"name\":\"managed-session-policies\",\"required\":false},\"mask-aws-account-id\":{\"name\":\"mask-aws-account-id\",\"required\":false},\"output-credentials\":{\"name\":\"output-credentials\",\"required\":false},\"retry-max-attempts\":{\"name\":\"retry-max-attempts\",\"required\":false},\"role-chaining\":{\"name\":\"role-chaining\",\"required\":false},\"role-duration-seconds\":{\"name\":\"role-duration-seconds\",\"required\":false},\"role-external-id\":{\"name\":\"role-external-id\",\"required\":false},\"role-session-name\":{\"name\":\"role-session-name\",\"required\":false},\"role-skip-session-tagging\":{\"name\":\"role-skip-session-tagging\",\"required\":false},\"role-to-assume\":{\"name\":\"role-to-assume\",\"required\":false},\"special-characters-workaround\":{\"name\":\"special-characters-workaround\",\"required\":false},\"unset-current-credentials\":{\"name\":\"unset-current-credentials\",\"required\":false},\"web-identity-token-file\":{\"name\":\"web-identity-token-file\",\"required\":false}},\"outputs\":{\"aws-access-key-id\":{\"name\":\"aws-access-key-id\"},\"aws-account-id\":{\"name\":\"aws-account-id\"},\"aws-secret-access-key\":{\"name\":\"aws-secret-access-key\"},\"aws-session-token\":{\"name\":\"aws-session-token\"}}}" +
+	",\"aws-actions/configure-aws-credentials@v4\":{\"name\":\"\\\"Configure AWS Credentials\\\" Action for GitHub Actions\",\"inputs\":{\"audience\":{\"name\":\"audience\",\"required\":false},\"aws-access-key-id\":{\"name\":\"aws-access-key-id\",\"required\":false},\"aws-region\":{\"name\":\"aws-region\",\"required\":true},\"aws-secret-access-key\":{\"name\":\"aws-secret-access-key\",\"required\":false},\"aws-session-token\":{\"name\":\"aws-session-token\",\"required\":false},\"disable-retry\":{\"name\":\"disable-retry\",\"required\":false},\"http-proxy\":{\"name\":\"http-proxy\",\"required\":false},\"inline-session-policy\":{\"name\":\"inline-session-policy\",\"required\":false},\"managed-session-policies\":{\"name\":\"managed-session-policies\",\"required\":false},\"mask-aws-account-id\":{\"name\":\"mask-aws-account-id\",\"required\":false},\"output-credentials\":{\"name\":\"output-credentials\",\"required\":false},\"retry-max-attempts\":{\"name\":\"retry-max-attempts\",\"required\":false},\"role-chaining\":{\"name\":\"role-chaining\",\"required\":false},\"role-duration-seconds\":{\"name\":\"role-duration-seconds\",\"required\":false},\"role-external-id\":{\"name\":\"role-external-id\",\"required\":false},\"role-session-name\":{\"name\":\"role-session-name\",\"required\":false},\"role-skip-session-tagging\":{\"name\":\"role-skip-session-tagging\",\"required\":false},\"role-to-assume\":{\"name\":\"role-to-assume\",\"required\":false},\"special-characters-workaround\":{\"name\":\"special-characters-workaround\",\"required\":false},\"unset-current-credentials\":{\"name\":\"unset-current-credentials\",\"required\":false},\"web-identity-token-file\":{\"name\":\"web-identity-token-file\",\"required\":false}},\"outputs\":{\"aws-access-key-id\":{\"name\":\"aws-access-key-id\"},\"aws-account-id\":{\"name\":\"aws-account-id\"},\"aws-secret-access-key\":{\"name\":\"aws-secret-access-key\"},\"aws-session-token\":{\"name\":\"aws-session-token\"}}}" +
+	",\"azure/aks-set-context@v3\":{\"name\":\"Azure Kubernetes set context\",\"inputs\":{\"admin\":{\"name\":\"admin\",\"required\":false},\"cluster-name\":{\"name\":\"cluster-name\",\"required\":true},\"resource-group\":{\"name\":\"resource-group\",\"required\":true},\"subscription\":{\"name\":\"subscription\",\"required\":false},\"use-kubelogin\":{\"name\":\"use-kubelogin\",\"required\":false}}}" +
+	",\"azure/aks-set-context@v4\":{\"name\":\"Azure Kubernetes set context\",\"inputs\":{\"admin\":{\"name\":\"admin\",\"required\":false},\"cluster-name\":{\"name\":\"cluster-name\",\"required\":true},\"public-fqdn\":{\"name\":\"public-fqdn\",\"required\":false},\"resource-group\":{\"name\":\"resource-group\",\"required\":true},\"subscription\":{\"name\":\"subscription\",\"required\":false},\"use-kubelogin\":{\"name\":\"use-kubelogin\",\"required\":false}}}" +
+	",\"azure/login@v1\":{\"name\":\"Azure Login\",\"inputs\":{\"allow-no-subscriptions\":{\"name\":\"allow-no-subscriptions\",\"required\":false},\"audience\":{\"name\":\"audience\",\"required\":false},\"auth-type\":{\"name\":\"auth-type\",\"required\":false},\"client-id\":{\"name\":\"client-id\",\"required\":false},\"creds\":{\"name\":\"creds\",\"required\":false},\"enable-azpssession\":{\"name\":\"enable-AzPSSession\",\"required\":false},\"environment\":{\"name\":\"environment\",\"required\":false},\"subscription-id\":{\"name\":\"subscription-id\",\"required\":false},\"tenant-id\":{\"name\":\"tenant-id\",\"required\":false}}}" +
+	",\"azure/login@v2\":{\"name\":\"Azure Login\",\"inputs\":{\"allow-no-subscriptions\":{\"name\":\"allow-no-subscriptions\",\"required\":false},\"audience\":{\"name\":\"audience\",\"required\":false},\"auth-type\":{\"name\":\"auth-type\",\"required\":false},\"client-id\":{\"name\":\"client-id\",\"required\":false},\"creds\":{\"name\":\"creds\",\"required\":false},\"enable-azpssession\":{\"name\":\"enable-AzPSSession\",\"required\":false},\"environment\":{\"name\":\"environment\",\"required\":false},\"subscription-id\":{\"name\":\"subscription-id\",\"required\":false},\"tenant-id\":{\"name\":\"tenant-id\",\"required\":false}}}" +
+	",\"bahmutov/npm-install@v1\":{\"name\":\"NPM or Yarn install with caching\",\"inputs\":{\"cache-key-prefix\":{\"name\":\"cache-key-prefix\",\"required\":false},\"install-command\":{\"name\":\"install-command\",\"required\":false},\"uselockfile\":{\"name\":\"useLockFile\",\"required\":false},\"userollingcache\":{\"name\":\"useRollingCache\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"codecov/codecov-action@v3\":{\"name\":\"Codecov\",\"inputs\":{\"commit_parent\":{\"name\":\"commit_parent\",\"required\":false},\"directory\":{\"name\":\"directory\",\"required\":false},\"dry_run\":{\"name\":\"dry_run\",\"required\":false},\"env_vars\":{\"name\":\"env_vars\",\"required\":false},\"fail_ci_if_error\":{\"name\":\"fail_ci_if_error\",\"required\":false},\"file\":{\"name\":\"file\",\"required\":false},\"files\":{\"name\":\"files\",\"required\":false},\"flags\":{\"name\":\"flags\",\"required\":false},\"full_report\":{\"name\":\"full_report\",\"required\":false},\"functionalities\":{\"name\":\"functionalities\",\"required\":false},\"gcov\":{\"name\":\"gcov\",\"required\":false},\"gcov_args\":{\"name\":\"gcov_args\",\"required\":false},\"gcov_executable\":{\"name\":\"gcov_executable\",\"required\":false},\"gcov_ignore\":{\"name\":\"gcov_ignore\",\"required\":false},\"gcov_include\":{\"name\":\"gcov_include\",\"required\":false},\"move_coverage_to_trash\":{\"name\":\"move_coverage_to_trash\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"network_filter\":{\"name\":\"network_filter\",\"required\":false},\"network_prefix\":{\"name\":\"network_prefix\",\"required\":false},\"os\":{\"name\":\"os\",\"required\":false},\"override_branch\":{\"name\":\"override_branch\",\"required\":false},\"override_build\":{\"name\":\"override_build\",\"required\":false},\"override_commit\":{\"name\":\"override_commit\",\"required\":false},\"override_pr\":{\"name\":\"override_pr\",\"required\":false},\"override_tag\":{\"name\":\"override_tag\",\"required\":false},\"root_dir\":{\"name\":\"root_dir\",\"required\":false},\"slug\":{\"name\":\"slug\",\"required\":false},\"swift\":{\"name\":\"swift\",\"required\":false},\"swift_project\":{\"name\":\"swift_project\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"upstream_proxy\":{\"name\":\"upstream_proxy\",\"required\":false},\"url\":{\"name\":\"url\",\"required\":false},\"verbose\":{\"name\":\"verbose\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false},\"xcode\":{\"name\":\"xcode\",\"required\":false},\"xcode_archive_path\":{\"name\":\"xcode_archive_path\",\"required\":false},\"xtra_args\":{\"name\":\"xtra_args\",\"required\":false}}}" +
+	",\"codecov/codecov-action@v4\":{\"name\":\"Codecov\",\"inputs\":{\"codecov_yml_path\":{\"name\":\"codecov_yml_path\",\"required\":false},\"commit_parent\":{\"name\":\"commit_parent\",\"required\":false},\"directory\":{\"name\":\"directory\",\"required\":false},\"disable_file_fixes\":{\"name\":\"disable_file_fixes\",\"required\":false},\"disable_safe_directory\":{\"name\":\"disable_safe_directory\",\"required\":false},\"disable_search\":{\"name\":\"disable_search\",\"required\":false},\"dry_run\":{\"name\":\"dry_run\",\"required\":false},\"env_vars\":{\"name\":\"env_vars\",\"required\":false},\"exclude\":{\"name\":\"exclude\",\"required\":false},\"fail_ci_if_error\":{\"name\":\"fail_ci_if_error\",\"required\":false},\"file\":{\"name\":\"file\",\"required\":false},\"files\":{\"name\":\"files\",\"required\":false},\"flags\":{\"name\":\"flags\",\"required\":false},\"git_service\":{\"name\":\"git_service\",\"required\":false},\"handle_no_reports_found\":{\"name\":\"handle_no_reports_found\",\"required\":false},\"job_code\":{\"name\":\"job_code\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"network_filter\":{\"name\":\"network_filter\",\"required\":false},\"network_prefix\":{\"name\":\"network_prefix\",\"required\":false},\"os\":{\"name\":\"os\",\"required\":false},\"override_branch\":{\"name\":\"override_branch\",\"required\":false},\"override_build\":{\"name\":\"override_build\",\"required\":false},\"override_build_url\":{\"name\":\"override_build_url\",\"required\":false},\"override_commit\":{\"name\":\"override_commit\",\"required\":false},\"override_pr\":{\"name\":\"override_pr\",\"required\":false},\"plugin\":{\"name\":\"plugin\",\"required\":false},\"plugins\":{\"name\":\"plugins\",\"required\":false},\"report_code\":{\"name\":\"report_code\",\"required\":false},\"root_dir\":{\"name\":\"root_dir\",\"required\":false},\"slug\":{\"name\":\"slug\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"url\":{\"name\":\"url\",\"required\":false},\"use_legacy_upload_endpoint\":{\"name\":\"use_legacy_upload_endpoint\",\"required\":false},\"use_oidc\":{\"name\":\"use_oidc\",\"required\":false},\"verbose\":{\"name\":\"verbose\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"dawidd6/action-download-artifact@v2\":{\"name\":\"Download workflow artifact\",\"inputs\":{\"allow_forks\":{\"name\":\"allow_forks\",\"required\":false},\"branch\":{\"name\":\"branch\",\"required\":false},\"check_artifacts\":{\"name\":\"check_artifacts\",\"required\":false},\"commit\":{\"name\":\"commit\",\"required\":false},\"dry_run\":{\"name\":\"dry_run\",\"required\":false},\"event\":{\"name\":\"event\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"if_no_artifact_found\":{\"name\":\"if_no_artifact_found\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"name_is_regexp\":{\"name\":\"name_is_regexp\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"pr\":{\"name\":\"pr\",\"required\":false},\"repo\":{\"name\":\"repo\",\"required\":false},\"run_id\":{\"name\":\"run_id\",\"required\":false},\"run_number\":{\"name\":\"run_number\",\"required\":false},\"search_artifacts\":{\"name\":\"search_artifacts\",\"required\":false},\"skip_unpack\":{\"name\":\"skip_unpack\",\"required\":false},\"workflow\":{\"name\":\"workflow\",\"required\":false},\"workflow_conclusion\":{\"name\":\"workflow_conclusion\",\"required\":false}},\"outputs\":{\"artifacts\":{\"name\":\"artifacts\"},\"dry_run\":{\"name\":\"dry_run\"},\"error_message\":{\"name\":\"error_message\"},\"found_artifact\":{\"name\":\"found_artifact\"}}}" +
+	",\"dawidd6/action-download-artifact@v3\":{\"name\":\"Download workflow artifact\",\"inputs\":{\"allow_forks\":{\"name\":\"allow_forks\",\"required\":false},\"branch\":{\"name\":\"branch\",\"required\":false},\"check_artifacts\":{\"name\":\"check_artifacts\",\"required\":false},\"commit\":{\"name\":\"commit\",\"required\":false},\"dry_run\":{\"name\":\"dry_run\",\"required\":false},\"event\":{\"name\":\"event\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"if_no_artifact_found\":{\"name\":\"if_no_artifact_found\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"name_is_regexp\":{\"name\":\"name_is_regexp\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"pr\":{\"name\":\"pr\",\"required\":false},\"repo\":{\"name\":\"repo\",\"required\":false},\"run_id\":{\"name\":\"run_id\",\"required\":false},\"run_number\":{\"name\":\"run_number\",\"required\":false},\"search_artifacts\":{\"name\":\"search_artifacts\",\"required\":false},\"skip_unpack\":{\"name\":\"skip_unpack\",\"required\":false},\"workflow\":{\"name\":\"workflow\",\"required\":false},\"workflow_conclusion\":{\"name\":\"workflow_conclusion\",\"required\":false},\"workflow_search\":{\"name\":\"workflow_search\",\"required\":false}},\"outputs\":{\"artifacts\":{\"name\":\"artifacts\"},\"dry_run\":{\"name\":\"dry_run\"},\"error_message\":{\"name\":\"error_message\"},\"found_artifact\":{\"name\":\"found_artifact\"}}}" +
+	",\"dawidd6/action-send-mail@v1\":{\"name\":\"Send email\",\"inputs\":{\"body\":{\"name\":\"body\",\"required\":true},\"content_type\":{\"name\":\"content_type\",\"required\":false},\"from\":{\"name\":\"from\",\"required\":true},\"password\":{\"name\":\"password\",\"required\":true},\"server_address\":{\"name\":\"server_address\",\"required\":true},\"server_port\":{\"name\":\"server_port\",\"required\":true},\"subject\":{\"name\":\"subject\",\"required\":true},\"to\":{\"name\":\"to\",\"required\":true},\"username\":{\"name\":\"username\",\"required\":true}}}" +
+	",\"dawidd6/action-send-mail@v3\":{\"name\":\"Send email\",\"inputs\":{\"attachments\":{\"name\":\"attachments\",\"required\":false},\"bcc\":{\"name\":\"bcc\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"cc\":{\"name\":\"cc\",\"required\":false},\"connection_url\":{\"name\":\"connection_url\",\"required\":false},\"convert_markdown\":{\"name\":\"convert_markdown\",\"required\":false},\"from\":{\"name\":\"from\",\"required\":true},\"html_body\":{\"name\":\"html_body\",\"required\":false},\"ignore_cert\":{\"name\":\"ignore_cert\",\"required\":false},\"in_reply_to\":{\"name\":\"in_reply_to\",\"required\":false},\"nodemailerdebug\":{\"name\":\"nodemailerdebug\",\"required\":false},\"nodemailerlog\":{\"name\":\"nodemailerlog\",\"required\":false},\"password\":{\"name\":\"password\",\"required\":false},\"priority\":{\"name\":\"priority\",\"required\":false},\"reply_to\":{\"name\":\"reply_to\",\"required\":false},\"secure\":{\"name\":\"secure\",\"required\":false},\"server_address\":{\"name\":\"server_address\",\"required\":false},\"server_port\":{\"name\":\"server_port\",\"required\":false},\"subject\":{\"name\":\"subject\",\"required\":true},\"to\":{\"name\":\"to\",\"required\":false},\"username\":{\"name\":\"username\",\"required\":false}}}" +
+	",\"dessant/lock-threads@v4\":{\"name\":\"Lock Threads\",\"inputs\":{\"add-issue-labels\":{\"name\":\"add-issue-labels\",\"required\":false},\"add-pr-labels\":{\"name\":\"add-pr-labels\",\"required\":false},\"exclude-any-issue-labels\":{\"name\":\"exclude-any-issue-labels\",\"required\":false},\"exclude-any-pr-labels\":{\"name\":\"exclude-any-pr-labels\",\"required\":false},\"exclude-issue-closed-after\":{\"name\":\"exclude-issue-closed-after\",\"required\":false},\"exclude-issue-closed-before\":{\"name\":\"exclude-issue-closed-before\",\"required\":false},\"exclude-issue-closed-between\":{\"name\":\"exclude-issue-closed-between\",\"required\":false},\"exclude-issue-created-after\":{\"name\":\"exclude-issue-created-after\",\"required\":false},\"exclude-issue-created-before\":{\"name\":\"exclude-issue-created-before\",\"required\":false},\"exclude-issue-created-between\":{\"name\":\"exclude-issue-created-between\",\"required\":false},\"exclude-pr-closed-after\":{\"name\":\"exclude-pr-closed-after\",\"required\":false},\"exclude-pr-closed-before\":{\"name\":\"exclude-pr-closed-before\",\"required\":false},\"exclude-pr-closed-between\":{\"name\":\"exclude-pr-closed-between\",\"required\":false},\"exclude-pr-created-after\":{\"name\":\"exclude-pr-created-after\",\"required\":false},\"exclude-pr-created-before\":{\"name\":\"exclude-pr-created-before\",\"required\":false},\"exclude-pr-created-between\":{\"name\":\"exclude-pr-created-between\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"include-all-issue-labels\":{\"name\":\"include-all-issue-labels\",\"required\":false},\"include-all-pr-labels\":{\"name\":\"include-all-pr-labels\",\"required\":false},\"include-any-issue-labels\":{\"name\":\"include-any-issue-labels\",\"required\":false},\"include-any-pr-labels\":{\"name\":\"include-any-pr-labels\",\"required\":false},\"issue-comment\":{\"name\":\"issue-comment\",\"required\":false},\"issue-inactive-days\":{\"name\":\"issue-inactive-days\",\"required\":false},\"issue-lock-reason\":{\"name\":\"issue-lock-reason\",\"required\":false},\"log-output\":{\"name\":\"log-output\",\"required\":false},\"pr-comment\":{\"name\":\"pr-comment\",\"required\":false},\"pr-inactive-days\":{\"name\":\"pr-inactive-days\",\"required\":false},\"pr-lock-reason\":{\"name\":\"pr-lock-reason\",\"required\":false},\"process-only\":{\"name\":\"process-only\",\"required\":false},\"remove-issue-labels\":{\"name\":\"remove-issue-labels\",\"required\":false},\"remove-pr-labels\":{\"name\":\"remove-pr-labels\",\"required\":false}},\"outputs\":{\"issues\":{\"name\":\"issues\"},\"prs\":{\"name\":\"prs\"}},\"triggers\":[\"schedule\",\"workflow_dispatch\"]}" +
+	",\"dessant/lock-threads@v5\":{\"name\":\"Lock Threads\",\"inputs\":{\"add-discussion-labels\":{\"name\":\"add-discussion-labels\",\"required\":false},\"add-issue-labels\":{\"name\":\"add-issue-labels\",\"required\":false},\"add-pr-labels\":{\"name\":\"add-pr-labels\",\"required\":false},\"discussion-comment\":{\"name\":\"discussion-comment\",\"required\":false},\"discussion-inactive-days\":{\"name\":\"discussion-inactive-days\",\"required\":false},\"exclude-any-discussion-labels\":{\"name\":\"exclude-any-discussion-labels\",\"required\":false},\"exclude-any-issue-labels\":{\"name\":\"exclude-any-issue-labels\",\"required\":false},\"exclude-any-pr-labels\":{\"name\":\"exclude-any-pr-labels\",\"required\":false},\"exclude-discussion-closed-after\":{\"name\":\"exclude-discussion-closed-after\",\"required\":false},\"exclude-discussion-closed-before\":{\"name\":\"exclude-discussion-closed-before\",\"required\":false},\"exclude-discussion-closed-between\":{\"name\":\"exclude-discussion-closed-between\",\"required\":false},\"exclude-discussion-created-after\":{\"name\":\"exclude-discussion-created-after\",\"required\":false},\"exclude-discussion-created-before\":{\"name\":\"exclude-discussion-created-before\",\"required\":false},\"exclude-discussion-created-between\":{\"name\":\"exclude-discussion-created-between\",\"required\":false},\"exclude-issue-closed-after\":{\"name\":\"exclude-issue-closed-after\",\"required\":false},\"exclude-issue-closed-before\":{\"name\":\"exclude-issue-closed-before\",\"required\":false},\"exclude-issue-closed-between\":{\"name\":\"exclude-issue-closed-between\",\"required\":false},\"exclude-issue-created-after\":{\"name\":\"exclude-issue-created-after\",\"required\":false},\"exclude-issue-created-before\":{\"name\":\"exclude-issue-created-before\",\"required\":false},\"exclude-issue-created-between\":{\"name\":\"exclude-issue-created-between\",\"required\":false},\"exclude-pr-closed-after\":{\"name\":\"exclude-pr-closed-after\",\"required\":false},\"exclude-pr-closed-before\":{\"name\":\"exclude-pr-closed-before\",\"required\":false},\"exclude-pr-closed-between\":{\"name\":\"exclude-pr-closed-between\",\"required\":false},\"exclude-pr-created-after\":{\"name\":\"exclude-pr-created-after\",\"required\":false},\"exclude-pr-created-before\":{\"name\":\"exclude-pr-created-before\",\"required\":false},\"exclude-pr-created-between\":{\"name\":\"exclude-pr-created-between\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"include-all-discussion-labels\":{\"name\":\"include-all-discussion-labels\",\"required\":false},\"include-all-issue-labels\":{\"name\":\"include-all-issue-labels\",\"required\":false},\"include-all-pr-labels\":{\"name\":\"include-all-pr-labels\",\"required\":false},\"include-any-discussion-labels\":{\"name\":\"include-any-discussion-labels\",\"required\":false},\"include-any-issue-labels\":{\"name\":\"include-any-issue-labels\",\"required\":false},\"include-any-pr-labels\":{\"name\":\"include-any-pr-labels\",\"required\":false},\"issue-comment\":{\"name\":\"issue-comment\",\"required\":false},\"issue-inactive-days\":{\"name\":\"issue-inactive-days\",\"required\":false},\"issue-lock-reason\":{\"name\":\"issue-lock-reason\",\"required\":false},\"log-output\":{\"name\":\"log-output\",\"required\":false},\"pr-comment\":{\"name\":\"pr-comment\",\"required\":false},\"pr-inactive-days\":{\"name\":\"pr-inactive-days\",\"required\":false},\"pr-lock-reason\":{\"name\":\"pr-lock-reason\",\"required\":false},\"process-only\":{\"name\":\"process-only\",\"required\":false},\"remove-discussion-labels\":{\"name\":\"remove-discussion-labels\",\"required\":false},\"remove-issue-labels\":{\"name\":\"remove-issue-labels\",\"required\":false},\"remove-pr-labels\":{\"name\":\"remove-pr-labels\",\"required\":false}},\"outputs\":{\"discussions\":{\"name\":\"discussions\"},\"issues\":{\"name\":\"issues\"},\"prs\":{\"name\":\"prs\"}},\"triggers\":[\"schedule\",\"workflow_dispatch\"]}" +
+	",\"docker/build-push-action@v1\":{\"name\":\"Build and push Docker images\",\"inputs\":{\"add_git_labels\":{\"name\":\"add_git_labels\",\"required\":false},\"always_pull\":{\"name\":\"always_pull\",\"required\":false},\"build_args\":{\"name\":\"build_args\",\"required\":false},\"cache_froms\":{\"name\":\"cache_froms\",\"required\":false},\"dockerfile\":{\"name\":\"dockerfile\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"password\":{\"name\":\"password\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"push\":{\"name\":\"push\",\"required\":false},\"registry\":{\"name\":\"registry\",\"required\":false},\"repository\":{\"name\":\"repository\",\"required\":true},\"tag_with_ref\":{\"name\":\"tag_with_ref\",\"required\":false},\"tag_with_sha\":{\"name\":\"tag_with_sha\",\"required\":false},\"tags\":{\"name\":\"tags\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"username\":{\"name\":\"username\",\"required\":false}}}" +
+	",\"docker/build-push-action@v3\":{\"name\":\"Build and push Docker images\",\"inputs\":{\"add-hosts\":{\"name\":\"add-hosts\",\"required\":false},\"allow\":{\"name\":\"allow\",\"required\":false},\"attests\":{\"name\":\"attests\",\"required\":false},\"build-args\":{\"name\":\"build-args\",\"required\":false},\"build-contexts\":{\"name\":\"build-contexts\",\"required\":false},\"builder\":{\"name\":\"builder\",\"required\":false},\"cache-from\":{\"name\":\"cache-from\",\"required\":false},\"cache-to\":{\"name\":\"cache-to\",\"required\":false},\"cgroup-parent\":{\"name\":\"cgroup-parent\",\"required\":false},\"context\":{\"name\":\"context\",\"required\":false},\"file\":{\"name\":\"file\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"load\":{\"name\":\"load\",\"required\":false},\"network\":{\"name\":\"network\",\"required\":false},\"no-cache\":{\"name\":\"no-cache\",\"required\":false},\"no-cache-filters\":{\"name\":\"no-cache-filters\",\"required\":false},\"outputs\":{\"name\":\"outputs\",\"required\":false},\"platforms\":{\"name\":\"platforms\",\"required\":false},\"provenance\":{\"name\":\"provenance\",\"required\":false},\"pull\":{\"name\":\"pull\",\"required\":false},\"push\":{\"name\":\"push\",\"required\":false},\"sbom\":{\"name\":\"sbom\",\"required\":false},\"secret-files\":{\"name\":\"secret-files\",\"required\":false},\"secrets\":{\"name\":\"secrets\",\"required\":false},\"shm-size\":{\"name\":\"shm-size\",\"required\":false},\"ssh\":{\"name\":\"ssh\",\"required\":false},\"tags\":{\"name\":\"tags\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"ulimit\":{\"name\":\"ulimit\",\"required\":false}},\"outputs\":{\"digest\":{\"name\":\"digest\"},\"imageid\":{\"name\":\"imageid\"},\"metadata\":{\"name\":\"metadata\"}}}" +
+	",\"docker/build-push-action@v4\":{\"name\":\"Build and push Docker images\",\"inputs\":{\"add-hosts\":{\"name\":\"add-hosts\",\"required\":false},\"allow\":{\"name\":\"allow\",\"required\":false},\"attests\":{\"name\":\"attests\",\"required\":false},\"build-args\":{\"name\":\"build-args\",\"required\":false},\"build-contexts\":{\"name\":\"build-contexts\",\"required\":false},\"builder\":{\"name\":\"builder\",\"required\":false},\"cache-from\":{\"name\":\"cache-from\",\"required\":false},\"cache-to\":{\"name\":\"cache-to\",\"required\":false},\"cgroup-parent\":{\"name\":\"cgroup-parent\",\"required\":false},\"context\":{\"name\":\"context\",\"required\":false},\"file\":{\"name\":\"file\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"load\":{\"name\":\"load\",\"required\":false},\"network\":{\"name\":\"network\",\"required\":false},\"no-cache\":{\"name\":\"no-cache\",\"required\":false},\"no-cache-filters\":{\"name\":\"no-cache-filters\",\"required\":false},\"outputs\":{\"name\":\"outputs\",\"required\":false},\"platforms\":{\"name\":\"platforms\",\"required\":false},\"provenance\":{\"name\":\"provenance\",\"required\":false},\"pull\":{\"name\":\"pull\",\"required\":false},\"push\":{\"name\":\"push\",\"required\":false},\"sbom\":{\"name\":\"sbom\",\"required\":false},\"secret-files\":{\"name\":\"secret-files\",\"required\":false},\"secrets\":{\"name\":\"secrets\",\"required\":false},\"shm-size\":{\"name\":\"shm-size\",\"required\":false},\"ssh\":{\"name\":\"ssh\",\"required\":false},\"tags\":{\"name\":\"tags\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"ulimit\":{\"name\":\"ulimit\",\"required\":false}},\"outputs\":{\"digest\":{\"name\":\"digest\"},\"imageid\":{\"name\":\"imageid\"},\"metadata\":{\"name\":\"metadata\"}}}" +
+	",\"docker/build-push-action@v5\":{\"name\":\"Build and push Docker images\",\"inputs\":{\"add-hosts\":{\"name\":\"add-hosts\",\"required\":false},\"allow\":{\"name\":\"allow\",\"required\":false},\"annotations\":{\"name\":\"annotations\",\"required\":false},\"attests\":{\"name\":\"attests\",\"required\":false},\"build-args\":{\"name\":\"build-args\",\"required\":false},\"build-contexts\":{\"name\":\"build-contexts\",\"required\":false},\"builder\":{\"name\":\"builder\",\"required\":false},\"cache-from\":{\"name\":\"cache-from\",\"required\":false},\"cache-to\":{\"name\":\"cache-to\",\"required\":false},\"cgroup-parent\":{\"name\":\"cgroup-parent\",\"required\":false},\"context\":{\"name\":\"context\",\"required\":false},\"file\":{\"name\":\"file\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"load\":{\"name\":\"load\",\"required\":false},\"network\":{\"name\":\"network\",\"required\":false},\"no-cache\":{\"name\":\"no-cache\",\"required\":false},\"no-cache-filters\":{\"name\":\"no-cache-filters\",\"required\":false},\"outputs\":{\"name\":\"outputs\",\"required\":false},\"platforms\":{\"name\":\"platforms\",\"required\":false},\"provenance\":{\"name\":\"provenance\",\"required\":false},\"pull\":{\"name\":\"pull\",\"required\":false},\"push\":{\"name\":\"push\",\"required\":false},\"sbom\":{\"name\":\"sbom\",\"required\":false},\"secret-envs\":{\"name\":\"secret-envs\",\"required\":false},\"secret-files\":{\"name\":\"secret-files\",\"required\":false},\"secrets\":{\"name\":\"secrets\",\"required\":false},\"shm-size\":{\"name\":\"shm-size\",\"required\":false},\"ssh\":{\"name\":\"ssh\",\"required\":false},\"tags\":{\"name\":\"tags\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"ulimit\":{\"name\":\"ulimit\",\"required\":false}},\"outputs\":{\"digest\":{\"name\":\"digest\"},\"imageid\":{\"name\":\"imageid\"},\"metadata\":{\"name\":\"metadata\"}}}" +
+	",\"docker/login-action@v2\":{\"name\":\"Docker Login\",\"inputs\":{\"ecr\":{\"name\":\"ecr\",\"required\":false},\"logout\":{\"name\":\"logout\",\"required\":false},\"password\":{\"name\":\"password\",\"required\":false},\"registry\":{\"name\":\"registry\",\"required\":false},\"username\":{\"name\":\"username\",\"required\":false}}}" +
+	",\"docker/login-action@v3\":{\"name\":\"Docker Login\",\"inputs\":{\"ecr\":{\"name\":\"ecr\",\"required\":false},\"logout\":{\"name\":\"logout\",\"required\":false},\"password\":{\"name\":\"password\",\"required\":false},\"registry\":{\"name\":\"registry\",\"required\":false},\"username\":{\"name\":\"username\",\"required\":false}}}" +
+	",\"docker/metadata-action@v4\":{\"name\":\"Docker Metadata action\",\"inputs\":{\"bake-target\":{\"name\":\"bake-target\",\"required\":false},\"context\":{\"name\":\"context\",\"required\":false},\"flavor\":{\"name\":\"flavor\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"images\":{\"name\":\"images\",\"required\":true},\"labels\":{\"name\":\"labels\",\"required\":false},\"sep-labels\":{\"name\":\"sep-labels\",\"required\":false},\"sep-tags\":{\"name\":\"sep-tags\",\"required\":false},\"tags\":{\"name\":\"tags\",\"required\":false}},\"outputs\":{\"bake-file\":{\"name\":\"bake-file\"},\"json\":{\"name\":\"json\"},\"labels\":{\"name\":\"labels\"},\"tags\":{\"name\":\"tags\"},\"version\":{\"name\":\"version\"}}}" +
+	",\"docker/metadata-action@v5\":{\"name\":\"Docker Metadata action\",\"inputs\":{\"annotations\":{\"name\":\"annotations\",\"required\":false},\"bake-target\":{\"name\":\"bake-target\",\"required\":false},\"context\":{\"name\":\"context\",\"required\":false},\"flavor\":{\"name\":\"flavor\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"images\":{\"name\":\"images\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"sep-annotations\":{\"name\":\"sep-annotations\",\"required\":false},\"sep-labels\":{\"name\":\"sep-labels\",\"required\":false},\"sep-tags\":{\"name\":\"sep-tags\",\"required\":false},\"tags\":{\"name\":\"tags\",\"required\":false}},\"outputs\":{\"annotations\":{\"name\":\"annotations\"},\"bake-file\":{\"name\":\"bake-file\"},\"bake-file-annotations\":{\"name\":\"bake-file-annotations\"},\"bake-file-labels\":{\"name\":\"bake-file-labels\"},\"bake-file-tags\":{\"name\":\"bake-file-tags\"},\"json\":{\"name\":\"json\"},\"labels\":{\"name\":\"labels\"},\"tags\":{\"name\":\"tags\"},\"version\":{\"name\":\"version\"}}}" +
+	",\"docker/setup-buildx-action@v2\":{\"name\":\"Docker Setup Buildx\",\"inputs\":{\"append\":{\"name\":\"append\",\"required\":false},\"buildkitd-flags\":{\"name\":\"buildkitd-flags\",\"required\":false},\"cleanup\":{\"name\":\"cleanup\",\"required\":false},\"config\":{\"name\":\"config\",\"required\":false},\"config-inline\":{\"name\":\"config-inline\",\"required\":false},\"driver\":{\"name\":\"driver\",\"required\":false},\"driver-opts\":{\"name\":\"driver-opts\",\"required\":false},\"endpoint\":{\"name\":\"endpoint\",\"required\":false},\"install\":{\"name\":\"install\",\"required\":false},\"platforms\":{\"name\":\"platforms\",\"required\":false},\"use\":{\"name\":\"use\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}},\"outputs\":{\"driver\":{\"name\":\"driver\"},\"endpoint\":{\"name\":\"endpoint\"},\"flags\":{\"name\":\"flags\"},\"name\":{\"name\":\"name\"},\"nodes\":{\"name\":\"nodes\"},\"platforms\":{\"name\":\"platforms\"},\"status\":{\"name\":\"status\"}}}" +
+	",\"docker/setup-buildx-action@v3\":{\"name\":\"Docker Setup Buildx\",\"inputs\":{\"append\":{\"name\":\"append\",\"required\":false},\"buildkitd-config\":{\"name\":\"buildkitd-config\",\"required\":false},\"buildkitd-config-inline\":{\"name\":\"buildkitd-config-inline\",\"required\":false},\"buildkitd-flags\":{\"name\":\"buildkitd-flags\",\"required\":false},\"cache-binary\":{\"name\":\"cache-binary\",\"required\":false},\"cleanup\":{\"name\":\"cleanup\",\"required\":false},\"config\":{\"name\":\"config\",\"required\":false},\"config-inline\":{\"name\":\"config-inline\",\"required\":false},\"driver\":{\"name\":\"driver\",\"required\":false},\"driver-opts\":{\"name\":\"driver-opts\",\"required\":false},\"endpoint\":{\"name\":\"endpoint\",\"required\":false},\"install\":{\"name\":\"install\",\"required\":false},\"platforms\":{\"name\":\"platforms\",\"required\":false},\"use\":{\"name\":\"use\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}},\"outputs\":{\"driver\":{\"name\":\"driver\"},\"endpoint\":{\"name\":\"endpoint\"},\"flags\":{\"name\":\"flags\"},\"name\":{\"name\":\"name\"},\"nodes\":{\"name\":\"nodes\"},\"platforms\":{\"name\":\"platforms\"},\"status\":{\"name\":\"status\"}}}" +
+	",\"docker/setup-qemu-action@v2\":{\"name\":\"Docker Setup QEMU\",\"inputs\":{\"image\":{\"name\":\"image\",\"required\":false},\"platforms\":{\"name\":\"platforms\",\"required\":false}},\"outputs\":{\"platforms\":{\"name\":\"platforms\"}}}" +
+	",\"docker/setup-qemu-action@v3\":{\"name\":\"Docker Setup QEMU\",\"inputs\":{\"image\":{\"name\":\"image\",\"required\":false},\"platforms\":{\"name\":\"platforms\",\"required\":false}},\"outputs\":{\"platforms\":{\"name\":\"platforms\"}}}" +
+	",\"dorny/paths-filter@v2\":{\"name\":\"Paths Changes Filter\",\"inputs\":{\"base\":{\"name\":\"base\",\"required\":false},\"filters\":{\"name\":\"filters\",\"required\":true},\"initial-fetch-depth\":{\"name\":\"initial-fetch-depth\",\"required\":false},\"list-files\":{\"name\":\"list-files\",\"required\":false},\"ref\":{\"name\":\"ref\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}},\"skip_outputs\":true}" +
+	",\"dorny/paths-filter@v3\":{\"name\":\"Paths Changes Filter\",\"inputs\":{\"base\":{\"name\":\"base\",\"required\":false},\"filters\":{\"name\":\"filters\",\"required\":true},\"initial-fetch-depth\":{\"name\":\"initial-fetch-depth\",\"required\":false},\"list-files\":{\"name\":\"list-files\",\"required\":false},\"predicate-quantifier\":{\"name\":\"predicate-quantifier\",\"required\":false},\"ref\":{\"name\":\"ref\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}},\"skip_outputs\":true}" +
+	",\"dtolnay/rust-toolchain@beta\":{\"name\":\"rustup toolchain install\",\"inputs\":{\"components\":{\"name\":\"components\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"targets\":{\"name\":\"targets\",\"required\":false},\"toolchain\":{\"name\":\"toolchain\",\"required\":false}},\"outputs\":{\"cachekey\":{\"name\":\"cachekey\"},\"name\":{\"name\":\"name\"}}}" +
+	",\"dtolnay/rust-toolchain@nightly\":{\"name\":\"rustup toolchain install\",\"inputs\":{\"components\":{\"name\":\"components\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"targets\":{\"name\":\"targets\",\"required\":false},\"toolchain\":{\"name\":\"toolchain\",\"required\":false}},\"outputs\":{\"cachekey\":{\"name\":\"cachekey\"},\"name\":{\"name\":\"name\"}}}" +
+	",\"dtolnay/rust-toolchain@stable\":{\"name\":\"rustup toolchain install\",\"inputs\":{\"components\":{\"name\":\"components\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"targets\":{\"name\":\"targets\",\"required\":false},\"toolchain\":{\"name\":\"toolchain\",\"required\":false}},\"outputs\":{\"cachekey\":{\"name\":\"cachekey\"},\"name\":{\"name\":\"name\"}}}" +
+	",\"enriikke/gatsby-gh-pages-action@v2\":{\"name\":\"Gatsby Publish\",\"inputs\":{\"access-token\":{\"name\":\"access-token\",\"required\":false},\"commit-message\":{\"name\":\"commit-message\",\"required\":false},\"deploy-branch\":{\"name\":\"deploy-branch\",\"required\":false},\"deploy-repo\":{\"name\":\"deploy-repo\",\"required\":false},\"gatsby-args\":{\"name\":\"gatsby-args\",\"required\":false},\"git-config-email\":{\"name\":\"git-config-email\",\"required\":false},\"git-config-name\":{\"name\":\"git-config-name\",\"required\":false},\"skip-publish\":{\"name\":\"skip-publish\",\"required\":false},\"working-dir\":{\"name\":\"working-dir\",\"required\":false}}}" +
+	",\"erlef/setup-beam@v1\":{\"name\":\"Setup Erlang/OTP with optional Elixir (and mix) and/or rebar3\",\"inputs\":{\"disable_problem_matchers\":{\"name\":\"disable_problem_matchers\",\"required\":false},\"elixir-version\":{\"name\":\"elixir-version\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"gleam-version\":{\"name\":\"gleam-version\",\"required\":false},\"hexpm-mirrors\":{\"name\":\"hexpm-mirrors\",\"required\":false},\"install-hex\":{\"name\":\"install-hex\",\"required\":false},\"install-rebar\":{\"name\":\"install-rebar\",\"required\":false},\"otp-version\":{\"name\":\"otp-version\",\"required\":false},\"rebar3-version\":{\"name\":\"rebar3-version\",\"required\":false},\"version-file\":{\"name\":\"version-file\",\"required\":false},\"version-type\":{\"name\":\"version-type\",\"required\":false}},\"outputs\":{\"elixir-version\":{\"name\":\"elixir-version\"},\"gleam-version\":{\"name\":\"gleam-version\"},\"otp-version\":{\"name\":\"otp-version\"},\"rebar3-version\":{\"name\":\"rebar3-version\"},\"setup-beam-version\":{\"name\":\"setup-beam-version\"}}}" +
+	",\"game-ci/unity-builder@v2\":{\"name\":\"Unity - Builder\",\"inputs\":{\"allowdirtybuild\":{\"name\":\"allowDirtyBuild\",\"required\":false},\"androidappbundle\":{\"name\":\"androidAppBundle\",\"required\":false},\"androidkeyaliasname\":{\"name\":\"androidKeyaliasName\",\"required\":false},\"androidkeyaliaspass\":{\"name\":\"androidKeyaliasPass\",\"required\":false},\"androidkeystorebase64\":{\"name\":\"androidKeystoreBase64\",\"required\":false},\"androidkeystorename\":{\"name\":\"androidKeystoreName\",\"required\":false},\"androidkeystorepass\":{\"name\":\"androidKeystorePass\",\"required\":false},\"androidtargetsdkversion\":{\"name\":\"androidTargetSdkVersion\",\"required\":false},\"androidversioncode\":{\"name\":\"androidVersionCode\",\"required\":false},\"awsbasestackname\":{\"name\":\"awsBaseStackName\",\"required\":false},\"buildmethod\":{\"name\":\"buildMethod\",\"required\":false},\"buildname\":{\"name\":\"buildName\",\"required\":false},\"buildspath\":{\"name\":\"buildsPath\",\"required\":false},\"cachekey\":{\"name\":\"cacheKey\",\"required\":false},\"cachepulloverridecommand\":{\"name\":\"cachePullOverrideCommand\",\"required\":false},\"cachepushoverridecommand\":{\"name\":\"cachePushOverrideCommand\",\"required\":false},\"checkdependencyhealthoverride\":{\"name\":\"checkDependencyHealthOverride\",\"required\":false},\"chownfilesto\":{\"name\":\"chownFilesTo\",\"required\":false},\"cloudrunnercluster\":{\"name\":\"cloudRunnerCluster\",\"required\":false},\"cloudrunnercpu\":{\"name\":\"cloudRunnerCpu\",\"required\":false},\"cloudrunnermemory\":{\"name\":\"cloudRunnerMemory\",\"required\":false},\"customimage\":{\"name\":\"customImage\",\"required\":false},\"customjob\":{\"name\":\"customJob\",\"required\":false},\"customjobhooks\":{\"name\":\"customJobHooks\",\"required\":false},\"customparameters\":{\"name\":\"customParameters\",\"required\":false},\"gitprivatetoken\":{\"name\":\"gitPrivateToken\",\"required\":false},\"kubeconfig\":{\"name\":\"kubeConfig\",\"required\":false},\"kubestorageclass\":{\"name\":\"kubeStorageClass\",\"required\":false},\"kubevolume\":{\"name\":\"kubeVolume\",\"required\":false},\"kubevolumesize\":{\"name\":\"kubeVolumeSize\",\"required\":false},\"postbuildsteps\":{\"name\":\"postBuildSteps\",\"required\":false},\"prebuildsteps\":{\"name\":\"preBuildSteps\",\"required\":false},\"projectpath\":{\"name\":\"projectPath\",\"required\":false},\"readinputfromoverridelist\":{\"name\":\"readInputFromOverrideList\",\"required\":false},\"readinputoverridecommand\":{\"name\":\"readInputOverrideCommand\",\"required\":false},\"sshagent\":{\"name\":\"sshAgent\",\"required\":false},\"startdependenciesoverride\":{\"name\":\"startDependenciesOverride\",\"required\":false},\"targetplatform\":{\"name\":\"targetPlatform\",\"required\":false},\"unityversion\":{\"name\":\"unityVersion\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"versioning\":{\"name\":\"versioning\",\"required\":false}},\"outputs\":{\"buildversion\":{\"name\":\"buildVersion\"},\"volume\":{\"name\":\"volume\"}}}" +
+	",\"game-ci/unity-builder@v3\":{\"name\":\"Unity - Builder\",\"inputs\":{\"allowdirtybuild\":{\"name\":\"allowDirtyBuild\",\"required\":false},\"androidexporttype\":{\"name\":\"androidExportType\",\"required\":false},\"androidkeyaliasname\":{\"name\":\"androidKeyaliasName\",\"required\":false},\"androidkeyaliaspass\":{\"name\":\"androidKeyaliasPass\",\"required\":false},\"androidkeystorebase64\":{\"name\":\"androidKeystoreBase64\",\"required\":false},\"androidkeystorename\":{\"name\":\"androidKeystoreName\",\"required\":false},\"androidkeystorepass\":{\"name\":\"androidKeystorePass\",\"required\":false},\"androidsymboltype\":{\"name\":\"androidSymbolType\",\"required\":false},\"androidtargetsdkversion\":{\"name\":\"androidTargetSdkVersion\",\"required\":false},\"androidversioncode\":{\"name\":\"androidVersionCode\",\"required\":false},\"awsstackname\":{\"name\":\"awsStackName\",\"required\":false},\"buildmethod\":{\"name\":\"buildMethod\",\"required\":false},\"buildname\":{\"name\":\"buildName\",\"required\":false},\"buildspath\":{\"name\":\"buildsPath\",\"required\":false},\"cachekey\":{\"name\":\"cacheKey\",\"required\":false},\"cacheunityinstallationonmac\":{\"name\":\"cacheUnityInstallationOnMac\",\"required\":false},\"chownfilesto\":{\"name\":\"chownFilesTo\",\"required\":false},\"cloudrunnercpu\":{\"name\":\"cloudRunnerCpu\",\"required\":false},\"cloudrunnermemory\":{\"name\":\"cloudRunnerMemory\",\"required\":false},\"containerhookfiles\":{\"name\":\"containerHookFiles\",\"required\":false},\"customcommandhooks\":{\"name\":\"customCommandHooks\",\"required\":false},\"customhookfiles\":{\"name\":\"customHookFiles\",\"required\":false},\"customimage\":{\"name\":\"customImage\",\"required\":false},\"customjob\":{\"name\":\"customJob\",\"required\":false},\"customparameters\":{\"name\":\"customParameters\",\"required\":false},\"dockerworkspacepath\":{\"name\":\"dockerWorkspacePath\",\"required\":false},\"githubowner\":{\"name\":\"githubOwner\",\"required\":false},\"gitprivatetoken\":{\"name\":\"gitPrivateToken\",\"required\":false},\"kubeconfig\":{\"name\":\"kubeConfig\",\"required\":false},\"kubestorageclass\":{\"name\":\"kubeStorageClass\",\"required\":false},\"kubevolume\":{\"name\":\"kubeVolume\",\"required\":false},\"kubevolumesize\":{\"name\":\"kubeVolumeSize\",\"required\":false},\"postbuildsteps\":{\"name\":\"postBuildSteps\",\"required\":false},\"prebuildsteps\":{\"name\":\"preBuildSteps\",\"required\":false},\"projectpath\":{\"name\":\"projectPath\",\"required\":false},\"providerstrategy\":{\"name\":\"providerStrategy\",\"required\":false},\"readinputfromoverridelist\":{\"name\":\"readInputFromOverrideList\",\"required\":false},\"readinputoverridecommand\":{\"name\":\"readInputOverrideCommand\",\"required\":false},\"sshagent\":{\"name\":\"sshAgent\",\"required\":false},\"sshpublickeysdirectorypath\":{\"name\":\"sshPublicKeysDirectoryPath\",\"required\":false},\"targetplatform\":{\"name\":\"targetPlatform\",\"required\":false},\"unityhubversiononmac\":{\"name\":\"unityHubVersionOnMac\",\"required\":false},\"unitylicensingserver\":{\"name\":\"unityLicensingServer\",\"required\":false},\"unityversion\":{\"name\":\"unityVersion\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"versioning\":{\"name\":\"versioning\",\"required\":false},\"watchtoend\":{\"name\":\"watchToEnd\",\"required\":false}},\"outputs\":{\"androidversioncode\":{\"name\":\"androidVersionCode\"},\"buildversion\":{\"name\":\"buildVersion\"},\"volume\":{\"name\":\"volume\"}}}" +
+	",\"game-ci/unity-builder@v4\":{\"name\":\"Unity - Builder\",\"inputs\":{\"allowdirtybuild\":{\"name\":\"allowDirtyBuild\",\"required\":false},\"androidexporttype\":{\"name\":\"androidExportType\",\"required\":false},\"androidkeyaliasname\":{\"name\":\"androidKeyaliasName\",\"required\":false},\"androidkeyaliaspass\":{\"name\":\"androidKeyaliasPass\",\"required\":false},\"androidkeystorebase64\":{\"name\":\"androidKeystoreBase64\",\"required\":false},\"androidkeystorename\":{\"name\":\"androidKeystoreName\",\"required\":false},\"androidkeystorepass\":{\"name\":\"androidKeystorePass\",\"required\":false},\"androidsymboltype\":{\"name\":\"androidSymbolType\",\"required\":false},\"androidtargetsdkversion\":{\"name\":\"androidTargetSdkVersion\",\"required\":false},\"androidversioncode\":{\"name\":\"androidVersionCode\",\"required\":false},\"awsstackname\":{\"name\":\"awsStackName\",\"required\":false},\"buildmethod\":{\"name\":\"buildMethod\",\"required\":false},\"buildname\":{\"name\":\"buildName\",\"required\":false},\"buildspath\":{\"name\":\"buildsPath\",\"required\":false},\"cachekey\":{\"name\":\"cacheKey\",\"required\":false},\"cacheunityinstallationonmac\":{\"name\":\"cacheUnityInstallationOnMac\",\"required\":false},\"chownfilesto\":{\"name\":\"chownFilesTo\",\"required\":false},\"containercpu\":{\"name\":\"containerCpu\",\"required\":false},\"containerhookfiles\":{\"name\":\"containerHookFiles\",\"required\":false},\"containermemory\":{\"name\":\"containerMemory\",\"required\":false},\"containerregistryimageversion\":{\"name\":\"containerRegistryImageVersion\",\"required\":false},\"containerregistryrepository\":{\"name\":\"containerRegistryRepository\",\"required\":false},\"customcommandhooks\":{\"name\":\"customCommandHooks\",\"required\":false},\"customhookfiles\":{\"name\":\"customHookFiles\",\"required\":false},\"customimage\":{\"name\":\"customImage\",\"required\":false},\"customjob\":{\"name\":\"customJob\",\"required\":false},\"customparameters\":{\"name\":\"customParameters\",\"required\":false},\"dockercpulimit\":{\"name\":\"dockerCpuLimit\",\"required\":false},\"dockerisolationmode\":{\"name\":\"dockerIsolationMode\",\"required\":false},\"dockermemorylimit\":{\"name\":\"dockerMemoryLimit\",\"required\":false},\"dockerworkspacepath\":{\"name\":\"dockerWorkspacePath\",\"required\":false},\"enablegpu\":{\"name\":\"enableGpu\",\"required\":false},\"githubowner\":{\"name\":\"githubOwner\",\"required\":false},\"gitprivatetoken\":{\"name\":\"gitPrivateToken\",\"required\":false},\"kubeconfig\":{\"name\":\"kubeConfig\",\"required\":false},\"kubestorageclass\":{\"name\":\"kubeStorageClass\",\"required\":false},\"kubevolume\":{\"name\":\"kubeVolume\",\"required\":false},\"kubevolumesize\":{\"name\":\"kubeVolumeSize\",\"required\":false},\"manualexit\":{\"name\":\"manualExit\",\"required\":false},\"postbuildsteps\":{\"name\":\"postBuildSteps\",\"required\":false},\"prebuildsteps\":{\"name\":\"preBuildSteps\",\"required\":false},\"projectpath\":{\"name\":\"projectPath\",\"required\":false},\"providerstrategy\":{\"name\":\"providerStrategy\",\"required\":false},\"readinputfromoverridelist\":{\"name\":\"readInputFromOverrideList\",\"required\":false},\"readinputoverridecommand\":{\"name\":\"readInputOverrideCommand\",\"required\":false},\"runashostuser\":{\"name\":\"runAsHostUser\",\"required\":false},\"skipactivation\":{\"name\":\"skipActivation\",\"required\":false},\"sshagent\":{\"name\":\"sshAgent\",\"required\":false},\"sshpublickeysdirectorypath\":{\"name\":\"sshPublicKeysDirectoryPath\",\"required\":false},\"targetplatform\":{\"name\":\"targetPlatform\",\"required\":false},\"unityhubversiononmac\":{\"name\":\"unityHubVersionOnMac\",\"required\":false},\"unitylicensingserver\":{\"name\":\"unityLicensingServer\",\"required\":false},\"unityversion\":{\"name\":\"unityVersion\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"versioning\":{\"name\":\"versioning\",\"required\":false},\"watchtoend\":{\"name\":\"watchToEnd\",\"required\":false}},\"outputs\":{\"androidversioncode\":{\"name\":\"androidVersionCode\"},\"buildversion\":{\"name\":\"buildVersion\"},\"engineexitcode\":{\"name\":\"engineExitCode\"},\"volume\":{\"name\":\"volume\"}}}" +
+	",\"github/codeql-action/analyze@v2\":{\"name\":\"CodeQL: Finish\",\"inputs\":{\"add-snippets\":{\"name\":\"add-snippets\",\"required\":false},\"category\":{\"name\":\"category\",\"required\":false},\"check_name\":{\"name\":\"check_name\",\"required\":false},\"checkout_path\":{\"name\":\"checkout_path\",\"required\":false},\"cleanup-level\":{\"name\":\"cleanup-level\",\"required\":false},\"expect-error\":{\"name\":\"expect-error\",\"required\":false},\"matrix\":{\"name\":\"matrix\",\"required\":false},\"output\":{\"name\":\"output\",\"required\":false},\"ram\":{\"name\":\"ram\",\"required\":false},\"ref\":{\"name\":\"ref\",\"required\":false},\"sha\":{\"name\":\"sha\",\"required\":false},\"skip-queries\":{\"name\":\"skip-queries\",\"required\":false},\"threads\":{\"name\":\"threads\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"upload\":{\"name\":\"upload\",\"required\":false},\"upload-database\":{\"name\":\"upload-database\",\"required\":false},\"wait-for-processing\":{\"name\":\"wait-for-processing\",\"required\":false}},\"outputs\":{\"db-locations\":{\"name\":\"db-locations\"},\"sarif-id\":{\"name\":\"sarif-id\"},\"sarif-output\":{\"name\":\"sarif-output\"}}}" +
+	",\"github/codeql-action/analyze@v3\":{\"name\":\"CodeQL: Finish\",\"inputs\":{\"add-snippets\":{\"name\":\"add-snippets\",\"required\":false},\"category\":{\"name\":\"category\",\"required\":false},\"check_name\":{\"name\":\"check_name\",\"required\":false},\"checkout_path\":{\"name\":\"checkout_path\",\"required\":false},\"cleanup-level\":{\"name\":\"cleanup-level\",\"required\":false},\"expect-error\":{\"name\":\"expect-error\",\"required\":false},\"matrix\":{\"name\":\"matrix\",\"required\":false},\"output\":{\"name\":\"output\",\"required\":false},\"ram\":{\"name\":\"ram\",\"required\":false},\"ref\":{\"name\":\"ref\",\"required\":false},\"sha\":{\"name\":\"sha\",\"required\":false},\"skip-queries\":{\"name\":\"skip-queries\",\"required\":false},\"threads\":{\"name\":\"threads\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"upload\":{\"name\":\"upload\",\"required\":false},\"upload-database\":{\"name\":\"upload-database\",\"required\":false},\"wait-for-processing\":{\"name\":\"wait-for-processing\",\"required\":false}},\"outputs\":{\"db-locations\":{\"name\":\"db-locations\"},\"sarif-id\":{\"name\":\"sarif-id\"},\"sarif-output\":{\"name\":\"sarif-output\"}}}" +
+	",\"github/codeql-action/autobuild@v2\":{\"name\":\"CodeQL: Autobuild\",\"inputs\":{\"matrix\":{\"name\":\"matrix\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"github/codeql-action/autobuild@v3\":{\"name\":\"CodeQL: Autobuild\",\"inputs\":{\"matrix\":{\"name\":\"matrix\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"github/codeql-action/init@v2\":{\"name\":\"CodeQL: Init\",\"inputs\":{\"build-mode\":{\"name\":\"build-mode\",\"required\":false},\"config\":{\"name\":\"config\",\"required\":false},\"config-file\":{\"name\":\"config-file\",\"required\":false},\"db-location\":{\"name\":\"db-location\",\"required\":false},\"debug\":{\"name\":\"debug\",\"required\":false},\"debug-artifact-name\":{\"name\":\"debug-artifact-name\",\"required\":false},\"debug-database-name\":{\"name\":\"debug-database-name\",\"required\":false},\"external-repository-token\":{\"name\":\"external-repository-token\",\"required\":false},\"languages\":{\"name\":\"languages\",\"required\":false},\"matrix\":{\"name\":\"matrix\",\"required\":false},\"packs\":{\"name\":\"packs\",\"required\":false},\"queries\":{\"name\":\"queries\",\"required\":false},\"ram\":{\"name\":\"ram\",\"required\":false},\"registries\":{\"name\":\"registries\",\"required\":false},\"setup-python-dependencies\":{\"name\":\"setup-python-dependencies\",\"required\":false},\"source-root\":{\"name\":\"source-root\",\"required\":false},\"threads\":{\"name\":\"threads\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"tools\":{\"name\":\"tools\",\"required\":false},\"trap-caching\":{\"name\":\"trap-caching\",\"required\":false}},\"outputs\":{\"codeql-path\":{\"name\":\"codeql-path\"}}}" +
+	",\"github/codeql-action/init@v3\":{\"name\":\"CodeQL: Init\",\"inputs\":{\"build-mode\":{\"name\":\"build-mode\",\"required\":false},\"config\":{\"name\":\"config\",\"required\":false},\"config-file\":{\"name\":\"config-file\",\"required\":false},\"db-location\":{\"name\":\"db-location\",\"required\":false},\"debug\":{\"name\":\"debug\",\"required\":false},\"debug-artifact-name\":{\"name\":\"debug-artifact-name\",\"required\":false},\"debug-database-name\":{\"name\":\"debug-database-name\",\"required\":false},\"external-repository-token\":{\"name\":\"external-repository-token\",\"required\":false},\"languages\":{\"name\":\"languages\",\"required\":false},\"matrix\":{\"name\":\"matrix\",\"required\":false},\"packs\":{\"name\":\"packs\",\"required\":false},\"queries\":{\"name\":\"queries\",\"required\":false},\"ram\":{\"name\":\"ram\",\"required\":false},\"registries\":{\"name\":\"registries\",\"required\":false},\"setup-python-dependencies\":{\"name\":\"setup-python-dependencies\",\"required\":false},\"source-root\":{\"name\":\"source-root\",\"required\":false},\"threads\":{\"name\":\"threads\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"tools\":{\"name\":\"tools\",\"required\":false},\"trap-caching\":{\"name\":\"trap-caching\",\"required\":false}},\"outputs\":{\"codeql-path\":{\"name\":\"codeql-path\"}}}" +
+	",\"github/super-linter@v3\":{\"name\":\"Super-Linter\"}" +
+	",\"github/super-linter@v4\":{\"name\":\"Super-Linter\"}" +
+	",\"github/super-linter@v5\":{\"name\":\"Super-Linter\"}" +
+	",\"github/super-linter@v6\":{\"name\":\"Super-Linter\"}" +
+	",\"golangci/golangci-lint-action@v3\":{\"name\":\"Run golangci-lint\",\"inputs\":{\"args\":{\"name\":\"args\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"install-mode\":{\"name\":\"install-mode\",\"required\":false},\"only-new-issues\":{\"name\":\"only-new-issues\",\"required\":false},\"skip-build-cache\":{\"name\":\"skip-build-cache\",\"required\":false},\"skip-cache\":{\"name\":\"skip-cache\",\"required\":false},\"skip-pkg-cache\":{\"name\":\"skip-pkg-cache\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"golangci/golangci-lint-action@v4\":{\"name\":\"Run golangci-lint\",\"inputs\":{\"args\":{\"name\":\"args\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"install-mode\":{\"name\":\"install-mode\",\"required\":false},\"only-new-issues\":{\"name\":\"only-new-issues\",\"required\":false},\"skip-build-cache\":{\"name\":\"skip-build-cache\",\"required\":false},\"skip-cache\":{\"name\":\"skip-cache\",\"required\":false},\"skip-pkg-cache\":{\"name\":\"skip-pkg-cache\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"golangci/golangci-lint-action@v5\":{\"name\":\"Golangci-lint\",\"inputs\":{\"annotations\":{\"name\":\"annotations\",\"required\":false},\"args\":{\"name\":\"args\",\"required\":false},\"cache-invalidation-interval\":{\"name\":\"cache-invalidation-interval\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"install-mode\":{\"name\":\"install-mode\",\"required\":false},\"only-new-issues\":{\"name\":\"only-new-issues\",\"required\":false},\"skip-cache\":{\"name\":\"skip-cache\",\"required\":false},\"skip-save-cache\":{\"name\":\"skip-save-cache\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"golangci/golangci-lint-action@v6\":{\"name\":\"Golangci-lint\",\"inputs\":{\"args\":{\"name\":\"args\",\"required\":false},\"cache-invalidation-interval\":{\"name\":\"cache-invalidation-interval\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"install-mode\":{\"name\":\"install-mode\",\"required\":false},\"only-new-issues\":{\"name\":\"only-new-issues\",\"required\":false},\"problem-matchers\":{\"name\":\"problem-matchers\",\"required\":false},\"skip-cache\":{\"name\":\"skip-cache\",\"required\":false},\"skip-save-cache\":{\"name\":\"skip-save-cache\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}}}" +
+	",\"google-github-actions/auth@v1\":{\"name\":\"Authenticate to Google Cloud\",\"inputs\":{\"access_token_lifetime\":{\"name\":\"access_token_lifetime\",\"required\":false},\"access_token_scopes\":{\"name\":\"access_token_scopes\",\"required\":false},\"access_token_subject\":{\"name\":\"access_token_subject\",\"required\":false},\"audience\":{\"name\":\"audience\",\"required\":false},\"backoff\":{\"name\":\"backoff\",\"required\":false},\"backoff_limit\":{\"name\":\"backoff_limit\",\"required\":false},\"cleanup_credentials\":{\"name\":\"cleanup_credentials\",\"required\":false},\"create_credentials_file\":{\"name\":\"create_credentials_file\",\"required\":false},\"credentials_json\":{\"name\":\"credentials_json\",\"required\":false},\"delegates\":{\"name\":\"delegates\",\"required\":false},\"export_environment_variables\":{\"name\":\"export_environment_variables\",\"required\":false},\"id_token_audience\":{\"name\":\"id_token_audience\",\"required\":false},\"id_token_include_email\":{\"name\":\"id_token_include_email\",\"required\":false},\"project_id\":{\"name\":\"project_id\",\"required\":false},\"retries\":{\"name\":\"retries\",\"required\":false},\"service_account\":{\"name\":\"service_account\",\"required\":false},\"token_format\":{\"name\":\"token_format\",\"required\":false},\"workload_identity_provider\":{\"name\":\"workload_identity_provider\",\"required\":false}},\"outputs\":{\"access_token\":{\"name\":\"access_token\"},\"access_token_expiration\":{\"name\":\"access_token_expiration\"},\"credentials_file_path\":{\"name\":\"credentials_file_path\"},\"id_token\":{\"name\":\"id_token\"},\"project_id\":{\"name\":\"project_id\"}}}" +
+	",\"google-github-actions/auth@v2\":{\"name\":\"Authenticate to Google Cloud\",\"inputs\":{\"access_token_lifetime\":{\"name\":\"access_token_lifetime\",\"required\":false},\"access_token_scopes\":{\"name\":\"access_token_scopes\",\"required\":false},\"access_token_subject\":{\"name\":\"access_token_subject\",\"required\":false},\"audience\":{\"name\":\"audience\",\"required\":false},\"backoff\":{\"name\":\"backoff\",\"required\":false},\"backoff_limit\":{\"name\":\"backoff_limit\",\"required\":false},\"cleanup_credentials\":{\"name\":\"cleanup_credentials\",\"required\":false},\"create_credentials_file\":{\"name\":\"create_credentials_file\",\"required\":false},\"credentials_json\":{\"name\":\"credentials_json\",\"required\":false},\"delegates\":{\"name\":\"delegates\",\"required\":false},\"export_environment_variables\":{\"name\":\"export_environment_variables\",\"required\":false},\"id_token_audience\":{\"name\":\"id_token_audience\",\"required\":false},\"id_token_include_email\":{\"name\":\"id_token_include_email\",\"required\":false},\"project_id\":{\"name\":\"project_id\",\"required\":false},\"retries\":{\"name\":\"retries\",\"required\":false},\"service_account\":{\"name\":\"service_account\",\"required\":false},\"token_format\":{\"name\":\"token_format\",\"required\":false},\"universe\":{\"name\":\"universe\",\"required\":false},\"workload_identity_provider\":{\"name\":\"workload_identity_provider\",\"required\":false}},\"outputs\":{\"access_token\":{\"name\":\"access_token\"},\"auth_token\":{\"name\":\"auth_token\"},\"credentials_file_path\":{\"name\":\"credentials_file_path\"},\"id_token\":{\"name\":\"id_token\"},\"project_id\":{\"name\":\"project_id\"}}}" +
+	",\"google-github-actions/get-secretmanager-secrets@v1\":{\"name\":\"Get Secret Manager secrets\",\"inputs\":{\"min_mask_length\":{\"name\":\"min_mask_length\",\"required\":false},\"secrets\":{\"name\":\"secrets\",\"required\":true}},\"skip_outputs\":true}" +
+	",\"google-github-actions/get-secretmanager-secrets@v2\":{\"name\":\"Get Secret Manager secrets\",\"inputs\":{\"min_mask_length\":{\"name\":\"min_mask_length\",\"required\":false},\"secrets\":{\"name\":\"secrets\",\"required\":true}},\"skip_outputs\":true}" +
+	",\"google-github-actions/setup-gcloud@v1\":{\"name\":\"Set up gcloud Cloud SDK environment\",\"inputs\":{\"install_components\":{\"name\":\"install_components\",\"required\":false},\"project_id\":{\"name\":\"project_id\",\"required\":false},\"skip_install\":{\"name\":\"skip_install\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}}}" +
+	",\"google-github-actions/setup-gcloud@v2\":{\"name\":\"Set up gcloud Cloud SDK environment\",\"inputs\":{\"install_components\":{\"name\":\"install_components\",\"required\":false},\"project_id\":{\"name\":\"project_id\",\"required\":false},\"skip_install\":{\"name\":\"skip_install\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}}}" +
+	",\"google-github-actions/upload-cloud-storage@v1\":{\"name\":\"Cloud Storage Uploader\",\"inputs\":{\"concurrency\":{\"name\":\"concurrency\",\"required\":false},\"destination\":{\"name\":\"destination\",\"required\":true},\"glob\":{\"name\":\"glob\",\"required\":false},\"gzip\":{\"name\":\"gzip\",\"required\":false},\"headers\":{\"name\":\"headers\",\"required\":false},\"parent\":{\"name\":\"parent\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":true},\"predefinedacl\":{\"name\":\"predefinedAcl\",\"required\":false},\"process_gcloudignore\":{\"name\":\"process_gcloudignore\",\"required\":false},\"project_id\":{\"name\":\"project_id\",\"required\":false},\"resumable\":{\"name\":\"resumable\",\"required\":false}}}" +
+	",\"google-github-actions/upload-cloud-storage@v2\":{\"name\":\"Cloud Storage Uploader\",\"inputs\":{\"concurrency\":{\"name\":\"concurrency\",\"required\":false},\"destination\":{\"name\":\"destination\",\"required\":true},\"glob\":{\"name\":\"glob\",\"required\":false},\"gzip\":{\"name\":\"gzip\",\"required\":false},\"headers\":{\"name\":\"headers\",\"required\":false},\"parent\":{\"name\":\"parent\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":true},\"predefinedacl\":{\"name\":\"predefinedAcl\",\"required\":false},\"process_gcloudignore\":{\"name\":\"process_gcloudignore\",\"required\":false},\"project_id\":{\"name\":\"project_id\",\"required\":false},\"resumable\":{\"name\":\"resumable\",\"required\":false}}}" +
+	",\"goreleaser/goreleaser-action@v3\":{\"name\":\"GoReleaser Action\",\"inputs\":{\"args\":{\"name\":\"args\",\"required\":false},\"distribution\":{\"name\":\"distribution\",\"required\":false},\"install-only\":{\"name\":\"install-only\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}},\"outputs\":{\"artifacts\":{\"name\":\"artifacts\"},\"metadata\":{\"name\":\"metadata\"}}}" +
+	",\"goreleaser/goreleaser-action@v4\":{\"name\":\"GoReleaser Action\",\"inputs\":{\"args\":{\"name\":\"args\",\"required\":false},\"distribution\":{\"name\":\"distribution\",\"required\":false},\"install-only\":{\"name\":\"install-only\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}},\"outputs\":{\"artifacts\":{\"name\":\"artifacts\"},\"metadata\":{\"name\":\"metadata\"}}}" +
+	",\"goreleaser/goreleaser-action@v5\":{\"name\":\"GoReleaser Action\",\"inputs\":{\"args\":{\"name\":\"args\",\"required\":false},\"distribution\":{\"name\":\"distribution\",\"required\":false},\"install-only\":{\"name\":\"install-only\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}},\"outputs\":{\"artifacts\":{\"name\":\"artifacts\"},\"metadata\":{\"name\":\"metadata\"}}}" +
+	",\"gradle/wrapper-validation-action@v1\":{\"name\":\"Gradle Wrapper Validation\",\"inputs\":{\"allow-checksums\":{\"name\":\"allow-checksums\",\"required\":false},\"allow-snapshots\":{\"name\":\"allow-snapshots\",\"required\":false},\"min-wrapper-count\":{\"name\":\"min-wrapper-count\",\"required\":false}},\"outputs\":{\"failed-wrapper\":{\"name\":\"failed-wrapper\"}}}" +
+	",\"gradle/wrapper-validation-action@v2\":{\"name\":\"Gradle Wrapper Validation\",\"inputs\":{\"allow-checksums\":{\"name\":\"allow-checksums\",\"required\":false},\"allow-snapshots\":{\"name\":\"allow-snapshots\",\"required\":false},\"min-wrapper-count\":{\"name\":\"min-wrapper-count\",\"required\":false}},\"outputs\":{\"failed-wrapper\":{\"name\":\"failed-wrapper\"}}}" +
+	",\"gradle/wrapper-validation-action@v3\":{\"name\":\"Gradle Wrapper Validation\",\"inputs\":{\"allow-checksums\":{\"name\":\"allow-checksums\",\"required\":false},\"allow-snapshots\":{\"name\":\"allow-snapshots\",\"required\":false},\"min-wrapper-count\":{\"name\":\"min-wrapper-count\",\"required\":false}},\"outputs\":{\"failed-wrapper\":{\"name\":\"failed-wrapper\"}}}" +
+	",\"haskell/actions/setup@v2\":{\"name\":\"Setup Haskell\",\"inputs\":{\"cabal-update\":{\"name\":\"cabal-update\",\"required\":false},\"cabal-version\":{\"name\":\"cabal-version\",\"required\":false},\"disable-matcher\":{\"name\":\"disable-matcher\",\"required\":false},\"enable-stack\":{\"name\":\"enable-stack\",\"required\":false},\"ghc-version\":{\"name\":\"ghc-version\",\"required\":false},\"ghcup-release-channel\":{\"name\":\"ghcup-release-channel\",\"required\":false},\"stack-no-global\":{\"name\":\"stack-no-global\",\"required\":false},\"stack-setup-ghc\":{\"name\":\"stack-setup-ghc\",\"required\":false},\"stack-version\":{\"name\":\"stack-version\",\"required\":false}},\"outputs\":{\"cabal-exe\":{\"name\":\"cabal-exe\"},\"cabal-path\":{\"name\":\"cabal-path\"},\"cabal-store\":{\"name\":\"cabal-store\"},\"cabal-version\":{\"name\":\"cabal-version\"},\"ghc-exe\":{\"name\":\"ghc-exe\"},\"ghc-path\":{\"name\":\"ghc-path\"},\"ghc-version\":{\"name\":\"ghc-version\"},\"stack-exe\":{\"name\":\"stack-exe\"},\"stack-path\":{\"name\":\"stack-path\"},\"stack-root\":{\"name\":\"stack-root\"},\"stack-version\":{\"name\":\"stack-version\"}}}" +
+	",\"microsoft/playwright-github-action@v1\":{\"name\":\"Run Playwright tests\"}" +
+	",\"mikepenz/release-changelog-builder-action@v3\":{\"name\":\"Release Changelog Builder\",\"inputs\":{\"baseurl\":{\"name\":\"baseUrl\",\"required\":false},\"commitmode\":{\"name\":\"commitMode\",\"required\":false},\"configuration\":{\"name\":\"configuration\",\"required\":false},\"configurationjson\":{\"name\":\"configurationJson\",\"required\":false},\"failonerror\":{\"name\":\"failOnError\",\"required\":false},\"fetchreleaseinformation\":{\"name\":\"fetchReleaseInformation\",\"required\":false},\"fetchreviewers\":{\"name\":\"fetchReviewers\",\"required\":false},\"fetchreviews\":{\"name\":\"fetchReviews\",\"required\":false},\"fromtag\":{\"name\":\"fromTag\",\"required\":false},\"ignoreprereleases\":{\"name\":\"ignorePreReleases\",\"required\":false},\"includeopen\":{\"name\":\"includeOpen\",\"required\":false},\"outputfile\":{\"name\":\"outputFile\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"repo\":{\"name\":\"repo\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"totag\":{\"name\":\"toTag\",\"required\":false}},\"outputs\":{\"categorized_prs\":{\"name\":\"categorized_prs\"},\"changelog\":{\"name\":\"changelog\"},\"failed\":{\"name\":\"failed\"},\"fromtag\":{\"name\":\"fromTag\"},\"open_prs\":{\"name\":\"open_prs\"},\"owner\":{\"name\":\"owner\"},\"pull_requests\":{\"name\":\"pull_requests\"},\"repo\":{\"name\":\"repo\"},\"totag\":{\"name\":\"toTag\"},\"uncategorized_prs\":{\"name\":\"uncategorized_prs\"}}}" +
+	",\"mikepenz/release-changelog-builder-action@v4\":{\"name\":\"Release Changelog Builder\",\"inputs\":{\"baseurl\":{\"name\":\"baseUrl\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"commitmode\":{\"name\":\"commitMode\",\"required\":false},\"configuration\":{\"name\":\"configuration\",\"required\":false},\"configurationjson\":{\"name\":\"configurationJson\",\"required\":false},\"exportcache\":{\"name\":\"exportCache\",\"required\":false},\"exportonly\":{\"name\":\"exportOnly\",\"required\":false},\"failonerror\":{\"name\":\"failOnError\",\"required\":false},\"fetchreleaseinformation\":{\"name\":\"fetchReleaseInformation\",\"required\":false},\"fetchreviewers\":{\"name\":\"fetchReviewers\",\"required\":false},\"fetchreviews\":{\"name\":\"fetchReviews\",\"required\":false},\"fetchviacommits\":{\"name\":\"fetchViaCommits\",\"required\":false},\"fromtag\":{\"name\":\"fromTag\",\"required\":false},\"ignoreprereleases\":{\"name\":\"ignorePreReleases\",\"required\":false},\"includeopen\":{\"name\":\"includeOpen\",\"required\":false},\"outputfile\":{\"name\":\"outputFile\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"platform\":{\"name\":\"platform\",\"required\":false},\"repo\":{\"name\":\"repo\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"totag\":{\"name\":\"toTag\",\"required\":false}},\"outputs\":{\"cache\":{\"name\":\"cache\"},\"categorized_prs\":{\"name\":\"categorized_prs\"},\"changelog\":{\"name\":\"changelog\"},\"failed\":{\"name\":\"failed\"},\"fromtag\":{\"name\":\"fromTag\"},\"open_prs\":{\"name\":\"open_prs\"},\"owner\":{\"name\":\"owner\"},\"pull_requests\":{\"name\":\"pull_requests\"},\"repo\":{\"name\":\"repo\"},\"totag\":{\"name\":\"toTag\"},\"uncategorized_prs\":{\"name\":\"uncategorized_prs\"}}}" +
+	",\"msys2/setup-msys2@v2\":{\"name\":\"Setup MSYS2\",\"inputs\":{\"cache\":{\"name\":\"cache\",\"required\":false},\"install\":{\"name\":\"install\",\"required\":false},\"location\":{\"name\":\"location\",\"required\":false},\"msystem\":{\"name\":\"msystem\",\"required\":false},\"pacboy\":{\"name\":\"pacboy\",\"required\":false},\"path-type\":{\"name\":\"path-type\",\"required\":false},\"platform-check-severity\":{\"name\":\"platform-check-severity\",\"required\":false},\"release\":{\"name\":\"release\",\"required\":false},\"update\":{\"name\":\"update\",\"required\":false}}}" +
+	",\"ncipollo/release-action@v1\":{\"name\":\"Create Release\",\"inputs\":{\"allowupdates\":{\"name\":\"allowUpdates\",\"required\":false},\"artifact\":{\"name\":\"artifact\",\"required\":false},\"artifactcontenttype\":{\"name\":\"artifactContentType\",\"required\":false},\"artifacterrorsfailbuild\":{\"name\":\"artifactErrorsFailBuild\",\"required\":false},\"artifacts\":{\"name\":\"artifacts\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"bodyfile\":{\"name\":\"bodyFile\",\"required\":false},\"commit\":{\"name\":\"commit\",\"required\":false},\"discussioncategory\":{\"name\":\"discussionCategory\",\"required\":false},\"draft\":{\"name\":\"draft\",\"required\":false},\"generatereleasenotes\":{\"name\":\"generateReleaseNotes\",\"required\":false},\"makelatest\":{\"name\":\"makeLatest\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"omitbody\":{\"name\":\"omitBody\",\"required\":false},\"omitbodyduringupdate\":{\"name\":\"omitBodyDuringUpdate\",\"required\":false},\"omitdraftduringupdate\":{\"name\":\"omitDraftDuringUpdate\",\"required\":false},\"omitname\":{\"name\":\"omitName\",\"required\":false},\"omitnameduringupdate\":{\"name\":\"omitNameDuringUpdate\",\"required\":false},\"omitprereleaseduringupdate\":{\"name\":\"omitPrereleaseDuringUpdate\",\"required\":false},\"owner\":{\"name\":\"owner\",\"required\":false},\"prerelease\":{\"name\":\"prerelease\",\"required\":false},\"removeartifacts\":{\"name\":\"removeArtifacts\",\"required\":false},\"replacesartifacts\":{\"name\":\"replacesArtifacts\",\"required\":false},\"repo\":{\"name\":\"repo\",\"required\":false},\"skipifreleaseexists\":{\"name\":\"skipIfReleaseExists\",\"required\":false},\"tag\":{\"name\":\"tag\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"updateonlyunreleased\":{\"name\":\"updateOnlyUnreleased\",\"required\":false}},\"outputs\":{\"html_url\":{\"name\":\"html_url\"},\"id\":{\"name\":\"id\"},\"upload_url\":{\"name\":\"upload_url\"}},\"pat_inputs\":{\"token\":\"release\"}}" +
+	",\"nwtgck/actions-netlify@v2\":{\"name\":\"Netlify Actions\",\"inputs\":{\"alias\":{\"name\":\"alias\",\"required\":false},\"deploy-message\":{\"name\":\"deploy-message\",\"required\":false},\"enable-commit-comment\":{\"name\":\"enable-commit-comment\",\"required\":false},\"enable-commit-status\":{\"name\":\"enable-commit-status\",\"required\":false},\"enable-github-deployment\":{\"name\":\"enable-github-deployment\",\"required\":false},\"enable-pull-request-comment\":{\"name\":\"enable-pull-request-comment\",\"required\":false},\"fails-without-credentials\":{\"name\":\"fails-without-credentials\",\"required\":false},\"functions-dir\":{\"name\":\"functions-dir\",\"required\":false},\"github-deployment-description\":{\"name\":\"github-deployment-description\",\"required\":false},\"github-deployment-environment\":{\"name\":\"github-deployment-environment\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"netlify-config-path\":{\"name\":\"netlify-config-path\",\"required\":false},\"overwrites-pull-request-comment\":{\"name\":\"overwrites-pull-request-comment\",\"required\":false},\"production-branch\":{\"name\":\"production-branch\",\"required\":false},\"production-deploy\":{\"name\":\"production-deploy\",\"required\":false},\"publish-dir\":{\"name\":\"publish-dir\",\"required\":true}},\"outputs\":{\"deploy-url\":{\"name\":\"deploy-url\"}}}" +
+	",\"nwtgck/actions-netlify@v3\":{\"name\":\"Netlify Actions\",\"inputs\":{\"alias\":{\"name\":\"alias\",\"required\":false},\"deploy-message\":{\"name\":\"deploy-message\",\"required\":false},\"enable-commit-comment\":{\"name\":\"enable-commit-comment\",\"required\":false},\"enable-commit-status\":{\"name\":\"enable-commit-status\",\"required\":false},\"enable-github-deployment\":{\"name\":\"enable-github-deployment\",\"required\":false},\"enable-pull-request-comment\":{\"name\":\"enable-pull-request-comment\",\"required\":false},\"fails-without-credentials\":{\"name\":\"fails-without-credentials\",\"required\":false},\"functions-dir\":{\"name\":\"functions-dir\",\"required\":false},\"github-deployment-description\":{\"name\":\"github-deployment-description\",\"required\":false},\"github-deployment-environment\":{\"name\":\"github-deployment-environment\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"netlify-config-path\":{\"name\":\"netlify-config-path\",\"required\":false},\"overwrites-pull-request-comment\":{\"name\":\"overwrites-pull-request-comment\",\"required\":false},\"production-branch\":{\"name\":\"production-branch\",\"required\":false},\"production-deploy\":{\"name\":\"production-deploy\",\"required\":false},\"publish-dir\":{\"name\":\"publish-dir\",\"required\":true}},\"outputs\":{\"deploy-url\":{\"name\":\"deploy-url\"}}}" +
+	",\"octokit/graphql-action@v2.x\":{\"name\":\"GitHub GraphQL API Query\",\"inputs\":{\"mediatype\":{\"name\":\"mediaType\",\"required\":false},\"query\":{\"name\":\"query\",\"required\":true},\"variables\":{\"name\":\"variables\",\"required\":false}},\"outputs\":{\"data\":{\"name\":\"data\"}}}" +
+	",\"octokit/request-action@v2.x\":{\"name\":\"GitHub API Request\",\"outputs\":{\"data\":{\"name\":\"data\"},\"headers\":{\"name\":\"headers\"},\"status\":{\"name\":\"status\"}},\"skip_inputs\":true}" +
+	",\"peaceiris/actions-gh-pages@v2\":{\"name\":\"GitHub Pages action\",\"inputs\":{\"commitmessage\":{\"name\":\"commitMessage\",\"required\":false},\"emptycommits\":{\"name\":\"emptyCommits\",\"required\":false},\"forceorphan\":{\"name\":\"forceOrphan\",\"required\":false},\"keepfiles\":{\"name\":\"keepFiles\",\"required\":false},\"tagmessage\":{\"name\":\"tagMessage\",\"required\":false},\"tagname\":{\"name\":\"tagName\",\"required\":false},\"tagoverwrite\":{\"name\":\"tagOverwrite\",\"required\":false},\"useremail\":{\"name\":\"useremail\",\"required\":false},\"username\":{\"name\":\"username\",\"required\":false}}}" +
+	",\"peaceiris/actions-gh-pages@v3\":{\"name\":\"GitHub Pages action\",\"inputs\":{\"allow_empty_commit\":{\"name\":\"allow_empty_commit\",\"required\":false},\"cname\":{\"name\":\"cname\",\"required\":false},\"commit_message\":{\"name\":\"commit_message\",\"required\":false},\"deploy_key\":{\"name\":\"deploy_key\",\"required\":false},\"destination_dir\":{\"name\":\"destination_dir\",\"required\":false},\"disable_nojekyll\":{\"name\":\"disable_nojekyll\",\"required\":false},\"enable_jekyll\":{\"name\":\"enable_jekyll\",\"required\":false},\"exclude_assets\":{\"name\":\"exclude_assets\",\"required\":false},\"external_repository\":{\"name\":\"external_repository\",\"required\":false},\"force_orphan\":{\"name\":\"force_orphan\",\"required\":false},\"full_commit_message\":{\"name\":\"full_commit_message\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"keep_files\":{\"name\":\"keep_files\",\"required\":false},\"personal_token\":{\"name\":\"personal_token\",\"required\":false},\"publish_branch\":{\"name\":\"publish_branch\",\"required\":false},\"publish_dir\":{\"name\":\"publish_dir\",\"required\":false},\"tag_message\":{\"name\":\"tag_message\",\"required\":false},\"tag_name\":{\"name\":\"tag_name\",\"required\":false},\"user_email\":{\"name\":\"user_email\",\"required\":false},\"user_name\":{\"name\":\"user_name\",\"required\":false}}}" +
+	",\"peaceiris/actions-gh-pages@v4\":{\"name\":\"GitHub Pages action\",\"inputs\":{\"allow_empty_commit\":{\"name\":\"allow_empty_commit\",\"required\":false},\"cname\":{\"name\":\"cname\",\"required\":false},\"commit_message\":{\"name\":\"commit_message\",\"required\":false},\"deploy_key\":{\"name\":\"deploy_key\",\"required\":false},\"destination_dir\":{\"name\":\"destination_dir\",\"required\":false},\"disable_nojekyll\":{\"name\":\"disable_nojekyll\",\"required\":false},\"enable_jekyll\":{\"name\":\"enable_jekyll\",\"required\":false},\"exclude_assets\":{\"name\":\"exclude_assets\",\"required\":false},\"external_repository\":{\"name\":\"external_repository\",\"required\":false},\"force_orphan\":{\"name\":\"force_orphan\",\"required\":false},\"full_commit_message\":{\"name\":\"full_commit_message\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"keep_files\":{\"name\":\"keep_files\",\"required\":false},\"personal_token\":{\"name\":\"personal_token\",\"required\":false},\"publish_branch\":{\"name\":\"publish_branch\",\"required\":false},\"publish_dir\":{\"name\":\"publish_dir\",\"required\":false},\"tag_message\":{\"name\":\"tag_message\",\"required\":false},\"tag_name\":{\"name\":\"tag_name\",\"required\":false},\"user_email\":{\"name\":\"user_email\",\"required\":false},\"user_name\":{\"name\":\"user_name\",\"required\":false}}}" +
+	",\"peaceiris/actions-hugo@v3\":{\"name\":\"Hugo setup\",\"inputs\":{\"extended\":{\"name\":\"extended\",\"required\":false},\"hugo-version\":{\"name\":\"hugo-version\",\"required\":false}}}" +
+	",\"peter-evans/create-pull-request@v4\":{\"name\":\"Create Pull Request\",\"inputs\":{\"add-paths\":{\"name\":\"add-paths\",\"required\":false},\"assignees\":{\"name\":\"assignees\",\"required\":false},\"author\":{\"name\":\"author\",\"required\":false},\"base\":{\"name\":\"base\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"branch\":{\"name\":\"branch\",\"required\":false},\"branch-suffix\":{\"name\":\"branch-suffix\",\"required\":false},\"commit-message\":{\"name\":\"commit-message\",\"required\":false},\"committer\":{\"name\":\"committer\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"draft\":{\"name\":\"draft\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"milestone\":{\"name\":\"milestone\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"push-to-fork\":{\"name\":\"push-to-fork\",\"required\":false},\"reviewers\":{\"name\":\"reviewers\",\"required\":false},\"signoff\":{\"name\":\"signoff\",\"required\":false},\"team-reviewers\":{\"name\":\"team-reviewers\",\"required\":false},\"title\":{\"name\":\"title\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"pull-request-head-sha\":{\"name\":\"pull-request-head-sha\"},\"pull-request-number\":{\"name\":\"pull-request-number\"},\"pull-request-operation\":{\"name\":\"pull-request-operation\"},\"pull-request-url\":{\"name\":\"pull-request-url\"}},\"pat_inputs\":{\"token\":\"pull_request\"}}" +
+	",\"peter-evans/create-pull-request@v5\":{\"name\":\"Create Pull Request\",\"inputs\":{\"add-paths\":{\"name\":\"add-paths\",\"required\":false},\"assignees\":{\"name\":\"assignees\",\"required\":false},\"author\":{\"name\":\"author\",\"required\":false},\"base\":{\"name\":\"base\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"body-path\":{\"name\":\"body-path\",\"required\":false},\"branch\":{\"name\":\"branch\",\"required\":false},\"branch-suffix\":{\"name\":\"branch-suffix\",\"required\":false},\"commit-message\":{\"name\":\"commit-message\",\"required\":false},\"committer\":{\"name\":\"committer\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"draft\":{\"name\":\"draft\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"milestone\":{\"name\":\"milestone\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"push-to-fork\":{\"name\":\"push-to-fork\",\"required\":false},\"reviewers\":{\"name\":\"reviewers\",\"required\":false},\"signoff\":{\"name\":\"signoff\",\"required\":false},\"team-reviewers\":{\"name\":\"team-reviewers\",\"required\":false},\"title\":{\"name\":\"title\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"pull-request-head-sha\":{\"name\":\"pull-request-head-sha\"},\"pull-request-number\":{\"name\":\"pull-request-number\"},\"pull-request-operation\":{\"name\":\"pull-request-operation\"},\"pull-request-url\":{\"name\":\"pull-request-url\"}},\"pat_inputs\":{\"token\":\"pull_request\"}}" +
+	",\"peter-evans/create-pull-request@v6\":{\"name\":\"Create Pull Request\",\"inputs\":{\"add-paths\":{\"name\":\"add-paths\",\"required\":false},\"assignees\":{\"name\":\"assignees\",\"required\":false},\"author\":{\"name\":\"author\",\"required\":false},\"base\":{\"name\":\"base\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"body-path\":{\"name\":\"body-path\",\"required\":false},\"branch\":{\"name\":\"branch\",\"required\":false},\"branch-suffix\":{\"name\":\"branch-suffix\",\"required\":false},\"commit-message\":{\"name\":\"commit-message\",\"required\":false},\"committer\":{\"name\":\"committer\",\"required\":false},\"delete-branch\":{\"name\":\"delete-branch\",\"required\":false},\"draft\":{\"name\":\"draft\",\"required\":false},\"git-token\":{\"name\":\"git-token\",\"required\":false},\"labels\":{\"name\":\"labels\",\"required\":false},\"milestone\":{\"name\":\"milestone\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"push-to-fork\":{\"name\":\"push-to-fork\",\"required\":false},\"reviewers\":{\"name\":\"reviewers\",\"required\":false},\"signoff\":{\"name\":\"signoff\",\"required\":false},\"team-reviewers\":{\"name\":\"team-reviewers\",\"required\":false},\"title\":{\"name\":\"title\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"pull-request-head-sha\":{\"name\":\"pull-request-head-sha\"},\"pull-request-number\":{\"name\":\"pull-request-number\"},\"pull-request-operation\":{\"name\":\"pull-request-operation\"},\"pull-request-url\":{\"name\":\"pull-request-url\"}},\"pat_inputs\":{\"token\":\"pull_request\"}}" +
+	",\"preactjs/compressed-size-action@v2\":{\"name\":\"compressed-size-action\",\"inputs\":{\"build-script\":{\"name\":\"build-script\",\"required\":false},\"clean-script\":{\"name\":\"clean-script\",\"required\":false},\"collapse-unchanged\":{\"name\":\"collapse-unchanged\",\"required\":false},\"compression\":{\"name\":\"compression\",\"required\":false},\"cwd\":{\"name\":\"cwd\",\"required\":false},\"exclude\":{\"name\":\"exclude\",\"required\":false},\"install-script\":{\"name\":\"install-script\",\"required\":false},\"minimum-change-threshold\":{\"name\":\"minimum-change-threshold\",\"required\":false},\"omit-unchanged\":{\"name\":\"omit-unchanged\",\"required\":false},\"pattern\":{\"name\":\"pattern\",\"required\":false},\"repo-token\":{\"name\":\"repo-token\",\"required\":false},\"show-total\":{\"name\":\"show-total\",\"required\":false},\"strip-hash\":{\"name\":\"strip-hash\",\"required\":false},\"use-check\":{\"name\":\"use-check\",\"required\":false}}}" +
+	",\"pulumi/actions@v1\":{\"name\":\"Pulumi CLI Action\",\"inputs\":{\"command\":{\"name\":\"command\",\"required\":true}}}" +
+	",\"pulumi/actions@v4\":{\"name\":\"Pulumi CLI Action\",\"inputs\":{\"cloud-url\":{\"name\":\"cloud-url\",\"required\":false},\"color\":{\"name\":\"color\",\"required\":false},\"command\":{\"name\":\"command\",\"required\":false},\"comment-on-pr\":{\"name\":\"comment-on-pr\",\"required\":false},\"comment-on-pr-number\":{\"name\":\"comment-on-pr-number\",\"required\":false},\"comment-on-summary\":{\"name\":\"comment-on-summary\",\"required\":false},\"config-map\":{\"name\":\"config-map\",\"required\":false},\"diff\":{\"name\":\"diff\",\"required\":false},\"edit-pr-comment\":{\"name\":\"edit-pr-comment\",\"required\":false},\"exclude-protected\":{\"name\":\"exclude-protected\",\"required\":false},\"expect-no-changes\":{\"name\":\"expect-no-changes\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"message\":{\"name\":\"message\",\"required\":false},\"parallel\":{\"name\":\"parallel\",\"required\":false},\"plan\":{\"name\":\"plan\",\"required\":false},\"policypackconfigs\":{\"name\":\"policyPackConfigs\",\"required\":false},\"policypacks\":{\"name\":\"policyPacks\",\"required\":false},\"pulumi-version\":{\"name\":\"pulumi-version\",\"required\":false},\"refresh\":{\"name\":\"refresh\",\"required\":false},\"remove\":{\"name\":\"remove\",\"required\":false},\"replace\":{\"name\":\"replace\",\"required\":false},\"secrets-provider\":{\"name\":\"secrets-provider\",\"required\":false},\"stack-name\":{\"name\":\"stack-name\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"target-dependents\":{\"name\":\"target-dependents\",\"required\":false},\"upsert\":{\"name\":\"upsert\",\"required\":false},\"work-dir\":{\"name\":\"work-dir\",\"required\":false}},\"outputs\":{\"output\":{\"name\":\"output\"}}}" +
+	",\"pulumi/actions@v5\":{\"name\":\"Pulumi CLI Action\",\"inputs\":{\"cloud-url\":{\"name\":\"cloud-url\",\"required\":false},\"color\":{\"name\":\"color\",\"required\":false},\"command\":{\"name\":\"command\",\"required\":false},\"comment-on-pr\":{\"name\":\"comment-on-pr\",\"required\":false},\"comment-on-pr-number\":{\"name\":\"comment-on-pr-number\",\"required\":false},\"comment-on-summary\":{\"name\":\"comment-on-summary\",\"required\":false},\"config-map\":{\"name\":\"config-map\",\"required\":false},\"diff\":{\"name\":\"diff\",\"required\":false},\"edit-pr-comment\":{\"name\":\"edit-pr-comment\",\"required\":false},\"exclude-protected\":{\"name\":\"exclude-protected\",\"required\":false},\"expect-no-changes\":{\"name\":\"expect-no-changes\",\"required\":false},\"github-token\":{\"name\":\"github-token\",\"required\":false},\"message\":{\"name\":\"message\",\"required\":false},\"parallel\":{\"name\":\"parallel\",\"required\":false},\"plan\":{\"name\":\"plan\",\"required\":false},\"policypackconfigs\":{\"name\":\"policyPackConfigs\",\"required\":false},\"policypacks\":{\"name\":\"policyPacks\",\"required\":false},\"pulumi-version\":{\"name\":\"pulumi-version\",\"required\":false},\"refresh\":{\"name\":\"refresh\",\"required\":false},\"remove\":{\"name\":\"remove\",\"required\":false},\"replace\":{\"name\":\"replace\",\"required\":false},\"secrets-provider\":{\"name\":\"secrets-provider\",\"required\":false},\"stack-name\":{\"name\":\"stack-name\",\"required\":false},\"suppress-outputs\":{\"name\":\"suppress-outputs\",\"required\":false},\"suppress-progress\":{\"name\":\"suppress-progress\",\"required\":false},\"target\":{\"name\":\"target\",\"required\":false},\"target-dependents\":{\"name\":\"target-dependents\",\"required\":false},\"upsert\":{\"name\":\"upsert\",\"required\":false},\"work-dir\":{\"name\":\"work-dir\",\"required\":false}},\"outputs\":{\"output\":{\"name\":\"output\"}}}" +
+	",\"pypa/gh-action-pypi-publish@release/v1\":{\"name\":\"pypi-publish\",\"inputs\":{\"packages-dir\":{\"name\":\"packages-dir\",\"required\":false},\"packages_dir\":{\"name\":\"packages_dir\",\"required\":false},\"password\":{\"name\":\"password\",\"required\":false},\"print-hash\":{\"name\":\"print-hash\",\"required\":false},\"print_hash\":{\"name\":\"print_hash\",\"required\":false},\"repository-url\":{\"name\":\"repository-url\",\"required\":false},\"repository_url\":{\"name\":\"repository_url\",\"required\":false},\"skip-existing\":{\"name\":\"skip-existing\",\"required\":false},\"skip_existing\":{\"name\":\"skip_existing\",\"required\":false},\"user\":{\"name\":\"user\",\"required\":false},\"verbose\":{\"name\":\"verbose\",\"required\":false},\"verify-metadata\":{\"name\":\"verify-metadata\",\"required\":false},\"verify_metadata\":{\"name\":\"verify_metadata\",\"required\":false}}}" +
+	",\"reviewdog/action-actionlint@v1\":{\"name\":\"actionlint with reviewdog\",\"inputs\":{\"actionlint_flags\":{\"name\":\"actionlint_flags\",\"required\":false},\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"tool_name\":{\"name\":\"tool_name\",\"required\":false}}}" +
+	",\"reviewdog/action-eslint@v1\":{\"name\":\"Run eslint with reviewdog\",\"inputs\":{\"eslint_flags\":{\"name\":\"eslint_flags\",\"required\":false},\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"node_options\":{\"name\":\"node_options\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"tool_name\":{\"name\":\"tool_name\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}}}" +
+	",\"reviewdog/action-golangci-lint@v1\":{\"name\":\"Run golangci-lint with reviewdog\",\"inputs\":{\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"golangci_lint_flags\":{\"name\":\"golangci_lint_flags\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"tool_name\":{\"name\":\"tool_name\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}}}" +
+	",\"reviewdog/action-golangci-lint@v2\":{\"name\":\"Run golangci-lint with reviewdog\",\"inputs\":{\"cache\":{\"name\":\"cache\",\"required\":false},\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"go_version\":{\"name\":\"go_version\",\"required\":false},\"go_version_file\":{\"name\":\"go_version_file\",\"required\":false},\"golangci_lint_flags\":{\"name\":\"golangci_lint_flags\",\"required\":false},\"golangci_lint_version\":{\"name\":\"golangci_lint_version\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"reviewdog_version\":{\"name\":\"reviewdog_version\",\"required\":false},\"tool_name\":{\"name\":\"tool_name\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}}}" +
+	",\"reviewdog/action-hadolint@v1\":{\"name\":\"Run hadolint with reviewdog\",\"inputs\":{\"exclude\":{\"name\":\"exclude\",\"required\":false},\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"hadolint_flags\":{\"name\":\"hadolint_flags\",\"required\":false},\"hadolint_ignore\":{\"name\":\"hadolint_ignore\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"tool_name\":{\"name\":\"tool_name\",\"required\":false}}}" +
+	",\"reviewdog/action-misspell@v1\":{\"name\":\"Run misspell with reviewdog\",\"inputs\":{\"exclude\":{\"name\":\"exclude\",\"required\":false},\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"ignore\":{\"name\":\"ignore\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"locale\":{\"name\":\"locale\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"pattern\":{\"name\":\"pattern\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false}}}" +
+	",\"reviewdog/action-rubocop@v1\":{\"name\":\"Run rubocop with reviewdog\",\"inputs\":{\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":true},\"level\":{\"name\":\"level\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"rubocop_extensions\":{\"name\":\"rubocop_extensions\",\"required\":false},\"rubocop_flags\":{\"name\":\"rubocop_flags\",\"required\":false},\"rubocop_version\":{\"name\":\"rubocop_version\",\"required\":false},\"skip_install\":{\"name\":\"skip_install\",\"required\":false},\"tool_name\":{\"name\":\"tool_name\",\"required\":false},\"use_bundler\":{\"name\":\"use_bundler\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}}}" +
+	",\"reviewdog/action-rubocop@v2\":{\"name\":\"Run rubocop with reviewdog\",\"inputs\":{\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"rubocop_extensions\":{\"name\":\"rubocop_extensions\",\"required\":false},\"rubocop_flags\":{\"name\":\"rubocop_flags\",\"required\":false},\"rubocop_version\":{\"name\":\"rubocop_version\",\"required\":false},\"skip_install\":{\"name\":\"skip_install\",\"required\":false},\"tool_name\":{\"name\":\"tool_name\",\"required\":false},\"use_bundler\":{\"name\":\"use_bundler\",\"required\":false},\"workdir\":{\"name\":\"workdir\",\"required\":false}}}" +
+	",\"reviewdog/action-shellcheck@v1\":{\"name\":\"Run shellcheck with reviewdog\",\"inputs\":{\"check_all_files_with_shebangs\":{\"name\":\"check_all_files_with_shebangs\",\"required\":false},\"exclude\":{\"name\":\"exclude\",\"required\":false},\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"path\":{\"name\":\"path\",\"required\":false},\"pattern\":{\"name\":\"pattern\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"reviewdog_flags\":{\"name\":\"reviewdog_flags\",\"required\":false},\"shellcheck_flags\":{\"name\":\"shellcheck_flags\",\"required\":false}}}" +
+	",\"reviewdog/action-tflint@v1\":{\"name\":\"Run tflint with reviewdog\",\"inputs\":{\"fail_on_error\":{\"name\":\"fail_on_error\",\"required\":false},\"filter_mode\":{\"name\":\"filter_mode\",\"required\":false},\"flags\":{\"name\":\"flags\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"level\":{\"name\":\"level\",\"required\":false},\"reporter\":{\"name\":\"reporter\",\"required\":false},\"tflint_config\":{\"name\":\"tflint_config\",\"required\":false},\"tflint_init\":{\"name\":\"tflint_init\",\"required\":false},\"tflint_rulesets\":{\"name\":\"tflint_rulesets\",\"required\":false},\"tflint_target_dir\":{\"name\":\"tflint_target_dir\",\"required\":false},\"tflint_version\":{\"name\":\"tflint_version\",\"required\":false},\"working_directory\":{\"name\":\"working_directory\",\"required\":false}},\"outputs\":{\"reviewdog-return-code\":{\"name\":\"reviewdog-return-code\"},\"tflint-return-code\":{\"name\":\"tflint-return-code\"}}}" +
+	",\"rhysd/action-setup-vim@v1\":{\"name\":\"Setup Vim\",\"inputs\":{\"configure-args\":{\"name\":\"configure-args\",\"required\":false},\"neovim\":{\"name\":\"neovim\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}},\"outputs\":{\"executable\":{\"name\":\"executable\"}}}" +
+	",\"ridedott/merge-me-action@v2\":{\"name\":\"Merge me!\",\"inputs\":{\"enable_github_api_preview\":{\"name\":\"ENABLE_GITHUB_API_PREVIEW\",\"required\":false},\"enabled_for_manual_changes\":{\"name\":\"ENABLED_FOR_MANUAL_CHANGES\",\"required\":false},\"github_login\":{\"name\":\"GITHUB_LOGIN\",\"required\":false},\"github_token\":{\"name\":\"GITHUB_TOKEN\",\"required\":true},\"maximum_retries\":{\"name\":\"MAXIMUM_RETRIES\",\"required\":false},\"merge_method\":{\"name\":\"MERGE_METHOD\",\"required\":false},\"preset\":{\"name\":\"PRESET\",\"required\":false}}}" +
+	",\"rtCamp/action-slack-notify@v2\":{\"name\":\"Slack Notify\"}" +
+	",\"ruby/setup-ruby@v1\":{\"name\":\"Setup Ruby, JRuby and TruffleRuby\",\"inputs\":{\"bundler\":{\"name\":\"bundler\",\"required\":false},\"bundler-cache\":{\"name\":\"bundler-cache\",\"required\":false},\"cache-version\":{\"name\":\"cache-version\",\"required\":false},\"ruby-version\":{\"name\":\"ruby-version\",\"required\":false},\"rubygems\":{\"name\":\"rubygems\",\"required\":false},\"self-hosted\":{\"name\":\"self-hosted\",\"required\":false},\"windows-toolchain\":{\"name\":\"windows-toolchain\",\"required\":false},\"working-directory\":{\"name\":\"working-directory\",\"required\":false}},\"outputs\":{\"ruby-prefix\":{\"name\":\"ruby-prefix\"}}}" +
+	",\"shivammathur/setup-php@v2\":{\"name\":\"Setup PHP Action\",\"inputs\":{\"coverage\":{\"name\":\"coverage\",\"required\":false},\"extensions\":{\"name\":\"extensions\",\"required\":false},\"ini-file\":{\"name\":\"ini-file\",\"required\":false},\"ini-values\":{\"name\":\"ini-values\",\"required\":false},\"php-version\":{\"name\":\"php-version\",\"required\":false},\"php-version-file\":{\"name\":\"php-version-file\",\"required\":false},\"tools\":{\"name\":\"tools\",\"required\":false}},\"outputs\":{\"php-version\":{\"name\":\"php-version\"}}}" +
+	",\"softprops/action-gh-release@v1\":{\"name\":\"GH Release\",\"inputs\":{\"append_body\":{\"name\":\"append_body\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"body_path\":{\"name\":\"body_path\",\"required\":false},\"discussion_category_name\":{\"name\":\"discussion_category_name\",\"required\":false},\"draft\":{\"name\":\"draft\",\"required\":false},\"fail_on_unmatched_files\":{\"name\":\"fail_on_unmatched_files\",\"required\":false},\"files\":{\"name\":\"files\",\"required\":false},\"generate_release_notes\":{\"name\":\"generate_release_notes\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"prerelease\":{\"name\":\"prerelease\",\"required\":false},\"repository\":{\"name\":\"repository\",\"required\":false},\"tag_name\":{\"name\":\"tag_name\",\"required\":false},\"target_commitish\":{\"name\":\"target_commitish\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"assets\":{\"name\":\"assets\"},\"id\":{\"name\":\"id\"},\"upload_url\":{\"name\":\"upload_url\"},\"url\":{\"name\":\"url\"}},\"pat_inputs\":{\"token\":\"release\"}}" +
+	",\"softprops/action-gh-release@v2\":{\"name\":\"GH Release\",\"inputs\":{\"append_body\":{\"name\":\"append_body\",\"required\":false},\"body\":{\"name\":\"body\",\"required\":false},\"body_path\":{\"name\":\"body_path\",\"required\":false},\"discussion_category_name\":{\"name\":\"discussion_category_name\",\"required\":false},\"draft\":{\"name\":\"draft\",\"required\":false},\"fail_on_unmatched_files\":{\"name\":\"fail_on_unmatched_files\",\"required\":false},\"files\":{\"name\":\"files\",\"required\":false},\"generate_release_notes\":{\"name\":\"generate_release_notes\",\"required\":false},\"make_latest\":{\"name\":\"make_latest\",\"required\":false},\"name\":{\"name\":\"name\",\"required\":false},\"prerelease\":{\"name\":\"prerelease\",\"required\":false},\"repository\":{\"name\":\"repository\",\"required\":false},\"tag_name\":{\"name\":\"tag_name\",\"required\":false},\"target_commitish\":{\"name\":\"target_commitish\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false}},\"outputs\":{\"assets\":{\"name\":\"assets\"},\"id\":{\"name\":\"id\"},\"upload_url\":{\"name\":\"upload_url\"},\"url\":{\"name\":\"url\"}},\"pat_inputs\":{\"token\":\"release\"}}" +
+	",\"subosito/flutter-action@v2\":{\"name\":\"Set up Flutter\",\"inputs\":{\"architecture\":{\"name\":\"architecture\",\"required\":false},\"cache\":{\"name\":\"cache\",\"required\":false},\"cache-key\":{\"name\":\"cache-key\",\"required\":false},\"cache-path\":{\"name\":\"cache-path\",\"required\":false},\"channel\":{\"name\":\"channel\",\"required\":false},\"dry-run\":{\"name\":\"dry-run\",\"required\":false},\"flutter-version\":{\"name\":\"flutter-version\",\"required\":false},\"flutter-version-file\":{\"name\":\"flutter-version-file\",\"required\":false},\"pub-cache-key\":{\"name\":\"pub-cache-key\",\"required\":false},\"pub-cache-path\":{\"name\":\"pub-cache-path\",\"required\":false}},\"outputs\":{\"architecture\":{\"name\":\"ARCHITECTURE\"},\"cache-key\":{\"name\":\"CACHE-KEY\"},\"cache-path\":{\"name\":\"CACHE-PATH\"},\"channel\":{\"name\":\"CHANNEL\"},\"pub-cache-key\":{\"name\":\"PUB-CACHE-KEY\"},\"pub-cache-path\":{\"name\":\"PUB-CACHE-PATH\"},\"version\":{\"name\":\"VERSION\"}}}" +
+	",\"treosh/lighthouse-ci-action@v10\":{\"name\":\"Lighthouse CI Action\",\"inputs\":{\"artifactname\":{\"name\":\"artifactName\",\"required\":false},\"basicauthpassword\":{\"name\":\"basicAuthPassword\",\"required\":false},\"basicauthusername\":{\"name\":\"basicAuthUsername\",\"required\":false},\"budgetpath\":{\"name\":\"budgetPath\",\"required\":false},\"configpath\":{\"name\":\"configPath\",\"required\":false},\"runs\":{\"name\":\"runs\",\"required\":false},\"serverbaseurl\":{\"name\":\"serverBaseUrl\",\"required\":false},\"servertoken\":{\"name\":\"serverToken\",\"required\":false},\"temporarypublicstorage\":{\"name\":\"temporaryPublicStorage\",\"required\":false},\"uploadartifacts\":{\"name\":\"uploadArtifacts\",\"required\":false},\"urls\":{\"name\":\"urls\",\"required\":false}},\"outputs\":{\"assertionresults\":{\"name\":\"assertionResults\"},\"links\":{\"name\":\"links\"},\"resultspath\":{\"name\":\"resultsPath\"}}}" +
+	",\"treosh/lighthouse-ci-action@v11\":{\"name\":\"Lighthouse CI Action\",\"inputs\":{\"artifactname\":{\"name\":\"artifactName\",\"required\":false},\"basicauthpassword\":{\"name\":\"basicAuthPassword\",\"required\":false},\"basicauthusername\":{\"name\":\"basicAuthUsername\",\"required\":false},\"budgetpath\":{\"name\":\"budgetPath\",\"required\":false},\"configpath\":{\"name\":\"configPath\",\"required\":false},\"runs\":{\"name\":\"runs\",\"required\":false},\"serverbaseurl\":{\"name\":\"serverBaseUrl\",\"required\":false},\"servertoken\":{\"name\":\"serverToken\",\"required\":false},\"temporarypublicstorage\":{\"name\":\"temporaryPublicStorage\",\"required\":false},\"uploadartifacts\":{\"name\":\"uploadArtifacts\",\"required\":false},\"uploadextraargs\":{\"name\":\"uploadExtraArgs\",\"required\":false},\"urls\":{\"name\":\"urls\",\"required\":false}},\"outputs\":{\"assertionresults\":{\"name\":\"assertionResults\"},\"links\":{\"name\":\"links\"},\"resultspath\":{\"name\":\"resultsPath\"}}}" +
+	",\"treosh/lighthouse-ci-action@v9\":{\"name\":\"Lighthouse CI Action\",\"inputs\":{\"artifactname\":{\"name\":\"artifactName\",\"required\":false},\"basicauthpassword\":{\"name\":\"basicAuthPassword\",\"required\":false},\"basicauthusername\":{\"name\":\"basicAuthUsername\",\"required\":false},\"budgetpath\":{\"name\":\"budgetPath\",\"required\":false},\"configpath\":{\"name\":\"configPath\",\"required\":false},\"runs\":{\"name\":\"runs\",\"required\":false},\"serverbaseurl\":{\"name\":\"serverBaseUrl\",\"required\":false},\"servertoken\":{\"name\":\"serverToken\",\"required\":false},\"temporarypublicstorage\":{\"name\":\"temporaryPublicStorage\",\"required\":false},\"uploadartifacts\":{\"name\":\"uploadArtifacts\",\"required\":false},\"urls\":{\"name\":\"urls\",\"required\":false}},\"outputs\":{\"assertionresults\":{\"name\":\"assertionResults\"},\"links\":{\"name\":\"links\"},\"resultspath\":{\"name\":\"resultsPath\"}}}" +
+	",\"wearerequired/lint-action@v2\":{\"name\":\"Lint Action\",\"inputs\":{\"auto_fix\":{\"name\":\"auto_fix\",\"required\":false},\"autopep8\":{\"name\":\"autopep8\",\"required\":false},\"autopep8_args\":{\"name\":\"autopep8_args\",\"required\":false},\"autopep8_auto_fix\":{\"name\":\"autopep8_auto_fix\",\"required\":false},\"autopep8_command_prefix\":{\"name\":\"autopep8_command_prefix\",\"required\":false},\"autopep8_dir\":{\"name\":\"autopep8_dir\",\"required\":false},\"autopep8_extensions\":{\"name\":\"autopep8_extensions\",\"required\":false},\"black\":{\"name\":\"black\",\"required\":false},\"black_args\":{\"name\":\"black_args\",\"required\":false},\"black_auto_fix\":{\"name\":\"black_auto_fix\",\"required\":false},\"black_command_prefix\":{\"name\":\"black_command_prefix\",\"required\":false},\"black_dir\":{\"name\":\"black_dir\",\"required\":false},\"black_extensions\":{\"name\":\"black_extensions\",\"required\":false},\"check_name\":{\"name\":\"check_name\",\"required\":false},\"clang_format\":{\"name\":\"clang_format\",\"required\":false},\"clang_format_args\":{\"name\":\"clang_format_args\",\"required\":false},\"clang_format_auto_fix\":{\"name\":\"clang_format_auto_fix\",\"required\":false},\"clang_format_command_prefix\":{\"name\":\"clang_format_command_prefix\",\"required\":false},\"clang_format_dir\":{\"name\":\"clang_format_dir\",\"required\":false},\"clang_format_extensions\":{\"name\":\"clang_format_extensions\",\"required\":false},\"commit\":{\"name\":\"commit\",\"required\":false},\"commit_message\":{\"name\":\"commit_message\",\"required\":false},\"continue_on_error\":{\"name\":\"continue_on_error\",\"required\":false},\"dotnet_format\":{\"name\":\"dotnet_format\",\"required\":false},\"dotnet_format_args\":{\"name\":\"dotnet_format_args\",\"required\":false},\"dotnet_format_auto_fix\":{\"name\":\"dotnet_format_auto_fix\",\"required\":false},\"dotnet_format_command_prefix\":{\"name\":\"dotnet_format_command_prefix\",\"required\":false},\"dotnet_format_dir\":{\"name\":\"dotnet_format_dir\",\"required\":false},\"dotnet_format_extensions\":{\"name\":\"dotnet_format_extensions\",\"required\":false},\"erblint\":{\"name\":\"erblint\",\"required\":false},\"erblint_args\":{\"name\":\"erblint_args\",\"required\":false},\"erblint_auto_fix\":{\"name\":\"erblint_auto_fix\",\"required\":false},\"erblint_command_prefix\":{\"name\":\"erblint_command_prefix\",\"required\":false},\"erblint_dir\":{\"name\":\"erblint_dir\",\"required\":false},\"erblint_extensions\":{\"name\":\"erblint_extensions\",\"required\":false},\"eslint\":{\"name\":\"eslint\",\"required\":false},\"eslint_args\":{\"name\":\"eslint_args\",\"required\":false},\"eslint_auto_fix\":{\"name\":\"eslint_auto_fix\",\"required\":false},\"eslint_command_prefix\":{\"name\":\"eslint_command_prefix\",\"required\":false},\"eslint_dir\":{\"name\":\"eslint_dir\",\"required\":false},\"eslint_extensions\":{\"name\":\"eslint_extensions\",\"required\":false},\"flake8\":{\"name\":\"flake8\",\"required\":false},\"flake8_args\":{\"name\":\"flake8_args\",\"required\":false},\"flake8_auto_fix\":{\"name\":\"flake8_auto_fix\",\"required\":false},\"flake8_command_prefix\":{\"name\":\"flake8_command_prefix\",\"required\":false},\"flake8_dir\":{\"name\":\"flake8_dir\",\"required\":false},\"flake8_extensions\":{\"name\":\"flake8_extensions\",\"required\":false},\"git_email\":{\"name\":\"git_email\",\"required\":false},\"git_name\":{\"name\":\"git_name\",\"required\":false},\"git_no_verify\":{\"name\":\"git_no_verify\",\"required\":false},\"github_token\":{\"name\":\"github_token\",\"required\":false},\"gofmt\":{\"name\":\"gofmt\",\"required\":false},\"gofmt_args\":{\"name\":\"gofmt_args\",\"required\":false},\"gofmt_auto_fix\":{\"name\":\"gofmt_auto_fix\",\"required\":false},\"gofmt_command_prefix\":{\"name\":\"gofmt_command_prefix\",\"required\":false},\"gofmt_dir\":{\"name\":\"gofmt_dir\",\"required\":false},\"gofmt_extensions\":{\"name\":\"gofmt_extensions\",\"required\":false},\"golint\":{\"name\":\"golint\",\"required\":false},\"golint_args\":{\"name\":\"golint_args\",\"required\":false},\"golint_auto_fix\":{\"name\":\"golint_auto_fix\",\"required\":false},\"golint_command_prefix\":{\"name\":\"golint_command_prefix\",\"required\":false},\"golint_dir\":{\"name\":\"golint_dir\",\"required\":false},\"golint_extensions\":{\"name\":\"golint_extensions\",\"required\":false},\"mypy\":{\"name\":\"mypy\",\"required\":false},\"mypy_args\":{\"name\":\"mypy_args\",\"required\":false},\"mypy_auto_fix\":{\"name\":\"mypy_auto_fix\",\"required\":false},\"mypy_command_prefix\":{\"name\":\"mypy_command_prefix\",\"required\":false},\"mypy_dir\":{\"name\":\"mypy_dir\",\"required\":false},\"mypy_extensions\":{\"name\":\"mypy_extensions\",\"required\":false},\"neutral_check_on_warning\":{\"name\":\"neutral_check_on_warning\",\"required\":false},\"oitnb\":{\"name\":\"oitnb\",\"required\":false},\"oitnb_args\":{\"name\":\"oitnb_args\",\"required\":false},\"oitnb_auto_fix\":{\"name\":\"oitnb_auto_fix\",\"required\":false},\"oitnb_command_prefix\":{\"name\":\"oitnb_command_prefix\",\"required\":false},\"oitnb_dir\":{\"name\":\"oitnb_dir\",\"required\":false},\"oitnb_extensions\":{\"name\":\"oitnb_extensions\",\"required\":false},\"php_codesniffer\":{\"name\":\"php_codesniffer\",\"required\":false},\"php_codesniffer_args\":{\"name\":\"php_codesniffer_args\",\"required\":false},\"php_codesniffer_auto_fix\":{\"name\":\"php_codesniffer_auto_fix\",\"required\":false},\"php_codesniffer_command_prefix\":{\"name\":\"php_codesniffer_command_prefix\",\"required\":false},\"php_codesniffer_dir\":{\"name\":\"php_codesniffer_dir\",\"required\":false},\"php_codesniffer_extensions\":{\"name\":\"php_codesniffer_extensions\",\"required\":false},\"prettier\":{\"name\":\"prettier\",\"required\":false},\"prettier_args\":{\"name\":\"prettier_args\",\"required\":false},\"prettier_auto_fix\":{\"name\":\"prettier_auto_fix\",\"required\":false},\"prettier_command_prefix\":{\"name\":\"prettier_command_prefix\",\"required\":false},\"prettier_dir\":{\"name\":\"prettier_dir\",\"required\":false},\"prettier_extensions\":{\"name\":\"prettier_extensions\",\"required\":false},\"pylint\":{\"name\":\"pylint\",\"required\":false},\"pylint_args\":{\"name\":\"pylint_args\",\"required\":false},\"pylint_auto_fix\":{\"name\":\"pylint_auto_fix\",\"required\":false},\"pylint_command_prefix\":{\"name\":\"pylint_command_prefix\",\"required\":false},\"pylint_dir\":{\"name\":\"pylint_dir\",\"required\":false},\"pylint_extensions\":{\"name\":\"pylint_extensions\",\"required\":false},\"rubocop\":{\"name\":\"rubocop\",\"required\":false},\"rubocop_args\":{\"name\":\"rubocop_args\",\"required\":false},\"rubocop_auto_fix\":{\"name\":\"rubocop_auto_fix\",\"required\":false},\"rubocop_command_prefix\":{\"name\":\"rubocop_command_prefix\",\"required\":false},\"rubocop_dir\":{\"name\":\"rubocop_dir\",\"required\":false},\"rubocop_extensions\":{\"name\":\"rubocop_extensions\",\"required\":false},\"stylelint\":{\"name\":\"stylelint\",\"required\":false},\"stylelint_args\":{\"name\":\"stylelint_args\",\"required\":false},\"stylelint_auto_fix\":{\"name\":\"stylelint_auto_fix\",\"required\":false},\"stylelint_command_prefix\":{\"name\":\"stylelint_command_prefix\",\"required\":false},\"stylelint_dir\":{\"name\":\"stylelint_dir\",\"required\":false},\"stylelint_extensions\":{\"name\":\"stylelint_extensions\",\"required\":false},\"swift_format_lockwood\":{\"name\":\"swift_format_lockwood\",\"required\":false},\"swift_format_lockwood_args\":{\"name\":\"swift_format_lockwood_args\",\"required\":false},\"swift_format_lockwood_auto_fix\":{\"name\":\"swift_format_lockwood_auto_fix\",\"required\":false},\"swift_format_lockwood_command_prefix\":{\"name\":\"swift_format_lockwood_command_prefix\",\"required\":false},\"swift_format_lockwood_dir\":{\"name\":\"swift_format_lockwood_dir\",\"required\":false},\"swift_format_lockwood_extensions\":{\"name\":\"swift_format_lockwood_extensions\",\"required\":false},\"swift_format_official\":{\"name\":\"swift_format_official\",\"required\":false},\"swift_format_official_args\":{\"name\":\"swift_format_official_args\",\"required\":false},\"swift_format_official_auto_fix\":{\"name\":\"swift_format_official_auto_fix\",\"required\":false},\"swift_format_official_command_prefix\":{\"name\":\"swift_format_official_command_prefix\",\"required\":false},\"swift_format_official_dir\":{\"name\":\"swift_format_official_dir\",\"required\":false},\"swift_format_official_extensions\":{\"name\":\"swift_format_official_extensions\",\"required\":false},\"swiftformat\":{\"name\":\"swiftformat\",\"required\":false},\"swiftformat_args\":{\"name\":\"swiftformat_args\",\"required\":false},\"swiftformat_auto_fix\":{\"name\":\"swiftformat_auto_fix\",\"required\":false},\"swiftformat_command_prefix\":{\"name\":\"swiftformat_command_prefix\",\"required\":false},\"swiftformat_dir\":{\"name\":\"swiftformat_dir\",\"required\":false},\"swiftformat_extensions\":{\"name\":\"swiftformat_extensions\",\"required\":false},\"swiftlint\":{\"name\":\"swiftlint\",\"required\":false},\"swiftlint_args\":{\"name\":\"swiftlint_args\",\"required\":false},\"swiftlint_auto_fix\":{\"name\":\"swiftlint_auto_fix\",\"required\":false},\"swiftlint_command_prefix\":{\"name\":\"swiftlint_command_prefix\",\"required\":false},\"swiftlint_dir\":{\"name\":\"swiftlint_dir\",\"required\":false},\"swiftlint_extensions\":{\"name\":\"swiftlint_extensions\",\"required\":false},\"tsc\":{\"name\":\"tsc\",\"required\":false},\"tsc_args\":{\"name\":\"tsc_args\",\"required\":false},\"tsc_auto_fix\":{\"name\":\"tsc_auto_fix\",\"required\":false},\"tsc_command_prefix\":{\"name\":\"tsc_command_prefix\",\"required\":false},\"tsc_dir\":{\"name\":\"tsc_dir\",\"required\":false},\"tsc_extensions\":{\"name\":\"tsc_extensions\",\"required\":false},\"xo\":{\"name\":\"xo\",\"required\":false},\"xo_args\":{\"name\":\"xo_args\",\"required\":false},\"xo_auto_fix\":{\"name\":\"xo_auto_fix\",\"required\":false},\"xo_command_prefix\":{\"name\":\"xo_command_prefix\",\"required\":false},\"xo_dir\":{\"name\":\"xo_dir\",\"required\":false},\"xo_extensions\":{\"name\":\"xo_extensions\",\"required\":false}}}" +
+	"}"
 
 // OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
 // means that the runner used by the action is no longer available such as "node12".
@@ -6,11 +6,11 @@ import (
 )
 
 func TestPopularActionsDataset(t *testing.T) {
-	if len(PopularActions) == 0 {
+	if len(PopularActions()) == 0 {
 		t.Fatal("popular actions data set is empty")
 	}
 
-	for n, meta := range PopularActions {
+	for n, meta := range PopularActions() {
 		if meta == nil {
 			t.Fatalf("metadata for %s is nil", n)
 		}
@@ -0,0 +1,173 @@
+package actionlint
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// RefUpdate is an update of a ref by a push. Git pre-receive hooks receive the updates from stdin.
+// https://git-scm.com/docs/githooks#pre-receive
+type RefUpdate struct {
+	// OldRev is an object name of the ref before the push. It consists of zeros when the ref is created.
+	OldRev string
+	// NewRev is an object name of the ref after the push. It consists of zeros when the ref is deleted.
+	NewRev string
+	// Ref is a full name of the ref like "refs/heads/main".
+	Ref string
+}
+
+// ShortRef returns the name of the ref without "refs/heads/" or "refs/tags/" prefix.
+func (u *RefUpdate) ShortRef() string {
+	for _, p := range []string{"refs/heads/", "refs/tags/"} {
+		if strings.HasPrefix(u.Ref, p) {
+			return u.Ref[len(p):]
+		}
+	}
+	return u.Ref
+}
+
+func isZeroObjectName(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
+
+// ParseRefUpdates parses ref updates given to pre-receive hooks. Each line of the input is in the
+// format "<old-value> SP <new-value> SP <ref-name>".
+func ParseRefUpdates(r io.Reader) ([]*RefUpdate, error) {
+	us := []*RefUpdate{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if l == "" {
+			continue
+		}
+		fs := strings.Fields(l)
+		if len(fs) != 3 {
+			return nil, fmt.Errorf("invalid ref update %q. it must be in the format \"<old-value> <new-value> <ref-name>\"", l)
+		}
+		us = append(us, &RefUpdate{fs[0], fs[1], fs[2]})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("could not read ref updates: %w", err)
+	}
+	return us, nil
+}
+
+// ReadGitPushedWorkflows reads workflow files which are added or modified by the ref update from
+// the Git repository. When the ref is updated, files changed between the old and the new revisions
+// are read. When the ref is created, files changed by commits which are not reachable from any
+// existing refs are read. When the ref is deleted, no file is read. The config file at the new
+// revision is also read. See ReadGitRevision for the gitDir parameter.
+func ReadGitPushedWorkflows(ctx context.Context, gitDir string, u *RefUpdate) (*Sources, error) {
+	c := newCollector(u.NewRev)
+	if isZeroObjectName(u.NewRev) {
+		return c.finish()
+	}
+
+	var args []string
+	if isZeroObjectName(u.OldRev) {
+		args = []string{"log", "--format=", "--name-only", "-z", "--diff-filter=d", u.NewRev, "--not", "--all", "--", ".github/workflows"}
+	} else {
+		args = []string{"diff", "--name-only", "-z", "--diff-filter=d", u.OldRev, u.NewRev, "--", ".github/workflows"}
+	}
+	out, err := gitCommand(ctx, gitDir, args...).Output()
+	if err != nil {
+		return nil, gitCommandError(ctx, err, fmt.Sprintf("could not list files changed by update of ref %q in Git directory %q", u.Ref, gitDirName(gitDir)))
+	}
+
+	seen := map[string]struct{}{}
+	paths := []string{}
+	for _, p := range strings.Split(string(out), "\x00") {
+		p = strings.TrimSpace(p) // `git log` outputs newlines between commits
+		if _, ok := seen[p]; !ok && p != "" {
+			seen[p] = struct{}{}
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == 0 {
+		return c.finish()
+	}
+
+	cfgs, err := listGitFiles(ctx, gitDir, u.NewRev, ".github/actionlint.yaml", ".github/actionlint.yml")
+	if err != nil {
+		return nil, err
+	}
+	if err := readGitFiles(ctx, gitDir, u.NewRev, append(cfgs, paths...), c); err != nil {
+		return nil, err
+	}
+	return c.finish()
+}
+
+// writePreReceiveSummary writes the concise summary of the result of linting workflows in a push.
+// At most top errors are listed. Warnings and notes do not reject the push so they are only counted.
+func writePreReceiveSummary(w io.Writer, errs []*Error, files, top int) {
+	if files == 0 {
+		fmt.Fprintln(w, "actionlint: no workflow file was changed by the push")
+		return
+	}
+
+	warnings := 0
+	rejected := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		if err.IsWarning() || err.IsNote() {
+			warnings++
+		} else {
+			rejected = append(rejected, err)
+		}
+	}
+	errs = rejected
+
+	if len(errs) == 0 {
+		note := ""
+		if warnings > 0 {
+			note = fmt.Sprintf(" (%d %s)", warnings, pluralWarnings(warnings))
+		}
+		fmt.Fprintf(w, "actionlint: passed. no error was found in %d workflow %s changed by the push%s\n", files, pluralFiles(files), note)
+		return
+	}
+
+	counts := map[string]int{}
+	for _, err := range errs {
+		counts[err.Kind]++
+	}
+	kinds := make([]string, 0, len(counts))
+	for k := range counts {
+		kinds = append(kinds, k)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if counts[kinds[i]] != counts[kinds[j]] {
+			return counts[kinds[i]] > counts[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+	ss := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		ss = append(ss, fmt.Sprintf("%s: %d", k, counts[k]))
+	}
+
+	fmt.Fprintf(w, "actionlint: push was rejected. %d %s found in %d workflow %s changed by the push (%s)\n", len(errs), pluralErrors(len(errs)), files, pluralFiles(files), strings.Join(ss, ", "))
+	for i, err := range errs {
+		if top > 0 && i >= top {
+			fmt.Fprintf(w, "  ... and %d more %s. run actionlint on the workflow files to see all errors\n", len(errs)-top, pluralErrors(len(errs)-top))
+			break
+		}
+		fmt.Fprintf(w, "  %s\n", err.Error())
+	}
+}
+
+func pluralWarnings(n int) string {
+	if n == 1 {
+		return "warning"
+	}
+	return "warnings"
+}
+
+func pluralFiles(n int) string {
+	if n == 1 {
+		return "file"
+	}
+	return "files"
+}
@@ -0,0 +1,204 @@
+package actionlint
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testZeroRev = "0000000000000000000000000000000000000000"
+
+func TestPreReceiveParseRefUpdates(t *testing.T) {
+	in := "1234abc 5678def refs/heads/main\n\n" + testZeroRev + " 90abcde refs/tags/v1.0.0\n"
+	us, err := ParseRefUpdates(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*RefUpdate{
+		{"1234abc", "5678def", "refs/heads/main"},
+		{testZeroRev, "90abcde", "refs/tags/v1.0.0"},
+	}
+	if !cmp.Equal(us, want) {
+		t.Fatal(cmp.Diff(us, want))
+	}
+	if s := us[0].ShortRef(); s != "main" {
+		t.Errorf("short ref of %q is unexpected: %q", us[0].Ref, s)
+	}
+	if s := us[1].ShortRef(); s != "v1.0.0" {
+		t.Errorf("short ref of %q is unexpected: %q", us[1].Ref, s)
+	}
+
+	_, err = ParseRefUpdates(strings.NewReader("1234abc refs/heads/main\n"))
+	if err == nil || !strings.Contains(err.Error(), "invalid ref update") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPreReceiveReadGitPushedWorkflows(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git command is necessary to run this test")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		args = append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+		out, err := exec.Command("git", args...).CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %s: %s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	write := func(p, c string) {
+		t.Helper()
+		f := filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(f), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(f, []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	paths := func(s *Sources) []string {
+		ps := []string{}
+		for _, f := range s.Files {
+			ps = append(ps, f.Path)
+		}
+		return ps
+	}
+
+	git("init", "-q")
+	write(".github/workflows/a.yaml", "on: push")
+	write(".github/workflows/b.yaml", "on: push")
+	git("add", "-A")
+	git("commit", "-q", "-m", "first")
+	rev1 := git("rev-parse", "HEAD")
+
+	write(".github/workflows/b.yaml", "on: pull_request")
+	write(".github/workflows/c.yaml", "on: push")
+	write(".github/actionlint.yaml", "self-hosted-runner:\n  labels: [my-runner]\n")
+	write("README.md", "# test")
+	git("add", "-A")
+	git("commit", "-q", "-m", "second")
+	git("rm", "-q", ".github/workflows/a.yaml")
+	git("commit", "-q", "-m", "third")
+	rev3 := git("rev-parse", "HEAD")
+
+	gitDir := filepath.Join(dir, ".git")
+	ctx := context.Background()
+
+	s, err := ReadGitPushedWorkflows(ctx, gitDir, &RefUpdate{rev1, rev3, "refs/heads/main"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := []string{".github/workflows/b.yaml", ".github/workflows/c.yaml"}, paths(s); !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+	if string(s.Files[0].Content) != "on: pull_request" {
+		t.Errorf("file at the new revision was not read: %q", s.Files[0].Content)
+	}
+	if s.Config == nil {
+		t.Error("config at the new revision was not read")
+	}
+
+	// Commits reachable from existing refs are not checked on creating a ref
+	git("update-ref", "refs/heads/base", rev1)
+	git("update-ref", "-d", "refs/heads/"+git("branch", "--show-current"))
+	s, err = ReadGitPushedWorkflows(ctx, gitDir, &RefUpdate{testZeroRev, rev3, "refs/heads/feature"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := []string{".github/workflows/b.yaml", ".github/workflows/c.yaml"}, paths(s); !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	s, err = ReadGitPushedWorkflows(ctx, gitDir, &RefUpdate{rev3, testZeroRev, "refs/heads/main"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Files) > 0 {
+		t.Fatalf("no file should be read on deleting a ref: %v", paths(s))
+	}
+
+	_, err = ReadGitPushedWorkflows(ctx, gitDir, &RefUpdate{rev1, "1234567890123456789012345678901234567890", "refs/heads/main"})
+	if err == nil || !strings.Contains(err.Error(), `could not list files changed by update of ref "refs/heads/main"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPreReceiveWriteSummary(t *testing.T) {
+	errs := []*Error{
+		{Message: "error 1", Filepath: "main:a.yaml", Line: 1, Column: 1, Kind: "expression"},
+		{Message: "error 2", Filepath: "main:a.yaml", Line: 2, Column: 1, Kind: "runner-label"},
+		{Message: "error 3", Filepath: "main:b.yaml", Line: 3, Column: 1, Kind: "expression"},
+	}
+
+	testCases := []struct {
+		what  string
+		errs  []*Error
+		files int
+		top   int
+		want  string
+	}{
+		{
+			what: "no file",
+			want: "actionlint: no workflow file was changed by the push\n",
+		},
+		{
+			what:  "no error",
+			errs:  []*Error{},
+			files: 1,
+			want:  "actionlint: passed. no error was found in 1 workflow file changed by the push\n",
+		},
+		{
+			what:  "only warnings",
+			errs:  []*Error{{Message: "warning 1", Filepath: "main:a.yaml", Line: 1, Column: 1, Kind: "expression", Severity: SeverityWarning}},
+			files: 1,
+			want:  "actionlint: passed. no error was found in 1 workflow file changed by the push (1 warning)\n",
+		},
+		{
+			what:  "errors and warnings",
+			errs:  append([]*Error{{Message: "warning 1", Filepath: "main:a.yaml", Line: 1, Column: 1, Kind: "expression", Severity: SeverityWarning}}, errs[2]),
+			files: 2,
+			want: `actionlint: push was rejected. 1 error found in 2 workflow files changed by the push (expression: 1)
+  main:b.yaml:3:1: error 3 [expression]
+`,
+		},
+		{
+			what:  "all errors",
+			errs:  errs,
+			files: 2,
+			want: `actionlint: push was rejected. 3 errors found in 2 workflow files changed by the push (expression: 2, runner-label: 1)
+  main:a.yaml:1:1: error 1 [expression]
+  main:a.yaml:2:1: error 2 [runner-label]
+  main:b.yaml:3:1: error 3 [expression]
+`,
+		},
+		{
+			what:  "top errors",
+			errs:  errs,
+			files: 2,
+			top:   1,
+			want: `actionlint: push was rejected. 3 errors found in 2 workflow files changed by the push (expression: 2, runner-label: 1)
+  main:a.yaml:1:1: error 1 [expression]
+  ... and 2 more errors. run actionlint on the workflow files to see all errors
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			var b bytes.Buffer
+			writePreReceiveSummary(&b, tc.errs, tc.files, tc.top)
+			if have := b.String(); have != tc.want {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
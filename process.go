@@ -20,8 +20,8 @@ type cmdExecution struct {
 	combineOutput bool
 }
 
-func (e *cmdExecution) run() ([]byte, error) {
-	cmd := exec.Command(e.cmd, e.args...)
+func (e *cmdExecution) run(ctx context.Context) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, e.cmd, e.args...)
 	cmd.Stderr = nil
 
 	p, err := cmd.StdinPipe()
@@ -42,6 +42,9 @@ func (e *cmdExecution) run() ([]byte, error) {
 	}
 
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("%s was canceled: %w", e.cmd, ctx.Err())
+		}
 		if exitErr, ok := err.(*exec.ExitError); ok {
 			code := exitErr.ExitCode()
 
@@ -76,24 +79,37 @@ type concurrentProcess struct {
 	ctx  context.Context
 	sema *semaphore.Weighted
 	wg   sync.WaitGroup
+	// Scripts of all workflows checked with this instance are batched by the shared batcher per
+	// shellcheck executable
+	shellchecks map[string]*shellcheckBatcher
+	mu          sync.Mutex
 }
 
 // newConcurrentProcess creates a new ConcurrentProcess instance. The `par` argument represents how
 // many processes can be run in parallel. It is recommended to use the value returned from
-// runtime.NumCPU() for the argument.
-func newConcurrentProcess(par int) *concurrentProcess {
+// runtime.NumCPU() for the argument. When the `ctx` argument is canceled, running processes are
+// killed and processes which have not started yet are not run.
+func newConcurrentProcess(ctx context.Context, par int) *concurrentProcess {
 	return &concurrentProcess{
-		ctx:  context.Background(),
+		ctx:  ctx,
 		sema: semaphore.NewWeighted(int64(par)),
 	}
 }
 
 func (proc *concurrentProcess) run(eg *errgroup.Group, exec *cmdExecution, callback func([]byte, error) error) {
-	proc.sema.Acquire(proc.ctx, 1)
+	if err := proc.sema.Acquire(proc.ctx, 1); err != nil {
+		err = fmt.Errorf("%s was canceled: %w", exec.cmd, err)
+		proc.wg.Add(1)
+		eg.Go(func() error {
+			defer proc.wg.Done()
+			return callback(nil, err)
+		})
+		return
+	}
 	proc.wg.Add(1)
 	eg.Go(func() error {
 		defer proc.wg.Done()
-		stdout, err := exec.run()
+		stdout, err := exec.run(proc.ctx)
 		proc.sema.Release(1)
 		return callback(stdout, err)
 	})
@@ -1,6 +1,8 @@
 package actionlint
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"strings"
@@ -36,7 +38,7 @@ func testSkipIfNoCommand(t *testing.T, p *concurrentProcess, cmd string) *extern
 }
 
 func TestProcessRunProcessSerial(t *testing.T) {
-	p := newConcurrentProcess(1)
+	p := newConcurrentProcess(context.Background(), 1)
 	ret := []string{}
 	mu := sync.Mutex{}
 	starts := []time.Time{}
@@ -98,7 +100,7 @@ func TestProcessRunConcurrently(t *testing.T) {
 		t.Skip("this test is flaky on Windows")
 	}
 
-	p := newConcurrentProcess(5)
+	p := newConcurrentProcess(context.Background(), 5)
 	sleep := testSkipIfNoCommand(t, p, "sleep")
 
 	start := time.Now()
@@ -123,7 +125,7 @@ func TestProcessRunConcurrently(t *testing.T) {
 }
 
 func TestProcessRunMultipleCommandsConcurrently(t *testing.T) {
-	p := newConcurrentProcess(3)
+	p := newConcurrentProcess(context.Background(), 3)
 
 	done := make([]bool, 5)
 	cmds := make([]*externalCommand, 0, 5)
@@ -155,7 +157,7 @@ func TestProcessRunMultipleCommandsConcurrently(t *testing.T) {
 }
 
 func TestProcessWaitMultipleCommandsFinish(t *testing.T) {
-	p := newConcurrentProcess(2)
+	p := newConcurrentProcess(context.Background(), 2)
 
 	done := make([]bool, 3)
 	for i := 0; i < 3; i++ {
@@ -181,7 +183,7 @@ func TestProcessWaitMultipleCommandsFinish(t *testing.T) {
 }
 
 func TestProcessInputStdin(t *testing.T) {
-	p := newConcurrentProcess(1)
+	p := newConcurrentProcess(context.Background(), 1)
 	cat := testSkipIfNoCommand(t, p, "cat")
 	out := ""
 
@@ -205,7 +207,7 @@ func TestProcessInputStdin(t *testing.T) {
 }
 
 func TestProcessErrorCommandNotFound(t *testing.T) {
-	p := newConcurrentProcess(1)
+	p := newConcurrentProcess(context.Background(), 1)
 	c := &externalCommand{
 		proc: p,
 		exe:  "this-command-does-not-exist",
@@ -235,7 +237,7 @@ func TestProcessErrorCommandNotFound(t *testing.T) {
 }
 
 func TestProcessErrorInCallback(t *testing.T) {
-	p := newConcurrentProcess(1)
+	p := newConcurrentProcess(context.Background(), 1)
 	echo := testSkipIfNoCommand(t, p, "echo")
 
 	echo.run([]string{}, "", func(b []byte, err error) error {
@@ -262,7 +264,7 @@ func TestProcessErrorInCallback(t *testing.T) {
 }
 
 func TestProcessErrorLinterFailed(t *testing.T) {
-	p := newConcurrentProcess(1)
+	p := newConcurrentProcess(context.Background(), 1)
 	ls := testSkipIfNoCommand(t, p, "ls")
 
 	// Running ls with directory which does not exist emulates external liter's failure.
@@ -296,7 +298,7 @@ func TestProcessErrorLinterFailed(t *testing.T) {
 }
 
 func TestProcessRunConcurrentlyAndWait(t *testing.T) {
-	p := newConcurrentProcess(2)
+	p := newConcurrentProcess(context.Background(), 2)
 	echo := testSkipIfNoCommand(t, p, "echo")
 
 	c := make(chan struct{})
@@ -319,7 +321,7 @@ func TestProcessRunConcurrentlyAndWait(t *testing.T) {
 }
 
 func TestProcessCombineStdoutAndStderr(t *testing.T) {
-	p := newConcurrentProcess(1)
+	p := newConcurrentProcess(context.Background(), 1)
 	bash := testSkipIfNoCommand(t, p, "bash")
 	bash.combineOutput = true
 	script := "echo 'hello stdout'; echo 'hello stderr' >&2"
@@ -349,7 +351,7 @@ func TestProcessCombineStdoutAndStderr(t *testing.T) {
 }
 
 func TestProcessCommandExitStatusNonZero(t *testing.T) {
-	p := newConcurrentProcess(1)
+	p := newConcurrentProcess(context.Background(), 1)
 	bash := testSkipIfNoCommand(t, p, "false")
 	done := make(chan error)
 
@@ -371,3 +373,34 @@ func TestProcessCommandExitStatusNonZero(t *testing.T) {
 		t.Fatalf("Unexpected error happened: %q", msg)
 	}
 }
+
+func TestProcessCancelRunningCommand(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := newConcurrentProcess(ctx, 1)
+	sleep := testSkipIfNoCommand(t, p, "sleep")
+	done := make(chan error)
+
+	start := time.Now()
+	time.AfterFunc(100*time.Millisecond, cancel)
+	for i := 0; i < 2; i++ {
+		// The second call blocks until the first command is canceled since there is only one slot
+		go sleep.run([]string{"10"}, "", func(b []byte, err error) error {
+			done <- err
+			return nil
+		})
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := <-done; !errors.Is(err, context.Canceled) {
+			t.Fatalf("canceled error was expected but got %v", err)
+		}
+	}
+	if err := sleep.wait(); err != nil {
+		t.Fatal(err)
+	}
+	p.wait()
+
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatalf("commands were not killed on cancellation. it took %v", d)
+	}
+}
@@ -1,8 +1,11 @@
 package actionlint
 
 import (
+	"bufio"
+	"bytes"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -74,6 +77,44 @@ func (p *Project) Config() *Config {
 	return p.config
 }
 
+var reGitHubRemoteURL = regexp.MustCompile(`^(?:https?://|ssh://)?(?:[^@/]+@)?[^/:]+[:/]([^/]+/[^/]+?)(?:\.git)?/?$`)
+
+// parseGitHubRemoteRepository finds URL of "origin" remote in .git/config file content and returns
+// the repository slug "owner/name" in the URL. An empty string is returned when it is not found.
+func parseGitHubRemoteRepository(config []byte) string {
+	s := bufio.NewScanner(bytes.NewReader(config))
+	origin := false
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(l, "[") {
+			origin = l == `[remote "origin"]`
+			continue
+		}
+		if !origin || !strings.HasPrefix(l, "url") {
+			continue
+		}
+		k, v, ok := strings.Cut(l, "=")
+		if !ok || strings.TrimSpace(k) != "url" {
+			continue
+		}
+		if m := reGitHubRemoteURL.FindStringSubmatch(strings.TrimSpace(v)); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// GitHubRepository returns the repository slug like "owner/name" of the project. It is detected
+// from URL of "origin" remote in .git/config. When the remote is not found, this method returns an
+// empty string. Note that this method reads the config file every time it is called.
+func (p *Project) GitHubRepository() string {
+	b, err := os.ReadFile(filepath.Join(p.root, ".git", "config"))
+	if err != nil {
+		return ""
+	}
+	return parseGitHubRemoteRepository(b)
+}
+
 // Projects represents set of projects. It caches Project instances which was created previously
 // and reuses them.
 type Projects struct {
@@ -147,3 +147,61 @@ func TestProjectsLoadingBrokenProjectConfig(t *testing.T) {
 		t.Fatalf("wanted error %q but have error %q", want, msg)
 	}
 }
+
+func TestProjectParseGitHubRemoteRepository(t *testing.T) {
+	testCases := []struct {
+		what   string
+		config string
+		want   string
+	}{
+		{
+			what:   "HTTPS URL",
+			config: "[remote \"origin\"]\n\turl = https://github.com/rhysd/actionlint.git\n\tfetch = +refs/heads/*:refs/remotes/origin/*\n",
+			want:   "rhysd/actionlint",
+		},
+		{
+			what:   "HTTPS URL without .git suffix",
+			config: "[remote \"origin\"]\n\turl = https://github.com/rhysd/actionlint\n",
+			want:   "rhysd/actionlint",
+		},
+		{
+			what:   "SCP-like SSH URL",
+			config: "[remote \"origin\"]\n\turl = git@github.com:rhysd/actionlint.git\n",
+			want:   "rhysd/actionlint",
+		},
+		{
+			what:   "SSH URL",
+			config: "[remote \"origin\"]\n\turl = ssh://git@github.com/rhysd/actionlint.git\n",
+			want:   "rhysd/actionlint",
+		},
+		{
+			what:   "multiple remotes",
+			config: "[core]\n\tbare = false\n[remote \"upstream\"]\n\turl = https://github.com/foo/bar.git\n[remote \"origin\"]\n\turl = https://github.com/rhysd/actionlint.git\n[branch \"main\"]\n\tremote = origin\n",
+			want:   "rhysd/actionlint",
+		},
+		{
+			what:   "no origin remote",
+			config: "[remote \"upstream\"]\n\turl = https://github.com/rhysd/actionlint.git\n",
+			want:   "",
+		},
+		{
+			what:   "no remote",
+			config: "[core]\n\tbare = false\n",
+			want:   "",
+		},
+		{
+			what:   "local path",
+			config: "[remote \"origin\"]\n\turl = /path/to/repo\n",
+			want:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			have := parseGitHubRemoteRepository([]byte(tc.config))
+			if have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
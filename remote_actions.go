@@ -0,0 +1,208 @@
+package actionlint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// remoteActionsCacheTTL is the duration while metadata of actions cached on disk is used. Tags and
+// branches may be moved to other commits so the metadata is fetched again after the duration.
+// Metadata of actions at full commit SHAs never expires.
+const remoteActionsCacheTTL = 24 * time.Hour
+
+var reFullCommitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// RemoteActionsCache is cache for metadata of actions hosted on GitHub. Metadata files (action.yml)
+// are fetched via GitHub API and cached in memory and on disk. It is used by online checks to
+// validate inputs and outputs of actions which are not in the popular actions data set. Calling
+// methods of this type is thread-safe.
+type RemoteActionsCache struct {
+	client *GitHubAPIClient
+	dir    string
+	dbg    io.Writer
+	state  *remoteActionsCacheState
+}
+
+// remoteActionsCacheState is a state shared by the caches derived with WithContext method.
+type remoteActionsCacheState struct {
+	mu    sync.Mutex
+	cache map[string]*remoteActionsCacheEntry
+}
+
+type remoteActionsCacheEntry struct {
+	meta *ActionMetadata
+	err  error
+}
+
+// remoteActionsCacheFile is the content of the file to cache metadata of the action on disk.
+type remoteActionsCacheFile struct {
+	Spec      string          `json:"spec"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Metadata  *ActionMetadata `json:"metadata"`
+}
+
+// NewRemoteActionsCache creates a new RemoteActionsCache instance. The client parameter is used to
+// fetch metadata files of actions. The dir parameter is a directory to cache the metadata on disk.
+// When it is empty, the metadata is only cached in memory. The dbg parameter is a writer to output
+// debug logs. When it is nil, no debug log is output.
+func NewRemoteActionsCache(client *GitHubAPIClient, dir string, dbg io.Writer) *RemoteActionsCache {
+	return &RemoteActionsCache{
+		client: client,
+		dir:    dir,
+		dbg:    dbg,
+		state:  &remoteActionsCacheState{cache: map[string]*remoteActionsCacheEntry{}},
+	}
+}
+
+// DefaultRemoteActionsCacheDir returns the default directory to cache metadata of actions on disk.
+// It is "actionlint/actions" in the user cache directory. Empty string is returned when the user
+// cache directory is not available.
+func DefaultRemoteActionsCacheDir() string {
+	d, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(d, "actionlint", "actions")
+}
+
+// WithContext returns a shallow copy of the cache whose requests are sent with the ctx parameter.
+// The returned cache shares the cached metadata with the original cache.
+func (c *RemoteActionsCache) WithContext(ctx context.Context) *RemoteActionsCache {
+	copied := *c
+	copied.client = c.client.WithContext(ctx)
+	return &copied
+}
+
+func (c *RemoteActionsCache) debug(format string, args ...interface{}) {
+	if c.dbg == nil {
+		return
+	}
+	format = "[RemoteActionsCache] " + format + "\n"
+	fmt.Fprintf(c.dbg, format, args...)
+}
+
+// FindMetadata finds metadata of the action hosted on GitHub. The spec parameter is
+// "{owner}/{repo}@{ref}" or "{owner}/{repo}/{path}@{ref}". The first return value is nil when the
+// action is not a target of this cache such as local actions, Docker actions, and outdated popular
+// actions.
+// An error is returned when the metadata could not be fetched or parsed. The result is cached.
+func (c *RemoteActionsCache) FindMetadata(spec string) (*ActionMetadata, error) {
+	if strings.HasPrefix(spec, "./") || strings.HasPrefix(spec, "docker://") || ContainsExpression(spec) {
+		return nil, nil
+	}
+	repo, dir, ref, ok := parseRemoteActionSpec(spec)
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := OutdatedPopularActionSpecs[spec]; ok {
+		return nil, nil
+	}
+
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+
+	if e, ok := c.state.cache[spec]; ok {
+		c.debug("Cache hit for %s", spec)
+		return e.meta, e.err
+	}
+
+	if m, ok := c.readDisk(spec, ref); ok {
+		c.state.cache[spec] = &remoteActionsCacheEntry{m, nil}
+		return m, nil
+	}
+
+	m, err := c.fetch(repo, dir, ref)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) && !errors.Is(err, ErrGitHubAPIBudgetExceeded) {
+			c.state.cache[spec] = &remoteActionsCacheEntry{nil, err}
+		}
+		return nil, err
+	}
+	c.state.cache[spec] = &remoteActionsCacheEntry{m, nil}
+	c.writeDisk(spec, m)
+	return m, nil
+}
+
+func (c *RemoteActionsCache) fetch(repo, dir, ref string) (*ActionMetadata, error) {
+	var b []byte
+	var err error
+	for _, f := range []string{"action.yml", "action.yaml"} {
+		b, err = c.client.FileContent(repo, path.Join(dir, f), ref)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var m ActionMetadata
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		msg := strings.ReplaceAll(err.Error(), "\n", " ")
+		return nil, fmt.Errorf("could not parse action metadata of %s/%s at %s: %s", repo, dir, ref, msg)
+	}
+	c.debug("Fetched metadata of action %q in %s at %s", m.Name, repo, ref)
+	return &m, nil
+}
+
+func (c *RemoteActionsCache) diskPath(spec string) string {
+	h := sha256.Sum256([]byte(spec))
+	return filepath.Join(c.dir, hex.EncodeToString(h[:])+".json")
+}
+
+func (c *RemoteActionsCache) readDisk(spec, ref string) (*ActionMetadata, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+	p := c.diskPath(spec)
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, false
+	}
+	var f remoteActionsCacheFile
+	if err := json.Unmarshal(b, &f); err != nil || f.Spec != spec || f.Metadata == nil {
+		c.debug("Ignored broken cache file %s for %s", p, spec)
+		return nil, false
+	}
+	if !reFullCommitSHA.MatchString(ref) && time.Since(f.FetchedAt) > remoteActionsCacheTTL {
+		c.debug("Cache file %s for %s was expired", p, spec)
+		return nil, false
+	}
+	c.debug("Read metadata of %s from cache file %s", spec, p)
+	return f.Metadata, true
+}
+
+func (c *RemoteActionsCache) writeDisk(spec string, m *ActionMetadata) {
+	if c.dir == "" {
+		return
+	}
+	b, err := json.Marshal(&remoteActionsCacheFile{spec, time.Now(), m})
+	if err != nil {
+		c.debug("Could not encode metadata of %s: %s", spec, err)
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		c.debug("Could not create cache directory %s: %s", c.dir, err)
+		return
+	}
+	p := c.diskPath(spec)
+	if err := os.WriteFile(p, b, 0644); err != nil {
+		c.debug("Could not write cache file %s: %s", p, err)
+		return
+	}
+	c.debug("Wrote metadata of %s to cache file %s", spec, p)
+}
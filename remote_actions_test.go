@@ -0,0 +1,223 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testRemoteActionMetadata = `name: My action
+inputs:
+  foo:
+    description: foo
+    required: true
+  bar:
+    description: bar
+outputs:
+  result:
+    description: result
+runs:
+  using: node20
+  main: index.js
+`
+
+func TestRemoteActionsCacheFindMetadata(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/repo/contents/action.yml?ref=v1":          testRemoteActionMetadata,
+		"/repos/owner/repo/contents/path/to/action.yaml?ref=v1": testRemoteActionMetadata,
+		"/repos/owner/broken/contents/action.yml?ref=v1":        "inputs: [",
+	}, &requests)
+	defer s.Close()
+	c := NewRemoteActionsCache(NewGitHubAPIClient(s.URL, "", nil), "", nil)
+
+	for _, spec := range []string{"owner/repo@v1", "owner/repo/path/to@v1"} {
+		m, err := c.FindMetadata(spec)
+		if err != nil {
+			t.Fatal(spec, err)
+		}
+		if m == nil {
+			t.Fatal("metadata was not found for", spec)
+		}
+		if m.Name != "My action" {
+			t.Errorf("unexpected name for %s: %q", spec, m.Name)
+		}
+		if i, ok := m.Inputs["foo"]; !ok || !i.Required {
+			t.Errorf("required input \"foo\" was not found for %s: %#v", spec, m.Inputs)
+		}
+		if _, ok := m.Outputs["result"]; !ok {
+			t.Errorf("output \"result\" was not found for %s: %#v", spec, m.Outputs)
+		}
+	}
+
+	n := requests
+	if _, err := c.FindMetadata("owner/repo@v1"); err != nil {
+		t.Fatal(err)
+	}
+	if requests != n {
+		t.Fatalf("metadata was not cached. %d requests were sent", requests-n)
+	}
+
+	_, err := c.FindMetadata("owner/broken@v1")
+	if err == nil || !strings.Contains(err.Error(), "could not parse action metadata") {
+		t.Fatalf("unexpected error for broken metadata: %v", err)
+	}
+	if _, err := c.FindMetadata("owner/missing@v1"); err == nil {
+		t.Fatal("error did not occur for missing metadata")
+	}
+
+	n = requests
+	for _, spec := range []string{
+		"./path/to/action",
+		"docker://alpine:3.19",
+		"${{ matrix.action }}",
+		"owner/repo",
+		"actions/checkout@v2",
+	} {
+		m, err := c.FindMetadata(spec)
+		if err != nil || m != nil {
+			t.Errorf("%q should be ignored but got %v, %v", spec, m, err)
+		}
+	}
+	if requests != n {
+		t.Fatalf("%d requests were sent for actions which should be ignored", requests-n)
+	}
+}
+
+func TestRemoteActionsCacheOnDisk(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/repo/contents/action.yml?ref=v1":                                       testRemoteActionMetadata,
+		"/repos/owner/repo/contents/action.yml?ref=0123456789abcdef0123456789abcdef01234567": testRemoteActionMetadata,
+	}, &requests)
+	defer s.Close()
+	dir := t.TempDir()
+	sha := "owner/repo@0123456789abcdef0123456789abcdef01234567"
+
+	c := NewRemoteActionsCache(NewGitHubAPIClient(s.URL, "", nil), dir, nil)
+	for _, spec := range []string{"owner/repo@v1", sha} {
+		if _, err := c.FindMetadata(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("wanted 2 requests but got %d", requests)
+	}
+
+	// Another run reads the metadata from the disk
+	c = NewRemoteActionsCache(NewGitHubAPIClient(s.URL, "", nil), dir, nil)
+	for _, spec := range []string{"owner/repo@v1", sha} {
+		m, err := c.FindMetadata(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i, ok := m.Inputs["foo"]; !ok || !i.Required || i.Name != "foo" {
+			t.Fatalf("input was not restored from disk for %s: %#v", spec, m.Inputs)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("metadata was not read from disk. %d requests were sent", requests)
+	}
+
+	// Make the cache files outdated
+	for _, spec := range []string{"owner/repo@v1", sha} {
+		p := c.diskPath(spec)
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var f remoteActionsCacheFile
+		if err := json.Unmarshal(b, &f); err != nil {
+			t.Fatal(err)
+		}
+		f.FetchedAt = f.FetchedAt.Add(-2 * remoteActionsCacheTTL)
+		b, err = json.Marshal(&f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Metadata at the tag is fetched again but metadata at the commit SHA never expires
+	c = NewRemoteActionsCache(NewGitHubAPIClient(s.URL, "", nil), dir, nil)
+	for _, spec := range []string{"owner/repo@v1", sha} {
+		if _, err := c.FindMetadata(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 3 {
+		t.Fatalf("wanted 3 requests but got %d", requests)
+	}
+
+	b, err := os.ReadFile(c.diskPath("owner/repo@v1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f remoteActionsCacheFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(f.FetchedAt) > time.Minute {
+		t.Fatalf("cache file was not updated: %v", f.FetchedAt)
+	}
+}
+
+func TestRemoteActionsCheckInputsAndOutputs(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/repo/contents/action.yml?ref=v1": testRemoteActionMetadata,
+	}, &requests)
+	defer s.Close()
+	c := NewRemoteActionsCache(NewGitHubAPIClient(s.URL, "", nil), "", nil)
+
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: owner/repo@v1
+        id: a
+        with:
+          baz: x
+      - run: echo '${{ steps.a.outputs.result }} ${{ steps.a.outputs.unknown }}'
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	rules := []Rule{
+		NewRuleActionWithRemote(nil, c),
+		NewRuleExpressionWithRemote(nil, c, nil, nil),
+	}
+	v := NewVisitor()
+	for _, r := range rules {
+		v.AddPass(r)
+	}
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs := []string{}
+	for _, r := range rules {
+		for _, err := range r.Errs() {
+			msgs = append(msgs, err.Message)
+		}
+	}
+	want := []string{
+		`input "baz" is not defined in action "owner/repo@v1". available inputs are "bar", "foo"`,
+		`missing input "foo" which is required by action "owner/repo@v1". all required inputs are "foo"`,
+		`property "unknown" is not defined in object type {result: string}`,
+	}
+	if len(msgs) != len(want) {
+		t.Fatalf("wanted %d errors but got %d: %q", len(want), len(msgs), msgs)
+	}
+	for i, w := range want {
+		if msgs[i] != w {
+			t.Errorf("error #%d: wanted %q but got %q", i, w, msgs[i])
+		}
+	}
+}
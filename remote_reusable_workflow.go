@@ -0,0 +1,169 @@
+package actionlint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RemoteReusableWorkflowCache is cache for metadata of reusable workflows hosted on GitHub. Workflow
+// files are fetched via GitHub API and cached in memory and on disk. It is used by online checks to
+// validate inputs, secrets, and outputs of reusable workflows called like
+// "owner/repo/.github/workflows/x.yml@ref". Calling methods of this type is thread-safe.
+type RemoteReusableWorkflowCache struct {
+	client *GitHubAPIClient
+	dir    string
+	dbg    io.Writer
+	state  *remoteReusableWorkflowCacheState
+}
+
+// remoteReusableWorkflowCacheState is a state shared by the caches derived with WithContext method.
+type remoteReusableWorkflowCacheState struct {
+	mu    sync.Mutex
+	cache map[string]*remoteReusableWorkflowCacheEntry
+}
+
+type remoteReusableWorkflowCacheEntry struct {
+	meta *ReusableWorkflowMetadata
+	err  error
+}
+
+// remoteReusableWorkflowCacheFile is the content of the file to cache the reusable workflow on disk.
+// The source of the workflow is cached instead of the metadata since types of inputs cannot be
+// encoded into JSON.
+type remoteReusableWorkflowCacheFile struct {
+	Spec      string    `json:"spec"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Source    string    `json:"source"`
+}
+
+// NewRemoteReusableWorkflowCache creates a new RemoteReusableWorkflowCache instance. The client
+// parameter is used to fetch workflow files. The dir parameter is a directory to cache the workflow
+// files on disk. When it is empty, the metadata is only cached in memory. The dbg parameter is a
+// writer to output debug logs. When it is nil, no debug log is output.
+func NewRemoteReusableWorkflowCache(client *GitHubAPIClient, dir string, dbg io.Writer) *RemoteReusableWorkflowCache {
+	return &RemoteReusableWorkflowCache{
+		client: client,
+		dir:    dir,
+		dbg:    dbg,
+		state:  &remoteReusableWorkflowCacheState{cache: map[string]*remoteReusableWorkflowCacheEntry{}},
+	}
+}
+
+// WithContext returns a shallow copy of the cache whose requests are sent with the ctx parameter.
+// The returned cache shares the cached metadata with the original cache.
+func (c *RemoteReusableWorkflowCache) WithContext(ctx context.Context) *RemoteReusableWorkflowCache {
+	copied := *c
+	copied.client = c.client.WithContext(ctx)
+	return &copied
+}
+
+func (c *RemoteReusableWorkflowCache) debug(format string, args ...interface{}) {
+	if c.dbg == nil {
+		return
+	}
+	format = "[RemoteReusableWorkflowCache] " + format + "\n"
+	fmt.Fprintf(c.dbg, format, args...)
+}
+
+// FindMetadata finds metadata of the reusable workflow hosted on GitHub. The spec parameter is
+// "{owner}/{repo}/{path}@{ref}". The first return value is nil when the spec is not a call of
+// reusable workflow in other repository. An error is returned when the workflow file could not be
+// fetched or parsed. The result is cached.
+func (c *RemoteReusableWorkflowCache) FindMetadata(spec string) (*ReusableWorkflowMetadata, error) {
+	if !isWorkflowCallUsesRepoFormat(spec) {
+		return nil, nil
+	}
+	repo, file, ref, ok := parseRemoteActionSpec(spec)
+	if !ok || file == "" {
+		return nil, nil
+	}
+
+	c.state.mu.Lock()
+	defer c.state.mu.Unlock()
+
+	if e, ok := c.state.cache[spec]; ok {
+		c.debug("Cache hit for %s", spec)
+		return e.meta, e.err
+	}
+
+	src, ok := c.readDisk(spec, ref)
+	if !ok {
+		b, err := c.client.FileContent(repo, file, ref)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) && !errors.Is(err, ErrGitHubAPIBudgetExceeded) {
+				c.state.cache[spec] = &remoteReusableWorkflowCacheEntry{nil, err}
+			}
+			return nil, err
+		}
+		c.debug("Fetched reusable workflow %s in %s at %s", file, repo, ref)
+		src = b
+		c.writeDisk(spec, src)
+	}
+
+	m, err := parseReusableWorkflowMetadata(src)
+	if err != nil {
+		msg := strings.ReplaceAll(err.Error(), "\n", " ")
+		err = fmt.Errorf("error while parsing reusable workflow %q: %s", spec, msg)
+	}
+	c.state.cache[spec] = &remoteReusableWorkflowCacheEntry{m, err}
+	return m, err
+}
+
+func (c *RemoteReusableWorkflowCache) diskPath(spec string) string {
+	// Prefix is added so that the file name never conflicts with the cache files of actions
+	h := sha256.Sum256([]byte("workflow:" + spec))
+	return filepath.Join(c.dir, hex.EncodeToString(h[:])+".json")
+}
+
+func (c *RemoteReusableWorkflowCache) readDisk(spec, ref string) ([]byte, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+	p := c.diskPath(spec)
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, false
+	}
+	var f remoteReusableWorkflowCacheFile
+	if err := json.Unmarshal(b, &f); err != nil || f.Spec != spec {
+		c.debug("Ignored broken cache file %s for %s", p, spec)
+		return nil, false
+	}
+	if !reFullCommitSHA.MatchString(ref) && time.Since(f.FetchedAt) > remoteActionsCacheTTL {
+		c.debug("Cache file %s for %s was expired", p, spec)
+		return nil, false
+	}
+	c.debug("Read reusable workflow %s from cache file %s", spec, p)
+	return []byte(f.Source), true
+}
+
+func (c *RemoteReusableWorkflowCache) writeDisk(spec string, src []byte) {
+	if c.dir == "" {
+		return
+	}
+	b, err := json.Marshal(&remoteReusableWorkflowCacheFile{spec, time.Now(), string(src)})
+	if err != nil {
+		c.debug("Could not encode reusable workflow %s: %s", spec, err)
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		c.debug("Could not create cache directory %s: %s", c.dir, err)
+		return
+	}
+	p := c.diskPath(spec)
+	if err := os.WriteFile(p, b, 0644); err != nil {
+		c.debug("Could not write cache file %s: %s", p, err)
+		return
+	}
+	c.debug("Wrote reusable workflow %s to cache file %s", spec, p)
+}
@@ -0,0 +1,162 @@
+package actionlint
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+const testRemoteReusableWorkflow = `on:
+  workflow_call:
+    inputs:
+      name:
+        type: string
+        required: true
+      count:
+        type: number
+    secrets:
+      token:
+        required: true
+    outputs:
+      result:
+        value: ${{ jobs.build.outputs.result }}
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    outputs:
+      result: ${{ steps.build.outputs.result }}
+    steps:
+      - id: build
+        run: echo "result=${{ inputs.name }}" >> "$GITHUB_OUTPUT"
+`
+
+func TestRemoteReusableWorkflowCacheFindMetadata(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/repo/contents/.github/workflows/build.yml?ref=v1": testRemoteReusableWorkflow,
+		"/repos/owner/repo/contents/.github/workflows/push.yml?ref=v1":  "on: push\njobs: {}\n",
+	}, &requests)
+	defer s.Close()
+	dir := t.TempDir()
+	spec := "owner/repo/.github/workflows/build.yml@v1"
+
+	c := NewRemoteReusableWorkflowCache(NewGitHubAPIClient(s.URL, "", nil), dir, nil)
+	m, err := c.FindMetadata(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i, ok := m.Inputs["name"]; !ok || !i.Required || i.Name != "name" {
+		t.Fatalf("input \"name\" was not found: %#v", m.Inputs)
+	}
+	if _, ok := m.Inputs["count"].Type.(NumberType); !ok {
+		t.Fatalf("input \"count\" is not typed as number: %#v", m.Inputs["count"])
+	}
+	if s, ok := m.Secrets["token"]; !ok || !s.Required {
+		t.Fatalf("secret \"token\" was not found: %#v", m.Secrets)
+	}
+	if _, ok := m.Outputs["result"]; !ok {
+		t.Fatalf("output \"result\" was not found: %#v", m.Outputs)
+	}
+
+	// Another run reads the workflow from the disk
+	c = NewRemoteReusableWorkflowCache(NewGitHubAPIClient(s.URL, "", nil), dir, nil)
+	m, err = c.FindMetadata(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.Inputs["count"].Type.(NumberType); !ok {
+		t.Fatalf("type of input was not restored from disk: %#v", m.Inputs["count"])
+	}
+	if requests != 1 {
+		t.Fatalf("workflow was not read from disk. %d requests were sent", requests)
+	}
+
+	_, err = c.FindMetadata("owner/repo/.github/workflows/push.yml@v1")
+	if err == nil || !strings.Contains(err.Error(), "\"workflow_call\" event trigger is not found") {
+		t.Fatalf("unexpected error for workflow which is not reusable: %v", err)
+	}
+	if _, err := c.FindMetadata("owner/repo/.github/workflows/missing.yml@v1"); err == nil {
+		t.Fatal("error did not occur for missing workflow")
+	}
+
+	n := requests
+	for _, spec := range []string{
+		"./.github/workflows/build.yml",
+		"owner/repo@v1",
+		"owner/repo/.github/workflows/build.yml",
+	} {
+		m, err := c.FindMetadata(spec)
+		if err != nil || m != nil {
+			t.Errorf("%q should be ignored but got %v, %v", spec, m, err)
+		}
+	}
+	if requests != n {
+		t.Fatalf("%d requests were sent for workflow calls which should be ignored", requests-n)
+	}
+}
+
+func TestRemoteReusableWorkflowCheckInputsSecretsAndOutputs(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/owner/repo/contents/.github/workflows/build.yml?ref=v1": testRemoteReusableWorkflow,
+	}, &requests)
+	defer s.Close()
+	c := NewRemoteReusableWorkflowCache(NewGitHubAPIClient(s.URL, "", nil), "", nil)
+
+	src := `on: push
+jobs:
+  build:
+    uses: owner/repo/.github/workflows/build.yml@v1
+    with:
+      count: foo
+      unknown: bar
+  show:
+    needs: [build]
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo '${{ needs.build.outputs.result }} ${{ needs.build.outputs.unknown }}'
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	wc := NewLocalReusableWorkflowCache(nil, "", nil)
+	rules := []Rule{
+		NewRuleWorkflowCall("test.yaml", wc, c),
+		NewRuleExpressionWithRemote(nil, nil, wc, c),
+	}
+	v := NewVisitor()
+	for _, r := range rules {
+		v.AddPass(r)
+	}
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs := []string{}
+	for _, r := range rules {
+		errs := r.Errs()
+		sort.Stable(ByErrorPosition(errs))
+		for _, err := range errs {
+			msgs = append(msgs, err.Message)
+		}
+	}
+	want := []string{
+		`input "name" is required by "owner/repo/.github/workflows/build.yml@v1" reusable workflow`,
+		`secret "token" is required by "owner/repo/.github/workflows/build.yml@v1" reusable workflow`,
+		`input "unknown" is not defined in "owner/repo/.github/workflows/build.yml@v1" reusable workflow. defined inputs are "count", "name"`,
+		`input "count" is typed as number by reusable workflow "owner/repo/.github/workflows/build.yml@v1". string value cannot be assigned`,
+		`property "unknown" is not defined in object type {result: string}`,
+	}
+	if len(msgs) != len(want) {
+		t.Fatalf("wanted %d errors but got %d: %q", len(want), len(msgs), msgs)
+	}
+	for i, w := range want {
+		if msgs[i] != w {
+			t.Errorf("error #%d: wanted %q but got %q", i, w, msgs[i])
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("workflow should be fetched once but %d requests were sent", requests)
+	}
+}
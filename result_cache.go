@@ -0,0 +1,237 @@
+package actionlint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"strings"
+)
+
+// resultCacheVersion is the version of the format of result cache files. It is increased when the
+// format is changed incompatibly.
+const resultCacheVersion = 1
+
+// Local actions and reusable workflows like `uses: ./path/to/action`
+var reResultCacheLocalUses = regexp.MustCompile(`(?m)^[\s-]*uses:\s*['"]?(\./[^\s'"#]+)`)
+
+// Inputs like `cache-dependency-path:` of setup-* actions and `path:` of actions/checkout. Errors of
+// "setup-cache" and "checkout-path" rules depend on the files in the repository when they are used
+var reResultCacheFileSystemDeps = regexp.MustCompile(`(?m)^[\s-]*(?:cache-dependency-path|path)\s*:`)
+
+// resultCacheFile is the content of the file to cache errors of a workflow file on disk.
+type resultCacheFile struct {
+	Version int      `json:"version"`
+	Path    string   `json:"path"`
+	Key     string   `json:"key"`
+	Errors  []*Error `json:"errors"`
+}
+
+// resultCache is cache for errors of workflow files on disk. One cache file is created per workflow
+// file. The errors are reused while the key of the file is not changed. The key is a hash of the
+// content of the file, the version of actionlint, the config, the options of the linter, the current
+// date, and the local actions and reusable workflows referenced by the file directly or indirectly.
+// Calling methods of this type is thread-safe.
+type resultCache struct {
+	dir  string
+	seed []byte
+	dbg  io.Writer
+}
+
+// newResultCache creates a new resultCache instance. The dir parameter is a directory to put cache
+// files. The parts parameter is values which affect errors of all workflow files such as options of
+// the linter.
+func newResultCache(dir string, parts []string, dbg io.Writer) *resultCache {
+	h := sha256.New()
+	fmt.Fprintf(h, "version=%d\nactionlint=%s\n", resultCacheVersion, resultCacheBuildVersion())
+	for _, p := range parts {
+		fmt.Fprintf(h, "%s\n", p)
+	}
+	return &resultCache{dir, h.Sum(nil), dbg}
+}
+
+func (c *resultCache) debug(format string, args ...interface{}) {
+	if c.dbg == nil {
+		return
+	}
+	format = "[ResultCache] " + format + "\n"
+	fmt.Fprintf(c.dbg, format, args...)
+}
+
+// resultCacheBuildVersion returns the string to identify the build of actionlint. The version is not
+// enough since development builds share the same version "(devel)", so the size and the modified
+// time of the executable are also included.
+func resultCacheBuildVersion() string {
+	v := "unknown"
+	if info, ok := debug.ReadBuildInfo(); ok {
+		v = info.Main.Version
+		if info.Main.Path != "github.com/rhysd/actionlint" {
+			for _, d := range info.Deps {
+				if d.Path == "github.com/rhysd/actionlint" {
+					v = d.Version
+					break
+				}
+			}
+		}
+	}
+	if p, err := os.Executable(); err == nil {
+		if s, err := os.Stat(p); err == nil {
+			v = fmt.Sprintf("%s (%d bytes at %d)", v, s.Size(), s.ModTime().UnixNano())
+		}
+	}
+	return v
+}
+
+// resultCacheExecutable returns the string to identify the external command such as shellcheck.
+// Errors reported by the command change when the command is updated.
+func resultCacheExecutable(name string) string {
+	if name == "" {
+		return ""
+	}
+	p, err := exec.LookPath(name)
+	if err != nil {
+		return name + " (not found)"
+	}
+	s, err := os.Stat(p)
+	if err != nil {
+		return p
+	}
+	return fmt.Sprintf("%s (%d bytes at %d)", p, s.Size(), s.ModTime().UnixNano())
+}
+
+func writeResultCacheFile(h hash.Hash, path string) []byte {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(h, "%s: not found\n", path)
+		return nil
+	}
+	fmt.Fprintf(h, "%s: %d\n", path, len(b))
+	h.Write(b)
+	return b
+}
+
+// writeResultCacheLocalUses writes the local actions and reusable workflows used in the source to
+// the hash. Local actions and reusable workflows used by them are also written recursively. The
+// seen parameter is the set of file paths already written.
+func writeResultCacheLocalUses(h hash.Hash, src []byte, root string, seen map[string]struct{}) {
+	for _, m := range reResultCacheLocalUses.FindAllSubmatch(src, -1) {
+		p := filepath.Join(root, filepath.FromSlash(string(m[1])))
+		ps := []string{p}
+		if !strings.HasSuffix(p, ".yml") && !strings.HasSuffix(p, ".yaml") {
+			ps = []string{filepath.Join(p, "action.yml"), filepath.Join(p, "action.yaml")}
+		}
+		for _, p := range ps {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			if b := writeResultCacheFile(h, p); b != nil {
+				writeResultCacheLocalUses(h, b, root, seen)
+			}
+		}
+	}
+}
+
+// resultCacheable returns whether the errors of the workflow source can be cached. Some rules check
+// the existence of files in the repository. The errors reported by them cannot be cached since the
+// files checked by them are not known until the workflow is checked.
+func resultCacheable(src []byte) bool {
+	return !reResultCacheFileSystemDeps.Match(src)
+}
+
+// key returns the key of the workflow file at the path. The cfg parameter is the config applied to
+// the file.
+func (c *resultCache) key(path string, src []byte, project *Project, cfg *Config) string {
+	h := sha256.New()
+	h.Write(c.seed)
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		b = []byte(err.Error())
+	}
+	fmt.Fprintf(h, "config: %d\n", len(b))
+	h.Write(b)
+
+	fmt.Fprintf(h, "%s: %d\n", filepath.ToSlash(path), len(src))
+	h.Write(src)
+
+	// "workflow-file" rule reports workflow files which are symbolic links
+	if s, err := os.Lstat(path); err == nil && s.Mode()&os.ModeSymlink != 0 {
+		h.Write([]byte("symlink\n"))
+	}
+
+	// Errors of the workflow depend on the local actions and reusable workflows used by it directly
+	// or indirectly
+	if project != nil {
+		writeResultCacheLocalUses(h, src, project.RootDir(), map[string]struct{}{})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *resultCache) diskPath(path string) string {
+	h := sha256.Sum256([]byte(path))
+	return filepath.Join(c.dir, hex.EncodeToString(h[:])+".json")
+}
+
+// get returns the cached errors of the workflow file at the path. The path must be an absolute path.
+// The second return value is false when the errors are not cached or the key was changed.
+func (c *resultCache) get(path, key string) ([]*Error, bool) {
+	p := c.diskPath(path)
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, false
+	}
+	var f resultCacheFile
+	if err := json.Unmarshal(b, &f); err != nil || f.Version != resultCacheVersion || f.Path != path || f.Errors == nil {
+		c.debug("Ignored broken cache file %s for %s", p, path)
+		return nil, false
+	}
+	if f.Key != key {
+		c.debug("Cache file %s for %s is outdated", p, path)
+		return nil, false
+	}
+	c.debug("Read %d errors of %s from cache file %s", len(f.Errors), path, p)
+	return f.Errors, true
+}
+
+// put writes the errors of the workflow file at the path to the cache file. The path must be an
+// absolute path. Failing to write the cache file is not an error since the cache is optional.
+func (c *resultCache) put(path, key string, errs []*Error) {
+	if errs == nil {
+		errs = []*Error{} // Distinguish no error from broken cache file
+	}
+	b, err := json.Marshal(&resultCacheFile{resultCacheVersion, path, key, errs})
+	if err != nil {
+		c.debug("Could not encode errors of %s: %s", path, err)
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		c.debug("Could not create cache directory %s: %s", c.dir, err)
+		return
+	}
+	p := c.diskPath(path)
+	if err := os.WriteFile(p, b, 0644); err != nil {
+		c.debug("Could not write cache file %s: %s", p, err)
+		return
+	}
+	c.debug("Wrote %d errors of %s to cache file %s", len(errs), path, p)
+}
+
+// DefaultResultCacheDir returns the default directory to cache errors of workflow files on disk. It
+// is "actionlint/results" in the user cache directory. Empty string is returned when the user cache
+// directory is not available.
+func DefaultResultCacheDir() string {
+	d, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(d, "actionlint", "results")
+}
@@ -0,0 +1,249 @@
+package actionlint
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "act"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	action := filepath.Join(root, "act", "action.yml")
+	if err := os.WriteFile(action, []byte("name: a\nruns:\n  using: composite\n  steps:\n    - uses: ./nested\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "nested"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	nested := filepath.Join(root, "nested", "action.yaml")
+	if err := os.WriteFile(nested, []byte("name: n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p := &Project{root, nil}
+	src := []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: ./act\n")
+
+	c := newResultCache(t.TempDir(), []string{"shellcheck="}, nil)
+	k := c.key("test.yaml", src, p, nil)
+	if k2 := c.key("test.yaml", src, p, nil); k != k2 {
+		t.Fatalf("key is not stable: %q vs %q", k, k2)
+	}
+
+	keys := map[string]string{"original": k}
+	check := func(what, key string) {
+		t.Helper()
+		for w, k := range keys {
+			if k == key {
+				t.Fatalf("key after changing %s is the same as key of %s: %q", what, w, key)
+			}
+		}
+		keys[what] = key
+	}
+
+	check("path", c.key("other.yaml", src, p, nil))
+	check("content", c.key("test.yaml", append(src, "        with:\n          foo: bar\n"...), p, nil))
+	check("config", c.key("test.yaml", src, p, &Config{ConfigVariables: []string{"FOO"}}))
+	check("options", newResultCache(t.TempDir(), []string{"shellcheck=/path/to/shellcheck"}, nil).key("test.yaml", src, p, nil))
+	if err := os.WriteFile(nested, []byte("name: m\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	check("nested local action", c.key("test.yaml", src, p, nil))
+	if err := os.WriteFile(action, []byte("name: b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	check("local action", c.key("test.yaml", src, p, nil))
+
+	file := filepath.Join(root, "file.yaml")
+	if err := os.WriteFile(file, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+	check("file", c.key(file, src, p, nil))
+	link := filepath.Join(root, "link.yaml")
+	if err := os.Rename(file, link); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(link, file); err != nil {
+		t.Skip("symbolic link is not available:", err)
+	}
+	check("symbolic link", c.key(file, src, p, nil))
+}
+
+func TestResultCacheGetPut(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	c := newResultCache(dir, nil, nil)
+
+	if _, ok := c.get("/path/to/test.yaml", "key"); ok {
+		t.Fatal("errors should not be cached before put")
+	}
+
+	c.put("/path/to/test.yaml", "key", nil)
+	errs, ok := c.get("/path/to/test.yaml", "key")
+	if !ok {
+		t.Fatal("no error should be cached")
+	}
+	if len(errs) != 0 {
+		t.Fatalf("no error should be cached but got %v", errs)
+	}
+
+	want := &Error{Message: "oops", Filepath: "test.yaml", Line: 1, Column: 2, Kind: "expression", Severity: SeverityWarning}
+	c.put("/path/to/test.yaml", "key2", []*Error{want})
+	if _, ok := c.get("/path/to/test.yaml", "key"); ok {
+		t.Fatal("outdated key should not hit")
+	}
+	errs, ok = c.get("/path/to/test.yaml", "key2")
+	if !ok {
+		t.Fatal("errors should be cached")
+	}
+	if !cmp.Equal([]*Error{want}, errs) {
+		t.Fatal(cmp.Diff([]*Error{want}, errs))
+	}
+
+	if err := os.WriteFile(c.diskPath("/path/to/test.yaml"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.get("/path/to/test.yaml", "key2"); ok {
+		t.Fatal("broken cache file should be ignored")
+	}
+}
+
+func TestResultCacheLinter(t *testing.T) {
+	dir := t.TempDir()
+	cache := filepath.Join(dir, "cache")
+	path := filepath.Join(dir, "test.yaml")
+	if err := os.WriteFile(path, []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ unknown }}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	today := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	lint := func() []*Error {
+		t.Helper()
+		l, err := NewLinter(io.Discard, &LinterOptions{CacheDir: cache, WorkingDir: dir, Now: today})
+		if err != nil {
+			t.Fatal(err)
+		}
+		errs, err := l.LintFiles([]string{path}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errs
+	}
+
+	errs := lint()
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, `undefined variable "unknown"`) {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	// Rewrite the cache file to check the errors are read from it
+	fs, err := os.ReadDir(cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fs) != 1 {
+		t.Fatalf("one cache file should be created but got %d files", len(fs))
+	}
+	f := filepath.Join(cache, fs[0].Name())
+	b, err := os.ReadFile(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(f, []byte(strings.ReplaceAll(string(b), "unknown", "cached")), 0644); err != nil {
+		t.Fatal(err)
+	}
+	errs = lint()
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, `undefined variable "cached"`) {
+		t.Fatalf("errors should be read from cache: %v", errs)
+	}
+	if errs[0].Filepath != "test.yaml" {
+		t.Fatalf("file path should be relative path but got %q", errs[0].Filepath)
+	}
+
+	// Custom rules may report errors depending on anything so their errors are not cached
+	err = RegisterRule("test-result-cache", func(path string, src []byte) Rule {
+		return &customRuleForTest{RuleBase: NewRuleBase("test-result-cache", "Test rule")}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs = lint()
+	RegisterRule("test-result-cache", nil)
+	if len(errs) == 0 || !strings.Contains(errs[0].Message, `undefined variable "unknown"`) {
+		t.Fatalf("cache should not be used with custom rules: %v", errs)
+	}
+	if errs := lint(); len(errs) != 1 || !strings.Contains(errs[0].Message, `undefined variable "cached"`) {
+		t.Fatalf("cache should not be updated with custom rules: %v", errs)
+	}
+
+	// Errors of some rules depend on the date
+	today = today.Add(24 * time.Hour)
+	if errs := lint(); len(errs) != 1 || !strings.Contains(errs[0].Message, `undefined variable "unknown"`) {
+		t.Fatalf("errors cached on another day should not be used: %v", errs)
+	}
+
+	if err := os.WriteFile(path, []byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if errs := lint(); len(errs) != 0 {
+		t.Fatalf("modified file should be checked again: %v", errs)
+	}
+}
+
+func TestResultCacheFileSystemDeps(t *testing.T) {
+	dir := t.TempDir()
+	proj := filepath.Join(dir, "proj")
+	for _, d := range []string{".git", ".github/workflows", "web"} {
+		if err := os.MkdirAll(filepath.Join(proj, filepath.FromSlash(d)), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/setup-node@v4
+        with:
+          cache: npm
+          cache-dependency-path: web/package-lock.json
+`
+	path := filepath.Join(proj, ".github", "workflows", "test.yaml")
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	lint := func() []*Error {
+		t.Helper()
+		l, err := NewLinter(io.Discard, &LinterOptions{CacheDir: filepath.Join(dir, "cache"), WorkingDir: proj})
+		if err != nil {
+			t.Fatal(err)
+		}
+		errs, err := l.LintFiles([]string{path}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errs
+	}
+
+	if errs := lint(); len(errs) != 1 || !strings.Contains(errs[0].Message, "no file in the repository matches") {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if err := os.WriteFile(filepath.Join(proj, "web", "package-lock.json"), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if errs := lint(); len(errs) != 0 {
+		t.Fatalf("workflow depending on files in repository should not be cached: %v", errs)
+	}
+
+	if resultCacheable([]byte(src)) {
+		t.Error("workflow with cache-dependency-path should not be cacheable")
+	}
+	if !resultCacheable([]byte("on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n")) {
+		t.Error("workflow without file system dependencies should be cacheable")
+	}
+}
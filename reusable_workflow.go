@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -160,6 +161,10 @@ type ReusableWorkflowMetadata struct {
 	Inputs  ReusableWorkflowMetadataInputs  `yaml:"inputs"`
 	Outputs ReusableWorkflowMetadataOutputs `yaml:"outputs"`
 	Secrets ReusableWorkflowMetadataSecrets `yaml:"secrets"`
+	// Calls is a list of reusable workflows called by jobs of this reusable workflow. Each element is
+	// the value of "uses:" like "./path/to/workflow.yaml" or "owner/repo/path/to/workflow.yaml@ref".
+	// Calls containing expressions are not included. The list is sorted and has no duplicates.
+	Calls []string `yaml:"-"`
 }
 
 // LocalReusableWorkflowCache is a cache for local reusable workflow metadata files. It avoids find/read/parse
@@ -205,6 +210,12 @@ func (c *LocalReusableWorkflowCache) writeCache(key string, val *ReusableWorkflo
 //
 // Calling this method is thread-safe.
 func (c *LocalReusableWorkflowCache) FindMetadata(spec string) (*ReusableWorkflowMetadata, error) {
+	return c.findMetadata(spec, true)
+}
+
+// findMetadata is the same as FindMetadata. When rememberErr is false, the error is not remembered
+// in the cache so that the following FindMetadata call can report the error.
+func (c *LocalReusableWorkflowCache) findMetadata(spec string, rememberErr bool) (*ReusableWorkflowMetadata, error) {
 	if c.proj == nil || !strings.HasPrefix(spec, "./") || ContainsExpression(spec) {
 		return nil, nil
 	}
@@ -217,13 +228,17 @@ func (c *LocalReusableWorkflowCache) FindMetadata(spec string) (*ReusableWorkflo
 	file := filepath.Join(c.proj.RootDir(), filepath.FromSlash(spec))
 	src, err := os.ReadFile(file)
 	if err != nil {
-		c.writeCache(spec, nil) // Remember the workflow file was not found
+		if rememberErr {
+			c.writeCache(spec, nil) // Remember the workflow file was not found
+		}
 		return nil, fmt.Errorf("could not read reusable workflow file for %q: %w", spec, err)
 	}
 
 	m, err := parseReusableWorkflowMetadata(src)
 	if err != nil {
-		c.writeCache(spec, nil) // Remember the workflow file was invalid
+		if rememberErr {
+			c.writeCache(spec, nil) // Remember the workflow file was invalid
+		}
 		msg := strings.ReplaceAll(err.Error(), "\n", " ")
 		return nil, fmt.Errorf("error while parsing reusable workflow %q: %s", spec, msg)
 	}
@@ -262,6 +277,10 @@ func (c *LocalReusableWorkflowCache) convWorkflowPathToSpec(p string) (string, b
 // to workflow call spec, (3) some cache for the workflow is already existing.
 // This method is thread safe.
 func (c *LocalReusableWorkflowCache) WriteWorkflowCallEvent(wpath string, event *WorkflowCallEvent) {
+	c.writeWorkflowCallEvent(wpath, event, nil)
+}
+
+func (c *LocalReusableWorkflowCache) writeWorkflowCallEvent(wpath string, event *WorkflowCallEvent, calls []string) {
 	// Convert workflow path to workflow call spec
 	spec, ok := c.convWorkflowPathToSpec(wpath)
 	if !ok {
@@ -280,6 +299,7 @@ func (c *LocalReusableWorkflowCache) WriteWorkflowCallEvent(wpath string, event
 		Inputs:  ReusableWorkflowMetadataInputs{},
 		Outputs: ReusableWorkflowMetadataOutputs{},
 		Secrets: ReusableWorkflowMetadataSecrets{},
+		Calls:   calls,
 	}
 
 	for _, i := range event.Inputs {
@@ -320,9 +340,61 @@ func (c *LocalReusableWorkflowCache) WriteWorkflowCallEvent(wpath string, event
 	c.debug("Workflow call metadata from workflow %s: %v", wpath, m)
 }
 
+// reusableWorkflowCalls returns the sorted list of "uses:" values of the jobs calling reusable
+// workflows. The calls containing expressions are omitted.
+func reusableWorkflowCalls(jobs map[string]*Job) []string {
+	seen := map[string]struct{}{}
+	for _, j := range jobs {
+		if j.WorkflowCall == nil || j.WorkflowCall.Uses == nil {
+			continue
+		}
+		if u := j.WorkflowCall.Uses; u.Value != "" && !u.ContainsExpression() {
+			seen[u.Value] = struct{}{}
+		}
+	}
+	return sortedWorkflowCalls(seen)
+}
+
+func sortedWorkflowCalls(m map[string]struct{}) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	calls := make([]string, 0, len(m))
+	for c := range m {
+		calls = append(calls, c)
+	}
+	sort.Strings(calls)
+	return calls
+}
+
+// parseReusableWorkflowCalls is the same as reusableWorkflowCalls but it collects the calls from the
+// "jobs:" node of a workflow which is not parsed yet.
+func parseReusableWorkflowCalls(n *yaml.Node) []string {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	seen := map[string]struct{}{}
+	for i := 1; i < len(n.Content); i += 2 {
+		j := n.Content[i]
+		if j.Kind != yaml.MappingNode {
+			continue
+		}
+		for k := 0; k < len(j.Content); k += 2 {
+			if j.Content[k].Value != "uses" {
+				continue
+			}
+			if u := j.Content[k+1]; u.Kind == yaml.ScalarNode && u.Value != "" && !ContainsExpression(u.Value) {
+				seen[u.Value] = struct{}{}
+			}
+		}
+	}
+	return sortedWorkflowCalls(seen)
+}
+
 func parseReusableWorkflowMetadata(src []byte) (*ReusableWorkflowMetadata, error) {
 	type workflow struct {
-		On yaml.Node `yaml:"on"`
+		On   yaml.Node `yaml:"on"`
+		Jobs yaml.Node `yaml:"jobs"`
 	}
 
 	var w workflow
@@ -346,20 +418,21 @@ func parseReusableWorkflowMetadata(src []byte) (*ReusableWorkflowMetadata, error
 				if err := n.Content[i+1].Decode(&m); err != nil {
 					return nil, err
 				}
+				m.Calls = parseReusableWorkflowCalls(&w.Jobs)
 				return &m, nil
 			}
 		}
 	case yaml.ScalarNode:
 		// on: workflow_call
 		if v := strings.ToLower(n.Value); v == "workflow_call" {
-			return &ReusableWorkflowMetadata{}, nil
+			return &ReusableWorkflowMetadata{Calls: parseReusableWorkflowCalls(&w.Jobs)}, nil
 		}
 	case yaml.SequenceNode:
 		// on: [workflow_call]
 		for _, c := range n.Content {
 			e := strings.ToLower(c.Value)
 			if e == "workflow_call" {
-				return &ReusableWorkflowMetadata{}, nil
+				return &ReusableWorkflowMetadata{Calls: parseReusableWorkflowCalls(&w.Jobs)}, nil
 			}
 		}
 	}
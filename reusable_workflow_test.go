@@ -42,6 +42,28 @@ func TestReusableWorkflowUnmarshalOK(t *testing.T) {
 				},
 			},
 		},
+		{
+			what: "nested workflow calls",
+			src: `
+			on: workflow_call
+			jobs:
+			  a:
+			    uses: ./b.yaml
+			  b:
+			    uses: owner/repo/.github/workflows/c.yaml@v1
+			  c:
+			    uses: ./b.yaml
+			  d:
+			    uses: ${{ matrix.workflow }}
+			  e:
+			    runs-on: ubuntu-latest
+			    steps:
+			      - uses: actions/checkout@v4
+			`,
+			want: &ReusableWorkflowMetadata{
+				Calls: []string{"./b.yaml", "owner/repo/.github/workflows/c.yaml@v1"},
+			},
+		},
 		{
 			what: "empty",
 			src: `
@@ -438,6 +460,19 @@ func TestReusableWorkflowCacheFindMetadataError(t *testing.T) {
 	}
 }
 
+func TestReusableWorkflowCacheFindMetadataErrorNotRemembered(t *testing.T) {
+	proj := &Project{filepath.Join("testdata", "reusable_workflow_metadata"), nil}
+	c := NewLocalReusableWorkflowCache(proj, "", nil)
+	for i := 0; i < 2; i++ {
+		if _, err := c.findMetadata("./broken.yaml", false); err == nil {
+			t.Fatal("no error happened at", i)
+		}
+	}
+	if _, err := c.FindMetadata("./broken.yaml"); err == nil {
+		t.Fatal("error was remembered by findMetadata")
+	}
+}
+
 func TestReusableWorkflowCacheFindMetadataSkipParsing(t *testing.T) {
 	p := &Project{filepath.Join("testdata", "reusable_workflow_metadata"), nil}
 	tests := []struct {
@@ -3,6 +3,8 @@ package actionlint
 import (
 	"fmt"
 	"io"
+	"sort"
+	"sync"
 )
 
 // RuleBase is a struct to be a base of rule structs. Embed this struct to define default methods
@@ -53,6 +55,24 @@ func (r *RuleBase) Errorf(pos *Pos, format string, args ...interface{}) {
 	r.errs = append(r.errs, err)
 }
 
+// ErrorfWithFixes reports a new error like Errorf with edits of the source to fix the error. The
+// edits are applied when -fix flag is given.
+func (r *RuleBase) ErrorfWithFixes(pos *Pos, fixes []*TextEdit, format string, args ...interface{}) {
+	err := errorfAt(pos, r.name, format, args...)
+	err.Fixes = fixes
+	r.errs = append(r.errs, err)
+}
+
+// ErrorfWithEvents reports a new error like Errorf with names of the workflow triggers which make
+// the error relevant. See Error.Events for more details.
+func (r *RuleBase) ErrorfWithEvents(pos *Pos, events []string, format string, args ...interface{}) {
+	err := errorfAt(pos, r.name, format, args...)
+	if len(events) > 0 {
+		err.Events = events
+	}
+	r.errs = append(r.errs, err)
+}
+
 // Debug prints debug log to the output. The output is specified by the argument of EnableDebug method.
 // By default, no output is set so debug log is not printed.
 func (r *RuleBase) Debug(format string, args ...interface{}) {
@@ -106,3 +126,138 @@ type Rule interface {
 	SetConfig(cfg *Config)
 	Config() *Config
 }
+
+// RuleFactory is a function to create a rule instance for each workflow file. The path parameter
+// is the file path of the workflow and the src parameter is its source. Returning nil means the rule
+// is not applied to the workflow. Since workflow files are checked in parallel, this function must
+// be thread-safe.
+type RuleFactory func(path string, src []byte) Rule
+
+// builtinRuleNames is the list of names of all built-in rules sorted in lexical order. Custom rules
+// cannot be registered with these names.
+var builtinRuleNames = []string{
+	"action",
+	"action-fork",
+	"action-trigger",
+	"always-on-cancel",
+	"artifact-name",
+	"checkout-path",
+	"checkout-persist-credentials",
+	"comment-guard",
+	"credentials",
+	"deprecated-commands",
+	"deprecation",
+	"dispatch-input-command",
+	"env-var",
+	"environment-file",
+	"event-action",
+	"events",
+	"expression",
+	"github-token",
+	"glob",
+	"id",
+	"if-cond",
+	"job-needs",
+	"job-order",
+	"literal-key",
+	"matrix",
+	"matrix-outputs",
+	"matrix-suggestion",
+	"matrix-unused",
+	"pages",
+	"permissions",
+	"psscriptanalyzer",
+	"pyflakes",
+	"ref-name",
+	"release-trigger",
+	"remote-script",
+	"required-status-checks",
+	"run-name",
+	"runner-arch",
+	"runner-label",
+	"runner-tool",
+	"schedule-timezone",
+	"setup-cache",
+	"setup-order",
+	"shell-name",
+	"shellcheck",
+	"status-check-name",
+	"syntax-check",
+	"trace-secrets",
+	"unreachable-step",
+	"untrusted-flow",
+	"windows-bash",
+	"workflow-call",
+	"workflow-file",
+}
+
+var (
+	customRules   = map[string]RuleFactory{}
+	customRulesMu sync.Mutex
+)
+
+// RegisterRule registers the factory of the custom rule with the name. Linter creates the rule with
+// the factory for each workflow file and applies it along with the built-in rules. Errors reported
+// by the rule are output, formatted, and filtered in the same way as errors of the built-in rules.
+// The name must be the same as the name of the created rule and it can be given to OnlyRules option
+// of Linter. When a rule is already registered with the same name, it is replaced with the new one.
+// When the factory is nil, the rule is unregistered. An error is returned when the name is not in
+// kebab case or it is a name of built-in rule. This function can be called in parallel.
+func RegisterRule(name string, f RuleFactory) error {
+	if contains(builtinRuleNames, name) {
+		return fmt.Errorf("rule %q cannot be registered since it is a built-in rule", name)
+	}
+	if !rePluginName.MatchString(name) {
+		return fmt.Errorf("invalid rule name %q. name must consist of lower-case letters, digits, and hyphens like \"step-name\"", name)
+	}
+	customRulesMu.Lock()
+	defer customRulesMu.Unlock()
+	if f == nil {
+		delete(customRules, name)
+	} else {
+		customRules[name] = f
+	}
+	return nil
+}
+
+// RegisteredRuleNames returns names of all custom rules registered by RegisterRule sorted in
+// lexical order. This function can be called in parallel.
+func RegisteredRuleNames() []string {
+	customRulesMu.Lock()
+	defer customRulesMu.Unlock()
+	ns := make([]string, 0, len(customRules))
+	for n := range customRules {
+		ns = append(ns, n)
+	}
+	sort.Strings(ns)
+	return ns
+}
+
+// newCustomRules creates instances of the custom rules registered by RegisterRule for the workflow.
+// The rules are sorted by their names. The factories are called without holding the lock so that
+// they can call RegisterRule and slow factories don't block other workflows.
+func newCustomRules(path string, src []byte) []Rule {
+	customRulesMu.Lock()
+	if len(customRules) == 0 {
+		customRulesMu.Unlock()
+		return nil
+	}
+	ns := make([]string, 0, len(customRules))
+	for n := range customRules {
+		ns = append(ns, n)
+	}
+	sort.Strings(ns)
+	fs := make([]RuleFactory, 0, len(ns))
+	for _, n := range ns {
+		fs = append(fs, customRules[n])
+	}
+	customRulesMu.Unlock()
+
+	rs := make([]Rule, 0, len(fs))
+	for _, f := range fs {
+		if r := f(path, src); r != nil {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -288,17 +289,26 @@ var BrandingIcons = map[string]struct{}{
 // https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#jobsjob_idstepsuses
 type RuleAction struct {
 	RuleBase
-	cache *LocalActionsCache
+	cache  *LocalActionsCache
+	remote *RemoteActionsCache
 }
 
 // NewRuleAction creates new RuleAction instance.
 func NewRuleAction(cache *LocalActionsCache) *RuleAction {
+	return NewRuleActionWithRemote(cache, nil)
+}
+
+// NewRuleActionWithRemote creates new RuleAction instance. The remote parameter is used to fetch
+// metadata of actions which are not in the popular actions data set. When it is nil, inputs of such
+// actions are not checked.
+func NewRuleActionWithRemote(cache *LocalActionsCache, remote *RemoteActionsCache) *RuleAction {
 	return &RuleAction{
 		RuleBase: RuleBase{
 			name: "action",
 			desc: "Checks for popular actions released on GitHub, local actions, and action calls at \"uses:\"",
 		},
-		cache: cache,
+		cache:  cache,
+		remote: remote,
 	}
 }
 
@@ -360,13 +370,19 @@ func (rule *RuleAction) checkRepoAction(spec string, exec *ExecAction) {
 		rule.invalidActionFormat(exec.Uses.Pos, spec, "owner and repo and ref should not be empty")
 	}
 
-	meta, ok := PopularActions[spec]
+	if !rule.Config().PopularActionsEnabled() {
+		rule.checkRemoteAction(spec, exec)
+		return
+	}
+
+	meta, ok := findPopularAction(spec)
 	if !ok {
 		if _, ok := OutdatedPopularActionSpecs[spec]; ok {
 			rule.Errorf(exec.Uses.Pos, "the runner of %q action is too old to run on GitHub Actions. update the action's version to fix this issue", spec)
 			return
 		}
 		rule.Debug("This action is not found in popular actions data set: %s", spec)
+		rule.checkRemoteAction(spec, exec)
 		return
 	}
 	if meta.SkipInputs {
@@ -376,9 +392,73 @@ func (rule *RuleAction) checkRepoAction(spec string, exec *ExecAction) {
 
 	rule.checkAction(meta, exec, func(m *ActionMetadata) string {
 		return strconv.Quote(spec)
+	}, func(id string) string {
+		return popularActionInputVersions(spec, id)
 	})
 }
 
+// checkRemoteAction checks inputs of the action with its metadata fetched from GitHub. It does
+// nothing when online checks are disabled.
+func (rule *RuleAction) checkRemoteAction(spec string, exec *ExecAction) {
+	if rule.remote == nil {
+		return
+	}
+	meta, err := rule.remote.FindMetadata(spec)
+	if err != nil {
+		rule.Debug("Could not fetch metadata of action %s: %s", spec, err)
+		return
+	}
+	if meta == nil {
+		return
+	}
+	rule.checkAction(meta, exec, func(m *ActionMetadata) string {
+		return strconv.Quote(spec)
+	}, nil)
+}
+
+var reSemverRef = regexp.MustCompile(`^v?(\d+)(?:\.\d+){1,2}$`)
+
+// findPopularAction finds the metadata of the popular action. When the exact version is not in
+// the data set, the metadata of the major version is used. For example, "actions/checkout@v4.1.1"
+// is checked as "actions/checkout@v4" since inputs are not changed within the same major version.
+func findPopularAction(spec string) (*ActionMetadata, bool) {
+	if m, ok := PopularActions()[spec]; ok {
+		return m, true
+	}
+	slug, ref, ok := strings.Cut(spec, "@")
+	if !ok {
+		return nil, false
+	}
+	ms := reSemverRef.FindStringSubmatch(ref)
+	if ms == nil {
+		return nil, false
+	}
+	m, ok := PopularActions()[slug+"@v"+ms[1]]
+	return m, ok
+}
+
+// popularActionInputVersions returns the other known versions of the popular action which define
+// the input. It returns an empty string when no version defines it.
+func popularActionInputVersions(spec, id string) string {
+	slug, _, ok := strings.Cut(spec, "@")
+	if !ok {
+		return ""
+	}
+	vs := []string{}
+	for s, m := range PopularActions() {
+		if !strings.HasPrefix(s, slug+"@") || s == spec || m.SkipInputs {
+			continue
+		}
+		if _, ok := m.Inputs[id]; ok {
+			vs = append(vs, s)
+		}
+	}
+	if len(vs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(". note that the input is defined in %s. check the version of the action", sortedQuotes(vs))
+}
+
 func (rule *RuleAction) invalidActionFormat(pos *Pos, spec string, why string) {
 	rule.Errorf(pos, "specifying action %q in invalid format because %s. available formats are \"{owner}/{repo}@{ref}\" or \"{owner}/{repo}/{path}@{ref}\"", spec, why)
 }
@@ -564,25 +644,73 @@ func (rule *RuleAction) checkLocalAction(spec string, action *ExecAction) {
 
 	rule.checkAction(meta, action, func(m *ActionMetadata) string {
 		return fmt.Sprintf("%q defined at %q", m.Name, spec)
-	})
+	}, nil)
 }
 
-func (rule *RuleAction) checkAction(meta *ActionMetadata, exec *ExecAction, describe func(*ActionMetadata) string) {
+func (rule *RuleAction) deprecatedInput(name *String, input *ActionMetadataInput, meta *ActionMetadata, describe func(*ActionMetadata) string) {
+	msg := strings.Join(strings.Fields(input.Deprecated), " ") // Deprecation message may be multi-line
+	if to, ok := meta.RenamedInputs[strings.ToLower(input.Name)]; ok {
+		rule.Errorf(
+			name.Pos,
+			"input %q of action %s is deprecated: %s. it was renamed to %q. use %q instead",
+			name.Value,
+			describe(meta),
+			strings.TrimSuffix(msg, "."),
+			to,
+			to,
+		)
+		return
+	}
+	rule.Errorf(
+		name.Pos,
+		"input %q of action %s is deprecated: %s",
+		name.Value,
+		describe(meta),
+		msg,
+	)
+}
+
+// checkAction checks inputs at "with:" of the step. The hint function returns an additional
+// message for the undefined input and it can be nil.
+func (rule *RuleAction) checkAction(meta *ActionMetadata, exec *ExecAction, describe func(*ActionMetadata) string, hint func(id string) string) {
 	// Check specified inputs are defined in action's inputs spec
 	for id, i := range exec.Inputs {
-		if _, ok := meta.Inputs[id]; !ok {
-			ns := make([]string, 0, len(meta.Inputs))
-			for _, i := range meta.Inputs {
-				ns = append(ns, i.Name)
+		m, ok := meta.Inputs[id]
+		if ok {
+			if m.Deprecated != "" {
+				rule.deprecatedInput(i.Name, m, meta, describe)
 			}
+			continue
+		}
+
+		if to, ok := meta.RenamedInputs[id]; ok {
 			rule.Errorf(
 				i.Name.Pos,
-				"input %q is not defined in action %s. available inputs are %s",
+				"input %q was renamed to %q in action %s. use %q instead",
 				i.Name.Value,
+				to,
 				describe(meta),
-				sortedQuotes(ns),
+				to,
 			)
+			continue
 		}
+
+		ns := make([]string, 0, len(meta.Inputs))
+		for _, i := range meta.Inputs {
+			ns = append(ns, i.Name)
+		}
+		h := ""
+		if hint != nil {
+			h = hint(id)
+		}
+		rule.Errorf(
+			i.Name.Pos,
+			"input %q is not defined in action %s. available inputs are %s%s",
+			i.Name.Value,
+			describe(meta),
+			sortedQuotes(ns),
+			h,
+		)
 	}
 
 	// Check mandatory inputs are specified
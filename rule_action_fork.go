@@ -0,0 +1,232 @@
+package actionlint
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// popularActionSlugs is a set of popular actions without their refs. Keys are "{owner}/{repo}" or
+// "{owner}/{repo}/{path}" in lower case and values are the slugs in original case.
+var popularActionSlugs map[string]string
+
+// popularActionNames maps names of popular actions in lower case to their slugs.
+var popularActionNames map[string][]string
+
+var popularActionSlugsOnce sync.Once
+
+func initPopularActionSlugs() {
+	popularActionSlugsOnce.Do(func() {
+		slugs := map[string]string{}
+		names := map[string][]string{}
+		for spec, meta := range PopularActions() {
+			s, _, _ := strings.Cut(spec, "@")
+			k := strings.ToLower(s)
+			if _, ok := slugs[k]; ok {
+				continue
+			}
+			slugs[k] = s
+			if meta.Name != "" {
+				n := strings.ToLower(meta.Name)
+				names[n] = append(names[n], s)
+			}
+		}
+		for _, ss := range names {
+			sort.Strings(ss)
+		}
+		popularActionSlugs = slugs
+		popularActionNames = names
+	})
+}
+
+// parseRemoteActionSpec parses action spec {owner}/{repo}@{ref} or {owner}/{repo}/{path}@{ref}.
+// The last return value is false when the spec is not in the format.
+func parseRemoteActionSpec(spec string) (repo string, dir string, ref string, ok bool) {
+	s, ref, ok := strings.Cut(spec, "@")
+	if !ok || ref == "" {
+		return "", "", "", false
+	}
+	owner, s, ok := strings.Cut(s, "/")
+	if !ok || owner == "" {
+		return "", "", "", false
+	}
+	name, dir, _ := strings.Cut(s, "/")
+	if name == "" {
+		return "", "", "", false
+	}
+	return owner + "/" + name, dir, ref, true
+}
+
+// RuleActionFork is a rule to detect actions which are forks of popular actions or which pretend
+// to be popular actions. Using such actions is a common risk of typo-squatting and supply chain
+// attacks. This rule sends requests to GitHub REST API so it is only enabled when online checks
+// are enabled.
+type RuleActionFork struct {
+	RuleBase
+	client *GitHubAPIClient
+}
+
+// NewRuleActionFork creates a new RuleActionFork instance. The client parameter is used to fetch
+// repository information and action metadata of actions from GitHub.
+func NewRuleActionFork(client *GitHubAPIClient) *RuleActionFork {
+	initPopularActionSlugs()
+	return &RuleActionFork{
+		RuleBase: RuleBase{
+			name: "action-fork",
+			desc: "Checks for actions at \"uses:\" which are forks of popular actions or have the same names as popular actions",
+		},
+		client: client,
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children. It
+// prefetches repositories and metadata files of all actions in the workflow in batches.
+func (rule *RuleActionFork) VisitWorkflowPre(n *Workflow) error {
+	repos := []string{}
+	files := []*GitHubFile{}
+	for _, j := range n.Jobs {
+		for _, s := range j.Steps {
+			repo, dir, ref, ok := rule.target(s)
+			if !ok {
+				continue
+			}
+			repos = append(repos, repo)
+			for _, f := range []string{"action.yml", "action.yaml"} {
+				files = append(files, &GitHubFile{repo, path.Join(dir, f), ref})
+			}
+		}
+	}
+	if len(repos) == 0 {
+		return nil
+	}
+	if err := rule.client.Prefetch(repos, files); err != nil {
+		rule.Debug("Could not prefetch %d actions via GraphQL API: %s", len(repos), err)
+	}
+	return nil
+}
+
+// target returns the repository, the directory, and the ref of the action used at the step when the
+// action should be checked by this rule.
+func (rule *RuleActionFork) target(n *Step) (string, string, string, bool) {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil || e.Uses.ContainsExpression() {
+		return "", "", "", false
+	}
+	spec := e.Uses.Value
+	if strings.HasPrefix(spec, "./") || strings.HasPrefix(spec, "docker://") {
+		return "", "", "", false
+	}
+	repo, dir, ref, ok := parseRemoteActionSpec(spec)
+	if !ok {
+		return "", "", "", false // Invalid format is reported by "action" rule
+	}
+	slug := repo
+	if dir != "" {
+		slug += "/" + dir
+	}
+	if _, ok := popularActionSlugs[strings.ToLower(slug)]; ok {
+		return "", "", "", false
+	}
+	if rule.allowed(slug) {
+		rule.Debug("Action %q is allowed by config", slug)
+		return "", "", "", false
+	}
+	return repo, dir, ref, true
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleActionFork) VisitStep(n *Step) error {
+	repo, dir, ref, ok := rule.target(n)
+	if !ok {
+		return nil
+	}
+
+	e := n.Exec.(*ExecAction)
+	spec := e.Uses.Value
+	slug := repo
+	if dir != "" {
+		slug += "/" + dir
+	}
+
+	if r, err := rule.client.Repository(repo); err != nil {
+		rule.Debug("Could not fetch repository %s: %s", repo, err)
+	} else if r.Fork && r.Parent != nil {
+		p := r.Parent.FullName
+		if dir != "" {
+			p += "/" + dir
+		}
+		if s, ok := popularActionSlugs[strings.ToLower(p)]; ok {
+			rule.Errorf(
+				e.Uses.Pos,
+				"action %q is a fork of popular action %q. forks may be used for typo-squatting and may not receive security fixes. use %q instead or add %q to \"allow\" of \"action-fork\" rule in actionlint.yaml if the fork is intended",
+				spec,
+				s,
+				s,
+				slug,
+			)
+			return nil
+		}
+	}
+
+	name, err := rule.fetchActionName(repo, dir, ref)
+	if err != nil {
+		rule.Debug("Could not fetch action metadata of %s: %s", spec, err)
+		return nil
+	}
+	owner, _, _ := strings.Cut(repo, "/")
+	for _, s := range popularActionNames[strings.ToLower(name)] {
+		if o, _, _ := strings.Cut(s, "/"); strings.EqualFold(o, owner) {
+			continue
+		}
+		rule.Errorf(
+			e.Uses.Pos,
+			"action %q has the same name %q as popular action %q but the owner is different. it may be typo-squatting the popular action. use %q instead or add %q to \"allow\" of \"action-fork\" rule in actionlint.yaml if the action is intended",
+			spec,
+			name,
+			s,
+			s,
+			slug,
+		)
+		break
+	}
+
+	return nil
+}
+
+func (rule *RuleActionFork) allowed(slug string) bool {
+	c := rule.Config().Rule(rule.Name())
+	if c == nil {
+		return false
+	}
+	for _, p := range c.Allow {
+		if ok, _ := path.Match(strings.ToLower(p), strings.ToLower(slug)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *RuleActionFork) fetchActionName(repo, dir, ref string) (string, error) {
+	var b []byte
+	var err error
+	for _, f := range []string{"action.yml", "action.yaml"} {
+		b, err = rule.client.FileContent(repo, path.Join(dir, f), ref)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var meta struct {
+		Name string `yaml:"name"`
+	}
+	if err := yaml.Unmarshal(b, &meta); err != nil {
+		return "", err
+	}
+	return meta.Name, nil
+}
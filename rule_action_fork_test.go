@@ -0,0 +1,108 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleActionForkParseRemoteActionSpec(t *testing.T) {
+	testCases := []struct {
+		spec string
+		repo string
+		dir  string
+		ref  string
+		ok   bool
+	}{
+		{"actions/checkout@v4", "actions/checkout", "", "v4", true},
+		{"github/codeql-action/init@v3", "github/codeql-action", "init", "v3", true},
+		{"owner/repo/path/to/action@main", "owner/repo", "path/to/action", "main", true},
+		{"owner/repo@release/v1", "owner/repo", "", "release/v1", true},
+		{"owner/repo", "", "", "", false},
+		{"owner/repo@", "", "", "", false},
+		{"repo@v1", "", "", "", false},
+		{"/repo@v1", "", "", "", false},
+		{"owner/@v1", "", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			repo, dir, ref, ok := parseRemoteActionSpec(tc.spec)
+			if ok != tc.ok {
+				t.Fatalf("wanted ok=%v but got ok=%v", tc.ok, ok)
+			}
+			if repo != tc.repo || dir != tc.dir || ref != tc.ref {
+				t.Fatalf("wanted (%q, %q, %q) but got (%q, %q, %q)", tc.repo, tc.dir, tc.ref, repo, dir, ref)
+			}
+		})
+	}
+}
+
+func TestRuleActionForkCheckUses(t *testing.T) {
+	requests := 0
+	s := testGitHubAPIServer(t, map[string]string{
+		"/repos/evil/checkout":                                   `{"full_name":"evil/checkout","fork":true,"parent":{"full_name":"actions/checkout"}}`,
+		"/repos/myorg/checkout":                                  `{"full_name":"myorg/checkout","fork":true,"parent":{"full_name":"actions/checkout"}}`,
+		"/repos/evil/codeql-action":                              `{"full_name":"evil/codeql-action","fork":true,"parent":{"full_name":"github/codeql-action"}}`,
+		"/repos/evil/cache":                                      `{"full_name":"evil/cache","fork":false}`,
+		"/repos/evil/cache/contents/action.yml?ref=v4":           "name: 'Cache'\ndescription: 'Cache artifacts like dependencies and build outputs to improve workflow execution time'\n",
+		"/repos/someone/fork":                                    `{"full_name":"someone/fork","fork":true,"parent":{"full_name":"someone/original"}}`,
+		"/repos/someone/fork/contents/action.yml?ref=v1":         "name: 'My action'\n",
+		"/repos/someone/yaml":                                    `{"full_name":"someone/yaml","fork":false}`,
+		"/repos/someone/yaml/contents/sub/action.yaml?ref=v1":    "name: 'Checkout'\n",
+		"/repos/actions/checkout-ext":                            `{"full_name":"actions/checkout-ext","fork":false}`,
+		"/repos/actions/checkout-ext/contents/action.yml?ref=v1": "name: 'Checkout'\n",
+	}, &requests)
+	defer s.Close()
+	c := NewGitHubAPIClient(s.URL, "", nil)
+
+	testCases := []struct {
+		uses string
+		want string
+	}{
+		{"actions/checkout@v4", ""},
+		{"Actions/Checkout@v4", ""},
+		{"./path/to/action", ""},
+		{"docker://alpine:latest", ""},
+		{"${{ env.ACTION }}", ""},
+		{"evil/checkout@v4", `action "evil/checkout@v4" is a fork of popular action "actions/checkout"`},
+		{"evil/codeql-action/init@v3", `action "evil/codeql-action/init@v3" is a fork of popular action "github/codeql-action/init"`},
+		{"evil/cache@v4", `action "evil/cache@v4" has the same name "Cache" as popular action "actions/cache"`},
+		{"someone/fork@v1", ""},
+		{"someone/yaml/sub@v1", `action "someone/yaml/sub@v1" has the same name "Checkout" as popular action "actions/checkout"`},
+		{"actions/checkout-ext@v1", ""},
+		{"myorg/checkout@v4", ""},
+		{"unknown/repo@v1", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.uses, func(t *testing.T) {
+			r := NewRuleActionFork(c)
+			r.SetConfig(&Config{
+				Rules: map[string]*RuleConfig{
+					"action-fork": {Allow: []string{"MyOrg/*"}},
+				},
+			})
+			s := &Step{
+				Exec: &ExecAction{
+					Uses: &String{Value: tc.uses, Pos: &Pos{}},
+				},
+			}
+			if err := r.VisitStep(s); err != nil {
+				t.Fatal(err)
+			}
+			errs := r.Errs()
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			if msg := errs[0].Message; !strings.Contains(msg, tc.want) {
+				t.Fatalf("error message %q does not contain %q", msg, tc.want)
+			}
+		})
+	}
+}
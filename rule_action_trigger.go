@@ -0,0 +1,74 @@
+package actionlint
+
+import (
+	"strings"
+)
+
+// RuleActionTrigger is an opt-in rule checker to detect popular actions used in workflows which are not
+// triggered by any event where the actions are meaningful. For example, actions/stale processes
+// stale issues and pull requests periodically so it should be run on "schedule" event, and
+// actions/labeler requires a pull request. The events are maintained in the popular actions data
+// set.
+type RuleActionTrigger struct {
+	RuleBase
+	// events is a set of events which trigger the workflow. nil means the events cannot be known
+	// statically.
+	events map[string]struct{}
+}
+
+// NewRuleActionTrigger creates a new RuleActionTrigger instance.
+func NewRuleActionTrigger() *RuleActionTrigger {
+	return &RuleActionTrigger{
+		RuleBase: RuleBase{
+			name: "action-trigger",
+			desc: "Checks for popular actions used in workflows which are not triggered by any event where the actions are meaningful. This rule is opt-in",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleActionTrigger) VisitWorkflowPre(n *Workflow) error {
+	rule.events = nil
+	if len(n.On) == 0 {
+		return nil
+	}
+
+	events := make(map[string]struct{}, len(n.On))
+	for _, e := range n.On {
+		if _, ok := e.(*WorkflowCallEvent); ok {
+			return nil // The event is inherited from the caller workflow
+		}
+		events[strings.ToLower(e.EventName())] = struct{}{}
+	}
+	rule.events = events
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleActionTrigger) VisitStep(n *Step) error {
+	if rule.events == nil {
+		return nil
+	}
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil || e.Uses.ContainsExpression() || !rule.Config().PopularActionsEnabled() {
+		return nil
+	}
+	meta, ok := findPopularAction(e.Uses.Value)
+	if !ok || len(meta.Triggers) == 0 {
+		return nil
+	}
+
+	for _, t := range meta.Triggers {
+		if _, ok := rule.events[t]; ok {
+			return nil
+		}
+	}
+
+	rule.Errorf(
+		e.Uses.Pos,
+		"action %q is meaningful only on %s events but this workflow is not triggered by any of them. add one of the events to \"on:\" section",
+		e.Uses.Value,
+		quotes(meta.Triggers),
+	)
+	return nil
+}
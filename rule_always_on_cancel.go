@@ -0,0 +1,262 @@
+package actionlint
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Commands which deploy or publish something. The values are subcommands doing it. An empty list
+// means the command always does it.
+var alwaysOnCancelDeployCommands = map[string][]string{
+	"kubectl":    {"apply", "create", "delete", "replace", "rollout", "scale"},
+	"helm":       {"install", "upgrade", "uninstall", "rollback"},
+	"terraform":  {"apply", "destroy"},
+	"tofu":       {"apply", "destroy"},
+	"pulumi":     {"up", "destroy"},
+	"cdk":        {"deploy", "destroy"},
+	"npm":        {"publish"},
+	"yarn":       {"publish"},
+	"pnpm":       {"publish"},
+	"cargo":      {"publish"},
+	"twine":      {"upload"},
+	"gem":        {"push"},
+	"docker":     {"push"},
+	"gh":         {"release"},
+	"git":        {"push"},
+	"firebase":   {"deploy"},
+	"netlify":    {"deploy"},
+	"flyctl":     {"deploy"},
+	"vercel":     {},
+	"serverless": {"deploy"},
+	"sls":        {"deploy"},
+	"wrangler":   {"deploy", "publish"},
+}
+
+// Popular actions which deploy or publish something but whose names don't say it.
+var alwaysOnCancelDeployActions = []string{
+	"peaceiris/actions-gh-pages",
+	"cloudflare/wrangler-action",
+	"amondnet/vercel-action",
+}
+
+// Words in names of steps, jobs, and actions which perform deployments.
+var alwaysOnCancelDeployWords = []string{"deploy", "publish", "release"}
+
+// RuleAlwaysOnCancel is a rule checker to detect steps and jobs which perform deployments or other
+// irreversible actions with `always()` in their "if:" conditions. `always()` is true even when the
+// workflow run is cancelled, so cancelling the run cannot stop the deployment.
+type RuleAlwaysOnCancel struct {
+	RuleBase
+	lines [][]byte
+}
+
+// NewRuleAlwaysOnCancel creates a new RuleAlwaysOnCancel instance. The src parameter is the source
+// of the workflow, which is used to build the edits to fix the conditions.
+func NewRuleAlwaysOnCancel(src []byte) *RuleAlwaysOnCancel {
+	return &RuleAlwaysOnCancel{
+		RuleBase: RuleBase{
+			name: "always-on-cancel",
+			desc: "Checks for steps and jobs performing deployments with \"always()\" in their \"if:\" conditions, which run even when the workflow run is cancelled",
+		},
+		lines: bytes.SplitAfter(src, []byte{'\n'}),
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleAlwaysOnCancel) VisitJobPre(n *Job) error {
+	for _, s := range n.Steps {
+		// Finding deployments in scripts is costly. Skip steps which never run on cancellation
+		if s.If == nil || rule.allowed(s.Name, s.ID) {
+			continue
+		}
+		if d := deploymentOfStep(s); d != "" {
+			rule.checkCond(s.If, "this step "+d)
+		}
+	}
+
+	if n.If == nil || rule.allowed(n.Name, n.ID) {
+		return nil
+	}
+	if n.Environment != nil && n.Environment.Name != nil {
+		rule.checkCond(n.If, fmt.Sprintf("job %q deploys to environment %q", n.ID.Value, n.Environment.Name.Value))
+		return nil
+	}
+	if n.Name != nil && containsDeployWord(n.Name.Value) || containsDeployWord(n.ID.Value) {
+		rule.checkCond(n.If, fmt.Sprintf("job %q looks like a deployment", n.ID.Value))
+		return nil
+	}
+	for _, s := range n.Steps {
+		if s.If != nil {
+			continue // The step's own condition decides whether it runs
+		}
+		if d := deploymentOfStep(s); d != "" {
+			rule.checkCond(n.If, fmt.Sprintf("job %q has the step at line:%d which %s", n.ID.Value, s.Pos.Line, d))
+			return nil
+		}
+	}
+	return nil
+}
+
+func (rule *RuleAlwaysOnCancel) allowed(name, id *String) bool {
+	c := rule.Config().Rule(rule.Name())
+	if c == nil {
+		return false
+	}
+	return name != nil && matchGlobFilter(c.Allow, name.Value) || id != nil && matchGlobFilter(c.Allow, id.Value)
+}
+
+func (rule *RuleAlwaysOnCancel) checkCond(cond *String, what string) {
+	if cond == nil {
+		return
+	}
+
+	src := strings.TrimSpace(cond.Value)
+	if cond.IsExpressionAssigned() {
+		src = src[3 : len(src)-2]
+	} else if cond.ContainsExpression() {
+		return
+	}
+	p := NewExprParser()
+	expr, err := p.Parse(NewExprLexer(src + "}}"))
+	if err != nil {
+		return // Syntax error is reported by "expression" rule
+	}
+
+	always, cancelled := 0, false
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if f, ok := n.(*FuncCallNode); entering && ok {
+			switch strings.ToLower(f.Callee) {
+			case "always":
+				always++
+			case "cancelled":
+				cancelled = true
+			}
+		}
+	})
+	if always == 0 || cancelled {
+		return // Cancellation is explicitly handled with cancelled()
+	}
+
+	repl := "success() || failure()"
+	if _, ok := expr.(*FuncCallNode); !ok {
+		repl = "(" + repl + ")"
+	}
+	var fixes []*TextEdit
+	if always == 1 {
+		if e := rule.replaceAlways(cond.Pos, repl); e != nil {
+			fixes = []*TextEdit{e}
+		}
+	}
+
+	rule.ErrorfWithFixes(
+		cond.Pos,
+		fixes,
+		"%s but \"always()\" in its \"if:\" condition %q makes it run even when the workflow run is cancelled. use %q instead to skip it on cancellation, or add the name to \"allow\" of \"always-on-cancel\" rule in actionlint.yaml if it is intended",
+		what,
+		cond.Value,
+		repl,
+	)
+}
+
+// replaceAlways returns the edit to replace "always()" in the line at the position. It returns nil
+// when "always()" is not found only once in the line.
+func (rule *RuleAlwaysOnCancel) replaceAlways(pos *Pos, repl string) *TextEdit {
+	if pos.Line <= 0 || len(rule.lines) < pos.Line || pos.Col <= 0 {
+		return nil
+	}
+	l := rule.lines[pos.Line-1]
+	if len(l) < pos.Col {
+		return nil
+	}
+	rest := l[pos.Col-1:]
+	if bytes.Count(rest, []byte("always()")) != 1 {
+		return nil
+	}
+	start := pos.Col - 1 + bytes.Index(rest, []byte("always()"))
+	for i := 0; i < pos.Line-1; i++ {
+		start += len(rule.lines[i])
+	}
+	return &TextEdit{start, start + len("always()"), repl}
+}
+
+func containsDeployWord(s string) bool {
+	s = strings.ToLower(s)
+	for _, w := range alwaysOnCancelDeployWords {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// deploymentOfStep describes how the step performs a deployment. It returns an empty string when
+// the step does not look like a deployment.
+func deploymentOfStep(s *Step) string {
+	switch e := s.Exec.(type) {
+	case *ExecRun:
+		if e.Run != nil {
+			if c := deployCommandIn(e.Run.Value); c != "" {
+				return fmt.Sprintf("runs deployment command %q", c)
+			}
+		}
+	case *ExecAction:
+		if e.Uses != nil && isDeployAction(e.Uses.Value) {
+			return fmt.Sprintf("uses deployment action %q", e.Uses.Value)
+		}
+	}
+	if s.Name != nil && containsDeployWord(s.Name.Value) {
+		return fmt.Sprintf("named %q looks like a deployment", s.Name.Value)
+	}
+	return ""
+}
+
+func isDeployAction(spec string) bool {
+	slug, _, ok := strings.Cut(spec, "@")
+	if !ok || strings.HasPrefix(slug, "./") || strings.HasPrefix(slug, "docker://") {
+		return false
+	}
+	slug = strings.ToLower(slug)
+	for _, a := range alwaysOnCancelDeployActions {
+		if slug == a || strings.HasPrefix(slug, a+"/") {
+			return true
+		}
+	}
+	if _, repo, ok := strings.Cut(slug, "/"); ok {
+		return containsDeployWord(repo)
+	}
+	return false
+}
+
+// deployCommandIn returns the first command in the script which deploys or publishes something. It
+// returns an empty string when no such command is found.
+func deployCommandIn(src string) string {
+	if strings.Contains(src, "\\") {
+		src = reLineContinuation.ReplaceAllString(src, " ")
+	}
+	for _, cmd := range splitRemoteScriptCommands(src) {
+		for len(cmd) > 1 && strings.Contains(cmd[0], "=") {
+			cmd = cmd[1:]
+		}
+		name := path.Base(cmd[0])
+		subs, ok := alwaysOnCancelDeployCommands[name]
+		if !ok {
+			if strings.Contains(cmd[0], "/") && containsDeployWord(name) {
+				return cmd[0] // Scripts like ./deploy.sh
+			}
+			continue
+		}
+		if len(subs) == 0 {
+			return name
+		}
+		for _, a := range cmd[1:] {
+			for _, s := range subs {
+				if a == s {
+					return name + " " + s
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,203 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleAlwaysOnCancelFix(t *testing.T) {
+	testCases := []struct {
+		what string
+		src  string
+		want string
+	}{
+		{
+			what: "deployment command",
+			src: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: kubectl apply -f k8s/
+        if: always()
+`,
+			want: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: kubectl apply -f k8s/
+        if: success() || failure()
+`,
+		},
+		{
+			what: "placeholder",
+			src: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/deploy-pages@v4
+        if: ${{ always() }}
+`,
+			want: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/deploy-pages@v4
+        if: ${{ success() || failure() }}
+`,
+		},
+		{
+			what: "compound condition",
+			src: `on: push
+jobs:
+  deploy:
+    runs-on: ubuntu-latest
+    environment: production
+    if: always() && github.ref == 'refs/heads/main'
+    steps:
+      - run: ./scripts/upload.sh
+`,
+			want: `on: push
+jobs:
+  deploy:
+    runs-on: ubuntu-latest
+    environment: production
+    if: (success() || failure()) && github.ref == 'refs/heads/main'
+    steps:
+      - run: ./scripts/upload.sh
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			w, errs := Parse([]byte(tc.src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleAlwaysOnCancel([]byte(tc.src))
+			for _, j := range w.Jobs {
+				if err := r.VisitJobPre(j); err != nil {
+					t.Fatal(err)
+				}
+			}
+			errs = r.Errs()
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			if !strings.Contains(errs[0].Message, "run even when the workflow run is cancelled") {
+				t.Fatalf("unexpected error message: %q", errs[0].Message)
+			}
+			b, fixed := ApplyFixes([]byte(tc.src), errs)
+			if len(fixed) != 1 {
+				t.Fatalf("error was not fixed: %v", errs[0])
+			}
+			if have := string(b); have != tc.want {
+				t.Fatalf("wanted:\n%s\nbut got:\n%s", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleAlwaysOnCancelNoError(t *testing.T) {
+	testCases := []struct {
+		what string
+		src  string
+	}{
+		{
+			what: "not deployment",
+			src: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo 'cleanup'
+        if: always()
+`,
+		},
+		{
+			what: "cancellation is handled",
+			src: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: git push origin main
+        if: always() && !cancelled()
+`,
+		},
+		{
+			what: "no always",
+			src: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    environment: production
+    if: success() || failure()
+    steps:
+      - run: npm publish
+        if: github.ref == 'refs/heads/main'
+`,
+		},
+		{
+			what: "allowed by step name",
+			src: `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - name: Publish test report
+        run: ./publish-report.sh
+        if: always()
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			w, errs := Parse([]byte(tc.src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleAlwaysOnCancel([]byte(tc.src))
+			r.SetConfig(&Config{
+				Rules: map[string]*RuleConfig{
+					"always-on-cancel": {Allow: []string{"Publish test *"}},
+				},
+			})
+			for _, j := range w.Jobs {
+				if err := r.VisitJobPre(j); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if errs := r.Errs(); len(errs) > 0 {
+				t.Fatalf("wanted no error but got %v", errs)
+			}
+		})
+	}
+}
+
+func TestRuleAlwaysOnCancelDeployCommands(t *testing.T) {
+	tests := []struct {
+		run  string
+		want string
+	}{
+		{"kubectl -n prod apply -f k8s/", "kubectl apply"},
+		{"make build && npm publish --access public", "npm publish"},
+		{"AWS_REGION=us-east-1 terraform apply -auto-approve", "terraform apply"},
+		{"./scripts/deploy.sh prod", "./scripts/deploy.sh"},
+		{"vercel --prod", "vercel"},
+		{"kubectl get pods", ""},
+		{"echo deploy", ""},
+		{"git commit -m 'fix'", ""},
+	}
+
+	for _, tc := range tests {
+		if have := deployCommandIn(tc.run); have != tc.want {
+			t.Errorf("wanted %q for %q but got %q", tc.want, tc.run, have)
+		}
+	}
+}
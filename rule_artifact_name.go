@@ -0,0 +1,159 @@
+package actionlint
+
+import (
+	"strings"
+)
+
+// Characters which are not allowed in artifact names.
+// https://github.com/actions/toolkit/blob/main/packages/artifact/src/internal/upload/path-and-artifact-name-validation.ts
+var artifactNameInvalidChars = []string{`"`, ":", "<", ">", "|", "*", "?", "\r", "\n", `\`, "/"}
+
+// Properties of contexts whose values can include "/". The value is true when the value always
+// includes "/".
+var artifactNameRefProps = map[string]bool{
+	"github.ref":                            true,
+	"github.event.ref":                      true,
+	"github.ref_name":                       false,
+	"github.head_ref":                       false,
+	"github.base_ref":                       false,
+	"github.event.pull_request.head.ref":    false,
+	"github.event.pull_request.base.ref":    false,
+	"github.event.workflow_run.head_branch": false,
+}
+
+// Max length of cache keys.
+// https://github.com/actions/toolkit/blob/main/packages/cache/src/cache.ts
+const maxCacheKeyLength = 512
+
+// RuleArtifactName is a rule checker to check artifact names and cache keys given to the official
+// actions. They are rejected at runtime when they contain invalid characters.
+type RuleArtifactName struct {
+	RuleBase
+}
+
+// NewRuleArtifactName creates a new RuleArtifactName instance.
+func NewRuleArtifactName() *RuleArtifactName {
+	return &RuleArtifactName{
+		RuleBase: RuleBase{
+			name: "artifact-name",
+			desc: "Checks for invalid characters in artifact names and cache keys",
+		},
+	}
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleArtifactName) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil {
+		return nil
+	}
+
+	spec, _, _ := strings.Cut(e.Uses.Value, "@")
+	switch strings.ToLower(spec) {
+	case "actions/upload-artifact", "actions/download-artifact":
+		if i, ok := e.Inputs["name"]; ok && i.Value != nil {
+			rule.checkArtifactName(i.Value)
+		}
+	case "actions/cache", "actions/cache/save", "actions/cache/restore":
+		if i, ok := e.Inputs["key"]; ok && i.Value != nil {
+			rule.checkCacheKey(i.Value, "key")
+		}
+		if i, ok := e.Inputs["restore-keys"]; ok && i.Value != nil {
+			rule.checkCacheKey(i.Value, "restore-keys")
+		}
+	}
+
+	return nil
+}
+
+// splitExpressions splits the string into literal parts and contents of ${{ }} placeholders.
+func splitExpressions(s string) ([]string, []string) {
+	lits, exprs := []string{}, []string{}
+	for {
+		i := strings.Index(s, "${{")
+		if i < 0 {
+			break
+		}
+		j := strings.Index(s[i:], "}}")
+		if j < 0 {
+			break
+		}
+		lits = append(lits, s[:i])
+		exprs = append(exprs, strings.TrimSpace(s[i+3:i+j]))
+		s = s[i+j+2:]
+	}
+	return append(lits, s), exprs
+}
+
+func (rule *RuleArtifactName) checkArtifactName(name *String) {
+	// Inputs are trimmed by actions
+	v := strings.TrimSpace(name.Value)
+	lits, exprs := splitExpressions(v)
+
+	for _, l := range lits {
+		for _, c := range artifactNameInvalidChars {
+			if strings.Contains(l, c) {
+				rule.Errorf(
+					name.Pos,
+					"artifact name %q contains invalid character %q. the following characters are not allowed in artifact names: %s",
+					v,
+					c,
+					quotes(artifactNameInvalidChars),
+				)
+				return
+			}
+		}
+	}
+
+	for _, e := range exprs {
+		always, ok := artifactNameRefProps[strings.ToLower(e)]
+		if !ok {
+			continue
+		}
+		why := "can include \"/\" when the branch name includes slashes like \"feature/foo\""
+		if always {
+			why = "always includes \"/\" like \"refs/heads/main\""
+		}
+		rule.Errorf(
+			name.Pos,
+			"artifact name %q contains \"${{ %s }}\" which %s. \"/\" is not allowed in artifact names. replace the characters in a previous step and use its output instead",
+			v,
+			e,
+			why,
+		)
+		return
+	}
+}
+
+func (rule *RuleArtifactName) checkCacheKey(key *String, input string) {
+	lines := []string{key.Value}
+	if input == "restore-keys" {
+		lines = strings.Split(strings.TrimSpace(key.Value), "\n")
+	}
+
+	for _, l := range lines {
+		l = strings.TrimSpace(l)
+		lits, exprs := splitExpressions(l)
+		for _, lit := range lits {
+			if strings.Contains(lit, ",") {
+				rule.Errorf(
+					key.Pos,
+					"cache key %q at %q input contains invalid character \",\". commas are not allowed in cache keys",
+					l,
+					input,
+				)
+				return
+			}
+		}
+		if len(exprs) == 0 && len(l) > maxCacheKeyLength {
+			rule.Errorf(
+				key.Pos,
+				"cache key at %q input is too long. its length is %d but the max length of cache keys is %d",
+				input,
+				len(l),
+				maxCacheKeyLength,
+			)
+			return
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleArtifactNameCheckInputs(t *testing.T) {
+	tests := []struct {
+		what   string
+		uses   string
+		inputs map[string]string
+		want   string
+	}{
+		{
+			what:   "valid artifact name",
+			uses:   "actions/upload-artifact@v4",
+			inputs: map[string]string{"name": "dist-linux_x64.1"},
+		},
+		{
+			what:   "slash in artifact name",
+			uses:   "actions/upload-artifact@v4",
+			inputs: map[string]string{"name": "dist/linux"},
+			want:   `contains invalid character "/"`,
+		},
+		{
+			what:   "double quote in artifact name",
+			uses:   "actions/download-artifact@v4",
+			inputs: map[string]string{"name": `"dist"`},
+			want:   `contains invalid character "\""`,
+		},
+		{
+			what:   "trailing newline of block scalar",
+			uses:   "actions/upload-artifact@v4",
+			inputs: map[string]string{"name": "dist\n"},
+		},
+		{
+			what:   "invalid character in expression",
+			uses:   "actions/upload-artifact@v4",
+			inputs: map[string]string{"name": "dist-${{ format('{0}:{1}', runner.os, runner.arch) }}"},
+		},
+		{
+			what:   "head_ref in artifact name",
+			uses:   "actions/upload-artifact@v3",
+			inputs: map[string]string{"name": "dist-${{github.head_ref}}"},
+			want:   `contains "${{ github.head_ref }}" which can include "/"`,
+		},
+		{
+			what:   "ref_name in artifact name",
+			uses:   "actions/upload-artifact@v4",
+			inputs: map[string]string{"name": "dist-${{ github.ref_name }}"},
+			want:   `contains "${{ github.ref_name }}" which can include "/"`,
+		},
+		{
+			what:   "ref in artifact name",
+			uses:   "actions/upload-artifact@v4",
+			inputs: map[string]string{"name": "${{ github.ref }}"},
+			want:   `contains "${{ github.ref }}" which always includes "/"`,
+		},
+		{
+			what:   "other action",
+			uses:   "actions/checkout@v4",
+			inputs: map[string]string{"name": "a/b"},
+		},
+		{
+			what:   "valid cache key",
+			uses:   "actions/cache@v4",
+			inputs: map[string]string{"key": "${{ runner.os }}/go-${{ hashFiles('**/go.sum') }}"},
+		},
+		{
+			what:   "comma in cache key",
+			uses:   "actions/cache/save@v4",
+			inputs: map[string]string{"key": "go,${{ runner.os }}"},
+			want:   `cache key "go,${{ runner.os }}" at "key" input contains invalid character ","`,
+		},
+		{
+			what:   "comma in restore keys",
+			uses:   "actions/cache@v4",
+			inputs: map[string]string{"restore-keys": "go-\n  go,\n"},
+			want:   `cache key "go," at "restore-keys" input contains invalid character ","`,
+		},
+		{
+			what:   "too long cache key",
+			uses:   "actions/cache@v4",
+			inputs: map[string]string{"key": strings.Repeat("a", 513)},
+			want:   "its length is 513 but the max length of cache keys is 512",
+		},
+		{
+			what:   "long cache key with expression",
+			uses:   "actions/cache@v4",
+			inputs: map[string]string{"key": strings.Repeat("a", 513) + "${{ runner.os }}"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			inputs := map[string]*Input{}
+			for k, v := range tc.inputs {
+				inputs[k] = &Input{
+					Name:  &String{Value: k, Pos: &Pos{}},
+					Value: &String{Value: v, Pos: &Pos{}},
+				}
+			}
+			s := &Step{
+				Exec: &ExecAction{
+					Uses:   &String{Value: tc.uses, Pos: &Pos{}},
+					Inputs: inputs,
+				},
+			}
+			r := NewRuleArtifactName()
+			if err := r.VisitStep(s); err != nil {
+				t.Fatal(err)
+			}
+
+			errs := r.Errs()
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			if m := errs[0].Message; !strings.Contains(m, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, m)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package actionlint
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// Words in scripts which may be file paths like `./build.sh` or `scripts/test.sh`
+	reCheckoutPathWord = regexp.MustCompile("[^\\s;&|()<>'\"`=]+")
+	// Commands changing the current directory like `cd app` or `pushd app`
+	reCheckoutPathCd = regexp.MustCompile(`^(?:cd|pushd)$`)
+)
+
+// RuleCheckoutPath is a rule checker to detect working directories and paths in scripts at "run:"
+// which assume the repository is checked out at the workspace root though actions/checkout checks
+// out the repository at another directory with "path" input. Such steps fail with "No such file or
+// directory". Paths are checked with files in the repository.
+type RuleCheckoutPath struct {
+	RuleBase
+	project     *Project
+	workflowDir *String
+	reported    map[*String]struct{}
+}
+
+// NewRuleCheckoutPath creates a new RuleCheckoutPath instance. The project parameter is used to
+// check existence of the paths in the repository. When it is nil, nothing is checked.
+func NewRuleCheckoutPath(project *Project) *RuleCheckoutPath {
+	return &RuleCheckoutPath{
+		RuleBase: RuleBase{
+			name: "checkout-path",
+			desc: "Checks for working directories and paths in scripts which assume the default location though \"actions/checkout\" checks out the repository at another path",
+		},
+		project:  project,
+		reported: map[*String]struct{}{},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleCheckoutPath) VisitWorkflowPre(n *Workflow) error {
+	if n.Defaults != nil && n.Defaults.Run != nil {
+		rule.workflowDir = n.Defaults.Run.WorkingDirectory
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleCheckoutPath) VisitJobPre(n *Job) error {
+	if rule.project == nil {
+		return nil
+	}
+
+	start := -1
+	var checkout *String
+	dir := ""
+	for i, s := range n.Steps {
+		e, ok := s.Exec.(*ExecAction)
+		if !ok || e.Uses == nil || !strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/checkout@") {
+			continue
+		}
+		if r, ok := e.Inputs["repository"]; ok && r.Value != nil && r.Value.Value != "" && r.Value.Value != "${{ github.repository }}" {
+			continue // Other repository is checked out
+		}
+		if start >= 0 {
+			rule.checkSteps(n, n.Steps[start+1:i], checkout, dir)
+			start = -1
+		}
+		p, ok := e.Inputs["path"]
+		if !ok || p.Value == nil || p.Value.ContainsExpression() {
+			continue
+		}
+		d, ok := checkoutPathRelDir(p.Value.Value)
+		if !ok || d == "." {
+			continue
+		}
+		start, checkout, dir = i, e.Uses, d
+	}
+	if start >= 0 {
+		rule.checkSteps(n, n.Steps[start+1:], checkout, dir)
+	}
+	return nil
+}
+
+// checkSteps checks the steps run after the checkout step which checks out the repository at the
+// directory.
+func (rule *RuleCheckoutPath) checkSteps(n *Job, steps []*Step, checkout *String, dir string) {
+	jobDir := rule.workflowDir
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.WorkingDirectory != nil {
+		jobDir = n.Defaults.Run.WorkingDirectory
+	}
+
+	for _, s := range steps {
+		e, ok := s.Exec.(*ExecRun)
+		if !ok {
+			continue
+		}
+		wd := e.WorkingDirectory
+		if wd == nil {
+			wd = jobDir
+		}
+		d := "."
+		if wd != nil {
+			if _, ok := rule.reported[wd]; ok || wd.ContainsExpression() {
+				continue
+			}
+			p, ok := checkoutPathRelDir(wd.Value)
+			if !ok {
+				continue
+			}
+			d = p
+		}
+		if d == "." {
+			// The script runs at the workspace root
+			if e.Run != nil {
+				rule.checkScript(e, checkout, dir)
+			}
+			continue
+		}
+		if isCheckoutPathUnder(d, dir) || !rule.exists(d) {
+			continue
+		}
+		rule.reported[wd] = struct{}{}
+		rule.Errorf(
+			wd.Pos,
+			"working-directory %q is a directory in the repository but %q at line %d checks out the repository at %q with \"path\" input. the directory will not be found. did you mean %q?",
+			wd.Value,
+			checkout.Value,
+			checkout.Pos.Line,
+			dir,
+			path.Join(dir, d),
+		)
+	}
+}
+
+// checkScript checks the paths in the script run at the workspace root.
+func (rule *RuleCheckoutPath) checkScript(e *ExecRun, checkout *String, dir string) {
+	for _, l := range strings.Split(e.Run.Value, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(l), "#") {
+			continue
+		}
+		ws := reCheckoutPathWord.FindAllString(l, -1)
+		for i, w := range ws {
+			cd := i > 0 && reCheckoutPathCd.MatchString(ws[i-1])
+			if !cd && !strings.HasPrefix(w, "./") && !strings.Contains(w, "/") {
+				continue
+			}
+			if strings.ContainsAny(w, "$*?[{~:") {
+				if cd {
+					return // The directory after changing it is unknown
+				}
+				continue // Variables, globs, or URLs
+			}
+			p, ok := checkoutPathRelDir(w)
+			if !ok || p == "." || isCheckoutPathUnder(p, dir) || !rule.exists(p) {
+				if cd {
+					return // Paths after changing the directory are not relative to the workspace root
+				}
+				continue
+			}
+			rule.Errorf(
+				e.Run.Pos,
+				"%q in the script is a path in the repository but %q at line %d checks out the repository at %q with \"path\" input. the path will not be found at the workspace root. set \"working-directory: %s\" or fix the path",
+				w,
+				checkout.Value,
+				checkout.Pos.Line,
+				dir,
+				dir,
+			)
+			return // Report only the first path in the script
+		}
+	}
+}
+
+// exists returns whether the path relative to the repository root exists in the repository.
+func (rule *RuleCheckoutPath) exists(p string) bool {
+	_, err := os.Stat(filepath.Join(rule.project.RootDir(), filepath.FromSlash(p)))
+	return err == nil
+}
+
+// checkoutPathRelDir cleans the path relative to the workspace. It returns false when the path is
+// not a relative path in the workspace.
+func checkoutPathRelDir(p string) (string, bool) {
+	if p == "" || path.IsAbs(p) || strings.HasPrefix(p, "~") || strings.HasPrefix(p, "-") {
+		return "", false
+	}
+	p = path.Clean(p)
+	if p == ".." || strings.HasPrefix(p, "../") {
+		return "", false
+	}
+	return p, true
+}
+
+func isCheckoutPathUnder(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, dir+"/")
+}
@@ -0,0 +1,85 @@
+package actionlint
+
+import (
+	"regexp"
+	"strings"
+)
+
+var reGitPush = regexp.MustCompile(`\bgit\s+(?:-\S+\s+(?:\S+\s+)?)*push\b`)
+
+// Actions which push commits to the repository using the credentials persisted by actions/checkout.
+var actionsPushingWithPersistedCredentials = []string{
+	"ad-m/github-push-action",
+	"EndBug/add-and-commit",
+	"stefanzweifel/git-auto-commit-action",
+}
+
+// RuleCheckoutPersistCredentials is an opt-in rule checker to enforce `persist-credentials: false`
+// on actions/checkout. actions/checkout stores the token in .git/config by default so any
+// following step in the job can read it. This rule reports checkout steps which persist the
+// credentials though no subsequent step in the job pushes to the repository.
+type RuleCheckoutPersistCredentials struct {
+	RuleBase
+}
+
+// NewRuleCheckoutPersistCredentials creates a new RuleCheckoutPersistCredentials instance.
+func NewRuleCheckoutPersistCredentials() *RuleCheckoutPersistCredentials {
+	return &RuleCheckoutPersistCredentials{
+		RuleBase: RuleBase{
+			name: "checkout-persist-credentials",
+			desc: "Checks for \"persist-credentials: false\" at actions/checkout in jobs which don't push to the repository. This rule is opt-in",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleCheckoutPersistCredentials) VisitJobPre(n *Job) error {
+	for i, s := range n.Steps {
+		a, ok := s.Exec.(*ExecAction)
+		if !ok || a.Uses == nil || !strings.HasPrefix(a.Uses.Value, "actions/checkout@") {
+			continue
+		}
+
+		if in, ok := a.Inputs["persist-credentials"]; ok && in.Value != nil {
+			if in.Value.ContainsExpression() || strings.TrimSpace(in.Value.Value) == "false" {
+				continue
+			}
+		}
+
+		if stepsPushToRepository(n.Steps[i+1:]) {
+			continue
+		}
+
+		rule.Errorf(
+			a.Uses.Pos,
+			"%q persists the token in .git/config where any following step can read it, but no subsequent step in job %q pushes to the repository. set \"persist-credentials: false\" in \"with:\"",
+			a.Uses.Value,
+			n.ID.Value,
+		)
+	}
+	return nil
+}
+
+func stepsPushToRepository(steps []*Step) bool {
+	for _, s := range steps {
+		switch e := s.Exec.(type) {
+		case *ExecRun:
+			if e.Run != nil && reGitPush.MatchString(e.Run.Value) {
+				return true
+			}
+		case *ExecAction:
+			if e.Uses == nil {
+				continue
+			}
+			if e.Uses.ContainsExpression() {
+				return true // Unknown action may push
+			}
+			for _, p := range actionsPushingWithPersistedCredentials {
+				if strings.HasPrefix(e.Uses.Value, p+"@") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package actionlint
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// Properties identifying the commenter which are checked at "if:" conditions such as
+	// "github.event.comment.author_association" and "github.actor"
+	reCommentGuardCond = regexp.MustCompile(`(?i)\bgithub\s*\.\s*(?:event\s*\.\s*comment\s*\.\s*(?:author_association|user\s*\.\s*login)|event\s*\.\s*sender\s*\.\s*login|actor|triggering_actor)\b`)
+	// References to outputs or results of jobs like "needs.check.outputs.allowed"
+	reCommentGuardNeeds = regexp.MustCompile(`(?i)\bneeds\s*\.\s*([a-z_][a-z0-9_-]*)`)
+	// Placeholders which refer secrets like "${{ secrets.TOKEN }}" or "${{ github.token }}"
+	reCommentGuardSecret = regexp.MustCompile(`(?i)\$\{\{[^}]*\b(?:secrets\s*[.\[]|github\s*\.\s*token\b)`)
+	// Scripts which check permission or membership of the commenter via GitHub API
+	reCommentGuardCheckScript = regexp.MustCompile(`(?i)getCollaboratorPermissionLevel|checkMembershipForUser|getMembershipForUserInOrg|/collaborators/[^/\s]+/permission|/teams/[^/\s]+/memberships/|/orgs/[^/\s]+/members/|author_association`)
+	// Actions which check permission or membership of the user who triggered the workflow
+	reCommentGuardCheckAction = regexp.MustCompile(`(?i)permission|membership|team|collaborator`)
+)
+
+// commentGuardEvents is the events triggered by comments which make errors of RuleCommentGuard relevant.
+var commentGuardEvents = []string{"issue_comment", "pull_request_review_comment"}
+
+// RuleCommentGuard is a rule checker to detect jobs triggered by comments which use secrets without
+// checking the commenter. Anyone who can comment on issues and pull requests can trigger workflows
+// such as "/deploy" command workflows, and the workflows run with secrets and a write token. Steps
+// using secrets need to be guarded by author association or team membership of the commenter.
+type RuleCommentGuard struct {
+	RuleBase
+	events []string
+}
+
+// NewRuleCommentGuard creates a new RuleCommentGuard instance.
+func NewRuleCommentGuard() *RuleCommentGuard {
+	return &RuleCommentGuard{
+		RuleBase: RuleBase{
+			name: "comment-guard",
+			desc: "Checks for steps using secrets in workflows triggered by comments without checking the commenter at \"if:\"",
+		},
+	}
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RuleCommentGuard) VisitWorkflowPost(n *Workflow) error {
+	rule.events = rule.events[:0]
+	for _, e := range n.On {
+		for _, c := range commentGuardEvents {
+			if e.EventName() == c {
+				rule.events = append(rule.events, c)
+			}
+		}
+	}
+	if len(rule.events) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(n.Jobs))
+	for id := range n.Jobs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	checkers := map[string]struct{}{}
+	for _, id := range ids {
+		if rule.checksCommenter(n.Jobs[id]) {
+			checkers[id] = struct{}{}
+		}
+	}
+
+	guarded := map[string]bool{}
+	wenv := rule.envUsesSecrets(n.Env)
+	for _, id := range ids {
+		j := n.Jobs[id]
+		g := rule.isJobGuarded(n.Jobs, id, checkers, guarded, map[string]struct{}{})
+		if j.WorkflowCall != nil {
+			if !g && (j.WorkflowCall.InheritSecrets || len(j.WorkflowCall.Secrets) > 0) {
+				rule.ErrorfWithEvents(
+					j.Pos,
+					rule.events,
+					"job %q passes secrets to reusable workflow on %s without checking the commenter. anyone who can comment on issues or pull requests can run the workflow with the secrets. check the commenter at \"if:\" of the job like `contains(fromJSON('[\"OWNER\", \"MEMBER\", \"COLLABORATOR\"]'), github.event.comment.author_association)`",
+					j.ID.Value,
+					rule.eventsDesc(),
+				)
+			}
+			continue
+		}
+		rule.checkSteps(j, g, wenv || rule.envUsesSecrets(j.Env))
+	}
+	return nil
+}
+
+func (rule *RuleCommentGuard) eventsDesc() string {
+	if len(rule.events) == 1 {
+		return quotes(rule.events) + " event"
+	}
+	return quotes(rule.events) + " events"
+}
+
+// checkSteps reports the first step using secrets which is not guarded in the job. Steps following
+// a step which checks the commenter are guarded since such steps usually fail the job or set an
+// output to skip the following steps.
+func (rule *RuleCommentGuard) checkSteps(j *Job, guarded bool, env bool) {
+	for _, s := range j.Steps {
+		if guarded {
+			return
+		}
+		if !rule.isCondGuarded(s.If, nil) && rule.stepUsesSecrets(s, env) {
+			rule.ErrorfWithEvents(
+				s.Pos,
+				rule.events,
+				"step using secrets in job %q runs on %s without checking the commenter. anyone who can comment on issues or pull requests can run the step with the secrets. check the commenter at \"if:\" of the job or the step like `contains(fromJSON('[\"OWNER\", \"MEMBER\", \"COLLABORATOR\"]'), github.event.comment.author_association)`",
+				j.ID.Value,
+				rule.eventsDesc(),
+			)
+			return
+		}
+		guarded = rule.isCheckStep(s)
+	}
+}
+
+// isJobGuarded returns true when the job runs only after the commenter is checked. The job is
+// guarded when its "if:" checks the commenter or refers the outputs of the job checking the
+// commenter, or when it depends on a guarded job since the job is skipped when the dependency is
+// skipped or failed.
+func (rule *RuleCommentGuard) isJobGuarded(jobs map[string]*Job, id string, checkers map[string]struct{}, memo map[string]bool, visiting map[string]struct{}) bool {
+	if g, ok := memo[id]; ok {
+		return g
+	}
+	j, ok := jobs[id]
+	if !ok {
+		return false
+	}
+	if _, ok := visiting[id]; ok {
+		return false // Cyclic dependencies are reported by job-needs rule
+	}
+	visiting[id] = struct{}{}
+
+	g := rule.isCondGuarded(j.If, checkers)
+	for _, n := range j.Needs {
+		if g {
+			break
+		}
+		d := strings.ToLower(n.Value)
+		if _, ok := checkers[d]; ok {
+			g = true
+			break
+		}
+		g = rule.isJobGuarded(jobs, d, checkers, memo, visiting)
+	}
+
+	memo[id] = g
+	return g
+}
+
+// isCondGuarded returns true when the "if:" condition checks the commenter directly or refers the
+// outputs of the jobs which check the commenter.
+func (rule *RuleCommentGuard) isCondGuarded(cond *String, checkers map[string]struct{}) bool {
+	if cond == nil {
+		return false
+	}
+	if reCommentGuardCond.MatchString(cond.Value) {
+		return true
+	}
+	for _, m := range reCommentGuardNeeds.FindAllStringSubmatch(cond.Value, -1) {
+		if _, ok := checkers[strings.ToLower(m[1])]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// checksCommenter returns true when the job checks the commenter at its "if:" or its steps.
+func (rule *RuleCommentGuard) checksCommenter(j *Job) bool {
+	if j.If != nil && reCommentGuardCond.MatchString(j.If.Value) {
+		return true
+	}
+	for _, s := range j.Steps {
+		if rule.isCheckStep(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCheckStep returns true when the step checks permission or membership of the commenter.
+func (rule *RuleCommentGuard) isCheckStep(s *Step) bool {
+	switch e := s.Exec.(type) {
+	case *ExecRun:
+		return e.Run != nil && reCommentGuardCheckScript.MatchString(e.Run.Value)
+	case *ExecAction:
+		if e.Uses == nil {
+			return false
+		}
+		if strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/github-script@") {
+			i, ok := e.Inputs["script"]
+			return ok && i.Value != nil && reCommentGuardCheckScript.MatchString(i.Value.Value)
+		}
+		spec, _, _ := strings.Cut(e.Uses.Value, "@")
+		return reCommentGuardCheckAction.MatchString(spec)
+	}
+	return false
+}
+
+func (rule *RuleCommentGuard) envUsesSecrets(env *Env) bool {
+	if env == nil {
+		return false
+	}
+	if env.Expression != nil {
+		return reCommentGuardSecret.MatchString(env.Expression.Value)
+	}
+	for _, v := range env.Vars {
+		if v.Value != nil && reCommentGuardSecret.MatchString(v.Value.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// stepUsesSecrets returns true when the step refers secrets. The env parameter is true when the
+// secrets are given via environment variables of the job or the workflow.
+func (rule *RuleCommentGuard) stepUsesSecrets(s *Step, env bool) bool {
+	if env || rule.envUsesSecrets(s.Env) {
+		return true
+	}
+	switch e := s.Exec.(type) {
+	case *ExecRun:
+		return e.Run != nil && reCommentGuardSecret.MatchString(e.Run.Value)
+	case *ExecAction:
+		for _, i := range e.Inputs {
+			if i.Value != nil && reCommentGuardSecret.MatchString(i.Value.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
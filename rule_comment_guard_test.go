@@ -0,0 +1,80 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleCommentGuard(t *testing.T) {
+	steps := "    runs-on: ubuntu-latest\n    steps:\n      - run: ./deploy.sh '${{ secrets.TOKEN }}'\n"
+	tests := []struct {
+		what string
+		src  string
+		want string
+	}{
+		{
+			what: "not triggered by comments",
+			src:  "on: [push, issues]\njobs:\n  test:\n" + steps,
+		},
+		{
+			what: "both comment events",
+			src:  "on: [issue_comment, pull_request_review_comment]\njobs:\n  test:\n" + steps,
+			want: `runs on "issue_comment", "pull_request_review_comment" events without checking the commenter`,
+		},
+		{
+			what: "no secret",
+			src:  "on: issue_comment\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo '${{ github.event.comment.id }}'\n",
+		},
+		{
+			what: "secret at workflow env",
+			src:  "on: issue_comment\nenv:\n  TOKEN: ${{ secrets.TOKEN }}\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: ./deploy.sh\n",
+			want: `step using secrets in job "test" runs on "issue_comment" event`,
+		},
+		{
+			what: "commenter login",
+			src:  "on: issue_comment\njobs:\n  test:\n    if: github.event.comment.user.login == 'octocat'\n" + steps,
+		},
+		{
+			what: "transitive dependency",
+			src:  "on: issue_comment\njobs:\n  a:\n    if: github.event.comment.author_association == 'OWNER'\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n  b:\n    needs: a\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n  c:\n    needs: [b]\n" + steps,
+		},
+		{
+			what: "check with gh api",
+			src:  "on: issue_comment\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: gh api \"repos/$REPO/collaborators/$LOGIN/permission\" --jq '.permission' | grep -q admin\n      - run: ./deploy.sh '${{ secrets.TOKEN }}'\n",
+		},
+		{
+			what: "cyclic dependency",
+			src:  "on: issue_comment\njobs:\n  a:\n    needs: b\n" + steps + "  b:\n    needs: a\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			want: `step using secrets in job "a"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			w, errs := Parse([]byte(tc.src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleCommentGuard()
+			v := NewVisitor()
+			v.AddPass(r)
+			if err := v.Visit(w); err != nil {
+				t.Fatal(err)
+			}
+
+			errs = r.Errs()
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted 1 error but got %d: %v", len(errs), errs)
+			}
+			if !strings.Contains(errs[0].Message, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, errs[0].Message)
+			}
+		})
+	}
+}
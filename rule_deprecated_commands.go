@@ -1,8 +1,33 @@
 package actionlint
 
-import "regexp"
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
 
-var deprecatedCommandsPattern = regexp.MustCompile(`(?:::(save-state|set-output|set-env)\s+name=[a-zA-Z][a-zA-Z_-]*::\S+|::(add-path)::\S+)`)
+// The command and its parameters may be separated by closing and opening quotes like
+// `echo '::set-output' "name=$NAME::$VALUE"`. The name parameter may be a shell variable or
+// a format specifier of printf such as `printf '::set-output name=%s::%s\n' foo bar`.
+var deprecatedCommandsPattern = regexp.MustCompile(`(?:::(save-state|set-output|set-env)['"]*\s+['"]*name=[a-zA-Z$%][^:\n]*::|::(add-path)::)`)
+
+// reLineContinuation matches a backslash at end of line. The command can be split into multiple
+// lines with it.
+var reLineContinuation = regexp.MustCompile(`\\\r?\n`)
+
+// deprecatedCommandsFixablePattern matches a line which only runs one deprecated command with echo
+// like `echo "::set-output name=foo::$VALUE"`. Such lines can be fixed automatically.
+var deprecatedCommandsFixablePattern = regexp.MustCompile(`^echo\s+(["'])::(save-state|set-output|set-env|add-path)(?: name=([a-zA-Z_][a-zA-Z0-9_-]*))?::(.*)(["'])$`)
+
+// deprecatedCommandsFiles is a map from deprecated commands to the environment variables of files
+// replacing them.
+var deprecatedCommandsFiles = map[string]string{
+	"save-state": "GITHUB_STATE",
+	"set-output": "GITHUB_OUTPUT",
+	"set-env":    "GITHUB_ENV",
+	"add-path":   "GITHUB_PATH",
+}
 
 // RuleDeprecatedCommands is a rule checker to detect deprecated workflow commands. Currently
 // 'set-state', 'set-output', `set-env' and 'add-path' are detected as deprecated.
@@ -11,25 +36,43 @@ var deprecatedCommandsPattern = regexp.MustCompile(`(?:::(save-state|set-output|
 // - https://github.blog/changelog/2022-10-11-github-actions-deprecating-save-state-and-set-output-commands/
 type RuleDeprecatedCommands struct {
 	RuleBase
+	src []byte
 }
 
 // NewRuleDeprecatedCommands creates a new RuleDeprecatedCommands instance.
 func NewRuleDeprecatedCommands() *RuleDeprecatedCommands {
+	return NewRuleDeprecatedCommandsWithSource(nil)
+}
+
+// NewRuleDeprecatedCommandsWithSource creates a new RuleDeprecatedCommands instance with the source
+// of the workflow, which is used to build the edits to replace the deprecated commands.
+func NewRuleDeprecatedCommandsWithSource(src []byte) *RuleDeprecatedCommands {
 	return &RuleDeprecatedCommands{
 		RuleBase: RuleBase{
 			name: "deprecated-commands",
 			desc: "Checks for deprecated \"set-output\", \"save-state\", \"set-env\", and \"add-path\" commands at \"run:\"",
 		},
+		src: src,
 	}
 }
 
 // VisitStep is callback when visiting Step node.
 func (rule *RuleDeprecatedCommands) VisitStep(n *Step) error {
 	if r, ok := n.Exec.(*ExecRun); ok && r.Run != nil {
-		for _, m := range deprecatedCommandsPattern.FindAllStringSubmatch(r.Run.Value, -1) {
-			c := m[1]
-			if len(c) == 0 {
-				c = m[2]
+		// Commands in folded scalars and heredocs are matched as-is. Only line continuations need
+		// to be joined
+		src := r.Run.Value
+		joined := false
+		if strings.Contains(src, "\\") {
+			src = reLineContinuation.ReplaceAllString(src, "")
+			joined = len(src) != len(r.Run.Value)
+		}
+		for _, m := range deprecatedCommandsPattern.FindAllStringSubmatchIndex(src, -1) {
+			var c string
+			if m[2] >= 0 {
+				c = src[m[2]:m[3]]
+			} else {
+				c = src[m[4]:m[5]]
 			}
 
 			var a string
@@ -46,8 +89,14 @@ func (rule *RuleDeprecatedCommands) VisitStep(n *Step) error {
 				panic("unreachable")
 			}
 
-			rule.Errorf(
+			var fixes []*TextEdit
+			if !joined {
+				fixes = rule.fixCommand(r.Run, m[0])
+			}
+
+			rule.ErrorfWithFixes(
 				r.Run.Pos,
+				fixes,
 				"workflow command %q was deprecated. use `%s` instead: https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions",
 				c,
 				a,
@@ -56,3 +105,44 @@ func (rule *RuleDeprecatedCommands) VisitStep(n *Step) error {
 	}
 	return nil
 }
+
+// fixCommand returns the edit to replace the line of the deprecated command at the offset in the
+// script. Only a line which consists of one echo command is fixed. The line must appear only once in
+// the source of the script so that the edit is never applied to other places.
+func (rule *RuleDeprecatedCommands) fixCommand(run *String, offset int) []*TextEdit {
+	s := run.Value
+	start := strings.LastIndexByte(s[:offset], '\n') + 1
+	end := len(s)
+	if i := strings.IndexByte(s[offset:], '\n'); i >= 0 {
+		end = offset + i
+	}
+	line := strings.TrimSpace(s[start:end])
+	m := deprecatedCommandsFixablePattern.FindStringSubmatch(line)
+	if m == nil || m[1] != m[5] || strings.Contains(m[4], m[1]) || (m[2] == "add-path") != (m[3] == "") {
+		return nil
+	}
+	q, c, name, value := m[1], m[2], m[3], m[4]
+	if name != "" {
+		value = name + "=" + value
+	}
+	repl := fmt.Sprintf(`echo %s%s%s >> "$%s"`, q, value, q, deprecatedCommandsFiles[c])
+
+	if strings.Count(s, line) != 1 || run.Pos == nil {
+		return nil
+	}
+	// The script starts at the line of "run:" or at the next line when it is a block scalar
+	rs, _, ok := lineRange(rule.src, run.Pos.Line)
+	if !ok {
+		return nil
+	}
+	re := len(rule.src)
+	if _, e, ok := lineRange(rule.src, run.Pos.Line+strings.Count(s, "\n")+1); ok {
+		re = e
+	}
+	region := rule.src[rs:re]
+	if bytes.Count(region, []byte(line)) != 1 {
+		return nil
+	}
+	o := rs + bytes.Index(region, []byte(line))
+	return []*TextEdit{{o, o + len(line), repl}}
+}
@@ -83,6 +83,41 @@ func TestRuleDeprecatedCommandsDetectTargetCommands(t *testing.T) {
 			run:  "::save-state name=-foo::42",
 			want: []string{},
 		},
+		{
+			what: "shell variable in name",
+			run:  `echo "::set-output name=$NAME::$VALUE"`,
+			want: []string{"set-output"},
+		},
+		{
+			what: "format specifier of printf in name",
+			run:  `printf '::set-output name=%s::%s\n' foo bar`,
+			want: []string{"set-output"},
+		},
+		{
+			what: "command and arguments in separate quotes",
+			run:  `echo '::set-env' "name=foo::42"`,
+			want: []string{"set-env"},
+		},
+		{
+			what: "line continuation",
+			run:  "echo \"::save-state \\\n  name=foo::42\"",
+			want: []string{"save-state"},
+		},
+		{
+			what: "line continuation with CRLF",
+			run:  "echo \"::save-state\\\r\n name=foo::42\"",
+			want: []string{"save-state"},
+		},
+		{
+			what: "heredoc",
+			run:  "cat <<EOS\n::set-output name=foo::42\nEOS",
+			want: []string{"set-output"},
+		},
+		{
+			what: "empty value",
+			run:  `echo "::set-output name=foo::"`,
+			want: []string{"set-output"},
+		},
 		{
 			what: "different argument",
 			run:  "::save-state myname=foo::42",
@@ -0,0 +1,215 @@
+package actionlint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+//go:generate go run ./scripts/generate-deprecations ./deprecations.go
+
+// DeprecationKind is a kind of things deprecated by GitHub.
+type DeprecationKind uint8
+
+const (
+	// DeprecationKindAction is a kind of deprecated versions of GitHub-managed actions like
+	// "actions/upload-artifact@v3".
+	DeprecationKindAction DeprecationKind = iota
+	// DeprecationKindRuntime is a kind of deprecated runtimes of actions like "node16".
+	DeprecationKindRuntime
+	// DeprecationKindRunner is a kind of deprecated runner images like "ubuntu-20.04".
+	DeprecationKindRunner
+)
+
+// Deprecation is an entry of the calendar of deprecations by GitHub.
+type Deprecation struct {
+	// Kind is a kind of the deprecated thing.
+	Kind DeprecationKind
+	// Target is the deprecated thing. It is an action spec like "actions/upload-artifact@v3" for
+	// DeprecationKindAction, a value of "runs.using" in action metadata like "node16" for
+	// DeprecationKindRuntime, and a runner label like "ubuntu-20.04" for DeprecationKindRunner.
+	Target string
+	// Announced is a date when the deprecation was announced in "YYYY-MM-DD" format.
+	Announced string
+	// Effective is a date when the deprecated thing is no longer available in "YYYY-MM-DD" format.
+	Effective string
+	// Replacement is an alternative of the deprecated thing. This value can be empty.
+	Replacement string
+	// URL is a URL of the announcement.
+	URL string
+	// Actions is a list of known popular actions affected by the deprecation of runtime. This value
+	// is only set for DeprecationKindRuntime.
+	Actions []string
+}
+
+// matchDeprecatedAction returns true when the action spec "owner/repo@ref" is the deprecated
+// version of the action. For example, "actions/upload-artifact@v3" matches "actions/upload-artifact@v3.1.2".
+func matchDeprecatedAction(target, spec string) bool {
+	slug, ref, ok := strings.Cut(spec, "@")
+	if !ok {
+		return false
+	}
+	tslug, tref, _ := strings.Cut(target, "@")
+	return strings.EqualFold(slug, tslug) && (ref == tref || strings.HasPrefix(ref, tref+"."))
+}
+
+// matchDeprecatedRunner returns true when the runner label selects the deprecated runner image.
+// For example, "macos-12" matches "macos-12.0" and "macos-12-xlarge".
+func matchDeprecatedRunner(target, label string) bool {
+	l := strings.ToLower(label)
+	return l == target || strings.HasPrefix(l, target+"-") || strings.HasPrefix(l, target+".")
+}
+
+// RuleDeprecation is a rule checker to detect GitHub-managed actions, action runtimes, and runner
+// images which are deprecated by GitHub. The deprecations are defined in the calendar
+// GitHubDeprecations. Deprecations are reported after they were announced with their effective
+// dates so that users can migrate before the deprecated things stop working.
+type RuleDeprecation struct {
+	RuleBase
+	cache *LocalActionsCache
+	now   time.Time
+}
+
+// NewRuleDeprecation creates a new RuleDeprecation instance. The cache parameter is used to know
+// the runtimes of local actions. The now parameter is the current time to compare with the dates
+// of the deprecations.
+func NewRuleDeprecation(cache *LocalActionsCache, now time.Time) *RuleDeprecation {
+	return &RuleDeprecation{
+		RuleBase: RuleBase{
+			name: "deprecation",
+			desc: "Checks for actions, action runtimes, and runner images deprecated by GitHub with their effective dates",
+		},
+		cache: cache,
+		now:   now,
+	}
+}
+
+// when returns the phrase describing when the deprecation is effective. The second return value
+// is false when the deprecation is not announced yet.
+func (rule *RuleDeprecation) when(d *Deprecation) (string, bool) {
+	today := rule.now.UTC().Format("2006-01-02")
+	if today < d.Announced {
+		return "", false
+	}
+	if today >= d.Effective {
+		return fmt.Sprintf("is no longer available since %s", d.Effective), true
+	}
+	return fmt.Sprintf("will be unavailable from %s", d.Effective), true
+}
+
+func (rule *RuleDeprecation) report(pos *Pos, d *Deprecation, what, instead string) {
+	w, ok := rule.when(d)
+	if !ok {
+		return
+	}
+	msg := fmt.Sprintf("%s is deprecated and %s", what, w)
+	if instead != "" {
+		msg += ". " + instead
+	}
+	rule.Error(pos, msg+". see "+d.URL)
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleDeprecation) VisitJobPre(n *Job) error {
+	if n.RunsOn == nil {
+		return nil
+	}
+
+	var m *Matrix
+	if n.Strategy != nil {
+		m = n.Strategy.Matrix
+	}
+
+	labels := n.RunsOn.Labels
+	if n.RunsOn.LabelsExpr != nil {
+		labels = []*String{n.RunsOn.LabelsExpr}
+	}
+	for _, l := range labels {
+		if l.ContainsExpression() {
+			for _, s := range runnerLabelsInMatrix(l, m) {
+				rule.checkRunner(s)
+			}
+		} else {
+			rule.checkRunner(l)
+		}
+	}
+	return nil
+}
+
+func (rule *RuleDeprecation) checkRunner(label *String) {
+	for _, d := range GitHubDeprecations {
+		if d.Kind != DeprecationKindRunner || !matchDeprecatedRunner(d.Target, label.Value) {
+			continue
+		}
+		instead := ""
+		if d.Replacement != "" {
+			instead = fmt.Sprintf("use %q instead", d.Replacement)
+		}
+		what := fmt.Sprintf("runner image %q", d.Target)
+		if !strings.EqualFold(label.Value, d.Target) {
+			what = fmt.Sprintf("runner image %q selected by label %q", d.Target, label.Value)
+		}
+		rule.report(label.Pos, d, what, instead)
+		return
+	}
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleDeprecation) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil || e.Uses.ContainsExpression() {
+		return nil
+	}
+	spec := e.Uses.Value
+
+	if strings.HasPrefix(spec, "./") {
+		rule.checkLocalAction(e.Uses)
+		return nil
+	}
+
+	for _, d := range GitHubDeprecations {
+		switch d.Kind {
+		case DeprecationKindAction:
+			if matchDeprecatedAction(d.Target, spec) {
+				instead := ""
+				if d.Replacement != "" {
+					instead = fmt.Sprintf("use %q instead", d.Replacement)
+				}
+				rule.report(e.Uses.Pos, d, fmt.Sprintf("action %q", spec), instead)
+				return nil
+			}
+		case DeprecationKindRuntime:
+			for _, a := range d.Actions {
+				if matchDeprecatedAction(a, spec) {
+					rule.reportRuntime(e.Uses.Pos, d, spec)
+					return nil
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (rule *RuleDeprecation) checkLocalAction(uses *String) {
+	if rule.cache == nil {
+		return
+	}
+	meta, _, err := rule.cache.FindMetadata(uses.Value)
+	if err != nil || meta == nil {
+		return // The error is reported by "action" rule
+	}
+	for _, d := range GitHubDeprecations {
+		if d.Kind == DeprecationKindRuntime && strings.EqualFold(meta.Runs.Using, d.Target) {
+			rule.reportRuntime(uses.Pos, d, uses.Value)
+			return
+		}
+	}
+}
+
+func (rule *RuleDeprecation) reportRuntime(pos *Pos, d *Deprecation, spec string) {
+	instead := ""
+	if d.Replacement != "" {
+		instead = fmt.Sprintf("update the action to a version running on %q", d.Replacement)
+	}
+	rule.report(pos, d, fmt.Sprintf("action %q runs on %q runtime which", spec, d.Target), instead)
+}
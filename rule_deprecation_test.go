@@ -0,0 +1,125 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRuleDeprecationMatch(t *testing.T) {
+	actions := []struct {
+		target string
+		spec   string
+		want   bool
+	}{
+		{"actions/upload-artifact@v3", "actions/upload-artifact@v3", true},
+		{"actions/upload-artifact@v3", "actions/upload-artifact@v3.1.2", true},
+		{"actions/upload-artifact@v3", "Actions/Upload-Artifact@v3", true},
+		{"actions/upload-artifact@v3", "actions/upload-artifact@v4", false},
+		{"actions/upload-artifact@v3", "actions/upload-artifact@v30", false},
+		{"actions/upload-artifact@v3", "actions/upload-artifact/merge@v3", false},
+		{"actions/upload-artifact@v3", "actions/upload-artifact", false},
+	}
+	for _, tc := range actions {
+		if have := matchDeprecatedAction(tc.target, tc.spec); have != tc.want {
+			t.Errorf("match of action %q with %q should be %v but got %v", tc.target, tc.spec, tc.want, have)
+		}
+	}
+
+	runners := []struct {
+		target string
+		label  string
+		want   bool
+	}{
+		{"macos-12", "macos-12", true},
+		{"macos-12", "macos-12.0", true},
+		{"macos-12", "macos-12-xlarge", true},
+		{"macos-12", "MacOS-12", true},
+		{"macos-1", "macos-12", false},
+		{"ubuntu-20.04", "ubuntu-22.04", false},
+	}
+	for _, tc := range runners {
+		if have := matchDeprecatedRunner(tc.target, tc.label); have != tc.want {
+			t.Errorf("match of runner %q with %q should be %v but got %v", tc.target, tc.label, tc.want, have)
+		}
+	}
+}
+
+func TestRuleDeprecationEffectiveDate(t *testing.T) {
+	d := &Deprecation{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/upload-artifact@v3",
+		Announced:   "2024-04-16",
+		Effective:   "2025-01-30",
+		Replacement: "actions/upload-artifact@v4",
+		URL:         "https://example.com",
+	}
+
+	tests := []struct {
+		what string
+		now  string
+		want string
+	}{
+		{
+			what: "not announced yet",
+			now:  "2024-04-15T23:00:00Z",
+		},
+		{
+			what: "announced",
+			now:  "2024-04-16T00:00:00Z",
+			want: "will be unavailable from 2025-01-30",
+		},
+		{
+			what: "day before effective",
+			now:  "2025-01-29T12:00:00Z",
+			want: "will be unavailable from 2025-01-30",
+		},
+		{
+			what: "effective",
+			now:  "2025-01-30T00:00:00Z",
+			want: "is no longer available since 2025-01-30",
+		},
+		{
+			what: "after effective",
+			now:  "2026-01-01T00:00:00Z",
+			want: "is no longer available since 2025-01-30",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			now, err := time.Parse(time.RFC3339, tc.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := NewRuleDeprecation(nil, now)
+			r.report(&Pos{}, d, `action "actions/upload-artifact@v3"`, `use "actions/upload-artifact@v4" instead`)
+
+			errs := r.Errs()
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			want := `action "actions/upload-artifact@v3" is deprecated and ` + tc.want + `. use "actions/upload-artifact@v4" instead. see https://example.com`
+			if have := errs[0].Message; have != want {
+				t.Fatalf("wanted %q but got %q", want, have)
+			}
+		})
+	}
+}
+
+func TestRuleDeprecationCalendar(t *testing.T) {
+	for _, d := range GitHubDeprecations {
+		if _, err := time.Parse("2006-01-02", d.Effective); err != nil {
+			t.Errorf("effective date of %q is invalid: %v", d.Target, err)
+		}
+		if d.Kind == DeprecationKindRunner && d.Target != strings.ToLower(d.Target) {
+			t.Errorf("runner label %q must be in lower case", d.Target)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package actionlint
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Inputs of workflow_dispatch event in placeholders like "${{ inputs.cmd }}" or "${{ github.event.inputs.cmd }}"
+	reDispatchInputPlaceholder = regexp.MustCompile(`\$\{\{\s*(?:github\.event\.)?inputs\.([a-zA-Z_][a-zA-Z0-9_-]*)\s*\}\}`)
+	// Separators of commands in shell script. Keywords of compound commands are also separators
+	reDispatchInputCommandSep = regexp.MustCompile("\\|\\||&&|[;|&\\n(`{]|\\b(?:then|do|else)\\s")
+	// Prefix of a command such as `sudo` and `FOO=bar`. An input following the prefix is executed as a command
+	reDispatchInputCommandPrefix = regexp.MustCompile(`^\s*(?:(?:sudo|exec|command|env|time|nohup)\s+|[A-Za-z_][A-Za-z0-9_]*=\S*\s+)*["']?$`)
+	// Commands which evaluate their argument as a script like `eval "..."` or `bash -c "..."`
+	reDispatchInputEvalCommand = regexp.MustCompile(`(?:^|\s)(eval|source|\.|iex|Invoke-Expression|(?:sh|bash|zsh|dash|ksh|python[0-9.]*|perl|ruby|node|pwsh|powershell)(?:\s+-[a-zA-Z]+)*\s+-(?:c|e|Command))\s+["']?$`)
+)
+
+// dispatchInputEvents is the event which makes errors of RuleDispatchInputCommand relevant.
+var dispatchInputEvents = []string{"workflow_dispatch"}
+
+// RuleDispatchInputCommand is a rule checker to detect string inputs of workflow_dispatch event
+// which are executed as shell commands at "run:" or evaluated as scripts. Anyone who can dispatch
+// the workflow can set any value to the inputs so they are semi-trusted. Interpolating them into
+// arguments of commands is common but executing them allows running arbitrary commands.
+type RuleDispatchInputCommand struct {
+	RuleBase
+	inputs map[string]string
+}
+
+// NewRuleDispatchInputCommand creates a new RuleDispatchInputCommand instance.
+func NewRuleDispatchInputCommand() *RuleDispatchInputCommand {
+	return &RuleDispatchInputCommand{
+		RuleBase: RuleBase{
+			name: "dispatch-input-command",
+			desc: "Checks for string inputs of \"workflow_dispatch\" event executed as shell commands at \"run:\" or evaluated as scripts",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleDispatchInputCommand) VisitWorkflowPre(n *Workflow) error {
+	rule.inputs = nil
+	e, ok := n.FindWorkflowDispatchEvent()
+	if !ok {
+		return nil
+	}
+
+	c := rule.Config().Rule(rule.Name())
+	for id, i := range e.Inputs {
+		switch i.Type {
+		case WorkflowDispatchEventInputTypeNone, WorkflowDispatchEventInputTypeString:
+		default:
+			continue // Values of other types are restricted
+		}
+		if c != nil && matchGlobFilter(c.Allow, i.Name.Value) {
+			rule.Debug("Input %q is trusted by config", i.Name.Value)
+			continue
+		}
+		if rule.inputs == nil {
+			rule.inputs = map[string]string{}
+		}
+		rule.inputs[id] = i.Name.Value
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleDispatchInputCommand) VisitStep(n *Step) error {
+	if len(rule.inputs) == 0 {
+		return nil
+	}
+
+	switch e := n.Exec.(type) {
+	case *ExecRun:
+		if e.Run != nil {
+			rule.checkRun(e.Run)
+		}
+	case *ExecAction:
+		if e.Uses == nil || !strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/github-script@") {
+			return nil
+		}
+		if s, ok := e.Inputs["script"]; ok && s.Value != nil {
+			rule.checkScript(s.Value, e.Uses.Value)
+		}
+	}
+	return nil
+}
+
+func (rule *RuleDispatchInputCommand) checkRun(run *String) {
+	src := run.Value
+	if strings.Contains(src, "\\") {
+		src = reLineContinuation.ReplaceAllString(src, " ")
+	}
+
+	seen := map[string]struct{}{}
+	for _, m := range reDispatchInputPlaceholder.FindAllStringSubmatchIndex(src, -1) {
+		name, ok := rule.inputs[strings.ToLower(src[m[2]:m[3]])]
+		if !ok {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+
+		before := src[:m[0]]
+		if s := reDispatchInputCommandSep.FindAllStringIndex(before, -1); len(s) > 0 {
+			before = before[s[len(s)-1][1]:]
+		}
+
+		if reDispatchInputCommandPrefix.MatchString(before) {
+			rule.ErrorfWithEvents(
+				run.Pos,
+				dispatchInputEvents,
+				"string input %q of \"workflow_dispatch\" event is executed as a command at \"run:\". anyone who can dispatch the workflow can run arbitrary commands in the job. use the input as an argument of a fixed command, or add %q to \"allow\" of \"dispatch-input-command\" rule in actionlint.yaml if the input is trusted",
+				name,
+				name,
+			)
+			seen[name] = struct{}{}
+			continue
+		}
+		if e := reDispatchInputEvalCommand.FindStringSubmatch(before); e != nil {
+			rule.ErrorfWithEvents(
+				run.Pos,
+				dispatchInputEvents,
+				"string input %q of \"workflow_dispatch\" event is evaluated as a script by %q at \"run:\". anyone who can dispatch the workflow can run arbitrary commands in the job. use the input as an argument of a fixed command, or add %q to \"allow\" of \"dispatch-input-command\" rule in actionlint.yaml if the input is trusted",
+				name,
+				e[1],
+				name,
+			)
+			seen[name] = struct{}{}
+		}
+	}
+}
+
+func (rule *RuleDispatchInputCommand) checkScript(script *String, action string) {
+	seen := map[string]struct{}{}
+	for _, m := range reDispatchInputPlaceholder.FindAllStringSubmatch(script.Value, -1) {
+		name, ok := rule.inputs[strings.ToLower(m[1])]
+		if !ok {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		rule.ErrorfWithEvents(
+			script.Pos,
+			dispatchInputEvents,
+			"string input %q of \"workflow_dispatch\" event is embedded in \"script\" input of %q. anyone who can dispatch the workflow can run arbitrary JavaScript code in the job. pass the input via an environment variable and read it with \"process.env\", or add %q to \"allow\" of \"dispatch-input-command\" rule in actionlint.yaml if the input is trusted",
+			name,
+			action,
+			name,
+		)
+		seen[name] = struct{}{}
+	}
+}
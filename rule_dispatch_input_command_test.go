@@ -0,0 +1,153 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleDispatchInputCommandDetectCommands(t *testing.T) {
+	tests := []struct {
+		what string
+		run  string
+		want []string
+	}{
+		{
+			what: "command",
+			run:  "${{ inputs.cmd }}",
+			want: []string{"executed"},
+		},
+		{
+			what: "event inputs",
+			run:  "${{ github.event.inputs.cmd }} --verbose",
+			want: []string{"executed"},
+		},
+		{
+			what: "after separator",
+			run:  "cd dir && ${{ inputs.cmd }}",
+			want: []string{"executed"},
+		},
+		{
+			what: "with prefix",
+			run:  "sudo FOO=bar ${{ inputs.cmd }}",
+			want: []string{"executed"},
+		},
+		{
+			what: "in compound command",
+			run:  "if true; then ${{ inputs.cmd }}; fi",
+			want: []string{"executed"},
+		},
+		{
+			what: "command substitution",
+			run:  "out=$(${{ inputs.cmd }})",
+			want: []string{"executed"},
+		},
+		{
+			what: "eval",
+			run:  `eval "${{ inputs.cmd }}"`,
+			want: []string{"evaluated"},
+		},
+		{
+			what: "python -c",
+			run:  "python3 -u -c '${{ inputs.cmd }}'",
+			want: []string{"evaluated"},
+		},
+		{
+			what: "line continuation",
+			run:  "echo foo && \\\n  ${{ inputs.cmd }}",
+			want: []string{"executed"},
+		},
+		{
+			what: "reported once per input",
+			run:  "${{ inputs.cmd }}\n${{ inputs.cmd }}",
+			want: []string{"executed"},
+		},
+		{
+			what: "argument",
+			run:  "./deploy.sh ${{ inputs.cmd }}",
+			want: []string{},
+		},
+		{
+			what: "quoted argument",
+			run:  `echo "${{ inputs.cmd }}"`,
+			want: []string{},
+		},
+		{
+			what: "allowed",
+			run:  "${{ inputs.trusted }}",
+			want: []string{},
+		},
+		{
+			what: "boolean input",
+			run:  "${{ inputs.dry-run }}",
+			want: []string{},
+		},
+		{
+			what: "unknown input",
+			run:  "${{ inputs.unknown }}",
+			want: []string{},
+		},
+	}
+
+	w := &Workflow{
+		On: []Event{
+			&WorkflowDispatchEvent{
+				Inputs: map[string]*DispatchInput{
+					"cmd": {
+						Name: &String{Value: "cmd"},
+						Type: WorkflowDispatchEventInputTypeString,
+					},
+					"trusted": {
+						Name: &String{Value: "trusted"},
+					},
+					"dry-run": {
+						Name: &String{Value: "dry-run"},
+						Type: WorkflowDispatchEventInputTypeBoolean,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			s := &Step{
+				Exec: &ExecRun{
+					Run: &String{
+						Value: tc.run,
+						Pos:   &Pos{},
+					},
+				},
+			}
+			r := NewRuleDispatchInputCommand()
+			r.SetConfig(&Config{
+				Rules: map[string]*RuleConfig{
+					"dispatch-input-command": {Allow: []string{"trusted"}},
+				},
+			})
+			if err := r.VisitWorkflowPre(w); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.VisitStep(s); err != nil {
+				t.Fatal(err)
+			}
+
+			have := []string{}
+			for _, err := range r.Errs() {
+				switch m := err.Message; {
+				case strings.Contains(m, " is executed as a command "):
+					have = append(have, "executed")
+				case strings.Contains(m, " is evaluated as a script "):
+					have = append(have, "evaluated")
+				default:
+					t.Fatalf("unexpected error: %q", m)
+				}
+			}
+
+			if !cmp.Equal(have, tc.want) {
+				t.Fatal(cmp.Diff(have, tc.want))
+			}
+		})
+	}
+}
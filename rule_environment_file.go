@@ -0,0 +1,195 @@
+package actionlint
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// echo or printf writing to $GITHUB_OUTPUT or $GITHUB_ENV like `echo "name=value" >> "$GITHUB_OUTPUT"`.
+	// Arguments are captured until the redirection without crossing other commands.
+	reEnvironmentFileWrite = regexp.MustCompile(`(?:^|&&|;)\s*(echo|printf)\s+(?:-[a-zA-Z]+\s+)*((?:"[^"]*"|'[^']*'|[^"';&|{}])*?)\s*>>\s*["']?\$\{?(GITHUB_OUTPUT|GITHUB_ENV)\b`)
+	// Command substitutions of commands which usually output multiple lines like `$(git diff --name-only)`
+	reEnvironmentFileMultilineCommand = regexp.MustCompile("(?:\\$\\(|`)\\s*((?:cat|ls|find|git\\s+(?:diff|log|show|status))\\b[^|)`]*)(?:\\)|`)")
+)
+
+// RuleEnvironmentFile is a rule checker to detect invalid writes to $GITHUB_OUTPUT and $GITHUB_ENV
+// in scripts at "run:". The runner parses each line of the files as "{name}={value}" or
+// "{name}<<{delimiter}" followed by a multiline value and the delimiter. Names are not trimmed and
+// multiline values written without the delimiter syntax are broken.
+// https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions#environment-files
+type RuleEnvironmentFile struct {
+	RuleBase
+}
+
+// NewRuleEnvironmentFile creates a new RuleEnvironmentFile instance.
+func NewRuleEnvironmentFile() *RuleEnvironmentFile {
+	return &RuleEnvironmentFile{
+		RuleBase: RuleBase{
+			name: "environment-file",
+			desc: "Checks for invalid names and multiline values written to $GITHUB_OUTPUT and $GITHUB_ENV at \"run:\"",
+		},
+	}
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleEnvironmentFile) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecRun)
+	if !ok || e.Run == nil {
+		return nil
+	}
+
+	src := e.Run.Value
+	if strings.Contains(src, "\\") {
+		src = reLineContinuation.ReplaceAllString(src, "")
+	}
+	lines := strings.Split(src, "\n")
+	for i, l := range lines {
+		for _, m := range reEnvironmentFileWrite.FindAllStringSubmatch(l, -1) {
+			rule.checkWrite(e.Run, m[1], m[2], m[3], lines[i+1:])
+		}
+	}
+	return nil
+}
+
+// checkWrite checks the line written to the file by echo or printf command. The rest parameter is
+// the lines of the script following the command.
+func (rule *RuleEnvironmentFile) checkWrite(run *String, cmd, args, file string, rest []string) {
+	words := splitShellWords(args)
+	if len(words) == 0 {
+		return
+	}
+	line := strings.Join(words, " ")
+	if cmd == "printf" {
+		line = words[0] // Format string is written
+	}
+	// Escaped newlines are interpreted by printf and `echo -e`
+	if ls := strings.Split(line, `\n`); len(ls) > 1 {
+		line = ls[0]
+		rest = append(ls[1:], rest...)
+	}
+
+	eq := strings.IndexByte(line, '=')
+	hd := strings.Index(line, "<<")
+	if eq < 0 && hd < 0 {
+		return // Delimiter of multiline value or the entire line is given by variable
+	}
+
+	if hd >= 0 && (eq < 0 || hd < eq) {
+		name, delim := line[:hd], line[hd+2:]
+		if !rule.checkName(run, name, line, file) || delim == "" {
+			return
+		}
+		for _, l := range rest {
+			if strings.Contains(l, delim) {
+				return
+			}
+		}
+		rule.Errorf(
+			run.Pos,
+			"delimiter %q of multiline value of %q written to $%s is never written after the value. the step fails since the end of the value is not found. write the delimiter like `echo %s >> \"$%s\"` after the value",
+			delim,
+			name,
+			file,
+			delim,
+			file,
+		)
+		return
+	}
+
+	name, value := line[:eq], line[eq+1:]
+	if !rule.checkName(run, name, line, file) {
+		return
+	}
+
+	if strings.HasPrefix(value, "<<") {
+		rule.Errorf(
+			run.Pos,
+			"value of %q written to $%s is set to %q literally since \"=\" precedes \"<<\". write %q to use delimiter syntax for multiline value",
+			name,
+			file,
+			value,
+			name+value,
+		)
+		return
+	}
+
+	if cmd == "printf" {
+		value = strings.Join(append([]string{value}, words[1:]...), " ") // Arguments are embedded in the format
+	}
+	if m := reEnvironmentFileMultilineCommand.FindStringSubmatch(value); m != nil {
+		c := strings.TrimSpace(m[1])
+		rule.Errorf(
+			run.Pos,
+			"output of %q may contain multiple lines but it is written to $%s as value of %q without delimiter. the value is cut at the first newline and the following lines break the file. use delimiter syntax like `{ echo '%s<<EOF'; %s; echo EOF; } >> \"$%s\"`: https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions#multiline-strings",
+			c,
+			file,
+			name,
+			name,
+			c,
+			file,
+		)
+	}
+}
+
+// checkName checks the name of value written to the file. It returns false when the name is invalid.
+func (rule *RuleEnvironmentFile) checkName(run *String, name, line, file string) bool {
+	if strings.ContainsAny(name, "$%`") || ContainsExpression(name) {
+		return true // The name is dynamic
+	}
+	if name == "" {
+		rule.Errorf(
+			run.Pos,
+			"name of value is empty in %q written to $%s. the step fails since the name is required",
+			line,
+			file,
+		)
+		return false
+	}
+	if strings.ContainsAny(name, " \t") {
+		rule.Errorf(
+			run.Pos,
+			"name %q in %q written to $%s contains whitespace. the name is not trimmed so the value cannot be referred with the intended name. remove the whitespace around \"=\" or \"<<\"",
+			name,
+			line,
+			file,
+		)
+		return false
+	}
+	return true
+}
+
+// splitShellWords splits the arguments of shell command into words. Quotes are removed and quoted
+// strings adjacent to other strings are concatenated like shells do.
+func splitShellWords(s string) []string {
+	ws := []string{}
+	var b strings.Builder
+	in := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\'':
+			in = true
+			j := strings.IndexByte(s[i+1:], c)
+			if j < 0 {
+				b.WriteString(s[i+1:])
+				i = len(s)
+				break
+			}
+			b.WriteString(s[i+1 : i+1+j])
+			i += j + 1
+		case ' ', '\t':
+			if in {
+				ws = append(ws, b.String())
+				b.Reset()
+				in = false
+			}
+		default:
+			in = true
+			b.WriteByte(c)
+		}
+	}
+	if in {
+		ws = append(ws, b.String())
+	}
+	return ws
+}
@@ -0,0 +1,33 @@
+package actionlint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleEnvironmentFileSplitShellWords(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  []string
+	}{
+		{"", []string{}},
+		{"foo", []string{"foo"}},
+		{"  foo   bar\tbaz ", []string{"foo", "bar", "baz"}},
+		{`"foo bar"`, []string{"foo bar"}},
+		{`'foo "bar"'`, []string{`foo "bar"`}},
+		{`foo="bar baz"`, []string{"foo=bar baz"}},
+		{`"foo"'bar'baz`, []string{"foobarbaz"}},
+		{`"" ''`, []string{"", ""}},
+		{`"foo`, []string{"foo"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			have := splitShellWords(tc.input)
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
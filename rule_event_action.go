@@ -0,0 +1,165 @@
+package actionlint
+
+import (
+	"sort"
+	"strings"
+)
+
+// Webhook events whose payloads have no "action" property. They don't have activity types.
+var eventActionNoTypeEvents = map[string]struct{}{
+	"push":       {},
+	"create":     {},
+	"delete":     {},
+	"fork":       {},
+	"gollum":     {},
+	"page_build": {},
+	"public":     {},
+	"status":     {},
+}
+
+// RuleEventAction is a rule checker to detect comparisons of `github.event.action` in "if:"
+// conditions with activity types which never trigger the workflow. For example, when the workflow
+// is only triggered by "published" type of "release" event, `github.event.action == 'created'` is
+// always false.
+type RuleEventAction struct {
+	RuleBase
+	// types is a set of activity types which can trigger the workflow. nil means the types cannot
+	// be known statically.
+	types map[string]struct{}
+}
+
+// NewRuleEventAction creates a new RuleEventAction instance.
+func NewRuleEventAction() *RuleEventAction {
+	return &RuleEventAction{
+		RuleBase: RuleBase{
+			name: "event-action",
+			desc: "Checks for comparisons of github.event.action with activity types which never trigger the workflow",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleEventAction) VisitWorkflowPre(n *Workflow) error {
+	rule.types = nil
+	if len(n.On) == 0 {
+		return nil
+	}
+
+	types := map[string]struct{}{}
+	for _, e := range n.On {
+		switch e := e.(type) {
+		case *WebhookEvent:
+			name := strings.ToLower(e.Hook.Value)
+			if len(e.Types) > 0 {
+				for _, t := range e.Types {
+					types[strings.ToLower(t.Value)] = struct{}{}
+				}
+				continue
+			}
+			if _, ok := eventActionNoTypeEvents[name]; ok {
+				continue
+			}
+			ts, ok := AllWebhookTypes[name]
+			if !ok || len(ts) == 0 {
+				return nil // Activity types of this event are unknown (e.g. repository_dispatch)
+			}
+			for _, t := range ts {
+				types[t] = struct{}{}
+			}
+		case *WorkflowCallEvent:
+			return nil // The event is inherited from the caller workflow
+		}
+		// Scheduled events and workflow_dispatch events have no activity type
+	}
+
+	if len(types) > 0 {
+		rule.types = types
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleEventAction) VisitJobPre(n *Job) error {
+	rule.checkCond(n.If)
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleEventAction) VisitStep(n *Step) error {
+	rule.checkCond(n.If)
+	return nil
+}
+
+func (rule *RuleEventAction) checkCond(cond *String) {
+	if rule.types == nil || cond == nil {
+		return
+	}
+
+	src := strings.TrimSpace(cond.Value)
+	if cond.IsExpressionAssigned() {
+		src = src[3 : len(src)-2]
+	} else if cond.ContainsExpression() {
+		return
+	}
+	if !strings.Contains(src, "action") {
+		return
+	}
+	expr, err := NewExprParser().Parse(NewExprLexer(src + "}}"))
+	if err != nil {
+		return // Syntax error is reported by "expression" rule
+	}
+
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		c, ok := n.(*CompareOpNode)
+		if !ok || c.Kind != CompareOpNodeKindEq && c.Kind != CompareOpNodeKindNotEq {
+			return
+		}
+		s, ok := c.Right.(*StringNode)
+		if !ok || !isEventActionNode(c.Left) {
+			if s, ok = c.Left.(*StringNode); !ok || !isEventActionNode(c.Right) {
+				return
+			}
+		}
+		t := strings.ToLower(s.Value)
+		if _, ok := rule.types[t]; ok {
+			return
+		}
+
+		always := "false"
+		if c.Kind == CompareOpNodeKindNotEq {
+			always = "true"
+		}
+		rule.Errorf(
+			cond.Pos,
+			"\"github.event.action\" is compared with %q in \"if:\" condition but this workflow is never triggered by the activity type so the comparison is always %s. available activity types are %s. check \"types:\" filters in \"on:\" section",
+			s.Value,
+			always,
+			quotes(rule.sortedTypes()),
+		)
+	})
+}
+
+func (rule *RuleEventAction) sortedTypes() []string {
+	ts := make([]string, 0, len(rule.types))
+	for t := range rule.types {
+		ts = append(ts, t)
+	}
+	sort.Strings(ts)
+	return ts
+}
+
+func isEventActionNode(n ExprNode) bool {
+	a, ok := n.(*ObjectDerefNode)
+	if !ok || strings.ToLower(a.Property) != "action" {
+		return false
+	}
+	e, ok := a.Receiver.(*ObjectDerefNode)
+	if !ok || strings.ToLower(e.Property) != "event" {
+		return false
+	}
+	v, ok := e.Receiver.(*VariableNode)
+	return ok && strings.ToLower(v.Name) == "github"
+}
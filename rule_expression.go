@@ -1,6 +1,9 @@
 package actionlint
 
 import (
+	"encoding/json"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -25,14 +28,43 @@ type RuleExpression struct {
 	secretsTy        *ObjectType
 	inputsTy         *ObjectType
 	dispatchInputsTy *ObjectType
-	jobsTy           *ObjectType
-	workflow         *Workflow
-	localActions     *LocalActionsCache
-	localWorkflows   *LocalReusableWorkflowCache
+	// githubTy is `github` context type updated with the payloads of events and dispatchInputsTy. It
+	// is cached since creating it for each expression is costly.
+	githubTy ExprType
+	jobsTy   *ObjectType
+	jobID    string
+	workflow *Workflow
+	// events is names of the workflow triggers in lower case. It is used to annotate errors which
+	// depend on the triggers.
+	events          []string
+	localActions    *LocalActionsCache
+	remoteActions   *RemoteActionsCache
+	localWorkflows  *LocalReusableWorkflowCache
+	remoteWorkflows *RemoteReusableWorkflowCache
+	// inspected records checked expressions for Linter.InspectPosition. nil means not recording.
+	inspected []*inspectedExpr
+}
+
+// inspectedExpr is an expression checked by RuleExpression with the types of its nodes.
+type inspectedExpr struct {
+	src         string
+	placeholder bool
+	line        int
+	root        ExprNode
+	types       map[ExprNode]ExprType
+	workflowKey string
 }
 
 // NewRuleExpression creates new RuleExpression instance.
 func NewRuleExpression(actionsCache *LocalActionsCache, workflowCache *LocalReusableWorkflowCache) *RuleExpression {
+	return NewRuleExpressionWithRemote(actionsCache, nil, workflowCache, nil)
+}
+
+// NewRuleExpressionWithRemote creates new RuleExpression instance. The remoteCache parameter is used
+// to type outputs of actions which are not in the popular actions data set. The remoteWorkflowCache
+// parameter is used to type inputs and outputs of reusable workflows in other repositories. They can
+// be nil.
+func NewRuleExpressionWithRemote(actionsCache *LocalActionsCache, remoteCache *RemoteActionsCache, workflowCache *LocalReusableWorkflowCache, remoteWorkflowCache *RemoteReusableWorkflowCache) *RuleExpression {
 	return &RuleExpression{
 		RuleBase: RuleBase{
 			name: "expression",
@@ -47,12 +79,21 @@ func NewRuleExpression(actionsCache *LocalActionsCache, workflowCache *LocalReus
 		jobsTy:           nil,
 		workflow:         nil,
 		localActions:     actionsCache,
+		remoteActions:    remoteCache,
 		localWorkflows:   workflowCache,
+		remoteWorkflows:  remoteWorkflowCache,
 	}
 }
 
 // VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
 func (rule *RuleExpression) VisitWorkflowPre(n *Workflow) error {
+	rule.events = make([]string, 0, len(n.On))
+	for _, e := range n.On {
+		rule.events = append(rule.events, strings.ToLower(e.EventName()))
+	}
+	sort.Strings(rule.events)
+	rule.githubTy = nil
+
 	rule.checkString(n.Name, "")
 
 	for _, e := range n.On {
@@ -90,6 +131,7 @@ func (rule *RuleExpression) VisitWorkflowPre(n *Workflow) error {
 				ity.Props[id] = ty
 			}
 			rule.dispatchInputsTy = ity
+			rule.githubTy = nil
 		case *RepositoryDispatchEvent:
 			rule.checkStrings(e.Types, "")
 		case *WorkflowCallEvent:
@@ -162,6 +204,11 @@ func (rule *RuleExpression) VisitWorkflowPre(n *Workflow) error {
 		}
 	}
 
+	// Shared inputs with different types are allowed by GitHub (#263) so this check is opt-in
+	if rule.config.RuleEnabled(rule.Name()) {
+		rule.checkDivergentInputs(n)
+	}
+
 	rule.checkString(n.RunName, "run-name")
 	rule.checkEnv(n.Env, "env")
 
@@ -172,12 +219,75 @@ func (rule *RuleExpression) VisitWorkflowPre(n *Workflow) error {
 	return nil
 }
 
+// checkDivergentInputs checks inputs which are defined in both `workflow_call` and `workflow_dispatch`
+// events with different types or default values. Since `inputs` context is shared by both events,
+// the value of such input is ambiguous.
+func (rule *RuleExpression) checkDivergentInputs(n *Workflow) {
+	call, ok := n.FindWorkflowCallEvent()
+	if !ok || rule.inputsTy == nil {
+		return
+	}
+	dispatch, ok := n.FindWorkflowDispatchEvent()
+	if !ok || rule.dispatchInputsTy == nil {
+		return
+	}
+
+	for _, c := range call.Inputs {
+		d, ok := dispatch.Inputs[c.ID]
+		if !ok || d.Name == nil {
+			continue
+		}
+
+		ct, dt := rule.inputsTy.Props[c.ID], rule.dispatchInputsTy.Props[c.ID]
+		if ct == nil || dt == nil {
+			continue
+		}
+		if _, ok := ct.(AnyType); ok {
+			continue
+		}
+		if _, ok := dt.(AnyType); ok {
+			continue
+		}
+		if !EqualTypes(ct, dt) {
+			rule.Errorf(
+				d.Name.Pos,
+				"input %q is defined in both \"workflow_call\" and \"workflow_dispatch\" events with different types. the type is %s on \"workflow_call\" and %s on \"workflow_dispatch\" so type of \"inputs.%s\" is ambiguous. use the same type in both events or rename one of the inputs",
+				d.Name.Value,
+				ct.String(),
+				dt.String(),
+				c.ID,
+			)
+			continue
+		}
+
+		cd, dd := inputDefaultValue(c.Default), inputDefaultValue(d.Default)
+		if cd != dd {
+			rule.Errorf(
+				d.Name.Pos,
+				"input %q is defined in both \"workflow_call\" and \"workflow_dispatch\" events with different default values. the default value is %s on \"workflow_call\" and %s on \"workflow_dispatch\" so value of \"inputs.%s\" depends on how the workflow is triggered",
+				d.Name.Value,
+				cd,
+				dd,
+				c.ID,
+			)
+		}
+	}
+}
+
+func inputDefaultValue(s *String) string {
+	if s == nil {
+		return "none"
+	}
+	return strconv.Quote(s.Value)
+}
+
 // VisitWorkflowPost is callback when visiting Workflow node after visiting its children
 func (rule *RuleExpression) VisitWorkflowPost(n *Workflow) error {
 	if e, ok := n.FindWorkflowCallEvent(); ok {
 		rule.checkWorkflowCallOutputs(e.Outputs, n.Jobs)
 	}
 	rule.workflow = nil
+	rule.events = nil
 	return nil
 }
 
@@ -186,6 +296,9 @@ func (rule *RuleExpression) VisitJobPre(n *Job) error {
 	// Type of needs must be resolved before resolving type of matrix because `needs` context can
 	// be used in matrix configuration.
 	rule.needsTy = rule.calcNeedsType(n)
+	if n.ID != nil {
+		rule.jobID = n.ID.Value
+	}
 
 	// Set matrix type at start of VisitJobPre() because matrix values are available in
 	// jobs.<job_id> section. For example:
@@ -205,14 +318,7 @@ func (rule *RuleExpression) VisitJobPre(n *Job) error {
 
 	if n.RunsOn != nil {
 		if n.RunsOn.LabelsExpr != nil {
-			if ty := rule.checkOneExpression(n.RunsOn.LabelsExpr, "runner label at \"runs-on\" section", "jobs.<job_id>.runs-on"); ty != nil {
-				switch ty.(type) {
-				case *ArrayType, StringType, AnyType:
-					// OK
-				default:
-					rule.Errorf(n.RunsOn.LabelsExpr.Pos, "type of expression at \"runs-on\" must be string or array but found type %q", ty.String())
-				}
-			}
+			rule.checkRunnerLabelsExpr(n.RunsOn.LabelsExpr)
 		} else {
 			for _, l := range n.RunsOn.Labels {
 				rule.checkString(l, "jobs.<job_id>.runs-on")
@@ -256,7 +362,8 @@ func (rule *RuleExpression) VisitJobPre(n *Job) error {
 func (rule *RuleExpression) VisitJobPost(n *Job) error {
 	// 'environment' and 'outputs' sections are evaluated after all steps are run
 	if n.Environment != nil {
-		rule.checkString(n.Environment.Name, "jobs.<job_id>.environment")
+		ts := rule.checkString(n.Environment.Name, "jobs.<job_id>.environment")
+		rule.checkEnvironmentName(n.Environment.Name, ts)
 		rule.checkString(n.Environment.URL, "jobs.<job_id>.environment.url")
 	}
 	for _, output := range n.Outputs {
@@ -266,6 +373,7 @@ func (rule *RuleExpression) VisitJobPost(n *Job) error {
 	rule.matrixTy = nil
 	rule.stepsTy = nil
 	rule.needsTy = nil
+	rule.jobID = ""
 
 	return nil
 }
@@ -343,8 +451,20 @@ func (rule *RuleExpression) getActionOutputsType(spec *String) *ObjectType {
 
 	// When the action run at this step is a popular action, we know what outputs are set by it.
 	// Set the output names to `steps.{step_id}.outputs.{name}`.
-	if meta, ok := PopularActions[spec.Value]; ok {
-		return typeOfActionOutputs(meta)
+	if rule.Config().PopularActionsEnabled() {
+		if meta, ok := findPopularAction(spec.Value); ok {
+			return typeOfActionOutputs(meta)
+		}
+	}
+
+	// When online checks are enabled, outputs are typed with the metadata fetched from GitHub
+	if rule.remoteActions != nil {
+		meta, err := rule.remoteActions.FindMetadata(spec.Value)
+		if err != nil {
+			rule.Debug("Could not fetch metadata of action %s: %s", spec.Value, err)
+		} else if meta != nil {
+			return typeOfActionOutputs(meta)
+		}
 	}
 
 	return NewMapObjectType(StringType{})
@@ -360,6 +480,9 @@ func (rule *RuleExpression) getWorkflowCallOutputsType(call *WorkflowCall) *Obje
 		rule.Error(call.Uses.Pos, err.Error())
 		return NewMapObjectType(StringType{})
 	}
+	if m == nil {
+		m = rule.findRemoteWorkflowMetadata(call.Uses.Value)
+	}
 	if m == nil {
 		return NewMapObjectType(StringType{})
 	}
@@ -371,6 +494,20 @@ func (rule *RuleExpression) getWorkflowCallOutputsType(call *WorkflowCall) *Obje
 	return NewStrictObjectType(p)
 }
 
+// findRemoteWorkflowMetadata finds metadata of the reusable workflow in other repository. It returns
+// nil when online checks are disabled or the workflow could not be fetched.
+func (rule *RuleExpression) findRemoteWorkflowMetadata(spec string) *ReusableWorkflowMetadata {
+	if rule.remoteWorkflows == nil {
+		return nil
+	}
+	m, err := rule.remoteWorkflows.FindMetadata(spec)
+	if err != nil {
+		rule.Debug("Could not fetch reusable workflow %s: %s", spec, err)
+		return nil
+	}
+	return m
+}
+
 func (rule *RuleExpression) checkOneExpression(s *String, what, workflowKey string) ExprType {
 	// checkString is not available since it checks types for embedding values into a string
 	if s == nil {
@@ -518,6 +655,9 @@ func (rule *RuleExpression) checkWorkflowCall(c *WorkflowCall) {
 	if err != nil {
 		rule.Error(c.Uses.Pos, err.Error())
 	}
+	if m == nil && err == nil {
+		m = rule.findRemoteWorkflowMetadata(c.Uses.Value)
+	}
 
 	for n, i := range c.Inputs {
 		ts := rule.checkString(i.Value, "jobs.<job_id>.with.<with_id>")
@@ -613,14 +753,15 @@ func (rule *RuleExpression) checkIfCondition(str *String, workflowKey string) {
 	//   if: true && false
 
 	var condTy ExprType
-	if str.ContainsExpression() {
-		ts := rule.checkString(str, workflowKey)
-
-		if len(ts) == 1 {
-			if str.IsExpressionAssigned() {
-				condTy = ts[0].ty
-			}
+	if str.IsExpressionAssigned() {
+		// The value of `if: ${{ ... }}` is coerced to bool. Objects are often evaluated for checking
+		// their presence like `if: ${{ github.event.pull_request }}` so they are not reported
+		ts, ok := rule.checkExprsIn(str.Value, str.Pos, str.Quoted, false, workflowKey)
+		if ok && len(ts) == 1 {
+			condTy = ts[0].ty
 		}
+	} else if str.ContainsExpression() {
+		rule.checkString(str, workflowKey)
 	} else {
 		src := str.Value + "}}" // }} is necessary since lexer lexes it as end of tokens
 		line, col := str.Pos.Line, str.Pos.Col
@@ -632,7 +773,9 @@ func (rule *RuleExpression) checkIfCondition(str *String, workflowKey string) {
 			return
 		}
 
-		if ty, ok := rule.checkSemanticsOfExprNode(expr, line, col, false, workflowKey); ok {
+		ty, ok := rule.checkSemanticsOfExprNode(expr, line, col, false, workflowKey)
+		rule.setInspectedSource(str.Value, false)
+		if ok {
 			condTy = ty
 		}
 	}
@@ -665,6 +808,195 @@ func (rule *RuleExpression) checkString(str *String, workflowKey string) []typed
 	return ts
 }
 
+// checkRunnerLabelsExpr checks the expression at "runs-on" section like `runs-on: ${{ fromJSON(inputs.labels) }}`.
+// The expression must be evaluated to a string or an array of strings.
+func (rule *RuleExpression) checkRunnerLabelsExpr(s *String) {
+	ty := rule.checkOneExpression(s, "runner label at \"runs-on\" section", "jobs.<job_id>.runs-on")
+	if ty == nil {
+		return
+	}
+
+	switch ty := ty.(type) {
+	case StringType:
+		// OK
+	case *ArrayType:
+		switch ty.Elem.(type) {
+		case StringType, AnyType:
+			// OK
+		default:
+			rule.Errorf(s.Pos, "type of elements of array at \"runs-on\" must be string but found type %q", ty.Elem.String())
+		}
+	case AnyType:
+		rule.checkRunnerLabelsFromJSON(s)
+	default:
+		rule.Errorf(s.Pos, "type of expression at \"runs-on\" must be string or array but found type %q", ty.String())
+	}
+}
+
+// Runner labels which are built by fromJSON() like "${{ fromJSON('["self-hosted", "linux"]') }}" or
+// "${{ fromJSON(inputs.labels) }}"
+var reRunnerLabelsFromJSON = regexp.MustCompile(`^\s*\$\{\{\s*(?i:fromjson)\(\s*(?:'((?:[^']|'')*)'|(?:github\.event\.)?inputs\.([a-zA-Z_][a-zA-Z0-9_-]*))\s*\)\s*\}\}\s*$`)
+
+// checkRunnerLabelsFromJSON checks the JSON value passed to fromJSON() at "runs-on" section when it is known
+// statically. The value is a string literal or default values and options of workflow inputs.
+func (rule *RuleExpression) checkRunnerLabelsFromJSON(s *String) {
+	m := reRunnerLabelsFromJSON.FindStringSubmatch(s.Value)
+	if m == nil {
+		return
+	}
+
+	if m[2] == "" {
+		v := strings.ReplaceAll(m[1], "''", "'")
+		if p := invalidRunnerLabelsJSON(v); p != "" {
+			rule.Errorf(s.Pos, "argument of fromJSON() at \"runs-on\" must be JSON string or array of strings but %q %s", v, p)
+		}
+		return
+	}
+
+	if rule.workflow == nil {
+		return
+	}
+	id := strings.ToLower(m[2])
+
+	if e, ok := rule.workflow.FindWorkflowCallEvent(); ok {
+		for _, i := range e.Inputs {
+			if i.ID == id && i.Default != nil && !i.Default.ContainsExpression() {
+				if p := invalidRunnerLabelsJSON(i.Default.Value); p != "" {
+					rule.Errorf(s.Pos, "argument of fromJSON() at \"runs-on\" must be JSON string or array of strings but default value %q of input %q %s", i.Default.Value, i.Name.Value, p)
+				}
+			}
+		}
+	}
+
+	e, ok := rule.workflow.FindWorkflowDispatchEvent()
+	if !ok {
+		return
+	}
+	i, ok := e.Inputs[id]
+	if !ok {
+		return
+	}
+	if i.Type == WorkflowDispatchEventInputTypeChoice {
+		for _, o := range i.Options {
+			if o.ContainsExpression() {
+				continue
+			}
+			if p := invalidRunnerLabelsJSON(o.Value); p != "" {
+				rule.Errorf(s.Pos, "argument of fromJSON() at \"runs-on\" must be JSON string or array of strings but option %q of input %q %s", o.Value, i.Name.Value, p)
+			}
+		}
+		return
+	}
+	if i.Default != nil && !i.Default.ContainsExpression() {
+		if p := invalidRunnerLabelsJSON(i.Default.Value); p != "" {
+			rule.Errorf(s.Pos, "argument of fromJSON() at \"runs-on\" must be JSON string or array of strings but default value %q of input %q %s", i.Default.Value, i.Name.Value, p)
+		}
+	}
+}
+
+// invalidRunnerLabelsJSON describes why the JSON value cannot be runner labels. It returns an empty string when
+// the value is a string or a non-empty array of strings.
+func invalidRunnerLabelsJSON(src string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(src), &v); err != nil {
+		return "is not valid JSON"
+	}
+	switch v := v.(type) {
+	case string:
+		return ""
+	case []interface{}:
+		if len(v) == 0 {
+			return "is empty array"
+		}
+		for _, e := range v {
+			if k := jsonValueKind(e); k != "string" {
+				return "is array containing " + k
+			}
+		}
+		return ""
+	default:
+		return "is " + jsonValueKind(v)
+	}
+}
+
+func jsonValueKind(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// Environment name which is built from one input like "deploy-${{ inputs.target }}"
+var reEnvironmentNameFromInput = regexp.MustCompile(`^(.*?)\$\{\{\s*(?:github\.event\.)?inputs\.([a-zA-Z_][a-zA-Z0-9_-]*)\s*\}\}(.*)$`)
+
+// checkEnvironmentName checks the environment name which is dynamically decided by an expression.
+// When the name is built from a choice input of workflow_dispatch event, the possible environment
+// names are determined from the options of the input.
+func (rule *RuleExpression) checkEnvironmentName(name *String, ts []typedExpr) {
+	if name == nil || len(ts) != 1 {
+		return
+	}
+
+	if _, ok := ts[0].ty.(BoolType); ok && name.IsExpressionAssigned() {
+		rule.Errorf(name.Pos, "environment name must be string but found type %s", ts[0].ty.String())
+		return
+	}
+
+	m := reEnvironmentNameFromInput.FindStringSubmatch(name.Value)
+	if m == nil || rule.workflow == nil {
+		return
+	}
+	prefix, id, suffix := m[1], strings.ToLower(m[2]), m[3]
+
+	if e, ok := rule.workflow.FindWorkflowCallEvent(); ok {
+		for _, i := range e.Inputs {
+			if i.ID == id {
+				return // Any string can be passed from the caller
+			}
+		}
+	}
+	e, ok := rule.workflow.FindWorkflowDispatchEvent()
+	if !ok {
+		return
+	}
+	i, ok := e.Inputs[id]
+	if !ok || i.Type != WorkflowDispatchEventInputTypeChoice {
+		return
+	}
+
+	seen := map[string]string{}
+	for _, o := range i.Options {
+		if o.ContainsExpression() {
+			continue
+		}
+		env := prefix + o.Value + suffix
+		if strings.TrimSpace(env) == "" {
+			rule.Errorf(name.Pos, "environment name is empty when option %q of input %q is selected", o.Value, i.Name.Value)
+			continue
+		}
+		if len(env) > 255 {
+			rule.Errorf(name.Pos, "environment name %q is longer than 255 characters when option %q of input %q is selected", env, o.Value, i.Name.Value)
+			continue
+		}
+		k := strings.ToLower(env)
+		if prev, ok := seen[k]; ok && prev != o.Value {
+			rule.Errorf(name.Pos, "options %q and %q of input %q select the same environment %q since environment names are case-insensitive", prev, o.Value, i.Name.Value, env)
+			continue
+		}
+		seen[k] = o.Value
+	}
+}
+
 func (rule *RuleExpression) checkScriptString(str *String, workflowKey string) {
 	if str == nil {
 		return
@@ -751,6 +1083,55 @@ func (rule *RuleExpression) exprError(err *ExprError, lineBase, colBase int) {
 	rule.Error(pos, err.Message)
 }
 
+// exprErrorWithEvents reports the error with the workflow triggers which make the error relevant.
+// Errors in property accesses like `github.event.pull_request.title` are positioned at the start of
+// the accesses so the events are looked up by the offset of the error.
+func (rule *RuleExpression) exprErrorWithEvents(err *ExprError, lineBase, colBase int, events map[int][]string) {
+	pos := convertExprLineColToPos(err.Line, err.Column, lineBase, colBase)
+	rule.ErrorfWithEvents(pos, events[err.Offset], "%s", err.Message)
+}
+
+// eventsOfExprNode returns a map from offsets of property accesses which depend on the workflow
+// triggers to the triggers of the workflow which populate the properties. When no trigger of the
+// workflow populates the property, the access is not included.
+func (rule *RuleExpression) eventsOfExprNode(expr ExprNode) map[int][]string {
+	if len(rule.events) == 0 {
+		return nil
+	}
+	var ret map[int][]string
+	VisitExprNode(expr, func(node, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		var available []string
+		var root ExprNode
+		if _, es, ok := eventDependentProp(node); ok {
+			available, root = es, node
+		} else if v, ok := node.(*VariableNode); ok && strings.ToLower(v.Name) == "inputs" {
+			available, root = []string{"workflow_call", "workflow_dispatch"}, v
+		} else {
+			return
+		}
+		events := []string{}
+		for _, e := range rule.events {
+			if contains(available, e) {
+				events = append(events, e)
+			}
+		}
+		if len(events) == 0 {
+			return
+		}
+		if ret == nil {
+			ret = map[int][]string{}
+		}
+		o := root.Token().Offset
+		if _, ok := ret[o]; !ok {
+			ret[o] = events // Keep the outermost property access
+		}
+	})
+	return ret
+}
+
 func (rule *RuleExpression) checkSemanticsOfExprNode(expr ExprNode, line, col int, checkUntrusted bool, workflowKey string) (ExprType, bool) {
 	var v []string
 	if rule.config != nil {
@@ -772,8 +1153,22 @@ func (rule *RuleExpression) checkSemanticsOfExprNode(expr ExprNode, line, col in
 	if rule.inputsTy != nil {
 		c.UpdateInputs(rule.inputsTy)
 	}
-	if rule.dispatchInputsTy != nil {
-		c.UpdateDispatchInputs(rule.dispatchInputsTy)
+	if len(rule.events) > 0 || rule.dispatchInputsTy != nil {
+		if rule.githubTy == nil {
+			rule.githubTy = githubTypeWithEventPayload(BuiltinGlobalVariableTypes["github"], rule.events)
+			if rule.dispatchInputsTy != nil {
+				rule.githubTy = githubTypeWithDispatchInputs(rule.githubTy, rule.dispatchInputsTy)
+			}
+		}
+		c.setEvents(rule.events, rule.githubTy)
+		// Properties of `github.event` never populated by the triggers are reported only when this
+		// rule is enabled in config since the payloads are often accessed in shared steps
+		if rule.config.RuleEnabled(rule.Name()) {
+			c.CheckEventPayloadProps()
+		}
+		if rule.dispatchInputsTy != nil {
+			c.updateDispatchInputs(rule.dispatchInputsTy, rule.githubTy)
+		}
 	}
 	if rule.jobsTy != nil {
 		c.UpdateJobs(rule.jobsTy)
@@ -785,11 +1180,32 @@ func (rule *RuleExpression) checkSemanticsOfExprNode(expr ExprNode, line, col in
 		}
 		c.SetContextAvailability(ctx)
 		c.SetSpecialFunctionAvailability(sp)
+		if rule.jobID != "" {
+			c.SetWorkflowKey(strings.Replace(workflowKey, "<job_id>", rule.jobID, 1))
+		} else {
+			c.SetWorkflowKey(workflowKey)
+		}
+	}
+
+	if rule.inspected != nil {
+		c.nodeTypes = map[ExprNode]ExprType{}
 	}
 
 	ty, errs := c.Check(expr)
-	for _, err := range errs {
-		rule.exprError(err, line, col)
+	if len(errs) > 0 {
+		events := rule.eventsOfExprNode(expr)
+		for _, err := range errs {
+			rule.exprErrorWithEvents(err, line, col, events)
+		}
+	}
+
+	if rule.inspected != nil {
+		rule.inspected = append(rule.inspected, &inspectedExpr{
+			line:        line,
+			root:        expr,
+			types:       c.nodeTypes,
+			workflowKey: workflowKey,
+		})
 	}
 
 	return ty, len(errs) == 0
@@ -804,9 +1220,19 @@ func (rule *RuleExpression) checkSemantics(src string, line, col int, checkUntru
 		return nil, l.Offset(), false
 	}
 	t, ok := rule.checkSemanticsOfExprNode(expr, line, col, checkUntrusted, workflowKey)
+	rule.setInspectedSource(strings.TrimSuffix(src[:l.Offset()], "}}"), true)
 	return t, l.Offset(), ok
 }
 
+// setInspectedSource sets the source of the last inspected expression. The placeholder parameter
+// is true when the source is placed in ${{ }}.
+func (rule *RuleExpression) setInspectedSource(src string, placeholder bool) {
+	if len(rule.inspected) > 0 {
+		e := rule.inspected[len(rule.inspected)-1]
+		e.src, e.placeholder = src, placeholder
+	}
+}
+
 func (rule *RuleExpression) calcNeedsType(job *Job) *ObjectType {
 	// https://docs.github.com/en/actions/learn-github-actions/contexts#needs-context
 	o := NewEmptyStrictObjectType()
@@ -926,7 +1352,7 @@ func (rule *RuleExpression) checkMatrix(m *Matrix) *ObjectType {
 
 	for _, combi := range m.Include.Combinations {
 		if combi.Expression != nil {
-			ty := rule.checkOneExpression(m.Include.Expression, "matrix combination at element of include section", "jobs.<job_id>.strategy")
+			ty := rule.checkOneExpression(combi.Expression, "matrix combination at element of include section", "jobs.<job_id>.strategy")
 			if ty == nil {
 				continue
 			}
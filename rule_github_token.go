@@ -0,0 +1,105 @@
+package actionlint
+
+import (
+	"strings"
+)
+
+// RuleGitHubToken is a rule checker to detect `secrets.GITHUB_TOKEN` given to inputs of popular
+// actions which require a personal access token. Events such as pull requests or releases created
+// with `secrets.GITHUB_TOKEN` never trigger other workflows to prevent recursive workflow runs.
+// The inputs are maintained in the popular actions data set.
+// https://docs.github.com/en/actions/using-workflows/triggering-a-workflow#triggering-a-workflow-from-a-workflow
+type RuleGitHubToken struct {
+	RuleBase
+}
+
+// NewRuleGitHubToken creates a new RuleGitHubToken instance.
+func NewRuleGitHubToken() *RuleGitHubToken {
+	return &RuleGitHubToken{
+		RuleBase: RuleBase{
+			name: "github-token",
+			desc: "Checks for \"secrets.GITHUB_TOKEN\" given to popular actions whose created events should trigger other workflows",
+		},
+	}
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleGitHubToken) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil || e.Uses.ContainsExpression() || !rule.Config().PopularActionsEnabled() {
+		return nil
+	}
+	meta, ok := findPopularAction(e.Uses.Value)
+	if !ok || len(meta.PATInputs) == 0 {
+		return nil
+	}
+	slug, _, _ := strings.Cut(e.Uses.Value, "@")
+	if c := rule.Config().Rule(rule.Name()); c != nil && matchGlobFilter(c.Allow, slug) {
+		rule.Debug("secrets.GITHUB_TOKEN for %q is allowed by config", slug)
+		return nil
+	}
+
+	for id, event := range meta.PATInputs {
+		i, ok := e.Inputs[id]
+		if !ok || i.Value == nil {
+			continue
+		}
+		v, ok := defaultGitHubToken(i.Value.Value)
+		if !ok {
+			continue
+		}
+		rule.Errorf(
+			i.Value.Pos,
+			"%q is given to %q input of %q. %q events created with this token do not trigger any other workflow. use a personal access token or a GitHub App token instead. add %q to \"allow\" of \"github-token\" rule in actionlint.yaml if triggering workflows is not necessary",
+			v,
+			i.Name.Value,
+			e.Uses.Value,
+			event,
+			slug,
+		)
+	}
+
+	return nil
+}
+
+// defaultGitHubToken returns the expression when the value consists of a single ${{ }} placeholder
+// of `secrets.GITHUB_TOKEN` or `github.token`. Fallbacks such as `secrets.PAT || github.token` are
+// not reported since the token is only used when the PAT is not available.
+func defaultGitHubToken(s string) (string, bool) {
+	lits, exprs := splitExpressions(strings.TrimSpace(s))
+	if len(exprs) != 1 || lits[0] != "" || lits[1] != "" {
+		return "", false
+	}
+	expr, err := NewExprParser().Parse(NewExprLexer(exprs[0] + "}}"))
+	if err != nil {
+		return "", false // Syntax error is reported by "expression" rule
+	}
+
+	var receiver ExprNode
+	var prop string
+	switch n := expr.(type) {
+	case *ObjectDerefNode:
+		receiver, prop = n.Receiver, n.Property
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok {
+			return "", false
+		}
+		receiver, prop = n.Operand, s.Value
+	default:
+		return "", false
+	}
+	v, ok := receiver.(*VariableNode)
+	if !ok {
+		return "", false
+	}
+
+	switch name, prop := strings.ToLower(v.Name), strings.ToLower(prop); {
+	case name == "secrets" && prop == "github_token":
+		return "secrets.GITHUB_TOKEN", true
+	case name == "github" && prop == "token":
+		return "github.token", true
+	default:
+		return "", false
+	}
+}
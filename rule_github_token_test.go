@@ -0,0 +1,70 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleGitHubTokenDetectDefaultToken(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"${{ secrets.GITHUB_TOKEN }}", "secrets.GITHUB_TOKEN"},
+		{"${{ secrets.github_token }}", "secrets.GITHUB_TOKEN"},
+		{"${{secrets.GITHUB_TOKEN}}", "secrets.GITHUB_TOKEN"},
+		{" ${{ secrets['GITHUB_TOKEN'] }} ", "secrets.GITHUB_TOKEN"},
+		{"${{ github.token }}", "github.token"},
+		{"${{ GitHub.Token }}", "github.token"},
+		{"${{ secrets.PAT }}", ""},
+		{"${{ secrets.PAT || secrets.GITHUB_TOKEN }}", ""},
+		{"${{ env.GITHUB_TOKEN }}", ""},
+		{"x-access-token:${{ github.token }}", ""},
+		{"${{ github.token }}${{ github.token }}", ""},
+		{"${{ github.token", ""},
+		{"ghp_xxxxxxxx", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			have, ok := defaultGitHubToken(tc.input)
+			if ok != (tc.want != "") || have != tc.want {
+				t.Fatalf("wanted %q but got %q (ok=%v)", tc.want, have, ok)
+			}
+		})
+	}
+}
+
+func TestRuleGitHubTokenAllow(t *testing.T) {
+	s := &Step{
+		Exec: &ExecAction{
+			Uses: &String{Value: "softprops/action-gh-release@v2"},
+			Inputs: map[string]*Input{
+				"token": {
+					Name:  &String{Value: "token"},
+					Value: &String{Value: "${{ secrets.GITHUB_TOKEN }}", Pos: &Pos{}},
+				},
+			},
+		},
+	}
+
+	r := NewRuleGitHubToken()
+	if err := r.VisitStep(s); err != nil {
+		t.Fatal(err)
+	}
+	if errs := r.Errs(); len(errs) != 1 {
+		t.Fatalf("wanted 1 error but got %d: %v", len(errs), errs)
+	}
+
+	r = NewRuleGitHubToken()
+	r.SetConfig(&Config{
+		Rules: map[string]*RuleConfig{
+			"github-token": {Allow: []string{"softprops/*"}},
+		},
+	})
+	if err := r.VisitStep(s); err != nil {
+		t.Fatal(err)
+	}
+	if errs := r.Errs(); len(errs) != 0 {
+		t.Fatalf("wanted no error but got %v", errs)
+	}
+}
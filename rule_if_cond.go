@@ -7,15 +7,23 @@ import (
 // RuleIfCond is a rule to check if: conditions.
 type RuleIfCond struct {
 	RuleBase
+	src []byte
 }
 
 // NewRuleIfCond creates new RuleIfCond instance.
 func NewRuleIfCond() *RuleIfCond {
+	return NewRuleIfCondWithSource(nil)
+}
+
+// NewRuleIfCondWithSource creates new RuleIfCond instance with the source of the workflow, which
+// is used to build the edits to fix the conditions.
+func NewRuleIfCondWithSource(src []byte) *RuleIfCond {
 	return &RuleIfCond{
 		RuleBase: RuleBase{
 			name: "if-cond",
 			desc: "Checks for if: conditions which are always true/false",
 		},
+		src: src,
 	}
 }
 
@@ -42,9 +50,36 @@ func (rule *RuleIfCond) checkIfCond(n *String) {
 	if strings.HasPrefix(n.Value, "${{") && strings.HasSuffix(n.Value, "}}") && strings.Count(n.Value, "${{") == 1 {
 		return
 	}
-	rule.Errorf(
+	var fixes []*TextEdit
+	if e := rule.unwrapExpressions(n); e != nil {
+		fixes = []*TextEdit{e}
+	}
+	rule.ErrorfWithFixes(
 		n.Pos,
+		fixes,
 		"if: condition %q is always evaluated to true because extra characters are around ${{ }}",
 		n.Value,
 	)
 }
+
+// unwrapExpressions returns the edit to merge all ${{ }} placeholders in the condition into one. For
+// example, `${{ a }} && ${{ b }}` is fixed to `${{ a && b }}`. It returns nil when the merged
+// condition is not a valid expression.
+func (rule *RuleIfCond) unwrapExpressions(n *String) *TextEdit {
+	lits, exprs := splitExpressions(n.Value)
+	var b strings.Builder
+	for i, l := range lits {
+		b.WriteString(l)
+		if i < len(exprs) {
+			b.WriteString(exprs[i])
+		}
+	}
+	e := strings.TrimSpace(b.String())
+	if e == "" || strings.Contains(e, "}}") {
+		return nil
+	}
+	if _, err := NewExprParser().Parse(NewExprLexer(e + "}}")); err != nil {
+		return nil
+	}
+	return replaceAt(rule.src, n.Pos, n.Value, "${{ "+e+" }}")
+}
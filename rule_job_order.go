@@ -0,0 +1,112 @@
+package actionlint
+
+import (
+	"sort"
+	"strings"
+)
+
+// RuleJobOrder is a rule to enforce dependencies between jobs configured in "require" of the rule
+// configuration. For example, it can require that any job matching to "deploy-*" runs after a job
+// matching to "test-*" by checking the graph of "needs:".
+type RuleJobOrder struct {
+	RuleBase
+}
+
+// NewRuleJobOrder creates a new RuleJobOrder instance.
+func NewRuleJobOrder() *RuleJobOrder {
+	return &RuleJobOrder{
+		RuleBase: RuleBase{
+			name: "job-order",
+			desc: "Checks for dependencies between jobs required by \"require\" in the configuration",
+		},
+	}
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RuleJobOrder) VisitWorkflowPost(n *Workflow) error {
+	c := rule.Config().Rule(rule.Name())
+	if c == nil || len(c.Require) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(n.Jobs))
+	for id, j := range n.Jobs {
+		if j.ID != nil && j.Pos != nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return n.Jobs[ids[i]].Pos.IsBefore(n.Jobs[ids[j]].Pos)
+	})
+
+	for _, req := range c.Require {
+		if req == nil {
+			continue
+		}
+		defined := false
+		for _, id := range ids {
+			if matchJobIDGlob(req.Needs, id) {
+				defined = true
+				break
+			}
+		}
+
+		for _, id := range ids {
+			if !matchJobIDGlob(req.Jobs, id) || rule.needsTransitively(n, id, req.Needs) {
+				continue
+			}
+			j := n.Jobs[id]
+			if !defined {
+				rule.Errorf(
+					j.ID.Pos,
+					"job %q must run after a job matching to %q but no such job is defined in this workflow. this constraint is configured in \"require\" of %q rule in actionlint.yaml",
+					j.ID.Value,
+					req.Needs,
+					rule.Name(),
+				)
+				continue
+			}
+			rule.Errorf(
+				j.ID.Pos,
+				"job %q must run after a job matching to %q but it does not need such job directly or indirectly. add the job to \"needs:\" of this job. this constraint is configured in \"require\" of %q rule in actionlint.yaml",
+				j.ID.Value,
+				req.Needs,
+				rule.Name(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// needsTransitively returns whether the job depends on a job matching to the glob pattern directly
+// or indirectly via "needs:". The job itself is not included.
+func (rule *RuleJobOrder) needsTransitively(w *Workflow, id string, pat string) bool {
+	visited := map[string]struct{}{id: {}}
+	stack := []string{id}
+	for len(stack) > 0 {
+		j := w.Jobs[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if j == nil {
+			continue // Undefined job is reported by "job-needs" rule
+		}
+		for _, n := range j.Needs {
+			dep := strings.ToLower(n.Value)
+			if _, ok := visited[dep]; ok {
+				continue
+			}
+			visited[dep] = struct{}{}
+			if matchJobIDGlob(pat, dep) {
+				return true
+			}
+			stack = append(stack, dep)
+		}
+	}
+	return false
+}
+
+// matchJobIDGlob returns whether the job ID matches to the glob pattern. Job IDs are compared
+// case-insensitively.
+func matchJobIDGlob(pat, id string) bool {
+	return matchGlobFilter([]string{strings.ToLower(pat)}, strings.ToLower(id))
+}
@@ -0,0 +1,68 @@
+package actionlint
+
+// RuleLiteralKey is a rule checker to detect ${{ }} expressions at workflow keys which only accept
+// literal values. GitHub Actions does not evaluate expressions at such keys and silently treats
+// them as plain strings. Whether a key accepts expressions is decided by the context availability
+// table. See WorkflowKeyAcceptsExpression.
+type RuleLiteralKey struct {
+	RuleBase
+}
+
+// NewRuleLiteralKey creates a new RuleLiteralKey instance.
+func NewRuleLiteralKey() *RuleLiteralKey {
+	return &RuleLiteralKey{
+		RuleBase: RuleBase{
+			name: "literal-key",
+			desc: "Checks for expressions at workflow keys which only accept literal values",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleLiteralKey) VisitWorkflowPre(n *Workflow) error {
+	rule.check(n.Name, "name")
+	if n.Defaults != nil && n.Defaults.Run != nil {
+		rule.check(n.Defaults.Run.Shell, "defaults.run")
+		rule.check(n.Defaults.Run.WorkingDirectory, "defaults.run")
+	}
+	for _, e := range n.On {
+		if e, ok := e.(*WorkflowDispatchEvent); ok {
+			for _, i := range e.Inputs {
+				rule.check(i.Default, "on.workflow_dispatch.inputs.<inputs_id>.default")
+			}
+		}
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleLiteralKey) VisitJobPre(n *Job) error {
+	for _, s := range n.Needs {
+		rule.check(s, "jobs.<job_id>.needs")
+	}
+	if n.WorkflowCall != nil {
+		rule.check(n.WorkflowCall.Uses, "jobs.<job_id>.uses")
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleLiteralKey) VisitStep(n *Step) error {
+	rule.check(n.ID, "jobs.<job_id>.steps.id")
+	if e, ok := n.Exec.(*ExecAction); ok {
+		rule.check(e.Uses, "jobs.<job_id>.steps.uses")
+	}
+	return nil
+}
+
+func (rule *RuleLiteralKey) check(s *String, key string) {
+	if s == nil || !s.ContainsExpression() || WorkflowKeyAcceptsExpression(key) {
+		return
+	}
+	rule.Errorf(
+		s.Pos,
+		"expression in %q is not evaluated since workflow key %q only accepts a literal value. GitHub Actions treats the value as a plain string",
+		s.Value,
+		key,
+	)
+}
@@ -1,6 +1,12 @@
 package actionlint
 
-import "strings"
+import (
+	"strings"
+)
+
+// Maximum number of matrix combinations to check "include" entries. GitHub Actions generates at
+// most 256 jobs per workflow run from a matrix.
+const maxMatrixCombinationsToCheckInclude = 256
 
 // RuleMatrix is a rule checker to check 'matrix' field of job.
 type RuleMatrix struct {
@@ -40,6 +46,7 @@ func (rule *RuleMatrix) VisitJobPre(n *Job) error {
 	//       sh: pwsh
 
 	rule.checkExclude(m)
+	rule.checkIncludeExcluded(m)
 	return nil
 }
 
@@ -214,3 +221,144 @@ func (rule *RuleMatrix) checkExclude(m *Matrix) {
 		}
 	}
 }
+
+// matrixCombinations returns all combinations of the values in rows. It returns false when some
+// value is constructed with ${{ }} or the number of the combinations is too large.
+func matrixCombinations(rows map[string]*MatrixRow) ([]map[string]RawYAMLValue, bool) {
+	combis := []map[string]RawYAMLValue{{}}
+	for n, r := range rows {
+		if r.Expression != nil || len(r.Values) == 0 {
+			return nil, false
+		}
+		if len(combis)*len(r.Values) > maxMatrixCombinationsToCheckInclude {
+			return nil, false
+		}
+		next := make([]map[string]RawYAMLValue, 0, len(combis)*len(r.Values))
+		for _, v := range r.Values {
+			if s, ok := v.(*RawYAMLString); ok && ContainsExpression(s.Value) {
+				return nil, false
+			}
+			for _, c := range combis {
+				m := make(map[string]RawYAMLValue, len(c)+1)
+				for k, x := range c {
+					m[k] = x
+				}
+				m[n] = v
+				next = append(next, m)
+			}
+		}
+		combis = next
+	}
+	return combis, true
+}
+
+// isMatrixCombinationExcluded returns whether the combination is filtered out by the "exclude" entry.
+func isMatrixCombinationExcluded(combi map[string]RawYAMLValue, exclude *MatrixCombination) bool {
+	for k, a := range exclude.Assigns {
+		v, ok := combi[k]
+		if !ok || !isYAMLValueSubset(v, a.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// isMatrixCombinationExtended returns whether the "include" entry is added to the combination. The
+// entry is added when it does not overwrite any original matrix value in the combination.
+func isMatrixCombinationExtended(combi map[string]RawYAMLValue, include *MatrixCombination) bool {
+	for k, a := range include.Assigns {
+		if v, ok := combi[k]; ok && !v.Equals(a.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkIncludeExcluded checks "include" entries which match only combinations removed by "exclude"
+// section. "include" is processed after "exclude" so such entry is not added to any combination
+// and added as a new combination. When the entry does not define all matrix values, the values are
+// missing in the new combination.
+//
+// matrix:
+//
+//	os: [ubuntu-latest, windows-latest]
+//	node: [18, 20]
+//	exclude:
+//	  - os: windows-latest
+//	include:
+//	  # New combination {os: windows-latest, experimental: true} without "node"
+//	  - os: windows-latest
+//	    experimental: true
+func (rule *RuleMatrix) checkIncludeExcluded(m *Matrix) {
+	if m.Include == nil || m.Exclude == nil || len(m.Rows) == 0 || m.Include.ContainsExpression() || m.Exclude.ContainsExpression() {
+		return
+	}
+
+	all, ok := matrixCombinations(m.Rows)
+	if !ok {
+		return
+	}
+	remaining := make([]map[string]RawYAMLValue, 0, len(all))
+Combinations:
+	for _, c := range all {
+		for _, e := range m.Exclude.Combinations {
+			if isMatrixCombinationExcluded(c, e) {
+				continue Combinations
+			}
+		}
+		remaining = append(remaining, c)
+	}
+	if len(remaining) == len(all) {
+		return // Nothing was excluded
+	}
+
+Include:
+	for _, inc := range m.Include.Combinations {
+		if len(inc.Assigns) == 0 {
+			continue
+		}
+		for _, a := range inc.Assigns {
+			if s, ok := a.Value.(*RawYAMLString); ok && ContainsExpression(s.Value) {
+				continue Include
+			}
+		}
+
+		missing := []string{}
+		for n := range m.Rows {
+			if _, ok := inc.Assigns[n]; !ok {
+				missing = append(missing, n)
+			}
+		}
+		if len(missing) == 0 {
+			continue // Adding back the excluded combination
+		}
+
+		for _, c := range remaining {
+			if isMatrixCombinationExtended(c, inc) {
+				continue Include
+			}
+		}
+		excluded := false
+		for _, c := range all {
+			if isMatrixCombinationExtended(c, inc) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			continue // New combination which is not related to "exclude" section
+		}
+
+		var pos *Pos
+		for _, a := range inc.Assigns {
+			if pos == nil || a.Key.Pos.IsBefore(pos) {
+				pos = a.Key.Pos
+			}
+		}
+		rule.Errorf(
+			pos,
+			"this \"include\" entry is not added to any matrix combination because all combinations matching it are removed by \"exclude\" section. it is added as a new combination where matrix values %s are missing. define the values in this entry or fix \"exclude\" section",
+			sortedQuotes(missing),
+		)
+	}
+}
@@ -0,0 +1,244 @@
+package actionlint
+
+import (
+	"strings"
+)
+
+// RuleMatrixOutputs is a rule checker to detect outputs of matrix jobs read via `needs` context.
+// All jobs in a matrix set the same outputs of the job and the value is overwritten by the job
+// which finished last. So dependent jobs cannot get outputs of each job in the matrix.
+// https://docs.github.com/en/actions/using-jobs/defining-outputs-for-jobs
+type RuleMatrixOutputs struct {
+	RuleBase
+	matrixJobs map[string]*Job
+}
+
+// NewRuleMatrixOutputs creates a new RuleMatrixOutputs instance.
+func NewRuleMatrixOutputs() *RuleMatrixOutputs {
+	return &RuleMatrixOutputs{
+		RuleBase: RuleBase{
+			name: "matrix-outputs",
+			desc: "Checks for outputs of matrix jobs read via \"needs\" context which are overwritten by each job in the matrix",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleMatrixOutputs) VisitWorkflowPre(n *Workflow) error {
+	rule.matrixJobs = nil
+	c := rule.Config().Rule(rule.Name())
+	for id, j := range n.Jobs {
+		if j.Strategy == nil || j.Strategy.Matrix == nil || (len(j.Outputs) == 0 && j.WorkflowCall == nil) {
+			continue
+		}
+		if c != nil && j.ID != nil && matchGlobFilter(c.Allow, j.ID.Value) {
+			rule.Debug("Outputs of matrix job %q are allowed by config", j.ID.Value)
+			continue
+		}
+		if rule.matrixJobs == nil {
+			rule.matrixJobs = map[string]*Job{}
+		}
+		rule.matrixJobs[id] = j
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleMatrixOutputs) VisitJobPre(n *Job) error {
+	if len(rule.matrixJobs) == 0 || len(n.Needs) == 0 {
+		return nil
+	}
+
+	// Only direct dependencies are available in `needs` context
+	deps := map[string]*Job{}
+	for _, id := range n.Needs {
+		i := strings.ToLower(id.Value)
+		if j, ok := rule.matrixJobs[i]; ok {
+			deps[i] = j
+		}
+	}
+	if len(deps) == 0 {
+		return nil
+	}
+
+	reported := map[string]struct{}{}
+	check := func(s *String) {
+		if s == nil {
+			return
+		}
+		rule.checkString(s.Value, s.Pos, false, deps, reported)
+	}
+	checkIf := func(s *String) {
+		if s == nil {
+			return
+		}
+		rule.checkString(s.Value, s.Pos, true, deps, reported)
+	}
+	checkEnv := func(e *Env) {
+		if e == nil {
+			return
+		}
+		check(e.Expression)
+		for _, v := range e.Vars {
+			check(v.Value)
+		}
+	}
+
+	checkIf(n.If)
+	check(n.Name)
+	if n.Strategy != nil && n.Strategy.Matrix != nil {
+		rule.checkMatrix(n.Strategy.Matrix, deps, reported)
+	}
+	if n.RunsOn != nil {
+		check(n.RunsOn.LabelsExpr)
+		for _, l := range n.RunsOn.Labels {
+			check(l)
+		}
+	}
+	if n.Environment != nil {
+		check(n.Environment.Name)
+		check(n.Environment.URL)
+	}
+	if n.Container != nil {
+		check(n.Container.Image)
+	}
+	checkEnv(n.Env)
+	if n.WorkflowCall != nil {
+		for _, i := range n.WorkflowCall.Inputs {
+			check(i.Value)
+		}
+	}
+	for _, s := range n.Steps {
+		checkIf(s.If)
+		check(s.Name)
+		checkEnv(s.Env)
+		switch e := s.Exec.(type) {
+		case *ExecRun:
+			check(e.Run)
+		case *ExecAction:
+			for _, i := range e.Inputs {
+				check(i.Value)
+			}
+		}
+	}
+	for _, o := range n.Outputs {
+		check(o.Value)
+	}
+
+	return nil
+}
+
+func (rule *RuleMatrixOutputs) checkMatrix(m *Matrix, deps map[string]*Job, reported map[string]struct{}) {
+	check := func(s *String) {
+		if s != nil {
+			rule.checkString(s.Value, s.Pos, false, deps, reported)
+		}
+	}
+	check(m.Expression)
+	for _, r := range m.Rows {
+		check(r.Expression)
+		for _, v := range r.Values {
+			if s, ok := v.(*RawYAMLString); ok {
+				rule.checkString(s.Value, s.Pos(), false, deps, reported)
+			}
+		}
+	}
+	for _, cs := range []*MatrixCombinations{m.Include, m.Exclude} {
+		if cs == nil {
+			continue
+		}
+		check(cs.Expression)
+		for _, c := range cs.Combinations {
+			check(c.Expression)
+		}
+	}
+}
+
+// checkString reports `needs.<job_id>.outputs` in the string where the job is a matrix job. The
+// isIf parameter is true when the string is a condition at "if:" which can omit ${{ }}.
+func (rule *RuleMatrixOutputs) checkString(s string, pos *Pos, isIf bool, deps map[string]*Job, reported map[string]struct{}) {
+	var exprs []string
+	if ContainsExpression(s) {
+		_, exprs = splitExpressions(s)
+	} else if isIf {
+		exprs = []string{s}
+	} else {
+		return
+	}
+
+	for _, src := range exprs {
+		if !strings.Contains(strings.ToLower(src), "needs") {
+			continue
+		}
+		expr, err := NewExprParser().Parse(NewExprLexer(src + "}}"))
+		if err != nil {
+			continue // Syntax error is reported by "expression" rule
+		}
+		VisitExprNode(expr, func(node, _ ExprNode, entering bool) {
+			if !entering {
+				return
+			}
+			id, ok := needsOutputsJobID(node)
+			if !ok {
+				return
+			}
+			j, ok := deps[id]
+			if !ok {
+				return
+			}
+			if _, ok := reported[id]; ok {
+				return
+			}
+			reported[id] = struct{}{}
+			rule.Errorf(
+				pos,
+				"outputs of job %q are read via \"needs.%s.outputs\" but the job runs with matrix. all jobs in the matrix set the same outputs and the values are overwritten by the job which finished last. upload the values from each job in the matrix as artifacts and download them, or aggregate them into JSON in a separate job. add %q to \"allow\" of %q rule in actionlint.yaml if this is intended",
+				j.ID.Value,
+				id,
+				j.ID.Value,
+				rule.Name(),
+			)
+		})
+	}
+}
+
+// needsOutputsJobID returns the job ID in lower case when the node accesses `needs.<job_id>.outputs`.
+func needsOutputsJobID(n ExprNode) (string, bool) {
+	var receiver ExprNode
+	switch n := n.(type) {
+	case *ObjectDerefNode:
+		if strings.ToLower(n.Property) != "outputs" {
+			return "", false
+		}
+		receiver = n.Receiver
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok || strings.ToLower(s.Value) != "outputs" {
+			return "", false
+		}
+		receiver = n.Operand
+	default:
+		return "", false
+	}
+
+	var operand ExprNode
+	var id string
+	switch n := receiver.(type) {
+	case *ObjectDerefNode:
+		operand, id = n.Receiver, n.Property
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok {
+			return "", false
+		}
+		operand, id = n.Operand, s.Value
+	default:
+		return "", false
+	}
+
+	v, ok := operand.(*VariableNode)
+	if !ok || strings.ToLower(v.Name) != "needs" {
+		return "", false
+	}
+	return strings.ToLower(id), true
+}
@@ -0,0 +1,116 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleMatrixOutputsDetectReads(t *testing.T) {
+	tests := []struct {
+		what string
+		cond string
+		run  string
+		want int
+	}{
+		{
+			what: "property access",
+			run:  "echo ${{ needs.build.outputs.artifact }}",
+			want: 1,
+		},
+		{
+			what: "index access",
+			run:  "echo ${{ needs['build']['outputs'].artifact }}",
+			want: 1,
+		},
+		{
+			what: "case insensitive",
+			run:  "echo ${{ Needs.BUILD.Outputs.artifact }}",
+			want: 1,
+		},
+		{
+			what: "whole outputs object",
+			run:  "echo '${{ toJSON(needs.build.outputs) }}'",
+			want: 1,
+		},
+		{
+			what: "condition without placeholder",
+			cond: "needs.build.outputs.artifact != ''",
+			want: 1,
+		},
+		{
+			what: "reported once per job",
+			cond: "needs.build.outputs.artifact != ''",
+			run:  "echo ${{ needs.build.outputs.artifact }}",
+			want: 1,
+		},
+		{
+			what: "result",
+			run:  "echo ${{ needs.build.result }}",
+			want: 0,
+		},
+		{
+			what: "non-matrix job",
+			run:  "echo ${{ needs.lint.outputs.report }}",
+			want: 0,
+		},
+		{
+			what: "allowed",
+			run:  "echo ${{ needs.build-per-os.outputs.linux }}",
+			want: 0,
+		},
+		{
+			what: "in string literal",
+			run:  "echo ${{ 'needs.build.outputs' }}",
+			want: 0,
+		},
+	}
+
+	matrixJob := func(id string) *Job {
+		return &Job{
+			ID:       &String{Value: id},
+			Strategy: &Strategy{Matrix: &Matrix{}},
+			Outputs:  map[string]*Output{"out": {}},
+		}
+	}
+	w := &Workflow{
+		Jobs: map[string]*Job{
+			"build":        matrixJob("build"),
+			"build-per-os": matrixJob("build-per-os"),
+			"lint": {
+				ID:      &String{Value: "lint"},
+				Outputs: map[string]*Output{"report": {}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			j := &Job{
+				ID:    &String{Value: "deploy"},
+				Needs: []*String{{Value: "build"}, {Value: "build-per-os"}, {Value: "lint"}},
+			}
+			if tc.cond != "" {
+				j.If = &String{Value: tc.cond, Pos: &Pos{}}
+			}
+			if tc.run != "" {
+				j.Steps = []*Step{{Exec: &ExecRun{Run: &String{Value: tc.run, Pos: &Pos{}}}}}
+			}
+
+			r := NewRuleMatrixOutputs()
+			r.SetConfig(&Config{
+				Rules: map[string]*RuleConfig{
+					"matrix-outputs": {Allow: []string{"*-per-os"}},
+				},
+			})
+			if err := r.VisitWorkflowPre(w); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.VisitJobPre(j); err != nil {
+				t.Fatal(err)
+			}
+
+			if errs := r.Errs(); len(errs) != tc.want {
+				t.Fatalf("wanted %d errors but got %d: %v", tc.want, len(errs), errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+package actionlint
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Top-level keys of job which cannot vary in matrix since `matrix` context is not available there
+// or the values cannot contain expressions.
+var matrixSuggestionUnavailableKeys = map[string]struct{}{
+	"if":          {},
+	"needs":       {},
+	"permissions": {},
+	"strategy":    {},
+	"uses":        {},
+}
+
+type matrixSuggestionJob struct {
+	job   *Job
+	start int // Index of the line of the job ID
+	end   int // Index of the next line of the last line of the job
+}
+
+// RuleMatrixSuggestion is an opt-in rule checker to detect sibling jobs whose bodies are identical
+// except for one constant such as a version of Go or an OS. Such jobs can be merged into one job
+// with matrix. The error has the edits to rewrite the jobs into the matrix job so that -fix can
+// apply them.
+type RuleMatrixSuggestion struct {
+	RuleBase
+	lines [][]byte
+}
+
+// NewRuleMatrixSuggestion creates a new RuleMatrixSuggestion instance. The src parameter is the
+// source of the workflow, which is used to compare jobs and to build the rewrite.
+func NewRuleMatrixSuggestion(src []byte) *RuleMatrixSuggestion {
+	return &RuleMatrixSuggestion{
+		RuleBase: RuleBase{
+			name: "matrix-suggestion",
+			desc: "Checks for sibling jobs which are identical except for one constant and can be merged into one matrix job. This rule is opt-in",
+		},
+		lines: bytes.SplitAfter(src, []byte{'\n'}),
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleMatrixSuggestion) VisitWorkflowPre(n *Workflow) error {
+	jobs := rule.jobRanges(n)
+	if len(jobs) < 2 {
+		return nil
+	}
+
+	needed := map[string]struct{}{}
+	for _, j := range n.Jobs {
+		for _, id := range j.Needs {
+			needed[strings.ToLower(id.Value)] = struct{}{}
+		}
+	}
+
+	grouped := make([]bool, len(jobs))
+	for i, a := range jobs {
+		if grouped[i] {
+			continue
+		}
+		group := []*matrixSuggestionJob{a}
+		var key, value string
+		values := []string{}
+		for j := i + 1; j < len(jobs); j++ {
+			if grouped[j] {
+				continue
+			}
+			k, va, vb, ok := rule.diffJobs(a, jobs[j])
+			if !ok || (len(group) > 1 && (k != key || va != value)) {
+				continue
+			}
+			if len(group) == 1 {
+				key, value = k, va
+				values = append(values, va)
+			}
+			group = append(group, jobs[j])
+			values = append(values, vb)
+			grouped[j] = true
+		}
+		if len(group) < 2 {
+			continue
+		}
+		rule.report(group, key, value, values, needed)
+	}
+
+	return nil
+}
+
+// jobRanges returns the line ranges of the jobs sorted by their positions. Jobs which already have
+// matrix are excluded.
+func (rule *RuleMatrixSuggestion) jobRanges(n *Workflow) []*matrixSuggestionJob {
+	all := make([]*matrixSuggestionJob, 0, len(n.Jobs))
+	for _, j := range n.Jobs {
+		if j.ID == nil || j.ID.Pos == nil || j.ID.Pos.Line <= 0 || j.ID.Pos.Line > len(rule.lines) {
+			return nil
+		}
+		all = append(all, &matrixSuggestionJob{job: j, start: j.ID.Pos.Line - 1})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].start < all[j].start
+	})
+
+	for i, j := range all {
+		indent := j.job.ID.Pos.Col - 1
+		end := len(rule.lines)
+		if i+1 < len(all) {
+			end = all[i+1].start
+		} else {
+			for l := j.start + 1; l < len(rule.lines); l++ {
+				if d, ok := lineIndent(rule.lines[l]); ok && d <= indent {
+					end = l
+					break
+				}
+			}
+		}
+		// Exclude trailing blank lines and comments
+		for end > j.start+1 {
+			if _, ok := lineIndent(rule.lines[end-1]); ok {
+				break
+			}
+			end--
+		}
+		j.end = end
+	}
+
+	ret := make([]*matrixSuggestionJob, 0, len(all))
+	for _, j := range all {
+		if j.job.Strategy == nil && j.end > j.start+1 {
+			ret = append(ret, j)
+		}
+	}
+	return ret
+}
+
+func isMatrixValueChar(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9' || b == '.' || b == '-' || b == '_'
+}
+
+// diffLine returns the range of the token which differs between the two lines. The range is extended
+// to token boundaries so that "1.21" and "1.22" are compared instead of "1" and "2".
+func diffLine(a, b string) (int, int, int, bool) {
+	p := 0
+	for p < len(a) && p < len(b) && a[p] == b[p] {
+		p++
+	}
+	s := 0
+	for s < len(a)-p && s < len(b)-p && a[len(a)-1-s] == b[len(b)-1-s] {
+		s++
+	}
+	for p > 0 && isMatrixValueChar(a[p-1]) {
+		p--
+	}
+	for s > 0 && isMatrixValueChar(a[len(a)-s]) {
+		s--
+	}
+	ea, eb := len(a)-s, len(b)-s
+	if p >= ea || p >= eb {
+		return 0, 0, 0, false
+	}
+	for i := p; i < ea; i++ {
+		if !isMatrixValueChar(a[i]) {
+			return 0, 0, 0, false
+		}
+	}
+	for i := p; i < eb; i++ {
+		if !isMatrixValueChar(b[i]) {
+			return 0, 0, 0, false
+		}
+	}
+	return p, ea, eb, true
+}
+
+// lineKey returns the mapping key at the line like "runs-on" of "runs-on: ubuntu-latest".
+func lineKey(l string) string {
+	t := strings.TrimLeft(strings.TrimSpace(l), "- ")
+	k, _, ok := strings.Cut(t, ":")
+	if !ok || strings.ContainsAny(k, " \"'") {
+		return ""
+	}
+	return k
+}
+
+// topLevelKey returns the key of job at the line index. The body indent is an indentation of keys
+// directly under the job.
+func (rule *RuleMatrixSuggestion) topLevelKey(j *matrixSuggestionJob, line int) string {
+	body := -1
+	for l := j.start + 1; l <= line; l++ {
+		d, ok := lineIndent(rule.lines[l])
+		if !ok {
+			continue
+		}
+		if body < 0 {
+			body = d
+		}
+	}
+	for l := line; l > j.start; l-- {
+		if d, ok := lineIndent(rule.lines[l]); ok && d == body {
+			return lineKey(string(rule.lines[l]))
+		}
+	}
+	return ""
+}
+
+// diffJobs compares bodies of two jobs. When they are identical except for one constant, it returns
+// the key of the first different line and the constants in the two jobs.
+func (rule *RuleMatrixSuggestion) diffJobs(a, b *matrixSuggestionJob) (string, string, string, bool) {
+	if a.end-a.start != b.end-b.start {
+		return "", "", "", false
+	}
+
+	key, va, vb := "", "", ""
+	for i := 1; i < a.end-a.start; i++ {
+		la, lb := string(rule.lines[a.start+i]), string(rule.lines[b.start+i])
+		if la == lb {
+			continue
+		}
+		p, ea, eb, ok := diffLine(la, lb)
+		if !ok {
+			return "", "", "", false
+		}
+		if k := lineKey(la); k == "uses" || k == "id" {
+			return "", "", "", false // These values cannot contain expressions
+		}
+		if _, ok := matrixSuggestionUnavailableKeys[rule.topLevelKey(a, a.start+i)]; ok {
+			return "", "", "", false
+		}
+		x, y := la[p:ea], lb[p:eb]
+		if va == "" {
+			key, va, vb = lineKey(la), x, y
+		} else if x != va || y != vb {
+			return "", "", "", false // Differs in more than one constant
+		} else if k := lineKey(la); k == "runs-on" || strings.HasSuffix(k, "-version") {
+			key = k // Prefer a key which describes the constant well to name the matrix row
+		}
+		// Check the rest of the line is the same after replacing the constant
+		if strings.ReplaceAll(la, va, "\x00") != strings.ReplaceAll(lb, vb, "\x00") {
+			return "", "", "", false
+		}
+	}
+	if va == "" {
+		return "", "", "", false
+	}
+	return key, va, vb, true
+}
+
+func matrixSuggestionKeyName(k string) string {
+	switch {
+	case k == "runs-on":
+		return "os"
+	case strings.HasSuffix(k, "-version") && len(k) > len("-version"):
+		return strings.TrimSuffix(k, "-version")
+	case k == "":
+		return "value"
+	default:
+		return k
+	}
+}
+
+// matrixSuggestionJobID decides an ID of the merged job. The constant is removed from the ID of the
+// first job like "test-go1.21" -> "test-go". When the ID does not contain the constant, the common
+// prefix of the IDs is used like "test-linux", "test-macos" -> "test".
+func matrixSuggestionJobID(group []*matrixSuggestionJob, values []string) string {
+	first := group[0].job.ID.Value
+	if id := strings.Trim(strings.ReplaceAll(first, values[0], ""), "-_."); id != "" && id != first {
+		return id
+	}
+
+	id := first
+	for _, j := range group[1:] {
+		o := j.job.ID.Value
+		i := 0
+		for i < len(id) && i < len(o) && id[i] == o[i] {
+			i++
+		}
+		id = id[:i]
+	}
+	if i := strings.LastIndexAny(id, "-_"); i > 0 {
+		id = id[:i]
+	}
+	if id = strings.TrimRight(id, "-_."); id == "" {
+		return first
+	}
+	return id
+}
+
+func quoteMatrixValue(v string) string {
+	if v[0] < '0' || '9' < v[0] {
+		switch v {
+		case "true", "false", "null", "yes", "no", "on", "off":
+		default:
+			return v
+		}
+	}
+	return "'" + v + "'" // Avoid being parsed as number such as 1.20
+}
+
+func (rule *RuleMatrixSuggestion) lineOffset(line int) int {
+	o := 0
+	for i := 0; i < line && i < len(rule.lines); i++ {
+		o += len(rule.lines[i])
+	}
+	return o
+}
+
+func (rule *RuleMatrixSuggestion) report(group []*matrixSuggestionJob, key, value string, values []string, needed map[string]struct{}) {
+	ids := make([]string, 0, len(group))
+	for _, j := range group {
+		ids = append(ids, j.job.ID.Value)
+	}
+	name := matrixSuggestionKeyName(key)
+	q := make([]string, 0, len(values))
+	for _, v := range values {
+		q = append(q, quoteMatrixValue(v))
+	}
+	mat := fmt.Sprintf("%s: [%s]", name, strings.Join(q, ", "))
+
+	var fixes []*TextEdit
+	referred := false
+	for _, id := range ids {
+		if _, ok := needed[strings.ToLower(id)]; ok {
+			referred = true
+		}
+	}
+	if !referred {
+		fixes = rule.rewrite(group, name, value, mat, values)
+	}
+
+	rule.ErrorfWithFixes(
+		group[0].job.ID.Pos,
+		fixes,
+		"jobs %s are identical except for the value of %q. consider merging them into one job with matrix \"%s\"",
+		sortedQuotes(ids),
+		key,
+		mat,
+	)
+}
+
+func (rule *RuleMatrixSuggestion) rewrite(group []*matrixSuggestionJob, name, value, mat string, values []string) []*TextEdit {
+	first := group[0]
+	key := string(rule.lines[first.start])
+	indent := strings.Repeat(" ", first.job.ID.Pos.Col-1)
+	bodyIndent := ""
+	for l := first.start + 1; l < first.end; l++ {
+		if d, ok := lineIndent(rule.lines[l]); ok {
+			bodyIndent = strings.Repeat(" ", d)
+			break
+		}
+	}
+	step := strings.TrimPrefix(bodyIndent, indent)
+	if step == "" {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString(indent)
+	b.WriteString(matrixSuggestionJobID(group, values))
+	b.WriteString(":")
+	if i := strings.Index(key, ":"); i >= 0 {
+		b.WriteString(strings.TrimRight(key[i+1:], "\r\n")) // Keep trailing comment
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "%sstrategy:\n%s%smatrix:\n%s%s%s%s\n", bodyIndent, bodyIndent, step, bodyIndent, step, step, mat)
+
+	expr := fmt.Sprintf("${{ matrix.%s }}", name)
+	a := group[0]
+	for l := a.start + 1; l < a.end; l++ {
+		la := string(rule.lines[l])
+		lb := string(rule.lines[group[1].start+l-a.start])
+		if la != lb {
+			la = strings.ReplaceAll(la, value, expr)
+		}
+		b.WriteString(la)
+	}
+
+	edits := []*TextEdit{{rule.lineOffset(first.start), rule.lineOffset(first.end), b.String()}}
+	for _, j := range group[1:] {
+		start, end := j.start, j.end
+		// Also remove blank lines and comments between the previous job and this job
+		for start > 0 {
+			if _, ok := lineIndent(rule.lines[start-1]); ok {
+				break
+			}
+			start--
+		}
+		edits = append(edits, &TextEdit{rule.lineOffset(start), rule.lineOffset(end), ""})
+	}
+	return edits
+}
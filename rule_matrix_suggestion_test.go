@@ -0,0 +1,202 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleMatrixSuggestionRewrite(t *testing.T) {
+	testCases := []struct {
+		what string
+		src  string
+		want string
+	}{
+		{
+			what: "go versions",
+			src: `on: push
+jobs:
+  test-go121:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/setup-go@v5
+        with:
+          go-version: '1.21'
+      - run: go test ./...
+
+  test-go122:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/setup-go@v5
+        with:
+          go-version: '1.22'
+      - run: go test ./...
+`,
+			want: `on: push
+jobs:
+  test:
+    strategy:
+      matrix:
+        go: ['1.21', '1.22']
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/setup-go@v5
+        with:
+          go-version: '${{ matrix.go }}'
+      - run: go test ./...
+`,
+		},
+		{
+			what: "operating systems",
+			src: `on: push
+jobs:
+  build-linux:
+    name: Build on ubuntu-latest
+    runs-on: ubuntu-latest
+    steps:
+      - run: make
+  # Build on Windows
+  build-windows:
+    name: Build on windows-latest
+    runs-on: windows-latest
+    steps:
+      - run: make
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make lint
+`,
+			want: `on: push
+jobs:
+  build:
+    strategy:
+      matrix:
+        os: [ubuntu-latest, windows-latest]
+    name: Build on ${{ matrix.os }}
+    runs-on: ${{ matrix.os }}
+    steps:
+      - run: make
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make lint
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			w, errs := Parse([]byte(tc.src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleMatrixSuggestion([]byte(tc.src))
+			if err := r.VisitWorkflowPre(w); err != nil {
+				t.Fatal(err)
+			}
+			errs = r.Errs()
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			if !strings.Contains(errs[0].Message, "consider merging them into one job with matrix") {
+				t.Fatalf("unexpected error message: %q", errs[0].Message)
+			}
+			b, fixed := ApplyFixes([]byte(tc.src), errs)
+			if len(fixed) != 1 {
+				t.Fatalf("error was not fixed: %v", errs[0])
+			}
+			if have := string(b); have != tc.want {
+				t.Fatalf("wanted:\n%s\nbut got:\n%s", tc.want, have)
+			}
+			if _, errs := Parse(b); len(errs) > 0 {
+				t.Fatalf("fixed workflow has syntax errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestRuleMatrixSuggestionNoError(t *testing.T) {
+	testCases := []struct {
+		what string
+		src  string
+	}{
+		{
+			what: "differs in two constants",
+			src: `on: push
+jobs:
+  a:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make foo
+  b:
+    runs-on: windows-latest
+    steps:
+      - run: make bar
+`,
+		},
+		{
+			what: "differs in action",
+			src: `on: push
+jobs:
+  a:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v3
+  b:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+`,
+		},
+		{
+			what: "differs in needs",
+			src: `on: push
+jobs:
+  x:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make
+  a:
+    needs: x
+    runs-on: ubuntu-latest
+    steps:
+      - run: make
+  b:
+    needs: a
+    runs-on: ubuntu-latest
+    steps:
+      - run: make
+`,
+		},
+		{
+			what: "differs in number of lines",
+			src: `on: push
+jobs:
+  a:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make
+  b:
+    runs-on: windows-latest
+    steps:
+      - run: make
+      - run: make test
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			w, errs := Parse([]byte(tc.src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleMatrixSuggestion([]byte(tc.src))
+			if err := r.VisitWorkflowPre(w); err != nil {
+				t.Fatal(err)
+			}
+			if errs := r.Errs(); len(errs) > 0 {
+				t.Fatalf("wanted no error but got %v", errs)
+			}
+		})
+	}
+}
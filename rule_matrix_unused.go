@@ -0,0 +1,157 @@
+package actionlint
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+// RuleMatrixUnused is a rule checker to detect dimensions of "matrix:" whose values are never
+// referenced in the job. Each dimension multiplies the number of jobs generated from the matrix so
+// an unused dimension runs the same job multiple times without affecting its behavior.
+// https://docs.github.com/en/actions/using-jobs/using-a-matrix-for-your-jobs
+type RuleMatrixUnused struct {
+	RuleBase
+	lines [][]byte
+	ends  map[*Job]int
+}
+
+// NewRuleMatrixUnused creates a new RuleMatrixUnused instance. The src parameter is the source of
+// the workflow which is used to find references to `matrix` context in the job.
+func NewRuleMatrixUnused(src []byte) *RuleMatrixUnused {
+	return &RuleMatrixUnused{
+		RuleBase: RuleBase{
+			name: "matrix-unused",
+			desc: "Checks for dimensions of matrix whose values are never referenced in the job",
+		},
+		lines: bytes.SplitAfter(src, []byte{'\n'}),
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleMatrixUnused) VisitWorkflowPre(n *Workflow) error {
+	// Source of each job is from its ID until the ID of the next job
+	jobs := make([]*Job, 0, len(n.Jobs))
+	for _, j := range n.Jobs {
+		if j.ID != nil && j.ID.Pos != nil {
+			jobs = append(jobs, j)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ID.Pos.IsBefore(jobs[j].ID.Pos)
+	})
+	rule.ends = make(map[*Job]int, len(jobs))
+	for i, j := range jobs {
+		end := len(rule.lines) + 1
+		if i+1 < len(jobs) {
+			end = jobs[i+1].ID.Pos.Line
+		}
+		rule.ends[j] = end
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleMatrixUnused) VisitJobPre(n *Job) error {
+	if n.Strategy == nil || n.Strategy.Matrix == nil || n.Strategy.Matrix.Expression != nil {
+		return nil
+	}
+	end, ok := rule.ends[n]
+	if !ok {
+		return nil
+	}
+
+	rows := []*MatrixRow{}
+	for _, r := range n.Strategy.Matrix.Rows {
+		// Dimensions with one value do not multiply the jobs
+		if r.Name != nil && r.Expression == nil && len(r.Values) > 1 {
+			rows = append(rows, r)
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	used, all := rule.matrixReferences(n, end)
+	if all {
+		return nil
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Name.Pos.IsBefore(rows[j].Name.Pos)
+	})
+	for _, r := range rows {
+		if _, ok := used[strings.ToLower(r.Name.Value)]; ok {
+			continue
+		}
+		rule.Errorf(
+			r.Name.Pos,
+			"matrix dimension %q is never referenced in job %q. its %d values multiply the number of jobs by %d without affecting the behavior of the job. remove the dimension or use \"matrix.%s\" in the job",
+			r.Name.Value,
+			n.ID.Value,
+			len(r.Values),
+			len(r.Values),
+			r.Name.Value,
+		)
+	}
+
+	return nil
+}
+
+// matrixReferences returns the lower-case names of matrix dimensions referenced in the source of the
+// job. The second return value is true when the whole `matrix` object is used like `toJSON(matrix)`.
+func (rule *RuleMatrixUnused) matrixReferences(n *Job, end int) (map[string]struct{}, bool) {
+	used := map[string]struct{}{}
+	scan := func(ts []*Token) bool {
+		for i, t := range ts {
+			if t.Kind != TokenKindIdent || !strings.EqualFold(t.Value, "matrix") || i > 0 && ts[i-1].Kind == TokenKindDot {
+				continue
+			}
+			if i+2 < len(ts) && ts[i+1].Kind == TokenKindDot && ts[i+2].Kind == TokenKindIdent {
+				used[strings.ToLower(ts[i+2].Value)] = struct{}{}
+				continue
+			}
+			if i+3 < len(ts) && ts[i+1].Kind == TokenKindLeftBracket && ts[i+2].Kind == TokenKindString && ts[i+3].Kind == TokenKindRightBracket {
+				s := ts[i+2].Value
+				s = strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+				used[strings.ToLower(s)] = struct{}{}
+				continue
+			}
+			return true // The matrix object is used as a whole or with dynamic keys
+		}
+		return false
+	}
+
+	var b strings.Builder
+	for l := n.ID.Pos.Line; l < end && l <= len(rule.lines); l++ {
+		b.Write(rule.lines[l-1])
+	}
+	src := b.String()
+	for {
+		i := strings.Index(src, "${{")
+		if i < 0 {
+			break
+		}
+		src = src[i+3:]
+		ts, o, err := LexExpression(src)
+		if err != nil {
+			continue // Syntax error is reported by "expression" rule
+		}
+		if scan(ts) {
+			return nil, true
+		}
+		src = src[o:]
+	}
+
+	// "${{ }}" can be omitted at "if:"
+	for _, s := range n.Steps {
+		if s.If == nil || s.If.ContainsExpression() {
+			continue
+		}
+		if ts, _, err := LexExpression(s.If.Value + "}}"); err == nil && scan(ts) {
+			return nil, true
+		}
+	}
+
+	return used, false
+}
@@ -0,0 +1,83 @@
+package actionlint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleMatrixUnusedReferences(t *testing.T) {
+	testCases := []struct {
+		what string
+		body string
+		want []string
+	}{
+		{
+			what: "property access",
+			body: "    runs-on: ${{ matrix.os }}\n    steps:\n      - run: echo ${{ matrix.Node }}\n",
+			want: []string{"node", "os"},
+		},
+		{
+			what: "index access",
+			body: "    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ matrix['os'] }}\n",
+			want: []string{"os"},
+		},
+		{
+			what: "if condition without ${{ }}",
+			body: "    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n        if: matrix.os == 'linux'\n",
+			want: []string{"os"},
+		},
+		{
+			what: "property of other context",
+			body: "    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ github.matrix }} ${{ env.os }}\n",
+			want: []string{},
+		},
+		{
+			what: "whole object",
+			body: "    runs-on: ubuntu-latest\n    steps:\n      - run: echo '${{ toJSON(matrix) }}'\n",
+			want: nil,
+		},
+		{
+			what: "dynamic key",
+			body: "    runs-on: ubuntu-latest\n    steps:\n      - run: echo ${{ matrix[env.KEY] }}\n",
+			want: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			src := "on: push\njobs:\n  test:\n    strategy:\n      matrix:\n        os: [linux, macos]\n        node: [18, 20]\n" + tc.body + "  other:\n    runs-on: ${{ matrix.other }}\n    steps:\n      - run: echo\n"
+			w, errs := Parse([]byte(src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			rule := NewRuleMatrixUnused([]byte(src))
+			if err := rule.VisitWorkflowPre(w); err != nil {
+				t.Fatal(err)
+			}
+			j := w.Jobs["test"]
+			used, all := rule.matrixReferences(j, rule.ends[j])
+			if tc.want == nil {
+				if !all {
+					t.Fatalf("whole matrix object should be used but got %v", used)
+				}
+				return
+			}
+			if all {
+				t.Fatal("whole matrix object should not be used")
+			}
+			have := []string{}
+			for _, k := range []string{"node", "os"} {
+				if _, ok := used[k]; ok {
+					have = append(have, k)
+				}
+			}
+			if _, ok := used["other"]; ok {
+				t.Fatal("reference in other job was collected")
+			}
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package actionlint
+
+import (
+	"strings"
+)
+
+// Permission scopes required by actions/deploy-pages.
+var pagesRequiredPermissions = []string{"pages", "id-token"}
+
+// RulePages is a rule checker to detect missing setup of GitHub Pages deployments. The job deploying
+// Pages with actions/deploy-pages requires "pages: write" and "id-token: write" permissions and
+// "github-pages" environment. The site must be prepared with actions/configure-pages and uploaded
+// with actions/upload-pages-artifact before the deployment.
+// https://docs.github.com/en/pages/getting-started-with-github-pages/using-custom-workflows-with-github-pages
+type RulePages struct {
+	RuleBase
+	jobs        map[string]*Job
+	permissions *Permissions
+	reusable    bool
+}
+
+// NewRulePages creates a new RulePages instance.
+func NewRulePages() *RulePages {
+	return &RulePages{
+		RuleBase: RuleBase{
+			name: "pages",
+			desc: "Checks for permissions, environment, and steps required by GitHub Pages deployments with \"actions/deploy-pages\"",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RulePages) VisitWorkflowPre(n *Workflow) error {
+	rule.jobs = n.Jobs
+	rule.permissions = n.Permissions
+	rule.reusable = false
+	for _, e := range n.On {
+		if _, ok := e.(*WorkflowCallEvent); ok {
+			rule.reusable = true
+		}
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RulePages) VisitJobPre(n *Job) error {
+	deploy := -1
+	for i, s := range n.Steps {
+		if pagesActionStep(s, "actions/deploy-pages") != nil {
+			deploy = i
+			break
+		}
+	}
+	if deploy < 0 {
+		return nil
+	}
+	uses := pagesActionStep(n.Steps[deploy], "actions/deploy-pages")
+
+	rule.checkPermissions(n, uses)
+	rule.checkEnvironment(n, uses)
+	rule.checkSteps(n, deploy, uses)
+	return nil
+}
+
+func (rule *RulePages) checkPermissions(n *Job, deploy *String) {
+	p := n.Permissions
+	if p == nil {
+		p = rule.permissions
+	}
+	if p == nil {
+		if rule.reusable {
+			return // Permissions are given by the caller workflow
+		}
+		rule.Errorf(
+			deploy.Pos,
+			"%q requires \"pages: write\" and \"id-token: write\" permissions but \"permissions:\" is not set to job %q nor the workflow. the default permissions of GITHUB_TOKEN do not include \"id-token: write\"",
+			deploy.Value,
+			n.ID.Value,
+		)
+		return
+	}
+	if p.All != nil {
+		if p.All.Value != "write-all" {
+			rule.Errorf(
+				p.All.Pos,
+				"%q requires \"pages: write\" and \"id-token: write\" permissions but permissions of job %q are %q. set the permissions for each scope",
+				deploy.Value,
+				n.ID.Value,
+				p.All.Value,
+			)
+		}
+		return
+	}
+	for _, name := range pagesRequiredPermissions {
+		if s, ok := p.Scopes[name]; ok && s.Value != nil && s.Value.Value == "write" {
+			continue
+		}
+		rule.Errorf(
+			p.Pos,
+			"%q requires \"%s: write\" permission but it is not granted to job %q. add \"%s: write\" to \"permissions:\"",
+			deploy.Value,
+			name,
+			n.ID.Value,
+			name,
+		)
+	}
+}
+
+func (rule *RulePages) checkEnvironment(n *Job, deploy *String) {
+	if n.Environment == nil || n.Environment.Name == nil {
+		rule.Errorf(
+			deploy.Pos,
+			"%q requires \"github-pages\" environment but \"environment:\" is not set to job %q. add \"environment: github-pages\" to the job",
+			deploy.Value,
+			n.ID.Value,
+		)
+		return
+	}
+	e := n.Environment.Name
+	if e.ContainsExpression() || e.Value == "github-pages" {
+		return
+	}
+	rule.Errorf(
+		e.Pos,
+		"%q requires \"github-pages\" environment but environment of job %q is %q. GitHub Pages can only be deployed to \"github-pages\" environment",
+		deploy.Value,
+		n.ID.Value,
+		e.Value,
+	)
+}
+
+func (rule *RulePages) checkSteps(n *Job, deploy int, uses *String) {
+	// Uploading the artifact and configuring Pages may be done in the job itself or in the jobs it
+	// depends on.
+	var upload, configure *String
+	for i, s := range n.Steps {
+		if u := pagesActionStep(s, "actions/upload-pages-artifact"); u != nil && upload == nil {
+			if i > deploy {
+				rule.Errorf(
+					u.Pos,
+					"%q step must be run before %q step at line:%d. the site is deployed before it is uploaded",
+					u.Value,
+					uses.Value,
+					uses.Pos.Line,
+				)
+			}
+			upload = u
+		}
+		if c := pagesActionStep(s, "actions/configure-pages"); c != nil && configure == nil {
+			if upload != nil {
+				rule.Errorf(
+					c.Pos,
+					"%q step must be run before %q step at line:%d. the site should be built with the configuration of Pages before it is uploaded",
+					c.Value,
+					upload.Value,
+					upload.Pos.Line,
+				)
+			}
+			configure = c
+		}
+	}
+
+	if upload == nil || configure == nil {
+		for _, j := range rule.neededJobs(n) {
+			for _, s := range j.Steps {
+				if upload == nil {
+					upload = pagesActionStep(s, "actions/upload-pages-artifact")
+				}
+				if configure == nil {
+					configure = pagesActionStep(s, "actions/configure-pages")
+				}
+			}
+		}
+	}
+
+	if upload == nil {
+		rule.Errorf(
+			uses.Pos,
+			"\"actions/upload-pages-artifact\" action is not run in job %q nor the jobs it needs. %q deploys the artifact uploaded by the action",
+			n.ID.Value,
+			uses.Value,
+		)
+	}
+	if configure == nil {
+		rule.Errorf(
+			uses.Pos,
+			"\"actions/configure-pages\" action is not run in job %q nor the jobs it needs. run the action before building the site to enable Pages and get the metadata of the site",
+			n.ID.Value,
+		)
+	}
+}
+
+// neededJobs returns all the jobs which the job depends on directly or indirectly.
+func (rule *RulePages) neededJobs(n *Job) []*Job {
+	ret := []*Job{}
+	seen := map[string]struct{}{strings.ToLower(n.ID.Value): {}}
+	todo := append([]*String{}, n.Needs...)
+	for len(todo) > 0 {
+		id := strings.ToLower(todo[0].Value)
+		todo = todo[1:]
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		j, ok := rule.jobs[id]
+		if !ok {
+			continue // Unknown job is reported by "job-needs" rule
+		}
+		ret = append(ret, j)
+		todo = append(todo, j.Needs...)
+	}
+	return ret
+}
+
+// pagesActionStep returns the "uses:" value of the step when the step runs the action. Otherwise
+// it returns nil.
+func pagesActionStep(s *Step, action string) *String {
+	e, ok := s.Exec.(*ExecAction)
+	if !ok || e.Uses == nil {
+		return nil
+	}
+	slug, _, ok := strings.Cut(e.Uses.Value, "@")
+	if !ok || !strings.EqualFold(slug, action) {
+		return nil
+	}
+	return e.Uses
+}
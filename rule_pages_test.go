@@ -0,0 +1,70 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRulePagesNeededJobs(t *testing.T) {
+	src := `on: push
+jobs:
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make lint
+  build:
+    needs: [lint, Deploy]
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/configure-pages@v5
+      - uses: actions/upload-pages-artifact@v3
+  deploy:
+    needs: [build, unknown]
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/deploy-pages@v4
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r := NewRulePages()
+	if err := r.VisitWorkflowPre(w); err != nil {
+		t.Fatal(err)
+	}
+	js := r.neededJobs(w.Jobs["deploy"])
+	ids := make([]string, 0, len(js))
+	for _, j := range js {
+		ids = append(ids, j.ID.Value)
+	}
+	// Cyclic dependency via "Deploy" and unknown job are ignored
+	if len(ids) != 2 || ids[0] != "build" || ids[1] != "lint" {
+		t.Fatalf("unexpected needed jobs: %q", ids)
+	}
+}
+
+func TestRulePagesReusableWorkflow(t *testing.T) {
+	src := `on: workflow_call
+jobs:
+  deploy:
+    runs-on: ubuntu-latest
+    environment: github-pages
+    steps:
+      - uses: actions/configure-pages@v5
+      - uses: actions/upload-pages-artifact@v3
+      - uses: actions/deploy-pages@v4
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r := NewRulePages()
+	if err := r.VisitWorkflowPre(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.VisitJobPre(w.Jobs["deploy"]); err != nil {
+		t.Fatal(err)
+	}
+	if errs := r.Errs(); len(errs) > 0 {
+		t.Fatalf("permissions should be given by the caller workflow but got errors: %v", errs)
+	}
+}
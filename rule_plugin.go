@@ -0,0 +1,132 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// pluginProtocolVersion is the version of the protocol between actionlint and plugins. It is
+// increased when incompatible changes are made to the input or the output of plugins.
+const pluginProtocolVersion = 1
+
+// Names of plugins are used as rule names like "org-naming".
+var rePluginName = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// PluginConfig is configuration of a plugin in "plugins:" section of config file. A plugin is an
+// external executable which implements custom rules. It receives the parsed workflow as JSON via
+// stdin and outputs the errors as JSON to stdout.
+type PluginConfig struct {
+	// Name is the name of the plugin. It is used as the rule name of the errors reported by the
+	// plugin.
+	Name string `yaml:"name"`
+	// Command is a command name or a file path of the executable. A relative file path like
+	// "./scripts/lint.sh" is resolved from the repository root.
+	Command string `yaml:"command"`
+	// Args is list of arguments passed to the command.
+	Args []string `yaml:"args"`
+}
+
+// pluginInput is the JSON value written to stdin of plugins.
+type pluginInput struct {
+	Version  int       `json:"version"`
+	Path     string    `json:"path"`
+	Source   string    `json:"source"`
+	Workflow *Workflow `json:"workflow"`
+}
+
+// pluginError is an error reported by plugins.
+type pluginError struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+// pluginOutput is the JSON value which plugins output to stdout.
+type pluginOutput struct {
+	Errors []*pluginError `json:"errors"`
+}
+
+// RulePlugin is a rule to run an external executable configured in "plugins:" section of config
+// file. The parsed workflow is passed to the executable and the errors reported by it are merged
+// into the errors of actionlint.
+type RulePlugin struct {
+	RuleBase
+	cmd  *externalCommand
+	args []string
+	path string
+	src  []byte
+	mu   sync.Mutex
+}
+
+func newRulePlugin(name string, cmd *externalCommand, args []string, path string, src []byte) *RulePlugin {
+	return &RulePlugin{
+		RuleBase: RuleBase{
+			name: name,
+			desc: fmt.Sprintf("Checks by plugin %q", name),
+		},
+		cmd:  cmd,
+		args: args,
+		path: path,
+		src:  src,
+	}
+}
+
+// NewRulePlugin creates new RulePlugin instance. The root parameter is the repository root used
+// to resolve a relative file path of the command. The path and src parameters are the file path and
+// the source of the checked workflow. When the command of the plugin is not found in system, it
+// returns an error.
+func NewRulePlugin(cfg *PluginConfig, root, path string, src []byte, proc *concurrentProcess) (*RulePlugin, error) {
+	exe := cfg.Command
+	if root != "" && !filepath.IsAbs(exe) && strings.ContainsAny(exe, `/\`) {
+		exe = filepath.Join(root, exe)
+	}
+	cmd, err := proc.newCommandRunner(exe, false)
+	if err != nil {
+		return nil, fmt.Errorf("command %q of plugin %q was not found: %w", cfg.Command, cfg.Name, err)
+	}
+	return newRulePlugin(cfg.Name, cmd, cfg.Args, path, src), nil
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RulePlugin) VisitWorkflowPost(n *Workflow) error {
+	b, err := json.Marshal(&pluginInput{pluginProtocolVersion, rule.path, string(rule.src), n})
+	if err != nil {
+		return fmt.Errorf("could not encode workflow %s into JSON for plugin %q: %w", rule.path, rule.name, err)
+	}
+
+	rule.Debug("Running plugin command %s with %s for %s", rule.cmd.exe, rule.args, rule.path)
+	rule.cmd.run(rule.args, string(b), func(stdout []byte, err error) error {
+		if err != nil {
+			rule.Debug("Command %s %s failed: %v", rule.cmd.exe, rule.args, err)
+			return fmt.Errorf("plugin %q did not run successfully while checking %s: %w", rule.name, rule.path, err)
+		}
+
+		var out pluginOutput
+		if err := json.Unmarshal(stdout, &out); err != nil {
+			return fmt.Errorf("could not parse JSON output from plugin %q: %w: stdout=%q", rule.name, err, stdout)
+		}
+
+		rule.mu.Lock()
+		defer rule.mu.Unlock()
+		for _, e := range out.Errors {
+			if e == nil || e.Message == "" {
+				continue
+			}
+			pos := &Pos{Line: e.Line, Col: e.Column}
+			if pos.Line < 1 {
+				pos.Line = 1
+			}
+			if pos.Col < 1 {
+				pos.Col = 1
+			}
+			rule.Error(pos, e.Message)
+		}
+		return nil
+	})
+
+	return rule.cmd.wait() // Wait until the plugin process finishes
+}
@@ -0,0 +1,156 @@
+package actionlint
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func testWritePlugin(t *testing.T, dir, name, script string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRulePluginReportErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake plugin command is a shell script")
+	}
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.json")
+	testWritePlugin(t, dir, "plugin.sh", `echo "$*" > '`+input+`.args'
+cat > '`+input+`'
+echo '{"errors":[{"line":3,"column":5,"message":"job ID must start with \"ci-\""},{"message":"no position"}]}'
+exit 1
+`)
+
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n"
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	proc := newConcurrentProcess(context.Background(), 2)
+	cfg := &PluginConfig{Name: "org-naming", Command: "./plugin.sh", Args: []string{"--strict"}}
+	r, err := NewRulePlugin(cfg, dir, "test.yaml", []byte(src), proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name() != "org-naming" {
+		t.Fatalf("unexpected rule name %q", r.Name())
+	}
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+	proc.wait()
+
+	errs = r.Errs()
+	if len(errs) != 2 {
+		t.Fatalf("wanted 2 errors but got %d: %v", len(errs), errs)
+	}
+	for i, want := range []struct {
+		line, col int
+		msg       string
+	}{
+		{3, 5, `job ID must start with "ci-"`},
+		{1, 1, "no position"},
+	} {
+		e := errs[i]
+		if e.Line != want.line || e.Column != want.col || e.Message != want.msg || e.Kind != "org-naming" {
+			t.Errorf("unexpected error #%d: %v", i, e)
+		}
+	}
+
+	b, err := os.ReadFile(input + ".args")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := strings.TrimSpace(string(b)); have != "--strict" {
+		t.Errorf("unexpected arguments %q", have)
+	}
+
+	b, err = os.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var in struct {
+		Version  int    `json:"version"`
+		Path     string `json:"path"`
+		Source   string `json:"source"`
+		Workflow struct {
+			Jobs map[string]struct {
+				ID struct {
+					Value string
+					Pos   *Pos
+				}
+			}
+		} `json:"workflow"`
+	}
+	if err := json.Unmarshal(b, &in); err != nil {
+		t.Fatalf("invalid JSON input %q: %v", b, err)
+	}
+	if in.Version != pluginProtocolVersion || in.Path != "test.yaml" || in.Source != src {
+		t.Errorf("unexpected input: %s", b)
+	}
+	j, ok := in.Workflow.Jobs["test"]
+	if !ok || j.ID.Value != "test" || j.ID.Pos == nil || j.ID.Pos.Line != 3 || j.ID.Pos.Col != 3 {
+		t.Errorf("job was not passed to plugin: %s", b)
+	}
+}
+
+func TestRulePluginCommandError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake plugin command is a shell script")
+	}
+
+	dir := t.TempDir()
+	testWritePlugin(t, dir, "fail.sh", "echo 'oops' >&2\nexit 2\n")
+	testWritePlugin(t, dir, "broken.sh", "echo 'not json'\n")
+
+	testCases := []struct {
+		what string
+		cmd  string
+		want string
+	}{
+		{"exit status", "./fail.sh", `plugin "test" did not run successfully while checking test.yaml`},
+		{"broken output", "./broken.sh", `could not parse JSON output from plugin "test"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			proc := newConcurrentProcess(context.Background(), 1)
+			r, err := NewRulePlugin(&PluginConfig{Name: "test", Command: tc.cmd}, dir, "test.yaml", nil, proc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = r.VisitWorkflowPost(&Workflow{})
+			proc.wait()
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestRulePluginCommandNotFound(t *testing.T) {
+	proc := newConcurrentProcess(context.Background(), 1)
+	defer proc.wait()
+	_, err := NewRulePlugin(&PluginConfig{Name: "test", Command: "./this-command-does-not-exist"}, t.TempDir(), "test.yaml", nil, proc)
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	if want := `command "./this-command-does-not-exist" of plugin "test" was not found`; !strings.Contains(err.Error(), want) {
+		t.Fatalf("wanted %q in error message but got %q", want, err.Error())
+	}
+}
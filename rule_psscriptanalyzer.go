@@ -0,0 +1,177 @@
+package actionlint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// psscriptanalyzerCommand is a PowerShell command to run PSScriptAnalyzer for the script given via
+// stdin. Diagnostics are output as JSON array. Severity is converted to string since ConvertTo-Json
+// outputs enum values as numbers.
+const psscriptanalyzerCommand = "$ErrorActionPreference = 'Stop'; " +
+	"Invoke-ScriptAnalyzer -ScriptDefinition ([Console]::In.ReadToEnd()) | " +
+	"Select-Object RuleName, @{Name='Severity'; Expression={[string]$_.Severity}}, Line, Column, Message | " +
+	"ConvertTo-Json -AsArray -Compress"
+
+// psscriptanalyzerError is a diagnostic record output by Invoke-ScriptAnalyzer.
+type psscriptanalyzerError struct {
+	RuleName string `json:"RuleName"`
+	Severity string `json:"Severity"`
+	Line     int    `json:"Line"`
+	Column   int    `json:"Column"`
+	Message  string `json:"Message"`
+}
+
+func isPowerShell(shell string) bool {
+	for _, sh := range []string{"pwsh", "powershell"} {
+		if shell == sh || strings.HasPrefix(shell, sh+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// RulePSScriptAnalyzer is a rule to check PowerShell scripts at 'run:' using PSScriptAnalyzer.
+// https://github.com/PowerShell/PSScriptAnalyzer
+type RulePSScriptAnalyzer struct {
+	RuleBase
+	cmd           *externalCommand
+	workflowShell string
+	jobShell      string
+	runnerShell   string
+	mu            sync.Mutex
+}
+
+func newRulePSScriptAnalyzer(cmd *externalCommand) *RulePSScriptAnalyzer {
+	return &RulePSScriptAnalyzer{
+		RuleBase: RuleBase{
+			name: "psscriptanalyzer",
+			desc: "Checks for PowerShell script when \"shell: pwsh\" or \"shell: powershell\" is configured using PSScriptAnalyzer",
+		},
+		cmd:           cmd,
+		workflowShell: "",
+		jobShell:      "",
+		runnerShell:   "",
+	}
+}
+
+// NewRulePSScriptAnalyzer creates new RulePSScriptAnalyzer instance. Parameter executable is
+// PowerShell 7 or later where PSScriptAnalyzer module is installed. It can be command name like
+// "pwsh" or relative/absolute file path. When the given executable is not found in system, it
+// returns an error.
+func NewRulePSScriptAnalyzer(executable string, proc *concurrentProcess) (*RulePSScriptAnalyzer, error) {
+	// Do not combine output since stdout must be JSON
+	cmd, err := proc.newCommandRunner(executable, false)
+	if err != nil {
+		return nil, err
+	}
+	return newRulePSScriptAnalyzer(cmd), nil
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RulePSScriptAnalyzer) VisitWorkflowPre(n *Workflow) error {
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
+		rule.workflowShell = n.Defaults.Run.Shell.Value
+	}
+	return nil
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RulePSScriptAnalyzer) VisitWorkflowPost(n *Workflow) error {
+	rule.workflowShell = ""
+	return rule.cmd.wait() // Wait until all processes running for this rule
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RulePSScriptAnalyzer) VisitJobPre(n *Job) error {
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
+		rule.jobShell = n.Defaults.Run.Shell.Value
+	}
+	// Default shell on Windows is PowerShell.
+	// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#using-a-specific-shell
+	if getPlatformFromRunner(n.RunsOn) == platformKindWindows {
+		rule.runnerShell = "pwsh"
+	}
+	return nil
+}
+
+// VisitJobPost is callback when visiting Job node after visiting its children.
+func (rule *RulePSScriptAnalyzer) VisitJobPost(n *Job) error {
+	rule.jobShell = ""
+	rule.runnerShell = ""
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RulePSScriptAnalyzer) VisitStep(n *Step) error {
+	run, ok := n.Exec.(*ExecRun)
+	if !ok || run.Run == nil {
+		return nil
+	}
+
+	if !isPowerShell(rule.getShellName(run)) {
+		return nil
+	}
+
+	rule.runPSScriptAnalyzer(run.Run.Value, run.RunPos)
+	return nil
+}
+
+func (rule *RulePSScriptAnalyzer) getShellName(exec *ExecRun) string {
+	if exec.Shell != nil {
+		return exec.Shell.Value
+	}
+	if rule.jobShell != "" {
+		return rule.jobShell
+	}
+	if rule.workflowShell != "" {
+		return rule.workflowShell
+	}
+	return rule.runnerShell
+}
+
+func (rule *RulePSScriptAnalyzer) runPSScriptAnalyzer(src string, pos *Pos) {
+	src = sanitizeExpressionsInScript(src) // Defined at rule_shellcheck.go
+
+	// GitHub Actions runs PowerShell scripts with this preference prepended
+	// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#exit-codes-and-error-action-preference
+	script := "$ErrorActionPreference = 'stop'\n" + src
+	rule.Debug("%s: Running %s for PowerShell script:\n%s", pos, rule.cmd.exe, script)
+
+	args := []string{"-NoLogo", "-NoProfile", "-NonInteractive", "-Command", psscriptanalyzerCommand}
+	rule.cmd.run(args, script, func(stdout []byte, err error) error {
+		if err != nil {
+			rule.Debug("Command %s failed: %v", rule.cmd.exe, err)
+			return fmt.Errorf("`%s` did not run PSScriptAnalyzer successfully while checking script at %s: %w", rule.cmd.exe, pos, err)
+		}
+		return rule.parseErrors(stdout, pos)
+	})
+}
+
+func (rule *RulePSScriptAnalyzer) parseErrors(stdout []byte, pos *Pos) error {
+	if len(stdout) == 0 {
+		return nil
+	}
+
+	var errs []psscriptanalyzerError
+	if err := json.Unmarshal(stdout, &errs); err != nil {
+		return fmt.Errorf("could not parse JSON output from PSScriptAnalyzer while checking script at %s: %w: stdout=%q", pos, err, stdout)
+	}
+
+	// This method needs to be thread-safe since concurrentProcess.run calls its callback in a different goroutine.
+	rule.mu.Lock()
+	defer rule.mu.Unlock()
+	for i := range errs {
+		err := &errs[i]
+		// Consider the first line is setup for running PowerShell which was implicitly added for better check
+		line := err.Line - 1
+		if line <= 0 {
+			continue // Issue in the setup line is not related to the script
+		}
+		msg := strings.TrimSuffix(strings.TrimSpace(err.Message), ".") // Trim period aligning style of error message
+		rule.Errorf(pos, "PSScriptAnalyzer reported issue in this script: %s:%s:%d:%d: %s", err.RuleName, err.Severity, line, err.Column, msg)
+	}
+	return nil
+}
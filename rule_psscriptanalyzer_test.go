@@ -0,0 +1,274 @@
+package actionlint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestRulePSScriptAnalyzerDetectPowerShell(t *testing.T) {
+	tests := []struct {
+		what     string
+		want     bool
+		workflow string // Shell name set at 'defaults' in Workflow node
+		job      string // Shell name set at 'defaults' in Job node
+		step     string // Shell name set at 'shell' in Step node
+		runner   string // Runner label set at 'runs-on' in Job node
+	}{
+		{
+			what: "no default shell",
+			want: false,
+		},
+		{
+			what:     "workflow default",
+			want:     true,
+			workflow: "pwsh",
+		},
+		{
+			what: "job default",
+			want: true,
+			job:  "powershell",
+		},
+		{
+			what: "step shell",
+			want: true,
+			step: "pwsh",
+		},
+		{
+			what:     "custom shell",
+			want:     true,
+			workflow: "pwsh -File {0}",
+		},
+		{
+			what:   "Windows runner",
+			want:   true,
+			runner: "windows-latest",
+		},
+		{
+			what:   "bash on Windows runner",
+			want:   false,
+			runner: "windows-latest",
+			step:   "bash",
+		},
+		{
+			what:   "Linux runner",
+			want:   false,
+			runner: "ubuntu-latest",
+		},
+		{
+			what:     "step shell overrides default",
+			want:     false,
+			workflow: "pwsh",
+			step:     "bash",
+		},
+		{
+			what:     "other shell",
+			want:     false,
+			workflow: "python",
+		},
+		{
+			what:     "other shell starting with pwsh",
+			want:     false,
+			workflow: "pwsh-preview",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			r := newRulePSScriptAnalyzer(&externalCommand{})
+
+			w := &Workflow{}
+			if tc.workflow != "" {
+				w.Defaults = &Defaults{
+					Run: &DefaultsRun{
+						Shell: &String{Value: tc.workflow},
+					},
+				}
+			}
+			r.VisitWorkflowPre(w)
+
+			j := &Job{}
+			if tc.job != "" {
+				j.Defaults = &Defaults{
+					Run: &DefaultsRun{
+						Shell: &String{Value: tc.job},
+					},
+				}
+			}
+			if tc.runner != "" {
+				j.RunsOn = &Runner{
+					Labels: []*String{{Value: tc.runner}},
+				}
+			}
+			r.VisitJobPre(j)
+
+			e := &ExecRun{}
+			if tc.step != "" {
+				e.Shell = &String{Value: tc.step}
+			}
+			if have := isPowerShell(r.getShellName(e)); have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRulePSScriptAnalyzerParseOutput(t *testing.T) {
+	tests := []struct {
+		what  string
+		input string
+		want  []string
+	}{
+		{
+			what:  "no output",
+			input: "",
+		},
+		{
+			what:  "no error",
+			input: "[]",
+		},
+		{
+			what:  "single error",
+			input: `[{"RuleName":"PSAvoidUsingCmdletAliases","Severity":"Warning","Line":2,"Column":1,"Message":"'ls' is an alias of 'Get-ChildItem'. Alias can introduce possible problems and make scripts hard to maintain. Please consider changing alias to its full content."}]`,
+			want: []string{
+				"PSScriptAnalyzer reported issue in this script: PSAvoidUsingCmdletAliases:Warning:1:1: 'ls' is an alias of 'Get-ChildItem'. Alias can introduce possible problems and make scripts hard to maintain. Please consider changing alias to its full content",
+			},
+		},
+		{
+			what: "multiple errors",
+			input: `[{"RuleName":"PSAvoidUsingWriteHost","Severity":"Warning","Line":3,"Column":5,"Message":"File uses Write-Host."},` +
+				`{"RuleName":"PSUseDeclaredVarsMoreThanAssignments","Severity":"Warning","Line":4,"Column":1,"Message":"The variable 'x' is assigned but never used."}]`,
+			want: []string{
+				"PSScriptAnalyzer reported issue in this script: PSAvoidUsingWriteHost:Warning:2:5: File uses Write-Host",
+				"PSScriptAnalyzer reported issue in this script: PSUseDeclaredVarsMoreThanAssignments:Warning:3:1: The variable 'x' is assigned but never used",
+			},
+		},
+		{
+			what:  "ignore setup line",
+			input: `[{"RuleName":"PSUseDeclaredVarsMoreThanAssignments","Severity":"Warning","Line":1,"Column":1,"Message":"The variable 'ErrorActionPreference' is assigned but never used."}]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			r := newRulePSScriptAnalyzer(&externalCommand{})
+			if err := r.parseErrors([]byte(tc.input), &Pos{Line: 1, Col: 2}); err != nil {
+				t.Fatal(err)
+			}
+			have := r.Errs()
+			if len(have) != len(tc.want) {
+				t.Fatalf("wanted %d errors but got %d errors: %v", len(tc.want), len(have), have)
+			}
+			for i, want := range tc.want {
+				if have[i].Message != want {
+					t.Errorf("wanted %q but got %q", want, have[i].Message)
+				}
+				if have[i].Line != 1 || have[i].Column != 2 {
+					t.Errorf("error is not reported at 'run:' section: %v", have[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRulePSScriptAnalyzerParseOutputError(t *testing.T) {
+	r := newRulePSScriptAnalyzer(&externalCommand{})
+	err := r.parseErrors([]byte("Invoke-ScriptAnalyzer: The term 'Invoke-ScriptAnalyzer' is not recognized"), &Pos{})
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	want := "could not parse JSON output from PSScriptAnalyzer"
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("error %q does not contain %q", err.Error(), want)
+	}
+}
+
+func TestRulePSScriptAnalyzerRunCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake pwsh command is a shell script")
+	}
+
+	dir := t.TempDir()
+	stdin := filepath.Join(dir, "stdin.ps1")
+	exe := filepath.Join(dir, "pwsh")
+	fake := `#!/bin/sh
+cat > '` + stdin + `'
+printf '[{"RuleName":"PSAvoidUsingCmdletAliases","Severity":"Warning","Line":3,"Column":1,"Message":"alias is used."}]'
+`
+	if err := os.WriteFile(exe, []byte(fake), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	proc := newConcurrentProcess(context.Background(), 1)
+	r, err := NewRulePSScriptAnalyzer(exe, proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.VisitWorkflowPre(&Workflow{})
+	r.VisitJobPre(&Job{RunsOn: &Runner{Labels: []*String{{Value: "windows-latest"}}}})
+	s := &Step{Exec: &ExecRun{Run: &String{Value: "echo ${{ github.sha }}\nls\n"}, RunPos: &Pos{Line: 7, Col: 9}}}
+	if err := r.VisitStep(s); err != nil {
+		t.Fatal(err)
+	}
+	r.VisitJobPost(&Job{})
+	if err := r.VisitWorkflowPost(&Workflow{}); err != nil {
+		t.Fatal(err)
+	}
+	proc.wait()
+
+	b, err := os.ReadFile(stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "$ErrorActionPreference = 'stop'\necho _________________\nls\n", string(b); want != have {
+		t.Fatalf("wanted script %q but got %q", want, have)
+	}
+
+	errs := r.Errs()
+	if len(errs) != 1 {
+		t.Fatalf("wanted 1 error but got %d: %v", len(errs), errs)
+	}
+	want := "PSScriptAnalyzer reported issue in this script: PSAvoidUsingCmdletAliases:Warning:2:1: alias is used"
+	if errs[0].Message != want {
+		t.Fatalf("wanted %q but got %q", want, errs[0].Message)
+	}
+	if errs[0].Line != 7 || errs[0].Column != 9 {
+		t.Fatalf("error is not reported at 'run:' section: %v", errs[0])
+	}
+}
+
+func TestRulePSScriptAnalyzerCommandFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake pwsh command is a shell script")
+	}
+
+	exe := filepath.Join(t.TempDir(), "pwsh")
+	if err := os.WriteFile(exe, []byte("#!/bin/sh\necho 'Invoke-ScriptAnalyzer is not recognized' >&2\nexit 1\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	proc := newConcurrentProcess(context.Background(), 1)
+	r, err := NewRulePSScriptAnalyzer(exe, proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.VisitWorkflowPre(&Workflow{})
+	r.VisitJobPre(&Job{})
+	s := &Step{Exec: &ExecRun{Run: &String{Value: "ls"}, Shell: &String{Value: "pwsh"}, RunPos: &Pos{Line: 1, Col: 1}}}
+	if err := r.VisitStep(s); err != nil {
+		t.Fatal(err)
+	}
+	r.VisitJobPost(&Job{})
+	err = r.VisitWorkflowPost(&Workflow{})
+	proc.wait()
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+	want := "did not run PSScriptAnalyzer successfully"
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("error %q does not contain %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,212 @@
+package actionlint
+
+import (
+	"strings"
+)
+
+// refNameProps is a map from properties of `github` context which are ref names to whether they
+// are full ref names starting with "refs/". Other properties are short names like "main" which
+// never start with "refs/".
+var refNameProps = map[string]bool{
+	"ref":      true,
+	"ref_name": false,
+	"base_ref": false,
+	"head_ref": false,
+}
+
+// RuleRefName is a rule checker to detect confusions of full ref names like "refs/heads/main" and
+// short ref names like "main" in "if:" conditions. For example, `github.ref == 'main'` is always
+// false since `github.ref` is always a full ref name.
+type RuleRefName struct {
+	RuleBase
+}
+
+// NewRuleRefName creates a new RuleRefName instance.
+func NewRuleRefName() *RuleRefName {
+	return &RuleRefName{
+		RuleBase: RuleBase{
+			name: "ref-name",
+			desc: "Checks for comparisons of full ref names and short ref names which are always false",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRefName) VisitJobPre(n *Job) error {
+	rule.checkCond(n.If)
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleRefName) VisitStep(n *Step) error {
+	rule.checkCond(n.If)
+	return nil
+}
+
+func (rule *RuleRefName) checkCond(cond *String) {
+	if cond == nil {
+		return
+	}
+
+	src := strings.TrimSpace(cond.Value)
+	if cond.IsExpressionAssigned() {
+		src = src[3 : len(src)-2]
+	} else if cond.ContainsExpression() {
+		return
+	}
+	if !strings.Contains(src, "ref") {
+		return
+	}
+	expr, err := NewExprParser().Parse(NewExprLexer(src + "}}"))
+	if err != nil {
+		return // Syntax error is reported by "expression" rule
+	}
+
+	VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+		if !entering {
+			return
+		}
+		switch n := n.(type) {
+		case *CompareOpNode:
+			rule.checkCompare(n, cond.Pos)
+		case *FuncCallNode:
+			rule.checkStartsWith(n, cond.Pos)
+		}
+	})
+}
+
+func (rule *RuleRefName) checkCompare(n *CompareOpNode, pos *Pos) {
+	if n.Kind != CompareOpNodeKindEq && n.Kind != CompareOpNodeKindNotEq {
+		return
+	}
+
+	prop, full, ok := refNameProp(n.Left)
+	operand := n.Right
+	if !ok {
+		if prop, full, ok = refNameProp(n.Right); !ok {
+			return
+		}
+		operand = n.Left
+	}
+	s, ok := constantString(operand)
+	if !ok || hasRefsPrefix(s) == full {
+		return
+	}
+
+	always := "false"
+	if n.Kind == CompareOpNodeKindNotEq {
+		always = "true"
+	}
+
+	if full {
+		rule.Errorf(
+			pos,
+			"\"github.%s\" is compared with %q in \"if:\" condition but \"github.%s\" is always a full ref name starting with \"refs/\" such as \"refs/heads/main\" so the comparison is always %s. prepend \"refs/heads/\" or \"refs/tags/\" to the string or use \"github.ref_name\" instead",
+			prop,
+			s,
+			prop,
+			always,
+		)
+		return
+	}
+
+	alt := ""
+	if prop == "ref_name" {
+		alt = " or use \"github.ref\" instead"
+	}
+	rule.Errorf(
+		pos,
+		"\"github.%s\" is compared with %q in \"if:\" condition but \"github.%s\" is a short ref name such as \"main\" which never starts with \"refs/\" so the comparison is always %s. compare it with %q%s",
+		prop,
+		s,
+		prop,
+		always,
+		shortRefName(s),
+		alt,
+	)
+}
+
+func (rule *RuleRefName) checkStartsWith(n *FuncCallNode, pos *Pos) {
+	if !strings.EqualFold(n.Callee, "startsWith") || len(n.Args) != 2 {
+		return
+	}
+	prop, full, ok := refNameProp(n.Args[0])
+	if !ok {
+		return
+	}
+	s, ok := constantString(n.Args[1])
+	if !ok {
+		return
+	}
+
+	if full {
+		// Prefix like "ref" is a prefix of "refs/" so it is always true rather than always false
+		if hasRefsPrefix(s) || strings.HasPrefix("refs/", strings.ToLower(s)) {
+			return
+		}
+		rule.Errorf(
+			pos,
+			"startsWith(github.%s, %q) in \"if:\" condition is always false since \"github.%s\" always starts with \"refs/\". use %q as prefix or use \"github.ref_name\" instead",
+			prop,
+			s,
+			prop,
+			"refs/tags/"+s,
+		)
+		return
+	}
+
+	if !hasRefsPrefix(s) {
+		return
+	}
+	rule.Errorf(
+		pos,
+		"startsWith(github.%s, %q) in \"if:\" condition is always false since \"github.%s\" is a short ref name which never starts with \"refs/\". use %q as prefix",
+		prop,
+		s,
+		prop,
+		shortRefName(s),
+	)
+}
+
+// refNameProp returns the property name of `github` context when the node is a ref name like
+// `github.ref`. The second return value is true when the ref name is a full ref name.
+func refNameProp(n ExprNode) (string, bool, bool) {
+	d, ok := n.(*ObjectDerefNode)
+	if !ok {
+		return "", false, false
+	}
+	v, ok := d.Receiver.(*VariableNode)
+	if !ok || strings.ToLower(v.Name) != "github" {
+		return "", false, false
+	}
+	p := strings.ToLower(d.Property)
+	full, ok := refNameProps[p]
+	return p, full, ok
+}
+
+// constantString folds the expression node into a string when its value does not depend on any
+// context. For example, `format('refs/heads/{0}', 'main')` is folded into "refs/heads/main".
+func constantString(n ExprNode) (string, bool) {
+	v, err := NewExprEvaluator(nil).Eval(n)
+	if err != nil {
+		return "", false
+	}
+	s, ok := v.(string)
+	return s, ok
+}
+
+// hasRefsPrefix returns if the string starts with "refs/". Strings are compared case-insensitively
+// in expressions.
+func hasRefsPrefix(s string) bool {
+	return strings.HasPrefix(strings.ToLower(s), "refs/")
+}
+
+// shortRefName removes "refs/heads/" or "refs/tags/" prefix from the ref name.
+func shortRefName(s string) string {
+	for _, p := range []string{"refs/heads/", "refs/tags/"} {
+		if len(s) >= len(p) && strings.EqualFold(s[:len(p)], p) {
+			return s[len(p):]
+		}
+	}
+	return s
+}
@@ -0,0 +1,141 @@
+package actionlint
+
+import (
+	"regexp"
+	"strings"
+)
+
+var reGhReleaseCreateWithRef = regexp.MustCompile(`\bgh\s+release\s+create\b[^\n]*(?:\$\{?GITHUB_REF(?:_NAME)?\b|\$\{\{\s*github\.ref(?:_name)?\s*\}\})`)
+
+// RuleReleaseTrigger is a rule to check consistency between workflow triggers and steps creating
+// GitHub releases. It detects workflows which create a release from the pushed tag but are only
+// triggered by pushing branches. When a GitHub API client is given, it also checks that tag filters
+// at "on.push.tags" match at least one tag in the repository.
+type RuleReleaseTrigger struct {
+	RuleBase
+	client *GitHubAPIClient
+	repo   string
+	onTag  bool
+	filter []*String
+}
+
+// NewRuleReleaseTrigger creates a new RuleReleaseTrigger instance. The client parameter is used to
+// fetch tags of the repository. When it is nil, the tags are not checked. The repo parameter is a
+// slug of the repository like "owner/name" which the workflow belongs to.
+func NewRuleReleaseTrigger(client *GitHubAPIClient, repo string) *RuleReleaseTrigger {
+	return &RuleReleaseTrigger{
+		RuleBase: RuleBase{
+			name: "release-trigger",
+			desc: "Checks for consistency between workflow triggers and steps creating releases",
+		},
+		client: client,
+		repo:   repo,
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleReleaseTrigger) VisitWorkflowPre(n *Workflow) error {
+	rule.onTag = len(n.On) == 0 // Avoid false positives when "on:" is missing
+	rule.filter = nil
+
+	for _, e := range n.On {
+		switch e := e.(type) {
+		case *WebhookEvent:
+			switch e.Hook.Value {
+			case "push":
+				if !e.Tags.IsEmpty() || !e.TagsIgnore.IsEmpty() || (e.Branches.IsEmpty() && e.BranchesIgnore.IsEmpty()) {
+					rule.onTag = true
+				}
+				if !e.Tags.IsEmpty() {
+					rule.filter = append(rule.filter, e.Tags.Values...)
+				}
+			case "create", "release":
+				rule.onTag = true
+			}
+			// Other webhook events run on the default branch or on refs/pull/... refs
+		case *WorkflowDispatchEvent, *WorkflowCallEvent:
+			// A tag can be selected on dispatching the workflow. A workflow caller can run on a tag
+			rule.onTag = true
+		}
+	}
+
+	rule.checkTagFilter()
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleReleaseTrigger) VisitStep(n *Step) error {
+	if rule.onTag {
+		return nil
+	}
+
+	switch e := n.Exec.(type) {
+	case *ExecAction:
+		if e.Uses == nil {
+			return nil
+		}
+		spec := e.Uses.Value
+		// Tag name input is optional for these actions. They fall back to the tag of `github.ref`
+		if strings.HasPrefix(spec, "softprops/action-gh-release@") {
+			if _, ok := e.Inputs["tag_name"]; !ok {
+				rule.releaseFromRef(e.Uses.Pos, spec, "set \"tag_name\" input")
+			}
+		} else if strings.HasPrefix(spec, "ncipollo/release-action@") {
+			if _, ok := e.Inputs["tag"]; !ok {
+				rule.releaseFromRef(e.Uses.Pos, spec, "set \"tag\" input")
+			}
+		}
+	case *ExecRun:
+		if e.Run != nil && reGhReleaseCreateWithRef.MatchString(e.Run.Value) {
+			rule.releaseFromRef(e.Run.Pos, "gh release create", "specify the tag name explicitly")
+		}
+	}
+	return nil
+}
+
+func (rule *RuleReleaseTrigger) releaseFromRef(pos *Pos, what, fix string) {
+	rule.Errorf(
+		pos,
+		"%q creates a release for the tag which triggered the workflow, but this workflow is never triggered by tags. add \"tags\" filter to \"push\" event or %s",
+		what,
+		fix,
+	)
+}
+
+func (rule *RuleReleaseTrigger) checkTagFilter() {
+	if rule.client == nil || rule.repo == "" || len(rule.filter) == 0 {
+		return
+	}
+
+	pats := make([]string, 0, len(rule.filter))
+	for _, f := range rule.filter {
+		if f.ContainsExpression() {
+			return
+		}
+		pats = append(pats, f.Value)
+	}
+
+	tags, err := rule.client.ListTags(rule.repo)
+	if err != nil {
+		rule.Debug("Could not fetch tags of repository %s: %s", rule.repo, err)
+		return
+	}
+	if len(tags) == 0 {
+		// The repository may not be released yet
+		return
+	}
+
+	for _, t := range tags {
+		if matchGlobFilter(pats, t) {
+			return
+		}
+	}
+
+	rule.Errorf(
+		rule.filter[0].Pos,
+		"none of %d tags in repository %q matches tag filters %s at \"on.push.tags\". release automation triggered by the filters has never run. check the tag naming scheme of the repository",
+		len(tags),
+		rule.repo,
+		sortedQuotes(pats),
+	)
+}
@@ -0,0 +1,107 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func testReleaseTriggerPushTagsWorkflow(pats ...string) *Workflow {
+	vs := make([]*String, 0, len(pats))
+	for i, p := range pats {
+		vs = append(vs, &String{Value: p, Pos: &Pos{Line: 5 + i, Col: 9}})
+	}
+	return &Workflow{
+		On: []Event{
+			&WebhookEvent{
+				Hook: &String{Value: "push", Pos: &Pos{}},
+				Tags: &WebhookEventFilter{
+					Name:   &String{Value: "tags", Pos: &Pos{}},
+					Values: vs,
+				},
+				Pos: &Pos{},
+			},
+		},
+	}
+}
+
+func TestRuleReleaseTriggerCheckTagFilter(t *testing.T) {
+	tags := []string{"v1.0.0", "v1.1.0", "v2.0.0-rc1"}
+
+	testCases := []struct {
+		what string
+		pats []string
+		err  bool
+	}{
+		{"matched", []string{"v*"}, false},
+		{"one of patterns matched", []string{"release-*", "v[0-9]+.[0-9]+.[0-9]+"}, false},
+		{"pre-release matched", []string{"v*-rc*"}, false},
+		{"not matched", []string{"release-*"}, true},
+		{"version without prefix", []string{"[0-9]+.[0-9]+.[0-9]+"}, true},
+		{"all matched tags are excluded", []string{"v*", "!v*"}, true},
+		{"expression is skipped", []string{"${{ env.TAG }}"}, false},
+	}
+
+	requests := 0
+	s := testGitHubTagsServer(t, tags, &requests)
+	defer s.Close()
+	c := NewGitHubAPIClient(s.URL, "dummy-token", nil)
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			r := NewRuleReleaseTrigger(c, "owner/repo")
+			if err := r.VisitWorkflowPre(testReleaseTriggerPushTagsWorkflow(tc.pats...)); err != nil {
+				t.Fatal(err)
+			}
+			errs := r.Errs()
+			if !tc.err {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			want := `none of 3 tags in repository "owner/repo" matches tag filters`
+			if msg := errs[0].Message; !strings.Contains(msg, want) {
+				t.Fatalf("error message %q does not contain %q", msg, want)
+			}
+			if errs[0].Line != 5 || errs[0].Column != 9 {
+				t.Fatalf("error position is unexpected: %d:%d", errs[0].Line, errs[0].Column)
+			}
+		})
+	}
+
+	if requests != 1 {
+		t.Fatalf("tags were not cached. %d requests were sent", requests)
+	}
+}
+
+func TestRuleReleaseTriggerSkipTagFilterCheck(t *testing.T) {
+	requests := 0
+	s := testGitHubTagsServer(t, []string{}, &requests)
+	defer s.Close()
+
+	testCases := []struct {
+		what   string
+		client *GitHubAPIClient
+		repo   string
+	}{
+		{"offline", nil, "owner/repo"},
+		{"unknown repository", NewGitHubAPIClient(s.URL, "dummy-token", nil), ""},
+		{"no tags", NewGitHubAPIClient(s.URL, "dummy-token", nil), "owner/repo"},
+		{"API error", NewGitHubAPIClient(s.URL, "dummy-token", nil), "owner/unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			r := NewRuleReleaseTrigger(tc.client, tc.repo)
+			if err := r.VisitWorkflowPre(testReleaseTriggerPushTagsWorkflow("v*")); err != nil {
+				t.Fatal(err)
+			}
+			if errs := r.Errs(); len(errs) > 0 {
+				t.Fatalf("wanted no error but got %v", errs)
+			}
+		})
+	}
+}
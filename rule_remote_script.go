@@ -0,0 +1,279 @@
+package actionlint
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+const remoteScriptInterpreters = `sh|bash|zsh|dash|ksh|fish|python[0-9.]*|perl|ruby|node`
+
+var (
+	// `curl -fsSL https://example.com/install.sh | sudo -E bash -`
+	reRemoteScriptPipe = regexp.MustCompile(`\b(curl|wget)\b[^|;&\n]*\|\s*(?:sudo\s+(?:-[a-zA-Z]+\s+)*)?(?:[A-Za-z_][A-Za-z0-9_]*=\S*\s+)*(` + remoteScriptInterpreters + `)\b`)
+	// `bash <(curl -fsSL https://example.com/install.sh)` or `sh -c "$(curl -fsSL https://example.com/install.sh)"`
+	reRemoteScriptSubst = regexp.MustCompile(`\b(` + remoteScriptInterpreters + `)\s+(?:-[a-zA-Z]+\s+)*(?:<\(|-c\s+["']?\$\()\s*(curl|wget)\b[^)\n]*`)
+	// `iwr https://example.com/install.ps1 | iex` on PowerShell
+	reRemoteScriptPwsh = regexp.MustCompile(`(?i)\b(iwr|irm|Invoke-WebRequest|Invoke-RestMethod)\b[^|;\n]*\|\s*(iex|Invoke-Expression)\b`)
+	reRemoteScriptURL  = regexp.MustCompile(`https?://[^\s'"|;)]+`)
+	// Commands to verify checksums or signatures of downloaded files
+	reRemoteScriptVerify = regexp.MustCompile(`\b(?:sha(?:1|224|256|384|512)sum|shasum|md5sum|b2sum|cosign|minisign|gpgv?|slsa-verifier|openssl\s+dgst|gh\s+attestation\s+verify)\b`)
+	// Separators of commands in shell script
+	reRemoteScriptCommandSep = regexp.MustCompile(`\|\||&&|[;|&\n]`)
+	reRemoteScriptInterp     = regexp.MustCompile(`^(?:` + remoteScriptInterpreters + `|source|\.)$`)
+	// Building a replacer is costly so it is shared by all calls of splitRemoteScriptCommands
+	remoteScriptQuoteRemover = strings.NewReplacer(`"`, "", `'`, "")
+)
+
+// RuleRemoteScript is a rule checker to detect scripts downloaded from the network and executed
+// without verification at "run:". Such scripts bypass pinning actions by commit SHAs since their
+// contents can be changed at any time. This rule also detects archives extracted with options
+// which allow path traversal.
+type RuleRemoteScript struct {
+	RuleBase
+}
+
+// NewRuleRemoteScript creates a new RuleRemoteScript instance.
+func NewRuleRemoteScript() *RuleRemoteScript {
+	return &RuleRemoteScript{
+		RuleBase: RuleBase{
+			name: "remote-script",
+			desc: "Checks for scripts downloaded and executed without verification such as \"curl ... | sh\" and archives extracted with path traversal at \"run:\"",
+		},
+	}
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleRemoteScript) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecRun)
+	if !ok || e.Run == nil {
+		return nil
+	}
+
+	src := e.Run.Value
+	if strings.Contains(src, "\\") {
+		src = reLineContinuation.ReplaceAllString(src, " ")
+	}
+
+	// Most scripts don't download anything. Avoid matching the costly regular expressions to them
+	if strings.Contains(src, "curl") || strings.Contains(src, "wget") {
+		for _, m := range reRemoteScriptPipe.FindAllStringSubmatch(src, -1) {
+			rule.reportPipe(e.Run.Pos, m[0], m[1], m[2])
+		}
+		for _, m := range reRemoteScriptSubst.FindAllStringSubmatch(src, -1) {
+			rule.reportPipe(e.Run.Pos, m[0], m[2], m[1])
+		}
+	}
+	if l := strings.ToLower(src); strings.Contains(l, "iex") || strings.Contains(l, "invoke-expression") {
+		for _, m := range reRemoteScriptPwsh.FindAllStringSubmatch(src, -1) {
+			rule.reportPipe(e.Run.Pos, m[0], m[1], m[2])
+		}
+	}
+
+	cmds := splitRemoteScriptCommands(src)
+	if !reRemoteScriptVerify.MatchString(src) {
+		rule.checkDownloadedFiles(e.Run.Pos, cmds)
+	}
+	rule.checkArchiveExtraction(e.Run.Pos, cmds)
+
+	return nil
+}
+
+func (rule *RuleRemoteScript) allowed(url string) bool {
+	c := rule.Config().Rule(rule.Name())
+	return c != nil && url != "" && matchGlobFilter(c.Allow, url)
+}
+
+func (rule *RuleRemoteScript) reportPipe(pos *Pos, cmd, download, interp string) {
+	url := reRemoteScriptURL.FindString(cmd)
+	if rule.allowed(url) {
+		rule.Debug("Downloading script from %q is allowed by config", url)
+		return
+	}
+	from := download
+	if url != "" {
+		from = url
+	}
+	rule.Errorf(
+		pos,
+		"script downloaded by %q is executed by %q without verifying its content. the content can be changed at any time regardless of pinning. download it to a file and verify its checksum before executing it, or add the URL to \"allow\" of \"remote-script\" rule in actionlint.yaml if the source is trusted",
+		from,
+		interp,
+	)
+}
+
+// splitRemoteScriptCommands splits the script into commands. Each command is split into words.
+// Quotes are removed roughly since it is not necessary to parse the script precisely here.
+func splitRemoteScriptCommands(src string) [][]string {
+	ret := [][]string{}
+	for _, c := range reRemoteScriptCommandSep.Split(src, -1) {
+		c = remoteScriptQuoteRemover.Replace(c)
+		ws := strings.Fields(c)
+		for len(ws) > 0 && (ws[0] == "sudo" || ws[0] == "command" || ws[0] == "exec") {
+			ws = ws[1:]
+		}
+		if len(ws) > 0 {
+			ret = append(ret, ws)
+		}
+	}
+	return ret
+}
+
+// downloadedFile returns the file path where the curl or wget command writes the downloaded
+// content and the URL. It returns an empty path when the content is written to stdout.
+func downloadedFile(cmd []string) (string, string) {
+	url := ""
+	for _, w := range cmd[1:] {
+		if reRemoteScriptURL.MatchString(w) {
+			url = w
+			break
+		}
+	}
+	remote := func() string {
+		if url == "" {
+			return ""
+		}
+		u, _, _ := strings.Cut(url, "?")
+		return path.Base(u)
+	}
+
+	switch cmd[0] {
+	case "curl":
+		for i := 1; i < len(cmd); i++ {
+			w := cmd[i]
+			switch {
+			case w == "-o" || w == "--output":
+				if i+1 < len(cmd) {
+					return cmd[i+1], url
+				}
+			case strings.HasPrefix(w, "--output="):
+				return strings.TrimPrefix(w, "--output="), url
+			case w == "-O" || w == "--remote-name":
+				return remote(), url
+			case strings.HasPrefix(w, "-") && !strings.HasPrefix(w, "--") && strings.HasSuffix(w, "o") && i+1 < len(cmd):
+				return cmd[i+1], url // Combined short options like -fsSLo
+			case strings.HasPrefix(w, "-") && !strings.HasPrefix(w, "--") && strings.HasSuffix(w, "O"):
+				return remote(), url // Combined short options like -fsSLO
+			}
+		}
+		return "", url
+	case "wget":
+		for i := 1; i < len(cmd); i++ {
+			w := cmd[i]
+			var f string
+			switch {
+			case strings.HasPrefix(w, "--output-document="):
+				f = strings.TrimPrefix(w, "--output-document=")
+			case w == "--output-document" || strings.HasPrefix(w, "-") && !strings.HasPrefix(w, "--") && strings.HasSuffix(w, "O"):
+				if i+1 < len(cmd) {
+					f = cmd[i+1]
+				}
+			case strings.HasPrefix(w, "-") && !strings.HasPrefix(w, "--") && strings.Contains(w, "O"):
+				f = w[strings.IndexByte(w, 'O')+1:] // Like -qO- or -Ofile
+			default:
+				continue
+			}
+			if f == "-" {
+				return "", url
+			}
+			return f, url
+		}
+		return remote(), url
+	default:
+		return "", ""
+	}
+}
+
+// executedFile returns the file path which is executed by the command.
+func executedFile(cmd []string) string {
+	if reRemoteScriptInterp.MatchString(cmd[0]) {
+		for _, w := range cmd[1:] {
+			if !strings.HasPrefix(w, "-") {
+				return w
+			}
+		}
+		return ""
+	}
+	if strings.HasPrefix(cmd[0], "./") || strings.HasPrefix(cmd[0], "/") {
+		return cmd[0]
+	}
+	return ""
+}
+
+func sameFile(a, b string) bool {
+	a, b = path.Clean(a), path.Clean(b)
+	return a == b || path.Base(a) == path.Base(b) && (path.Dir(a) == "." || path.Dir(b) == ".")
+}
+
+func (rule *RuleRemoteScript) checkDownloadedFiles(pos *Pos, cmds [][]string) {
+	type download struct{ file, url string }
+	downloads := []download{}
+	for _, cmd := range cmds {
+		if f, url := downloadedFile(cmd); f != "" && f != "." && f != "/" {
+			downloads = append(downloads, download{f, url})
+			continue
+		}
+		exe := executedFile(cmd)
+		if exe == "" {
+			continue
+		}
+		for _, d := range downloads {
+			if !sameFile(d.file, exe) {
+				continue
+			}
+			if rule.allowed(d.url) {
+				rule.Debug("Downloading file from %q is allowed by config", d.url)
+				break
+			}
+			from := d.url
+			if from == "" {
+				from = d.file
+			}
+			rule.Errorf(
+				pos,
+				"file %q downloaded from %q is executed without verifying its checksum. the content can be changed at any time regardless of pinning. verify it with a command such as \"sha256sum -c\" before executing it, or add the URL to \"allow\" of \"remote-script\" rule in actionlint.yaml if the source is trusted",
+				d.file,
+				from,
+			)
+			break
+		}
+	}
+}
+
+func (rule *RuleRemoteScript) checkArchiveExtraction(pos *Pos, cmds [][]string) {
+	for _, cmd := range cmds {
+		opt := ""
+		switch cmd[0] {
+		case "tar":
+			for i, w := range cmd[1:] {
+				if w == "--absolute-names" {
+					opt = w
+					break
+				}
+				// Short options can be combined like -xPf. The first argument can omit the leading '-'
+				// like `tar xPf archive.tar`
+				combined := strings.HasPrefix(w, "-") && !strings.HasPrefix(w, "--") || i == 0 && !strings.HasPrefix(w, "-")
+				if combined && strings.ContainsRune(w, 'P') {
+					opt = "-P"
+					break
+				}
+			}
+		case "unzip":
+			for _, w := range cmd[1:] {
+				if w == "-:" {
+					opt = w
+					break
+				}
+			}
+		}
+		if opt == "" {
+			continue
+		}
+		rule.Errorf(
+			pos,
+			"archive is extracted by %q with %q option. it allows files in the archive to be written outside of the destination directory with absolute paths or \"..\" (path traversal). remove the option",
+			cmd[0],
+			opt,
+		)
+	}
+}
@@ -0,0 +1,183 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleRemoteScriptDetectScripts(t *testing.T) {
+	tests := []struct {
+		what  string
+		run   string
+		allow []string
+		want  []string
+	}{
+		{
+			what: "curl piped to sh",
+			run:  "curl -fsSL https://example.com/install.sh | sh",
+			want: []string{"executed"},
+		},
+		{
+			what: "wget piped to bash",
+			run:  "wget -qO- https://example.com/install.sh | bash",
+			want: []string{"executed"},
+		},
+		{
+			what: "piped to sudo with options and env vars",
+			run:  "curl -sL https://deb.nodesource.com/setup_20.x | sudo -E FOO=bar bash -",
+			want: []string{"executed"},
+		},
+		{
+			what: "piped to python",
+			run:  "curl https://bootstrap.pypa.io/get-pip.py | python3",
+			want: []string{"executed"},
+		},
+		{
+			what: "process substitution",
+			run:  "bash <(curl -s https://example.com/install.sh)",
+			want: []string{"executed"},
+		},
+		{
+			what: "command substitution with -c",
+			run:  `sh -c "$(curl -fsSL https://example.com/install.sh)"`,
+			want: []string{"executed"},
+		},
+		{
+			what: "powershell",
+			run:  "iwr https://example.com/install.ps1 -useb | iex",
+			want: []string{"executed"},
+		},
+		{
+			what: "line continuation",
+			run:  "curl -fsSL \\\n  https://example.com/install.sh \\\n  | sh",
+			want: []string{"executed"},
+		},
+		{
+			what: "piped to other command",
+			run:  "curl -fsSL https://example.com/data.json | jq .",
+			want: []string{},
+		},
+		{
+			what:  "allowed URL",
+			run:   "curl -fsSL https://sh.rustup.rs | sh -s -- -y",
+			allow: []string{"https://sh.rustup.rs"},
+			want:  []string{},
+		},
+		{
+			what:  "not allowed URL",
+			run:   "curl -fsSL https://example.com/install.sh | sh",
+			allow: []string{"https://sh.rustup.rs", "!https://example.com/**"},
+			want:  []string{"executed"},
+		},
+		{
+			what: "curl output file executed",
+			run:  "curl -fsSL -o install.sh https://example.com/install.sh\nbash install.sh",
+			want: []string{"checksum"},
+		},
+		{
+			what: "curl remote name executed",
+			run:  "curl -fsSLO https://example.com/install.sh && chmod +x install.sh && ./install.sh",
+			want: []string{"checksum"},
+		},
+		{
+			what: "wget executed",
+			run:  "wget https://example.com/install.sh?v=1\nsh ./install.sh",
+			want: []string{"checksum"},
+		},
+		{
+			what: "wget output document sourced",
+			run:  "wget -O /tmp/env.sh https://example.com/env.sh; source /tmp/env.sh",
+			want: []string{"checksum"},
+		},
+		{
+			what: "downloaded file verified",
+			run:  "curl -fsSLO https://example.com/install.sh\necho \"$SUM  install.sh\" | sha256sum -c\nbash install.sh",
+			want: []string{},
+		},
+		{
+			what: "downloaded file not executed",
+			run:  "curl -fsSL -o data.json https://example.com/data.json\njq . data.json",
+			want: []string{},
+		},
+		{
+			what:  "allowed download",
+			run:   "curl -fsSL -o install.sh https://example.com/install.sh\nbash install.sh",
+			allow: []string{"https://example.com/*"},
+			want:  []string{},
+		},
+		{
+			what: "tar with -P",
+			run:  "tar -xPf archive.tar.gz",
+			want: []string{"extracted"},
+		},
+		{
+			what: "tar with old style options",
+			run:  "tar xPzf archive.tar.gz -C /",
+			want: []string{"extracted"},
+		},
+		{
+			what: "tar with --absolute-names",
+			run:  "tar --absolute-names -xf archive.tar",
+			want: []string{"extracted"},
+		},
+		{
+			what: "unzip with -:",
+			run:  "unzip -: archive.zip",
+			want: []string{"extracted"},
+		},
+		{
+			what: "tar without -P",
+			run:  "tar -xzf archive.tar.gz -C ./out",
+			want: []string{},
+		},
+		{
+			what: "multiple errors",
+			run:  "curl https://example.com/a.sh | sh\ncurl -o b.sh https://example.com/b.sh\nsh b.sh\ntar -xPf c.tar",
+			want: []string{"executed", "checksum", "extracted"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			s := &Step{
+				Exec: &ExecRun{
+					Run: &String{
+						Value: tc.run,
+						Pos:   &Pos{},
+					},
+				},
+			}
+			r := NewRuleRemoteScript()
+			if tc.allow != nil {
+				r.SetConfig(&Config{
+					Rules: map[string]*RuleConfig{
+						"remote-script": {Allow: tc.allow},
+					},
+				})
+			}
+			if err := r.VisitStep(s); err != nil {
+				t.Fatal(err)
+			}
+
+			have := []string{}
+			for _, err := range r.Errs() {
+				switch m := err.Message; {
+				case strings.HasPrefix(m, "script downloaded by "):
+					have = append(have, "executed")
+				case strings.HasPrefix(m, "file "):
+					have = append(have, "checksum")
+				case strings.HasPrefix(m, "archive is extracted "):
+					have = append(have, "extracted")
+				default:
+					t.Fatalf("unexpected error: %q", m)
+				}
+			}
+
+			if !cmp.Equal(have, tc.want) {
+				t.Fatal(cmp.Diff(have, tc.want))
+			}
+		})
+	}
+}
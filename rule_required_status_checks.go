@@ -0,0 +1,273 @@
+package actionlint
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// githubActionsAppID is the ID of GitHub Actions app. Required status checks can be bound to the
+// app which must report them.
+const githubActionsAppID = 15368
+
+// requiredStatusCheck is a required status check in the export of branch protection rules.
+type requiredStatusCheck struct {
+	name string
+	pos  *Pos
+}
+
+// parseRequiredStatusChecks parses the export of required status checks in JSON or YAML. The
+// following formats are accepted:
+//
+//   - an array of status check names
+//   - the response of "Get branch protection" or "Get status checks protection" API
+//   - the response of "Get a repository ruleset" or "Get rules for a branch" API
+//
+// Checks bound to apps other than GitHub Actions are not included in the returned value.
+func parseRequiredStatusChecks(b []byte) ([]*requiredStatusCheck, error) {
+	var n yaml.Node
+	if err := yaml.Unmarshal(b, &n); err != nil {
+		return nil, errors.New(strings.ReplaceAll(err.Error(), "\n", " "))
+	}
+	if n.Kind != yaml.DocumentNode || len(n.Content) == 0 {
+		return nil, errors.New("the file is empty")
+	}
+
+	p := &requiredStatusChecksParser{seen: map[string]struct{}{}}
+	p.parse(n.Content[0])
+	if len(p.checks) == 0 {
+		return nil, errors.New("no required status check was found. the file must be an array of status check names or the response of branch protection or ruleset API")
+	}
+	return p.checks, nil
+}
+
+type requiredStatusChecksParser struct {
+	checks []*requiredStatusCheck
+	seen   map[string]struct{}
+}
+
+func (p *requiredStatusChecksParser) add(n *yaml.Node, app *yaml.Node) {
+	if n.Kind != yaml.ScalarNode || n.Value == "" {
+		return
+	}
+	if app != nil && app.Kind == yaml.ScalarNode && app.Tag != "!!null" {
+		if id, err := strconv.Atoi(app.Value); err == nil && id != githubActionsAppID {
+			return // The check is reported by other app such as CI services
+		}
+	}
+	if _, ok := p.seen[n.Value]; ok {
+		return
+	}
+	p.seen[n.Value] = struct{}{}
+	p.checks = append(p.checks, &requiredStatusCheck{n.Value, &Pos{Line: n.Line, Col: n.Column}})
+}
+
+func (p *requiredStatusChecksParser) parse(n *yaml.Node) {
+	switch n.Kind {
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			if c.Kind == yaml.ScalarNode {
+				p.add(c, nil)
+			} else {
+				p.parse(c)
+			}
+		}
+	case yaml.MappingNode:
+		m := make(map[string]*yaml.Node, len(n.Content)/2)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			m[n.Content[i].Value] = n.Content[i+1]
+		}
+
+		// Element of "checks" in branch protection or "required_status_checks" in rulesets
+		if c, ok := m["context"]; ok {
+			app, ok := m["app_id"]
+			if !ok {
+				app = m["integration_id"]
+			}
+			p.add(c, app)
+			return
+		}
+		// Rule of rulesets
+		if t, ok := m["type"]; ok {
+			if v, ok := m["parameters"]; ok && t.Value == "required_status_checks" {
+				p.parse(v)
+			}
+			return
+		}
+		if v, ok := m["required_status_checks"]; ok {
+			p.parse(v)
+			return
+		}
+		// "checks" has the same names as "contexts" with app IDs
+		if v, ok := m["checks"]; ok && v.Kind == yaml.SequenceNode && len(v.Content) > 0 {
+			p.parse(v)
+			return
+		}
+		if v, ok := m["contexts"]; ok {
+			p.parse(v)
+			return
+		}
+		if v, ok := m["rules"]; ok {
+			p.parse(v)
+		}
+	}
+}
+
+// RuleRequiredStatusChecks is a rule checker to detect required status checks in branch protection
+// rules which are not reported by any job in the workflows of the repository. Status checks are
+// named after jobs so renaming a job or changing its matrix silently blocks merging pull requests
+// until the protection rule is updated. Unlike other rules, this rule is applied to all workflows in
+// the repository at once.
+// https://docs.github.com/en/repositories/configuring-branches-and-merges-in-your-repository/managing-protected-branches/troubleshooting-required-status-checks
+type RuleRequiredStatusChecks struct {
+	RuleBase
+	matrix   *RuleStatusCheckName
+	names    map[string]struct{}
+	patterns []*regexp.Regexp
+	reusable bool
+}
+
+// NewRuleRequiredStatusChecks creates a new RuleRequiredStatusChecks instance.
+func NewRuleRequiredStatusChecks() *RuleRequiredStatusChecks {
+	return &RuleRequiredStatusChecks{
+		RuleBase: RuleBase{
+			name: "required-status-checks",
+			desc: "Checks for required status checks in branch protection rules which no job in the repository reports",
+		},
+		matrix: NewRuleStatusCheckName(),
+		names:  map[string]struct{}{},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleRequiredStatusChecks) VisitWorkflowPre(n *Workflow) error {
+	// Jobs in reusable workflows report status checks via the caller jobs
+	rule.reusable = len(n.On) > 0
+	for _, e := range n.On {
+		if e.EventName() != "workflow_call" {
+			rule.reusable = false
+			break
+		}
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRequiredStatusChecks) VisitJobPre(n *Job) error {
+	if rule.reusable || n.ID == nil {
+		return nil
+	}
+
+	literal := true
+	name := n.ID.Value
+	pat := regexp.QuoteMeta(name)
+	if n.Name != nil {
+		name = n.Name.Value
+		pat = regexp.QuoteMeta(name)
+		if n.Name.ContainsExpression() {
+			// Matrix values are not added when the job name contains expressions
+			pat = statusCheckNamePattern(name) + `(?: \(.*\))?`
+			literal = false
+		}
+	}
+
+	names := []string{name}
+	pats := []string{pat}
+	if literal {
+		legs, ok := rule.matrix.matrixLegs(n)
+		if !ok {
+			pats = []string{pat + ` \(.*\)`}
+			literal = false
+		} else if legs != nil {
+			names = make([]string, 0, len(legs))
+			pats = make([]string, 0, len(legs))
+			for _, l := range legs {
+				c := name + " (" + l + ")"
+				names = append(names, c)
+				pats = append(pats, regexp.QuoteMeta(c))
+			}
+		}
+	}
+
+	// Status checks of reusable workflows are named "{caller job} / {called job}"
+	if n.WorkflowCall != nil {
+		for i, p := range pats {
+			pats[i] = p + ` / .+`
+		}
+		literal = false
+	}
+
+	if literal {
+		for _, c := range names {
+			rule.names[c] = struct{}{}
+			rule.names[truncateStatusCheckName(c)] = struct{}{}
+		}
+		return nil
+	}
+
+	for _, p := range pats {
+		if r, err := regexp.Compile("^" + p + "$"); err == nil {
+			rule.patterns = append(rule.patterns, r)
+		}
+	}
+	return nil
+}
+
+// check reports the required status checks which are not reported by any job in the visited
+// workflows. This method must be called after visiting all workflows in the repository.
+func (rule *RuleRequiredStatusChecks) check(checks []*requiredStatusCheck) {
+	cands := make([]string, 0, len(rule.names))
+	for n := range rule.names {
+		cands = append(cands, n)
+	}
+	sort.Strings(cands)
+
+Checks:
+	for _, c := range checks {
+		if _, ok := rule.names[c.name]; ok {
+			continue
+		}
+		for _, r := range rule.patterns {
+			if r.MatchString(c.name) {
+				continue Checks
+			}
+		}
+
+		note := ""
+		if s, ok := suggestName(c.name, cands); ok {
+			note = fmt.Sprintf(". perhaps the job was renamed to %q", s)
+		}
+		rule.Errorf(
+			c.pos,
+			"required status check %q is not reported by any job in the workflows of the repository. pull requests are blocked since the check never completes. update the branch protection rule or the job name%s",
+			c.name,
+			note,
+		)
+	}
+}
+
+// statusCheckNamePattern converts the job name containing expressions into a regular expression.
+// Each placeholder of expression matches any string.
+func statusCheckNamePattern(name string) string {
+	var b strings.Builder
+	for {
+		s := strings.Index(name, "${{")
+		if s < 0 {
+			break
+		}
+		e := strings.Index(name[s:], "}}")
+		if e < 0 {
+			break
+		}
+		b.WriteString(regexp.QuoteMeta(name[:s]))
+		b.WriteString(".*")
+		name = name[s+e+2:]
+	}
+	b.WriteString(regexp.QuoteMeta(name))
+	return b.String()
+}
@@ -0,0 +1,256 @@
+package actionlint
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleRequiredStatusChecksParseExport(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  []string
+	}{
+		{
+			what:  "array of names",
+			input: `["lint", "test (ubuntu-latest)", "lint"]`,
+			want:  []string{"lint", "test (ubuntu-latest)"},
+		},
+		{
+			what: "branch protection",
+			input: `{
+  "required_status_checks": {
+    "strict": true,
+    "contexts": ["lint", "codecov/patch"],
+    "checks": [
+      {"context": "lint", "app_id": 15368},
+      {"context": "codecov/patch", "app_id": 254}
+    ]
+  },
+  "enforce_admins": {"enabled": true}
+}`,
+			want: []string{"lint"},
+		},
+		{
+			what:  "status checks protection without checks",
+			input: `{"strict": false, "contexts": ["lint", "test"], "checks": []}`,
+			want:  []string{"lint", "test"},
+		},
+		{
+			what: "ruleset",
+			input: `{
+  "name": "main",
+  "rules": [
+    {"type": "deletion"},
+    {
+      "type": "required_status_checks",
+      "parameters": {
+        "strict_required_status_checks_policy": true,
+        "required_status_checks": [
+          {"context": "lint", "integration_id": 15368},
+          {"context": "test"},
+          {"context": "sonarcloud", "integration_id": 12526}
+        ]
+      }
+    }
+  ]
+}`,
+			want: []string{"lint", "test"},
+		},
+		{
+			what: "rules for branch in YAML",
+			input: `- type: pull_request
+- type: required_status_checks
+  parameters:
+    required_status_checks:
+      - context: lint
+        integration_id: null
+`,
+			want: []string{"lint"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			cs, err := parseRequiredStatusChecks([]byte(tc.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			have := []string{}
+			for _, c := range cs {
+				have = append(have, c.name)
+			}
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
+
+func TestRuleRequiredStatusChecksParseExportError(t *testing.T) {
+	testCases := []struct {
+		what  string
+		input string
+		want  string
+	}{
+		{"empty", "", "the file is empty"},
+		{"broken", `{"contexts": [`, "did not find expected node content"},
+		{"no check", `{"required_status_checks": null}`, "no required status check was found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseRequiredStatusChecks([]byte(tc.input))
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestRuleRequiredStatusChecksJobNames(t *testing.T) {
+	srcs := []string{
+		`on: pull_request
+jobs:
+  lint:
+    name: Lint
+    runs-on: ubuntu-latest
+    steps:
+      - run: make lint
+  test:
+    strategy:
+      matrix:
+        os: [ubuntu-latest, windows-latest]
+    runs-on: ${{ matrix.os }}
+    steps:
+      - run: make test
+  e2e:
+    name: E2E on ${{ matrix.browser }}
+    strategy:
+      matrix:
+        browser: [chrome, firefox]
+    runs-on: ubuntu-latest
+    steps:
+      - run: make e2e
+  build:
+    strategy:
+      matrix: ${{ fromJSON(vars.TARGETS) }}
+    runs-on: ubuntu-latest
+    steps:
+      - run: make build
+  release:
+    uses: ./.github/workflows/release.yaml
+`,
+		`on: workflow_call
+jobs:
+  publish:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make publish
+`,
+	}
+
+	rule := NewRuleRequiredStatusChecks()
+	v := NewVisitor()
+	v.AddPass(rule)
+	for _, src := range srcs {
+		w, errs := Parse([]byte(src))
+		if len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		if err := v.Visit(w); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ok := []string{
+		"Lint",
+		"test (ubuntu-latest)",
+		"test (windows-latest)",
+		"E2E on chrome",
+		"build (linux)",
+		"release / publish",
+		"release / publish (amd64)",
+	}
+	ng := []string{
+		"lint",
+		"test",
+		"test (macos-latest)",
+		"build",
+		"publish",
+		"release",
+	}
+	checks := []*requiredStatusCheck{}
+	for i, n := range append(append([]string{}, ok...), ng...) {
+		checks = append(checks, &requiredStatusCheck{n, &Pos{Line: i + 1, Col: 1}})
+	}
+	rule.check(checks)
+
+	have := []string{}
+	for _, e := range rule.Errs() {
+		have = append(have, checks[e.Line-1].name)
+	}
+	if !cmp.Equal(ng, have) {
+		t.Fatal(cmp.Diff(ng, have))
+	}
+
+	if msg := rule.Errs()[0].Message; !strings.Contains(msg, `perhaps the job was renamed to "Lint"`) {
+		t.Fatalf("renamed job was not suggested: %q", msg)
+	}
+}
+
+func TestLinterRequiredStatusChecks(t *testing.T) {
+	dir := t.TempDir()
+	for _, d := range []string{".git", filepath.Join(".github", "workflows")} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string]string{
+		".github/actionlint.yaml":        "required-status-checks: .github/protection.json\n",
+		".github/protection.json":        `["test", "lint"]`,
+		".github/workflows/test.yaml":    "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: make test\n",
+		".github/workflows/release.yaml": "on: push\njobs:\n  release:\n    runs-on: ubuntu-latest\n    steps:\n      - run: make release\n",
+	}
+	for f, c := range files {
+		if err := os.WriteFile(filepath.Join(dir, filepath.FromSlash(f)), []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	l, err := NewLinter(io.Discard, &LinterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs, err := l.LintRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("wanted one error but got %v", errs)
+	}
+	e := errs[0]
+	if e.Kind != "required-status-checks" || e.Line != 1 || e.Column != 10 || !strings.Contains(e.Message, `"lint"`) {
+		t.Fatalf("unexpected error: %v", e)
+	}
+	if filepath.Base(e.Filepath) != "protection.json" {
+		t.Fatalf("error is not reported in the file of required status checks: %q", e.Filepath)
+	}
+
+	// The rule is not applied when linting files
+	errs, err = l.LintFiles([]string{filepath.Join(dir, ".github", "workflows", "test.yaml")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 0 {
+		t.Fatalf("wanted no error but got %v", errs)
+	}
+}
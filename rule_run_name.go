@@ -0,0 +1,86 @@
+package actionlint
+
+import (
+	"sort"
+	"strings"
+)
+
+// RuleRunName is a rule checker to check "run-name:" of workflow. It detects properties of
+// `github` context in the run name which are never populated by the triggers of the workflow.
+// Syntax, types, and available contexts of the expressions are checked by "expression" rule.
+// https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#run-name
+type RuleRunName struct {
+	RuleBase
+}
+
+// NewRuleRunName creates a new RuleRunName instance.
+func NewRuleRunName() *RuleRunName {
+	return &RuleRunName{
+		RuleBase: RuleBase{
+			name: "run-name",
+			desc: "Checks for properties of github context at \"run-name:\" which are not populated by the triggers of the workflow",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleRunName) VisitWorkflowPre(n *Workflow) error {
+	if n.RunName == nil || len(n.On) == 0 || !n.RunName.ContainsExpression() {
+		return nil
+	}
+
+	events := make([]string, 0, len(n.On))
+	for _, e := range n.On {
+		name := strings.ToLower(e.EventName())
+		if name == "workflow_call" {
+			return nil // The payload is given by the caller workflow
+		}
+		events = append(events, name)
+	}
+	sort.Strings(events)
+
+	_, exprs := splitExpressions(n.RunName.Value)
+	reported := map[string]struct{}{}
+	for _, src := range exprs {
+		expr, err := NewExprParser().Parse(NewExprLexer(src + "}}"))
+		if err != nil {
+			continue // Syntax error is reported by "expression" rule
+		}
+		VisitExprNode(expr, func(node, _ ExprNode, entering bool) {
+			if !entering {
+				return
+			}
+			prop, available, ok := eventDependentProp(node)
+			if !ok || containsAny(available, events) {
+				return
+			}
+			// Properties of `github.event` are reported by "expression" rule when it is enabled
+			if strings.HasPrefix(prop, "github.event.") && rule.config.RuleEnabled("expression") {
+				return
+			}
+			if _, ok := reported[prop]; ok {
+				return
+			}
+			reported[prop] = struct{}{}
+			rule.ErrorfWithEvents(
+				n.RunName.Pos,
+				events,
+				"%q at \"run-name:\" is not populated by any trigger of this workflow %s. it is only available on %s events so its value is always empty",
+				prop,
+				quotes(events),
+				quotes(available),
+			)
+		})
+	}
+
+	return nil
+}
+
+func containsAny(haystack []string, needles []string) bool {
+	for _, n := range needles {
+		if contains(haystack, n) {
+			return true
+		}
+	}
+	return false
+}
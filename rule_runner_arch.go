@@ -0,0 +1,185 @@
+package actionlint
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	reRunnerArchURL   = regexp.MustCompile(`https?://[^\s'"|;)]+`)
+	reRunnerArchX64   = regexp.MustCompile(`(?:^|[^a-z0-9])(?:amd64|x86_64|x86-64|x64)(?:[^a-z0-9]|$)`)
+	reRunnerArchARM64 = regexp.MustCompile(`(?:^|[^a-z0-9])(?:arm64|aarch64)(?:[^a-z0-9]|$)`)
+	reRunnerMacOSVer  = regexp.MustCompile(`^macos-(\d+)(?:\.\d+)?(-[a-z]+)?$`)
+)
+
+const (
+	runnerArchX64   = "x64"
+	runnerArchARM64 = "arm64"
+)
+
+// runnerArchOfLabel returns the CPU architecture of the runner selected by the label. It returns
+// an empty string when the architecture is unknown.
+// https://docs.github.com/en/actions/using-github-hosted-runners/about-github-hosted-runners#standard-github-hosted-runners-for-public-repositories
+func runnerArchOfLabel(label string) string {
+	l := strings.ToLower(label)
+	switch l {
+	case "arm64", "arm":
+		return runnerArchARM64
+	case "x64":
+		return runnerArchX64
+	case "macos-latest", "macos-latest-xlarge":
+		return runnerArchARM64
+	case "macos-latest-large":
+		return runnerArchX64
+	}
+	if strings.HasSuffix(l, "-arm") || strings.HasSuffix(l, "-arm64") {
+		return runnerArchARM64 // e.g. ubuntu-24.04-arm, windows-11-arm
+	}
+	if m := reRunnerMacOSVer.FindStringSubmatch(l); m != nil {
+		switch m[2] {
+		case "-large":
+			return runnerArchX64 // Larger macOS runners are Intel
+		case "-xlarge", "-xl":
+			return runnerArchARM64
+		}
+		if v, _ := strconv.Atoi(m[1]); v >= 14 {
+			return runnerArchARM64 // macos-14 or later are Apple silicon
+		}
+		return runnerArchX64
+	}
+	if strings.HasPrefix(l, "ubuntu-") || strings.HasPrefix(l, "windows-") {
+		return runnerArchX64
+	}
+	return ""
+}
+
+// runnerArchOfURL returns the CPU architecture of the binary downloaded from the URL by looking
+// at the words in it. It returns an empty string when the architecture is unknown or the URL
+// includes words of multiple architectures.
+func runnerArchOfURL(url string) string {
+	u := strings.ToLower(url)
+	x64, arm64 := reRunnerArchX64.MatchString(u), reRunnerArchARM64.MatchString(u)
+	if x64 && !arm64 {
+		return runnerArchX64
+	}
+	if arm64 && !x64 {
+		return runnerArchARM64
+	}
+	return ""
+}
+
+// runnerArchOfInput returns the CPU architecture specified by the input of setup action.
+func runnerArchOfInput(v string) string {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "x64", "amd64", "x86_64":
+		return runnerArchX64
+	case "arm64", "aarch64":
+		return runnerArchARM64
+	default:
+		return ""
+	}
+}
+
+// RuleRunnerArch is a rule checker to detect mismatches between the CPU architecture of the runner
+// and binaries used in the job. Such mismatches cause obscure errors like "exec format error" only
+// at runtime.
+type RuleRunnerArch struct {
+	RuleBase
+}
+
+// NewRuleRunnerArch creates a new RuleRunnerArch instance.
+func NewRuleRunnerArch() *RuleRunnerArch {
+	return &RuleRunnerArch{
+		RuleBase: RuleBase{
+			name: "runner-arch",
+			desc: "Checks for binaries downloaded or set up for CPU architecture different from the runner",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRunnerArch) VisitJobPre(n *Job) error {
+	if n.RunsOn == nil || n.RunsOn.LabelsExpr != nil || n.ID == nil {
+		return nil
+	}
+
+	label, arch := "", ""
+	for _, l := range n.RunsOn.Labels {
+		if l.ContainsExpression() {
+			return nil // Architecture may depend on matrix
+		}
+		if a := runnerArchOfLabel(l.Value); a != "" {
+			label, arch = l.Value, a
+			break
+		}
+	}
+	if arch == "" {
+		return nil
+	}
+
+	for _, s := range n.Steps {
+		switch e := s.Exec.(type) {
+		case *ExecRun:
+			if arch == runnerArchARM64 && e.Run != nil {
+				rule.checkDownloads(e.Run, n.ID.Value, label)
+			}
+		case *ExecAction:
+			rule.checkSetupAction(e, n.ID.Value, label, arch)
+		}
+	}
+	return nil
+}
+
+func (rule *RuleRunnerArch) checkDownloads(run *String, job, label string) {
+	src := run.Value
+	if !strings.Contains(src, "curl") && !strings.Contains(src, "wget") {
+		return
+	}
+	for _, u := range reRunnerArchURL.FindAllString(src, -1) {
+		if runnerArchOfURL(u) != runnerArchX64 {
+			continue
+		}
+		rule.Errorf(
+			run.Pos,
+			"job %q runs on arm64 runner %q but this step downloads %q which looks built for x64. the binary would fail with an obscure error like \"exec format error\" or run under emulation. download the arm64 binary instead",
+			job,
+			label,
+			u,
+		)
+		return
+	}
+}
+
+func (rule *RuleRunnerArch) checkSetupAction(e *ExecAction, job, label, arch string) {
+	if e.Uses == nil || e.Uses.ContainsExpression() {
+		return
+	}
+	spec, _, _ := strings.Cut(e.Uses.Value, "@")
+	if i := strings.IndexByte(spec, '/'); i < 0 || !strings.HasPrefix(spec[i+1:], "setup-") {
+		return
+	}
+
+	for _, name := range []string{"architecture", "arch"} {
+		i, ok := e.Inputs[name]
+		if !ok || i.Value == nil || i.Value.ContainsExpression() {
+			continue
+		}
+		a := runnerArchOfInput(i.Value.Value)
+		if a == "" || a == arch {
+			continue
+		}
+		rule.Errorf(
+			i.Value.Pos,
+			"%q input of %q is %q but job %q runs on %s runner %q. the tool for %s would fail with an obscure error like \"exec format error\" or run under emulation. set %q or remove the input",
+			name,
+			spec,
+			i.Value.Value,
+			job,
+			arch,
+			label,
+			a,
+			arch,
+		)
+	}
+}
@@ -0,0 +1,69 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleRunnerArchOfLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		want  string
+	}{
+		{"ubuntu-latest", runnerArchX64},
+		{"ubuntu-22.04", runnerArchX64},
+		{"ubuntu-24.04-arm", runnerArchARM64},
+		{"ubuntu-22.04-arm", runnerArchARM64},
+		{"windows-latest", runnerArchX64},
+		{"windows-11-arm", runnerArchARM64},
+		{"macos-latest", runnerArchARM64},
+		{"macos-latest-large", runnerArchX64},
+		{"macos-latest-xlarge", runnerArchARM64},
+		{"macos-12", runnerArchX64},
+		{"macos-13", runnerArchX64},
+		{"macos-13-large", runnerArchX64},
+		{"macos-13-xlarge", runnerArchARM64},
+		{"macos-14", runnerArchARM64},
+		{"macos-14.0", runnerArchARM64},
+		{"macos-14-large", runnerArchX64},
+		{"macos-15", runnerArchARM64},
+		{"MacOS-14", runnerArchARM64},
+		{"arm64", runnerArchARM64},
+		{"ARM64", runnerArchARM64},
+		{"x64", runnerArchX64},
+		{"self-hosted", ""},
+		{"linux", ""},
+		{"my-custom-runner", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			if have := runnerArchOfLabel(tc.label); have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleRunnerArchOfURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://example.com/tool_linux_amd64.tar.gz", runnerArchX64},
+		{"https://example.com/tool-x86_64-unknown-linux-gnu.tar.gz", runnerArchX64},
+		{"https://example.com/node-v20.0.0-linux-x64.tar.xz", runnerArchX64},
+		{"https://example.com/tool_linux_arm64.tar.gz", runnerArchARM64},
+		{"https://example.com/tool-aarch64-unknown-linux-gnu.tar.gz", runnerArchARM64},
+		{"https://example.com/tool.tar.gz", ""},
+		{"https://example.com/x64box/tool.tar.gz", ""},
+		{"https://example.com/tool-amd64-and-arm64.tar.gz", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			if have := runnerArchOfURL(tc.url); have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
@@ -129,16 +129,24 @@ type RuleRunnerLabel struct {
 	// all past compatibility values here for better error message. If accumulating all compatibility
 	// values into one integer, we can no longer know what labels are conflicting.
 	compats map[runnerOSCompat]*String
+	src     []byte
 }
 
 // NewRuleRunnerLabel creates new RuleRunnerLabel instance.
 func NewRuleRunnerLabel() *RuleRunnerLabel {
+	return NewRuleRunnerLabelWithSource(nil)
+}
+
+// NewRuleRunnerLabelWithSource creates new RuleRunnerLabel instance with the source of the
+// workflow, which is used to build the edits to fix misspelled labels.
+func NewRuleRunnerLabelWithSource(src []byte) *RuleRunnerLabel {
 	return &RuleRunnerLabel{
 		RuleBase: RuleBase{
 			name: "runner-label",
 			desc: "Checks for GitHub-hosted and preset self-hosted runner labels in \"runs-on:\"",
 		},
 		compats: nil,
+		src:     src,
 	}
 }
 
@@ -174,7 +182,7 @@ func (rule *RuleRunnerLabel) VisitJobPre(n *Job) error {
 // https://docs.github.com/en/actions/using-github-hosted-runners/about-github-hosted-runners
 func (rule *RuleRunnerLabel) checkLabelAndConflict(l *String, m *Matrix) {
 	if l.ContainsExpression() {
-		ss := rule.tryToGetLabelsInMatrix(l, m)
+		ss := runnerLabelsInMatrix(l, m)
 		cs := make([]runnerOSCompat, 0, len(ss))
 		for _, s := range ss {
 			comp := rule.verifyRunnerLabel(s)
@@ -190,7 +198,7 @@ func (rule *RuleRunnerLabel) checkLabelAndConflict(l *String, m *Matrix) {
 
 func (rule *RuleRunnerLabel) checkLabel(l *String, m *Matrix) {
 	if l.ContainsExpression() {
-		ss := rule.tryToGetLabelsInMatrix(l, m)
+		ss := runnerLabelsInMatrix(l, m)
 		for _, s := range ss {
 			rule.verifyRunnerLabel(s)
 		}
@@ -224,8 +232,9 @@ func (rule *RuleRunnerLabel) verifyRunnerLabel(label *String) runnerOSCompat {
 		}
 	}
 
-	rule.Errorf(
+	rule.ErrorfWithFixes(
 		label.Pos,
+		rule.fixLabel(label),
 		"label %q is unknown. available labels are %s. if it is a custom label for self-hosted runner, set list of labels in actionlint.yaml config file",
 		label.Value,
 		quotesAll(
@@ -239,7 +248,35 @@ func (rule *RuleRunnerLabel) verifyRunnerLabel(label *String) runnerOSCompat {
 	return compatInvalid
 }
 
-func (rule *RuleRunnerLabel) tryToGetLabelsInMatrix(label *String, m *Matrix) []*String {
+// fixLabel returns the edit to replace the misspelled label with the known label. Labels which
+// differ in their versions like "ubuntu-18.04" are not fixed since the intended version is unknown.
+func (rule *RuleRunnerLabel) fixLabel(label *String) []*TextEdit {
+	cands := make([]string, 0, len(allGitHubHostedRunnerLabels)+len(selfHostedRunnerPresetOSLabels)+len(selfHostedRunnerPresetOtherLabels))
+	cands = append(cands, allGitHubHostedRunnerLabels...)
+	cands = append(cands, selfHostedRunnerPresetOSLabels...)
+	cands = append(cands, selfHostedRunnerPresetOtherLabels...)
+	l, ok := suggestName(label.Value, cands)
+	if !ok || digitsOf(l) != digitsOf(label.Value) {
+		return nil
+	}
+	if e := replaceAt(rule.src, label.Pos, label.Value, l); e != nil {
+		return []*TextEdit{e}
+	}
+	return nil
+}
+
+func digitsOf(s string) string {
+	return strings.Map(func(r rune) rune {
+		if '0' <= r && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// runnerLabelsInMatrix returns the runner labels in the matrix when the label is an expression
+// like "${{ matrix.os }}".
+func runnerLabelsInMatrix(label *String, m *Matrix) []*String {
 	if m == nil {
 		return nil
 	}
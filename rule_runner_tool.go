@@ -0,0 +1,234 @@
+package actionlint
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//go:generate go run ./scripts/generate-runner-tools ./runner_tools.go
+
+// RunnerImage is an inventory of tools preinstalled on a GitHub-hosted runner image.
+type RunnerImage struct {
+	// Label is the runner label to select the image like "ubuntu-22.04".
+	Label string
+	// OS is the OS of the image. One of "linux", "macos", or "windows".
+	OS string
+	// URL is a URL of the software report of the image.
+	URL string
+	// Tools is a map from commands to whether they are preinstalled on the image. Commands not
+	// tracked by the inventory are not included.
+	Tools map[string]bool
+}
+
+var (
+	// Suffixes of runner labels for larger runners like "macos-14-xlarge" and "ubuntu-latest-8-cores"
+	reRunnerImageSize = regexp.MustCompile(`-(?:x?large|xl|\d+-cores)$`)
+	// Lines installing tools like `brew install docker` or checking tools like `command -v docker`
+	reRunnerToolInstall = regexp.MustCompile(`\binstall\b(.*)|(?:\bcommand\s+-v|\bwhich|\btype|\bhash|Get-Command)\s+(.*)`)
+	// Scripts which switch commands by OS of the runner
+	reRunnerToolOSCheck = regexp.MustCompile(`(?i)RUNNER_OS|runner\s*\.\s*os\b|\buname\b|\bOSTYPE\b|\$Is(?:Windows|MacOS|Linux)\b`)
+	reRunnerToolWord    = regexp.MustCompile(`[A-Za-z0-9_.-]+`)
+	// Words in action names like "docker" in "douglascamata/setup-docker-macos-action"
+	reRunnerToolActionWord = regexp.MustCompile(`[A-Za-z0-9]+`)
+	// Tracked commands at command positions like `docker build .` or `sudo apt-get update`
+	reRunnerToolCommand = runnerToolCommandRegexp()
+)
+
+var runnerOSNames = map[string]string{
+	"linux":   "Linux",
+	"macos":   "macOS",
+	"windows": "Windows",
+}
+
+func runnerToolCommandRegexp() *regexp.Regexp {
+	seen := map[string]struct{}{}
+	cmds := []string{}
+	for _, i := range RunnerImageTools {
+		for c := range i.Tools {
+			if _, ok := seen[c]; !ok {
+				seen[c] = struct{}{}
+				cmds = append(cmds, regexp.QuoteMeta(c))
+			}
+		}
+	}
+	sort.Strings(cmds)
+	return regexp.MustCompile(
+		"(?:^|[;&|({`!]|\\b(?:then|do|else|if|elif|while|until|sudo|exec|time|xargs|env|nohup)\\s)\\s*(?:\\w+=\\S*\\s+)*(" +
+			strings.Join(cmds, "|") +
+			")(?:\\.exe)?(?:[\\s;&|)`]|$)",
+	)
+}
+
+// runnerImageOfLabel returns the runner image selected by the runner label. It returns nil when the
+// label does not select a GitHub-hosted runner image in the inventory.
+func runnerImageOfLabel(label string) *RunnerImage {
+	l := reRunnerImageSize.ReplaceAllString(strings.ToLower(label), "")
+	c, ok := defaultRunnerOSCompats[l]
+	if !ok {
+		return nil
+	}
+	for _, i := range RunnerImageTools {
+		if defaultRunnerOSCompats[i.Label] == c {
+			return i
+		}
+	}
+	return nil
+}
+
+// RuleRunnerTool is a rule checker to detect commands at "run:" which are not preinstalled on the
+// GitHub-hosted runner image selected by "runs-on:". For example, "docker" is not available on
+// macOS runners and some tools are removed on upgrading runner images. Such scripts fail only at
+// runtime with "command not found". The inventory of the tools is generated from the software
+// reports of runner images by script at ./scripts/generate-runner-tools.
+type RuleRunnerTool struct {
+	RuleBase
+}
+
+// NewRuleRunnerTool creates a new RuleRunnerTool instance.
+func NewRuleRunnerTool() *RuleRunnerTool {
+	return &RuleRunnerTool{
+		RuleBase: RuleBase{
+			name: "runner-tool",
+			desc: "Checks for commands at \"run:\" which are not preinstalled on the runner image selected by \"runs-on:\"",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleRunnerTool) VisitJobPre(n *Job) error {
+	// Steps in container do not run on the runner image
+	if n.RunsOn == nil || n.RunsOn.LabelsExpr != nil || n.ID == nil || n.Container != nil {
+		return nil
+	}
+
+	var img *RunnerImage
+	for _, l := range n.RunsOn.Labels {
+		if l.ContainsExpression() || strings.EqualFold(l.Value, "self-hosted") {
+			return nil
+		}
+		if i := runnerImageOfLabel(l.Value); i != nil {
+			img = i
+			break
+		}
+	}
+	if img == nil {
+		return nil
+	}
+
+	installed := map[string]struct{}{}
+	reported := map[string]struct{}{}
+	for _, s := range n.Steps {
+		switch e := s.Exec.(type) {
+		case *ExecAction:
+			// Actions like "docker/setup-docker-action" set up the tools
+			if e.Uses != nil {
+				for _, w := range reRunnerToolActionWord.FindAllString(e.Uses.Value, -1) {
+					installed[w] = struct{}{}
+				}
+			}
+		case *ExecRun:
+			if e.Run == nil || s.If != nil && reRunnerToolOSCheck.MatchString(s.If.Value) {
+				continue
+			}
+			src := e.Run.Value
+			if reRunnerToolOSCheck.MatchString(src) {
+				continue
+			}
+			lines := strings.Split(src, "\n")
+			for _, l := range lines {
+				if m := reRunnerToolInstall.FindStringSubmatch(l); m != nil {
+					for _, w := range reRunnerToolWord.FindAllString(m[1]+" "+m[2], -1) {
+						installed[w] = struct{}{}
+					}
+				}
+			}
+			for _, l := range lines {
+				if strings.HasPrefix(strings.TrimSpace(l), "#") {
+					continue
+				}
+				for _, m := range reRunnerToolCommand.FindAllStringSubmatch(l, -1) {
+					c := m[1]
+					if img.Tools[c] {
+						continue
+					}
+					if _, ok := installed[c]; ok {
+						continue
+					}
+					if _, ok := reported[c]; ok {
+						continue
+					}
+					reported[c] = struct{}{}
+					rule.report(e.Run.Pos, c, n.ID.Value, img)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (rule *RuleRunnerTool) report(pos *Pos, cmd, job string, img *RunnerImage) {
+	older, newer, others := []string{}, []string{}, []string{}
+	found := false
+	for _, i := range RunnerImageTools {
+		if i == img {
+			found = true
+			continue
+		}
+		if !i.Tools[cmd] {
+			continue
+		}
+		if i.OS != img.OS {
+			if o := runnerOSNames[i.OS]; len(others) == 0 || others[len(others)-1] != o {
+				others = append(others, o)
+			}
+		} else if found {
+			newer = append(newer, i.Label)
+		} else {
+			older = append(older, i.Label)
+		}
+	}
+
+	switch {
+	case len(older) > 0:
+		rule.Errorf(
+			pos,
+			"command %q is not preinstalled on runner image %q of job %q though it was preinstalled on the older image %s. it was removed on upgrading the image. install it in a previous step. see %s",
+			cmd,
+			img.Label,
+			job,
+			quotes(older),
+			img.URL,
+		)
+	case len(newer) > 0:
+		rule.Errorf(
+			pos,
+			"command %q is not preinstalled on runner image %q of job %q. it is preinstalled on the newer image %s. install it in a previous step or use the newer image. see %s",
+			cmd,
+			img.Label,
+			job,
+			quotes(newer),
+			img.URL,
+		)
+	case len(others) > 0:
+		rule.Errorf(
+			pos,
+			"command %q is not preinstalled on %s runner image %q of job %q. it is preinstalled only on %s runners. install it in a previous step or run the job on such runner. see %s",
+			cmd,
+			runnerOSNames[img.OS],
+			img.Label,
+			job,
+			strings.Join(others, " and "),
+			img.URL,
+		)
+	default:
+		rule.Errorf(
+			pos,
+			"command %q is not preinstalled on runner image %q of job %q. install it in a previous step. see %s",
+			cmd,
+			img.Label,
+			job,
+			img.URL,
+		)
+	}
+}
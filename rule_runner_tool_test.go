@@ -0,0 +1,98 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleRunnerToolImageOfLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		want  string
+	}{
+		{"ubuntu-latest", "ubuntu-22.04"},
+		{"ubuntu-latest-8-cores", "ubuntu-22.04"},
+		{"ubuntu-22.04", "ubuntu-22.04"},
+		{"ubuntu-20.04", "ubuntu-20.04"},
+		{"macos-latest", "macos-14"},
+		{"macos-latest-large", "macos-14"},
+		{"macos-14", "macos-14"},
+		{"MacOS-14", "macos-14"},
+		{"macos-14.0", "macos-14"},
+		{"macos-13-xlarge", "macos-13"},
+		{"macos-12-xl", "macos-12"},
+		{"windows-latest", "windows-2022"},
+		{"windows-2019", "windows-2019"},
+		{"linux", ""},
+		{"macos", ""},
+		{"self-hosted", ""},
+		{"my-custom-runner", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.label, func(t *testing.T) {
+			have := ""
+			if i := runnerImageOfLabel(tc.label); i != nil {
+				have = i.Label
+			}
+			if have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleRunnerToolCommand(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"docker build .", "docker"},
+		{"  docker build .", "docker"},
+		{"sudo apt-get update", "apt-get"},
+		{"DEBIAN_FRONTEND=noninteractive apt-get install -y jq", "apt-get"},
+		{"make && docker push app", "docker"},
+		{"echo $(xcrun --show-sdk-path)", "xcrun"},
+		{"if ! docker info; then exit 1; fi", "docker"},
+		{"docker.exe build .", "docker"},
+		{"cat list | xargs brew install", "brew"},
+		{"echo docker build .", ""},
+		{"./docker build .", ""},
+		{"docker-compose up", ""},
+		{"mydocker build .", ""},
+		{"echo 'apt-get'", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.line, func(t *testing.T) {
+			have := ""
+			if m := reRunnerToolCommand.FindStringSubmatch(tc.line); m != nil {
+				have = m[1]
+			}
+			if have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleRunnerToolInventory(t *testing.T) {
+	cmds := map[string]struct{}{}
+	for _, i := range RunnerImageTools {
+		if runnerImageOfLabel(i.Label) != i {
+			t.Errorf("label %q does not select the image", i.Label)
+		}
+		if _, ok := runnerOSNames[i.OS]; !ok {
+			t.Errorf("unknown OS %q of image %q", i.OS, i.Label)
+		}
+		for c := range i.Tools {
+			cmds[c] = struct{}{}
+		}
+	}
+	for _, i := range RunnerImageTools {
+		for c := range cmds {
+			if _, ok := i.Tools[c]; !ok {
+				t.Errorf("command %q is not tracked on image %q", c, i.Label)
+			}
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package actionlint
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Timezone annotations should be verified even on systems without timezone database
+)
+
+var (
+	// Times in comments like "9am", "9:30 pm", or "17:00"
+	reScheduleTimezone12h = regexp.MustCompile(`(?i)\b(1[0-2]|0?[1-9])(?::([0-5][0-9]))?\s*([ap])\.?m\b\.?`)
+	reScheduleTimezone24h = regexp.MustCompile(`\b([01]?[0-9]|2[0-3]):([0-5][0-9])\b`)
+	// Timezones in comments like "PST" or "UTC+9"
+	reScheduleTimezoneAbbr   = regexp.MustCompile(`\b(UTC|[A-Z]{1,4}T)\b`)
+	reScheduleTimezoneOffset = regexp.MustCompile(`\b(?:UTC|GMT)([+-])([01]?[0-9])(?::?([0-5][0-9]))?\b`)
+	// Annotation to declare the timezone of the times in the comment like "actionlint:tz America/Los_Angeles"
+	reScheduleTimezoneAnnotation = regexp.MustCompile(`\bactionlint:tz\s+(\S+)`)
+)
+
+// scheduleTimezoneAbbrs maps the abbreviations of timezones to their offsets from UTC in minutes.
+var scheduleTimezoneAbbrs = map[string]int{
+	"UTC":  0,
+	"GMT":  0,
+	"PST":  -8 * 60,
+	"PDT":  -7 * 60,
+	"MST":  -7 * 60,
+	"MDT":  -6 * 60,
+	"CST":  -6 * 60,
+	"CDT":  -5 * 60,
+	"EST":  -5 * 60,
+	"EDT":  -4 * 60,
+	"AKST": -9 * 60,
+	"AKDT": -8 * 60,
+	"HST":  -10 * 60,
+	"BST":  1 * 60,
+	"CET":  1 * 60,
+	"CEST": 2 * 60,
+	"EET":  2 * 60,
+	"EEST": 3 * 60,
+	"IST":  5*60 + 30,
+	"SGT":  8 * 60,
+	"HKT":  8 * 60,
+	"JST":  9 * 60,
+	"KST":  9 * 60,
+	"AEST": 10 * 60,
+	"AEDT": 11 * 60,
+}
+
+// scheduleTimezoneGenericAbbrs maps the abbreviations of timezones which switch standard time and
+// daylight saving time to their locations.
+var scheduleTimezoneGenericAbbrs = map[string]string{
+	"PT": "America/Los_Angeles",
+	"MT": "America/Denver",
+	"CT": "America/Chicago",
+	"ET": "America/New_York",
+}
+
+// scheduleTime is a time of day in minutes since midnight.
+type scheduleTime int
+
+func (t scheduleTime) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+// commentTime is a time mentioned in a comment. When the minute is not mentioned like "9am", only
+// the hour is compared.
+type commentTime struct {
+	time      scheduleTime
+	text      string
+	hourMatch bool
+}
+
+func (t *commentTime) matches(s scheduleTime) bool {
+	if t.hourMatch {
+		return int(t.time)/60 == int(s)/60
+	}
+	return t.time == s
+}
+
+// RuleScheduleTimezone is a rule checker to detect comments of cron schedules which assume the
+// schedules run in a local timezone. Scheduled workflows always run in UTC. The timezone of the times
+// in comments can be declared by "# actionlint:tz {location}" annotation.
+// https://docs.github.com/en/actions/using-workflows/events-that-trigger-workflows#schedule
+type RuleScheduleTimezone struct {
+	RuleBase
+	lines []string
+}
+
+// NewRuleScheduleTimezone creates a new RuleScheduleTimezone instance. The src parameter is the
+// source of the workflow, which is used to read the comments of cron schedules.
+func NewRuleScheduleTimezone(src []byte) *RuleScheduleTimezone {
+	return &RuleScheduleTimezone{
+		RuleBase: RuleBase{
+			name: "schedule-timezone",
+			desc: "Checks for comments of cron schedules expecting local timezone while scheduled workflows run in UTC",
+		},
+		lines: strings.Split(string(bytes.ReplaceAll(src, []byte{'\r'}, nil)), "\n"),
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleScheduleTimezone) VisitWorkflowPre(n *Workflow) error {
+	for _, e := range n.On {
+		if s, ok := e.(*ScheduledEvent); ok {
+			for _, c := range s.Cron {
+				rule.checkCron(c)
+			}
+		}
+	}
+	return nil
+}
+
+func (rule *RuleScheduleTimezone) checkCron(spec *String) {
+	if spec == nil || spec.Pos == nil {
+		return
+	}
+	trailing, above := rule.comments(spec.Pos.Line)
+
+	// The comment at the end of the line is prioritized. The timezone can be mentioned in the other
+	// comment like an annotation above the line
+	var mentioned []*commentTime
+	cs := []string{trailing, above}
+	for i, c := range cs {
+		// Remove offsets like "UTC+09:00" not to regard them as times
+		if ts := scheduleTimesInComment(reScheduleTimezoneOffset.ReplaceAllString(c, "")); len(ts) > 0 {
+			mentioned = ts
+			cs[0], cs[i] = cs[i], cs[0]
+			break
+		}
+	}
+
+	var name string
+	var offsets []int
+	for _, c := range cs {
+		n, os, err := scheduleTimezoneOfComment(c)
+		if err != nil {
+			rule.Error(spec.Pos, err.Error())
+			return
+		}
+		if len(os) > 0 {
+			name, offsets = n, os
+			break
+		}
+	}
+	if len(offsets) == 0 || len(mentioned) == 0 {
+		return
+	}
+	times := scheduleTimesOfCron(spec.Value)
+	if len(times) == 0 {
+		return
+	}
+
+	// offsets[0] is the offset in standard time. offsets[1] is the offset in daylight saving time if any
+	matched := make([]bool, len(offsets))
+	for i, o := range offsets {
+	Loop:
+		for _, t := range times {
+			l := scheduleTime((int(t) + o + 24*60) % (24 * 60))
+			for _, m := range mentioned {
+				if m.matches(l) {
+					matched[i] = true
+					break Loop
+				}
+			}
+		}
+	}
+
+	local := func(o int) scheduleTime {
+		return scheduleTime((int(times[0]) + o + 24*60) % (24 * 60))
+	}
+	if len(offsets) == 1 || (!matched[0] && !matched[1]) {
+		if matched[0] {
+			return
+		}
+		if len(offsets) == 1 && offsets[0] == 0 {
+			rule.Errorf(
+				spec.Pos,
+				"schedule %q runs at %s UTC. it does not match %q mentioned in the comment",
+				spec.Value,
+				times[0],
+				mentioned[0].text,
+			)
+			return
+		}
+		rule.Errorf(
+			spec.Pos,
+			"schedule %q runs at %s UTC, which is %s in %s. it does not match %q mentioned in the comment. note that scheduled workflows always run in UTC",
+			spec.Value,
+			times[0],
+			local(offsets[0]),
+			name,
+			mentioned[0].text,
+		)
+		return
+	}
+	if matched[0] && matched[1] {
+		return
+	}
+
+	which, other, o := "standard", "daylight saving", offsets[1]
+	if matched[1] {
+		which, other, o = other, which, offsets[0]
+	}
+	rule.Errorf(
+		spec.Pos,
+		"schedule %q runs at %s UTC, which matches %q mentioned in the comment only during %s time in %s. it runs at %s during %s time since scheduled workflows always run in UTC and do not follow daylight saving time",
+		spec.Value,
+		times[0],
+		mentioned[0].text,
+		which,
+		name,
+		local(o),
+		other,
+	)
+}
+
+// comments returns the comment at the end of the line of the cron schedule and the comment lines
+// just above the line.
+func (rule *RuleScheduleTimezone) comments(line int) (string, string) {
+	if line < 1 || line > len(rule.lines) {
+		return "", ""
+	}
+	trailing := ""
+	if i := strings.Index(rule.lines[line-1], " #"); i >= 0 {
+		trailing = rule.lines[line-1][i+2:]
+	}
+	above := []string{}
+	for i := line - 2; i >= 0; i-- {
+		l := strings.TrimSpace(rule.lines[i])
+		if !strings.HasPrefix(l, "#") {
+			break
+		}
+		above = append(above, l[1:])
+	}
+	for i, j := 0, len(above)-1; i < j; i, j = i+1, j-1 {
+		above[i], above[j] = above[j], above[i]
+	}
+	return trailing, strings.Join(above, "\n")
+}
+
+// scheduleTimezoneOfComment returns the name of the timezone mentioned in the comment and its
+// offsets from UTC in minutes. When the timezone follows daylight saving time, the offset in
+// standard time and the offset in daylight saving time are returned. An error is returned when the
+// annotation has unknown location.
+func scheduleTimezoneOfComment(comment string) (string, []int, error) {
+	if m := reScheduleTimezoneAnnotation.FindStringSubmatch(comment); m != nil {
+		loc, err := time.LoadLocation(m[1])
+		if err != nil {
+			return "", nil, fmt.Errorf("unknown timezone %q in \"actionlint:tz\" annotation. it must be a name in IANA time zone database such as \"America/Los_Angeles\"", m[1])
+		}
+		return m[1], scheduleTimezoneOffsets(loc), nil
+	}
+	if m := reScheduleTimezoneOffset.FindStringSubmatch(comment); m != nil {
+		h, _ := strconv.Atoi(m[2])
+		o := h * 60
+		if m[3] != "" {
+			min, _ := strconv.Atoi(m[3])
+			o += min
+		}
+		if m[1] == "-" {
+			o = -o
+		}
+		return m[0], []int{o}, nil
+	}
+	for _, m := range reScheduleTimezoneAbbr.FindAllStringSubmatch(comment, -1) {
+		if o, ok := scheduleTimezoneAbbrs[m[1]]; ok {
+			return m[1], []int{o}, nil
+		}
+		if l, ok := scheduleTimezoneGenericAbbrs[m[1]]; ok {
+			loc, err := time.LoadLocation(l)
+			if err != nil {
+				return "", nil, nil
+			}
+			return fmt.Sprintf("%s (%s)", m[1], l), scheduleTimezoneOffsets(loc), nil
+		}
+	}
+	return "", nil, nil
+}
+
+// scheduleTimezoneOffsets returns the offset of the location in standard time and the offset in
+// daylight saving time in minutes.
+func scheduleTimezoneOffsets(loc *time.Location) []int {
+	_, jan := time.Date(2024, time.January, 15, 0, 0, 0, 0, loc).Zone()
+	_, jul := time.Date(2024, time.July, 15, 0, 0, 0, 0, loc).Zone()
+	if jan == jul {
+		return []int{jan / 60}
+	}
+	if jan > jul {
+		jan, jul = jul, jan // Southern hemisphere
+	}
+	return []int{jan / 60, jul / 60}
+}
+
+// scheduleTimesInComment returns the times of day mentioned in the comment.
+func scheduleTimesInComment(comment string) []*commentTime {
+	ts := []*commentTime{}
+	for _, m := range reScheduleTimezone12h.FindAllStringSubmatch(comment, -1) {
+		h, _ := strconv.Atoi(m[1])
+		h %= 12
+		if strings.ToLower(m[3]) == "p" {
+			h += 12
+		}
+		min := 0
+		if m[2] != "" {
+			min, _ = strconv.Atoi(m[2])
+		}
+		ts = append(ts, &commentTime{scheduleTime(h*60 + min), strings.TrimSpace(m[0]), m[2] == ""})
+	}
+	if len(ts) > 0 {
+		return ts
+	}
+	for _, m := range reScheduleTimezone24h.FindAllStringSubmatch(comment, -1) {
+		h, _ := strconv.Atoi(m[1])
+		min, _ := strconv.Atoi(m[2])
+		ts = append(ts, &commentTime{scheduleTime(h*60 + min), m[0], false})
+	}
+	return ts
+}
+
+// scheduleTimesOfCron returns the times of day when the cron schedule runs in UTC. Only minute
+// and hour fields which are numbers or lists of numbers are supported. nil is returned for other
+// schedules like "*/15 * * * *".
+func scheduleTimesOfCron(spec string) []scheduleTime {
+	fs := strings.Fields(spec)
+	if len(fs) != 5 {
+		return nil
+	}
+	mins := cronFieldNumbers(fs[0], 59)
+	hours := cronFieldNumbers(fs[1], 23)
+	if len(mins) == 0 || len(hours) == 0 {
+		return nil
+	}
+	ts := make([]scheduleTime, 0, len(mins)*len(hours))
+	for _, h := range hours {
+		for _, m := range mins {
+			ts = append(ts, scheduleTime(h*60+m))
+		}
+	}
+	return ts
+}
+
+func cronFieldNumbers(field string, max int) []int {
+	ns := []int{}
+	for _, f := range strings.Split(field, ",") {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 || n > max {
+			return nil
+		}
+		ns = append(ns, n)
+	}
+	return ns
+}
@@ -0,0 +1,93 @@
+package actionlint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleScheduleTimezoneTimesInComment(t *testing.T) {
+	tests := []struct {
+		comment string
+		want    []string
+	}{
+		{"every day at 9am", []string{"09:00"}},
+		{"at 9:30 PM", []string{"21:30"}},
+		{"12 a.m. and 12pm", []string{"00:00", "12:00"}},
+		{"at 17:45", []string{"17:45"}},
+		{"9am and 17:00", []string{"09:00"}},
+		{"build for amd64", []string{}},
+		{"every 5 minutes", []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.comment, func(t *testing.T) {
+			have := []string{}
+			for _, c := range scheduleTimesInComment(tc.comment) {
+				have = append(have, c.time.String())
+			}
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
+
+func TestRuleScheduleTimezoneOfComment(t *testing.T) {
+	tests := []struct {
+		comment string
+		name    string
+		offsets []int
+	}{
+		{"9am PST", "PST", []int{-8 * 60}},
+		{"10:30 JST", "JST", []int{9 * 60}},
+		{"9:00 UTC+9", "UTC+9", []int{9 * 60}},
+		{"9:00 GMT-03:30", "GMT-03:30", []int{-(3*60 + 30)}},
+		{"2am ET", "ET (America/New_York)", []int{-5 * 60, -4 * 60}},
+		{"9am actionlint:tz Australia/Sydney", "Australia/Sydney", []int{10 * 60, 11 * 60}},
+		{"9am actionlint:tz Asia/Tokyo", "Asia/Tokyo", []int{9 * 60}},
+		{"9am", "", nil},
+		{"run TEST at 9am", "", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.comment, func(t *testing.T) {
+			name, offsets, err := scheduleTimezoneOfComment(tc.comment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name != tc.name {
+				t.Errorf("wanted name %q but got %q", tc.name, name)
+			}
+			if !cmp.Equal(tc.offsets, offsets) {
+				t.Error(cmp.Diff(tc.offsets, offsets))
+			}
+		})
+	}
+}
+
+func TestRuleScheduleTimezoneTimesOfCron(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []string
+	}{
+		{"0 9 * * *", []string{"09:00"}},
+		{"15,45 9,21 * * 1-5", []string{"09:15", "09:45", "21:15", "21:45"}},
+		{"*/15 * * * *", nil},
+		{"0 9-17 * * *", nil},
+		{"0 24 * * *", nil},
+		{"0 9 * *", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.spec, func(t *testing.T) {
+			var have []string
+			for _, s := range scheduleTimesOfCron(tc.spec) {
+				have = append(have, s.String())
+			}
+			if !cmp.Equal(tc.want, have) {
+				t.Fatal(cmp.Diff(tc.want, have))
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package actionlint
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// setupCacheManager is a package manager which can be set to "cache" input of a setup action.
+type setupCacheManager struct {
+	name string
+	// files is name patterns of dependency files of the package manager. They are matched to base
+	// names of the paths in "cache-dependency-path" input.
+	files []string
+}
+
+// The table of setup actions and package managers supported by their "cache" inputs.
+// https://github.com/actions/setup-node#caching-global-packages-data
+// https://github.com/actions/setup-python#caching-packages-dependencies
+// https://github.com/actions/setup-java#caching-packages-dependencies
+var setupCacheManagers = map[string][]*setupCacheManager{
+	"actions/setup-node": {
+		{"npm", []string{"package-lock.json", "npm-shrinkwrap.json"}},
+		{"yarn", []string{"yarn.lock"}},
+		{"pnpm", []string{"pnpm-lock.yaml"}},
+	},
+	"actions/setup-python": {
+		{"pip", nil}, // Any file such as requirements.txt or pyproject.toml can be used
+		{"pipenv", []string{"Pipfile", "Pipfile.lock"}},
+		{"poetry", []string{"poetry.lock"}},
+	},
+	"actions/setup-java": {
+		{"maven", []string{"pom.xml"}},
+		{"gradle", []string{"*.gradle", "*.gradle.kts", "gradle-wrapper.properties"}},
+		{"sbt", []string{"build.sbt"}},
+	},
+}
+
+// RuleSetupCache is a rule checker to detect "cache" and "cache-dependency-path" inputs of setup
+// actions such as actions/setup-node which never hit the cache. The cache key is computed from the
+// files matching to "cache-dependency-path". When no file matches or the files are for another
+// package manager, the cache silently does not work.
+type RuleSetupCache struct {
+	RuleBase
+	project *Project
+	// checkout is true when actions/checkout in the current job checks out the repository at a
+	// different path from the workspace root.
+	checkout bool
+}
+
+// NewRuleSetupCache creates a new RuleSetupCache instance. The project parameter is used to check
+// existence of files matching to "cache-dependency-path". When it is nil, the existence is not
+// checked.
+func NewRuleSetupCache(project *Project) *RuleSetupCache {
+	return &RuleSetupCache{
+		RuleBase: RuleBase{
+			name: "setup-cache",
+			desc: "Checks for \"cache\" and \"cache-dependency-path\" inputs of setup actions such as \"actions/setup-node\" which never hit the cache",
+		},
+		project: project,
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleSetupCache) VisitJobPre(n *Job) error {
+	rule.checkout = false
+	for _, s := range n.Steps {
+		e, ok := s.Exec.(*ExecAction)
+		if !ok || e.Uses == nil || !strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/checkout@") {
+			continue
+		}
+		if p, ok := e.Inputs["path"]; ok && p.Value != nil && strings.Trim(p.Value.Value, "./") != "" {
+			rule.checkout = true
+		}
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleSetupCache) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecAction)
+	if !ok || e.Uses == nil {
+		return nil
+	}
+	slug, _, ok := strings.Cut(e.Uses.Value, "@")
+	if !ok {
+		return nil
+	}
+	managers, ok := setupCacheManagers[strings.ToLower(slug)]
+	if !ok {
+		return nil
+	}
+
+	deps := e.Inputs["cache-dependency-path"]
+	if deps != nil && (deps.Value == nil || deps.Value.ContainsExpression()) {
+		deps = nil
+	}
+
+	c, ok := e.Inputs["cache"]
+	if !ok || c.Value == nil || c.Value.Value == "" {
+		if deps != nil {
+			rule.Errorf(
+				deps.Value.Pos,
+				"\"cache-dependency-path\" input of %q is ignored because \"cache\" input is not set. set the package manager to \"cache\" input to enable caching",
+				e.Uses.Value,
+			)
+		}
+		return nil
+	}
+	if c.Value.ContainsExpression() {
+		return nil
+	}
+
+	var m *setupCacheManager
+	for _, s := range managers {
+		if s.name == c.Value.Value {
+			m = s
+			break
+		}
+	}
+	if m == nil {
+		ns := make([]string, 0, len(managers))
+		for _, s := range managers {
+			ns = append(ns, s.name)
+		}
+		rule.Errorf(
+			c.Value.Pos,
+			"package manager %q at \"cache\" input is not supported by %q. supported package managers are %s",
+			c.Value.Value,
+			e.Uses.Value,
+			sortedQuotes(ns),
+		)
+		return nil
+	}
+
+	if deps == nil {
+		return nil
+	}
+	for _, p := range strings.Split(deps.Value.Value, "\n") {
+		p = strings.TrimSpace(p)
+		if p == "" || strings.HasPrefix(p, "!") || strings.HasPrefix(p, "#") {
+			continue
+		}
+		if o := setupCacheManagerOf(managers, path.Base(p)); o != nil && o != m {
+			rule.Errorf(
+				deps.Value.Pos,
+				"%q at \"cache-dependency-path\" input is a dependency file of %q but the package manager at \"cache\" input is %q. the cache key is computed from the wrong file. fix \"cache\" input or the path",
+				p,
+				o.name,
+				m.name,
+			)
+			continue
+		}
+		if !rule.exists(p) {
+			rule.Errorf(
+				deps.Value.Pos,
+				"no file in the repository matches to %q at \"cache-dependency-path\" input of %q. the cache never hits. fix the path relative to the repository root",
+				p,
+				e.Uses.Value,
+			)
+		}
+	}
+	return nil
+}
+
+func setupCacheManagerOf(managers []*setupCacheManager, base string) *setupCacheManager {
+	for _, m := range managers {
+		for _, f := range m.files {
+			if ok, _ := path.Match(f, base); ok {
+				return m
+			}
+		}
+	}
+	return nil
+}
+
+// exists returns whether any file in the project matches to the glob pattern. It returns true when
+// the existence cannot be checked.
+func (rule *RuleSetupCache) exists(pat string) bool {
+	if rule.project == nil || rule.checkout {
+		return true
+	}
+	if strings.ContainsAny(pat, "$~{") || path.IsAbs(pat) || strings.HasPrefix(pat, "..") {
+		return true // Paths with variables or outside of the repository
+	}
+	pat = path.Clean(strings.TrimPrefix(pat, "./"))
+
+	// Start walking from the directory which does not contain any glob character
+	segs := strings.Split(pat, "/")
+	base := []string{}
+	for len(segs) > 1 && !strings.ContainsAny(segs[0], "*?[") {
+		base, segs = append(base, segs[0]), segs[1:]
+	}
+	root := filepath.Join(append([]string{rule.project.RootDir()}, base...)...)
+
+	errFound := errors.New("found")
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		r, err := filepath.Rel(root, p)
+		if err != nil || r == "." {
+			return nil
+		}
+		if d.IsDir() {
+			if n := d.Name(); n == ".git" || n == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if matchPathGlobSegments(segs, strings.Split(filepath.ToSlash(r), "/")) {
+			return errFound // Stop walking
+		}
+		return nil
+	})
+	return err == errFound
+}
+
+// matchPathGlobSegments matches the path segments to the glob pattern segments. "**" matches zero
+// or more segments and other segments are matched by path.Match.
+func matchPathGlobSegments(pat, segs []string) bool {
+	if len(pat) == 0 {
+		return len(segs) == 0
+	}
+	if pat[0] == "**" {
+		for i := 0; i <= len(segs); i++ {
+			if matchPathGlobSegments(pat[1:], segs[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segs) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pat[0], segs[0]); !ok {
+		return false
+	}
+	return matchPathGlobSegments(pat[1:], segs[1:])
+}
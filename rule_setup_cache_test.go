@@ -0,0 +1,32 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleSetupCacheMatchPathGlob(t *testing.T) {
+	tests := []struct {
+		pat   string
+		path  string
+		match bool
+	}{
+		{"package-lock.json", "package-lock.json", true},
+		{"**/package-lock.json", "package-lock.json", true},
+		{"**/package-lock.json", "web/app/package-lock.json", true},
+		{"web/*/yarn.lock", "web/app/yarn.lock", true},
+		{"web/*/yarn.lock", "web/yarn.lock", false},
+		{"requirements/*.txt", "requirements/dev.txt", true},
+		{"requirements/*.txt", "requirements/dev/test.txt", false},
+		{"requirements/**", "requirements/dev/test.txt", true},
+		{"req?.txt", "req1.txt", true},
+		{"package-lock.json", "web/package-lock.json", false},
+	}
+
+	for _, tc := range tests {
+		have := matchPathGlobSegments(strings.Split(tc.pat, "/"), strings.Split(tc.path, "/"))
+		if have != tc.match {
+			t.Errorf("wanted %v for matching %q to %q but got %v", tc.match, tc.path, tc.pat, have)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package actionlint
+
+import (
+	"strings"
+)
+
+// setupActionTool is a tool set up by a setup action such as actions/setup-go.
+type setupActionTool struct {
+	// action is a slug of the setup action.
+	action string
+	// commands is names of commands provided by the tool.
+	commands []string
+	// cachePaths is paths of caches of the package manager of the tool.
+	cachePaths []string
+}
+
+// The table of setup actions. Commands are usually preinstalled on GitHub-hosted runners so running
+// them before the setup step does not fail but uses the different version silently.
+var setupActionTools = []*setupActionTool{
+	{
+		action:     "actions/setup-go",
+		commands:   []string{"go"},
+		cachePaths: []string{"~/go/pkg/mod", "~/.cache/go-build"},
+	},
+	{
+		action:     "actions/setup-node",
+		commands:   []string{"node", "npm", "npx", "yarn", "pnpm", "corepack"},
+		cachePaths: []string{"~/.npm", "~/.yarn", "~/.pnpm-store", "node_modules"},
+	},
+	{
+		action:     "actions/setup-python",
+		commands:   []string{"python", "python3", "pip", "pip3", "pipx"},
+		cachePaths: []string{"~/.cache/pip", "~/.cache/pypoetry"},
+	},
+	{
+		action:     "actions/setup-java",
+		commands:   []string{"java", "javac", "mvn", "gradle", "./mvnw", "./gradlew"},
+		cachePaths: []string{"~/.m2", "~/.m2/repository", "~/.gradle", "~/.gradle/caches"},
+	},
+	{
+		action:     "actions/setup-dotnet",
+		commands:   []string{"dotnet"},
+		cachePaths: []string{"~/.nuget/packages"},
+	},
+	{
+		action:     "ruby/setup-ruby",
+		commands:   []string{"ruby", "gem", "bundle"},
+		cachePaths: []string{"vendor/bundle"},
+	},
+}
+
+// RuleSetupOrder is a rule checker to detect steps which need a tool but are run before the step
+// setting up the tool with a setup action such as actions/setup-go.
+type RuleSetupOrder struct {
+	RuleBase
+}
+
+// NewRuleSetupOrder creates a new RuleSetupOrder instance.
+func NewRuleSetupOrder() *RuleSetupOrder {
+	return &RuleSetupOrder{
+		RuleBase: RuleBase{
+			name: "setup-order",
+			desc: "Checks for steps using tools or caching their packages before the setup actions such as \"actions/setup-go\"",
+		},
+	}
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleSetupOrder) VisitJobPre(n *Job) error {
+	seen := map[*setupActionTool]struct{}{}
+	for i, s := range n.Steps {
+		e, ok := s.Exec.(*ExecAction)
+		if !ok || e.Uses == nil {
+			continue
+		}
+		t := setupActionToolOf(e.Uses.Value)
+		if t == nil {
+			continue
+		}
+		if _, ok := seen[t]; ok {
+			continue // Only the first setup step matters. The tool may be set up again with other version
+		}
+		seen[t] = struct{}{}
+		rule.checkStepsBefore(n.Steps[:i], t, e.Uses)
+	}
+	return nil
+}
+
+func setupActionToolOf(spec string) *setupActionTool {
+	slug, _, ok := strings.Cut(spec, "@")
+	if !ok {
+		return nil
+	}
+	for _, t := range setupActionTools {
+		if strings.EqualFold(slug, t.action) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (rule *RuleSetupOrder) checkStepsBefore(steps []*Step, t *setupActionTool, setup *String) {
+	for _, s := range steps {
+		switch e := s.Exec.(type) {
+		case *ExecRun:
+			if e.Run == nil {
+				continue
+			}
+			if c := setupToolCommandIn(e.Run.Value, t); c != "" {
+				rule.Errorf(
+					e.Run.Pos,
+					"command %q is run before %q step at line:%d. the command may be missing or a different version of the command preinstalled on the runner may be used. move this step after the setup step",
+					c,
+					setup.Value,
+					setup.Pos.Line,
+				)
+			}
+		case *ExecAction:
+			if e.Uses == nil || !strings.HasPrefix(strings.ToLower(e.Uses.Value), "actions/cache@") {
+				continue
+			}
+			i, ok := e.Inputs["path"]
+			if !ok || i.Value == nil {
+				continue
+			}
+			if p := setupToolCachePathIn(i.Value.Value, t); p != "" {
+				rule.Errorf(
+					e.Uses.Pos,
+					"cache of %q by %q is restored before %q step at line:%d. the setup step decides the version of the tool and the paths of its packages. move this step after the setup step",
+					p,
+					e.Uses.Value,
+					setup.Value,
+					setup.Pos.Line,
+				)
+			}
+		}
+	}
+}
+
+// setupToolCommandIn returns the first command of the tool run in the script. It returns an empty
+// string when no command of the tool is found.
+func setupToolCommandIn(src string, t *setupActionTool) string {
+	if strings.Contains(src, "\\") {
+		src = reLineContinuation.ReplaceAllString(src, " ")
+	}
+	for _, cmd := range splitRemoteScriptCommands(src) {
+		// Skip assignments of environment variables like `GOOS=linux go build`
+		for len(cmd) > 1 && strings.Contains(cmd[0], "=") {
+			cmd = cmd[1:]
+		}
+		for _, c := range t.commands {
+			if cmd[0] == c {
+				return c
+			}
+		}
+	}
+	return ""
+}
+
+// setupToolCachePathIn returns the first path of the package manager cache of the tool in the
+// paths separated by newlines. It returns an empty string when no cache path is found.
+func setupToolCachePathIn(paths string, t *setupActionTool) string {
+	for _, p := range strings.Split(paths, "\n") {
+		p = strings.TrimSuffix(strings.TrimSpace(p), "/")
+		if p == "" || strings.HasPrefix(p, "!") {
+			continue
+		}
+		for _, c := range t.cachePaths {
+			if p == c || !strings.HasPrefix(c, "~") && strings.HasSuffix(p, "/"+c) {
+				return p
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleSetupOrderCommands(t *testing.T) {
+	tests := []struct {
+		what string
+		run  string
+		want string
+	}{
+		{"command", "go build ./...", "go"},
+		{"after other command", "echo hi && go test ./...", "go"},
+		{"with env vars", "GOOS=linux GOARCH=arm64 go build", "go"},
+		{"with sudo", "sudo go install ./cmd/foo", "go"},
+		{"line continuation", "echo hi && \\\n  go vet", "go"},
+		{"argument", "echo go build", ""},
+		{"other command", "gofmt -l .", ""},
+	}
+
+	setupGo := setupActionToolOf("actions/setup-go@v5")
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			if have := setupToolCommandIn(tc.run, setupGo); have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleSetupOrderCachePaths(t *testing.T) {
+	tests := []struct {
+		action string
+		paths  string
+		want   string
+	}{
+		{"actions/setup-go@v5", "~/go/pkg/mod", "~/go/pkg/mod"},
+		{"actions/setup-go@v5", "vendor\n~/.cache/go-build/\n", "~/.cache/go-build"},
+		{"actions/setup-node@v4", "**/node_modules", "**/node_modules"},
+		{"actions/setup-node@v4", "!node_modules", ""},
+		{"actions/setup-go@v5", "~/.npm", ""},
+		{"Actions/Setup-Java@v4", "~/.m2/repository", "~/.m2/repository"},
+	}
+
+	for _, tc := range tests {
+		s := setupActionToolOf(tc.action)
+		if s == nil {
+			t.Fatalf("setup action %q is not found", tc.action)
+		}
+		if have := setupToolCachePathIn(tc.paths, s); have != tc.want {
+			t.Errorf("wanted %q for %q with %q but got %q", tc.want, tc.paths, tc.action, have)
+		}
+	}
+}
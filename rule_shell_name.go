@@ -43,7 +43,7 @@ func (rule *RuleShellName) VisitJobPre(n *Job) error {
 	if n.RunsOn == nil {
 		return nil
 	}
-	rule.platform = rule.getPlatformFromRunner(n.RunsOn)
+	rule.platform = getPlatformFromRunner(n.RunsOn)
 	if n.Defaults != nil && n.Defaults.Run != nil {
 		rule.checkShellName(n.Defaults.Run.Shell)
 	}
@@ -146,7 +146,9 @@ func getAvailableShellNames(kind platformKind) []string {
 	}
 }
 
-func (rule *RuleShellName) getPlatformFromRunner(runner *Runner) platformKind {
+// getPlatformFromRunner returns the platform of the runner from its labels. It returns
+// platformKindAny when the platform cannot be determined.
+func getPlatformFromRunner(runner *Runner) platformKind {
 	if runner == nil {
 		return platformKindAny
 	}
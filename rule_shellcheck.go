@@ -3,11 +3,25 @@ package actionlint
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
+// shellcheckBatchSize is the maximum number of scripts checked by one shellcheck process. Checking
+// multiple scripts at once reduces the number of processes on large repositories which have
+// thousands of run: steps. Scripts of different workflows are checked in the same batch.
+const shellcheckBatchSize = 32
+
+// shellcheckBatchLinger is the time to wait for other workflows adding scripts to the batches
+// before checking the batches which are not full yet.
+const shellcheckBatchLinger = 20 * time.Millisecond
+
 type shellcheckError struct {
+	File    string `json:"file"`
 	Line    int    `json:"line"`
 	Column  int    `json:"column"`
 	Level   string `json:"level"`
@@ -15,24 +29,100 @@ type shellcheckError struct {
 	Message string `json:"message"`
 }
 
+// shellcheckOutput is the output of shellcheck with `-f json1`.
+type shellcheckOutput struct {
+	Comments []shellcheckError `json:"comments"`
+}
+
+// shellcheckScript is a script at 'run:' waiting for being checked by shellcheck.
+type shellcheckScript struct {
+	src  string
+	pos  *Pos
+	rule *RuleShellcheck
+}
+
+// shellcheckBatcher collects scripts at 'run:' from all workflows checked in one run and checks
+// them with shellcheck processes in batches. RuleShellcheck instances share one batcher via
+// concurrentProcess so that small workflows do not run one process each. Batches are checked when
+// they are full or when shellcheckBatchLinger passed after a workflow finished adding its scripts.
+// Calling methods of this type is thread-safe.
+type shellcheckBatcher struct {
+	cmd     *externalCommand
+	batches map[string][]*shellcheckScript
+	mu      sync.Mutex
+}
+
+// shellcheckBatcher returns the batcher shared by all workflows checked with this instance for the
+// shellcheck executable. The batcher is created at the first call.
+func (proc *concurrentProcess) shellcheckBatcher(executable string) (*shellcheckBatcher, error) {
+	cmd, err := proc.newCommandRunner(executable, false)
+	if err != nil {
+		return nil, err
+	}
+
+	proc.mu.Lock()
+	defer proc.mu.Unlock()
+	if b, ok := proc.shellchecks[cmd.exe]; ok {
+		return b, nil
+	}
+	b := &shellcheckBatcher{cmd: cmd, batches: map[string][]*shellcheckScript{}}
+	if proc.shellchecks == nil {
+		proc.shellchecks = map[string]*shellcheckBatcher{}
+	}
+	proc.shellchecks[cmd.exe] = b
+	return b, nil
+}
+
+// add adds the script to the batch for the shell. The batch is checked when it is full.
+func (b *shellcheckBatcher) add(sh string, script *shellcheckScript) {
+	b.mu.Lock()
+	b.batches[sh] = append(b.batches[sh], script)
+	if len(b.batches[sh]) < shellcheckBatchSize {
+		b.mu.Unlock()
+		return
+	}
+	scripts := b.batches[sh]
+	delete(b.batches, sh)
+	b.mu.Unlock()
+
+	b.run(sh, scripts) // Run the process without the lock since starting it may wait for other processes
+}
+
+// flush checks the scripts in the batches even if they are not full.
+func (b *shellcheckBatcher) flush() {
+	for _, sh := range []string{"bash", "sh"} {
+		b.mu.Lock()
+		scripts := b.batches[sh]
+		delete(b.batches, sh)
+		b.mu.Unlock()
+
+		if len(scripts) > 0 {
+			b.run(sh, scripts)
+		}
+	}
+}
+
 // RuleShellcheck is a rule to check shell scripts at 'run:' using shellcheck.
 // https://github.com/koalaman/shellcheck
 type RuleShellcheck struct {
 	RuleBase
-	cmd           *externalCommand
+	batcher       *shellcheckBatcher
 	workflowShell string
 	jobShell      string
 	runnerShell   string
+	pending       sync.WaitGroup
+	added         bool
+	err           error
 	mu            sync.Mutex
 }
 
-func newRuleShellcheck(cmd *externalCommand) *RuleShellcheck {
+func newRuleShellcheck(batcher *shellcheckBatcher) *RuleShellcheck {
 	return &RuleShellcheck{
 		RuleBase: RuleBase{
 			name: "shellcheck",
 			desc: "Checks for shell script sources in \"run:\" using shellcheck",
 		},
-		cmd:           cmd,
+		batcher:       batcher,
 		workflowShell: "",
 		jobShell:      "",
 		runnerShell:   "",
@@ -41,13 +131,14 @@ func newRuleShellcheck(cmd *externalCommand) *RuleShellcheck {
 
 // NewRuleShellcheck creates new RuleShellcheck instance. The executable argument can be command
 // name or relative/absolute file path. When the given executable is not found in system, it returns
-// an error as 2nd return value.
+// an error as 2nd return value. Scripts are checked in batches shared by all rules created with the
+// same proc argument.
 func NewRuleShellcheck(executable string, proc *concurrentProcess) (*RuleShellcheck, error) {
-	cmd, err := proc.newCommandRunner(executable, false)
+	b, err := proc.shellcheckBatcher(executable)
 	if err != nil {
 		return nil, err
 	}
-	return newRuleShellcheck(cmd), nil
+	return newRuleShellcheck(b), nil
 }
 
 // VisitStep is callback when visiting Step node.
@@ -57,8 +148,7 @@ func (rule *RuleShellcheck) VisitStep(n *Step) error {
 		return nil
 	}
 
-	rule.runShellcheck(run.Run.Value, rule.getShellName(run), run.RunPos)
-	return nil
+	return rule.addScript(run.Run.Value, rule.getShellName(run), run.RunPos)
 }
 
 // VisitJobPre is callback when visiting Job node before visiting its children.
@@ -100,7 +190,26 @@ func (rule *RuleShellcheck) VisitWorkflowPre(n *Workflow) error {
 // VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
 func (rule *RuleShellcheck) VisitWorkflowPost(n *Workflow) error {
 	rule.workflowShell = ""
-	return rule.cmd.wait() // Wait until all processes running for this rule
+	if !rule.added {
+		return nil
+	}
+	// Wait until all scripts of this workflow are checked
+	done := make(chan struct{})
+	go func() {
+		rule.pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(shellcheckBatchLinger):
+		// Scripts added by other workflows while waiting are also checked in the same batches
+		rule.batcher.flush()
+		<-done
+	}
+
+	rule.mu.Lock()
+	defer rule.mu.Unlock()
+	return rule.err
 }
 
 func (rule *RuleShellcheck) getShellName(exec *ExecRun) string {
@@ -160,7 +269,9 @@ func sanitizeExpressionsInScript(src string) string {
 	}
 }
 
-func (rule *RuleShellcheck) runShellcheck(src, shell string, pos *Pos) {
+// addScript adds the script to the batch for the shell. The batch is checked by one shellcheck
+// process when it is full or when visiting some workflow is finished.
+func (rule *RuleShellcheck) addScript(src, shell string, pos *Pos) error {
 	var sh string
 	if shell == "bash" || shell == "sh" {
 		sh = shell
@@ -169,11 +280,59 @@ func (rule *RuleShellcheck) runShellcheck(src, shell string, pos *Pos) {
 	} else if strings.HasPrefix(shell, "sh ") {
 		sh = "sh"
 	} else {
-		return // Skip checking this shell script since shellcheck doesn't support it
+		return nil // Skip checking this shell script since shellcheck doesn't support it
 	}
 
 	src = sanitizeExpressionsInScript(src)
-	rule.Debug("%s: Run shellcheck for %s script:\n%s", pos, sh, src)
+	rule.Debug("%s: Add %s script to shellcheck batch:\n%s", pos, sh, src)
+
+	// Use same options to run shell process described at document
+	// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#using-a-specific-shell
+	setup := "set -e"
+	if sh == "bash" {
+		setup = "set -eo pipefail"
+	}
+	script := fmt.Sprintf("%s\n%s\n", setup, src)
+
+	rule.added = true
+	rule.pending.Add(1)
+	rule.batcher.add(sh, &shellcheckScript{script, pos, rule})
+	return nil
+}
+
+// fail records the error which occurred while checking the script. Only the first error is returned
+// from VisitWorkflowPost.
+func (rule *RuleShellcheck) fail(err error) {
+	rule.mu.Lock()
+	if rule.err == nil {
+		rule.err = err
+	}
+	rule.mu.Unlock()
+}
+
+// report reports the issue found by shellcheck in the script.
+func (rule *RuleShellcheck) report(pos *Pos, err *shellcheckError) {
+	// Synchronize rule.Errorf calls
+	rule.mu.Lock()
+	defer rule.mu.Unlock()
+	// Consider the first line is setup for running shell which was implicitly added for better check
+	line := err.Line - 1
+	msg := strings.TrimSuffix(err.Message, ".") // Trim period aligning style of error message
+	rule.Errorf(pos, "shellcheck reported issue in this script: SC%d:%s:%d:%d: %s", err.Code, err.Level, line, err.Column, msg)
+}
+
+// run runs shellcheck process for the scripts. A single script is passed via stdin. Multiple
+// scripts are written to temporary files and passed to one process. The results are reported to
+// the rules which added the scripts.
+func (b *shellcheckBatcher) run(sh string, scripts []*shellcheckScript) {
+	done := func(err error) {
+		for _, s := range scripts {
+			if err != nil {
+				s.rule.fail(err)
+			}
+			s.rule.pending.Done()
+		}
+	}
 
 	// Reasons to exclude the rules:
 	//
@@ -187,47 +346,69 @@ func (rule *RuleShellcheck) runShellcheck(src, shell string, pos *Pos) {
 	//           so this rule can cause false positives (#53).
 	// - SC2157: Argument to -z is always false due to literal strings. When the argument of -z is replaced from ${{ }},
 	//           this can happen. For example, `if [ -z ${{ env.FOO }} ]` -> `if [ -z ______________ ]` (#113).
-	args := []string{"--norc", "-f", "json", "-x", "--shell", sh, "-e", "SC1091,SC2194,SC2050,SC2154,SC2157", "-"}
-	rule.Debug("%s: Running %s command with %s", pos, rule.cmd.exe, args)
+	args := []string{"--norc", "-f", "json1", "-x", "--shell", sh, "-e", "SC1091,SC2194,SC2050,SC2154,SC2157"}
 
-	// Use same options to run shell process described at document
-	// https://docs.github.com/en/actions/learn-github-actions/workflow-syntax-for-github-actions#using-a-specific-shell
-	setup := "set -e"
-	if sh == "bash" {
-		setup = "set -eo pipefail"
+	stdin := ""
+	dir := ""
+	files := map[string]*shellcheckScript{}
+	if len(scripts) == 1 {
+		stdin = scripts[0].src
+		files["-"] = scripts[0]
+		args = append(args, "-")
+	} else {
+		d, err := os.MkdirTemp("", "actionlint-shellcheck-")
+		if err != nil {
+			done(fmt.Errorf("could not create temporary directory to run shellcheck: %w", err))
+			return
+		}
+		dir = d
+		for i, s := range scripts {
+			f := filepath.Join(dir, strconv.Itoa(i)+".sh")
+			if err := os.WriteFile(f, []byte(s.src), 0600); err != nil {
+				os.RemoveAll(dir)
+				done(fmt.Errorf("could not write script to temporary file to run shellcheck: %w", err))
+				return
+			}
+			files[f] = s
+			args = append(args, f)
+		}
 	}
-	script := fmt.Sprintf("%s\n%s\n", setup, src)
+	pos := scripts[0].pos
+	scripts[0].rule.Debug("%s: Running %s command with %s for %d script(s)", pos, b.cmd.exe, args, len(scripts))
 
-	rule.cmd.run(args, script, func(stdout []byte, err error) error {
-		if err != nil {
-			rule.Debug("Command %s %s failed: %v", rule.cmd.exe, args, err)
-			return fmt.Errorf("`%s %s` did not run successfully while checking script at %s: %w", rule.cmd.exe, strings.Join(args, " "), pos, err)
+	b.cmd.run(args, stdin, func(stdout []byte, err error) error {
+		if dir != "" {
+			defer os.RemoveAll(dir)
 		}
 
-		errs := []shellcheckError{}
-		if err := json.Unmarshal(stdout, &errs); err != nil {
-			return fmt.Errorf("could not parse JSON output from shellcheck: %w: stdout=%q", err, stdout)
+		if err != nil {
+			scripts[0].rule.Debug("Command %s %s failed: %v", b.cmd.exe, args, err)
+			done(fmt.Errorf("`%s %s` did not run successfully while checking script at %s: %w", b.cmd.exe, strings.Join(args, " "), pos, err))
+			return nil
 		}
-		if len(errs) == 0 {
+
+		var out shellcheckOutput
+		if err := json.Unmarshal(stdout, &out); err != nil {
+			done(fmt.Errorf("could not parse JSON output from shellcheck: %w: stdout=%q", err, stdout))
 			return nil
 		}
 
-		// Synchronize rule.Errorf calls
-		rule.mu.Lock()
-		defer rule.mu.Unlock()
 		// It's better to show source location in the script as position of error, but it's not
 		// possible easily. YAML has multiple block styles with '|', '>', '|+', '>+', '|-', '>-'. Some
 		// of them remove indentation and/or blank lines. So restoring source position in block string
 		// is not possible. Sourcemap is necessary to do it.
 		// Instead, actionlint shows position of 'run:' as position of error. And separately show
 		// location in script which is reported by shellcheck in error message.
-		for _, err := range errs {
-			// Consider the first line is setup for running shell which was implicitly added for better check
-			line := err.Line - 1
-			msg := strings.TrimSuffix(err.Message, ".") // Trim period aligning style of error message
-			rule.Errorf(pos, "shellcheck reported issue in this script: SC%d:%s:%d:%d: %s", err.Code, err.Level, line, err.Column, msg)
+		for i := range out.Comments {
+			err := &out.Comments[i]
+			s, ok := files[err.File]
+			if !ok {
+				continue // Issue in other file sourced by the script with -x
+			}
+			s.rule.report(s.pos, err)
 		}
 
+		done(nil)
 		return nil
 	})
 }
@@ -1,7 +1,12 @@
 package actionlint
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
 )
 
@@ -158,7 +163,7 @@ func TestRuleShellcheckDetectShell(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.what, func(t *testing.T) {
-			r := newRuleShellcheck(&externalCommand{})
+			r := newRuleShellcheck(nil)
 
 			w := &Workflow{}
 			if tc.workflow != "" {
@@ -197,3 +202,181 @@ func TestRuleShellcheckDetectShell(t *testing.T) {
 		})
 	}
 }
+
+// writeFakeShellcheck writes a fake shellcheck command which reports an issue for each script and
+// records its arguments to the log file.
+func writeFakeShellcheck(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	log := filepath.Join(dir, "invocations.log")
+	exe := filepath.Join(dir, "shellcheck")
+	fake := `#!/bin/sh
+echo "$*" >> '` + log + `'
+printf '{"comments":['
+sep=
+for a in "$@"; do
+	case "$a" in
+	-|*.sh)
+		printf '%s{"file":"%s","line":2,"column":6,"level":"info","code":2086,"message":"Double quote to prevent globbing."}' "$sep" "$a"
+		sep=,
+		;;
+	esac
+done
+printf ']}'
+exit 1
+`
+	if err := os.WriteFile(exe, []byte(fake), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return exe, log
+}
+
+func TestRuleShellcheckBatchScripts(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake shellcheck command is a shell script")
+	}
+
+	exe, log := writeFakeShellcheck(t)
+	proc := newConcurrentProcess(context.Background(), 2)
+	r, err := NewRuleShellcheck(exe, proc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numBash := shellcheckBatchSize + 8
+	r.VisitWorkflowPre(&Workflow{})
+	r.VisitJobPre(&Job{})
+	for i := 0; i < numBash; i++ {
+		s := &Step{Exec: &ExecRun{Run: &String{Value: "echo $FOO"}, RunPos: &Pos{Line: i + 1, Col: 1}}}
+		if err := r.VisitStep(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := &Step{Exec: &ExecRun{Run: &String{Value: "echo $FOO"}, Shell: &String{Value: "sh"}, RunPos: &Pos{Line: numBash + 1, Col: 1}}}
+	if err := r.VisitStep(s); err != nil {
+		t.Fatal(err)
+	}
+	r.VisitJobPost(&Job{})
+	if err := r.VisitWorkflowPost(&Workflow{}); err != nil {
+		t.Fatal(err)
+	}
+	proc.wait()
+
+	b, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// One process for the full bash batch, one for the rest of bash scripts, and one for sh script
+	if lines := strings.Split(strings.TrimSpace(string(b)), "\n"); len(lines) != 3 {
+		t.Fatalf("wanted 3 shellcheck processes but got %d: %q", len(lines), lines)
+	}
+
+	errs := r.Errs()
+	if len(errs) != numBash+1 {
+		t.Fatalf("wanted %d errors but got %d: %v", numBash+1, len(errs), errs)
+	}
+	seen := map[int]struct{}{}
+	for _, err := range errs {
+		want := "shellcheck reported issue in this script: SC2086:info:1:6: Double quote to prevent globbing"
+		if err.Message != want {
+			t.Fatalf("wanted %q but got %q", want, err.Message)
+		}
+		seen[err.Line] = struct{}{}
+	}
+	if len(seen) != numBash+1 {
+		t.Fatalf("errors were not reported at each step: %v", errs)
+	}
+}
+
+func TestRuleShellcheckBatchScriptsAcrossWorkflows(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake shellcheck command is a shell script")
+	}
+
+	exe, log := writeFakeShellcheck(t)
+	proc := newConcurrentProcess(context.Background(), 2)
+	rules := make([]*RuleShellcheck, 3)
+	for i := range rules {
+		r, err := NewRuleShellcheck(exe, proc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.VisitWorkflowPre(&Workflow{})
+		r.VisitJobPre(&Job{})
+		rules[i] = r
+	}
+	// The last workflow has no script
+	for i, r := range rules[:2] {
+		s := &Step{Exec: &ExecRun{Run: &String{Value: "echo $FOO"}, RunPos: &Pos{Line: i + 1, Col: 1}}}
+		if err := r.VisitStep(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Finishing one workflow checks the scripts of the other workflow together
+	for _, r := range rules {
+		r.VisitJobPost(&Job{})
+		if err := r.VisitWorkflowPost(&Workflow{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	proc.wait()
+
+	b, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(string(b)), "\n"); len(lines) != 1 {
+		t.Fatalf("wanted 1 shellcheck process but got %d: %q", len(lines), lines)
+	}
+
+	for i, r := range rules {
+		errs := r.Errs()
+		if i == 2 {
+			if len(errs) != 0 {
+				t.Fatalf("workflow without script should have no error but got %v", errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Line != i+1 {
+			t.Fatalf("error at line %d should be reported to rule #%d but got %v", i+1, i, errs)
+		}
+	}
+}
+
+func TestRuleShellcheckBatchFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake shellcheck command is a shell script")
+	}
+
+	exe := filepath.Join(t.TempDir(), "shellcheck")
+	if err := os.WriteFile(exe, []byte("#!/bin/sh\necho oops >&2\nexit 2\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	proc := newConcurrentProcess(context.Background(), 2)
+	rules := make([]*RuleShellcheck, 2)
+	for i := range rules {
+		r, err := NewRuleShellcheck(exe, proc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.VisitWorkflowPre(&Workflow{})
+		r.VisitJobPre(&Job{})
+		s := &Step{Exec: &ExecRun{Run: &String{Value: "echo"}, RunPos: &Pos{Line: i + 1, Col: 1}}}
+		if err := r.VisitStep(s); err != nil {
+			t.Fatal(err)
+		}
+		rules[i] = r
+	}
+
+	// Both workflows fail since their scripts were checked by the same process
+	for _, r := range rules {
+		r.VisitJobPost(&Job{})
+		err := r.VisitWorkflowPost(&Workflow{})
+		if err == nil || !strings.Contains(err.Error(), "did not run successfully") {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	proc.wait()
+}
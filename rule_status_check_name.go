@@ -0,0 +1,296 @@
+package actionlint
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// maxStatusCheckNameLen is the maximum number of characters of status check names. GitHub
+	// truncates longer names of status checks.
+	maxStatusCheckNameLen = 100
+	// maxMatrixJobs is the maximum number of jobs generated by one matrix.
+	// https://docs.github.com/en/actions/using-jobs/using-a-matrix-for-your-jobs
+	maxMatrixJobs = 256
+)
+
+type statusCheck struct {
+	name string
+	job  *Job
+	pos  *Pos
+}
+
+// RuleStatusCheckName is a rule checker to detect status check names of jobs which are too long or
+// collide with each other. GitHub reports a status check named "{job name} ({matrix values})" per
+// job and truncates long names. Required status checks in branch protection rules are selected by
+// the names so jobs whose names collide after truncation cannot be distinguished silently.
+type RuleStatusCheckName struct {
+	RuleBase
+	checks []*statusCheck
+}
+
+// NewRuleStatusCheckName creates a new RuleStatusCheckName instance.
+func NewRuleStatusCheckName() *RuleStatusCheckName {
+	return &RuleStatusCheckName{
+		RuleBase: RuleBase{
+			name: "status-check-name",
+			desc: "Checks for status check names of jobs which are too long or collide with each other after truncation",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleStatusCheckName) VisitWorkflowPre(n *Workflow) error {
+	rule.checks = nil
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleStatusCheckName) VisitJobPre(n *Job) error {
+	if n.WorkflowCall != nil || n.ID == nil {
+		return nil // Status checks of reusable workflows are named after jobs in the called workflow
+	}
+
+	name, pos := n.ID.Value, n.ID.Pos
+	if n.Name != nil {
+		if n.Name.ContainsExpression() {
+			return nil
+		}
+		name, pos = n.Name.Value, n.Name.Pos
+	}
+
+	legs, ok := rule.matrixLegs(n)
+	if !ok {
+		return nil
+	}
+
+	names := []string{name}
+	if legs != nil {
+		names = make([]string, 0, len(legs))
+		for _, l := range legs {
+			names = append(names, name+" ("+l+")")
+		}
+	}
+
+	long := false
+	for _, c := range names {
+		if !long && len([]rune(c)) > maxStatusCheckNameLen {
+			rule.Errorf(
+				pos,
+				"status check name %q of job %q is %d characters long. GitHub truncates it to %d characters. make the job name or matrix values shorter",
+				c,
+				n.ID.Value,
+				len([]rune(c)),
+				maxStatusCheckNameLen,
+			)
+			long = true
+		}
+		rule.checks = append(rule.checks, &statusCheck{c, n, pos})
+	}
+
+	return nil
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RuleStatusCheckName) VisitWorkflowPost(n *Workflow) error {
+	// Jobs are visited in random order
+	sort.SliceStable(rule.checks, func(i, j int) bool {
+		return rule.checks[i].job.ID.Pos.IsBefore(rule.checks[j].job.ID.Pos)
+	})
+
+	seen := map[string]*statusCheck{}
+	reported := map[*Job]struct{}{}
+	for _, c := range rule.checks {
+		t := truncateStatusCheckName(c.name)
+		prev, ok := seen[t]
+		if !ok {
+			seen[t] = c
+			continue
+		}
+		if _, ok := reported[c.job]; ok {
+			continue // Report only the first collision per job
+		}
+		if prev.job == c.job && prev.name == c.name {
+			continue // Duplicate values in matrix are reported by "matrix" rule
+		}
+		reported[c.job] = struct{}{}
+
+		if prev.job == c.job {
+			rule.Errorf(
+				c.pos,
+				"status check names %q and %q of matrix combinations in job %q are the same after GitHub truncates them to %d characters. required status checks cannot distinguish them. make the job name or matrix values shorter",
+				prev.name,
+				c.name,
+				c.job.ID.Value,
+				maxStatusCheckNameLen,
+			)
+			continue
+		}
+
+		how := "same as"
+		if prev.name != c.name {
+			how = "same as the truncated one of"
+		}
+		rule.Errorf(
+			c.pos,
+			"status check name %q of job %q is the %s %q of job %q at line:%d. required status checks cannot distinguish the jobs. give different names to the jobs",
+			t,
+			c.job.ID.Value,
+			how,
+			prev.name,
+			prev.job.ID.Value,
+			prev.pos.Line,
+		)
+	}
+
+	rule.checks = nil
+	return nil
+}
+
+func truncateStatusCheckName(s string) string {
+	r := []rune(s)
+	if len(r) <= maxStatusCheckNameLen {
+		return s
+	}
+	return string(r[:maxStatusCheckNameLen])
+}
+
+// matrixLegs returns matrix values of the jobs generated from the matrix of the job. The values of
+// each job are joined with ", " as GitHub shows them in the status check name. When the job has no
+// matrix, it returns nil. The second return value is false when the jobs cannot be known statically.
+func (rule *RuleStatusCheckName) matrixLegs(n *Job) ([]string, bool) {
+	if n.Strategy == nil || n.Strategy.Matrix == nil {
+		return nil, true
+	}
+	m := n.Strategy.Matrix
+	if m.Expression != nil {
+		return nil, false
+	}
+
+	rows := make([]*MatrixRow, 0, len(m.Rows))
+	total := 1
+	for _, r := range m.Rows {
+		if r.Expression != nil {
+			return nil, false
+		}
+		rows = append(rows, r)
+		total *= len(r.Values)
+		if total > maxMatrixJobs*maxMatrixJobs {
+			break // Avoid overflow. The number is anyway too large
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].Name.Pos.IsBefore(rows[j].Name.Pos)
+	})
+	if len(rows) == 0 {
+		total = 0
+	}
+
+	if m.Exclude != nil && m.Exclude.ContainsExpression() || m.Include != nil && m.Include.ContainsExpression() {
+		return nil, false
+	}
+	if total > maxMatrixJobs {
+		rule.Errorf(
+			m.Pos,
+			"matrix generates %d jobs but GitHub allows up to %d jobs per matrix. split the job or reduce values in the matrix",
+			total,
+			maxMatrixJobs,
+		)
+		return nil, false
+	}
+
+	// Compute Cartesian product of the rows
+	combos := [][]RawYAMLValue{}
+	if len(rows) > 0 {
+		combos = append(combos, []RawYAMLValue{})
+	}
+	for _, r := range rows {
+		next := make([][]RawYAMLValue, 0, len(combos)*len(r.Values))
+		for _, c := range combos {
+			for _, v := range r.Values {
+				vs := make([]RawYAMLValue, 0, len(c)+1)
+				vs = append(vs, c...)
+				next = append(next, append(vs, v))
+			}
+		}
+		combos = next
+	}
+
+	matches := func(c []RawYAMLValue, assigns map[string]*MatrixAssign) bool {
+		for i, r := range rows {
+			if a, ok := assigns[strings.ToLower(r.Name.Value)]; ok && !a.Value.Equals(c[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if m.Exclude != nil {
+		filtered := combos[:0]
+	Combos:
+		for _, c := range combos {
+			for _, e := range m.Exclude.Combinations {
+				if matches(c, e.Assigns) {
+					continue Combos
+				}
+			}
+			filtered = append(filtered, c)
+		}
+		combos = filtered
+	}
+
+	legs := make([]string, 0, len(combos))
+	for _, c := range combos {
+		l, ok := joinMatrixValues(c)
+		if !ok {
+			return nil, false
+		}
+		legs = append(legs, l)
+	}
+
+	// Combinations in "include:" which cannot be merged into any existing combination are added as
+	// new jobs. Values of other combinations do not change the status check names.
+	if m.Include != nil {
+	Include:
+		for _, i := range m.Include.Combinations {
+			for _, c := range combos {
+				if matches(c, i.Assigns) {
+					continue Include
+				}
+			}
+			as := make([]*MatrixAssign, 0, len(i.Assigns))
+			for _, a := range i.Assigns {
+				as = append(as, a)
+			}
+			sort.Slice(as, func(i, j int) bool {
+				return as[i].Key.Pos.IsBefore(as[j].Key.Pos)
+			})
+			vs := make([]RawYAMLValue, 0, len(as))
+			for _, a := range as {
+				vs = append(vs, a.Value)
+			}
+			l, ok := joinMatrixValues(vs)
+			if !ok {
+				return nil, false
+			}
+			legs = append(legs, l)
+		}
+	}
+
+	if len(legs) == 0 {
+		return nil, true
+	}
+	return legs, true
+}
+
+func joinMatrixValues(vs []RawYAMLValue) (string, bool) {
+	ss := make([]string, 0, len(vs))
+	for _, v := range vs {
+		s, ok := v.(*RawYAMLString)
+		if !ok || ContainsExpression(s.Value) {
+			return "", false // Status check names of objects and arrays are not predictable
+		}
+		ss = append(ss, s.Value)
+	}
+	return strings.Join(ss, ", "), true
+}
@@ -0,0 +1,98 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRuleStatusCheckNameMatrixLegs(t *testing.T) {
+	tests := []struct {
+		what   string
+		matrix string
+		want   []string
+	}{
+		{
+			what:   "no matrix",
+			matrix: "",
+			want:   nil,
+		},
+		{
+			what:   "rows",
+			matrix: "os: [linux, mac]\nnode: [18, 20]",
+			want:   []string{"linux, 18", "linux, 20", "mac, 18", "mac, 20"},
+		},
+		{
+			what:   "exclude",
+			matrix: "os: [linux, mac]\nnode: [18, 20]\nexclude:\n  - os: mac\n    node: 18",
+			want:   []string{"linux, 18", "linux, 20", "mac, 20"},
+		},
+		{
+			what:   "include extending combinations",
+			matrix: "os: [linux, mac]\ninclude:\n  - os: linux\n    experimental: true",
+			want:   []string{"linux", "mac"},
+		},
+		{
+			what:   "include adding combination",
+			matrix: "os: [linux, mac]\ninclude:\n  - os: windows\n    experimental: true",
+			want:   []string{"linux", "mac", "windows, true"},
+		},
+		{
+			what:   "only include",
+			matrix: "include:\n  - os: linux\n  - os: mac",
+			want:   []string{"linux", "mac"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n"
+			if tc.matrix != "" {
+				src += "    strategy:\n      matrix:\n"
+				for _, l := range strings.Split(tc.matrix, "\n") {
+					src += "        " + l + "\n"
+				}
+			}
+			src += "    steps:\n      - run: echo\n"
+
+			w, errs := Parse([]byte(src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleStatusCheckName()
+			have, ok := r.matrixLegs(w.Jobs["test"])
+			if !ok {
+				t.Fatal("matrix legs could not be computed")
+			}
+			if !cmp.Equal(have, tc.want) {
+				t.Fatal(cmp.Diff(have, tc.want))
+			}
+		})
+	}
+}
+
+func TestRuleStatusCheckNameMatrixLegsUnknown(t *testing.T) {
+	tests := []struct {
+		what   string
+		matrix string
+	}{
+		{"expression", "${{ fromJSON(inputs.matrix) }}"},
+		{"row expression", "{os: '${{ fromJSON(inputs.os) }}'}"},
+		{"object value", "{os: [{name: linux}]}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    strategy:\n      matrix: " + tc.matrix + "\n    steps:\n      - run: echo\n"
+			w, errs := Parse([]byte(src))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleStatusCheckName()
+			if have, ok := r.matrixLegs(w.Jobs["test"]); ok {
+				t.Fatalf("matrix legs should be unknown but got %q", have)
+			}
+		})
+	}
+}
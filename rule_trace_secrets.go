@@ -0,0 +1,188 @@
+package actionlint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// Placeholders which refer secrets like "${{ secrets.TOKEN }}" or "${{ github.token }}". The
+	// first group is the referred secret
+	reTraceSecretsSecret = regexp.MustCompile(`(?i)\$\{\{[^}]*?\b(secrets\s*\.\s*[a-z_][a-z0-9_-]*|secrets\s*\[[^\]]*\]|github\s*\.\s*token\b)`)
+	// Commands to enable or disable xtrace like "set -x", "set -euxo pipefail", "set +x", or
+	// "if ...; then set -o xtrace"
+	reTraceSecretsSetX = regexp.MustCompile(`(?m)^\s*(?:[^#\n]*?(?:[;&|({]|\bthen|\bdo|\belse)\s*)?(set\s+(?:[-+][a-zA-Z]*x[a-zA-Z]*|[-+]o\s+xtrace))\b`)
+	// Shells run with xtrace like "bash -x ./deploy.sh" or "bash --noprofile --norc -exo pipefail {0}"
+	reTraceSecretsShellX = regexp.MustCompile(`\b(?:ba|z|k|da)?sh\s+(?:--?[a-zA-Z]+\s+)*-[a-zA-Z]*x[a-zA-Z]*\b`)
+	// PowerShell tracing like "Set-PSDebug -Trace 1"
+	reTraceSecretsPSDebug = regexp.MustCompile(`(?i)\bSet-PSDebug\s+(?:-\w+\s+)*-Trace\s+[12]\b`)
+)
+
+// traceSecretsDebugEnvs is the environment variables which enable debug logging of workflow runs.
+var traceSecretsDebugEnvs = []string{"ACTIONS_STEP_DEBUG", "ACTIONS_RUNNER_DEBUG"}
+
+// RuleTraceSecrets is a rule checker to detect steps which enable trace or debug output of scripts
+// while handling secrets. GitHub masks values of secrets in logs, but values derived from them such
+// as base64-encoded or partial values are not masked. Trace output like "set -x" prints commands
+// with such values expanded.
+type RuleTraceSecrets struct {
+	RuleBase
+	workflowEnv   *Env
+	workflowShell *String
+	jobEnv        *Env
+	jobShell      *String
+}
+
+// NewRuleTraceSecrets creates a new RuleTraceSecrets instance.
+func NewRuleTraceSecrets() *RuleTraceSecrets {
+	return &RuleTraceSecrets{
+		RuleBase: RuleBase{
+			name: "trace-secrets",
+			desc: "Checks for steps enabling trace or debug output such as \"set -x\" while handling secrets",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleTraceSecrets) VisitWorkflowPre(n *Workflow) error {
+	rule.workflowEnv = n.Env
+	rule.workflowShell = nil
+	if n.Defaults != nil && n.Defaults.Run != nil {
+		rule.workflowShell = n.Defaults.Run.Shell
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleTraceSecrets) VisitJobPre(n *Job) error {
+	rule.jobEnv = n.Env
+	rule.jobShell = rule.workflowShell
+	if n.Defaults != nil && n.Defaults.Run != nil && n.Defaults.Run.Shell != nil {
+		rule.jobShell = n.Defaults.Run.Shell
+	}
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleTraceSecrets) VisitStep(n *Step) error {
+	e, ok := n.Exec.(*ExecRun)
+	if !ok || e.Run == nil {
+		return nil
+	}
+
+	secret := traceSecretsSecretIn(e.Run.Value)
+	for _, env := range []*Env{n.Env, rule.jobEnv, rule.workflowEnv} {
+		if secret != "" {
+			break
+		}
+		secret = traceSecretsSecretInEnv(env)
+	}
+	if secret == "" {
+		return nil
+	}
+
+	pos, what := rule.trace(n, e)
+	if pos == nil {
+		return nil
+	}
+	rule.Errorf(
+		pos,
+		"%s while this step handles secrets via %q. values derived from the secrets such as base64-encoded or partial values are shown in the log without masking. disable it while handling secrets",
+		what,
+		secret,
+	)
+	return nil
+}
+
+// trace returns the position and the description of what enables trace or debug output of the
+// step. It returns nil position when nothing enables it.
+func (rule *RuleTraceSecrets) trace(n *Step, e *ExecRun) (*Pos, string) {
+	if e.Shell != nil && reTraceSecretsShellX.MatchString(e.Shell.Value) {
+		return e.Shell.Pos, fmt.Sprintf("shell %q enables tracing commands", e.Shell.Value)
+	}
+	if e.Shell == nil && rule.jobShell != nil && reTraceSecretsShellX.MatchString(rule.jobShell.Value) {
+		return e.Run.Pos, fmt.Sprintf("default shell %q enables tracing commands", rule.jobShell.Value)
+	}
+
+	src := e.Run.Value
+	if strings.Contains(src, "set") {
+		// Trace disabled by "set +x" after enabling it is OK since secrets are usually handled
+		// after disabling the trace
+		set := ""
+		for _, m := range reTraceSecretsSetX.FindAllStringSubmatch(src, -1) {
+			if strings.Contains(m[1], "-") {
+				set = m[1]
+			} else {
+				set = ""
+			}
+		}
+		if set != "" {
+			return e.Run.Pos, fmt.Sprintf("%q in the script enables tracing commands", set)
+		}
+	}
+	if m := reTraceSecretsShellX.FindString(src); m != "" {
+		return e.Run.Pos, fmt.Sprintf("%q in the script enables tracing commands", m)
+	}
+	if strings.Contains(strings.ToLower(src), "set-psdebug") {
+		if m := reTraceSecretsPSDebug.FindString(src); m != "" {
+			return e.Run.Pos, fmt.Sprintf("%q in the script enables tracing commands", m)
+		}
+	}
+
+	if v := traceSecretsDebugEnv(n.Env); v != nil {
+		return v.Name.Pos, fmt.Sprintf("env var %q enables debug logging", v.Name.Value)
+	}
+	for _, env := range []*Env{rule.jobEnv, rule.workflowEnv} {
+		if v := traceSecretsDebugEnv(env); v != nil {
+			return e.Run.Pos, fmt.Sprintf("env var %q at line %d enables debug logging", v.Name.Value, v.Name.Pos.Line)
+		}
+	}
+
+	return nil, ""
+}
+
+func traceSecretsSecretIn(s string) string {
+	if !strings.Contains(s, "${{") {
+		return ""
+	}
+	if m := reTraceSecretsSecret.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+func traceSecretsSecretInEnv(env *Env) string {
+	if env == nil {
+		return ""
+	}
+	if env.Expression != nil {
+		return traceSecretsSecretIn(env.Expression.Value)
+	}
+	names := make([]string, 0, len(env.Vars))
+	for n := range env.Vars {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if v := env.Vars[n].Value; v != nil {
+			if s := traceSecretsSecretIn(v.Value); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+func traceSecretsDebugEnv(env *Env) *EnvVar {
+	if env == nil {
+		return nil
+	}
+	for _, n := range traceSecretsDebugEnvs {
+		if v, ok := env.Vars[strings.ToLower(n)]; ok && v.Value != nil && strings.EqualFold(v.Value.Value, "true") {
+			return v
+		}
+	}
+	return nil
+}
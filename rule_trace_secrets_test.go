@@ -0,0 +1,74 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleTraceSecretsScript(t *testing.T) {
+	tests := []struct {
+		script string
+		want   string
+	}{
+		{"set -x\n./deploy.sh", `"set -x" in the script`},
+		{"set -euxo pipefail\n./deploy.sh", `"set -euxo" in the script`},
+		{"set -o xtrace", `"set -o xtrace" in the script`},
+		{"if [ -n \"$DEBUG\" ]; then set -x; fi", `"set -x" in the script`},
+		{"set -x\nmake\nset +x\n./deploy.sh\nset -x", `"set -x" in the script`},
+		{"bash -x ./deploy.sh", `"bash -x" in the script`},
+		{"sh -ex ./deploy.sh", `"sh -ex" in the script`},
+		{"Set-PSDebug -Trace 2\n./deploy.ps1", `"Set-PSDebug -Trace 2" in the script`},
+		{"set-psdebug -strict -trace 1", `"set-psdebug -strict -trace 1" in the script`},
+		{"set -x\nmake\nset +x\n./deploy.sh", ""},
+		{"set -o xtrace\nmake\nset +o xtrace", ""},
+		{"set -e\n./deploy.sh", ""},
+		{"# set -x\n./deploy.sh", ""},
+		{"echo \"set -x\"", ""},
+		{"ssh -x deploy@example.com", ""},
+		{"bash ./deploy.sh", ""},
+		{"Set-PSDebug -Trace 0", ""},
+		{"Set-PSDebug -Off", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.script, func(t *testing.T) {
+			rule := NewRuleTraceSecrets()
+			e := &ExecRun{Run: &String{Value: tc.script, Pos: &Pos{}}}
+			pos, have := rule.trace(&Step{Exec: e}, e)
+			if tc.want == "" {
+				if pos != nil {
+					t.Fatalf("wanted no trace but got %q", have)
+				}
+				return
+			}
+			if want := tc.want + " enables tracing commands"; have != want {
+				t.Fatalf("wanted %q but got %q", want, have)
+			}
+		})
+	}
+}
+
+func TestRuleTraceSecretsSecretIn(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"${{ secrets.TOKEN }}", "secrets.TOKEN"},
+		{"Bearer ${{ secrets.api_token }}", "secrets.api_token"},
+		{"${{ secrets['TOKEN'] }}", "secrets['TOKEN']"},
+		{"${{ github.token }}", "github.token"},
+		{"${{ inputs.name || secrets.FALLBACK }}", "secrets.FALLBACK"},
+		{"${{ GITHUB.TOKEN }}", "GITHUB.TOKEN"},
+		{"secrets.TOKEN", ""},
+		{"${{ github.token_url }}", ""},
+		{"${{ inputs.secrets }}", ""},
+		{"${{ github.repository }}", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			if have := traceSecretsSecretIn(tc.input); have != tc.want {
+				t.Fatalf("wanted %q but got %q", tc.want, have)
+			}
+		})
+	}
+}
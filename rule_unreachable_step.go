@@ -0,0 +1,289 @@
+package actionlint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Scripts which always fail with "exit" command. Only commands to output messages like "echo" are
+// allowed before the "exit" command so that the scripts can be analyzed statically.
+var (
+	reUnreachableStepExit    = regexp.MustCompile(`^exit\s+([0-9]+)\s*;?$`)
+	reUnreachableStepMessage = regexp.MustCompile(`^(?:echo|printf|Write-Host|Write-Output)(?:\s[^|&;<>` + "`" + `$()]*)?$`)
+)
+
+// RuleUnreachableStep is a rule checker to detect steps which are never executed. A step is never
+// executed when a previous step in the same job always fails, or when its "if:" condition
+// contradicts the job's "if:" condition.
+type RuleUnreachableStep struct {
+	RuleBase
+	shell string // Shell name set by workflow-level "defaults:"
+}
+
+// NewRuleUnreachableStep creates a new RuleUnreachableStep instance.
+func NewRuleUnreachableStep() *RuleUnreachableStep {
+	return &RuleUnreachableStep{
+		RuleBase: RuleBase{
+			name: "unreachable-step",
+			desc: "Checks for steps which are never executed because of previous steps always failing or conditions contradicting the job's condition",
+		},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleUnreachableStep) VisitWorkflowPre(n *Workflow) error {
+	rule.shell = defaultShellOf(n.Defaults)
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleUnreachableStep) VisitJobPre(n *Job) error {
+	shell := rule.shell
+	if s := defaultShellOf(n.Defaults); s != "" {
+		shell = s
+	}
+
+	job := newCondConstraints(n.If)
+	var failing *Step
+	var exit string
+	reported := false
+	for _, s := range n.Steps {
+		if failing != nil && !reported && !hasStatusCheckFunc(s.If) {
+			rule.Errorf(
+				s.Pos,
+				"this step is never executed because the previous step at line %d always fails with %q. add \"if: failure()\" or \"if: always()\" to run this step after the failure, or remove the unreachable steps",
+				failing.Pos.Line,
+				exit,
+			)
+			reported = true
+		}
+
+		if job != nil {
+			if c := newCondConstraints(s.If); c != nil {
+				if j, t, ok := job.contradiction(c); ok {
+					rule.Errorf(
+						s.If.Pos,
+						"this step is never executed because its condition %q contradicts %q in the job's condition %q",
+						t,
+						j,
+						n.If.Value,
+					)
+				}
+			}
+		}
+
+		if failing == nil {
+			if e, ok := alwaysFailingStep(s, shell); ok {
+				failing, exit = s, e
+			}
+		}
+	}
+	return nil
+}
+
+func defaultShellOf(d *Defaults) string {
+	if d == nil || d.Run == nil || d.Run.Shell == nil {
+		return ""
+	}
+	return d.Run.Shell.Value
+}
+
+// alwaysFailingStep returns the command which makes the step always fail. The step must run
+// unconditionally and must not continue on error.
+func alwaysFailingStep(s *Step, shell string) (string, bool) {
+	if s.If != nil || s.ContinueOnError != nil && (s.ContinueOnError.Value || s.ContinueOnError.Expression != nil) {
+		return "", false
+	}
+	e, ok := s.Exec.(*ExecRun)
+	if !ok || e.Run == nil || e.Run.ContainsExpression() {
+		return "", false
+	}
+	if e.Shell != nil {
+		shell = e.Shell.Value
+	}
+	// Shells other than these may not handle "exit" command. Empty means the default shell, which
+	// is bash or pwsh
+	switch name, _, _ := strings.Cut(strings.TrimSpace(shell), " "); name {
+	case "", "bash", "sh", "pwsh", "powershell":
+	default:
+		return "", false
+	}
+
+	for _, l := range strings.Split(e.Run.Value, "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		if m := reUnreachableStepExit.FindStringSubmatch(l); m != nil {
+			if strings.TrimLeft(m[1], "0") == "" {
+				return "", false // exit 0
+			}
+			return l, true
+		}
+		if !reUnreachableStepMessage.MatchString(l) {
+			return "", false
+		}
+	}
+	return "", false
+}
+
+func hasStatusCheckFunc(cond *String) bool {
+	if cond == nil {
+		return false
+	}
+	found := false
+	if e := parseIfCond(cond); e != nil {
+		VisitExprNode(e, func(n, _ ExprNode, entering bool) {
+			if f, ok := n.(*FuncCallNode); entering && ok {
+				switch strings.ToLower(f.Callee) {
+				case "always", "failure", "cancelled":
+					found = true
+				}
+			}
+		})
+		return found
+	}
+	// When the condition cannot be analyzed, assume it contains some status check function
+	return true
+}
+
+// parseIfCond parses the expression of "if:" condition. "${{ }}" can be omitted at "if:". It returns
+// nil when the condition is not a single expression or has a syntax error.
+func parseIfCond(cond *String) ExprNode {
+	src := strings.TrimSpace(cond.Value)
+	if cond.IsExpressionAssigned() {
+		src = src[3 : len(src)-2]
+	} else if cond.ContainsExpression() {
+		return nil
+	}
+	e, err := NewExprParser().Parse(NewExprLexer(src + "}}"))
+	if err != nil {
+		return nil // Syntax error is reported by "expression" rule
+	}
+	return e
+}
+
+// condConstraints is a set of constraints which must be satisfied when the "if:" condition is true.
+// It is collected from the comparisons between properties and string literals joined with &&
+// operators like `github.event_name == 'push' && github.ref != 'refs/heads/main'`. Keys are the
+// property paths. Strings are compared case-insensitively as GitHub Actions does.
+type condConstraints struct {
+	eq  map[string]string
+	neq map[string][]string
+}
+
+func newCondConstraints(cond *String) *condConstraints {
+	if cond == nil {
+		return nil
+	}
+	e := parseIfCond(cond)
+	if e == nil {
+		return nil
+	}
+	c := &condConstraints{map[string]string{}, map[string][]string{}}
+	c.collect(e)
+	if len(c.eq) == 0 && len(c.neq) == 0 {
+		return nil
+	}
+	return c
+}
+
+func (c *condConstraints) collect(n ExprNode) {
+	switch n := n.(type) {
+	case *LogicalOpNode:
+		if n.Kind == LogicalOpNodeKindAnd {
+			c.collect(n.Left)
+			c.collect(n.Right)
+		}
+	case *CompareOpNode:
+		if n.Kind != CompareOpNodeKindEq && n.Kind != CompareOpNodeKindNotEq {
+			return
+		}
+		l, r := n.Left, n.Right
+		if _, ok := l.(*StringNode); ok {
+			l, r = r, l
+		}
+		s, ok := r.(*StringNode)
+		if !ok {
+			return
+		}
+		k, ok := condPropertyPath(l)
+		if !ok {
+			return
+		}
+		if n.Kind == CompareOpNodeKindEq {
+			c.eq[k] = s.Value
+		} else {
+			c.neq[k] = append(c.neq[k], s.Value)
+		}
+	}
+}
+
+// contradiction returns the comparison in c and the comparison in other which cannot be true at the
+// same time.
+func (c *condConstraints) contradiction(other *condConstraints) (string, string, bool) {
+	keys := make([]string, 0, len(c.eq)+len(other.eq))
+	for k := range c.eq {
+		keys = append(keys, k)
+	}
+	for k := range other.eq {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if v, ok := c.eq[k]; ok {
+			if w, ok := other.eq[k]; ok && !strings.EqualFold(v, w) {
+				return fmt.Sprintf("%s == '%s'", k, v), fmt.Sprintf("%s == '%s'", k, w), true
+			}
+			for _, w := range other.neq[k] {
+				if strings.EqualFold(v, w) {
+					return fmt.Sprintf("%s == '%s'", k, v), fmt.Sprintf("%s != '%s'", k, w), true
+				}
+			}
+		}
+		if v, ok := other.eq[k]; ok {
+			for _, w := range c.neq[k] {
+				if strings.EqualFold(v, w) {
+					return fmt.Sprintf("%s != '%s'", k, w), fmt.Sprintf("%s == '%s'", k, v), true
+				}
+			}
+		}
+	}
+	return "", "", false
+}
+
+// condPropertyPath returns the property path like "github.event_name" of the expression. Only
+// contexts whose values don't change while running a job are allowed so that the conditions of the
+// job and its steps can be compared.
+func condPropertyPath(n ExprNode) (string, bool) {
+	switch n := n.(type) {
+	case *VariableNode:
+		switch v := strings.ToLower(n.Name); v {
+		case "github", "inputs", "needs", "vars":
+			return v, true
+		default:
+			return "", false
+		}
+	case *ObjectDerefNode:
+		r, ok := condPropertyPath(n.Receiver)
+		if !ok {
+			return "", false
+		}
+		return r + "." + strings.ToLower(n.Property), true
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok {
+			return "", false
+		}
+		r, ok := condPropertyPath(n.Operand)
+		if !ok {
+			return "", false
+		}
+		return r + "." + strings.ToLower(s.Value), true
+	default:
+		return "", false
+	}
+}
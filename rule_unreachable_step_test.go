@@ -0,0 +1,78 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleUnreachableStepAlwaysFailingScript(t *testing.T) {
+	tests := []struct {
+		script string
+		shell  string
+		want   string
+	}{
+		{"exit 1", "", "exit 1"},
+		{"exit 2;", "bash", "exit 2;"},
+		{"echo '::error::oops'\n\n# Stop here\nexit 1\necho unreachable", "", "exit 1"},
+		{"Write-Host 'oops'\nexit 1", "pwsh", "exit 1"},
+		{"printf 'oops\\n'\nexit 1", "sh -e {0}", "exit 1"},
+		{"exit 0", "", ""},
+		{"exit 00", "", ""},
+		{"exit", "", ""},
+		{"exit $CODE", "", ""},
+		{"echo hello", "", ""},
+		{"make\nexit 1", "", ""},
+		{"echo $(make)\nexit 1", "", ""},
+		{"echo hello | tee out.txt\nexit 1", "", ""},
+		{"exit 1", "python", ""},
+		{"exit 1", "cmd", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.script, func(t *testing.T) {
+			s := &Step{
+				Exec: &ExecRun{
+					Run: &String{Value: tc.script},
+				},
+			}
+			have, ok := alwaysFailingStep(s, tc.shell)
+			if ok != (tc.want != "") || have != tc.want {
+				t.Fatalf("wanted %q but got %q (ok=%v)", tc.want, have, ok)
+			}
+		})
+	}
+}
+
+func TestRuleUnreachableStepContradiction(t *testing.T) {
+	tests := []struct {
+		job  string
+		step string
+		ok   bool
+	}{
+		{"github.event_name == 'push'", "github.event_name == 'pull_request'", true},
+		{"github.event_name == 'push'", "${{ 'pull_request' == github.event_name }}", true},
+		{"github.event_name == 'push'", "github.event_name != 'Push'", true},
+		{"github.event_name != 'push'", "github.event_name == 'push'", true},
+		{"${{ always() && inputs.target == 'prod' }}", "success() && inputs.target == 'dev'", true},
+		{"github.event['action'] == 'opened'", "github.event.action == 'closed'", true},
+		{"github.event_name == 'push'", "github.event_name == 'PUSH'", false},
+		{"github.event_name == 'push'", "github.event_name != 'pull_request'", false},
+		{"github.event_name != 'push'", "github.event_name != 'pull_request'", false},
+		{"github.event_name == 'push' || github.event_name == 'pull_request'", "github.event_name == 'pull_request'", false},
+		{"github.event_name == 'push'", "!(github.event_name == 'pull_request')", false},
+		{"env.FOO == 'a'", "env.FOO == 'b'", false},
+		{"github.event_name == 'push'", "steps.foo.outputs.bar == 'baz'", false},
+		{"github.event_name == 'push'", "github.event_name == 'pull_request' ${{ true }}", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.job+" vs "+tc.step, func(t *testing.T) {
+			ok := false
+			if j, s := newCondConstraints(&String{Value: tc.job}), newCondConstraints(&String{Value: tc.step}); j != nil && s != nil {
+				_, _, ok = j.contradiction(s)
+			}
+			if ok != tc.ok {
+				t.Fatalf("wanted %v but got %v", tc.ok, ok)
+			}
+		})
+	}
+}
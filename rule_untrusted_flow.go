@@ -0,0 +1,314 @@
+package actionlint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Lines in "run:" scripts which write outputs or environment variables like
+// `echo "title=$TITLE" >> "$GITHUB_OUTPUT"`.
+var reUntrustedFlowWrite = regexp.MustCompile(`^\s*(?:echo|printf)\s+(?:-[a-zA-Z]+\s+)*["']?([A-Za-z_][A-Za-z0-9_-]*)=(.*)>>\s*["']?\$\{?(GITHUB_OUTPUT|GITHUB_ENV)\b`)
+
+// Shell variable references like $FOO or ${FOO}.
+var reUntrustedFlowShellVar = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*)`)
+
+// untrustedFlow is a flow of a potentially untrusted input. The chain starts with the untrusted
+// input like "github.event.issue.title" and ends with the value where the input flows into like
+// "env.TITLE".
+type untrustedFlow struct {
+	chain []string
+}
+
+func (f *untrustedFlow) to(name string) *untrustedFlow {
+	c := make([]string, 0, len(f.chain)+1)
+	c = append(c, f.chain...)
+	return &untrustedFlow{append(c, name)}
+}
+
+func (f *untrustedFlow) String() string {
+	qs := make([]string, 0, len(f.chain))
+	for _, c := range f.chain {
+		qs = append(qs, fmt.Sprintf("%q", c))
+	}
+	return strings.Join(qs, " -> ")
+}
+
+// untrustedFlowScope is a map from the lower-case property paths like "env.title" and
+// "steps.meta.outputs.title" to the flows of untrusted inputs into the properties.
+type untrustedFlowScope map[string]*untrustedFlow
+
+func (s untrustedFlowScope) copy() untrustedFlowScope {
+	c := make(untrustedFlowScope, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+// RuleUntrustedFlow is a rule checker to detect potentially untrusted inputs which indirectly flow
+// into inline scripts. The "expression" rule reports untrusted inputs used directly in scripts. This
+// rule tracks the inputs through environment variables at "env:", step outputs, and job outputs
+// across "needs:", and reports the values derived from them when they are used in `run:` scripts or
+// scripts of actions/github-script.
+type RuleUntrustedFlow struct {
+	RuleBase
+}
+
+// NewRuleUntrustedFlow creates a new RuleUntrustedFlow instance.
+func NewRuleUntrustedFlow() *RuleUntrustedFlow {
+	return &RuleUntrustedFlow{
+		RuleBase: RuleBase{
+			name: "untrusted-flow",
+			desc: "Checks for potentially untrusted inputs flowing into inline scripts through environment variables, step outputs, and job outputs",
+		},
+	}
+}
+
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children.
+func (rule *RuleUntrustedFlow) VisitWorkflowPost(n *Workflow) error {
+	wenv := untrustedFlowScope{}
+	rule.assignEnv(wenv, n.Env)
+
+	ids := make([]string, 0, len(n.Jobs))
+	for id := range n.Jobs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	// Job outputs flow into downstream jobs. Repeat analyzing jobs until all flows are resolved since
+	// jobs are not sorted in the order of dependencies.
+	outputs := map[string]untrustedFlowScope{}
+	for i := 0; i <= len(ids); i++ {
+		changed := false
+		for _, id := range ids {
+			o := rule.analyzeJob(n.Jobs[id], wenv, outputs, false)
+			if len(o) != len(outputs[id]) {
+				outputs[id] = o
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	for _, id := range ids {
+		rule.analyzeJob(n.Jobs[id], wenv, outputs, true)
+	}
+	return nil
+}
+
+// analyzeJob tracks the flows of untrusted inputs in the job and returns the outputs of the job into
+// which untrusted inputs flow. When report is true, the flows into scripts are reported.
+func (rule *RuleUntrustedFlow) analyzeJob(j *Job, wenv untrustedFlowScope, outputs map[string]untrustedFlowScope, report bool) untrustedFlowScope {
+	scope := wenv.copy()
+	for _, need := range j.Needs {
+		id := strings.ToLower(need.Value)
+		for name, f := range outputs[id] {
+			scope["needs."+id+".outputs."+name] = f.to(fmt.Sprintf("needs.%s.outputs.%s", need.Value, name))
+		}
+	}
+	rule.assignEnv(scope, j.Env)
+
+	for _, s := range j.Steps {
+		step := scope.copy()
+		rule.assignEnv(step, s.Env)
+
+		switch e := s.Exec.(type) {
+		case *ExecRun:
+			if report {
+				rule.checkScript(e.Run, step)
+			}
+			rule.trackWrites(e.Run, s.ID, step, scope)
+		case *ExecAction:
+			if report && e.Uses != nil && strings.HasPrefix(e.Uses.Value, "actions/github-script@") {
+				if i, ok := e.Inputs["script"]; ok {
+					rule.checkScript(i.Value, step)
+				}
+			}
+		}
+	}
+
+	ret := untrustedFlowScope{}
+	for name, o := range j.Outputs {
+		if f := rule.flowIn(o.Value, scope); f != nil {
+			ret[strings.ToLower(name)] = f.to(fmt.Sprintf("jobs.%s.outputs.%s", j.ID.Value, o.Name.Value))
+		}
+	}
+	return ret
+}
+
+func (rule *RuleUntrustedFlow) assignEnv(scope untrustedFlowScope, env *Env) {
+	if env == nil {
+		return
+	}
+	for _, v := range env.Vars {
+		k := "env." + strings.ToLower(v.Name.Value)
+		if f := rule.flowIn(v.Value, scope); f != nil {
+			scope[k] = f.to("env." + v.Name.Value)
+		} else {
+			delete(scope, k) // The variable is overwritten with a trusted value
+		}
+	}
+}
+
+// trackWrites tracks the untrusted inputs written to $GITHUB_OUTPUT or $GITHUB_ENV in the script.
+// Outputs are stored in the job scope so that they can be referred by the following steps and job
+// outputs. Environment variables are also stored in the job scope since they are set for the
+// following steps.
+func (rule *RuleUntrustedFlow) trackWrites(script *String, id *String, step, job untrustedFlowScope) {
+	if script == nil {
+		return
+	}
+	for _, l := range strings.Split(script.Value, "\n") {
+		m := reUntrustedFlowWrite.FindStringSubmatch(l)
+		if m == nil {
+			continue
+		}
+		name, value, file := m[1], m[2], m[3]
+		f := rule.flowInScript(value, step)
+		if f == nil {
+			continue
+		}
+		if file == "GITHUB_ENV" {
+			job["env."+strings.ToLower(name)] = f.to("env." + name)
+		} else if id != nil && !id.ContainsExpression() {
+			job["steps."+strings.ToLower(id.Value)+".outputs."+strings.ToLower(name)] = f.to(fmt.Sprintf("steps.%s.outputs.%s", id.Value, name))
+		}
+	}
+}
+
+// flowInScript returns the flow of untrusted input in the part of a script. The untrusted input is
+// used via ${{ }} placeholders or environment variables.
+func (rule *RuleUntrustedFlow) flowInScript(src string, scope untrustedFlowScope) *untrustedFlow {
+	if f := rule.flowIn(&String{Value: src}, scope); f != nil {
+		return f
+	}
+	for _, m := range reUntrustedFlowShellVar.FindAllStringSubmatch(src, -1) {
+		if f, ok := scope["env."+strings.ToLower(m[1])]; ok {
+			return f
+		}
+	}
+	return nil
+}
+
+// flowIn returns the first flow of untrusted input found in the ${{ }} placeholders of the string.
+func (rule *RuleUntrustedFlow) flowIn(s *String, scope untrustedFlowScope) *untrustedFlow {
+	if s == nil {
+		return nil
+	}
+	var ret *untrustedFlow
+	rule.visitExprs(s, func(expr ExprNode, _, _ int) bool {
+		if is := untrustedInputsIn(expr); len(is) > 0 {
+			ret = &untrustedFlow{[]string{is[0]}}
+			return false
+		}
+		VisitExprNode(expr, func(n, _ ExprNode, entering bool) {
+			if entering && ret == nil {
+				if p, ok := untrustedFlowPropertyPath(n); ok {
+					ret = scope[p]
+				}
+			}
+		})
+		return ret == nil
+	})
+	return ret
+}
+
+// checkScript reports the values derived from untrusted inputs used in the script. Untrusted inputs
+// used directly are reported by "expression" rule.
+func (rule *RuleUntrustedFlow) checkScript(s *String, scope untrustedFlowScope) {
+	if s == nil || len(scope) == 0 {
+		return
+	}
+	rule.visitExprs(s, func(expr ExprNode, line, col int) bool {
+		VisitExprNode(expr, func(n, parent ExprNode, entering bool) {
+			if !entering {
+				return
+			}
+			p, ok := untrustedFlowPropertyPath(n)
+			if !ok {
+				return
+			}
+			f, ok := scope[p]
+			if !ok {
+				return
+			}
+			if pp, ok := untrustedFlowPropertyPath(parent); ok && scope[pp] != nil {
+				return // Already reported at the parent
+			}
+			t := n.Token()
+			rule.Errorf(
+				convertExprLineColToPos(t.Line, t.Column, line, col),
+				"%q is potentially untrusted because the value flows from untrusted input: %s. avoid using it directly in inline scripts. instead, pass it through an environment variable. see https://docs.github.com/en/actions/security-guides/security-hardening-for-github-actions for more details",
+				f.chain[len(f.chain)-1],
+				f,
+			)
+		})
+		return true
+	})
+}
+
+// visitExprs parses the ${{ }} placeholders in the string and calls the callback with the parsed
+// expressions and the base positions of the placeholders. Visiting is stopped when the callback
+// returns false. Placeholders which have syntax errors are skipped.
+func (rule *RuleUntrustedFlow) visitExprs(s *String, f func(expr ExprNode, line, col int) bool) {
+	src := s.Value
+	line, col := 0, 0
+	if s.Pos != nil {
+		line, col = s.Pos.Line, s.Pos.Col
+		if s.Quoted {
+			col++
+		}
+	}
+	offset := 0
+	for {
+		idx := strings.Index(src, "${{")
+		if idx == -1 {
+			return
+		}
+		start := idx + 3 // 3 means removing "${{"
+		src = src[start:]
+		offset += start
+
+		l := NewExprLexer(src)
+		expr, err := NewExprParser().Parse(l)
+		if err != nil {
+			return // Syntax error is reported by "expression" rule
+		}
+		if !f(expr, line, col+offset) {
+			return
+		}
+		src = src[l.Offset():]
+		offset += l.Offset()
+	}
+}
+
+// untrustedFlowPropertyPath returns the lower-case property path of the expression like
+// "env.title" or "steps.meta.outputs.title".
+func untrustedFlowPropertyPath(n ExprNode) (string, bool) {
+	switch n := n.(type) {
+	case *VariableNode:
+		return strings.ToLower(n.Name), true
+	case *ObjectDerefNode:
+		r, ok := untrustedFlowPropertyPath(n.Receiver)
+		if !ok {
+			return "", false
+		}
+		return r + "." + strings.ToLower(n.Property), true
+	case *IndexAccessNode:
+		s, ok := n.Index.(*StringNode)
+		if !ok {
+			return "", false
+		}
+		r, ok := untrustedFlowPropertyPath(n.Operand)
+		if !ok {
+			return "", false
+		}
+		return r + "." + strings.ToLower(s.Value), true
+	default:
+		return "", false
+	}
+}
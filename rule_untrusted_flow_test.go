@@ -0,0 +1,79 @@
+package actionlint
+
+import (
+	"testing"
+)
+
+func TestRuleUntrustedFlowPropertyPath(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"env.TITLE", "env.title"},
+		{"env['TITLE']", "env.title"},
+		{"steps.meta.outputs['Title']", "steps.meta.outputs.title"},
+		{"needs.a.outputs.b", "needs.a.outputs.b"},
+		{"env[matrix.name]", ""},
+		{"fromJSON(env.TITLE).foo", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			e, err := NewExprParser().Parse(NewExprLexer(tc.input + "}}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			have, ok := untrustedFlowPropertyPath(e)
+			if ok != (tc.want != "") || have != tc.want {
+				t.Fatalf("wanted %q but got %q (ok=%v)", tc.want, have, ok)
+			}
+		})
+	}
+}
+
+func TestRuleUntrustedFlowAcrossJobs(t *testing.T) {
+	// Jobs are declared in the reverse order of their dependencies
+	src := `on: issues
+jobs:
+  a:
+    needs: [b]
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo '${{ needs.b.outputs.title }}'
+  b:
+    needs: [c]
+    runs-on: ubuntu-latest
+    outputs:
+      title: ${{ needs.c.outputs.title }}
+    steps:
+      - run: echo
+  c:
+    runs-on: ubuntu-latest
+    outputs:
+      title: ${{ github.event.issue.title }}
+    steps:
+      - run: echo
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	r := NewRuleUntrustedFlow()
+	v := NewVisitor()
+	v.AddPass(r)
+	if err := v.Visit(w); err != nil {
+		t.Fatal(err)
+	}
+
+	errs = r.Errs()
+	if len(errs) != 1 {
+		t.Fatalf("wanted 1 error but got %d: %v", len(errs), errs)
+	}
+	want := `"needs.b.outputs.title" is potentially untrusted because the value flows from untrusted input: "github.event.issue.title" -> "jobs.c.outputs.title" -> "needs.c.outputs.title" -> "jobs.b.outputs.title" -> "needs.b.outputs.title". avoid using it directly in inline scripts. instead, pass it through an environment variable. see https://docs.github.com/en/actions/security-guides/security-hardening-for-github-actions for more details`
+	if errs[0].Message != want {
+		t.Fatalf("wanted %q but got %q", want, errs[0].Message)
+	}
+	if errs[0].Line != 7 || errs[0].Column != 24 {
+		t.Fatalf("unexpected position %d:%d", errs[0].Line, errs[0].Column)
+	}
+}
@@ -0,0 +1,174 @@
+package actionlint
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	// Lines writing to $GITHUB_PATH or $GITHUB_ENV like `echo "$HOME/bin" >> "$GITHUB_PATH"`
+	reWindowsBashFileWrite = regexp.MustCompile(`>>\s*["']?\$\{?(GITHUB_PATH|GITHUB_ENV)\b`)
+	// Paths in POSIX style which are translated only inside Git Bash
+	reWindowsBashPOSIXPath = regexp.MustCompile("(?:^|[\\s\"'=:])(~|\\$\\{?HOME\\b|\\$\\{?PWD\\b|\\$\\(pwd\\)|`pwd`|/tmp\\b)")
+	// "/tmp" directory used in the script like `cp out.txt /tmp/` or `TMPDIR=/tmp`
+	reWindowsBashTmp = regexp.MustCompile(`(?:^|[\s"'=:(])/tmp(?:/|[\s"';:)]|$)`)
+	// Home directory "~" used in the script like `cp out.txt ~/` or `CACHE=~/.cache`. "=~" operator
+	// of `[[ ]]` is not matched
+	reWindowsBashTilde = regexp.MustCompile(`(?:^|[\s"':(])~(?:/|[\s"';:)]|$)|=~/`)
+)
+
+// RuleWindowsBash is a rule checker to detect "bash" scripts on Windows runners which rely on the
+// behavior of bash on Linux and macOS. "bash" on Windows runners is Git Bash, which emulates POSIX
+// paths only inside it. Paths like "/tmp" and "$HOME" are not understood by other shells, actions,
+// and the runner, and a login shell reorders $PATH by Git Bash's profile.
+// https://docs.github.com/en/actions/using-workflows/workflow-syntax-for-github-actions#jobsjob_idstepsshell
+type RuleWindowsBash struct {
+	RuleBase
+	workflowShell *String
+	jobShell      *String
+	windows       bool
+	reported      map[*String]struct{}
+}
+
+// NewRuleWindowsBash creates a new RuleWindowsBash instance.
+func NewRuleWindowsBash() *RuleWindowsBash {
+	return &RuleWindowsBash{
+		RuleBase: RuleBase{
+			name: "windows-bash",
+			desc: "Checks for \"bash\" scripts on Windows runners relying on POSIX paths such as \"/tmp\" and \"~\", and login shell behavior",
+		},
+		reported: map[*String]struct{}{},
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleWindowsBash) VisitWorkflowPre(n *Workflow) error {
+	if n.Defaults != nil && n.Defaults.Run != nil {
+		rule.workflowShell = n.Defaults.Run.Shell
+	}
+	return nil
+}
+
+// VisitJobPre is callback when visiting Job node before visiting its children.
+func (rule *RuleWindowsBash) VisitJobPre(n *Job) error {
+	rule.windows = getPlatformFromRunner(n.RunsOn) == platformKindWindows || runsOnWindowsInMatrix(n)
+	if n.Defaults != nil && n.Defaults.Run != nil {
+		rule.jobShell = n.Defaults.Run.Shell
+	}
+	return nil
+}
+
+// VisitJobPost is callback when visiting Job node after visiting its children.
+func (rule *RuleWindowsBash) VisitJobPost(n *Job) error {
+	rule.windows = false
+	rule.jobShell = nil
+	return nil
+}
+
+// VisitStep is callback when visiting Step node.
+func (rule *RuleWindowsBash) VisitStep(n *Step) error {
+	if !rule.windows {
+		return nil
+	}
+	e, ok := n.Exec.(*ExecRun)
+	if !ok || e.Run == nil {
+		return nil
+	}
+
+	shell := e.Shell
+	if shell == nil {
+		shell = rule.jobShell
+	}
+	if shell == nil {
+		shell = rule.workflowShell
+	}
+	// Default shell on Windows is pwsh
+	if shell == nil || shell.ContainsExpression() {
+		return nil
+	}
+	args := strings.Fields(shell.Value)
+	if len(args) == 0 || strings.TrimSuffix(strings.ToLower(path.Base(strings.ReplaceAll(args[0], `\`, "/"))), ".exe") != "bash" {
+		return nil
+	}
+
+	if _, ok := rule.reported[shell]; !ok && isLoginShellArgs(args[1:]) {
+		rule.reported[shell] = struct{}{}
+		rule.Errorf(
+			shell.Pos,
+			"login shell %q on Windows runner runs /etc/profile of Git Bash, which puts MSYS directories before the directories in $PATH. commands set up by previous steps may be shadowed. remove the login option unless it is necessary",
+			shell.Value,
+		)
+	}
+
+	rule.checkScript(e.Run)
+	return nil
+}
+
+func (rule *RuleWindowsBash) checkScript(run *String) {
+	tmp, tilde := false, false
+	for _, l := range strings.Split(run.Value, "\n") {
+		if m := reWindowsBashFileWrite.FindStringSubmatch(l); m != nil && !strings.Contains(l, "cygpath") {
+			if p := reWindowsBashPOSIXPath.FindStringSubmatch(l); p != nil {
+				rule.Errorf(
+					run.Pos,
+					"%q written to $%s is a POSIX style path in Git Bash on Windows runner. the runner, other shells, and actions cannot find it. convert it to Windows style path with \"cygpath -w\"",
+					p[1],
+					m[1],
+				)
+				continue
+			}
+		}
+		if !tmp && reWindowsBashTmp.MatchString(l) {
+			tmp = true
+			rule.Error(
+				run.Pos,
+				"\"/tmp\" in bash script on Windows runner is a directory emulated by Git Bash. it is not available to other shells and actions, and its location differs from Linux and macOS runners. use \"$RUNNER_TEMP\" instead",
+			)
+		}
+		if !tilde && !strings.Contains(l, "cygpath") && reWindowsBashTilde.MatchString(l) {
+			tilde = true
+			rule.Error(
+				run.Pos,
+				"\"~\" in bash script on Windows runner is expanded to a POSIX style path like \"/c/Users/runneradmin\" by Git Bash. other shells, actions, and Windows programs cannot find it. use \"$USERPROFILE\" or convert the path to Windows style path with \"cygpath -w\"",
+			)
+		}
+	}
+}
+
+// runsOnWindowsInMatrix returns true when the label at "runs-on:" is resolved to a Windows runner
+// label from the matrix like `runs-on: ${{ matrix.os }}`.
+func runsOnWindowsInMatrix(n *Job) bool {
+	if n.RunsOn == nil || n.Strategy == nil || n.Strategy.Matrix == nil {
+		return false
+	}
+	labels := n.RunsOn.Labels
+	if n.RunsOn.LabelsExpr != nil {
+		labels = []*String{n.RunsOn.LabelsExpr}
+	}
+	for _, l := range labels {
+		if !l.ContainsExpression() {
+			continue
+		}
+		for _, s := range runnerLabelsInMatrix(l, n.Strategy.Matrix) {
+			if getPlatformFromRunner(&Runner{Labels: []*String{s}}) == platformKindWindows {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isLoginShellArgs returns true when the arguments of bash contain the option to run a login shell
+// like "-l", "-el", or "--login".
+func isLoginShellArgs(args []string) bool {
+	for _, a := range args {
+		if a == "--login" {
+			return true
+		}
+		if len(a) > 1 && a[0] == '-' && a[1] != '-' && strings.ContainsRune(a, 'l') {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleWindowsBashLoginShellArgs(t *testing.T) {
+	tests := []struct {
+		shell string
+		want  bool
+	}{
+		{"bash -l {0}", true},
+		{"bash -el {0}", true},
+		{"bash --login -eo pipefail {0}", true},
+		{"bash {0}", false},
+		{"bash --noprofile --norc -eo pipefail {0}", false},
+		{"bash -e -o pipefail {0}", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.shell, func(t *testing.T) {
+			args := strings.Fields(tc.shell)[1:]
+			if have := isLoginShellArgs(args); have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleWindowsBashTildePath(t *testing.T) {
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{"cp out.txt ~/", true},
+		{"cd ~", true},
+		{"CACHE=~/.cache", true},
+		{"ls \"$(echo ~/foo)\"", true},
+		{"tar -C ~/.local -xf out.tar", true},
+		{"git diff HEAD~1", false},
+		{"[[ $s =~ ^foo ]]", false},
+		{"echo ~foo", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.line, func(t *testing.T) {
+			if have := reWindowsBashTilde.MatchString(tc.line); have != tc.want {
+				t.Fatalf("wanted %v but got %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestRuleWindowsBashShellOfStep(t *testing.T) {
+	tests := []struct {
+		what string
+		src  string
+		want int
+	}{
+		{
+			what: "step shell",
+			src: `jobs:
+  test:
+    runs-on: windows-latest
+    steps:
+      - run: echo hi > /tmp/out
+        shell: bash`,
+			want: 1,
+		},
+		{
+			what: "job default shell overrides workflow default shell",
+			src: `defaults:
+  run:
+    shell: bash
+jobs:
+  test:
+    runs-on: windows-latest
+    defaults:
+      run:
+        shell: pwsh
+    steps:
+      - run: echo hi > /tmp/out`,
+			want: 0,
+		},
+		{
+			what: "default shell on Windows is pwsh",
+			src: `jobs:
+  test:
+    runs-on: windows-latest
+    steps:
+      - run: echo hi > /tmp/out`,
+			want: 0,
+		},
+		{
+			what: "path to bash",
+			src: `jobs:
+  test:
+    runs-on: [self-hosted, windows]
+    steps:
+      - run: echo hi > /tmp/out
+        shell: C:\Git\bin\bash.exe {0}`,
+			want: 1,
+		},
+		{
+			what: "platform is unknown",
+			src: `jobs:
+  test:
+    runs-on: ${{ matrix.os }}
+    steps:
+      - run: echo hi > /tmp/out
+        shell: bash`,
+			want: 0,
+		},
+		{
+			what: "windows runner in matrix",
+			src: `jobs:
+  test:
+    strategy:
+      matrix:
+        include:
+          - os: ubuntu-latest
+          - os: windows-2022
+    runs-on: ${{ matrix.os }}
+    steps:
+      - run: echo hi > /tmp/out
+        shell: bash`,
+			want: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.what, func(t *testing.T) {
+			w, errs := Parse([]byte("on: push\n" + tc.src + "\n"))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			r := NewRuleWindowsBash()
+			v := NewVisitor()
+			v.AddPass(r)
+			if err := v.Visit(w); err != nil {
+				t.Fatal(err)
+			}
+			if errs := r.Errs(); len(errs) != tc.want {
+				t.Fatalf("wanted %d errors but got %d: %v", tc.want, len(errs), errs)
+			}
+		})
+	}
+}
@@ -2,20 +2,34 @@ package actionlint
 
 import (
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
 )
 
+// Limits of nesting reusable workflows. A workflow can connect up to 4 levels of workflows including
+// the top-level caller workflow, and can call up to 20 unique reusable workflows including the nested
+// calls.
+// https://docs.github.com/en/actions/using-workflows/reusing-workflows#nesting-reusable-workflows
+// https://docs.github.com/en/actions/using-workflows/reusing-workflows#limitations
+const (
+	maxReusableWorkflowNestingLevels = 4
+	maxUniqueReusableWorkflows       = 20
+)
+
 // RuleWorkflowCall is a rule checker to check workflow call at jobs.<job_id>.
 type RuleWorkflowCall struct {
 	RuleBase
 	workflowCallEventPos *Pos
 	workflowPath         string
 	cache                *LocalReusableWorkflowCache
+	remote               *RemoteReusableWorkflowCache
 }
 
 // NewRuleWorkflowCall creates a new RuleWorkflowCall instance. 'workflowPath' is a file path to
-// the workflow which is relative to a project root directory or an absolute path.
-func NewRuleWorkflowCall(workflowPath string, cache *LocalReusableWorkflowCache) *RuleWorkflowCall {
+// the workflow which is relative to a project root directory or an absolute path. 'remote' is used
+// to validate calls of reusable workflows in other repositories. It can be nil.
+func NewRuleWorkflowCall(workflowPath string, cache *LocalReusableWorkflowCache, remote *RemoteReusableWorkflowCache) *RuleWorkflowCall {
 	return &RuleWorkflowCall{
 		RuleBase: RuleBase{
 			name: "workflow-call",
@@ -24,6 +38,7 @@ func NewRuleWorkflowCall(workflowPath string, cache *LocalReusableWorkflowCache)
 		workflowCallEventPos: nil,
 		workflowPath:         workflowPath,
 		cache:                cache,
+		remote:               remote,
 	}
 }
 
@@ -34,13 +49,106 @@ func (rule *RuleWorkflowCall) VisitWorkflowPre(n *Workflow) error {
 			rule.workflowCallEventPos = e.Pos
 			// Register this reusable workflow in cache so that it does not need to parse this workflow
 			// file again when this workflow is called by other workflows.
-			rule.cache.WriteWorkflowCallEvent(rule.workflowPath, e)
+			rule.cache.writeWorkflowCallEvent(rule.workflowPath, e, reusableWorkflowCalls(n.Jobs))
 			break
 		}
 	}
 	return nil
 }
 
+// VisitWorkflowPost is callback when visiting Workflow node after visiting its children. It checks
+// the nesting of reusable workflows by resolving the local workflow calls across files. This is done
+// after visiting jobs so that errors on reading the called workflows are reported at each call first.
+func (rule *RuleWorkflowCall) VisitWorkflowPost(n *Workflow) error {
+	calls := make([]*String, 0, len(n.Jobs))
+	for _, j := range n.Jobs {
+		if j.WorkflowCall == nil {
+			continue
+		}
+		u := j.WorkflowCall.Uses
+		if u == nil || u.ContainsExpression() || !isWorkflowCallUsesLocalFormat(u.Value) && !isWorkflowCallUsesRepoFormat(u.Value) {
+			continue
+		}
+		calls = append(calls, u)
+	}
+	if len(calls) == 0 {
+		return nil
+	}
+	sort.Slice(calls, func(i, j int) bool {
+		return calls[i].Pos.IsBefore(calls[j].Pos)
+	})
+
+	self, ok := rule.cache.convWorkflowPathToSpec(rule.workflowPath)
+	if !ok {
+		self = filepath.ToSlash(rule.workflowPath)
+	}
+
+	for _, u := range calls {
+		chain := append([]string{self}, rule.longestCallChain(u.Value, 2)...)
+		if len(chain) > maxReusableWorkflowNestingLevels {
+			qs := make([]string, 0, len(chain))
+			for _, c := range chain {
+				qs = append(qs, fmt.Sprintf("%q", c))
+			}
+			rule.Errorf(
+				u.Pos,
+				"reusable workflow call %q exceeds the maximum nesting of %d levels of workflows including the top-level caller workflow. the call chain is %s",
+				u.Value,
+				maxReusableWorkflowNestingLevels,
+				strings.Join(qs, " -> "),
+			)
+		}
+	}
+
+	unique := map[string]struct{}{}
+	for _, u := range calls {
+		rule.collectCalls(u.Value, unique)
+		if len(unique) > maxUniqueReusableWorkflows {
+			rule.Errorf(
+				u.Pos,
+				"reusable workflow call %q exceeds the maximum number of %d unique reusable workflows called from one workflow including nested calls. %d unique reusable workflows are called until this call",
+				u.Value,
+				maxUniqueReusableWorkflows,
+				len(unique),
+			)
+			break
+		}
+	}
+
+	return nil
+}
+
+// longestCallChain returns the longest chain of workflow calls starting from the spec. The level
+// parameter is the nesting level of the workflow. The resolution stops when the level exceeds the
+// limit so that it terminates even if the calls are recursive.
+func (rule *RuleWorkflowCall) longestCallChain(spec string, level int) []string {
+	m, _ := rule.cache.findMetadata(spec, false) // Errors are reported when visiting jobs of the workflow
+	if m == nil || level > maxReusableWorkflowNestingLevels {
+		return []string{spec}
+	}
+	var longest []string
+	for _, c := range m.Calls {
+		if chain := rule.longestCallChain(c, level+1); len(chain) > len(longest) {
+			longest = chain
+		}
+	}
+	return append([]string{spec}, longest...)
+}
+
+func (rule *RuleWorkflowCall) collectCalls(spec string, seen map[string]struct{}) {
+	if _, ok := seen[spec]; ok {
+		return
+	}
+	seen[spec] = struct{}{}
+	m, _ := rule.cache.findMetadata(spec, false)
+	if m == nil {
+		return
+	}
+	for _, c := range m.Calls {
+		rule.collectCalls(c, seen)
+	}
+}
+
 // VisitJobPre is callback when visiting Job node before visiting its children.
 func (rule *RuleWorkflowCall) VisitJobPre(n *Job) error {
 	if n.WorkflowCall == nil {
@@ -58,6 +166,7 @@ func (rule *RuleWorkflowCall) VisitJobPre(n *Job) error {
 	}
 
 	if isWorkflowCallUsesRepoFormat(u.Value) {
+		rule.checkWorkflowCallUsesRemote(n.WorkflowCall)
 		return nil
 	}
 
@@ -88,6 +197,31 @@ func (rule *RuleWorkflowCall) checkWorkflowCallUsesLocal(call *WorkflowCall) {
 		return
 	}
 
+	rule.checkWorkflowCallInputsAndSecrets(call, m)
+	rule.Debug("Validated reusable workflow %q", u.Value)
+}
+
+func (rule *RuleWorkflowCall) checkWorkflowCallUsesRemote(call *WorkflowCall) {
+	if rule.remote == nil {
+		return
+	}
+	u := call.Uses
+	m, err := rule.remote.FindMetadata(u.Value)
+	if err != nil {
+		rule.Debug("Could not fetch reusable workflow %s: %s", u.Value, err)
+		return
+	}
+	if m == nil {
+		return
+	}
+
+	rule.checkWorkflowCallInputsAndSecrets(call, m)
+	rule.Debug("Validated remote reusable workflow %q", u.Value)
+}
+
+func (rule *RuleWorkflowCall) checkWorkflowCallInputsAndSecrets(call *WorkflowCall, m *ReusableWorkflowMetadata) {
+	u := call.Uses
+
 	// Validate inputs
 	for n, i := range m.Inputs {
 		if i != nil && i.Required {
@@ -141,8 +275,6 @@ func (rule *RuleWorkflowCall) checkWorkflowCallUsesLocal(call *WorkflowCall) {
 			}
 		}
 	}
-
-	rule.Debug("Validated reusable workflow %q", u.Value)
 }
 
 // Parse ./{path/{filename}
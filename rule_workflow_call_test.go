@@ -36,7 +36,7 @@ func TestRuleWorkflowCallCheckWorkflowCallUsesFormat(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.uses, func(t *testing.T) {
 			c := NewLocalReusableWorkflowCache(nil, "", nil)
-			r := NewRuleWorkflowCall("", c)
+			r := NewRuleWorkflowCall("", c, nil)
 			j := &Job{
 				WorkflowCall: &WorkflowCall{
 					Uses: &String{
@@ -81,7 +81,7 @@ func TestRuleWorkflowCallNestedWorkflowCalls(t *testing.T) {
 	}
 
 	c := NewLocalReusableWorkflowCache(nil, "", nil)
-	r := NewRuleWorkflowCall("", c)
+	r := NewRuleWorkflowCall("", c, nil)
 
 	if err := r.VisitWorkflowPre(w); err != nil {
 		t.Fatal(err)
@@ -124,7 +124,7 @@ func TestRuleWorkflowCallWriteEventNodeToMetadataCache(t *testing.T) {
 
 	cwd := filepath.Join("path", "to", "project")
 	c := NewLocalReusableWorkflowCache(&Project{cwd, nil}, cwd, nil)
-	r := NewRuleWorkflowCall("test-workflow.yaml", c)
+	r := NewRuleWorkflowCall("test-workflow.yaml", c, nil)
 
 	if err := r.VisitWorkflowPre(w); err != nil {
 		t.Fatal(err)
@@ -321,7 +321,7 @@ func TestRuleWorkflowCallCheckReusableWorkflowCall(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.what, func(t *testing.T) {
-			r := NewRuleWorkflowCall("this-workflow.yaml", cache)
+			r := NewRuleWorkflowCall("this-workflow.yaml", cache, nil)
 
 			w := &Workflow{
 				On: []Event{
@@ -0,0 +1,93 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RuleWorkflowFile is a rule checker to detect workflow files which GitHub Actions never runs due to
+// their names or locations. GitHub Actions only runs the workflow files whose extensions are ".yml"
+// or ".yaml" put directly in ".github/workflows" directory. When the rule is enabled by "enable:"
+// in the configuration, file names are also checked following the lower case naming style.
+type RuleWorkflowFile struct {
+	RuleBase
+	path    string
+	project *Project
+}
+
+// NewRuleWorkflowFile creates a new RuleWorkflowFile instance. The path parameter is an absolute
+// file path of the workflow. When the path is empty or the project is nil, nothing is checked.
+func NewRuleWorkflowFile(path string, project *Project) *RuleWorkflowFile {
+	return &RuleWorkflowFile{
+		RuleBase: RuleBase{
+			name: "workflow-file",
+			desc: "Checks for names and locations of workflow files which GitHub Actions never runs",
+		},
+		path:    path,
+		project: project,
+	}
+}
+
+// VisitWorkflowPre is callback when visiting Workflow node before visiting its children.
+func (rule *RuleWorkflowFile) VisitWorkflowPre(n *Workflow) error {
+	if rule.path == "" || rule.project == nil {
+		return nil
+	}
+	wd := rule.project.WorkflowsDir()
+	r, err := filepath.Rel(filepath.Dir(wd), rule.path)
+	if err != nil || strings.HasPrefix(r, "..") {
+		return nil // Files outside ".github" are not treated as workflow files of the repository
+	}
+
+	name := filepath.Base(rule.path)
+	c := rule.Config().Rule(rule.Name())
+	if c != nil && matchGlobFilter(c.Allow, name) {
+		return nil
+	}
+	pos := &Pos{Line: 1, Col: 1}
+	rel := filepath.ToSlash(filepath.Join(".github", r))
+
+	if filepath.Dir(rule.path) != wd {
+		rule.Errorf(
+			pos,
+			"workflow file %q is not put directly in \".github/workflows\" directory. GitHub Actions ignores workflow files in other directories including subdirectories of \".github/workflows\"",
+			rel,
+		)
+		return nil
+	}
+
+	if ext := filepath.Ext(name); ext != ".yml" && ext != ".yaml" {
+		rule.Errorf(
+			pos,
+			"extension %q of workflow file %q is not \".yml\" nor \".yaml\". GitHub Actions ignores the file. add the file name to \"allow\" of \"workflow-file\" rule in actionlint.yaml if the workflow is intentionally disabled",
+			ext,
+			rel,
+		)
+	}
+
+	if s, err := os.Lstat(rule.path); err == nil && s.Mode()&os.ModeSymlink != 0 {
+		rule.Errorf(
+			pos,
+			"workflow file %q is a symbolic link. GitHub Actions ignores symbolic links in \".github/workflows\" directory. put the actual file instead",
+			rel,
+		)
+	}
+
+	if c != nil && c.Enable {
+		if want := workflowFileStyleName(name); want != name {
+			rule.Errorf(
+				pos,
+				"workflow file name %q should not contain spaces nor upper case characters. rename it to %q",
+				name,
+				want,
+			)
+		}
+	}
+
+	return nil
+}
+
+func workflowFileStyleName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), "-")
+}
@@ -0,0 +1,109 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestRuleWorkflowFile(t *testing.T) {
+	root := t.TempDir()
+	wd := filepath.Join(root, ".github", "workflows")
+	if err := os.MkdirAll(filepath.Join(wd, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		what   string
+		path   string
+		enable bool
+		want   string
+	}{
+		{"ok", ".github/workflows/ci.yaml", false, ""},
+		{"ok with yml", ".github/workflows/release-please.yml", true, ""},
+		{"subdirectory", ".github/workflows/sub/ci.yaml", false, "is not put directly in \".github/workflows\" directory"},
+		{"wrong directory", ".github/workflow/ci.yaml", false, "is not put directly in \".github/workflows\" directory"},
+		{"invalid extension", ".github/workflows/ci.yml.disabled", false, "extension \".disabled\" of workflow file \".github/workflows/ci.yml.disabled\""},
+		{"upper case extension", ".github/workflows/ci.YML", false, "extension \".YML\""},
+		{"allowed", ".github/workflows/nightly.yml.disabled", false, ""},
+		{"outside .github", "testdata/ci.yaml", false, ""},
+		{"upper case without enable", ".github/workflows/CI.yaml", false, ""},
+		{"upper case", ".github/workflows/CI.yaml", true, "rename it to \"ci.yaml\""},
+		{"spaces", ".github/workflows/Build and test.yaml", true, "rename it to \"build-and-test.yaml\""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			r := NewRuleWorkflowFile(filepath.Join(root, filepath.FromSlash(tc.path)), &Project{root: root})
+			r.SetConfig(&Config{
+				Rules: map[string]*RuleConfig{
+					"workflow-file": {Enable: tc.enable, Allow: []string{"nightly.*"}},
+				},
+			})
+			if err := r.VisitWorkflowPre(&Workflow{}); err != nil {
+				t.Fatal(err)
+			}
+			errs := r.Errs()
+			if tc.want == "" {
+				if len(errs) > 0 {
+					t.Fatalf("wanted no error but got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("wanted one error but got %v", errs)
+			}
+			if !strings.Contains(errs[0].Message, tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, errs[0].Message)
+			}
+		})
+	}
+}
+
+func TestRuleWorkflowFileSymlink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("creating symbolic links requires privilege on Windows")
+	}
+
+	root := t.TempDir()
+	wd := filepath.Join(root, ".github", "workflows")
+	if err := os.MkdirAll(wd, 0755); err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(root, "ci.yaml")
+	if err := os.WriteFile(src, []byte("on: push\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(wd, "ci.yaml")
+	if err := os.Symlink(src, dst); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewRuleWorkflowFile(dst, &Project{root: root})
+	if err := r.VisitWorkflowPre(&Workflow{}); err != nil {
+		t.Fatal(err)
+	}
+	errs := r.Errs()
+	if len(errs) != 1 {
+		t.Fatalf("wanted one error but got %v", errs)
+	}
+	if !strings.Contains(errs[0].Message, "is a symbolic link") {
+		t.Fatalf("unexpected error message: %q", errs[0].Message)
+	}
+}
+
+func TestRuleWorkflowFileNoProject(t *testing.T) {
+	for _, r := range []*RuleWorkflowFile{
+		NewRuleWorkflowFile("", &Project{root: "."}),
+		NewRuleWorkflowFile(filepath.Join(".github", "workflows", "sub", "ci.yaml"), nil),
+	} {
+		if err := r.VisitWorkflowPre(&Workflow{}); err != nil {
+			t.Fatal(err)
+		}
+		if errs := r.Errs(); len(errs) > 0 {
+			t.Fatalf("wanted no error but got %v", errs)
+		}
+	}
+}
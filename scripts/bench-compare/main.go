@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// `BenchmarkLintCorpus/files-8    394    3168806 ns/op    769491 B/op    10038 allocs/op`
+var reBenchLine = regexp.MustCompile(`^(Benchmark\S+?)(?:-\d+)?\s+\d+\s+(.+)$`)
+
+// results is a map from benchmark names to metric units to measured values. One benchmark has
+// multiple values when it is run with -count option.
+type results map[string]map[string][]float64
+
+func parseResults(r io.Reader) (results, []string, error) {
+	ret := results{}
+	names := []string{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := reBenchLine.FindStringSubmatch(strings.TrimSpace(s.Text()))
+		if m == nil {
+			continue
+		}
+		name := m[1]
+		fs := strings.Fields(m[2])
+		if len(fs)%2 != 0 {
+			return nil, nil, fmt.Errorf("broken result of benchmark %s: %q", name, s.Text())
+		}
+		ms, ok := ret[name]
+		if !ok {
+			ms = map[string][]float64{}
+			ret[name] = ms
+			names = append(names, name)
+		}
+		for i := 0; i < len(fs); i += 2 {
+			v, err := strconv.ParseFloat(fs[i], 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("broken value %q of %s in benchmark %s: %w", fs[i], fs[i+1], name, err)
+			}
+			ms[fs[i+1]] = append(ms[fs[i+1]], v)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, nil, err
+	}
+	return ret, names, nil
+}
+
+func readResults(path string) (results, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	rs, names, err := parseResults(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse benchmark results in %q: %w", path, err)
+	}
+	if len(names) == 0 {
+		return nil, nil, fmt.Errorf("no benchmark result was found in %q", path)
+	}
+	return rs, names, nil
+}
+
+// median is used to compare results since it is more robust against outliers than mean.
+func median(vs []float64) float64 {
+	s := append([]float64{}, vs...)
+	sort.Float64s(s)
+	l := len(s)
+	if l%2 == 1 {
+		return s[l/2]
+	}
+	return (s[l/2-1] + s[l/2]) / 2
+}
+
+type comparison struct {
+	name      string
+	unit      string
+	base      float64
+	head      float64
+	delta     float64 // Percentage of change. Positive value means regression
+	regressed bool
+}
+
+func compare(base, head results, names, units []string, threshold float64) []*comparison {
+	ret := []*comparison{}
+	for _, n := range names {
+		o, ok := base[n]
+		if !ok {
+			continue // Benchmark newly added
+		}
+		for _, u := range units {
+			ov, nv := o[u], head[n][u]
+			if len(ov) == 0 || len(nv) == 0 {
+				continue
+			}
+			c := &comparison{name: n, unit: u, base: median(ov), head: median(nv)}
+			switch {
+			case c.base == c.head:
+				c.delta = 0
+			case c.base == 0:
+				c.delta = math.Inf(1)
+			default:
+				c.delta = (c.head - c.base) / c.base * 100
+			}
+			c.regressed = c.delta > threshold
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func formatValue(v float64) string {
+	if v == math.Trunc(v) {
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("bench-compare", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	threshold := flags.Float64("threshold", 10, "Percentage of regression which makes this command fail")
+	metrics := flags.String("metrics", "ns/op,allocs/op", "Comma-separated units of metrics to compare")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: bench-compare [-threshold PERCENT] [-metrics UNITS] OLD NEW")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 1
+	}
+
+	base, _, err := readResults(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	head, names, err := readResults(flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	units := strings.Split(*metrics, ",")
+	cs := compare(base, head, names, units, *threshold)
+
+	w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "name\tunit\told\tnew\tdelta\t")
+	regressions := 0
+	for _, c := range cs {
+		mark := ""
+		if c.regressed {
+			mark = "REGRESSION"
+			regressions++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%+.2f%%\t%s\n", c.name, c.unit, formatValue(c.base), formatValue(c.head), c.delta, mark)
+	}
+	w.Flush()
+
+	for _, n := range names {
+		if _, ok := base[n]; !ok {
+			fmt.Fprintf(stdout, "%s is not found in old results\n", n)
+		}
+	}
+
+	if regressions > 0 {
+		fmt.Fprintf(stderr, "%d regression(s) exceeding %.2f%% were detected\n", regressions, *threshold)
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
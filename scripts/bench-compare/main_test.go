@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRunMain(args []string) (string, string, int) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	status := run(args, stdout, stderr)
+	return stdout.String(), stderr.String(), status
+}
+
+func TestCompareOK(t *testing.T) {
+	base := filepath.Join("testdata", "base.txt")
+	head := filepath.Join("testdata", "ok.txt")
+	stdout, stderr, status := testRunMain([]string{base, head})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+
+	want := []string{
+		"BenchmarkLintWorkflowContent/minimal  ns/op      52000    51000    -1.92%",
+		"BenchmarkLintWorkflowContent/minimal  allocs/op  235      235      +0.00%",
+		"BenchmarkLintCorpus/files             ns/op      3100000  3000000  -3.23%",
+		"BenchmarkLintCorpus/files             allocs/op  10000    9000     -10.00%",
+		"BenchmarkLintCorpus/content is not found in old results",
+	}
+	for _, w := range want {
+		if !strings.Contains(stdout, w) {
+			t.Errorf("%q is not included in output:\n%s", w, stdout)
+		}
+	}
+}
+
+func TestCompareRegression(t *testing.T) {
+	base := filepath.Join("testdata", "base.txt")
+	head := filepath.Join("testdata", "regression.txt")
+	stdout, stderr, status := testRunMain([]string{base, head})
+	if status == 0 {
+		t.Fatalf("status was zero: %q", stdout)
+	}
+	if !strings.Contains(stdout, "allocs/op  10000    12000    +20.00%  REGRESSION") {
+		t.Errorf("regression is not reported:\n%s", stdout)
+	}
+	if strings.Count(stdout, "REGRESSION") != 1 {
+		t.Errorf("only allocs/op of BenchmarkLintCorpus/files should regress:\n%s", stdout)
+	}
+	if want := "1 regression(s) exceeding 10.00% were detected"; !strings.Contains(stderr, want) {
+		t.Errorf("%q is not included in stderr: %q", want, stderr)
+	}
+
+	// Loosen the threshold
+	stdout, stderr, status = testRunMain([]string{"-threshold", "25", base, head})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q: %s", status, stderr, stdout)
+	}
+}
+
+func TestCompareMetrics(t *testing.T) {
+	base := filepath.Join("testdata", "base.txt")
+	head := filepath.Join("testdata", "regression.txt")
+	stdout, stderr, status := testRunMain([]string{"-metrics", "B/op", base, head})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	if strings.Contains(stdout, "allocs/op") || !strings.Contains(stdout, "B/op") {
+		t.Errorf("only B/op should be compared:\n%s", stdout)
+	}
+}
+
+func TestCompareError(t *testing.T) {
+	testCases := []struct {
+		what string
+		args []string
+		want string
+	}{
+		{
+			what: "too few arguments",
+			args: []string{"foo.txt"},
+			want: "usage: bench-compare",
+		},
+		{
+			what: "file not found",
+			args: []string{filepath.Join("testdata", "base.txt"), filepath.Join("testdata", "this-file-does-not-exist.txt")},
+			want: "this-file-does-not-exist.txt",
+		},
+		{
+			what: "broken value",
+			args: []string{filepath.Join("testdata", "base.txt"), filepath.Join("testdata", "broken.txt")},
+			want: `broken value "3.1.0" of ns/op in benchmark BenchmarkLintCorpus/files`,
+		},
+		{
+			what: "no result",
+			args: []string{filepath.Join("testdata", "base.txt"), "main.go"},
+			want: "no benchmark result was found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, stderr, status := testRunMain(tc.args)
+			if status == 0 {
+				t.Fatal("status was zero")
+			}
+			if !strings.Contains(stderr, tc.want) {
+				t.Fatalf("%q is not included in stderr: %q", tc.want, stderr)
+			}
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	for _, tc := range []struct {
+		input []float64
+		want  float64
+	}{
+		{[]float64{3}, 3},
+		{[]float64{3, 1, 2}, 2},
+		{[]float64{4, 1, 3, 2}, 2.5},
+	} {
+		if have := median(tc.input); have != tc.want {
+			t.Errorf("median of %v should be %v but got %v", tc.input, tc.want, have)
+		}
+	}
+	in := []float64{3, 1, 2}
+	median(in)
+	if !cmp.Equal([]float64{3, 1, 2}, in) {
+		t.Errorf("input was modified: %v", in)
+	}
+}
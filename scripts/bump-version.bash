@@ -59,6 +59,7 @@ sed_ "\
     s/    rev: v[0-9]+\.[0-9]+\.[0-9]+/    rev: v${version}/; \
     s/ actionlint@[0-9]+\.[0-9]+\.[0-9]+/ actionlint@${version}/g; \
     s/\`actionlint:[0-9]+\.[0-9]+\.[0-9]+\`/\`actionlint:${version}\`/g; \
+    s/rhysd\/actionlint@v[0-9]+\.[0-9]+\.[0-9]+/rhysd\/actionlint@v${version}/g; \
     " "$usage_doc"
 
 echo "Updating $playground_html"
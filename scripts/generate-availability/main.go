@@ -200,8 +200,29 @@ func WorkflowKeyAvailability(key string) ([]string, []string) {
 // See the script for more details: https://github.com/rhysd/actionlint/blob/main/scripts/generate-availability/`)
 	fmt.Fprintf(buf, "var SpecialFunctionNames = %#v\n", funcs)
 
-	// This variable is for unit tests
 	sort.Strings(keys)
+	qs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		qs = append(qs, strconv.Quote(k))
+	}
+	fmt.Fprintf(buf, `
+// WorkflowKeyAcceptsExpression returns whether the given workflow key accepts ${{ }} expressions.
+// Expressions at keys which are not listed in the context availability table are not evaluated and
+// their values are treated as literal strings.
+//
+// This function was generated from https://docs.github.com/en/actions/learn-github-actions/contexts#context-availability.
+// See the script for more details: https://github.com/rhysd/actionlint/blob/main/scripts/generate-availability/
+func WorkflowKeyAcceptsExpression(key string) bool {
+	switch key {
+	case %s:
+		return true
+	default:
+		return false
+	}
+}
+`, strings.Join(qs, ", "))
+
+	// This variable is for unit tests
 	fmt.Fprintf(buf, "\n// For test\nvar allWorkflowKeys = %#v\n", keys)
 
 	formatted, err := format.Source(buf.Bytes())
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+var dbg = log.New(io.Discard, "", log.LstdFlags)
+
+//go:embed deprecations.json
+var defaultDeprecationsJSON []byte
+
+const dateLayout = "2006-01-02"
+
+var kinds = map[string]string{
+	"action":  "DeprecationKindAction",
+	"runtime": "DeprecationKindRuntime",
+	"runner":  "DeprecationKindRunner",
+}
+
+type deprecation struct {
+	Kind        string   `json:"kind"`
+	Target      string   `json:"target"`
+	Announced   string   `json:"announced"`
+	Effective   string   `json:"effective"`
+	Replacement string   `json:"replacement"`
+	URL         string   `json:"url"`
+	Actions     []string `json:"actions"`
+}
+
+func isActionSpec(s string) bool {
+	slug, ref, ok := strings.Cut(s, "@")
+	return ok && ref != "" && strings.Contains(slug, "/")
+}
+
+func (d *deprecation) validate() error {
+	if _, ok := kinds[d.Kind]; !ok {
+		return fmt.Errorf("unknown kind %q of deprecation %q. valid kinds are \"action\", \"runtime\", and \"runner\"", d.Kind, d.Target)
+	}
+	if d.Target == "" {
+		return fmt.Errorf("\"target\" must not be empty in deprecation of kind %q", d.Kind)
+	}
+	if d.Kind == "action" && !isActionSpec(d.Target) {
+		return fmt.Errorf("target %q of deprecated action must be in the format \"owner/repo@ref\"", d.Target)
+	}
+	announced, err := time.Parse(dateLayout, d.Announced)
+	if err != nil {
+		return fmt.Errorf("\"announced\" of deprecation %q must be a date like \"2024-01-31\": %w", d.Target, err)
+	}
+	effective, err := time.Parse(dateLayout, d.Effective)
+	if err != nil {
+		return fmt.Errorf("\"effective\" of deprecation %q must be a date like \"2024-01-31\": %w", d.Target, err)
+	}
+	if effective.Before(announced) {
+		return fmt.Errorf("deprecation %q is effective on %s before it is announced on %s", d.Target, d.Effective, d.Announced)
+	}
+	if !strings.HasPrefix(d.URL, "https://") {
+		return fmt.Errorf("\"url\" of deprecation %q must be a URL starting with \"https://\": %q", d.Target, d.URL)
+	}
+	if len(d.Actions) > 0 && d.Kind != "runtime" {
+		return fmt.Errorf("\"actions\" is only available for deprecation of kind \"runtime\" but deprecation %q is of kind %q", d.Target, d.Kind)
+	}
+	for _, a := range d.Actions {
+		if !isActionSpec(a) {
+			return fmt.Errorf("action %q at \"actions\" of deprecation %q must be in the format \"owner/repo@ref\"", a, d.Target)
+		}
+	}
+	return nil
+}
+
+func parse(src []byte) ([]*deprecation, error) {
+	var ds []*deprecation
+	if err := json.Unmarshal(src, &ds); err != nil {
+		return nil, fmt.Errorf("could not parse the deprecations file as JSON: %w", err)
+	}
+
+	seen := map[string]struct{}{}
+	for _, d := range ds {
+		if err := d.validate(); err != nil {
+			return nil, err
+		}
+		k := d.Kind + "/" + d.Target
+		if _, ok := seen[k]; ok {
+			return nil, fmt.Errorf("deprecation %q of kind %q is duplicated", d.Target, d.Kind)
+		}
+		seen[k] = struct{}{}
+	}
+
+	sort.SliceStable(ds, func(i, j int) bool {
+		if ds[i].Effective != ds[j].Effective {
+			return ds[i].Effective < ds[j].Effective
+		}
+		return ds[i].Target < ds[j].Target
+	})
+
+	return ds, nil
+}
+
+func generate(src []byte, out io.Writer) error {
+	ds, err := parse(src)
+	if err != nil {
+		return err
+	}
+	dbg.Println("Parsed", len(ds), "deprecations")
+
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, `// Code generated by actionlint/scripts/generate-deprecations. DO NOT EDIT.
+
+package actionlint
+
+// GitHubDeprecations is a calendar of deprecations of GitHub-managed actions, action runtimes, and
+// runner images sorted by their effective dates. This variable was generated by script at
+// ./scripts/generate-deprecations based on ./scripts/generate-deprecations/deprecations.json
+var GitHubDeprecations = []*Deprecation{
+`)
+	for _, d := range ds {
+		fmt.Fprintln(buf, "{")
+		fmt.Fprintf(buf, "Kind: %s,\n", kinds[d.Kind])
+		fmt.Fprintf(buf, "Target: %q,\n", d.Target)
+		fmt.Fprintf(buf, "Announced: %q,\n", d.Announced)
+		fmt.Fprintf(buf, "Effective: %q,\n", d.Effective)
+		if d.Replacement != "" {
+			fmt.Fprintf(buf, "Replacement: %q,\n", d.Replacement)
+		}
+		fmt.Fprintf(buf, "URL: %q,\n", d.URL)
+		if len(d.Actions) > 0 {
+			fmt.Fprintln(buf, "Actions: []string{")
+			for _, a := range d.Actions {
+				fmt.Fprintf(buf, "%q,\n", a)
+			}
+			fmt.Fprintln(buf, "},")
+		}
+		fmt.Fprintln(buf, "},")
+	}
+	fmt.Fprintln(buf, "}")
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format Go source: %w", err)
+	}
+	if _, err := out.Write(b); err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+	return nil
+}
+
+func run(args []string, stdout, stderr, dbgout io.Writer) int {
+	dbg.SetOutput(dbgout)
+
+	if len(args) > 2 {
+		fmt.Fprintln(stderr, "usage: generate-deprecations [[srcfile] dstfile]")
+		return 1
+	}
+
+	dbg.Println("Start generate-deprecations script")
+
+	src := defaultDeprecationsJSON
+	if len(args) == 2 {
+		b, err := os.ReadFile(args[0])
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		src = b
+	}
+
+	var out io.Writer
+	var dst string
+	if len(args) == 0 || args[len(args)-1] == "-" {
+		out = stdout
+		dst = "stdout"
+	} else {
+		n := args[len(args)-1]
+		f, err := os.Create(n)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+		dst = n
+	}
+
+	if err := generate(src, out); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dbg.Println("Wrote output to", dst)
+	dbg.Println("Done generate-deprecations script successfully")
+
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Stderr))
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRunMain(args []string) (string, string, int) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	status := run(args, stdout, stderr, io.Discard)
+	return stdout.String(), stderr.String(), status
+}
+
+func TestOKWriteStdout(t *testing.T) {
+	f := filepath.Join("testdata", "ok.json")
+	stdout, stderr, status := testRunMain([]string{f, "-"})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+
+	b, err := os.ReadFile(filepath.Join("testdata", "ok.go"))
+	if err != nil {
+		panic(err)
+	}
+	want := string(b)
+
+	if stdout != want {
+		t.Fatal(cmp.Diff(want, stdout))
+	}
+}
+
+func TestOKWriteFile(t *testing.T) {
+	in := filepath.Join("testdata", "ok.json")
+	out := filepath.Join("testdata", "_test_output.go")
+	defer os.Remove(out)
+
+	stdout, stderr, status := testRunMain([]string{in, out})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	if stdout != "" {
+		t.Fatalf("stdout is not empty: %q", stdout)
+	}
+
+	b, err := os.ReadFile(filepath.Join("testdata", "ok.go"))
+	if err != nil {
+		panic(err)
+	}
+	want := string(b)
+
+	b, err = os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("output file %q cannot be read: %v", out, err)
+	}
+	have := string(b)
+
+	if want != have {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestOKDefaultDeprecations(t *testing.T) {
+	stdout, stderr, status := testRunMain([]string{})
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+
+	b, err := os.ReadFile(filepath.Join("..", "..", "deprecations.go"))
+	if err != nil {
+		panic(err)
+	}
+	want := string(b)
+
+	if stdout != want {
+		t.Fatal("deprecations.go is outdated. run `go generate` to update it\n" + cmp.Diff(want, stdout))
+	}
+}
+
+func TestInvalidDeprecations(t *testing.T) {
+	testCases := []struct {
+		file string
+		want string
+	}{
+		{
+			file: "broken.json",
+			want: "could not parse the deprecations file as JSON",
+		},
+		{
+			file: "unknown_kind.json",
+			want: `unknown kind "image" of deprecation "ubuntu-20.04"`,
+		},
+		{
+			file: "invalid_date.json",
+			want: `"effective" of deprecation "ubuntu-20.04" must be a date like "2024-01-31"`,
+		},
+		{
+			file: "announced_after_effective.json",
+			want: `deprecation "ubuntu-20.04" is effective on 2025-04-15 before it is announced on 2025-05-01`,
+		},
+		{
+			file: "invalid_action.json",
+			want: `target "upload-artifact@v3" of deprecated action must be in the format "owner/repo@ref"`,
+		},
+		{
+			file: "duplicate.json",
+			want: `deprecation "ubuntu-20.04" of kind "runner" is duplicated`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			in := filepath.Join("testdata", tc.file)
+			stdout, stderr, status := testRunMain([]string{in, "-"})
+			if status == 0 {
+				t.Fatalf("status was zero: %q", stdout)
+			}
+			if !strings.Contains(stderr, tc.want) {
+				t.Fatalf("stderr %q does not contain %q", stderr, tc.want)
+			}
+		})
+	}
+}
+
+func TestTooManyArgs(t *testing.T) {
+	_, stderr, status := testRunMain([]string{"a", "b", "c"})
+	if status == 0 {
+		t.Fatal("status was zero")
+	}
+	if !strings.Contains(stderr, "usage: generate-deprecations [[srcfile] dstfile]") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by actionlint/scripts/generate-deprecations. DO NOT EDIT.
+
+package actionlint
+
+// GitHubDeprecations is a calendar of deprecations of GitHub-managed actions, action runtimes, and
+// runner images sorted by their effective dates. This variable was generated by script at
+// ./scripts/generate-deprecations based on ./scripts/generate-deprecations/deprecations.json
+var GitHubDeprecations = []*Deprecation{
+	{
+		Kind:      DeprecationKindRuntime,
+		Target:    "node16",
+		Announced: "2023-09-22",
+		Effective: "2024-11-12",
+		URL:       "https://github.blog/changelog/2024-09-25-end-of-life-for-actions-node16/",
+		Actions: []string{
+			"actions/checkout@v3",
+		},
+	},
+	{
+		Kind:        DeprecationKindAction,
+		Target:      "actions/upload-artifact@v3",
+		Announced:   "2024-04-16",
+		Effective:   "2025-01-30",
+		Replacement: "actions/upload-artifact@v4",
+		URL:         "https://github.blog/changelog/2024-04-16-deprecation-notice-v3-of-the-artifact-actions/",
+	},
+	{
+		Kind:        DeprecationKindRunner,
+		Target:      "ubuntu-20.04",
+		Announced:   "2025-01-09",
+		Effective:   "2025-04-15",
+		Replacement: "ubuntu-latest",
+		URL:         "https://github.com/actions/runner-images/issues/11101",
+	},
+}
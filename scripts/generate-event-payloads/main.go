@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var dbg = log.New(io.Discard, "", log.LstdFlags)
+
+const defaultBaseURL = "https://raw.githubusercontent.com/octokit/webhooks/main/payload-examples/api.github.com"
+
+// Example payload file of each webhook event in octokit/webhooks repository. The file path is
+// relative to payload-examples/api.github.com directory.
+var payloadFiles = map[string]string{
+	"check_run":                   "check_run/completed.payload.json",
+	"check_suite":                 "check_suite/completed.payload.json",
+	"create":                      "create/payload.json",
+	"delete":                      "delete/payload.json",
+	"deployment":                  "deployment/created.payload.json",
+	"discussion":                  "discussion/created.payload.json",
+	"issue_comment":               "issue_comment/created.payload.json",
+	"issues":                      "issues/opened.payload.json",
+	"merge_group":                 "merge_group/checks_requested.payload.json",
+	"pull_request":                "pull_request/opened.payload.json",
+	"pull_request_review":         "pull_request_review/submitted.payload.json",
+	"pull_request_review_comment": "pull_request_review_comment/created.payload.json",
+	"pull_request_target":         "pull_request/opened.payload.json",
+	"push":                        "push/payload.json",
+	"release":                     "release/published.payload.json",
+	"workflow_dispatch":           "workflow_dispatch/payload.json",
+	"workflow_run":                "workflow_run/completed.payload.json",
+}
+
+// trim removes properties of API URLs like "comments_url" to make the payload smaller. They are
+// rarely used in workflows. "html_url" is kept since it is often used in messages.
+func trim(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if strings.HasSuffix(k, "_url") && k != "html_url" {
+				delete(v, k)
+				continue
+			}
+			v[k] = trim(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = trim(e)
+		}
+	}
+	return v
+}
+
+func generate(payloads map[string][]byte, out io.Writer) error {
+	if len(payloads) == 0 {
+		return errors.New("no payload was found")
+	}
+
+	events := make([]string, 0, len(payloads))
+	for e := range payloads {
+		events = append(events, e)
+	}
+	sort.Strings(events)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, `// Code generated by actionlint/scripts/generate-event-payloads. DO NOT EDIT.
+
+package actionlint
+
+// EventPayloadExamples is a table from webhook event names to example payloads of the events in
+// JSON. This variable was generated by script at ./scripts/generate-event-payloads based on
+// https://github.com/octokit/webhooks/tree/main/payload-examples/api.github.com
+var EventPayloadExamples = map[string]string{
+`)
+	for _, e := range events {
+		var v interface{}
+		if err := json.Unmarshal(payloads[e], &v); err != nil {
+			return fmt.Errorf("could not parse payload of %q event: %w", e, err)
+		}
+		if _, ok := v.(map[string]interface{}); !ok {
+			return fmt.Errorf("payload of %q event is not an object", e)
+		}
+		b, err := json.Marshal(trim(v))
+		if err != nil {
+			return fmt.Errorf("could not encode payload of %q event: %w", e, err)
+		}
+		fmt.Fprintf(buf, "\t%q: %q,\n", e, b)
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format Go source: %w", err)
+	}
+
+	if _, err := out.Write(src); err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+
+	return nil
+}
+
+func fetch(url string) ([]byte, error) {
+	var c http.Client
+
+	dbg.Println("Fetching", url)
+
+	res, err := c.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch %s: %w", url, err)
+	}
+	if res.StatusCode < 200 || 300 <= res.StatusCode {
+		return nil, fmt.Errorf("request was not successful for %s: %s", url, res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch body for %s: %w", url, err)
+	}
+	res.Body.Close()
+
+	dbg.Printf("Fetched %d bytes from %s", len(body), url)
+	return body, nil
+}
+
+func fetchAll(baseURL string) (map[string][]byte, error) {
+	ret := make(map[string][]byte, len(payloadFiles))
+	for e, f := range payloadFiles {
+		b, err := fetch(baseURL + "/" + f)
+		if err != nil {
+			return nil, err
+		}
+		ret[e] = b
+	}
+	return ret, nil
+}
+
+// readAll reads payload files in the local directory. Events whose files don't exist are skipped.
+func readAll(dir string) (map[string][]byte, error) {
+	ret := map[string][]byte{}
+	for e, f := range payloadFiles {
+		p := filepath.Join(dir, filepath.FromSlash(f))
+		b, err := os.ReadFile(p)
+		if errors.Is(err, os.ErrNotExist) {
+			dbg.Printf("Skip %q event since %s does not exist", e, p)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read payload file: %w", err)
+		}
+		ret[e] = b
+	}
+	return ret, nil
+}
+
+func run(args []string, stdout, stderr, dbgout io.Writer, baseURL string) int {
+	dbg.SetOutput(dbgout)
+
+	if len(args) > 2 {
+		fmt.Fprintln(stderr, "usage: generate-event-payloads [[srcdir] dstfile]")
+		return 1
+	}
+
+	dbg.Println("Start generate-event-payloads script")
+
+	var payloads map[string][]byte
+	var err error
+	if len(args) == 2 {
+		payloads, err = readAll(args[0])
+	} else {
+		payloads, err = fetchAll(baseURL)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	var out io.Writer
+	var dst string
+	if len(args) == 0 || args[len(args)-1] == "-" {
+		out = stdout
+		dst = "stdout"
+	} else {
+		n := args[len(args)-1]
+		f, err := os.Create(n)
+		if err != nil {
+			fmt.Fprintf(stderr, "could not open file %q: %s\n", n, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+		dst = n
+	}
+
+	if err := generate(payloads, out); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dbg.Println("Wrote output to", dst)
+	dbg.Println("Done generate-event-payloads script successfully")
+
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Stderr, defaultBaseURL))
+}
@@ -0,0 +1,12 @@
+// Code generated by actionlint/scripts/generate-event-payloads. DO NOT EDIT.
+
+package actionlint
+
+// EventPayloadExamples is a table from webhook event names to example payloads of the events in
+// JSON. This variable was generated by script at ./scripts/generate-event-payloads based on
+// https://github.com/octokit/webhooks/tree/main/payload-examples/api.github.com
+var EventPayloadExamples = map[string]string{
+	"pull_request":        "{\"action\":\"opened\",\"number\":2,\"pull_request\":{\"labels\":[{\"name\":\"bug\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/labels/bug\"}],\"title\":\"Update the `README` with new information.\"}}",
+	"pull_request_target": "{\"action\":\"opened\",\"number\":2,\"pull_request\":{\"labels\":[{\"name\":\"bug\",\"url\":\"https://api.github.com/repos/Codertocat/Hello-World/labels/bug\"}],\"title\":\"Update the `README` with new information.\"}}",
+	"push":                "{\"after\":\"0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c\",\"ref\":\"refs/heads/main\",\"repository\":{\"full_name\":\"Codertocat/Hello-World\",\"html_url\":\"https://github.com/Codertocat/Hello-World\"}}",
+}
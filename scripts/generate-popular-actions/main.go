@@ -38,6 +38,19 @@ type registry struct {
 	// may or may not exist. And they are not listed in action.yml metadata. actionlint cannot check
 	// such outputs and fallback into allowing to set any outputs. (#18)
 	SkipOutputs bool `json:"skip_outputs"`
+	// Inputs renamed at the latest version. Keys are old input names and values are new input names.
+	// action.yml has no field to describe renames so they need to be maintained manually.
+	RenamedInputs map[string]string `json:"renamed_inputs"`
+	// Inputs which require a personal access token. Keys are input names and values are events
+	// which are not triggered when `secrets.GITHUB_TOKEN` is given to the inputs.
+	PATInputs map[string]string `json:"pat_inputs"`
+	// Events which can trigger workflows where the action is meaningful. For example, actions/stale
+	// only works on "schedule" and "workflow_dispatch" events. action.yml has no field for it.
+	Triggers []string `json:"triggers"`
+}
+
+func (r *registry) latest(tag string) bool {
+	return len(r.Tags) > 0 && r.Tags[len(r.Tags)-1] == tag
 }
 
 func (r *registry) rawURL(tag string) string {
@@ -148,6 +161,21 @@ func (g *gen) fetchRemote() (map[string]*actionlint.ActionMetadata, error) {
 					if req.action.SkipOutputs {
 						meta.SkipOutputs = true
 					}
+					if len(req.action.RenamedInputs) > 0 && req.action.latest(req.tag) {
+						meta.RenamedInputs = make(map[string]string, len(req.action.RenamedInputs))
+						for from, to := range req.action.RenamedInputs {
+							meta.RenamedInputs[strings.ToLower(from)] = to
+						}
+					}
+					if len(req.action.PATInputs) > 0 {
+						meta.PATInputs = make(map[string]string, len(req.action.PATInputs))
+						for id, ev := range req.action.PATInputs {
+							meta.PATInputs[strings.ToLower(id)] = ev
+						}
+					}
+					if len(req.action.Triggers) > 0 {
+						meta.Triggers = req.action.Triggers
+					}
 					ret <- &fetched{spec: spec, meta: &meta}
 				case <-done:
 					return
@@ -214,15 +242,47 @@ func (g *gen) writeJSONL(out io.Writer, actions map[string]*actionlint.ActionMet
 	return nil
 }
 
+// compactActionMetadata is metadata of action encoded in the generated Go source. Empty fields are
+// omitted to make the data set compact.
+type compactActionMetadata struct {
+	Name          string                           `json:"name"`
+	Inputs        actionlint.ActionMetadataInputs  `json:"inputs,omitempty"`
+	Outputs       actionlint.ActionMetadataOutputs `json:"outputs,omitempty"`
+	RenamedInputs map[string]string                `json:"renamed_inputs,omitempty"`
+	PATInputs     map[string]string                `json:"pat_inputs,omitempty"`
+	Triggers      []string                         `json:"triggers,omitempty"`
+	SkipInputs    bool                             `json:"skip_inputs,omitempty"`
+	SkipOutputs   bool                             `json:"skip_outputs,omitempty"`
+}
+
+func newCompactActionMetadata(meta *actionlint.ActionMetadata) *compactActionMetadata {
+	c := &compactActionMetadata{
+		Name:        meta.Name,
+		Triggers:    meta.Triggers,
+		SkipInputs:  meta.SkipInputs,
+		SkipOutputs: meta.SkipOutputs,
+	}
+	if !meta.SkipInputs {
+		c.Inputs = meta.Inputs
+		c.RenamedInputs = meta.RenamedInputs
+		c.PATInputs = meta.PATInputs
+	}
+	if !meta.SkipOutputs {
+		c.Outputs = meta.Outputs
+	}
+	return c
+}
+
 func (g *gen) writeGo(out io.Writer, actions map[string]*actionlint.ActionMetadata) error {
 	b := &bytes.Buffer{}
 	fmt.Fprint(b, `// Code generated by actionlint/scripts/generate-popular-actions. DO NOT EDIT.
 
 package actionlint
 
-// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of actions
-// and values are their metadata.
-var PopularActions = map[string]*ActionMetadata{
+// popularActionsJSON is data set of known popular actions encoded in JSON. Keys are specs
+// (owner/repo@ref) of actions and values are their metadata. It is decoded by PopularActions on
+// the first call. Each action is put in one line to make the diff of updates readable.
+const popularActionsJSON = "{" +
 `)
 
 	specs := make([]string, 0, len(actions))
@@ -232,6 +292,7 @@ var PopularActions = map[string]*ActionMetadata{
 	sort.Strings(specs)
 
 	outdated := []string{}
+	sep := ""
 	for _, spec := range specs {
 		meta := actions[spec]
 		if isOutdatedRunner(meta.Runs.Using) {
@@ -239,51 +300,22 @@ var PopularActions = map[string]*ActionMetadata{
 			continue
 		}
 
-		fmt.Fprintf(b, "%q: {\n", spec)
-		fmt.Fprintf(b, "Name: %q,\n", meta.Name)
-
-		if meta.SkipInputs {
-			fmt.Fprintf(b, "SkipInputs: true,\n")
-		}
-
-		if len(meta.Inputs) > 0 && !meta.SkipInputs {
-			ids := make([]string, 0, len(meta.Inputs))
-			for n := range meta.Inputs {
-				ids = append(ids, n)
-			}
-			sort.Strings(ids)
-
-			fmt.Fprintf(b, "Inputs: ActionMetadataInputs{\n")
-			for _, id := range ids {
-				i := meta.Inputs[id]
-				fmt.Fprintf(b, "%q: {%q, %v},\n", id, i.Name, i.Required)
-			}
-			fmt.Fprintf(b, "},\n")
-		}
-
-		if meta.SkipOutputs {
-			fmt.Fprintf(b, "SkipOutputs: true,\n")
+		var e bytes.Buffer
+		enc := json.NewEncoder(&e)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(newCompactActionMetadata(meta)); err != nil {
+			return fmt.Errorf("could not encode action %q data into JSON: %w", spec, err)
 		}
-
-		if len(meta.Outputs) > 0 && !meta.SkipOutputs {
-			ids := make([]string, 0, len(meta.Outputs))
-			for n := range meta.Outputs {
-				ids = append(ids, n)
-			}
-			sort.Strings(ids)
-
-			fmt.Fprintf(b, "Outputs: ActionMetadataOutputs{\n")
-			for _, id := range ids {
-				o := meta.Outputs[id]
-				fmt.Fprintf(b, "%q: {%q},\n", id, o.Name)
-			}
-			fmt.Fprintf(b, "},\n")
+		k, err := json.Marshal(spec)
+		if err != nil {
+			return fmt.Errorf("could not encode action spec %q into JSON: %w", spec, err)
 		}
 
-		fmt.Fprintf(b, "},\n")
+		fmt.Fprintf(b, "%q +\n", sep+string(k)+":"+strings.TrimSuffix(e.String(), "\n"))
+		sep = ","
 	}
 
-	fmt.Fprintln(b, "}")
+	fmt.Fprint(b, "\"}\"\n\n")
 
 	fmt.Fprintln(b, `// OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
 // means that the runner used by the action is no longer available such as "node12".
@@ -65,6 +65,9 @@ func TestReadWriteJSONL(t *testing.T) {
 		"test.jsonl",
 		"skip_inputs.jsonl",
 		"skip_outputs.jsonl",
+		"deprecated_inputs.jsonl",
+		"pat_inputs.jsonl",
+		"triggers.jsonl",
 	}
 
 	for _, file := range files {
@@ -109,6 +112,18 @@ func TestWriteGoToStdout(t *testing.T) {
 			in:   "skip_outputs.jsonl",
 			want: "skip_outputs_want.go",
 		},
+		{
+			in:   "deprecated_inputs.jsonl",
+			want: "deprecated_inputs_want.go",
+		},
+		{
+			in:   "pat_inputs.jsonl",
+			want: "pat_inputs_want.go",
+		},
+		{
+			in:   "triggers.jsonl",
+			want: "triggers_want.go",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,32 +2,13 @@
 
 package actionlint
 
-// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of actions
-// and values are their metadata.
-var PopularActions = map[string]*ActionMetadata{
-	"rhysd/action-setup-vim@v1.3.2": {
-		Name: "Setup Vim",
-		Inputs: ActionMetadataInputs{
-			"configure-args": {"configure-args", false},
-			"neovim":         {"neovim", false},
-			"token":          {"token", false},
-			"version":        {"version", false},
-		},
-		Outputs: ActionMetadataOutputs{
-			"executable": {"executable"},
-		},
-	},
-	"rhysd/changelog-from-release/action@v2.2.2": {
-		Name: "Run changelog-from-release",
-		Inputs: ActionMetadataInputs{
-			"commit":       {"commit", false},
-			"file":         {"file", true},
-			"github_token": {"github_token", true},
-			"push":         {"push", false},
-			"version":      {"version", false},
-		},
-	},
-}
+// popularActionsJSON is data set of known popular actions encoded in JSON. Keys are specs
+// (owner/repo@ref) of actions and values are their metadata. It is decoded by PopularActions on
+// the first call. Each action is put in one line to make the diff of updates readable.
+const popularActionsJSON = "{" +
+	"\"rhysd/action-setup-vim@v1.3.2\":{\"name\":\"Setup Vim\",\"inputs\":{\"configure-args\":{\"name\":\"configure-args\",\"required\":false},\"neovim\":{\"name\":\"neovim\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}},\"outputs\":{\"executable\":{\"name\":\"executable\"}}}" +
+	",\"rhysd/changelog-from-release/action@v2.2.2\":{\"name\":\"Run changelog-from-release\",\"inputs\":{\"commit\":{\"name\":\"commit\",\"required\":false},\"file\":{\"name\":\"file\",\"required\":true},\"github_token\":{\"name\":\"github_token\",\"required\":true},\"push\":{\"name\":\"push\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}}}" +
+	"}"
 
 // OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
 // means that the runner used by the action is no longer available such as "node12".
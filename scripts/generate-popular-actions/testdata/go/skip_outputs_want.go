@@ -2,19 +2,12 @@
 
 package actionlint
 
-// PopularActions is data set of known popular actions. Keys are specs (owner/repo@ref) of actions
-// and values are their metadata.
-var PopularActions = map[string]*ActionMetadata{
-	"rhysd/action-setup-vim@v1": {
-		Name: "Setup Vim",
-		Inputs: ActionMetadataInputs{
-			"neovim":  {"neovim", false},
-			"token":   {"token", false},
-			"version": {"version", false},
-		},
-		SkipOutputs: true,
-	},
-}
+// popularActionsJSON is data set of known popular actions encoded in JSON. Keys are specs
+// (owner/repo@ref) of actions and values are their metadata. It is decoded by PopularActions on
+// the first call. Each action is put in one line to make the diff of updates readable.
+const popularActionsJSON = "{" +
+	"\"rhysd/action-setup-vim@v1\":{\"name\":\"Setup Vim\",\"inputs\":{\"neovim\":{\"name\":\"neovim\",\"required\":false},\"token\":{\"name\":\"token\",\"required\":false},\"version\":{\"name\":\"version\",\"required\":false}},\"skip_outputs\":true}" +
+	"}"
 
 // OutdatedPopularActionSpecs is a spec set of known outdated popular actions. The word 'outdated'
 // means that the runner used by the action is no longer available such as "node12".
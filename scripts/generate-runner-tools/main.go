@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var dbg = log.New(io.Discard, "", log.LstdFlags)
+
+//go:embed tools.json
+var defaultToolsJSON []byte
+
+var (
+	reListItem = regexp.MustCompile(`^\s*[-*]\s+(.+)$`)
+	reTableRow = regexp.MustCompile(`^\s*\|(.+)\|\s*$`)
+)
+
+var oses = map[string]struct{}{
+	"linux":   {},
+	"macos":   {},
+	"windows": {},
+}
+
+type image struct {
+	Label  string `json:"label"`
+	OS     string `json:"os"`
+	Readme string `json:"readme"`
+}
+
+type tool struct {
+	Commands []string `json:"commands"`
+	System   []string `json:"system"`
+	Software []string `json:"software"`
+}
+
+type config struct {
+	Images []*image `json:"images"`
+	Tools  []*tool  `json:"tools"`
+}
+
+func parseConfig(src []byte) (*config, error) {
+	var c config
+	if err := json.Unmarshal(src, &c); err != nil {
+		return nil, fmt.Errorf("could not parse the tools file as JSON: %w", err)
+	}
+
+	labels := map[string]struct{}{}
+	for _, i := range c.Images {
+		if i.Label == "" || i.Readme == "" {
+			return nil, fmt.Errorf("\"label\" and \"readme\" must not be empty in image %q", i.Label)
+		}
+		if _, ok := oses[i.OS]; !ok {
+			return nil, fmt.Errorf("unknown OS %q of image %q. valid OSes are \"linux\", \"macos\", and \"windows\"", i.OS, i.Label)
+		}
+		if _, ok := labels[i.Label]; ok {
+			return nil, fmt.Errorf("image %q is duplicated", i.Label)
+		}
+		labels[i.Label] = struct{}{}
+	}
+
+	cmds := map[string]struct{}{}
+	for _, t := range c.Tools {
+		if len(t.Commands) == 0 {
+			return nil, fmt.Errorf("\"commands\" must not be empty in tool %v", t.Software)
+		}
+		if (len(t.System) == 0) == (len(t.Software) == 0) {
+			return nil, fmt.Errorf("exactly one of \"system\" or \"software\" must be set in tool %q", t.Commands[0])
+		}
+		for _, o := range t.System {
+			if _, ok := oses[o]; !ok {
+				return nil, fmt.Errorf("unknown OS %q at \"system\" of tool %q. valid OSes are \"linux\", \"macos\", and \"windows\"", o, t.Commands[0])
+			}
+		}
+		for _, n := range t.Commands {
+			if _, ok := cmds[n]; ok {
+				return nil, fmt.Errorf("command %q is duplicated", n)
+			}
+			cmds[n] = struct{}{}
+		}
+	}
+
+	return &c, nil
+}
+
+// parseSoftware extracts the names with versions of software listed in the software report of
+// runner image like "Docker Client 24.0.9". The software report lists software as list items or
+// rows of tables.
+func parseSoftware(src []byte) []string {
+	ret := []string{}
+	for _, l := range strings.Split(string(src), "\n") {
+		l = strings.TrimRight(l, "\r")
+		if m := reListItem.FindStringSubmatch(l); m != nil {
+			ret = append(ret, strings.TrimSpace(m[1]))
+			continue
+		}
+		if m := reTableRow.FindStringSubmatch(l); m != nil {
+			cells := strings.Split(m[1], "|")
+			for i, c := range cells {
+				cells[i] = strings.TrimSpace(c)
+			}
+			ret = append(ret, strings.Join(cells, " "))
+		}
+	}
+	return ret
+}
+
+func isListed(software []string, names []string) bool {
+	for _, n := range names {
+		r := regexp.MustCompile(`(?i)^` + regexp.QuoteMeta(n) + `(?:\s+v?\d|$)`)
+		for _, s := range software {
+			if r.MatchString(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func contains(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func generate(c *config, readmes map[string][]byte, out io.Writer) error {
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, `// Code generated by actionlint/scripts/generate-runner-tools. DO NOT EDIT.
+
+package actionlint
+
+// RunnerImageTools is the inventory of tools preinstalled on GitHub-hosted runner images. Keys of
+// the Tools field are commands and values are whether the commands are preinstalled on the image.
+// This variable was generated by script at ./scripts/generate-runner-tools based on the software
+// reports of runner images in https://github.com/actions/runner-images
+var RunnerImageTools = []*RunnerImage{
+`)
+	for _, i := range c.Images {
+		sw := parseSoftware(readmes[i.Label])
+		dbg.Printf("Found %d software in the report of %s", len(sw), i.Label)
+
+		tools := map[string]bool{}
+		for _, t := range c.Tools {
+			var ok bool
+			if len(t.System) > 0 {
+				ok = contains(t.System, i.OS)
+			} else {
+				ok = isListed(sw, t.Software)
+			}
+			for _, n := range t.Commands {
+				tools[n] = ok
+			}
+		}
+		cmds := make([]string, 0, len(tools))
+		for n := range tools {
+			cmds = append(cmds, n)
+		}
+		sort.Strings(cmds)
+
+		fmt.Fprintln(buf, "{")
+		fmt.Fprintf(buf, "Label: %q,\n", i.Label)
+		fmt.Fprintf(buf, "OS: %q,\n", i.OS)
+		fmt.Fprintf(buf, "URL: %q,\n", "https://github.com/actions/runner-images/blob/main/"+i.Readme)
+		fmt.Fprintln(buf, "Tools: map[string]bool{")
+		for _, n := range cmds {
+			fmt.Fprintf(buf, "%q: %v,\n", n, tools[n])
+		}
+		fmt.Fprintln(buf, "},")
+		fmt.Fprintln(buf, "},")
+	}
+	fmt.Fprintln(buf, "}")
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format Go source: %w", err)
+	}
+	if _, err := out.Write(b); err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+	return nil
+}
+
+func fetch(url string) ([]byte, error) {
+	var c http.Client
+
+	dbg.Println("Fetching software report from URL:", url)
+
+	res, err := c.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch %s: %w", url, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || 300 <= res.StatusCode {
+		return nil, fmt.Errorf("request was not successful for %s: %s", url, res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch body for %s: %w", url, err)
+	}
+
+	dbg.Printf("Fetched %d bytes from %s", len(body), url)
+	return body, nil
+}
+
+// readmes reads the software reports of the images. When srcdir is empty, they are fetched from
+// the baseURL.
+func readmes(c *config, srcdir, baseURL string) (map[string][]byte, error) {
+	ret := make(map[string][]byte, len(c.Images))
+	for _, i := range c.Images {
+		var b []byte
+		var err error
+		if srcdir != "" {
+			b, err = os.ReadFile(filepath.Join(srcdir, filepath.FromSlash(i.Readme)))
+		} else {
+			b, err = fetch(baseURL + i.Readme)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read software report of image %q: %w", i.Label, err)
+		}
+		ret[i.Label] = b
+	}
+	return ret, nil
+}
+
+func run(args []string, stdout, stderr, dbgout io.Writer, baseURL string) int {
+	dbg.SetOutput(dbgout)
+
+	if len(args) > 2 {
+		fmt.Fprintln(stderr, "usage: generate-runner-tools [[srcdir] dstfile]")
+		return 1
+	}
+
+	dbg.Println("Start generate-runner-tools script")
+
+	c, err := parseConfig(defaultToolsJSON)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	srcdir := ""
+	if len(args) == 2 {
+		srcdir = args[0]
+	}
+	rs, err := readmes(c, srcdir, baseURL)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	var out io.Writer
+	var dst string
+	if len(args) == 0 || args[len(args)-1] == "-" {
+		out = stdout
+		dst = "stdout"
+	} else {
+		n := args[len(args)-1]
+		f, err := os.Create(n)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+		dst = n
+	}
+
+	if err := generate(c, rs, out); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	dbg.Println("Wrote output to", dst)
+	dbg.Println("Done generate-runner-tools script successfully")
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr, os.Stderr, "https://raw.githubusercontent.com/actions/runner-images/main/"))
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testRunMain(args []string, baseURL string) (string, string, int) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	status := run(args, stdout, stderr, io.Discard, baseURL)
+	return stdout.String(), stderr.String(), status
+}
+
+func readOKOutput(t *testing.T) string {
+	b, err := os.ReadFile(filepath.Join("testdata", "ok.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestOKWriteStdout(t *testing.T) {
+	stdout, stderr, status := testRunMain([]string{filepath.Join("testdata", "ok"), "-"}, "")
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	if want := readOKOutput(t); stdout != want {
+		t.Fatal(cmp.Diff(want, stdout))
+	}
+}
+
+func TestOKWriteFile(t *testing.T) {
+	out := filepath.Join("testdata", "_test_output.go")
+	defer os.Remove(out)
+
+	stdout, stderr, status := testRunMain([]string{filepath.Join("testdata", "ok"), out}, "")
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	if stdout != "" {
+		t.Fatalf("stdout is not empty: %q", stdout)
+	}
+
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("output file %q cannot be read: %v", out, err)
+	}
+	if want, have := readOKOutput(t), string(b); want != have {
+		t.Fatal(cmp.Diff(want, have))
+	}
+}
+
+func TestOKFetchFromURL(t *testing.T) {
+	ts := httptest.NewServer(http.FileServer(http.Dir(filepath.Join("testdata", "ok"))))
+	defer ts.Close()
+
+	stdout, stderr, status := testRunMain([]string{"-"}, ts.URL+"/")
+	if status != 0 {
+		t.Fatalf("status was non-zero: %d: %q", status, stderr)
+	}
+	if want := readOKOutput(t); stdout != want {
+		t.Fatal(cmp.Diff(want, stdout))
+	}
+}
+
+func TestErrorMissingReadme(t *testing.T) {
+	stdout, stderr, status := testRunMain([]string{filepath.Join("testdata", "missing"), "-"}, "")
+	if status == 0 {
+		t.Fatalf("status was zero: %q", stdout)
+	}
+	want := `could not read software report of image "windows-2022"`
+	if !strings.Contains(stderr, want) {
+		t.Fatalf("stderr %q does not contain %q", stderr, want)
+	}
+}
+
+func TestErrorFetchFromURL(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	stdout, stderr, status := testRunMain([]string{"-"}, ts.URL+"/")
+	if status == 0 {
+		t.Fatalf("status was zero: %q", stdout)
+	}
+	want := "request was not successful"
+	if !strings.Contains(stderr, want) {
+		t.Fatalf("stderr %q does not contain %q", stderr, want)
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	testCases := []struct {
+		what string
+		src  string
+		want string
+	}{
+		{
+			what: "broken",
+			src:  `{`,
+			want: "could not parse the tools file as JSON",
+		},
+		{
+			what: "unknown OS of image",
+			src:  `{"images": [{"label": "ubuntu-22.04", "os": "ubuntu", "readme": "Ubuntu2204-Readme.md"}]}`,
+			want: `unknown OS "ubuntu" of image "ubuntu-22.04"`,
+		},
+		{
+			what: "empty readme",
+			src:  `{"images": [{"label": "ubuntu-22.04", "os": "linux"}]}`,
+			want: `"label" and "readme" must not be empty in image "ubuntu-22.04"`,
+		},
+		{
+			what: "duplicate image",
+			src:  `{"images": [{"label": "ubuntu-22.04", "os": "linux", "readme": "a.md"}, {"label": "ubuntu-22.04", "os": "linux", "readme": "b.md"}]}`,
+			want: `image "ubuntu-22.04" is duplicated`,
+		},
+		{
+			what: "no command",
+			src:  `{"tools": [{"software": ["Docker"]}]}`,
+			want: `"commands" must not be empty in tool [Docker]`,
+		},
+		{
+			what: "both system and software",
+			src:  `{"tools": [{"commands": ["docker"], "system": ["linux"], "software": ["Docker"]}]}`,
+			want: `exactly one of "system" or "software" must be set in tool "docker"`,
+		},
+		{
+			what: "unknown OS of system tool",
+			src:  `{"tools": [{"commands": ["apt"], "system": ["ubuntu"]}]}`,
+			want: `unknown OS "ubuntu" at "system" of tool "apt"`,
+		},
+		{
+			what: "duplicate command",
+			src:  `{"tools": [{"commands": ["docker"], "software": ["Docker"]}, {"commands": ["docker"], "software": ["Docker Client"]}]}`,
+			want: `command "docker" is duplicated`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := parseConfig([]byte(tc.src))
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("error %q does not contain %q", err.Error(), tc.want)
+			}
+		})
+	}
+}
+
+func TestDefaultConfig(t *testing.T) {
+	if _, err := parseConfig(defaultToolsJSON); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseSoftware(t *testing.T) {
+	src := "# Title\n- OS Version: 22.04\n\n### Tools\n- Docker Client 26.1.3\n* Podman 3.4.4\r\n| Name | Version |\n| Vagrant | 2.4.1 |\n"
+	want := []string{"OS Version: 22.04", "Docker Client 26.1.3", "Podman 3.4.4", "Name Version", "Vagrant 2.4.1"}
+	if have := parseSoftware([]byte(src)); !cmp.Equal(want, have) {
+		t.Fatal(cmp.Diff(want, have))
+	}
+
+	if !isListed(want, []string{"docker client"}) {
+		t.Error("Docker Client should be listed")
+	}
+	if isListed([]string{"Docker Compose v2 2.27.1", "Docker-Buildx 0.15.1"}, []string{"Docker"}) {
+		t.Error("Docker should not be listed by other software whose names start with Docker")
+	}
+}
+
+func TestTooManyArgs(t *testing.T) {
+	_, stderr, status := testRunMain([]string{"a", "b", "c"}, "")
+	if status == 0 {
+		t.Fatal("status was zero")
+	}
+	if !strings.Contains(stderr, "usage: generate-runner-tools [[srcdir] dstfile]") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
@@ -0,0 +1,157 @@
+// Code generated by actionlint/scripts/generate-runner-tools. DO NOT EDIT.
+
+package actionlint
+
+// RunnerImageTools is the inventory of tools preinstalled on GitHub-hosted runner images. Keys of
+// the Tools field are commands and values are whether the commands are preinstalled on the image.
+// This variable was generated by script at ./scripts/generate-runner-tools based on the software
+// reports of runner images in https://github.com/actions/runner-images
+var RunnerImageTools = []*RunnerImage{
+	{
+		Label: "ubuntu-20.04",
+		OS:    "linux",
+		URL:   "https://github.com/actions/runner-images/blob/main/images/ubuntu/Ubuntu2004-Readme.md",
+		Tools: map[string]bool{
+			"VBoxManage": false,
+			"apt":        true,
+			"apt-get":    true,
+			"brew":       true,
+			"buildah":    true,
+			"choco":      false,
+			"colima":     false,
+			"docker":     true,
+			"dpkg":       true,
+			"podman":     true,
+			"skopeo":     true,
+			"vagrant":    false,
+			"xcodebuild": false,
+			"xcrun":      false,
+		},
+	},
+	{
+		Label: "ubuntu-22.04",
+		OS:    "linux",
+		URL:   "https://github.com/actions/runner-images/blob/main/images/ubuntu/Ubuntu2204-Readme.md",
+		Tools: map[string]bool{
+			"VBoxManage": false,
+			"apt":        true,
+			"apt-get":    true,
+			"brew":       true,
+			"buildah":    true,
+			"choco":      false,
+			"colima":     false,
+			"docker":     true,
+			"dpkg":       true,
+			"podman":     true,
+			"skopeo":     true,
+			"vagrant":    false,
+			"xcodebuild": false,
+			"xcrun":      false,
+		},
+	},
+	{
+		Label: "macos-12",
+		OS:    "macos",
+		URL:   "https://github.com/actions/runner-images/blob/main/images/macos/macos-12-Readme.md",
+		Tools: map[string]bool{
+			"VBoxManage": true,
+			"apt":        false,
+			"apt-get":    false,
+			"brew":       true,
+			"buildah":    false,
+			"choco":      false,
+			"colima":     true,
+			"docker":     false,
+			"dpkg":       false,
+			"podman":     false,
+			"skopeo":     false,
+			"vagrant":    true,
+			"xcodebuild": true,
+			"xcrun":      true,
+		},
+	},
+	{
+		Label: "macos-13",
+		OS:    "macos",
+		URL:   "https://github.com/actions/runner-images/blob/main/images/macos/macos-13-Readme.md",
+		Tools: map[string]bool{
+			"VBoxManage": false,
+			"apt":        false,
+			"apt-get":    false,
+			"brew":       true,
+			"buildah":    false,
+			"choco":      false,
+			"colima":     false,
+			"docker":     false,
+			"dpkg":       false,
+			"podman":     false,
+			"skopeo":     false,
+			"vagrant":    false,
+			"xcodebuild": true,
+			"xcrun":      true,
+		},
+	},
+	{
+		Label: "macos-14",
+		OS:    "macos",
+		URL:   "https://github.com/actions/runner-images/blob/main/images/macos/macos-14-arm64-Readme.md",
+		Tools: map[string]bool{
+			"VBoxManage": false,
+			"apt":        false,
+			"apt-get":    false,
+			"brew":       true,
+			"buildah":    false,
+			"choco":      false,
+			"colima":     false,
+			"docker":     false,
+			"dpkg":       false,
+			"podman":     false,
+			"skopeo":     false,
+			"vagrant":    false,
+			"xcodebuild": true,
+			"xcrun":      true,
+		},
+	},
+	{
+		Label: "windows-2019",
+		OS:    "windows",
+		URL:   "https://github.com/actions/runner-images/blob/main/images/windows/Windows2019-Readme.md",
+		Tools: map[string]bool{
+			"VBoxManage": false,
+			"apt":        false,
+			"apt-get":    false,
+			"brew":       false,
+			"buildah":    false,
+			"choco":      true,
+			"colima":     false,
+			"docker":     true,
+			"dpkg":       false,
+			"podman":     false,
+			"skopeo":     false,
+			"vagrant":    false,
+			"xcodebuild": false,
+			"xcrun":      false,
+		},
+	},
+	{
+		Label: "windows-2022",
+		OS:    "windows",
+		URL:   "https://github.com/actions/runner-images/blob/main/images/windows/Windows2022-Readme.md",
+		Tools: map[string]bool{
+			"VBoxManage": false,
+			"apt":        false,
+			"apt-get":    false,
+			"brew":       false,
+			"buildah":    false,
+			"choco":      true,
+			"colima":     false,
+			"docker":     true,
+			"dpkg":       false,
+			"podman":     false,
+			"skopeo":     false,
+			"vagrant":    false,
+			"xcodebuild": false,
+			"xcrun":      false,
+		},
+	},
+}
@@ -0,0 +1,206 @@
+package actionlint
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var reSelector = regexp.MustCompile(`^jobs\.([a-zA-Z_][a-zA-Z0-9_-]*)(?:\.steps\[(\d+)\])?$`)
+
+// Selector selects a subtree of a workflow such as a job or a step. Its syntax is "jobs.<job_id>"
+// for a job and "jobs.<job_id>.steps[<index>]" for a step. The index of step is 0-based.
+type Selector struct {
+	// Job is an ID of the selected job.
+	Job string
+	// Step is a 0-based index of the selected step in the job. This value is -1 when the whole job
+	// is selected.
+	Step int
+}
+
+// ParseSelector parses the string as Selector. See the document of Selector for the syntax.
+func ParseSelector(s string) (*Selector, error) {
+	m := reSelector.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("invalid selector %q. it must be in the form of \"jobs.<job_id>\" or \"jobs.<job_id>.steps[<index>]\"", s)
+	}
+	sel := &Selector{Job: m[1], Step: -1}
+	if m[2] != "" {
+		i, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid index of step in selector %q: %w", s, err)
+		}
+		sel.Step = i
+	}
+	return sel, nil
+}
+
+func (s *Selector) String() string {
+	if s.Step < 0 {
+		return "jobs." + s.Job
+	}
+	return fmt.Sprintf("jobs.%s.steps[%d]", s.Job, s.Step)
+}
+
+// Select finds the subtree in the workflow and returns the range of lines of the subtree. The src
+// parameter is the source of the workflow. An error is returned when the subtree is not found.
+func (s *Selector) Select(w *Workflow, src []byte) (*Selection, error) {
+	job, ok := w.Jobs[strings.ToLower(s.Job)]
+	if !ok {
+		return nil, fmt.Errorf("job %q selected by %q is not found in the workflow", s.Job, s)
+	}
+
+	pos, indent := job.Pos, job.Pos.Col
+	if s.Step >= 0 {
+		if s.Step >= len(job.Steps) {
+			return nil, fmt.Errorf("step %d selected by %q is not found in job %q which has %d steps", s.Step, s, s.Job, len(job.Steps))
+		}
+		// Keys of the step mapping are put at the same column as the step position
+		pos = job.Steps[s.Step].Pos
+		indent = pos.Col - 1
+	}
+
+	return &Selection{
+		Selector: s,
+		Line:     pos.Line,
+		EndLine:  subtreeEndLine(src, pos.Line, indent),
+	}, nil
+}
+
+// subtreeEndLine returns the last line of the YAML subtree starting at the line. Lines of the
+// subtree are indented deeper than the indent column. Empty lines and comment lines at the end of
+// the subtree are not included.
+func subtreeEndLine(src []byte, start, indent int) int {
+	end := start
+	for l := start + 1; ; l++ {
+		s, _, ok := lineRange(src, l)
+		if !ok {
+			return end
+		}
+		c := firstNonSpaceColumn(src, l)
+		if c == 0 || src[s+c-1] == '#' {
+			continue
+		}
+		if c <= indent {
+			return end
+		}
+		end = l
+	}
+}
+
+// SelectedExpr is an expression in the subtree selected by Selector.
+type SelectedExpr struct {
+	// Expr is the source of the expression. For "${{ }}" placeholders, it does not include "${{"
+	// and "}}".
+	Expr string
+	// Line is the 1-based line number of the expression.
+	Line int
+	// Type is the type of the expression resolved by the type checker.
+	Type ExprType
+	// WorkflowKey is the key of the workflow where the expression is placed like
+	// "jobs.<job_id>.steps.run".
+	WorkflowKey string
+}
+
+// Selection is a subtree of a workflow selected by Selector.
+type Selection struct {
+	// Selector is the selector which selected the subtree.
+	Selector *Selector
+	// Line is the 1-based line number where the subtree starts.
+	Line int
+	// EndLine is the 1-based line number where the subtree ends (inclusive).
+	EndLine int
+	// Exprs is expressions in the subtree with their resolved types.
+	Exprs []*SelectedExpr
+}
+
+// Contains returns if the line is in the subtree.
+func (s *Selection) Contains(line int) bool {
+	return s.Line <= line && line <= s.EndLine
+}
+
+// Filter returns the errors reported in the subtree.
+func (s *Selection) Filter(errs []*Error) []*Error {
+	ret := make([]*Error, 0, len(errs))
+	for _, err := range errs {
+		if s.Contains(err.Line) {
+			ret = append(ret, err)
+		}
+	}
+	return ret
+}
+
+func (s *Selection) addExprs(exprs []*inspectedExpr) {
+	for _, e := range exprs {
+		if e.src == "" || !s.Contains(e.line) {
+			continue
+		}
+		s.Exprs = append(s.Exprs, &SelectedExpr{
+			Expr:        strings.TrimSpace(e.src),
+			Line:        e.line,
+			Type:        e.types[e.root],
+			WorkflowKey: e.workflowKey,
+		})
+	}
+	sort.SliceStable(s.Exprs, func(i, j int) bool {
+		return s.Exprs[i].Line < s.Exprs[j].Line
+	})
+}
+
+// PrettyPrint prints the range of the subtree and the expressions in it with their types. Contexts
+// and special functions available at each workflow key are also printed.
+func (s *Selection) PrettyPrint(out io.Writer, path string) {
+	bold.Fprintf(out, "%s:%d-%d", path, s.Line, s.EndLine)
+	gray.Fprintf(out, " (selected by %s)\n", s.Selector)
+
+	keys := []string{}
+	exprs := map[string][]*SelectedExpr{}
+	for _, e := range s.Exprs {
+		if _, ok := exprs[e.WorkflowKey]; !ok {
+			keys = append(keys, e.WorkflowKey)
+		}
+		exprs[e.WorkflowKey] = append(exprs[e.WorkflowKey], e)
+	}
+
+	for _, k := range keys {
+		fmt.Fprintf(out, "  %s\n", k)
+		ctx, sp := WorkflowKeyAvailability(k)
+		if len(ctx) > 0 {
+			gray.Fprintf(out, "    contexts: %s\n", strings.Join(ctx, ", "))
+		}
+		if len(sp) > 0 {
+			gray.Fprintf(out, "    special functions: %s\n", strings.Join(sp, ", "))
+		}
+		for _, e := range exprs[k] {
+			ty := "any"
+			if e.Type != nil {
+				ty = e.Type.String()
+			}
+			fmt.Fprintf(out, "    %d: %s: ", e.Line, e.Expr)
+			green.Fprintln(out, ty)
+		}
+	}
+}
+
+// selections records the subtrees selected in each workflow file. It is shared by goroutines which
+// lint multiple files in parallel.
+type selections struct {
+	mu sync.Mutex
+	m  map[string]*Selection
+}
+
+func (s *selections) set(path string, sel *Selection) {
+	s.mu.Lock()
+	s.m[path] = sel
+	s.mu.Unlock()
+}
+
+func (s *selections) get(path string) *Selection {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m[path]
+}
@@ -0,0 +1,184 @@
+package actionlint
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSelectorParse(t *testing.T) {
+	testCases := []struct {
+		input string
+		job   string
+		step  int
+	}{
+		{"jobs.build", "build", -1},
+		{"jobs.build.steps[0]", "build", 0},
+		{"jobs.my-job_1.steps[12]", "my-job_1", 12},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			s, err := ParseSelector(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Job != tc.job || s.Step != tc.step {
+				t.Fatalf("wanted job %q and step %d but got %#v", tc.job, tc.step, s)
+			}
+			if s.String() != tc.input {
+				t.Fatalf("wanted %q but got %q", tc.input, s.String())
+			}
+		})
+	}
+}
+
+func TestSelectorParseError(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"jobs",
+		"jobs.",
+		"build",
+		"jobs.build.steps",
+		"jobs.build.steps[]",
+		"jobs.build.steps[-1]",
+		"jobs.build.steps.foo",
+		"on.push",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseSelector(input)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), "invalid selector") {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestSelectorSelect(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "select", "test.yaml"))
+	if err != nil {
+		panic(err)
+	}
+	w, errs := Parse(b)
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	testCases := []struct {
+		sel   string
+		start int
+		end   int
+	}{
+		{"jobs.build", 3, 15},
+		{"jobs.BUILD", 3, 15},
+		{"jobs.build.steps[0]", 9, 9},
+		{"jobs.build.steps[1]", 11, 12},
+		{"jobs.build.steps[2]", 14, 15},
+		{"jobs.other", 16, 19},
+		{"jobs.other.steps[0]", 19, 19},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sel, func(t *testing.T) {
+			s, err := ParseSelector(tc.sel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sel, err := s.Select(w, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sel.Line != tc.start || sel.EndLine != tc.end {
+				t.Fatalf("wanted lines %d-%d but got %d-%d", tc.start, tc.end, sel.Line, sel.EndLine)
+			}
+		})
+	}
+}
+
+func TestSelectorSelectNotFound(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "select", "test.yaml"))
+	if err != nil {
+		panic(err)
+	}
+	w, errs := Parse(b)
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	testCases := []struct {
+		sel  string
+		want string
+	}{
+		{"jobs.unknown", `job "unknown" selected by "jobs.unknown" is not found`},
+		{"jobs.build.steps[3]", `step 3 selected by "jobs.build.steps[3]" is not found in job "build" which has 3 steps`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sel, func(t *testing.T) {
+			s, err := ParseSelector(tc.sel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = s.Select(w, b)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("wanted %q in error but got %q", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestLinterSelectSubtree(t *testing.T) {
+	var out bytes.Buffer
+	l, err := NewLinter(&out, &LinterOptions{
+		Select:    "jobs.build.steps[2]",
+		LogWriter: io.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join("testdata", "select", "test.yaml")
+	errs, err := l.LintFile(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("wanted one error in the subtree but got %d errors: %v", len(errs), errs)
+	}
+	if !strings.Contains(errs[0].Message, `property "oops" is not defined`) {
+		t.Fatalf("unexpected error: %s", errs[0])
+	}
+
+	o := out.String()
+	for _, s := range []string{
+		":14-15 (selected by jobs.build.steps[2])",
+		"  jobs.<job_id>.steps.if\n",
+		"    special functions: always, cancelled, failure, hashfiles, success\n",
+		"    14: steps.foo.outputs.bar == 1: bool\n",
+		"  jobs.<job_id>.steps.run\n",
+		"    15: matrix.oops: any\n",
+	} {
+		if !strings.Contains(o, s) {
+			t.Errorf("output does not contain %q: %q", s, o)
+		}
+	}
+	if strings.Contains(o, "unknown.x") || strings.Contains(o, "matrix.os") {
+		t.Errorf("expressions outside the subtree are output: %q", o)
+	}
+}
+
+func TestLinterSelectInvalidSelector(t *testing.T) {
+	_, err := NewLinter(io.Discard, &LinterOptions{Select: "jobs"})
+	if err == nil {
+		t.Fatal("error did not occur")
+	}
+}
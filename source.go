@@ -0,0 +1,305 @@
+package actionlint
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SourceFile is a file read from other than the file system such as an archive or a Git object.
+type SourceFile struct {
+	// Path is a slash-separated file path relative to the root of the repository.
+	Path string
+	// Content is the content of the file.
+	Content []byte
+}
+
+// Sources is a set of workflow files read from other than the file system.
+type Sources struct {
+	// Files is a list of workflow files sorted by their paths.
+	Files []*SourceFile
+	// Config is a config read from ".github/actionlint.yaml" or ".github/actionlint.yml". This
+	// value is nil when no config file was found.
+	Config *Config
+}
+
+// isWorkflowPath returns true when the slash-separated path relative to the repository root is a
+// workflow file.
+func isWorkflowPath(p string) bool {
+	return strings.HasPrefix(p, ".github/workflows/") && (strings.HasSuffix(p, ".yml") || strings.HasSuffix(p, ".yaml"))
+}
+
+func isConfigPath(p string) bool {
+	return p == ".github/actionlint.yaml" || p == ".github/actionlint.yml"
+}
+
+// collector collects workflow files and config file from files in a repository.
+type collector struct {
+	srcs   *Sources
+	config *SourceFile
+	where  string
+}
+
+func newCollector(where string) *collector {
+	return &collector{srcs: &Sources{Files: []*SourceFile{}}, where: where}
+}
+
+// wants returns true when the file at the path should be read. When the path is not relative to
+// the repository root, the file is ignored.
+func (c *collector) wants(p string) bool {
+	return isWorkflowPath(p) || isConfigPath(p) && (c.config == nil || strings.HasSuffix(p, ".yaml"))
+}
+
+func (c *collector) add(p string, b []byte) {
+	f := &SourceFile{p, b}
+	if isConfigPath(p) {
+		c.config = f // ".github/actionlint.yaml" is preferred to ".github/actionlint.yml"
+	} else {
+		c.srcs.Files = append(c.srcs.Files, f)
+	}
+}
+
+func (c *collector) finish() (*Sources, error) {
+	if c.config != nil {
+		cfg, err := parseConfig(c.config.Content, c.where+":"+c.config.Path)
+		if err != nil {
+			return nil, err
+		}
+		c.srcs.Config = cfg
+	}
+	fs := c.srcs.Files
+	sort.Slice(fs, func(i, j int) bool { return fs[i].Path < fs[j].Path })
+	return c.srcs, nil
+}
+
+// archiveEntryPath returns the path of the entry relative to the repository root. Archives
+// downloaded from GitHub put all files in one top-level directory like "owner-repo-1234abc/".
+// The directory is stripped.
+func archiveEntryPath(name string, top *string) string {
+	p := path.Clean(strings.TrimPrefix(strings.ReplaceAll(name, "\\", "/"), "./"))
+	if *top == "" {
+		if strings.HasPrefix(p, ".github/") {
+			*top = "."
+		} else if i := strings.Index(p, "/.github/"); i > 0 && !strings.Contains(p[:i], "/") {
+			*top = p[:i]
+		}
+	}
+	if *top == "." {
+		return p
+	}
+	if *top != "" && strings.HasPrefix(p, *top+"/") {
+		return p[len(*top)+1:]
+	}
+	return p
+}
+
+// ReadArchive reads workflow files and actionlint config file from the archive of a repository.
+// Zip (.zip), tar (.tar), and gzipped tar (.tar.gz, .tgz) archives are supported. Files in the
+// archive can be put in one top-level directory like archives downloaded from GitHub.
+func ReadArchive(file string) (*Sources, error) {
+	c := newCollector(file)
+	var err error
+	switch {
+	case strings.HasSuffix(file, ".zip"):
+		err = readZipArchive(file, c)
+	case strings.HasSuffix(file, ".tar"):
+		err = readTarArchive(file, c, false)
+	case strings.HasSuffix(file, ".tar.gz") || strings.HasSuffix(file, ".tgz"):
+		err = readTarArchive(file, c, true)
+	default:
+		return nil, fmt.Errorf("unsupported archive format %q. only .zip, .tar, .tar.gz, and .tgz are supported", file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.finish()
+}
+
+func readZipArchive(file string, c *collector) error {
+	r, err := zip.OpenReader(file)
+	if err != nil {
+		return fmt.Errorf("could not open zip archive %q: %w", file, err)
+	}
+	defer r.Close()
+
+	top := ""
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		p := archiveEntryPath(f.Name, &top)
+		if !c.wants(p) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("could not open %q in zip archive %q: %w", f.Name, file, err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("could not read %q in zip archive %q: %w", f.Name, file, err)
+		}
+		c.add(p, b)
+	}
+	return nil
+}
+
+func readTarArchive(file string, c *collector, gz bool) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("could not open tar archive %q: %w", file, err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if gz {
+		z, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("could not decompress tar archive %q: %w", file, err)
+		}
+		defer z.Close()
+		r = z
+	}
+
+	t := tar.NewReader(r)
+	top := ""
+	for {
+		h, err := t.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read tar archive %q: %w", file, err)
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue // Skip directories, symbolic links, and "pax_global_header" entries
+		}
+		p := archiveEntryPath(h.Name, &top)
+		if !c.wants(p) {
+			continue
+		}
+		b, err := io.ReadAll(t)
+		if err != nil {
+			return fmt.Errorf("could not read %q in tar archive %q: %w", h.Name, file, err)
+		}
+		c.add(p, b)
+	}
+}
+
+// ReadGitRevision reads workflow files and actionlint config file from the revision of the Git
+// repository without checking out the revision. The gitDir parameter is a path to the Git directory
+// such as ".git" or a bare repository. When it is empty, the Git directory is detected by "git"
+// command (e.g. from $GIT_DIR). The rev parameter is a revision like "main" or a commit SHA. This
+// function runs "git" command so it must be installed. The ctx parameter is used to cancel the
+// running command.
+func ReadGitRevision(ctx context.Context, gitDir, rev string) (*Sources, error) {
+	if rev == "" {
+		rev = "HEAD"
+	}
+	paths, err := listGitFiles(ctx, gitDir, rev, ".github")
+	if err != nil {
+		return nil, err
+	}
+	c := newCollector(rev)
+	if err := readGitFiles(ctx, gitDir, rev, paths, c); err != nil {
+		return nil, err
+	}
+	return c.finish()
+}
+
+func gitCommand(ctx context.Context, gitDir string, args ...string) *exec.Cmd {
+	if gitDir != "" {
+		args = append([]string{"--git-dir", gitDir}, args...)
+	}
+	return exec.CommandContext(ctx, "git", args...)
+}
+
+func gitDirName(gitDir string) string {
+	if gitDir == "" {
+		return "."
+	}
+	return gitDir
+}
+
+// listGitFiles lists files under the paths at the revision.
+func listGitFiles(ctx context.Context, gitDir, rev string, paths ...string) ([]string, error) {
+	args := append([]string{"ls-tree", "-r", "-z", "--name-only", rev, "--"}, paths...)
+	out, err := gitCommand(ctx, gitDir, args...).Output()
+	if err != nil {
+		return nil, gitCommandError(ctx, err, fmt.Sprintf("could not list files of revision %q in Git directory %q", rev, gitDirName(gitDir)))
+	}
+	return strings.Split(strings.TrimSuffix(string(out), "\x00"), "\x00"), nil
+}
+
+// readGitFiles reads the files at the revision and adds the files wanted by the collector.
+func readGitFiles(ctx context.Context, gitDir, rev string, paths []string, c *collector) error {
+	wanted := []string{}
+	for _, p := range paths {
+		if c.wants(p) {
+			wanted = append(wanted, p)
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	// Read all blobs by one `git cat-file --batch` process
+	var in bytes.Buffer
+	for _, p := range wanted {
+		fmt.Fprintf(&in, "%s:%s\n", rev, p)
+	}
+	cmd := gitCommand(ctx, gitDir, "cat-file", "--batch")
+	cmd.Stdin = &in
+	out, err := cmd.Output()
+	if err != nil {
+		return gitCommandError(ctx, err, fmt.Sprintf("could not read files of revision %q in Git directory %q", rev, gitDirName(gitDir)))
+	}
+
+	r := bufio.NewReader(bytes.NewReader(out))
+	for _, p := range wanted {
+		// Header line is "<sha> <type> <size>"
+		h, err := r.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("could not read header of %q from output of `git cat-file`: %w", p, err)
+		}
+		fs := strings.Fields(h)
+		if len(fs) != 3 || fs[1] != "blob" {
+			continue // Submodule or missing object
+		}
+		n, err := strconv.Atoi(fs[2])
+		if err != nil {
+			return fmt.Errorf("invalid size of %q in output of `git cat-file`: %q", p, h)
+		}
+		b := make([]byte, n+1) // +1 for trailing newline
+		if _, err := io.ReadFull(r, b); err != nil {
+			return fmt.Errorf("could not read %q from output of `git cat-file`: %w", p, err)
+		}
+		c.add(p, b[:n])
+	}
+
+	return nil
+}
+
+func gitCommandError(ctx context.Context, err error, msg string) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("%s. git command was canceled: %w", msg, ctx.Err())
+	}
+	var exit *exec.ExitError
+	if errors.As(err, &exit) && len(exit.Stderr) > 0 {
+		return fmt.Errorf("%s: %s", msg, strings.TrimSpace(string(exit.Stderr)))
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,177 @@
+package actionlint
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var testSourceFiles = map[string]string{
+	"README.md":                         "# test",
+	".github/actionlint.yaml":           "self-hosted-runner:\n  labels: [my-runner]\n",
+	".github/workflows/ci.yaml":         "on: push\njobs:\n  test:\n    runs-on: my-runner\n    steps:\n      - run: echo ${{ foo }}\n",
+	".github/workflows/sub/release.yml": "on: push\njobs:\n  release:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+	".github/workflows/README.md":       "# workflows",
+}
+
+func testWriteArchive(t *testing.T, file, prefix string) {
+	t.Helper()
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if strings.HasSuffix(file, ".zip") {
+		w := zip.NewWriter(f)
+		for p, c := range testSourceFiles {
+			fw, err := w.Create(prefix + p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := io.WriteString(fw, c); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	var out io.Writer = f
+	if strings.HasSuffix(file, ".gz") || strings.HasSuffix(file, ".tgz") {
+		z := gzip.NewWriter(f)
+		defer z.Close()
+		out = z
+	}
+	w := tar.NewWriter(out)
+	if prefix != "" {
+		if err := w.WriteHeader(&tar.Header{Name: prefix, Typeflag: tar.TypeDir, Mode: 0755}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for p, c := range testSourceFiles {
+		if err := w.WriteHeader(&tar.Header{Name: prefix + p, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(c))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(w, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testCheckSources(t *testing.T, srcs *Sources) {
+	t.Helper()
+	paths := []string{}
+	for _, f := range srcs.Files {
+		paths = append(paths, f.Path)
+		if want := testSourceFiles[f.Path]; string(f.Content) != want {
+			t.Errorf("content of %q is unexpected. wanted %q but got %q", f.Path, want, f.Content)
+		}
+	}
+	want := []string{".github/workflows/ci.yaml", ".github/workflows/sub/release.yml"}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Errorf("wanted files %v but got %v", want, paths)
+	}
+	if srcs.Config == nil {
+		t.Fatal("config was not read")
+	}
+	if l := srcs.Config.SelfHostedRunner.Labels; len(l) != 1 || l[0] != "my-runner" {
+		t.Fatalf("unexpected config: %v", srcs.Config)
+	}
+}
+
+func TestSourceReadArchive(t *testing.T) {
+	for _, name := range []string{"repo.zip", "repo.tar", "repo.tar.gz", "repo.tgz"} {
+		for _, prefix := range []string{"", "./", "owner-repo-1234abc/"} {
+			t.Run(name+" "+prefix, func(t *testing.T) {
+				file := filepath.Join(t.TempDir(), name)
+				testWriteArchive(t, file, prefix)
+				srcs, err := ReadArchive(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				testCheckSources(t, srcs)
+			})
+		}
+	}
+}
+
+func TestSourceReadArchiveError(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := ReadArchive(filepath.Join(dir, "repo.rar")); err == nil || !strings.Contains(err.Error(), "unsupported archive format") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	broken := filepath.Join(dir, "broken.tar.gz")
+	if err := os.WriteFile(broken, []byte("this is not gzip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadArchive(broken); err == nil || !strings.Contains(err.Error(), "could not decompress tar archive") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSourceReadGitRevision(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git command is necessary to run this test")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		args = append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %s: %s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	for p, c := range testSourceFiles {
+		f := filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(f), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(f, []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git("add", "-A")
+	git("commit", "-q", "-m", "first")
+	git("tag", "v1")
+	// Changes after the tag must not be read
+	if err := os.WriteFile(filepath.Join(dir, ".github", "workflows", "new.yaml"), []byte("on: push"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "-A")
+	git("commit", "-q", "-m", "second")
+
+	gitDir := filepath.Join(dir, ".git")
+	srcs, err := ReadGitRevision(context.Background(), gitDir, "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCheckSources(t, srcs)
+
+	srcs, err = ReadGitRevision(context.Background(), gitDir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srcs.Files) != 3 {
+		t.Fatalf("HEAD should be read by default but got %d files", len(srcs.Files))
+	}
+
+	_, err = ReadGitRevision(context.Background(), gitDir, "this-revision-does-not-exist")
+	if err == nil || !strings.Contains(err.Error(), `could not list files of revision "this-revision-does-not-exist"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package actionlint
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// Comments to suppress errors like "# actionlint-disable-line expression -- reason"
+var reSuppressionComment = regexp.MustCompile(`^#\s*actionlint-(disable-next-line|disable-line|disable|enable)(?:\s+(.*))?$`)
+
+type suppressionRange struct {
+	line    int
+	disable bool
+	rules   []string
+}
+
+// suppressions is a set of errors suppressed by comments in a workflow file. The following comments
+// are supported. Each comment optionally takes rule names separated with commas or spaces. When no
+// rule is given, errors of all rules are suppressed. A description can follow " -- ".
+//
+//   - "# actionlint-disable" suppresses errors from the line until the end of file
+//   - "# actionlint-enable" stops suppressing errors of "actionlint-disable" comments
+//   - "# actionlint-disable-line" suppresses errors at the line
+//   - "# actionlint-disable-next-line" suppresses errors at the next line which is not empty nor a
+//     comment only line
+type suppressions struct {
+	ranges []*suppressionRange
+	lines  map[int][][]string
+}
+
+// parseSuppressions parses comments to suppress errors in the source. It returns nil when the
+// source has no such comment. Only YAML comments are parsed. Lines in block scalars such as scripts
+// at "run: |" are not comments even if they start with "#".
+func parseSuppressions(src []byte) (*suppressions, error) {
+	if !bytes.Contains(src, []byte("actionlint-")) {
+		return nil, nil
+	}
+
+	s := &suppressions{lines: map[int][][]string{}}
+	var next [][]string
+	block := -1 // Indentation of the parent of the current block scalar. -1 means outside block scalars
+	l := 0
+	sc := bufio.NewScanner(bytes.NewReader(src))
+	sc.Buffer(nil, len(src)+1)
+	for sc.Scan() {
+		l++
+		line := sc.Text()
+		t := strings.TrimSpace(line)
+
+		if block >= 0 {
+			if t == "" || len(line)-len(strings.TrimLeft(line, " ")) > block {
+				continue
+			}
+			block = -1
+		}
+
+		if t != "" && !strings.HasPrefix(t, "#") && len(next) > 0 {
+			s.lines[l] = append(s.lines[l], next...)
+			next = nil
+		}
+
+		if strings.ContainsAny(line, "|>") && reBlockScalarHeader.MatchString(line) {
+			block = blockScalarParentIndent([]byte(line))
+		}
+
+		i := yamlCommentIndex(line)
+		if i < 0 {
+			continue
+		}
+		m := reSuppressionComment.FindStringSubmatch(line[i:])
+		if m == nil {
+			continue
+		}
+		rules := suppressedRules(m[2])
+		switch m[1] {
+		case "disable":
+			s.ranges = append(s.ranges, &suppressionRange{l, true, rules})
+		case "enable":
+			s.ranges = append(s.ranges, &suppressionRange{l, false, rules})
+		case "disable-line":
+			s.lines[l] = append(s.lines[l], rules)
+		case "disable-next-line":
+			next = append(next, rules)
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(s.ranges) == 0 && len(s.lines) == 0 {
+		return nil, nil
+	}
+	return s, nil
+}
+
+// yamlCommentIndex returns the index of "#" starting a comment in the line. "#" in single-quoted and
+// double-quoted strings is not a comment. It returns -1 when the line has no comment.
+func yamlCommentIndex(line string) int {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if quote != 0 {
+			switch {
+			case c == '\\' && quote == '"':
+				i++ // Skip escaped character
+			case c == '\'' && quote == '\'' && i+1 < len(line) && line[i+1] == '\'':
+				i++ // Skip escaped quote ''
+			case c == quote:
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '#':
+			if i == 0 || line[i-1] == ' ' || line[i-1] == '\t' {
+				return i
+			}
+		case '\'', '"':
+			// Quotes start a string only at the beginning of a scalar. Quotes in plain scalars like
+			// `echo "foo"` are not special
+			p := strings.TrimRight(line[:i], " \t")
+			if p == "" || strings.ContainsRune(":-[{,?", rune(p[len(p)-1])) {
+				quote = c
+			}
+		}
+	}
+	return -1
+}
+
+func suppressedRules(s string) []string {
+	if i := strings.Index(s, "--"); i >= 0 {
+		s = s[:i] // Remove description
+	}
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+func containsRule(rules []string, kind string) bool {
+	if len(rules) == 0 {
+		return true // All rules
+	}
+	for _, r := range rules {
+		if r == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// suppresses returns whether the error is suppressed by the comments.
+func (s *suppressions) suppresses(err *Error) bool {
+	for _, rules := range s.lines[err.Line] {
+		if containsRule(rules, err.Kind) {
+			return true
+		}
+	}
+
+	line := err.Line
+	if line < 1 {
+		line = 1 // Errors without position are at the head of file
+	}
+	off := false
+	for _, r := range s.ranges {
+		if r.line > line {
+			break
+		}
+		if containsRule(r.rules, err.Kind) {
+			off = r.disable
+		}
+	}
+	return off
+}
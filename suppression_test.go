@@ -0,0 +1,158 @@
+package actionlint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSuppressionComments(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    # actionlint-disable-next-line runner-label -- Label of self-hosted runner
+    runs-on: linux-gpu
+    steps:
+      - run: echo ${{ unknown }} # actionlint-disable-line
+      # actionlint-disable-next-line expression
+      # this comment line is skipped
+
+      - run: echo ${{ unknown }}
+  # actionlint-disable expression,runner-label
+  build:
+    runs-on: linux-gpu
+  # actionlint-enable runner-label
+  deploy:
+    runs-on: linux-gpu
+  # actionlint-enable
+  release:
+    runs-on: linux-gpu
+`
+	s, err := parseSuppressions([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s == nil {
+		t.Fatal("no suppression was parsed")
+	}
+
+	testCases := []struct {
+		line int
+		kind string
+		want bool
+	}{
+		{5, "runner-label", true},
+		{5, "expression", false},
+		{7, "expression", true},
+		{7, "shellcheck", true},
+		{11, "expression", true},
+		{11, "shellcheck", false},
+		{14, "runner-label", true},
+		{14, "expression", true},
+		{14, "shellcheck", false},
+		{17, "runner-label", false},
+		{17, "expression", true},
+		{20, "expression", false},
+		{0, "syntax-check", false},
+	}
+
+	for _, tc := range testCases {
+		err := &Error{Line: tc.line, Column: 1, Kind: tc.kind}
+		if have := s.suppresses(err); have != tc.want {
+			t.Errorf("wanted %v for error of %q at line %d but got %v", tc.want, tc.kind, tc.line, have)
+		}
+	}
+}
+
+func TestSuppressionCommentsNotFound(t *testing.T) {
+	for _, src := range []string{
+		"on: push\n",
+		"on: push # actionlint-disabled\n",
+		"on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo 'actionlint-disable-line'\n",
+		"on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: 'echo # actionlint-disable-line'\n",
+		"on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: \"echo \\\" # actionlint-disable-line\"\n",
+		"on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: |\n          # actionlint-disable\n          echo ${{ unknown }}\n",
+		"on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: >-\n          echo hello\n\n          # actionlint-disable-next-line\n          echo ${{ unknown }}\n",
+	} {
+		s, err := parseSuppressions([]byte(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s != nil {
+			t.Errorf("suppression was parsed from %q: %#v", src, s)
+		}
+	}
+}
+
+func TestSuppressionCommentsAfterBlockScalar(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - run: | # actionlint-disable-line shellcheck
+          # actionlint-disable
+          echo ${{ unknown }}
+        # actionlint-disable-next-line expression
+        shell: ${{ unknown }}
+      - run: echo ${{ unknown }}
+`
+	s, err := parseSuppressions([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s == nil {
+		t.Fatal("no suppression was parsed")
+	}
+
+	testCases := []struct {
+		line int
+		kind string
+		want bool
+	}{
+		{6, "shellcheck", true},
+		{6, "expression", false},
+		{8, "expression", false},
+		{10, "expression", true},
+		{11, "expression", false},
+	}
+
+	for _, tc := range testCases {
+		err := &Error{Line: tc.line, Column: 1, Kind: tc.kind}
+		if have := s.suppresses(err); have != tc.want {
+			t.Errorf("wanted %v for error of %q at line %d but got %v", tc.want, tc.kind, tc.line, have)
+		}
+	}
+}
+
+func TestSuppressionCommentsLongLine(t *testing.T) {
+	src := "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo " + strings.Repeat("a", 100000) + "\n      # actionlint-disable-next-line\n      - run: echo ${{ unknown }}\n"
+	s, err := parseSuppressions([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s == nil || !s.suppresses(&Error{Line: 8, Column: 9, Kind: "expression"}) {
+		t.Fatalf("error after long line was not suppressed: %#v", s)
+	}
+}
+
+func TestSuppressionYAMLCommentIndex(t *testing.T) {
+	testCases := []struct {
+		line string
+		want int
+	}{
+		{"# comment", 0},
+		{"  run: echo hello # comment", 18},
+		{"  run: echo#hello", -1},
+		{"  run: 'echo # hello'", -1},
+		{"  run: 'it''s # not comment' # comment", 29},
+		{`  run: "echo \" # hello" # comment`, 25},
+		{`  run: echo "foo" # comment`, 18},
+		{`  - '# not comment'`, -1},
+	}
+
+	for _, tc := range testCases {
+		if have := yamlCommentIndex(tc.line); have != tc.want {
+			t.Errorf("wanted %d for %q but got %d", tc.want, tc.line, have)
+		}
+	}
+}
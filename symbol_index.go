@@ -0,0 +1,511 @@
+package actionlint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Names of jobs, steps, and inputs and outputs which can be given on rename.
+var reSymbolName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// Kinds of symbols in symbolIndex.
+const (
+	symbolKindJob            = "job"
+	symbolKindStep           = "step"
+	symbolKindWorkflow       = "reusable workflow"
+	symbolKindWorkflowInput  = "input of reusable workflow"
+	symbolKindWorkflowOutput = "output of reusable workflow"
+	symbolKindActionInput    = "input of action"
+)
+
+// indexedSymbol is a symbol which can be referred across files in a repository.
+type indexedSymbol struct {
+	kind string
+	// file is the absolute path of the workflow file defining the symbol. For inputs of local
+	// actions, it is the absolute path of the action's directory.
+	file string
+	// scope is the lower-case ID of the job where the step is defined. It is empty for other kinds.
+	scope string
+	// name is the lower-case name of the symbol since all of them are case-insensitive.
+	name string
+}
+
+// symbolOccurrence is a definition or a reference of a symbol in a file.
+type symbolOccurrence struct {
+	symbol indexedSymbol
+	path   string
+	line   int // 1-based
+	col    int // 1-based, counted in characters
+	text   string
+	def    bool
+}
+
+func (o *symbolOccurrence) contains(line, col int) bool {
+	return o.line == line && o.col <= col && col <= o.col+utf8.RuneCountInString(o.text)
+}
+
+type indexedFile struct {
+	stamp       string // Modified time and size of the file. Empty for documents opened in the client
+	text        []byte
+	occurrences []*symbolOccurrence
+	actions     []string // Directories of local actions used in the file
+}
+
+// symbolIndex is an index of jobs, step IDs, inputs and outputs of reusable workflows, and inputs
+// of local actions across workflow files in a repository. It is kept while the language server is
+// running and only the files changed since the last lookup are indexed again. Documents opened in
+// the client are indexed with their unsaved contents.
+type symbolIndex struct {
+	root  string
+	files map[string]*indexedFile
+}
+
+func newSymbolIndex(root string) *symbolIndex {
+	return &symbolIndex{root, map[string]*indexedFile{}}
+}
+
+// refresh updates the index with workflow files in the repository and local actions used by them.
+// The docs parameter is a map from file paths to the contents of documents opened in the client.
+func (idx *symbolIndex) refresh(docs map[string][]byte) {
+	paths := []string{}
+	if idx.root != "" {
+		dir := filepath.Join(idx.root, ".github", "workflows")
+		if es, err := os.ReadDir(dir); err == nil {
+			for _, e := range es {
+				n := e.Name()
+				if !e.IsDir() && (strings.HasSuffix(n, ".yml") || strings.HasSuffix(n, ".yaml")) {
+					paths = append(paths, filepath.Join(dir, n))
+				}
+			}
+		}
+	}
+	for p := range docs {
+		if !contains(paths, p) {
+			paths = append(paths, p)
+		}
+	}
+
+	seen := map[string]struct{}{}
+	dirs := []string{}
+	for _, p := range paths {
+		if isActionMetadataFile(p) {
+			dirs = append(dirs, filepath.Dir(p))
+			continue
+		}
+		if f := idx.update(p, docs, false); f != nil {
+			seen[p] = struct{}{}
+			dirs = append(dirs, f.actions...)
+		}
+	}
+	for _, d := range dirs {
+		for _, n := range []string{"action.yaml", "action.yml"} {
+			p := filepath.Join(d, n)
+			if _, ok := seen[p]; ok {
+				break
+			}
+			if idx.update(p, docs, true) != nil {
+				seen[p] = struct{}{}
+				break
+			}
+		}
+	}
+
+	for p := range idx.files {
+		if _, ok := seen[p]; !ok {
+			delete(idx.files, p)
+		}
+	}
+}
+
+// update indexes the file again when it was changed. It returns nil when the file does not exist.
+func (idx *symbolIndex) update(path string, docs map[string][]byte, action bool) *indexedFile {
+	prev := idx.files[path]
+	text, open := docs[path]
+	stamp := ""
+	if open {
+		if prev != nil && prev.stamp == "" && bytes.Equal(prev.text, text) {
+			return prev
+		}
+	} else {
+		s, err := os.Stat(path)
+		if err != nil || s.IsDir() {
+			return nil
+		}
+		stamp = fmt.Sprintf("%s:%d", s.ModTime(), s.Size())
+		if prev != nil && prev.stamp == stamp {
+			return prev
+		}
+		text, err = os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+	}
+
+	f := &indexedFile{stamp: stamp, text: text}
+	if action {
+		f.occurrences = indexActionSymbols(path, text)
+	} else {
+		f.occurrences, f.actions = indexWorkflowSymbols(idx.root, path, text)
+	}
+	idx.files[path] = f
+	return f
+}
+
+// at returns the occurrence of a symbol at the position in the file.
+func (idx *symbolIndex) at(path string, line, col int) *symbolOccurrence {
+	if f, ok := idx.files[path]; ok {
+		for _, o := range f.occurrences {
+			if o.contains(line, col) {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+// occurrences returns all occurrences of the symbol sorted by their positions.
+func (idx *symbolIndex) occurrences(sym indexedSymbol) []*symbolOccurrence {
+	ret := []*symbolOccurrence{}
+	for _, f := range idx.files {
+		for _, o := range f.occurrences {
+			if o.symbol == sym {
+				ret = append(ret, o)
+			}
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		if a.path != b.path {
+			return a.path < b.path
+		}
+		if a.line != b.line {
+			return a.line < b.line
+		}
+		return a.col < b.col
+	})
+	return ret
+}
+
+// rename returns all occurrences of the symbol which should be replaced with the new name. An error
+// is returned when the symbol cannot be renamed safely. Renaming only the references breaks the
+// workflows, so the definition of the symbol must be found in the index.
+func (idx *symbolIndex) rename(sym indexedSymbol, name string) ([]*symbolOccurrence, error) {
+	if sym.kind == symbolKindWorkflow {
+		return nil, errors.New("reusable workflow cannot be renamed. rename its file instead")
+	}
+	if !reSymbolName.MatchString(name) {
+		return nil, fmt.Errorf("%q is invalid as name of %s. it must start with a letter or _ and contain only alphanumeric characters, -, or _", name, sym.kind)
+	}
+
+	occs := idx.occurrences(sym)
+	var def *symbolOccurrence
+	for _, o := range occs {
+		if o.def {
+			def = o
+			break
+		}
+	}
+	if def == nil {
+		return nil, fmt.Errorf("definition of %s %q was not found in the repository", sym.kind, sym.name)
+	}
+
+	// Changing only upper/lower cases does not conflict with the symbol itself
+	if to := strings.ToLower(name); to != sym.name {
+		dst := sym
+		dst.name = to
+		for _, o := range idx.occurrences(dst) {
+			if o.def {
+				return nil, fmt.Errorf("%s %q cannot be renamed to %q because it is already defined at %s:%d:%d", sym.kind, def.text, name, o.path, o.line, o.col)
+			}
+		}
+	}
+
+	return occs, nil
+}
+
+// replaceOccurrences replaces the occurrences in the source with the new name. All the occurrences
+// must be in the source. Only the names are replaced so that formatting of the source is preserved.
+func replaceOccurrences(src []byte, occs []*symbolOccurrence, name string) ([]byte, error) {
+	// Offsets of the start of each line
+	lines := []int{0}
+	for i, b := range src {
+		if b == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+
+	type edit struct {
+		start, end int
+	}
+	edits := make([]edit, 0, len(occs))
+	for _, o := range occs {
+		if o.line < 1 || len(lines) < o.line {
+			return nil, fmt.Errorf("line %d of %s is out of range", o.line, o.path)
+		}
+		s := lines[o.line-1]
+		e := len(src)
+		if o.line < len(lines) {
+			e = lines[o.line]
+		}
+		start := s + lspRuneOffset(string(src[s:e]), o.col)
+		end := start + len(o.text)
+		if end > e || string(src[start:end]) != o.text {
+			return nil, fmt.Errorf("%q was not found at %s:%d:%d", o.text, o.path, o.line, o.col)
+		}
+		edits = append(edits, edit{start, end})
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+
+	var b bytes.Buffer
+	prev := 0
+	for _, e := range edits {
+		if e.start < prev {
+			continue // Duplicate occurrence
+		}
+		b.Write(src[prev:e.start])
+		b.WriteString(name)
+		prev = e.end
+	}
+	b.Write(src[prev:])
+	return b.Bytes(), nil
+}
+
+func isActionMetadataFile(path string) bool {
+	n := filepath.Base(path)
+	return n == "action.yml" || n == "action.yaml"
+}
+
+func indexWorkflowSymbols(root, path string, src []byte) ([]*symbolOccurrence, []string) {
+	w, _ := Parse(src)
+	if w == nil {
+		return nil, nil
+	}
+
+	ret := []*symbolOccurrence{}
+	add := func(kind, file, scope string, s *String, def bool) {
+		if s == nil || s.Pos == nil || s.ContainsExpression() {
+			return
+		}
+		col := s.Pos.Col
+		if s.Quoted {
+			col++
+		}
+		sym := indexedSymbol{kind, file, scope, strings.ToLower(s.Value)}
+		ret = append(ret, &symbolOccurrence{sym, path, s.Pos.Line, col, s.Value, def})
+	}
+	local := func(uses *String) string {
+		if root == "" || uses == nil || !strings.HasPrefix(uses.Value, "./") {
+			return ""
+		}
+		return filepath.Join(root, filepath.FromSlash(uses.Value))
+	}
+
+	inputs := map[string]struct{}{}
+	if e, ok := w.FindWorkflowCallEvent(); ok {
+		if e.Pos != nil {
+			sym := indexedSymbol{symbolKindWorkflow, path, "", ""}
+			ret = append(ret, &symbolOccurrence{sym, path, e.Pos.Line, e.Pos.Col, "workflow_call", true})
+		}
+		for _, i := range e.Inputs {
+			add(symbolKindWorkflowInput, path, "", i.Name, true)
+			inputs[strings.ToLower(i.Name.Value)] = struct{}{}
+		}
+		for _, o := range e.Outputs {
+			add(symbolKindWorkflowOutput, path, "", o.Name, true)
+		}
+	}
+
+	actions := []string{}
+	callees := map[string]string{}
+	jobs := make([]*Job, 0, len(w.Jobs))
+	for _, j := range w.Jobs {
+		if j.ID == nil || j.ID.Pos == nil {
+			continue
+		}
+		jobs = append(jobs, j)
+		add(symbolKindJob, path, "", j.ID, true)
+		for _, n := range j.Needs {
+			add(symbolKindJob, path, "", n, false)
+		}
+		id := strings.ToLower(j.ID.Value)
+		for _, s := range j.Steps {
+			add(symbolKindStep, path, id, s.ID, true)
+			if e, ok := s.Exec.(*ExecAction); ok {
+				if d := local(e.Uses); d != "" {
+					actions = append(actions, d)
+					for _, i := range e.Inputs {
+						add(symbolKindActionInput, d, "", i.Name, false)
+					}
+				}
+			}
+		}
+		if c := j.WorkflowCall; c != nil {
+			if f := local(c.Uses); f != "" {
+				callees[id] = f
+				// The symbol of reusable workflow is identified by its file path
+				ret = append(ret, &symbolOccurrence{indexedSymbol{symbolKindWorkflow, f, "", ""}, path, c.Uses.Pos.Line, c.Uses.Pos.Col, c.Uses.Value, false})
+				for _, i := range c.Inputs {
+					add(symbolKindWorkflowInput, f, "", i.Name, false)
+				}
+			}
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID.Pos.Line < jobs[j].ID.Pos.Line })
+
+	// Find references in expressions. Steps can be referred only in the job where they are defined
+	scanPropertyAccesses(src, w, func(ts []*Token, i, line, col int) {
+		scope := ""
+		for _, j := range jobs {
+			if j.ID.Pos.Line > line {
+				break
+			}
+			scope = strings.ToLower(j.ID.Value)
+		}
+		t := ts[i+2]
+		o := &symbolOccurrence{path: path, line: line, col: col, text: t.Value}
+		switch strings.ToLower(ts[i].Value) {
+		case "needs":
+			o.symbol = indexedSymbol{symbolKindJob, path, "", strings.ToLower(t.Value)}
+			ret = append(ret, o)
+			f, ok := callees[strings.ToLower(t.Value)]
+			if !ok || i+6 >= len(ts) || !isPropertyToken(ts, i+4) || !strings.EqualFold(ts[i+4].Value, "outputs") || !isPropertyToken(ts, i+6) {
+				return
+			}
+			t := ts[i+6]
+			sym := indexedSymbol{symbolKindWorkflowOutput, f, "", strings.ToLower(t.Value)}
+			ret = append(ret, &symbolOccurrence{sym, path, line, col + ts[i+6].Column - ts[i+2].Column, t.Value, false})
+		case "jobs":
+			o.symbol = indexedSymbol{symbolKindJob, path, "", strings.ToLower(t.Value)}
+			ret = append(ret, o)
+		case "steps":
+			if scope != "" {
+				o.symbol = indexedSymbol{symbolKindStep, path, scope, strings.ToLower(t.Value)}
+				ret = append(ret, o)
+			}
+		case "inputs":
+			if _, ok := inputs[strings.ToLower(t.Value)]; ok {
+				o.symbol = indexedSymbol{symbolKindWorkflowInput, path, "", strings.ToLower(t.Value)}
+				ret = append(ret, o)
+			}
+		}
+	})
+
+	return ret, actions
+}
+
+func indexActionSymbols(path string, src []byte) []*symbolOccurrence {
+	var n yaml.Node
+	if err := yaml.Unmarshal(src, &n); err != nil || len(n.Content) == 0 || n.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	dir := filepath.Dir(path)
+	ret := []*symbolOccurrence{}
+	inputs := map[string]struct{}{}
+	m := n.Content[0].Content
+	for i := 0; i+1 < len(m); i += 2 {
+		if m[i].Value != "inputs" || m[i+1].Kind != yaml.MappingNode {
+			continue
+		}
+		is := m[i+1].Content
+		for j := 0; j+1 < len(is); j += 2 {
+			k := is[j]
+			col := k.Column
+			if k.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle) != 0 {
+				col++
+			}
+			name := strings.ToLower(k.Value)
+			inputs[name] = struct{}{}
+			ret = append(ret, &symbolOccurrence{indexedSymbol{symbolKindActionInput, dir, "", name}, path, k.Line, col, k.Value, true})
+		}
+	}
+
+	scanPropertyAccesses(src, nil, func(ts []*Token, i, line, col int) {
+		t := ts[i+2]
+		name := strings.ToLower(t.Value)
+		if _, ok := inputs[name]; ok && strings.EqualFold(ts[i].Value, "inputs") {
+			ret = append(ret, &symbolOccurrence{indexedSymbol{symbolKindActionInput, dir, "", name}, path, line, col, t.Value, false})
+		}
+	})
+	return ret
+}
+
+// isPropertyToken returns true when the i-th token is a property name following a dot.
+func isPropertyToken(ts []*Token, i int) bool {
+	return i < len(ts) && ts[i].Kind == TokenKindIdent && ts[i-1].Kind == TokenKindDot
+}
+
+// scanPropertyAccesses finds property accesses like "needs.foo" in the expressions of the source and calls
+// the callback with the tokens of the expression, the index of the token of the context name, and
+// the position of the property name token. Expressions are searched in ${{ }} placeholders line by
+// line and "if:" conditions of jobs and steps in the workflow. The workflow parameter can be nil.
+func scanPropertyAccesses(src []byte, w *Workflow, f func(ts []*Token, i, line, col int)) {
+	lines := strings.Split(string(src), "\n")
+	scan := func(line int, text string, base int, ts []*Token) {
+		for i, t := range ts {
+			if t.Kind != TokenKindIdent || i > 0 && ts[i-1].Kind == TokenKindDot || !isPropertyToken(ts, i+2) {
+				continue
+			}
+			o := base + ts[i+2].Offset
+			if o > len(text) {
+				continue
+			}
+			f(ts, i, line, utf8.RuneCountInString(text[:o])+1)
+		}
+	}
+
+	for l, text := range lines {
+		text = strings.TrimSuffix(text, "\r")
+		o := 0
+		for {
+			i := strings.Index(text[o:], "${{")
+			if i < 0 {
+				break
+			}
+			o += i + 3
+			ts, n, err := LexExpression(text[o:])
+			if err != nil {
+				break // Expression spanning multiple lines or having syntax error
+			}
+			scan(l+1, text, o, ts)
+			o += n
+		}
+	}
+
+	if w == nil {
+		return
+	}
+	// "${{ }}" can be omitted at "if:"
+	conds := []*String{}
+	for _, j := range w.Jobs {
+		conds = append(conds, j.If)
+		for _, s := range j.Steps {
+			conds = append(conds, s.If)
+		}
+	}
+	for _, c := range conds {
+		if c == nil || c.Pos == nil || c.ContainsExpression() || c.Pos.Line > len(lines) {
+			continue
+		}
+		col := c.Pos.Col
+		if c.Quoted {
+			col++
+		}
+		text := strings.TrimSuffix(lines[c.Pos.Line-1], "\r")
+		o := lspRuneOffset(text, col)
+		if o > len(text) || !strings.HasPrefix(text[o:], c.Value) {
+			continue // Multi-line or escaped string
+		}
+		if ts, _, err := LexExpression(c.Value + "}}"); err == nil {
+			scan(c.Pos.Line, text, o, ts)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+type watchedFile struct {
+	modTime time.Time
+	size    int64
+}
+
+// workflowWatcher watches workflow files, local actions and reusable workflows referenced by them,
+// and config files. It polls the files instead of using file system notifications so that it works
+// on all platforms without additional dependencies.
+type workflowWatcher struct {
+	args     []string
+	config   string
+	projects *Projects
+	files    map[string]watchedFile
+	refs     map[string][]string
+	polled   bool
+}
+
+// newWorkflowWatcher creates a new workflowWatcher instance. The args parameter is the file
+// arguments given to the command. When it is empty, all workflows in the repository at the current
+// directory are watched. The config parameter is the file path given by -config-file flag.
+func newWorkflowWatcher(args []string, config string) *workflowWatcher {
+	return &workflowWatcher{
+		args:     args,
+		config:   config,
+		projects: NewProjects(),
+		files:    map[string]watchedFile{},
+		refs:     map[string][]string{},
+	}
+}
+
+// workflows returns the file paths of the watched workflows.
+func (w *workflowWatcher) workflows() ([]string, error) {
+	if len(w.args) > 0 {
+		return w.args, nil
+	}
+	p, err := w.projects.At(".")
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return []string{}, nil // Reported by the first linting
+	}
+	return collectYAMLFiles(p.WorkflowsDir())
+}
+
+// configFiles returns the file paths of config files which affect the watched workflows.
+func (w *workflowWatcher) configFiles(workflows []string) []string {
+	if w.config != "" {
+		return []string{w.config}
+	}
+	fs := []string{}
+	seen := map[*Project]struct{}{}
+	for _, f := range workflows {
+		p, err := w.projects.At(f)
+		if err != nil || p == nil {
+			continue
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		for _, n := range []string{"actionlint.yaml", "actionlint.yml"} {
+			fs = append(fs, filepath.Join(p.RootDir(), ".github", n))
+		}
+	}
+	return fs
+}
+
+// localReferences returns the file paths of local actions and reusable workflows referenced in the
+// workflow file. Since an action metadata file is either action.yml or action.yaml, both are
+// returned for each local action.
+func (w *workflowWatcher) localReferences(path string) []string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	wf, _ := Parse(b)
+	if wf == nil {
+		return nil
+	}
+	p, err := w.projects.At(path)
+	if err != nil || p == nil {
+		return nil
+	}
+
+	refs := []string{}
+	for _, j := range wf.Jobs {
+		if j.WorkflowCall != nil && j.WorkflowCall.Uses != nil && strings.HasPrefix(j.WorkflowCall.Uses.Value, "./") {
+			refs = append(refs, filepath.Join(p.RootDir(), filepath.FromSlash(j.WorkflowCall.Uses.Value)))
+		}
+		for _, s := range j.Steps {
+			e, ok := s.Exec.(*ExecAction)
+			if !ok || e.Uses == nil || !strings.HasPrefix(e.Uses.Value, "./") {
+				continue
+			}
+			d := filepath.Join(p.RootDir(), filepath.FromSlash(e.Uses.Value))
+			refs = append(refs, filepath.Join(d, "action.yml"), filepath.Join(d, "action.yaml"))
+		}
+	}
+	return refs
+}
+
+// poll checks the watched files and returns the workflow files which need to be linted again since
+// they or the files referenced by them were created or modified after the previous call. The second
+// return value is true when some config file was modified. In the case, all workflows need to be
+// linted again. The first call only records the current state of the files and returns no file.
+func (w *workflowWatcher) poll() ([]string, bool, error) {
+	wfs, err := w.workflows()
+	if err != nil {
+		return nil, false, err
+	}
+
+	files := make(map[string]watchedFile, len(w.files))
+	modified := func(path string) bool {
+		var f watchedFile
+		if s, err := os.Stat(path); err == nil {
+			f = watchedFile{s.ModTime(), s.Size()}
+		}
+		files[path] = f
+		prev, ok := w.files[path]
+		return w.polled && !f.modTime.IsZero() && (!ok || !prev.modTime.Equal(f.modTime) || prev.size != f.size)
+	}
+
+	// Paths are compared in absolute form since references to local actions and reusable workflows
+	// are resolved from the root directory of the repository
+	paths := make(map[string]string, len(wfs))
+	changed := map[string]struct{}{}
+	refs := make(map[string][]string, len(wfs))
+	users := map[string][]string{}
+	for _, f := range wfs {
+		a := absPath(f)
+		paths[a] = f
+		rs, ok := w.refs[a]
+		if modified(a) {
+			changed[a] = struct{}{}
+			ok = false
+		}
+		if !ok {
+			rs = w.localReferences(f) // Parse the workflow only when it was modified
+		}
+		refs[a] = rs
+		for _, r := range rs {
+			users[r] = append(users[r], a)
+		}
+	}
+
+	for r, us := range users {
+		if _, ok := paths[r]; ok {
+			// Callers of the modified reusable workflow in the watched workflows
+			if _, ok := changed[r]; ok {
+				for _, u := range us {
+					changed[u] = struct{}{}
+				}
+			}
+			continue
+		}
+		if modified(r) {
+			for _, u := range us {
+				changed[u] = struct{}{}
+			}
+		}
+	}
+
+	config := false
+	for _, f := range w.configFiles(wfs) {
+		if modified(absPath(f)) {
+			config = true
+		}
+	}
+
+	w.files = files
+	w.refs = refs
+	w.polled = true
+
+	ret := make([]string, 0, len(changed))
+	for a := range changed {
+		ret = append(ret, paths[a])
+	}
+	sort.Strings(ret)
+	return ret, config, nil
+}
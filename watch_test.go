@@ -0,0 +1,88 @@
+package actionlint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWorkflowWatcherPoll(t *testing.T) {
+	root := t.TempDir()
+	wd := filepath.Join(root, ".github", "workflows")
+	for _, d := range []string{filepath.Join(root, ".git"), wd, filepath.Join(root, "act")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mtime := time.Now()
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		// Modification time may not change when the file is written within the resolution of the file system
+		mtime = mtime.Add(time.Second)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ci := filepath.Join(wd, "ci.yaml")
+	reusable := filepath.Join(wd, "reusable.yaml")
+	other := filepath.Join(wd, "other.yaml")
+	action := filepath.Join(root, "act", "action.yml")
+	config := filepath.Join(root, ".github", "actionlint.yaml")
+	write(ci, "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: ./act\n  call:\n    uses: ./.github/workflows/reusable.yaml\n")
+	write(reusable, "on: workflow_call\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n")
+	write(other, "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n")
+	write(action, "name: a\ndescription: a\nruns:\n  using: node20\n  main: index.js\n")
+
+	w := newWorkflowWatcher([]string{ci, reusable, other}, "")
+	poll := func(wantFiles []string, wantConfig bool) {
+		t.Helper()
+		files, config, err := w.poll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(wantFiles, files) {
+			t.Fatal(cmp.Diff(wantFiles, files))
+		}
+		if config != wantConfig {
+			t.Fatalf("config file modification should be %v but got %v", wantConfig, config)
+		}
+	}
+
+	poll([]string{}, false) // The first poll only records the state
+	poll([]string{}, false)
+
+	write(other, "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo hello\n")
+	poll([]string{other}, false)
+	poll([]string{}, false)
+
+	write(action, "name: a\ndescription: a\ninputs:\n  foo:\n    description: b\nruns:\n  using: node20\n  main: index.js\n")
+	poll([]string{ci}, false)
+
+	write(reusable, "on: workflow_call\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo hello\n")
+	poll([]string{ci, reusable}, false)
+
+	// The other action metadata file name is also watched
+	if err := os.Remove(action); err != nil {
+		t.Fatal(err)
+	}
+	write(filepath.Join(root, "act", "action.yaml"), "name: a\ndescription: a\nruns:\n  using: node20\n  main: index.js\n")
+	poll([]string{ci}, false)
+
+	// References are updated when the workflow is modified
+	write(ci, "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n")
+	poll([]string{ci}, false)
+	write(reusable, "on: workflow_call\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n")
+	poll([]string{reusable}, false)
+
+	write(config, "self-hosted-runner:\n  labels: [foo]\n")
+	poll([]string{}, true)
+	poll([]string{}, false)
+}
@@ -0,0 +1,585 @@
+package actionlint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Kinds of WorkflowChange.
+const (
+	// WorkflowChangeKindTrigger is the kind of changes of events which trigger the workflow.
+	WorkflowChangeKindTrigger = "trigger"
+	// WorkflowChangeKindPermissions is the kind of changes of permissions of GITHUB_TOKEN.
+	WorkflowChangeKindPermissions = "permissions"
+	// WorkflowChangeKindJob is the kind of jobs added to or removed from the workflow.
+	WorkflowChangeKindJob = "job"
+	// WorkflowChangeKindAction is the kind of changes of actions and reusable workflows used by the
+	// workflow.
+	WorkflowChangeKindAction = "action"
+	// WorkflowChangeKindMatrix is the kind of changes of the number of jobs generated by matrix.
+	WorkflowChangeKindMatrix = "matrix"
+)
+
+// Events which run the workflow with secrets and write permissions for events caused by forked
+// repositories.
+var workflowDiffPrivilegedTriggers = map[string]string{
+	"pull_request_target": "the workflow runs with secrets and write permissions for pull requests from forked repositories",
+	"workflow_run":        "the workflow runs with secrets and write permissions after workflows triggered by forked repositories",
+}
+
+var workflowDiffPermissionLevels = map[string]int{
+	"none":  0,
+	"read":  1,
+	"write": 2,
+}
+
+// WorkflowChange is a semantic change between two versions of a workflow which should be looked at
+// on reviewing the change.
+type WorkflowChange struct {
+	// Kind is the kind of the change. It is one of WorkflowChangeKind* constants.
+	Kind string `json:"kind"`
+	// Message is the human-readable description of the change.
+	Message string `json:"message"`
+	// Risky is true when the change may affect the security of the workflow such as broadened
+	// permissions or a new third-party action.
+	Risky bool `json:"risky"`
+	// Job is the ID of the job where the change happened. It is empty for workflow-level changes.
+	Job string `json:"job,omitempty"`
+	// Filepath is the path of the workflow file where the change is located. It is the path of the
+	// old version when the changed element was removed, otherwise the path of the new version.
+	Filepath string `json:"filepath"`
+	// Line is the line number of the changed element. It is 0 when the position is unknown.
+	Line int `json:"line"`
+	// Column is the column number of the changed element. It is 0 when the position is unknown.
+	Column int `json:"column"`
+}
+
+func (c *WorkflowChange) String() string {
+	s := fmt.Sprintf("%s:%d:%d: %s [%s]", c.Filepath, c.Line, c.Column, c.Message, c.Kind)
+	if c.Risky {
+		s += " (risky)"
+	}
+	return s
+}
+
+type workflowDiff struct {
+	beforePath string
+	afterPath  string
+	changes    []*WorkflowChange
+}
+
+func (d *workflowDiff) report(kind, job string, risky bool, pos *Pos, removed bool, format string, args ...interface{}) {
+	c := &WorkflowChange{
+		Kind:     kind,
+		Message:  fmt.Sprintf(format, args...),
+		Risky:    risky,
+		Job:      job,
+		Filepath: d.afterPath,
+	}
+	if removed {
+		c.Filepath = d.beforePath
+	}
+	if pos != nil {
+		c.Line = pos.Line
+		c.Column = pos.Col
+	}
+	d.changes = append(d.changes, c)
+}
+
+// DiffWorkflows compares two versions of a workflow semantically and returns the changes which
+// matter on reviewing CI changes: triggers added or removed, permissions broadened, actions newly
+// used, jobs added or removed, and growth of jobs generated by matrix. The beforePath and afterPath
+// parameters are the file paths of the old and new versions used for the positions of the changes.
+func DiffWorkflows(beforePath string, before *Workflow, afterPath string, after *Workflow) []*WorkflowChange {
+	d := &workflowDiff{beforePath, afterPath, []*WorkflowChange{}}
+	d.diffTriggers(before.On, after.On)
+	d.diffPermissions(before, after)
+	d.diffJobs(before.Jobs, after.Jobs)
+	d.diffActions(before, after)
+	d.diffMatrices(before.Jobs, after.Jobs)
+	return d.changes
+}
+
+func workflowDiffEventPos(e Event) *Pos {
+	switch e := e.(type) {
+	case *WebhookEvent:
+		return e.Pos
+	case *ScheduledEvent:
+		return e.Pos
+	case *WorkflowDispatchEvent:
+		return e.Pos
+	case *RepositoryDispatchEvent:
+		return e.Pos
+	case *WorkflowCallEvent:
+		return e.Pos
+	default:
+		return nil
+	}
+}
+
+func workflowDiffStrings(ss []*String) []string {
+	ret := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s != nil {
+			ret = append(ret, s.Value)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func workflowDiffFilterValues(f *WebhookEventFilter) []string {
+	if f == nil {
+		return nil
+	}
+	return workflowDiffStrings(f.Values)
+}
+
+// workflowDiffEventConfig returns the configurations of the event which affect when the workflow
+// is triggered. Keys are the names of the configurations and values are their sorted values.
+func workflowDiffEventConfig(e Event) map[string][]string {
+	ret := map[string][]string{}
+	switch e := e.(type) {
+	case *WebhookEvent:
+		ret["types"] = workflowDiffStrings(e.Types)
+		ret["branches"] = workflowDiffFilterValues(e.Branches)
+		ret["branches-ignore"] = workflowDiffFilterValues(e.BranchesIgnore)
+		ret["tags"] = workflowDiffFilterValues(e.Tags)
+		ret["tags-ignore"] = workflowDiffFilterValues(e.TagsIgnore)
+		ret["paths"] = workflowDiffFilterValues(e.Paths)
+		ret["paths-ignore"] = workflowDiffFilterValues(e.PathsIgnore)
+		ret["workflows"] = workflowDiffStrings(e.Workflows)
+	case *ScheduledEvent:
+		ret["cron"] = workflowDiffStrings(e.Cron)
+	case *WorkflowDispatchEvent:
+		is := make([]string, 0, len(e.Inputs))
+		for n := range e.Inputs {
+			is = append(is, n)
+		}
+		sort.Strings(is)
+		ret["inputs"] = is
+	case *RepositoryDispatchEvent:
+		ret["types"] = workflowDiffStrings(e.Types)
+	case *WorkflowCallEvent:
+		is := make([]string, 0, len(e.Inputs))
+		for _, i := range e.Inputs {
+			is = append(is, strings.ToLower(i.Name.Value))
+		}
+		sort.Strings(is)
+		ret["inputs"] = is
+		ss := make([]string, 0, len(e.Secrets))
+		for n := range e.Secrets {
+			ss = append(ss, n)
+		}
+		sort.Strings(ss)
+		ret["secrets"] = ss
+	}
+	return ret
+}
+
+func workflowDiffQuotes(ss []string) string {
+	if len(ss) == 0 {
+		return "nothing"
+	}
+	return quotes(ss)
+}
+
+func workflowDiffEqualStrings(l, r []string) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	for i := range l {
+		if l[i] != r[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *workflowDiff) diffTriggers(before, after []Event) {
+	bs := make(map[string]Event, len(before))
+	for _, e := range before {
+		bs[e.EventName()] = e
+	}
+	as := make(map[string]Event, len(after))
+	for _, e := range after {
+		as[e.EventName()] = e
+	}
+
+	for _, a := range after {
+		n := a.EventName()
+		b, ok := bs[n]
+		if !ok {
+			if why, ok := workflowDiffPrivilegedTriggers[n]; ok {
+				d.report(WorkflowChangeKindTrigger, "", true, workflowDiffEventPos(a), false, "trigger %q was added. %s", n, why)
+			} else {
+				d.report(WorkflowChangeKindTrigger, "", false, workflowDiffEventPos(a), false, "trigger %q was added", n)
+			}
+			continue
+		}
+
+		bc, ac := workflowDiffEventConfig(b), workflowDiffEventConfig(a)
+		ks := make([]string, 0, len(ac))
+		for k := range ac {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+		for _, k := range ks {
+			if !workflowDiffEqualStrings(bc[k], ac[k]) {
+				d.report(WorkflowChangeKindTrigger, "", false, workflowDiffEventPos(a), false, "%q of trigger %q was changed from %s to %s", k, n, workflowDiffQuotes(bc[k]), workflowDiffQuotes(ac[k]))
+			}
+		}
+	}
+
+	for _, b := range before {
+		if _, ok := as[b.EventName()]; !ok {
+			d.report(WorkflowChangeKindTrigger, "", false, workflowDiffEventPos(b), true, "trigger %q was removed", b.EventName())
+		}
+	}
+}
+
+// workflowDiffPermissions returns the permission level of each scope. nil is returned when
+// the permissions are not configured. In the case, the default permissions of GITHUB_TOKEN are
+// applied.
+func workflowDiffPermissions(p *Permissions) map[string]string {
+	if p == nil {
+		return nil
+	}
+	ret := make(map[string]string, len(allPermissionScopes))
+	all := "none"
+	if p.All != nil {
+		all = strings.TrimSuffix(p.All.Value, "-all")
+	}
+	for s := range allPermissionScopes {
+		ret[s] = all
+	}
+	for n, s := range p.Scopes {
+		if s.Value != nil {
+			ret[n] = s.Value.Value
+		}
+	}
+	return ret
+}
+
+func workflowDiffWritableScopes(p map[string]string) []string {
+	ss := []string{}
+	for s, l := range p {
+		if l == "write" {
+			ss = append(ss, s)
+		}
+	}
+	sort.Strings(ss)
+	return ss
+}
+
+func (d *workflowDiff) diffPermissions(before, after *Workflow) {
+	d.diffPermissionLevels("", "workflow", before.Permissions, after.Permissions)
+
+	ids := make([]string, 0, len(after.Jobs))
+	for id, a := range after.Jobs {
+		if b, ok := before.Jobs[id]; ok && (a.Permissions != nil || b.Permissions != nil) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		// Permissions of the job inherit the workflow-level permissions when they are omitted
+		b, a := before.Jobs[id].Permissions, after.Jobs[id].Permissions
+		if b == nil {
+			b = before.Permissions
+		}
+		if a == nil {
+			a = after.Permissions
+		}
+		d.diffPermissionLevels(id, fmt.Sprintf("job %q", after.Jobs[id].ID.Value), b, a)
+	}
+
+	// Write permissions granted to new jobs also need to be reviewed
+	for _, id := range workflowDiffSortedJobIDs(after.Jobs) {
+		j := after.Jobs[id]
+		if _, ok := before.Jobs[id]; ok || j.Permissions == nil {
+			continue
+		}
+		if ws := workflowDiffWritableScopes(workflowDiffPermissions(j.Permissions)); len(ws) > 0 {
+			d.report(WorkflowChangeKindPermissions, id, true, j.Permissions.Pos, false, "new job %q has write permission to %s", j.ID.Value, quotes(ws))
+		}
+	}
+}
+
+func (d *workflowDiff) diffPermissionLevels(job, where string, before, after *Permissions) {
+	bs, as := workflowDiffPermissions(before), workflowDiffPermissions(after)
+	switch {
+	case bs == nil && as == nil:
+		return
+	case as == nil:
+		d.report(WorkflowChangeKindPermissions, job, true, before.Pos, true, "permissions of %s were removed. the default permissions of GITHUB_TOKEN are applied and they may be broader", where)
+		return
+	case bs == nil:
+		if ws := workflowDiffWritableScopes(as); len(ws) > 0 {
+			d.report(WorkflowChangeKindPermissions, job, true, after.Pos, false, "permissions of %s were set explicitly with write permission to %s", where, quotes(ws))
+		} else {
+			d.report(WorkflowChangeKindPermissions, job, false, after.Pos, false, "permissions of %s were restricted explicitly without write permission", where)
+		}
+		return
+	}
+
+	ss := make([]string, 0, len(as))
+	for s := range as {
+		ss = append(ss, s)
+	}
+	sort.Strings(ss)
+	for _, s := range ss {
+		b, a := bs[s], as[s]
+		if b == a {
+			continue
+		}
+		pos := after.Pos
+		if p, ok := after.Scopes[s]; ok && p.Name != nil {
+			pos = p.Name.Pos
+		}
+		if workflowDiffPermissionLevels[a] > workflowDiffPermissionLevels[b] {
+			d.report(WorkflowChangeKindPermissions, job, true, pos, false, "permission of scope %q of %s was broadened from %q to %q", s, where, b, a)
+		} else {
+			d.report(WorkflowChangeKindPermissions, job, false, pos, false, "permission of scope %q of %s was narrowed from %q to %q", s, where, b, a)
+		}
+	}
+}
+
+func workflowDiffSortedJobIDs(jobs map[string]*Job) []string {
+	ids := make([]string, 0, len(jobs))
+	for id := range jobs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (d *workflowDiff) diffJobs(before, after map[string]*Job) {
+	for _, id := range workflowDiffSortedJobIDs(after) {
+		if _, ok := before[id]; !ok {
+			j := after[id]
+			d.report(WorkflowChangeKindJob, id, false, j.Pos, false, "job %q was added", j.ID.Value)
+		}
+	}
+	for _, id := range workflowDiffSortedJobIDs(before) {
+		if _, ok := after[id]; !ok {
+			j := before[id]
+			d.report(WorkflowChangeKindJob, id, false, j.Pos, true, "job %q was removed", j.ID.Value)
+		}
+	}
+}
+
+// workflowDiffActionUse is the usage of an action or a reusable workflow in a workflow.
+type workflowDiffActionUse struct {
+	job  string
+	refs []string
+	pos  *Pos
+}
+
+// workflowDiffActions collects the actions and remote reusable workflows used in the workflow. Keys
+// of the returned map are the specs without refs like "actions/checkout". Local actions and Docker
+// actions are not collected since they are reviewed as a part of the repository or the image.
+func workflowDiffActions(w *Workflow) map[string]*workflowDiffActionUse {
+	ret := map[string]*workflowDiffActionUse{}
+	add := func(job string, uses *String) {
+		if uses == nil || strings.HasPrefix(uses.Value, "./") || strings.HasPrefix(uses.Value, "docker://") || ContainsExpression(uses.Value) {
+			return
+		}
+		spec, ref, _ := strings.Cut(uses.Value, "@")
+		u, ok := ret[spec]
+		if !ok {
+			u = &workflowDiffActionUse{job, nil, uses.Pos}
+			ret[spec] = u
+		}
+		for _, r := range u.refs {
+			if r == ref {
+				return
+			}
+		}
+		u.refs = append(u.refs, ref)
+		sort.Strings(u.refs)
+	}
+
+	for _, id := range workflowDiffSortedJobIDs(w.Jobs) {
+		j := w.Jobs[id]
+		if j.WorkflowCall != nil {
+			add(id, j.WorkflowCall.Uses)
+		}
+		for _, s := range j.Steps {
+			if e, ok := s.Exec.(*ExecAction); ok {
+				add(id, e.Uses)
+			}
+		}
+	}
+	return ret
+}
+
+func workflowDiffIsThirdPartyAction(spec string) bool {
+	owner, _, _ := strings.Cut(spec, "/")
+	owner = strings.ToLower(owner)
+	return owner != "actions" && owner != "github"
+}
+
+func (d *workflowDiff) diffActions(before, after *Workflow) {
+	bs, as := workflowDiffActions(before), workflowDiffActions(after)
+
+	specs := make([]string, 0, len(as))
+	for s := range as {
+		specs = append(specs, s)
+	}
+	sort.Strings(specs)
+	for _, s := range specs {
+		a := as[s]
+		b, ok := bs[s]
+		if !ok {
+			uses := s + "@" + strings.Join(a.refs, ",")
+			if workflowDiffIsThirdPartyAction(s) {
+				d.report(WorkflowChangeKindAction, a.job, true, a.pos, false, "third-party action %q is newly used in job %q. check the action is trustworthy", uses, a.job)
+			} else {
+				d.report(WorkflowChangeKindAction, a.job, false, a.pos, false, "action %q is newly used in job %q", uses, a.job)
+			}
+			continue
+		}
+		if workflowDiffEqualStrings(b.refs, a.refs) {
+			continue
+		}
+		pinned := true
+		for _, r := range b.refs {
+			if !reFullCommitSHA.MatchString(r) {
+				pinned = false
+			}
+		}
+		unpinned := false
+		for _, r := range a.refs {
+			if !reFullCommitSHA.MatchString(r) {
+				unpinned = true
+			}
+		}
+		if pinned && unpinned {
+			d.report(WorkflowChangeKindAction, a.job, true, a.pos, false, "version of action %q was changed from %s to %s. it is no longer pinned to a full-length commit SHA", s, quotes(b.refs), quotes(a.refs))
+		} else {
+			d.report(WorkflowChangeKindAction, a.job, false, a.pos, false, "version of action %q was changed from %s to %s", s, quotes(b.refs), quotes(a.refs))
+		}
+	}
+
+	specs = specs[:0]
+	for s := range bs {
+		if _, ok := as[s]; !ok {
+			specs = append(specs, s)
+		}
+	}
+	sort.Strings(specs)
+	for _, s := range specs {
+		b := bs[s]
+		d.report(WorkflowChangeKindAction, b.job, false, b.pos, true, "action %q is no longer used", s)
+	}
+}
+
+func workflowDiffMatrixRowHas(r *MatrixRow, v RawYAMLValue) bool {
+	for _, x := range r.Values {
+		if x.Equals(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// workflowDiffMatrixJobs returns the number of jobs generated by the matrix. The second return
+// value is false when the number cannot be calculated statically since some expression is used.
+// Overlaps between excluded combinations are not considered so the number is an estimation.
+// https://docs.github.com/en/actions/using-jobs/using-a-matrix-for-your-jobs
+func workflowDiffMatrixJobs(m *Matrix) (int, bool) {
+	if m == nil {
+		return 1, true
+	}
+	if m.Expression != nil || m.Include != nil && m.Include.ContainsExpression() || m.Exclude != nil && m.Exclude.ContainsExpression() {
+		return 0, false
+	}
+
+	n := 0
+	if len(m.Rows) > 0 {
+		n = 1
+		for _, r := range m.Rows {
+			if r.Expression != nil {
+				return 0, false
+			}
+			n *= len(r.Values)
+		}
+	}
+
+	if m.Exclude != nil && n > 0 {
+		for _, c := range m.Exclude.Combinations {
+			excluded := 1
+			for k, r := range m.Rows {
+				a, ok := c.Assigns[k]
+				if !ok {
+					excluded *= len(r.Values)
+					continue
+				}
+				if !workflowDiffMatrixRowHas(r, a.Value) {
+					excluded = 0
+					break
+				}
+			}
+			n -= excluded
+		}
+		if n < 0 {
+			n = 0
+		}
+	}
+
+	// Each combination in 'include' extends the matching original combinations or adds a new job
+	// when no original combination matches it
+	if m.Include != nil {
+		base := n
+		for _, c := range m.Include.Combinations {
+			matched := base > 0
+			for k, a := range c.Assigns {
+				if r, ok := m.Rows[k]; ok && !workflowDiffMatrixRowHas(r, a.Value) {
+					matched = false
+					break
+				}
+			}
+			if !matched {
+				n++
+			}
+		}
+	}
+
+	return n, true
+}
+
+func (d *workflowDiff) diffMatrices(before, after map[string]*Job) {
+	for _, id := range workflowDiffSortedJobIDs(after) {
+		b, ok := before[id]
+		if !ok {
+			continue
+		}
+		a := after[id]
+		var bm, am *Matrix
+		if b.Strategy != nil {
+			bm = b.Strategy.Matrix
+		}
+		if a.Strategy != nil {
+			am = a.Strategy.Matrix
+		}
+		if bm == nil && am == nil {
+			continue
+		}
+		bn, bok := workflowDiffMatrixJobs(bm)
+		an, aok := workflowDiffMatrixJobs(am)
+		if !bok || !aok || bn == an {
+			continue
+		}
+		pos := a.Pos
+		if am != nil {
+			pos = am.Pos
+		}
+		if an > bn {
+			d.report(WorkflowChangeKindMatrix, id, false, pos, false, "number of runs of job %q by matrix grows from %d to %d", a.ID.Value, bn, an)
+		} else {
+			d.report(WorkflowChangeKindMatrix, id, false, pos, false, "number of runs of job %q by matrix shrinks from %d to %d", a.ID.Value, bn, an)
+		}
+	}
+}
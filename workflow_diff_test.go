@@ -0,0 +1,105 @@
+package actionlint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffWorkflows(t *testing.T) {
+	testCases := []struct {
+		what   string
+		before string
+		after  string
+		want   []string
+	}{
+		{
+			what:   "no change",
+			before: "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n",
+			after:  "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n",
+		},
+		{
+			what:   "triggers",
+			before: "on:\n  push:\n    branches: [main]\n  schedule:\n    - cron: '0 0 * * *'\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			after:  "on:\n  push:\n    branches: [main, 'release/**']\n  pull_request_target:\n  workflow_dispatch:\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			want: []string{
+				`after.yaml:2:3: "branches" of trigger "push" was changed from "main" to "main", "release/**" [trigger]`,
+				`after.yaml:4:3: trigger "pull_request_target" was added. the workflow runs with secrets and write permissions for pull requests from forked repositories [trigger] (risky)`,
+				`after.yaml:5:3: trigger "workflow_dispatch" was added [trigger]`,
+				`before.yaml:4:3: trigger "schedule" was removed [trigger]`,
+			},
+		},
+		{
+			what:   "workflow permissions",
+			before: "on: push\npermissions:\n  contents: write\n  issues: read\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			after:  "on: push\npermissions:\n  contents: read\n  pull-requests: write\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			want: []string{
+				`after.yaml:3:3: permission of scope "contents" of workflow was narrowed from "write" to "read" [permissions]`,
+				`after.yaml:2:1: permission of scope "issues" of workflow was narrowed from "read" to "none" [permissions]`,
+				`after.yaml:4:3: permission of scope "pull-requests" of workflow was broadened from "none" to "write" [permissions] (risky)`,
+			},
+		},
+		{
+			what:   "permissions removed",
+			before: "on: push\npermissions: read-all\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			after:  "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			want: []string{
+				`before.yaml:2:1: permissions of workflow were removed. the default permissions of GITHUB_TOKEN are applied and they may be broader [permissions] (risky)`,
+			},
+		},
+		{
+			what:   "job permissions",
+			before: "on: push\npermissions: {}\njobs:\n  test:\n    runs-on: ubuntu-latest\n    permissions:\n      contents: read\n    steps:\n      - run: echo\n",
+			after:  "on: push\npermissions: {}\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n  release:\n    runs-on: ubuntu-latest\n    permissions:\n      contents: write\n    steps:\n      - run: echo\n",
+			want: []string{
+				`after.yaml:2:1: permission of scope "contents" of job "test" was narrowed from "read" to "none" [permissions]`,
+				`after.yaml:10:5: new job "release" has write permission to "contents" [permissions] (risky)`,
+				`after.yaml:8:3: job "release" was added [job]`,
+			},
+		},
+		{
+			what:   "actions",
+			before: "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@8ade135a41bc03ea155e62e844d188df1ea18608\n      - uses: actions/setup-go@v4\n      - uses: ./local\n  call:\n    uses: owner/repo/.github/workflows/ci.yaml@v1\n",
+			after:  "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - uses: actions/checkout@v4\n      - uses: actions/cache@v4\n      - uses: foo/bar@v1\n      - uses: docker://alpine:3\n  call:\n    uses: owner/repo/.github/workflows/ci.yaml@v2\n",
+			want: []string{
+				`after.yaml:7:15: action "actions/cache@v4" is newly used in job "test" [action]`,
+				`after.yaml:6:15: version of action "actions/checkout" was changed from "8ade135a41bc03ea155e62e844d188df1ea18608" to "v4". it is no longer pinned to a full-length commit SHA [action] (risky)`,
+				`after.yaml:8:15: third-party action "foo/bar@v1" is newly used in job "test". check the action is trustworthy [action] (risky)`,
+				`after.yaml:11:11: version of action "owner/repo/.github/workflows/ci.yaml" was changed from "v1" to "v2" [action]`,
+				`before.yaml:7:15: action "actions/setup-go" is no longer used [action]`,
+			},
+		},
+		{
+			what:   "matrix",
+			before: "on: push\njobs:\n  test:\n    strategy:\n      matrix:\n        os: [ubuntu-latest, macos-latest]\n        go: ['1.21', '1.22']\n    runs-on: ${{ matrix.os }}\n    steps:\n      - run: echo\n  lint:\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			after:  "on: push\njobs:\n  test:\n    strategy:\n      matrix:\n        os: [ubuntu-latest, macos-latest, windows-latest]\n        go: ['1.21', '1.22']\n        exclude:\n          - os: windows-latest\n            go: '1.21'\n        include:\n          - os: ubuntu-latest\n            experimental: true\n          - os: ubuntu-22.04\n            go: '1.23'\n    runs-on: ${{ matrix.os }}\n    steps:\n      - run: echo\n  lint:\n    strategy:\n      matrix:\n        node: ${{ fromJSON(inputs.versions) }}\n    runs-on: ubuntu-latest\n    steps:\n      - run: echo\n",
+			want: []string{
+				`after.yaml:5:7: number of runs of job "test" by matrix grows from 4 to 6 [matrix]`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			before, errs := Parse([]byte(tc.before))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			after, errs := Parse([]byte(tc.after))
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			have := []string{}
+			for _, c := range DiffWorkflows("before.yaml", before, "after.yaml", after) {
+				have = append(have, c.String())
+			}
+			want := tc.want
+			if want == nil {
+				want = []string{}
+			}
+			if !cmp.Equal(want, have) {
+				t.Fatal(cmp.Diff(want, have))
+			}
+		})
+	}
+}
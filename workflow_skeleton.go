@@ -0,0 +1,232 @@
+package actionlint
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// WorkflowSkeletonOptions is a set of answers to customize a generated workflow skeleton.
+type WorkflowSkeletonOptions struct {
+	// OS is a runner label of jobs in the workflow such as "ubuntu-latest". The default value is
+	// "ubuntu-latest".
+	OS string
+	// GoVersion is a version of Go set up in the workflow such as "1.22". The default value is
+	// "stable". This is not used by "docker" template.
+	GoVersion string
+	// Permissions is a map from permission scopes to their levels such as "pull-requests": "write".
+	// They are added to the minimal permissions required by the template. Each level must be one of
+	// "read", "write", or "none".
+	Permissions map[string]string
+}
+
+type workflowSkeleton struct {
+	desc        string
+	permissions map[string]string
+	linuxOnly   bool
+	src         string
+}
+
+var workflowSkeletons = map[string]*workflowSkeleton{
+	"go-ci": {
+		desc:        "Build and test Go project on pushes and pull requests",
+		permissions: map[string]string{"contents": "read"},
+		src: `name: CI
+on:
+  push:
+    branches: [main]
+  pull_request:
+
+permissions:
+{{- range .Permissions}}
+  {{.Scope}}: {{.Level}}
+{{- end}}
+
+jobs:
+  test:
+    name: Test
+    runs-on: {{.OS}}
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          persist-credentials: false
+      - uses: actions/setup-go@v5
+        with:
+          go-version: {{.GoVersion}}
+      - run: go build ./...
+      - run: go vet ./...
+      - run: go test ./...
+`,
+	},
+	"release": {
+		desc:        "Create GitHub release with GoReleaser on pushing version tags",
+		permissions: map[string]string{"contents": "write"},
+		src: `name: Release
+on:
+  push:
+    tags:
+      - 'v*.*.*'
+
+permissions:
+{{- range .Permissions}}
+  {{.Scope}}: {{.Level}}
+{{- end}}
+
+jobs:
+  release:
+    name: Release
+    runs-on: {{.OS}}
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          fetch-depth: 0
+          persist-credentials: false
+      - uses: actions/setup-go@v5
+        with:
+          go-version: {{.GoVersion}}
+      - uses: goreleaser/goreleaser-action@v5
+        with:
+          version: latest
+          args: release --clean
+        env:
+          GITHUB_TOKEN: ${{"{{"}} secrets.GITHUB_TOKEN {{"}}"}}
+`,
+	},
+	"docker": {
+		desc:        "Build Docker image and push it to GitHub Container Registry",
+		permissions: map[string]string{"contents": "read", "packages": "write"},
+		linuxOnly:   true,
+		src: `name: Docker
+on:
+  push:
+    branches: [main]
+    tags:
+      - 'v*.*.*'
+  pull_request:
+
+permissions:
+{{- range .Permissions}}
+  {{.Scope}}: {{.Level}}
+{{- end}}
+
+jobs:
+  docker:
+    name: Build and push image
+    runs-on: {{.OS}}
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          persist-credentials: false
+      - uses: docker/setup-qemu-action@v3
+      - uses: docker/setup-buildx-action@v3
+      - uses: docker/login-action@v3
+        if: github.event_name != 'pull_request'
+        with:
+          registry: ghcr.io
+          username: ${{"{{"}} github.actor {{"}}"}}
+          password: ${{"{{"}} secrets.GITHUB_TOKEN {{"}}"}}
+      - id: meta
+        uses: docker/metadata-action@v5
+        with:
+          images: ghcr.io/${{"{{"}} github.repository {{"}}"}}
+      - uses: docker/build-push-action@v5
+        with:
+          context: .
+          push: ${{"{{"}} github.event_name != 'pull_request' {{"}}"}}
+          tags: ${{"{{"}} steps.meta.outputs.tags {{"}}"}}
+          labels: ${{"{{"}} steps.meta.outputs.labels {{"}}"}}
+`,
+	},
+}
+
+// WorkflowSkeletonNames returns sorted names of all templates of workflow skeletons.
+func WorkflowSkeletonNames() []string {
+	ns := make([]string, 0, len(workflowSkeletons))
+	for n := range workflowSkeletons {
+		ns = append(ns, n)
+	}
+	sort.Strings(ns)
+	return ns
+}
+
+type workflowSkeletonPermission struct {
+	Scope string
+	Level string
+}
+
+// GenerateWorkflowSkeleton generates the source of a workflow file from the template. The name is
+// a name of the template such as "go-ci". The opts parameter customizes the generated workflow.
+// When it is nil, the default values are used. The generated workflow is expected to pass all
+// checks by actionlint.
+func GenerateWorkflowSkeleton(name string, opts *WorkflowSkeletonOptions) ([]byte, error) {
+	s, ok := workflowSkeletons[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown template %q for workflow skeleton. available templates are %s", name, quotes(WorkflowSkeletonNames()))
+	}
+	if opts == nil {
+		opts = &WorkflowSkeletonOptions{}
+	}
+
+	os := strings.TrimSpace(opts.OS)
+	if os == "" {
+		os = "ubuntu-latest"
+	}
+	if s.linuxOnly && !strings.HasPrefix(os, "ubuntu-") {
+		return nil, fmt.Errorf("template %q requires Linux runner but runner OS %q was given. use \"ubuntu-latest\" or other Ubuntu runners", name, os)
+	}
+	if strings.ContainsAny(os, " \t\n:#'\"") {
+		return nil, fmt.Errorf("runner OS %q is not a valid runner label", os)
+	}
+
+	gov := strings.TrimSpace(opts.GoVersion)
+	if gov == "" {
+		gov = "stable"
+	}
+
+	perms := make(map[string]string, len(s.permissions)+len(opts.Permissions))
+	for k, v := range s.permissions {
+		perms[k] = v
+	}
+	for k, v := range opts.Permissions {
+		if _, ok := allPermissionScopes[k]; !ok {
+			ss := make([]string, 0, len(allPermissionScopes))
+			for s := range allPermissionScopes {
+				ss = append(ss, s)
+			}
+			return nil, fmt.Errorf("unknown permission scope %q. all available permission scopes are %s", k, sortedQuotes(ss))
+		}
+		switch v {
+		case "read", "write", "none":
+		default:
+			return nil, fmt.Errorf("permission level %q of scope %q is invalid. available levels are \"read\", \"write\", and \"none\"", v, k)
+		}
+		perms[k] = v
+	}
+	ps := make([]workflowSkeletonPermission, 0, len(perms))
+	for k, v := range perms {
+		ps = append(ps, workflowSkeletonPermission{k, v})
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].Scope < ps[j].Scope })
+
+	t, err := template.New(name).Parse(s.src)
+	if err != nil {
+		panic(err) // Templates are static. Parsing them never fails
+	}
+
+	var b bytes.Buffer
+	if err := t.Execute(&b, struct {
+		OS          string
+		GoVersion   string
+		Permissions []workflowSkeletonPermission
+	}{
+		OS:          os,
+		GoVersion:   strconv.Quote(gov), // Quote the version since YAML parses 1.20 as a float 1.2
+		Permissions: ps,
+	}); err != nil {
+		return nil, fmt.Errorf("could not generate workflow skeleton from template %q: %w", name, err)
+	}
+	return b.Bytes(), nil
+}
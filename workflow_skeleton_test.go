@@ -0,0 +1,94 @@
+package actionlint
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/execabs"
+)
+
+func TestWorkflowSkeletonPassesAllChecks(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "actionlint.yaml")
+	c := "rules:\n  checkout-persist-credentials:\n    enable: true\n  matrix-suggestion:\n    enable: true\n  literal-key:\n    enable: true\n  workflow-file:\n    enable: true\n"
+	if err := os.WriteFile(cfg, []byte(c), 0644); err != nil {
+		t.Fatal(err)
+	}
+	shellcheck, _ := execabs.LookPath("shellcheck")
+
+	testCases := []struct {
+		name string
+		opts *WorkflowSkeletonOptions
+	}{
+		{"go-ci", nil},
+		{"go-ci", &WorkflowSkeletonOptions{OS: "macos-latest", GoVersion: "1.20", Permissions: map[string]string{"pull-requests": "write"}}},
+		{"release", nil},
+		{"release", &WorkflowSkeletonOptions{OS: "windows-latest", GoVersion: "oldstable", Permissions: map[string]string{"contents": "read"}}},
+		{"docker", nil},
+		{"docker", &WorkflowSkeletonOptions{OS: "ubuntu-22.04", Permissions: map[string]string{"id-token": "write"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := GenerateWorkflowSkeleton(tc.name, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l, err := NewLinter(io.Discard, &LinterOptions{Shellcheck: shellcheck, ConfigFile: cfg})
+			if err != nil {
+				t.Fatal(err)
+			}
+			errs, err := l.Lint(tc.name+".yaml", b, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(errs) > 0 {
+				t.Fatalf("generated workflow has %d errors: %v\n%s", len(errs), errs, b)
+			}
+			if tc.opts == nil {
+				return
+			}
+			if tc.opts.OS != "" && !strings.Contains(string(b), "runs-on: "+tc.opts.OS+"\n") {
+				t.Errorf("runner OS %q is not used:\n%s", tc.opts.OS, b)
+			}
+			if tc.opts.GoVersion != "" && !strings.Contains(string(b), "go-version: \""+tc.opts.GoVersion+"\"\n") {
+				t.Errorf("Go version %q is not used:\n%s", tc.opts.GoVersion, b)
+			}
+			for k, v := range tc.opts.Permissions {
+				if !strings.Contains(string(b), "  "+k+": "+v+"\n") {
+					t.Errorf("permission %s:%s is not used:\n%s", k, v, b)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkflowSkeletonError(t *testing.T) {
+	testCases := []struct {
+		what string
+		name string
+		opts *WorkflowSkeletonOptions
+		want string
+	}{
+		{"unknown template", "rust-ci", nil, `unknown template "rust-ci" for workflow skeleton. available templates are "docker", "go-ci", "release"`},
+		{"non-linux docker", "docker", &WorkflowSkeletonOptions{OS: "macos-latest"}, `template "docker" requires Linux runner`},
+		{"invalid runner label", "go-ci", &WorkflowSkeletonOptions{OS: "ubuntu latest"}, `runner OS "ubuntu latest" is not a valid runner label`},
+		{"unknown scope", "go-ci", &WorkflowSkeletonOptions{Permissions: map[string]string{"pull-request": "write"}}, `unknown permission scope "pull-request"`},
+		{"invalid level", "go-ci", &WorkflowSkeletonOptions{Permissions: map[string]string{"issues": "admin"}}, `permission level "admin" of scope "issues" is invalid`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.what, func(t *testing.T) {
+			_, err := GenerateWorkflowSkeleton(tc.name, tc.opts)
+			if err == nil {
+				t.Fatal("error did not occur")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("wanted %q in error message but got %q", tc.want, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package actionlint
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GitHub Actions resolves plain scalars in workflow files with the core schema of YAML 1.2. The
+// go-yaml library mixes some YAML 1.1 rules into the resolution. For example, it resolves "017" to
+// 15 as octal number and "0b11" to 3 as binary number. These patterns follow the core schema.
+// https://yaml.org/spec/1.2.2/#1032-tag-resolution
+var (
+	reYAMLCoreNull  = regexp.MustCompile(`^(?:null|Null|NULL|~)$`)
+	reYAMLCoreBool  = regexp.MustCompile(`^(?:true|True|TRUE|false|False|FALSE)$`)
+	reYAMLCoreInt   = regexp.MustCompile(`^(?:[-+]?[0-9]+|0o[0-7]+|0x[0-9a-fA-F]+)$`)
+	reYAMLCoreFloat = regexp.MustCompile(`^(?:[-+]?(?:\.[0-9]+|[0-9]+(?:\.[0-9]*)?)(?:[eE][-+]?[0-9]+)?|[-+]?\.(?:inf|Inf|INF)|\.(?:nan|NaN|NAN))$`)
+)
+
+// YAML 1.1 resolves these plain scalars to non-string values while YAML 1.2 does not. Many YAML
+// libraries and tools such as PyYAML still follow YAML 1.1.
+// https://yaml.org/type/bool.html
+// https://yaml.org/type/int.html
+var (
+	reYAML11Bool = regexp.MustCompile(`^(?:y|Y|yes|Yes|YES|n|N|no|No|NO|on|On|ON|off|Off|OFF)$`)
+	reYAML11Int  = regexp.MustCompile(`^(?:[-+]?0b[01_]+|[-+]?0[0-7_]+|[-+]?[1-9][0-9_]*(?::[0-5]?[0-9])+|[-+]?(?:0|[1-9][0-9_]*)|[-+]?0x[0-9a-fA-F_]+)$`)
+)
+
+// yamlScalar is a scalar value resolved in the same way as GitHub Actions.
+type yamlScalar struct {
+	// tag is one of "!!null", "!!bool", "!!int", "!!float", and "!!str".
+	tag string
+	// value is the string representation of the value which GitHub Actions uses when the value is
+	// converted into a string. For example, "3.10" is converted into "3.1".
+	value string
+	// num is the value of "!!int" or "!!float" scalar.
+	num float64
+}
+
+// resolveYAMLScalar resolves the type and the value of the scalar node as GitHub Actions does.
+// Quoted scalars, block scalars, and scalars with explicit tags are always strings.
+func resolveYAMLScalar(n *yaml.Node) *yamlScalar {
+	s := n.Value
+	if n.Kind != yaml.ScalarNode || n.Style != 0 {
+		return &yamlScalar{tag: "!!str", value: s}
+	}
+	switch {
+	case s == "" || reYAMLCoreNull.MatchString(s):
+		return &yamlScalar{tag: "!!null"}
+	case reYAMLCoreBool.MatchString(s):
+		return &yamlScalar{tag: "!!bool", value: strings.ToLower(s)}
+	case reYAMLCoreInt.MatchString(s):
+		var i int64
+		var err error
+		switch {
+		case strings.HasPrefix(s, "0o"):
+			i, err = strconv.ParseInt(s[2:], 8, 64)
+		case strings.HasPrefix(s, "0x"):
+			i, err = strconv.ParseInt(s[2:], 16, 64)
+		default:
+			i, err = strconv.ParseInt(s, 10, 64)
+		}
+		if err == nil {
+			return &yamlScalar{tag: "!!int", value: strconv.FormatInt(i, 10), num: float64(i)}
+		}
+		// Integers out of range of int64 are handled as float
+		f, _ := strconv.ParseFloat(s, 64)
+		return &yamlScalar{tag: "!!float", value: formatYAMLFloat(f), num: f}
+	case reYAMLCoreFloat.MatchString(s):
+		var f float64
+		switch strings.ToLower(strings.TrimLeft(s, "+-")) {
+		case ".inf":
+			f = math.Inf(1)
+			if s[0] == '-' {
+				f = math.Inf(-1)
+			}
+		case ".nan":
+			f = math.NaN()
+		default:
+			f, _ = strconv.ParseFloat(s, 64) // Out of range is converted to infinity
+		}
+		return &yamlScalar{tag: "!!float", value: formatYAMLFloat(f), num: f}
+	default:
+		return &yamlScalar{tag: "!!str", value: s}
+	}
+}
+
+func formatYAMLFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	default:
+		return formatNumber(f)
+	}
+}
+
+// yaml11ScalarKind returns the kind of the plain scalar in YAML 1.1 when YAML 1.1 parsers resolve
+// it to a non-string value while GitHub Actions resolves it to a string. For example, "yes" is a
+// boolean and "0b11" is an integer in YAML 1.1.
+func yaml11ScalarKind(n *yaml.Node, resolved *yamlScalar) (string, bool) {
+	if resolved.tag != "!!str" || n.Style != 0 {
+		return "", false
+	}
+	switch {
+	case reYAML11Bool.MatchString(n.Value):
+		return "boolean", true
+	case reYAML11Int.MatchString(n.Value):
+		return "integer", true
+	default:
+		return "", false
+	}
+}
+
+// checkScalarCompat reports the plain scalar at the string value when GitHub Actions converts it
+// into a different string from the source or when YAML 1.1 parsers interpret it differently. The
+// what parameter describes where the value is like "in matrix". When typed is true, the value keeps its type like
+// matrix values so null and boolean values are not reported.
+func (p *parser) checkScalarCompat(n *yaml.Node, what string, typed bool) {
+	if n.Kind != yaml.ScalarNode || n.Style != 0 || n.Value == "" {
+		return
+	}
+	r := resolveYAMLScalar(n)
+	if typed && (r.tag == "!!null" || r.tag == "!!bool") {
+		return
+	}
+	if r.tag != "!!str" && r.value != n.Value {
+		p.errorf(
+			n,
+			"value %q %s is parsed as %s by GitHub Actions and it is converted into string %q. quote the value like '%s' if you want to use the string as-is",
+			n.Value,
+			what,
+			yamlTagKind(r.tag),
+			r.value,
+			n.Value,
+		)
+		return
+	}
+	if k, ok := yaml11ScalarKind(n, r); ok {
+		p.errorf(
+			n,
+			"value %q %s is parsed as string by GitHub Actions but it is parsed as %s by YAML 1.1 parsers. quote the value like '%s' to avoid the ambiguity",
+			n.Value,
+			what,
+			k,
+			n.Value,
+		)
+	}
+}
+
+func yamlTagKind(tag string) string {
+	switch tag {
+	case "!!null":
+		return "null"
+	case "!!bool":
+		return "boolean"
+	case "!!int", "!!float":
+		return "number"
+	default:
+		return "string"
+	}
+}
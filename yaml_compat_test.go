@@ -0,0 +1,88 @@
+package actionlint
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAMLCompatResolveScalar(t *testing.T) {
+	tests := []struct {
+		input string
+		tag   string
+		value string
+	}{
+		{"", "!!null", ""},
+		{"~", "!!null", ""},
+		{"null", "!!null", ""},
+		{"NULL", "!!null", ""},
+		{"nULL", "!!str", "nULL"},
+		{"true", "!!bool", "true"},
+		{"True", "!!bool", "true"},
+		{"FALSE", "!!bool", "false"},
+		{"yes", "!!str", "yes"},
+		{"on", "!!str", "on"},
+		{"42", "!!int", "42"},
+		{"-42", "!!int", "-42"},
+		{"+42", "!!int", "42"},
+		{"017", "!!int", "17"},
+		{"0o17", "!!int", "15"},
+		{"0x1F", "!!int", "31"},
+		{"0b11", "!!str", "0b11"},
+		{"1_000", "!!str", "1_000"},
+		{"1:30", "!!str", "1:30"},
+		{"99999999999999999999", "!!float", "100000000000000000000"},
+		{"3.10", "!!float", "3.1"},
+		{"1.0", "!!float", "1"},
+		{".5", "!!float", "0.5"},
+		{"1e3", "!!float", "1000"},
+		{"-.inf", "!!float", "-Infinity"},
+		{".NaN", "!!float", "NaN"},
+		{"v1.0", "!!str", "v1.0"},
+		{"'3.10'", "!!str", "3.10"},
+		{"!!str 3.10", "!!str", "3.10"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			var n yaml.Node
+			if err := yaml.Unmarshal([]byte("v: "+tc.input), &n); err != nil {
+				t.Fatal(err)
+			}
+			r := resolveYAMLScalar(n.Content[0].Content[1])
+			if r.tag != tc.tag || r.value != tc.value {
+				t.Fatalf("wanted %s %q but got %s %q", tc.tag, tc.value, r.tag, r.value)
+			}
+		})
+	}
+}
+
+func TestYAMLCompatParseNumbers(t *testing.T) {
+	src := `on: push
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    timeout-minutes: 0x1E
+    continue-on-error: TRUE
+    strategy:
+      max-parallel: 0o10
+      matrix:
+        os: [ubuntu-latest]
+    steps:
+      - run: echo
+`
+	w, errs := Parse([]byte(src))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	j := w.Jobs["test"]
+	if j.TimeoutMinutes.Value != 30 {
+		t.Errorf("timeout-minutes should be 30 but got %v", j.TimeoutMinutes.Value)
+	}
+	if !j.ContinueOnError.Value {
+		t.Error("continue-on-error should be true")
+	}
+	if j.Strategy.MaxParallel.Value != 8 {
+		t.Errorf("max-parallel should be 8 but got %v", j.Strategy.MaxParallel.Value)
+	}
+}